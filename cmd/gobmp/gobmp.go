@@ -7,17 +7,30 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"net/http"
 	_ "net/http/pprof"
 
 	"github.com/golang/glog"
+	"github.com/sbezverk/gobmp/pkg/avro"
+	"github.com/sbezverk/gobmp/pkg/churn"
 	"github.com/sbezverk/gobmp/pkg/config"
 	"github.com/sbezverk/gobmp/pkg/dumper"
 	"github.com/sbezverk/gobmp/pkg/filer"
+	"github.com/sbezverk/gobmp/pkg/geoip"
 	"github.com/sbezverk/gobmp/pkg/gobmpsrv"
+	"github.com/sbezverk/gobmp/pkg/grpcpub"
+	"github.com/sbezverk/gobmp/pkg/hijack"
+	"github.com/sbezverk/gobmp/pkg/inventory"
 	"github.com/sbezverk/gobmp/pkg/kafka"
 	"github.com/sbezverk/gobmp/pkg/nats"
+	"github.com/sbezverk/gobmp/pkg/openbmp"
+	"github.com/sbezverk/gobmp/pkg/profile"
+	"github.com/sbezverk/gobmp/pkg/pub"
+	"github.com/sbezverk/gobmp/pkg/rules"
+	"github.com/sbezverk/gobmp/pkg/topology"
+	"github.com/sbezverk/gobmp/pkg/vrf"
 	"github.com/sbezverk/tools"
 )
 
@@ -27,13 +40,51 @@ var (
 	kafkaSrv          string
 	kafkaTpRetnTimeMs string // Kafka topic retention time in ms
 	kafkaTopicPrefix  string
+	kafkaRegistry     string
+	kafkaSubjectStrat string
+	kafkaAutoRegister string
 	natsSrv           string
 	splitAF           string
 	dump              string
 	file              string
 	bmpRaw            string
+	bmpRawParsed      string
+	bmpRawGroup       string
 	adminID           string
+	openbmpParsed     string
 	configFile        string
+	structuredExtComm string
+	mrtDir            string
+	mrtRotation       string
+	mrtRIBInterval    string
+	mrtImport         string
+	mrtImportRouter   string
+	lsTopology        string
+	l3vpnVRF          string
+	l3vpnVRFFile      string
+	l3vpnVRFTopics    string
+	churnEnabled      string
+	churnInterval     string
+	churnHalfLife     string
+	churnSuppress     string
+	churnReuse        string
+	hijackPrefixes    string
+	routeLeak         string
+	routeLeakRoles    string
+	rulesFile         string
+	outputProfile     string
+	baseAttrs         string
+	baseAttrsNorm     string
+	baseAttrsCache    string
+	inventoryFile     string
+	inventoryReload   string
+	geoIPASNDB        string
+	geoIPCountryDB    string
+	geoIPCacheSize    string
+	geoIPReload       string
+	grpcAddress       string
+	grpcBufferSize    string
+	encoding          string
 )
 
 const (
@@ -48,14 +99,52 @@ func init() {
 	flag.IntVar(&srcPort, "source-port", defaultSourcePort, "port exposed to outside")
 	flag.StringVar(&kafkaSrv, "kafka-server", "", "URL to access Kafka server")
 	flag.StringVar(&kafkaTpRetnTimeMs, "kafka-topic-retention-time-ms", defaultKafkaTpRetnTimeMs, "Kafka topic retention time in ms, default is 900000 ms i.e 15 minutes")
+	flag.StringVar(&kafkaRegistry, "kafka-schema-registry", "", "URL of the Confluent compatible schema registry of the avro encoding, e.g. 'http://registry:8081'")
+	flag.StringVar(&kafkaSubjectStrat, "kafka-subject-name-strategy", "topic_name", "Schema registry subject naming of the avro encoding: 'topic_name', 'record_name' or 'topic_record_name'")
+	flag.StringVar(&kafkaAutoRegister, "kafka-auto-register-schemas", "true", "When set \"false\", the avro schemas are looked up in the schema registry instead of being registered")
 	flag.StringVar(&kafkaTopicPrefix, "kafka-topic-prefix", "", "Optional prefix prepended to all Kafka topic names (e.g. 'prod' -> 'prod.gobmp.parsed.peer')")
 	flag.StringVar(&natsSrv, "nats-server", "", "URL to access NATS server")
 	flag.StringVar(&splitAF, "split-af", "true", "When set \"true\" ipv4 and ipv6 will be published in separate topics. if set \"false\" the same topic will be used for both address families.")
 	flag.IntVar(&perfPort, "performance-port", 0, "port used for performance debugging")
 	flag.StringVar(&dump, "dump", "", "Selects the dump publisher: 'console' prints JSON messages to stdout, 'file' writes them to the path set by --msg-file (falls back to console if --msg-file is omitted)")
 	flag.StringVar(&file, "msg-file", "", "Full path and file name to store messages when \"--dump=file\"")
-	flag.StringVar(&bmpRaw, "bmp-raw", "false", "When set \"true\", BMP messages are published in RAW format without parsing (OpenBMP compatibility mode)")
+	flag.StringVar(&bmpRaw, "bmp-raw", "false", "When set \"true\", BMP messages are published in RAW format without parsing (OpenBMP compatibility mode), keyed by router hash, to any publisher")
+	flag.StringVar(&bmpRawParsed, "bmp-raw-parsed", "false", "When set \"true\" with --bmp-raw, BMP messages are published both in RAW format and parsed")
+	flag.StringVar(&bmpRawGroup, "bmp-raw-router-group", "", "Router group of the OpenBMP header of RAW messages")
+	flag.StringVar(&structuredExtComm, "structured-ext-communities", "false", "When set \"true\", base attributes carry typed ext_communities alongside the ext_community_list strings")
+	flag.StringVar(&mrtDir, "mrt-dir", "", "Directory where MRT (RFC 6396) updates and RIB snapshot files are written per router, MRT export is disabled when empty")
+	flag.StringVar(&mrtRotation, "mrt-rotation-interval", "15m", "Period covered by each MRT updates file")
+	flag.StringVar(&mrtRIBInterval, "mrt-rib-interval", "2h", "Period between MRT TABLE_DUMP_V2 RIB snapshots, a negative value disables snapshots")
+	flag.StringVar(&mrtImport, "mrt-import", "", "Comma separated list of MRT files (TABLE_DUMP_V2, BGP4MP, optionally gzip or bzip2 compressed) to publish instead of serving BMP sessions")
+	flag.StringVar(&lsTopology, "ls-topology", "false", "When set \"true\", the collector maintains the BGP-LS IGP topology and publishes topology change events")
+	flag.StringVar(&l3vpnVRF, "l3vpn-vrf", "false", "When set \"true\", L3VPN messages carry the vrf learned from RFC 9069 Loc-RIB table names and peer distinguishers")
+	flag.StringVar(&l3vpnVRFFile, "l3vpn-vrf-file", "", "Path to a YAML file mapping route targets to VRF names, enables the vrf field of L3VPN messages")
+	flag.StringVar(&l3vpnVRFTopics, "l3vpn-vrf-topics", "false", "When set \"true\", L3VPN messages with a known vrf are also published to the per-VRF topics (e.g. 'gobmp.parsed.l3vpn_v4.<vrf>')")
+	flag.StringVar(&churnEnabled, "churn", "false", "When set \"true\", route churn statistics and flap events are published on the churn_stats and flap_event topics")
+	flag.StringVar(&churnInterval, "churn-interval", "1m", "Period of the churn_stats reports")
+	flag.StringVar(&churnHalfLife, "churn-half-life", "15m", "Half life of the route flap damping penalty")
+	flag.StringVar(&churnSuppress, "churn-suppress-threshold", "2000", "Penalty from which a prefix is reported as flapping")
+	flag.StringVar(&churnReuse, "churn-reuse-threshold", "750", "Penalty below which a flapping prefix is reported as stable again")
+	flag.StringVar(&hijackPrefixes, "hijack-prefixes", "", "Path to a YAML file of owned prefixes and their authorised origins, enables the hijack_event topic")
+	flag.StringVar(&routeLeak, "route-leak", "false", "When set \"true\", unicast routes are checked for RFC 9234 route leaks and suspected leaks are published on the route_leak topic")
+	flag.StringVar(&routeLeakRoles, "route-leak-roles", "", "Comma separated list of peer=role BGP Roles overriding the roles learned from peer up messages, e.g. '192.0.2.1=customer,192.0.2.2=peer'")
+	flag.StringVar(&rulesFile, "rules-file", "", "Path to a YAML file of publishing rules dropping, sampling, labelling or routing parsed messages to sub-topics, evaluated after the rules of the config file")
+	flag.StringVar(&outputProfile, "output-profile", "full", "Fields of the published parsed messages: 'full', 'compact' (no empty and legacy fields, base attributes referenced by base_attr_hash, requires --base-attributes) or 'custom' (output_profile.fields of the config file)")
+	flag.StringVar(&baseAttrs, "base-attributes", "false", "When set \"true\", each unique set of base attributes of unicast and L3VPN prefixes is published once per router on the base_attribute topic")
+	flag.StringVar(&baseAttrsNorm, "base-attributes-normalized", "false", "When set \"true\" with --base-attributes, unicast and L3VPN prefix messages carry the base_attr_hash of their base attributes instead of base_attrs")
+	flag.StringVar(&baseAttrsCache, "base-attributes-cache-size", "100000", "Number of recently published base attribute hashes remembered per router, a hash evicted from the cache is published again")
+	flag.StringVar(&inventoryFile, "inventory-file", "", "Path to a YAML or CSV inventory of labels per router, peer, peer ASN or RD, added to the user_labels of the parsed messages")
+	flag.StringVar(&inventoryReload, "inventory-reload-interval", "30s", "Period the inventory file is checked for changes and reloaded, a negative value disables the reloads")
+	flag.StringVar(&geoIPASNDB, "geoip-asn-db", "", "Path to a local MaxMind format ASN database (.mmdb) adding the origin_as_name, origin_as_org and peer_as_name of unicast prefixes and the remote_as_name of peers")
+	flag.StringVar(&geoIPCountryDB, "geoip-country-db", "", "Path to a local MaxMind format country database (.mmdb) adding the country of unicast prefixes")
+	flag.StringVar(&geoIPCacheSize, "geoip-cache-size", "100000", "Number of prefix countries cached, the least recently used prefix is evicted from the cache")
+	flag.StringVar(&geoIPReload, "geoip-reload-interval", "1m", "Period the GeoIP database files are checked for changes and reloaded, a negative value disables the reloads")
+	flag.StringVar(&grpcAddress, "grpc-address", "", "Address the gRPC subscription API listens on, e.g. ':50051', the API is disabled when empty")
+	flag.StringVar(&grpcBufferSize, "grpc-buffer-size", "4096", "Number of messages buffered per gRPC subscriber, a subscriber falling further behind is disconnected")
+	flag.StringVar(&encoding, "encoding", "json", "Encoding of the parsed messages published to Kafka, NATS or dumped: 'json', 'protobuf' (the gobmp.api messages of pkg/api/messages.proto) or 'avro' (Kafka only, with --kafka-schema-registry)")
+	flag.StringVar(&mrtImportRouter, "mrt-import-router", "", "Router IP reported for imported MRT data, defaults to the collector BGP ID or BGP4MP local address")
 	flag.StringVar(&adminID, "admin-id", "", "Collector admin ID for RAW messages (defaults to hostname). Used to generate collector hash for OpenBMP compatibility")
+	flag.StringVar(&openbmpParsed, "openbmp-parsed", "false", "When set \"true\", peer, unicast and L3VPN prefix, BGP-LS and statistics messages are published in the OpenBMP v1.7 format on the openbmp.parsed topics (Kafka only)")
 }
 
 // fatal logs msg at error level, flushes glog's buffer, and exits with code 1.
@@ -91,6 +180,59 @@ func main() {
 			glog.Info(http.ListenAndServe(fmt.Sprintf(":%d", cfg.PerformancePort), nil))
 		}()
 	}
+	if c := cfg.L3VPNVRFConfig; c != nil && (c.Enabled || c.File != "" || c.Topics) {
+		if cfg.VRFMap, err = vrf.LoadMap(c.File); err != nil {
+			fatal("failed to load L3VPN VRF map with error: %+v", err)
+		}
+		glog.Infof("L3VPN VRF mapping has been enabled.")
+	}
+	if c := cfg.RouteLeakConfig; c != nil && c.Enabled {
+		if cfg.BGPRoles, err = config.ParseBGPRoles(c.Roles); err != nil {
+			fatal("failed to load route leak peer roles with error: %+v", err)
+		}
+		glog.Infof("Route leak detection has been enabled.")
+	}
+	if len(cfg.Rules) != 0 || cfg.RulesFile != "" {
+		rs := cfg.Rules
+		if cfg.RulesFile != "" {
+			fileRules, err := rules.LoadFile(cfg.RulesFile)
+			if err != nil {
+				fatal("failed to load publishing rules with error: %+v", err)
+			}
+			rs = append(append([]rules.Rule{}, cfg.Rules...), fileRules...)
+		}
+		if cfg.RuleEngine, err = rules.New(rs); err != nil {
+			fatal("failed to load publishing rules with error: %+v", err)
+		}
+		glog.Infof("%d publishing rules have been loaded.", len(rs))
+	}
+	if c := cfg.OutputProfile; c != nil && c.Name != "" {
+		if cfg.Profile, err = profile.New(c.Name, c.Fields); err != nil {
+			fatal("failed to load the output profile with error: %+v", err)
+		}
+		glog.Infof("The %s output profile has been selected.", c.Name)
+	}
+	if c := cfg.BaseAttributeConfig; c != nil && c.Enabled {
+		glog.Infof("The base_attribute messages have been enabled.")
+	}
+	if c := cfg.InventoryConfig; c != nil && c.File != "" {
+		if cfg.Inventory, err = inventory.Load(c.File); err != nil {
+			fatal("failed to load the inventory with error: %+v", err)
+		}
+		if c.ReloadInterval >= 0 {
+			cfg.Inventory.Start(c.ReloadInterval)
+		}
+		glog.Infof("Inventory labels have been enabled.")
+	}
+	if c := cfg.GeoIPConfig; c != nil && (c.ASNDatabase != "" || c.CountryDatabase != "") {
+		if cfg.GeoIP, err = geoip.New(c.ASNDatabase, c.CountryDatabase, c.CacheSize); err != nil {
+			fatal("failed to load the geoip databases with error: %+v", err)
+		}
+		if c.ReloadInterval >= 0 {
+			cfg.GeoIP.Start(c.ReloadInterval)
+		}
+		glog.Infof("GeoIP enrichment has been enabled.")
+	}
 	// Initializing publisher
 	switch cfg.PublisherType {
 	case config.PublisherTypeDump:
@@ -98,11 +240,11 @@ func main() {
 		// or "file", so dumpMode is guaranteed to be one of those two values here.
 		switch strings.ToLower(dump) {
 		case "console":
-			cfg.Publisher = dumper.NewDumper()
+			cfg.Publisher = dumper.NewDumper(cfg.Encoding)
 			glog.Infof("console publisher has been successfully initialized (dump=console).")
 		case "file":
 			if cfg.DumpConfig != nil && cfg.DumpConfig.File != "" {
-				cfg.Publisher, err = filer.NewFiler(cfg.DumpConfig.File)
+				cfg.Publisher, err = filer.NewFiler(cfg.DumpConfig.File, cfg.Encoding)
 				if err != nil {
 					fatal("failed to initialize file publisher with error: %+v", err)
 				} else {
@@ -110,13 +252,13 @@ func main() {
 				}
 			} else {
 				glog.Warningf("dump=file requested but no dump file configured; falling back to console publisher.")
-				cfg.Publisher = dumper.NewDumper()
+				cfg.Publisher = dumper.NewDumper(cfg.Encoding)
 				glog.Infof("console publisher has been successfully initialized (fallback from dump=file).")
 			}
 		}
 	case config.PublisherTypeNATS:
 		if cfg.NATSConfig != nil && cfg.NATSConfig.NatsSrv != "" {
-			cfg.Publisher, err = nats.NewPublisher(cfg.NATSConfig.NatsSrv)
+			cfg.Publisher, err = nats.NewPublisher(cfg.NATSConfig.NatsSrv, cfg.Encoding)
 			if err != nil {
 				fatal("failed to initialize NATS publisher with error: %+v", err)
 			} else {
@@ -133,14 +275,73 @@ func main() {
 			ServerAddress:        cfg.KafkaConfig.KafkaSrv,
 			TopicRetentionTimeMs: strconv.Itoa(cfg.KafkaConfig.KafkaTpRetnTimeMs),
 			TopicPrefix:          cfg.KafkaConfig.KafkaTopicPrefix,
+			Encoding:             cfg.Encoding,
+			SchemaRegistry:       cfg.KafkaConfig.SchemaRegistry,
+			SubjectStrategy:      cfg.KafkaConfig.SubjectNameStrategy,
+			AutoRegisterSchemas:  cfg.KafkaConfig.AutoRegisterSchemas == nil || *cfg.KafkaConfig.AutoRegisterSchemas,
 		}
 		cfg.Publisher, err = kafka.NewKafkaPublisher(kConfig)
 		if err != nil {
 			fatal("failed to initialize Kafka publisher with error: %+v", err)
 		}
 		glog.Infof("Kafka publisher has been successfully initialized.")
+		if cfg.KafkaConfig.OpenBMPParsed {
+			cfg.Publisher = openbmp.NewPublisher(cfg.Publisher, cfg.KafkaConfig.AdminID)
+			glog.Infof("OpenBMP parsed messages are published with admin ID %s", cfg.KafkaConfig.AdminID)
+		}
+	case config.PublisherTypeGRPC:
+		// The gRPC publisher is the only publisher, it is initialized below.
 	default:
-		fatal("no publisher configured: specify --kafka-server, --nats-server, or --dump")
+		fatal("no publisher configured: specify --kafka-server, --nats-server, --dump or --grpc-address")
+	}
+	if c := cfg.GRPCConfig; c != nil && c.Address != "" {
+		grpcPublisher, err := grpcpub.NewPublisher(c.Address, c.BufferSize)
+		if err != nil {
+			fatal("failed to initialize gRPC publisher with error: %+v", err)
+		}
+		if cfg.Publisher == nil {
+			cfg.Publisher = grpcPublisher
+		} else {
+			cfg.Publisher = pub.Tee(cfg.Publisher, grpcPublisher)
+		}
+		glog.Infof("gRPC publisher has been successfully initialized.")
+	}
+	if cfg.LSTopology {
+		cfg.Observers = append(cfg.Observers, topology.New(cfg.Publisher))
+		glog.Infof("BGP-LS topology has been enabled.")
+	}
+	var analyzer *churn.Analyzer
+	if cfg.ChurnConfig != nil && cfg.ChurnConfig.Enabled {
+		if analyzer, err = churn.New(cfg.Publisher, &cfg.ChurnConfig.Config); err != nil {
+			fatal("failed to initialize churn analytics with error: %+v", err)
+		}
+		analyzer.Start()
+		cfg.Observers = append(cfg.Observers, analyzer)
+		glog.Infof("Route churn analytics has been enabled.")
+	}
+	if cfg.HijackPrefixes != "" {
+		owned, err := hijack.LoadPrefixes(cfg.HijackPrefixes)
+		if err != nil {
+			fatal("failed to load owned prefixes with error: %+v", err)
+		}
+		detector, err := hijack.New(cfg.Publisher, owned)
+		if err != nil {
+			fatal("failed to initialize hijack detection with error: %+v", err)
+		}
+		cfg.Observers = append(cfg.Observers, detector)
+		glog.Infof("Prefix hijack detection has been enabled.")
+	}
+
+	if cfg.MRTImportConfig != nil && len(cfg.MRTImportConfig.Files) != 0 {
+		err := runMRTImport(cfg)
+		analyzer.Stop()
+		cfg.Inventory.Stop()
+		cfg.GeoIP.Stop()
+		cfg.Publisher.Stop()
+		if err != nil {
+			fatal("mrt import failed with error: %+v", err)
+		}
+		return
 	}
 
 	bmpSrv, err := gobmpsrv.NewBMPServer(cfg)
@@ -153,6 +354,9 @@ func main() {
 	<-stopCh
 
 	bmpSrv.Stop()
+	analyzer.Stop()
+	cfg.Inventory.Stop()
+	cfg.GeoIP.Stop()
 }
 
 // defaultKafkaConfig returns a KafkaConfig pre-populated with the retention-
@@ -164,6 +368,17 @@ func defaultKafkaConfig() *config.KafkaConfig {
 	return &config.KafkaConfig{KafkaTpRetnTimeMs: v}
 }
 
+// defaultAdminID returns the admin ID of the collector when none is configured,
+// the hostname.
+func defaultAdminID() string {
+	hostname, err := os.Hostname()
+	if err != nil {
+		glog.Warningf("failed to get hostname, using 'gobmp-collector' as admin ID: %+v", err)
+		return "gobmp-collector"
+	}
+	return hostname
+}
+
 func applyConfigDefaults(cfg *config.Config) {
 	// PublisherType is always reset to Unknown here; the actual type is
 	// inferred from populated sub-configs in applyConfigOverrides.
@@ -207,7 +422,7 @@ func applyConfigOverrides(cfg *config.Config, fs *flag.FlagSet) error {
 	// visitErr captures the first error from inside the closure (fs.Visit
 	// does not support early termination, so we skip further cases once set).
 	var visitErr error
-	var bmpRawSet, adminIDSet bool
+	var adminIDSet, openbmpParsedSet bool
 	fs.Visit(func(f *flag.Flag) {
 		if visitErr != nil {
 			return
@@ -271,10 +486,29 @@ func applyConfigOverrides(cfg *config.Config, fs *flag.FlagSet) error {
 				cfg.KafkaConfig = defaultKafkaConfig()
 			}
 			cfg.KafkaConfig.KafkaTopicPrefix = kafkaTopicPrefix
-		case "bmp-raw":
+		case "kafka-schema-registry":
+			if cfg.KafkaConfig == nil {
+				cfg.KafkaConfig = defaultKafkaConfig()
+			}
+			cfg.KafkaConfig.SchemaRegistry = kafkaRegistry
+		case "kafka-subject-name-strategy":
 			if cfg.KafkaConfig == nil {
 				cfg.KafkaConfig = defaultKafkaConfig()
 			}
+			cfg.KafkaConfig.SubjectNameStrategy = avro.SubjectStrategy(kafkaSubjectStrat)
+		case "kafka-auto-register-schemas":
+			if cfg.KafkaConfig == nil {
+				cfg.KafkaConfig = defaultKafkaConfig()
+			}
+			if v, err := strconv.ParseBool(kafkaAutoRegister); err != nil {
+				visitErr = fmt.Errorf("invalid value for --kafka-auto-register-schemas: %q: %w", kafkaAutoRegister, err)
+			} else {
+				cfg.KafkaConfig.AutoRegisterSchemas = &v
+			}
+		case "bmp-raw":
+			if cfg.RawConfig == nil {
+				cfg.RawConfig = &config.RawConfig{}
+			}
 			if bmpRaw == "" {
 				visitErr = errors.New("invalid empty value for --bmp-raw")
 				return
@@ -282,9 +516,271 @@ func applyConfigOverrides(cfg *config.Config, fs *flag.FlagSet) error {
 			if v, err := strconv.ParseBool(bmpRaw); err != nil {
 				visitErr = fmt.Errorf("invalid value for --bmp-raw: %q: %w", bmpRaw, err)
 			} else {
-				cfg.KafkaConfig.BmpRaw = v
-				bmpRawSet = true
+				cfg.RawConfig.Enabled = v
+			}
+		case "bmp-raw-parsed":
+			if cfg.RawConfig == nil {
+				cfg.RawConfig = &config.RawConfig{}
+			}
+			if v, err := strconv.ParseBool(bmpRawParsed); err != nil {
+				visitErr = fmt.Errorf("invalid value for --bmp-raw-parsed: %q: %w", bmpRawParsed, err)
+			} else {
+				cfg.RawConfig.Parsed = v
+			}
+		case "bmp-raw-router-group":
+			if cfg.RawConfig == nil {
+				cfg.RawConfig = &config.RawConfig{}
+			}
+			cfg.RawConfig.RouterGroup = bmpRawGroup
+		case "openbmp-parsed":
+			if v, err := strconv.ParseBool(openbmpParsed); err != nil {
+				visitErr = fmt.Errorf("invalid value for --openbmp-parsed: %q: %w", openbmpParsed, err)
+			} else {
+				if cfg.KafkaConfig == nil {
+					cfg.KafkaConfig = defaultKafkaConfig()
+				}
+				cfg.KafkaConfig.OpenBMPParsed = v
+				openbmpParsedSet = true
+			}
+		case "structured-ext-communities":
+			if v, err := strconv.ParseBool(structuredExtComm); err != nil {
+				visitErr = fmt.Errorf("invalid value for --structured-ext-communities: %q: %w", structuredExtComm, err)
+			} else {
+				cfg.StructuredExtCommunities = v
+			}
+		case "ls-topology":
+			if v, err := strconv.ParseBool(lsTopology); err != nil {
+				visitErr = fmt.Errorf("invalid value for --ls-topology: %q: %w", lsTopology, err)
+			} else {
+				cfg.LSTopology = v
+			}
+		case "l3vpn-vrf":
+			if cfg.L3VPNVRFConfig == nil {
+				cfg.L3VPNVRFConfig = &config.L3VPNVRFConfig{}
+			}
+			if v, err := strconv.ParseBool(l3vpnVRF); err != nil {
+				visitErr = fmt.Errorf("invalid value for --l3vpn-vrf: %q: %w", l3vpnVRF, err)
+			} else {
+				cfg.L3VPNVRFConfig.Enabled = v
+			}
+		case "l3vpn-vrf-file":
+			if cfg.L3VPNVRFConfig == nil {
+				cfg.L3VPNVRFConfig = &config.L3VPNVRFConfig{}
+			}
+			cfg.L3VPNVRFConfig.File = l3vpnVRFFile
+		case "l3vpn-vrf-topics":
+			if cfg.L3VPNVRFConfig == nil {
+				cfg.L3VPNVRFConfig = &config.L3VPNVRFConfig{}
+			}
+			if v, err := strconv.ParseBool(l3vpnVRFTopics); err != nil {
+				visitErr = fmt.Errorf("invalid value for --l3vpn-vrf-topics: %q: %w", l3vpnVRFTopics, err)
+			} else {
+				cfg.L3VPNVRFConfig.Topics = v
+			}
+		case "churn":
+			if cfg.ChurnConfig == nil {
+				cfg.ChurnConfig = &config.ChurnConfig{}
+			}
+			if v, err := strconv.ParseBool(churnEnabled); err != nil {
+				visitErr = fmt.Errorf("invalid value for --churn: %q: %w", churnEnabled, err)
+			} else {
+				cfg.ChurnConfig.Enabled = v
+			}
+		case "churn-interval":
+			if cfg.ChurnConfig == nil {
+				cfg.ChurnConfig = &config.ChurnConfig{}
+			}
+			if v, err := time.ParseDuration(churnInterval); err != nil || v <= 0 {
+				visitErr = fmt.Errorf("invalid value for --churn-interval: %q: must be a positive duration", churnInterval)
+			} else {
+				cfg.ChurnConfig.Interval = v
 			}
+		case "churn-half-life":
+			if cfg.ChurnConfig == nil {
+				cfg.ChurnConfig = &config.ChurnConfig{}
+			}
+			if v, err := time.ParseDuration(churnHalfLife); err != nil || v <= 0 {
+				visitErr = fmt.Errorf("invalid value for --churn-half-life: %q: must be a positive duration", churnHalfLife)
+			} else {
+				cfg.ChurnConfig.HalfLife = v
+			}
+		case "churn-suppress-threshold":
+			if cfg.ChurnConfig == nil {
+				cfg.ChurnConfig = &config.ChurnConfig{}
+			}
+			if v, err := strconv.ParseFloat(churnSuppress, 64); err != nil {
+				visitErr = fmt.Errorf("invalid value for --churn-suppress-threshold: %q: %w", churnSuppress, err)
+			} else {
+				cfg.ChurnConfig.SuppressThreshold = v
+			}
+		case "churn-reuse-threshold":
+			if cfg.ChurnConfig == nil {
+				cfg.ChurnConfig = &config.ChurnConfig{}
+			}
+			if v, err := strconv.ParseFloat(churnReuse, 64); err != nil {
+				visitErr = fmt.Errorf("invalid value for --churn-reuse-threshold: %q: %w", churnReuse, err)
+			} else {
+				cfg.ChurnConfig.ReuseThreshold = v
+			}
+		case "grpc-address":
+			if cfg.GRPCConfig == nil {
+				cfg.GRPCConfig = &config.GRPCConfig{}
+			}
+			cfg.GRPCConfig.Address = grpcAddress
+		case "grpc-buffer-size":
+			if cfg.GRPCConfig == nil {
+				cfg.GRPCConfig = &config.GRPCConfig{}
+			}
+			if v, err := strconv.Atoi(grpcBufferSize); err != nil || v <= 0 {
+				visitErr = fmt.Errorf("invalid value for --grpc-buffer-size: %q: must be a positive integer", grpcBufferSize)
+			} else {
+				cfg.GRPCConfig.BufferSize = v
+			}
+		case "encoding":
+			cfg.Encoding = pub.Encoding(encoding)
+		case "hijack-prefixes":
+			cfg.HijackPrefixes = hijackPrefixes
+		case "route-leak":
+			if cfg.RouteLeakConfig == nil {
+				cfg.RouteLeakConfig = &config.RouteLeakConfig{}
+			}
+			if v, err := strconv.ParseBool(routeLeak); err != nil {
+				visitErr = fmt.Errorf("invalid value for --route-leak: %q: %w", routeLeak, err)
+			} else {
+				cfg.RouteLeakConfig.Enabled = v
+			}
+		case "route-leak-roles":
+			if cfg.RouteLeakConfig == nil {
+				cfg.RouteLeakConfig = &config.RouteLeakConfig{}
+			}
+			if cfg.RouteLeakConfig.Roles == nil {
+				cfg.RouteLeakConfig.Roles = make(map[string]string)
+			}
+			for _, entry := range strings.Split(routeLeakRoles, ",") {
+				if entry = strings.TrimSpace(entry); entry == "" {
+					continue
+				}
+				peer, role, ok := strings.Cut(entry, "=")
+				if !ok {
+					visitErr = fmt.Errorf("invalid value for --route-leak-roles: %q: expected peer=role", entry)
+					return
+				}
+				cfg.RouteLeakConfig.Roles[strings.TrimSpace(peer)] = strings.TrimSpace(role)
+			}
+		case "rules-file":
+			cfg.RulesFile = rulesFile
+		case "output-profile":
+			if cfg.OutputProfile == nil {
+				cfg.OutputProfile = &config.OutputProfileConfig{}
+			}
+			cfg.OutputProfile.Name = outputProfile
+		case "base-attributes":
+			if cfg.BaseAttributeConfig == nil {
+				cfg.BaseAttributeConfig = &config.BaseAttributeConfig{}
+			}
+			if v, err := strconv.ParseBool(baseAttrs); err != nil {
+				visitErr = fmt.Errorf("invalid value for --base-attributes: %q: %w", baseAttrs, err)
+			} else {
+				cfg.BaseAttributeConfig.Enabled = v
+			}
+		case "base-attributes-normalized":
+			if cfg.BaseAttributeConfig == nil {
+				cfg.BaseAttributeConfig = &config.BaseAttributeConfig{}
+			}
+			if v, err := strconv.ParseBool(baseAttrsNorm); err != nil {
+				visitErr = fmt.Errorf("invalid value for --base-attributes-normalized: %q: %w", baseAttrsNorm, err)
+			} else {
+				cfg.BaseAttributeConfig.Normalized = v
+			}
+		case "base-attributes-cache-size":
+			if cfg.BaseAttributeConfig == nil {
+				cfg.BaseAttributeConfig = &config.BaseAttributeConfig{}
+			}
+			if v, err := strconv.Atoi(baseAttrsCache); err != nil || v <= 0 {
+				visitErr = fmt.Errorf("invalid value for --base-attributes-cache-size: %q: must be a positive integer", baseAttrsCache)
+			} else {
+				cfg.BaseAttributeConfig.CacheSize = v
+			}
+		case "inventory-file":
+			if cfg.InventoryConfig == nil {
+				cfg.InventoryConfig = &config.InventoryConfig{}
+			}
+			cfg.InventoryConfig.File = inventoryFile
+		case "inventory-reload-interval":
+			if cfg.InventoryConfig == nil {
+				cfg.InventoryConfig = &config.InventoryConfig{}
+			}
+			if v, err := time.ParseDuration(inventoryReload); err != nil {
+				visitErr = fmt.Errorf("invalid value for --inventory-reload-interval: %q: %w", inventoryReload, err)
+			} else {
+				cfg.InventoryConfig.ReloadInterval = v
+			}
+		case "geoip-asn-db":
+			if cfg.GeoIPConfig == nil {
+				cfg.GeoIPConfig = &config.GeoIPConfig{}
+			}
+			cfg.GeoIPConfig.ASNDatabase = geoIPASNDB
+		case "geoip-country-db":
+			if cfg.GeoIPConfig == nil {
+				cfg.GeoIPConfig = &config.GeoIPConfig{}
+			}
+			cfg.GeoIPConfig.CountryDatabase = geoIPCountryDB
+		case "geoip-cache-size":
+			if cfg.GeoIPConfig == nil {
+				cfg.GeoIPConfig = &config.GeoIPConfig{}
+			}
+			if v, err := strconv.Atoi(geoIPCacheSize); err != nil || v <= 0 {
+				visitErr = fmt.Errorf("invalid value for --geoip-cache-size: %q: must be a positive integer", geoIPCacheSize)
+			} else {
+				cfg.GeoIPConfig.CacheSize = v
+			}
+		case "geoip-reload-interval":
+			if cfg.GeoIPConfig == nil {
+				cfg.GeoIPConfig = &config.GeoIPConfig{}
+			}
+			if v, err := time.ParseDuration(geoIPReload); err != nil {
+				visitErr = fmt.Errorf("invalid value for --geoip-reload-interval: %q: %w", geoIPReload, err)
+			} else {
+				cfg.GeoIPConfig.ReloadInterval = v
+			}
+		case "mrt-dir":
+			if cfg.MRTConfig == nil {
+				cfg.MRTConfig = &config.MRTConfig{}
+			}
+			cfg.MRTConfig.Dir = mrtDir
+		case "mrt-rotation-interval":
+			if cfg.MRTConfig == nil {
+				cfg.MRTConfig = &config.MRTConfig{}
+			}
+			if v, err := time.ParseDuration(mrtRotation); err != nil || v <= 0 {
+				visitErr = fmt.Errorf("invalid value for --mrt-rotation-interval: %q: must be a positive duration", mrtRotation)
+			} else {
+				cfg.MRTConfig.RotationInterval = v
+			}
+		case "mrt-rib-interval":
+			if cfg.MRTConfig == nil {
+				cfg.MRTConfig = &config.MRTConfig{}
+			}
+			if v, err := time.ParseDuration(mrtRIBInterval); err != nil {
+				visitErr = fmt.Errorf("invalid value for --mrt-rib-interval: %q: %w", mrtRIBInterval, err)
+			} else {
+				cfg.MRTConfig.RIBInterval = v
+			}
+		case "mrt-import":
+			if cfg.MRTImportConfig == nil {
+				cfg.MRTImportConfig = &config.MRTImportConfig{}
+			}
+			cfg.MRTImportConfig.Files = nil
+			for _, f := range strings.Split(mrtImport, ",") {
+				if f = strings.TrimSpace(f); f != "" {
+					cfg.MRTImportConfig.Files = append(cfg.MRTImportConfig.Files, f)
+				}
+			}
+		case "mrt-import-router":
+			if cfg.MRTImportConfig == nil {
+				cfg.MRTImportConfig = &config.MRTImportConfig{}
+			}
+			cfg.MRTImportConfig.RouterIP = mrtImportRouter
 		case "admin-id":
 			if cfg.KafkaConfig == nil {
 				cfg.KafkaConfig = defaultKafkaConfig()
@@ -292,19 +788,18 @@ func applyConfigOverrides(cfg *config.Config, fs *flag.FlagSet) error {
 			cfg.KafkaConfig.AdminID = adminID
 			adminIDSet = true
 			if cfg.KafkaConfig.AdminID == "" {
-				hostname, err := os.Hostname()
-				if err != nil {
-					glog.Warningf("failed to get hostname, using 'gobmp-collector' as admin ID: %+v", err)
-					cfg.KafkaConfig.AdminID = "gobmp-collector"
-				} else {
-					cfg.KafkaConfig.AdminID = hostname
-				}
+				cfg.KafkaConfig.AdminID = defaultAdminID()
 			}
 		}
 	})
 	if visitErr != nil {
 		return visitErr
 	}
+	// The encoding is validated here as it may be set by the config file
+	var err error
+	if cfg.Encoding, err = pub.ParseEncoding(string(cfg.Encoding)); err != nil {
+		return fmt.Errorf("invalid encoding: %w", err)
+	}
 	// Infer publisher type from explicit server-URL flags when --dump was not
 	// provided. This preserves backward-compatible behaviour: passing
 	// --nats-server or --kafka-server alone is enough to select that publisher.
@@ -320,27 +815,73 @@ func applyConfigOverrides(cfg *config.Config, fs *flag.FlagSet) error {
 			cfg.PublisherType = config.PublisherTypeNATS
 		case hasKafka:
 			cfg.PublisherType = config.PublisherTypeKafka
+		case cfg.GRPCConfig != nil && cfg.GRPCConfig.Address != "":
+			cfg.PublisherType = config.PublisherTypeGRPC
 		}
 	}
-	// Ensure AdminID is set whenever Kafka is the selected publisher.
-	if cfg.PublisherType == config.PublisherTypeKafka && cfg.KafkaConfig != nil && cfg.KafkaConfig.AdminID == "" {
-		hostname, err := os.Hostname()
-		if err != nil {
-			glog.Warningf("failed to get hostname, using 'gobmp-collector' as admin ID: %+v", err)
-			cfg.KafkaConfig.AdminID = "gobmp-collector"
-		} else {
-			cfg.KafkaConfig.AdminID = hostname
+	// The avro encoding needs the schema registry of the Kafka publisher
+	if cfg.Encoding == pub.EncodingAvro {
+		if cfg.PublisherType != config.PublisherTypeKafka {
+			return fmt.Errorf("the avro encoding is only supported by the Kafka publisher (current publisher: %s)", cfg.PublisherType.String())
+		}
+		if cfg.KafkaConfig.SchemaRegistry == "" {
+			return errors.New("the avro encoding requires a schema registry: set --kafka-schema-registry or kafka_config.schema_registry")
+		}
+	}
+	if cfg.KafkaConfig != nil {
+		if cfg.KafkaConfig.SubjectNameStrategy, err = avro.ParseSubjectStrategy(string(cfg.KafkaConfig.SubjectNameStrategy)); err != nil {
+			return fmt.Errorf("invalid kafka subject name strategy: %w", err)
+		}
+	}
+	// kafka_config.bmp_raw is the RAW mode setting written before raw_config
+	if cfg.KafkaConfig != nil && cfg.KafkaConfig.BmpRaw {
+		if cfg.RawConfig == nil {
+			cfg.RawConfig = &config.RawConfig{}
+		}
+		cfg.RawConfig.Enabled = true
+	}
+	// The OpenBMP records are rendered from the published messages, they need
+	// every field
+	if c := cfg.OutputProfile; c != nil && c.Name != "" && c.Name != profile.Full &&
+		cfg.PublisherType == config.PublisherTypeKafka && cfg.KafkaConfig.OpenBMPParsed {
+		return fmt.Errorf("the %s output profile cannot be combined with the OpenBMP parsed messages", c.Name)
+	}
+	// The compact prefix messages reference their base attributes by hash
+	if c := cfg.OutputProfile; c != nil && c.Name == profile.Compact &&
+		(cfg.BaseAttributeConfig == nil || !cfg.BaseAttributeConfig.Enabled) {
+		return errors.New("the compact output profile requires the base_attribute messages: set --base-attributes or base_attribute_config.enabled")
+	}
+	if c := cfg.BaseAttributeConfig; c != nil && c.Normalized {
+		if !c.Enabled {
+			return errors.New("the normalized prefix messages require the base_attribute messages: set --base-attributes or base_attribute_config.enabled")
 		}
+		if cfg.PublisherType == config.PublisherTypeKafka && cfg.KafkaConfig.OpenBMPParsed {
+			return errors.New("the normalized prefix messages cannot be combined with the OpenBMP parsed messages")
+		}
+	}
+	rawMode := cfg.RawConfig != nil && cfg.RawConfig.Enabled
+	// Ensure AdminID is set whenever Kafka is the selected publisher or the RAW
+	// messages of any publisher are enabled.
+	if cfg.PublisherType == config.PublisherTypeKafka || rawMode {
+		if cfg.KafkaConfig == nil {
+			cfg.KafkaConfig = defaultKafkaConfig()
+		}
+		if cfg.KafkaConfig.AdminID == "" {
+			cfg.KafkaConfig.AdminID = defaultAdminID()
+		}
+	}
+	if rawMode && cfg.PublisherType == config.PublisherTypeGRPC {
+		glog.Warningf("--bmp-raw is set but the gRPC publisher does not publish RAW messages")
 	}
 	// Warn when Kafka-specific flags were provided but Kafka is not the selected
 	// publisher. The flags are accepted (not an error) to avoid breaking
 	// scripted invocations, but the operator should know they have no effect.
 	if cfg.PublisherType != config.PublisherTypeKafka {
-		if bmpRawSet {
-			glog.Warningf("--bmp-raw is set but has no effect: it only applies to the Kafka publisher (current publisher: %s)", cfg.PublisherType.String())
+		if adminIDSet && !rawMode {
+			glog.Warningf("--admin-id is set but has no effect: it only applies to the Kafka publisher and the RAW mode (current publisher: %s)", cfg.PublisherType.String())
 		}
-		if adminIDSet {
-			glog.Warningf("--admin-id is set but has no effect: it only applies to the Kafka publisher (current publisher: %s)", cfg.PublisherType.String())
+		if openbmpParsedSet {
+			glog.Warningf("--openbmp-parsed is set but has no effect: it only applies to the Kafka publisher (current publisher: %s)", cfg.PublisherType.String())
 		}
 	}
 	return nil
@@ -5,9 +5,12 @@ import (
 	"os"
 	"reflect"
 	"testing"
+	"time"
 
+	"github.com/sbezverk/gobmp/pkg/avro"
 	"github.com/sbezverk/gobmp/pkg/bmp"
 	"github.com/sbezverk/gobmp/pkg/config"
+	"github.com/sbezverk/gobmp/pkg/pub"
 )
 
 func TestMain(m *testing.M) {
@@ -257,12 +260,50 @@ func newTestFlagSet() *flag.FlagSet {
 	fs.StringVar(&kafkaSrv, "kafka-server", "", "")
 	fs.StringVar(&kafkaTpRetnTimeMs, "kafka-topic-retention-time-ms", defaultKafkaTpRetnTimeMs, "")
 	fs.StringVar(&kafkaTopicPrefix, "kafka-topic-prefix", "", "")
+	fs.StringVar(&kafkaRegistry, "kafka-schema-registry", "", "")
+	fs.StringVar(&kafkaSubjectStrat, "kafka-subject-name-strategy", "", "")
+	fs.StringVar(&kafkaAutoRegister, "kafka-auto-register-schemas", "", "")
 	fs.StringVar(&natsSrv, "nats-server", "", "")
 	fs.StringVar(&splitAF, "split-af", "", "")
 	fs.StringVar(&dump, "dump", "", "")
 	fs.StringVar(&file, "msg-file", "", "")
 	fs.StringVar(&bmpRaw, "bmp-raw", "", "")
+	fs.StringVar(&bmpRawParsed, "bmp-raw-parsed", "", "")
+	fs.StringVar(&bmpRawGroup, "bmp-raw-router-group", "", "")
 	fs.StringVar(&adminID, "admin-id", "", "")
+	fs.StringVar(&openbmpParsed, "openbmp-parsed", "", "")
+	fs.StringVar(&structuredExtComm, "structured-ext-communities", "", "")
+	fs.StringVar(&mrtDir, "mrt-dir", "", "")
+	fs.StringVar(&mrtRotation, "mrt-rotation-interval", "", "")
+	fs.StringVar(&mrtRIBInterval, "mrt-rib-interval", "", "")
+	fs.StringVar(&mrtImport, "mrt-import", "", "")
+	fs.StringVar(&mrtImportRouter, "mrt-import-router", "", "")
+	fs.StringVar(&lsTopology, "ls-topology", "", "")
+	fs.StringVar(&l3vpnVRF, "l3vpn-vrf", "", "")
+	fs.StringVar(&l3vpnVRFFile, "l3vpn-vrf-file", "", "")
+	fs.StringVar(&l3vpnVRFTopics, "l3vpn-vrf-topics", "", "")
+	fs.StringVar(&churnEnabled, "churn", "", "")
+	fs.StringVar(&churnInterval, "churn-interval", "", "")
+	fs.StringVar(&churnHalfLife, "churn-half-life", "", "")
+	fs.StringVar(&churnSuppress, "churn-suppress-threshold", "", "")
+	fs.StringVar(&churnReuse, "churn-reuse-threshold", "", "")
+	fs.StringVar(&hijackPrefixes, "hijack-prefixes", "", "")
+	fs.StringVar(&routeLeak, "route-leak", "", "")
+	fs.StringVar(&routeLeakRoles, "route-leak-roles", "", "")
+	fs.StringVar(&rulesFile, "rules-file", "", "")
+	fs.StringVar(&outputProfile, "output-profile", "full", "")
+	fs.StringVar(&baseAttrs, "base-attributes", "", "")
+	fs.StringVar(&baseAttrsNorm, "base-attributes-normalized", "", "")
+	fs.StringVar(&baseAttrsCache, "base-attributes-cache-size", "", "")
+	fs.StringVar(&inventoryFile, "inventory-file", "", "")
+	fs.StringVar(&inventoryReload, "inventory-reload-interval", "30s", "")
+	fs.StringVar(&geoIPASNDB, "geoip-asn-db", "", "")
+	fs.StringVar(&geoIPCountryDB, "geoip-country-db", "", "")
+	fs.StringVar(&geoIPCacheSize, "geoip-cache-size", "", "")
+	fs.StringVar(&geoIPReload, "geoip-reload-interval", "1m", "")
+	fs.StringVar(&grpcAddress, "grpc-address", "", "")
+	fs.StringVar(&grpcBufferSize, "grpc-buffer-size", "", "")
+	fs.StringVar(&encoding, "encoding", "", "")
 	return fs
 }
 
@@ -550,8 +591,48 @@ func TestApplyConfigOverrides_BmpRaw(t *testing.T) {
 		t.Fatalf("unexpected error: %v", err)
 	}
 
-	if !cfg.KafkaConfig.BmpRaw {
-		t.Error("BmpRaw = false, want true")
+	if cfg.RawConfig == nil || !cfg.RawConfig.Enabled {
+		t.Error("RawConfig.Enabled = false, want true")
+	}
+	// The RAW messages are named after the collector admin ID
+	if cfg.KafkaConfig == nil || cfg.KafkaConfig.AdminID == "" {
+		t.Error("AdminID should default to the hostname in RAW mode")
+	}
+}
+
+func TestApplyConfigOverrides_BmpRawParsed(t *testing.T) {
+	fs := newTestFlagSet()
+	for name, value := range map[string]string{"nats-server": "nats://localhost:4222", "bmp-raw": "true", "bmp-raw-parsed": "true", "bmp-raw-router-group": "edge"} {
+		if err := fs.Set(name, value); err != nil {
+			t.Fatalf("failed to set flag %s: %v", name, err)
+		}
+	}
+	cfg := &config.Config{}
+	if err := applyConfigOverrides(cfg, fs); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if c := cfg.RawConfig; c == nil || !c.Enabled || !c.Parsed || c.RouterGroup != "edge" {
+		t.Errorf("RawConfig = %+v", c)
+	}
+	if cfg.PublisherType != config.PublisherTypeNATS {
+		t.Errorf("PublisherType = %v, want NATS", cfg.PublisherType)
+	}
+
+	// kafka_config.bmp_raw enables the RAW mode
+	cfg = &config.Config{KafkaConfig: &config.KafkaConfig{KafkaSrv: "localhost:9092", BmpRaw: true}}
+	if err := applyConfigOverrides(cfg, newTestFlagSet()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.RawConfig == nil || !cfg.RawConfig.Enabled {
+		t.Errorf("RawConfig = %+v, want enabled", cfg.RawConfig)
+	}
+
+	fs = newTestFlagSet()
+	if err := fs.Set("bmp-raw-parsed", "notabool"); err != nil {
+		t.Fatalf("failed to set flag: %v", err)
+	}
+	if err := applyConfigOverrides(&config.Config{}, fs); err == nil {
+		t.Error("expected error for non-boolean --bmp-raw-parsed value, got nil")
 	}
 }
 
@@ -620,8 +701,8 @@ func TestApplyConfigOverrides_BmpRaw_Invalid(t *testing.T) {
 }
 
 func TestApplyConfigOverrides_BmpRaw_WithoutKafka_NoError(t *testing.T) {
-	// --bmp-raw without --kafka-server is not an error; a warning is logged.
-	// Verify applyConfigOverrides succeeds and the flag value is still stored.
+	// --bmp-raw without --kafka-server is not an error, the RAW messages are
+	// published by the dump publisher.
 	fs := newTestFlagSet()
 	if err := fs.Set("bmp-raw", "true"); err != nil {
 		t.Fatalf("failed to set flag: %v", err)
@@ -634,9 +715,8 @@ func TestApplyConfigOverrides_BmpRaw_WithoutKafka_NoError(t *testing.T) {
 	if err := applyConfigOverrides(cfg, fs); err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
-	// BmpRaw is stored even though the publisher is not Kafka.
-	if cfg.KafkaConfig == nil || !cfg.KafkaConfig.BmpRaw {
-		t.Error("BmpRaw should be stored in KafkaConfig regardless of publisher selection")
+	if cfg.RawConfig == nil || !cfg.RawConfig.Enabled {
+		t.Error("RawConfig.Enabled should be set regardless of publisher selection")
 	}
 }
 
@@ -658,3 +738,539 @@ func TestApplyConfigOverrides_AdminID_WithoutKafka_NoError(t *testing.T) {
 		t.Error("AdminID should be stored in KafkaConfig regardless of publisher selection")
 	}
 }
+
+func TestApplyConfigOverrides_StructuredExtCommunities(t *testing.T) {
+	fs := newTestFlagSet()
+	if err := fs.Set("structured-ext-communities", "true"); err != nil {
+		t.Fatalf("failed to set flag: %v", err)
+	}
+
+	cfg := &config.Config{}
+	if err := applyConfigOverrides(cfg, fs); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !cfg.StructuredExtCommunities {
+		t.Error("StructuredExtCommunities = false, want true")
+	}
+}
+
+func TestApplyConfigOverrides_StructuredExtCommunities_Invalid(t *testing.T) {
+	fs := newTestFlagSet()
+	if err := fs.Set("structured-ext-communities", "notabool"); err != nil {
+		t.Fatalf("failed to set flag: %v", err)
+	}
+
+	cfg := &config.Config{}
+	if err := applyConfigOverrides(cfg, fs); err == nil {
+		t.Error("expected error for non-boolean --structured-ext-communities value, got nil")
+	}
+}
+
+func TestApplyConfigOverrides_LSTopology(t *testing.T) {
+	fs := newTestFlagSet()
+	if err := fs.Set("ls-topology", "true"); err != nil {
+		t.Fatalf("failed to set flag: %v", err)
+	}
+
+	cfg := &config.Config{}
+	if err := applyConfigOverrides(cfg, fs); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !cfg.LSTopology {
+		t.Error("LSTopology = false, want true")
+	}
+}
+
+func TestApplyConfigOverrides_L3VPNVRF(t *testing.T) {
+	fs := newTestFlagSet()
+	for name, value := range map[string]string{
+		"l3vpn-vrf-file":   "/etc/gobmp/vrfs.yaml",
+		"l3vpn-vrf-topics": "true",
+	} {
+		if err := fs.Set(name, value); err != nil {
+			t.Fatalf("failed to set flag %s: %v", name, err)
+		}
+	}
+
+	cfg := &config.Config{}
+	if err := applyConfigOverrides(cfg, fs); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if cfg.L3VPNVRFConfig == nil || cfg.L3VPNVRFConfig.File != "/etc/gobmp/vrfs.yaml" || !cfg.L3VPNVRFConfig.Topics {
+		t.Errorf("L3VPNVRFConfig = %+v, want file /etc/gobmp/vrfs.yaml with topics", cfg.L3VPNVRFConfig)
+	}
+
+	fs = newTestFlagSet()
+	if err := fs.Set("l3vpn-vrf", "yes"); err != nil {
+		t.Fatalf("failed to set flag: %v", err)
+	}
+	if err := applyConfigOverrides(&config.Config{}, fs); err == nil {
+		t.Error("expected error for invalid --l3vpn-vrf value")
+	}
+}
+
+func TestApplyConfigOverrides_Churn(t *testing.T) {
+	fs := newTestFlagSet()
+	for name, value := range map[string]string{
+		"churn":                    "true",
+		"churn-interval":           "30s",
+		"churn-half-life":          "5m",
+		"churn-suppress-threshold": "3000",
+		"churn-reuse-threshold":    "1000",
+	} {
+		if err := fs.Set(name, value); err != nil {
+			t.Fatalf("failed to set flag %s: %v", name, err)
+		}
+	}
+
+	cfg := &config.Config{}
+	if err := applyConfigOverrides(cfg, fs); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	c := cfg.ChurnConfig
+	if c == nil || !c.Enabled || c.Interval != 30*time.Second || c.HalfLife != 5*time.Minute || c.SuppressThreshold != 3000 || c.ReuseThreshold != 1000 {
+		t.Errorf("ChurnConfig = %+v", c)
+	}
+
+	fs = newTestFlagSet()
+	if err := fs.Set("churn-interval", "0s"); err != nil {
+		t.Fatalf("failed to set flag: %v", err)
+	}
+	if err := applyConfigOverrides(&config.Config{}, fs); err == nil {
+		t.Error("expected error for non positive --churn-interval")
+	}
+}
+
+func TestApplyConfigOverrides_HijackPrefixes(t *testing.T) {
+	fs := newTestFlagSet()
+	if err := fs.Set("hijack-prefixes", "/etc/gobmp/prefixes.yaml"); err != nil {
+		t.Fatalf("failed to set flag: %v", err)
+	}
+
+	cfg := &config.Config{HijackPrefixes: "/tmp/prefixes.yaml"}
+	if err := applyConfigOverrides(cfg, fs); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.HijackPrefixes != "/etc/gobmp/prefixes.yaml" {
+		t.Errorf("HijackPrefixes = %q, want %q", cfg.HijackPrefixes, "/etc/gobmp/prefixes.yaml")
+	}
+}
+
+func TestApplyConfigOverrides_RouteLeak(t *testing.T) {
+	fs := newTestFlagSet()
+	for name, value := range map[string]string{
+		"route-leak":       "true",
+		"route-leak-roles": "192.0.2.1=customer, 2001:db8::1=peer",
+	} {
+		if err := fs.Set(name, value); err != nil {
+			t.Fatalf("failed to set flag %s: %v", name, err)
+		}
+	}
+
+	cfg := &config.Config{RouteLeakConfig: &config.RouteLeakConfig{Roles: map[string]string{"192.0.2.2": "provider"}}}
+	if err := applyConfigOverrides(cfg, fs); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := map[string]string{"192.0.2.1": "customer", "192.0.2.2": "provider", "2001:db8::1": "peer"}
+	if c := cfg.RouteLeakConfig; !c.Enabled || !reflect.DeepEqual(c.Roles, want) {
+		t.Errorf("RouteLeakConfig = %+v, want roles %v", c, want)
+	}
+
+	fs = newTestFlagSet()
+	if err := fs.Set("route-leak-roles", "192.0.2.1"); err != nil {
+		t.Fatalf("failed to set flag: %v", err)
+	}
+	if err := applyConfigOverrides(&config.Config{}, fs); err == nil {
+		t.Error("expected error for --route-leak-roles entry without a role")
+	}
+}
+
+func TestApplyConfigOverrides_GRPC(t *testing.T) {
+	fs := newTestFlagSet()
+	for name, value := range map[string]string{
+		"grpc-address":     ":50051",
+		"grpc-buffer-size": "128",
+	} {
+		if err := fs.Set(name, value); err != nil {
+			t.Fatalf("failed to set flag %s: %v", name, err)
+		}
+	}
+
+	cfg := &config.Config{}
+	if err := applyConfigOverrides(cfg, fs); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.PublisherType != config.PublisherTypeGRPC {
+		t.Errorf("PublisherType = %s, want gRPC", cfg.PublisherType)
+	}
+	if c := cfg.GRPCConfig; c.Address != ":50051" || c.BufferSize != 128 {
+		t.Errorf("GRPCConfig = %+v", c)
+	}
+
+	// The gRPC API does not replace a configured publisher
+	fs = newTestFlagSet()
+	for name, value := range map[string]string{
+		"grpc-address": ":50051",
+		"nats-server":  "nats://localhost:4222",
+	} {
+		if err := fs.Set(name, value); err != nil {
+			t.Fatalf("failed to set flag %s: %v", name, err)
+		}
+	}
+	cfg = &config.Config{}
+	if err := applyConfigOverrides(cfg, fs); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.PublisherType != config.PublisherTypeNATS {
+		t.Errorf("PublisherType = %s, want NATS", cfg.PublisherType)
+	}
+
+	fs = newTestFlagSet()
+	if err := fs.Set("grpc-buffer-size", "0"); err != nil {
+		t.Fatalf("failed to set flag: %v", err)
+	}
+	if err := applyConfigOverrides(&config.Config{}, fs); err == nil {
+		t.Error("expected error for --grpc-buffer-size=0")
+	}
+}
+
+func TestApplyConfigOverrides_Encoding(t *testing.T) {
+	// The encoding defaults to JSON
+	cfg := &config.Config{}
+	if err := applyConfigOverrides(cfg, newTestFlagSet()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.Encoding != pub.EncodingJSON {
+		t.Errorf("Encoding = %q, want %q", cfg.Encoding, pub.EncodingJSON)
+	}
+
+	fs := newTestFlagSet()
+	if err := fs.Set("encoding", "protobuf"); err != nil {
+		t.Fatalf("failed to set flag: %v", err)
+	}
+	cfg = &config.Config{Encoding: pub.EncodingJSON}
+	if err := applyConfigOverrides(cfg, fs); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.Encoding != pub.EncodingProtobuf {
+		t.Errorf("Encoding = %q, want %q", cfg.Encoding, pub.EncodingProtobuf)
+	}
+
+	fs = newTestFlagSet()
+	if err := fs.Set("encoding", "avro"); err != nil {
+		t.Fatalf("failed to set flag: %v", err)
+	}
+	if err := applyConfigOverrides(&config.Config{}, fs); err == nil {
+		t.Error("expected error for --encoding=avro")
+	}
+	// An unknown encoding of the config file is rejected as well
+	if err := applyConfigOverrides(&config.Config{Encoding: "xml"}, newTestFlagSet()); err == nil {
+		t.Error("expected error for encoding xml")
+	}
+}
+
+func TestApplyConfigOverrides_Avro(t *testing.T) {
+	fs := newTestFlagSet()
+	for name, value := range map[string]string{
+		"kafka-server":                "localhost:9092",
+		"encoding":                    "avro",
+		"kafka-schema-registry":       "http://localhost:8081",
+		"kafka-subject-name-strategy": "record_name",
+		"kafka-auto-register-schemas": "false",
+	} {
+		if err := fs.Set(name, value); err != nil {
+			t.Fatalf("failed to set flag %s: %v", name, err)
+		}
+	}
+	cfg := &config.Config{}
+	if err := applyConfigOverrides(cfg, fs); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.Encoding != pub.EncodingAvro {
+		t.Errorf("Encoding = %q, want avro", cfg.Encoding)
+	}
+	if c := cfg.KafkaConfig; c.SchemaRegistry != "http://localhost:8081" || c.SubjectNameStrategy != avro.RecordNameStrategy ||
+		c.AutoRegisterSchemas == nil || *c.AutoRegisterSchemas {
+		t.Errorf("KafkaConfig = %+v", c)
+	}
+
+	// The subject name strategy defaults to topic_name
+	cfg = &config.Config{Encoding: pub.EncodingAvro, KafkaConfig: &config.KafkaConfig{KafkaSrv: "localhost:9092", SchemaRegistry: "http://localhost:8081"}}
+	if err := applyConfigOverrides(cfg, newTestFlagSet()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.KafkaConfig.SubjectNameStrategy != avro.TopicNameStrategy {
+		t.Errorf("SubjectNameStrategy = %q, want topic_name", cfg.KafkaConfig.SubjectNameStrategy)
+	}
+
+	tests := []struct {
+		name  string
+		flags map[string]string
+	}{
+		{name: "no schema registry", flags: map[string]string{"kafka-server": "localhost:9092", "encoding": "avro"}},
+		{name: "nats publisher", flags: map[string]string{"nats-server": "nats://localhost:4222", "encoding": "avro"}},
+		{name: "dump publisher", flags: map[string]string{"dump": "console", "encoding": "avro"}},
+		{name: "invalid strategy", flags: map[string]string{"kafka-server": "localhost:9092", "kafka-subject-name-strategy": "topic"}},
+		{name: "invalid auto register", flags: map[string]string{"kafka-server": "localhost:9092", "kafka-auto-register-schemas": "maybe"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := newTestFlagSet()
+			for name, value := range tt.flags {
+				if err := fs.Set(name, value); err != nil {
+					t.Fatalf("failed to set flag %s: %v", name, err)
+				}
+			}
+			if err := applyConfigOverrides(&config.Config{}, fs); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
+
+func TestApplyConfigOverrides_OpenBMPParsed(t *testing.T) {
+	fs := newTestFlagSet()
+	for name, value := range map[string]string{"kafka-server": "localhost:9092", "openbmp-parsed": "true", "admin-id": "collector1"} {
+		if err := fs.Set(name, value); err != nil {
+			t.Fatalf("failed to set flag %s: %v", name, err)
+		}
+	}
+	cfg := &config.Config{}
+	if err := applyConfigOverrides(cfg, fs); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !cfg.KafkaConfig.OpenBMPParsed || cfg.KafkaConfig.AdminID != "collector1" {
+		t.Errorf("KafkaConfig = %+v", cfg.KafkaConfig)
+	}
+
+	// Without the Kafka publisher the flag is stored and a warning is logged
+	fs = newTestFlagSet()
+	for name, value := range map[string]string{"dump": "console", "openbmp-parsed": "true"} {
+		if err := fs.Set(name, value); err != nil {
+			t.Fatalf("failed to set flag %s: %v", name, err)
+		}
+	}
+	cfg = &config.Config{}
+	if err := applyConfigOverrides(cfg, fs); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.KafkaConfig == nil || !cfg.KafkaConfig.OpenBMPParsed {
+		t.Error("OpenBMPParsed should be stored in KafkaConfig regardless of publisher selection")
+	}
+
+	fs = newTestFlagSet()
+	if err := fs.Set("openbmp-parsed", "notabool"); err != nil {
+		t.Fatalf("failed to set flag: %v", err)
+	}
+	if err := applyConfigOverrides(&config.Config{}, fs); err == nil {
+		t.Error("expected error for non-boolean --openbmp-parsed value, got nil")
+	}
+}
+
+func TestApplyConfigOverrides_RulesFile(t *testing.T) {
+	fs := newTestFlagSet()
+	if err := fs.Set("rules-file", "/etc/gobmp/rules.yaml"); err != nil {
+		t.Fatalf("failed to set flag: %v", err)
+	}
+
+	cfg := &config.Config{RulesFile: "/tmp/rules.yaml"}
+	if err := applyConfigOverrides(cfg, fs); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.RulesFile != "/etc/gobmp/rules.yaml" {
+		t.Errorf("RulesFile = %q, want %q", cfg.RulesFile, "/etc/gobmp/rules.yaml")
+	}
+}
+
+func TestApplyConfigOverrides_OutputProfile(t *testing.T) {
+	fs := newTestFlagSet()
+	for name, value := range map[string]string{"output-profile": "compact", "base-attributes": "true"} {
+		if err := fs.Set(name, value); err != nil {
+			t.Fatalf("failed to set flag %s: %v", name, err)
+		}
+	}
+
+	cfg := &config.Config{OutputProfile: &config.OutputProfileConfig{Name: "custom"}}
+	if err := applyConfigOverrides(cfg, fs); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.OutputProfile.Name != "compact" {
+		t.Errorf("OutputProfile.Name = %q, want %q", cfg.OutputProfile.Name, "compact")
+	}
+
+	// The OpenBMP records need the full messages
+	fs = newTestFlagSet()
+	for name, value := range map[string]string{"kafka-server": "kafka:9092", "openbmp-parsed": "true", "output-profile": "compact"} {
+		if err := fs.Set(name, value); err != nil {
+			t.Fatalf("failed to set flag %s: %v", name, err)
+		}
+	}
+	if err := applyConfigOverrides(&config.Config{}, fs); err == nil {
+		t.Error("expected error for --output-profile=compact with --openbmp-parsed")
+	}
+
+	// The compact messages reference the base_attribute messages
+	fs = newTestFlagSet()
+	if err := fs.Set("output-profile", "compact"); err != nil {
+		t.Fatalf("failed to set flag: %v", err)
+	}
+	if err := applyConfigOverrides(&config.Config{}, fs); err == nil {
+		t.Error("expected error for --output-profile=compact without --base-attributes")
+	}
+}
+
+func TestApplyConfigOverrides_BaseAttributes(t *testing.T) {
+	fs := newTestFlagSet()
+	for name, value := range map[string]string{
+		"base-attributes":            "true",
+		"base-attributes-normalized": "true",
+		"base-attributes-cache-size": "250000",
+	} {
+		if err := fs.Set(name, value); err != nil {
+			t.Fatalf("failed to set flag %s: %v", name, err)
+		}
+	}
+
+	cfg := &config.Config{}
+	if err := applyConfigOverrides(cfg, fs); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := config.BaseAttributeConfig{Enabled: true, Normalized: true, CacheSize: 250000}
+	if c := cfg.BaseAttributeConfig; c == nil || *c != want {
+		t.Errorf("BaseAttributeConfig = %+v, want %+v", c, want)
+	}
+
+	for _, flags := range []map[string]string{
+		{"base-attributes-cache-size": "0"},
+		{"base-attributes": "yes"},
+		// The normalized messages reference the base_attribute messages
+		{"base-attributes-normalized": "true"},
+		{"kafka-server": "kafka:9092", "openbmp-parsed": "true", "base-attributes": "true", "base-attributes-normalized": "true"},
+	} {
+		fs := newTestFlagSet()
+		for name, value := range flags {
+			if err := fs.Set(name, value); err != nil {
+				t.Fatalf("failed to set flag %s: %v", name, err)
+			}
+		}
+		if err := applyConfigOverrides(&config.Config{}, fs); err == nil {
+			t.Errorf("expected error for %v", flags)
+		}
+	}
+}
+
+func TestApplyConfigOverrides_Inventory(t *testing.T) {
+	fs := newTestFlagSet()
+	for name, value := range map[string]string{
+		"inventory-file":            "/etc/gobmp/inventory.yaml",
+		"inventory-reload-interval": "-1s",
+	} {
+		if err := fs.Set(name, value); err != nil {
+			t.Fatalf("failed to set flag %s: %v", name, err)
+		}
+	}
+
+	cfg := &config.Config{InventoryConfig: &config.InventoryConfig{File: "inventory.csv", ReloadInterval: time.Minute}}
+	if err := applyConfigOverrides(cfg, fs); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := config.InventoryConfig{File: "/etc/gobmp/inventory.yaml", ReloadInterval: -time.Second}
+	if *cfg.InventoryConfig != want {
+		t.Errorf("InventoryConfig = %+v, want %+v", *cfg.InventoryConfig, want)
+	}
+
+	fs = newTestFlagSet()
+	if err := fs.Set("inventory-reload-interval", "often"); err != nil {
+		t.Fatalf("failed to set flag: %v", err)
+	}
+	if err := applyConfigOverrides(&config.Config{}, fs); err == nil {
+		t.Error("expected error for --inventory-reload-interval=often")
+	}
+}
+
+func TestApplyConfigOverrides_GeoIP(t *testing.T) {
+	fs := newTestFlagSet()
+	for name, value := range map[string]string{
+		"geoip-asn-db":          "/var/lib/gobmp/GeoLite2-ASN.mmdb",
+		"geoip-cache-size":      "500000",
+		"geoip-reload-interval": "-1s",
+	} {
+		if err := fs.Set(name, value); err != nil {
+			t.Fatalf("failed to set flag %s: %v", name, err)
+		}
+	}
+
+	cfg := &config.Config{GeoIPConfig: &config.GeoIPConfig{CountryDatabase: "/var/lib/gobmp/GeoLite2-Country.mmdb"}}
+	if err := applyConfigOverrides(cfg, fs); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := config.GeoIPConfig{
+		ASNDatabase:     "/var/lib/gobmp/GeoLite2-ASN.mmdb",
+		CountryDatabase: "/var/lib/gobmp/GeoLite2-Country.mmdb",
+		CacheSize:       500000,
+		ReloadInterval:  -time.Second,
+	}
+	if *cfg.GeoIPConfig != want {
+		t.Errorf("GeoIPConfig = %+v, want %+v", *cfg.GeoIPConfig, want)
+	}
+
+	for name, value := range map[string]string{
+		"geoip-cache-size":      "0",
+		"geoip-reload-interval": "often",
+	} {
+		fs = newTestFlagSet()
+		if err := fs.Set(name, value); err != nil {
+			t.Fatalf("failed to set flag %s: %v", name, err)
+		}
+		if err := applyConfigOverrides(&config.Config{}, fs); err == nil {
+			t.Errorf("expected error for --%s=%s", name, value)
+		}
+	}
+}
+
+func TestApplyConfigOverrides_MRT(t *testing.T) {
+	fs := newTestFlagSet()
+	for name, value := range map[string]string{
+		"mrt-dir":               "/var/lib/gobmp/mrt",
+		"mrt-rotation-interval": "5m",
+		"mrt-rib-interval":      "-1s",
+	} {
+		if err := fs.Set(name, value); err != nil {
+			t.Fatalf("failed to set flag %s: %v", name, err)
+		}
+	}
+
+	cfg := &config.Config{}
+	if err := applyConfigOverrides(cfg, fs); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if cfg.MRTConfig == nil {
+		t.Fatal("MRTConfig = nil, want non-nil")
+	}
+	if cfg.MRTConfig.Dir != "/var/lib/gobmp/mrt" {
+		t.Errorf("Dir = %q, want /var/lib/gobmp/mrt", cfg.MRTConfig.Dir)
+	}
+	if cfg.MRTConfig.RotationInterval != 5*time.Minute {
+		t.Errorf("RotationInterval = %v, want 5m", cfg.MRTConfig.RotationInterval)
+	}
+	if cfg.MRTConfig.RIBInterval != -time.Second {
+		t.Errorf("RIBInterval = %v, want -1s", cfg.MRTConfig.RIBInterval)
+	}
+}
+
+func TestApplyConfigOverrides_MRT_InvalidRotation(t *testing.T) {
+	for _, v := range []string{"0s", "-1m", "soon"} {
+		fs := newTestFlagSet()
+		if err := fs.Set("mrt-rotation-interval", v); err != nil {
+			t.Fatalf("failed to set flag: %v", err)
+		}
+		if err := applyConfigOverrides(&config.Config{}, fs); err == nil {
+			t.Errorf("expected error for --mrt-rotation-interval=%q, got nil", v)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/golang/glog"
+	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/config"
+	"github.com/sbezverk/gobmp/pkg/message"
+	"github.com/sbezverk/gobmp/pkg/mrt"
+)
+
+// peerQueueSize is the number of imported messages of a peer waiting for the
+// worker of the peer before the import waits for it.
+const peerQueueSize = 1024
+
+// runMRTImport publishes the routes of the configured MRT files through the
+// same producer used for live BMP sessions.
+func runMRTImport(cfg *config.Config) error {
+	prod := message.NewProducer(cfg.Publisher, cfg.SplitAF == nil || *cfg.SplitAF)
+	if err := prod.SetConfig(cfg.ProducerConfig()); err != nil {
+		return err
+	}
+	// The messages of a peer are produced in order by the worker of the peer,
+	// so that a withdraw follows the announcement it removes and the routes of
+	// a peer come between its Peer Up and its Peer Down. The peers are produced
+	// concurrently.
+	var wg sync.WaitGroup
+	peers := make(map[string]chan bmp.Message)
+	emit := func(msg bmp.Message) {
+		var key string
+		if msg.PeerHeader != nil {
+			key = msg.PeerHeader.GetPeerHash()
+		}
+		q, ok := peers[key]
+		if !ok {
+			q = make(chan bmp.Message, peerQueueSize)
+			peers[key] = q
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for msg := range q {
+					prod.Produce(msg)
+				}
+			}()
+		}
+		q <- msg
+	}
+	defer func() {
+		for _, q := range peers {
+			close(q)
+		}
+		wg.Wait()
+	}()
+	im, err := mrt.NewImporter(&mrt.ImportConfig{RouterIP: cfg.MRTImportConfig.RouterIP}, emit)
+	if err != nil {
+		return err
+	}
+	for _, fn := range cfg.MRTImportConfig.Files {
+		rd, err := mrt.Open(fn)
+		if err != nil {
+			return fmt.Errorf("failed to open mrt file %s: %w", fn, err)
+		}
+		n, err := im.Import(rd)
+		_ = rd.Close()
+		if err != nil {
+			return fmt.Errorf("failed to import mrt file %s after %d records: %w", fn, n, err)
+		}
+		glog.Infof("imported %d records from mrt file %s", n, fn)
+	}
+
+	return nil
+}
@@ -0,0 +1,151 @@
+package main
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/config"
+)
+
+type recordingPublisher struct {
+	mu    sync.Mutex
+	types []int
+	msgs  [][]byte
+}
+
+func (p *recordingPublisher) PublishMessage(msgType int, msgHash []byte, msg []byte) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.types = append(p.types, msgType)
+	p.msgs = append(p.msgs, msg)
+	return nil
+}
+
+func (p *recordingPublisher) Stop() {}
+
+// mrtRecord returns a BGP4MP_ET record with the given subtype and body.
+func mrtRecord(subtype uint16, body []byte) []byte {
+	b := make([]byte, 16, 16+len(body))
+	binary.BigEndian.PutUint32(b[0:4], 1000)
+	binary.BigEndian.PutUint16(b[4:6], 17)
+	binary.BigEndian.PutUint16(b[6:8], subtype)
+	binary.BigEndian.PutUint32(b[8:12], uint32(4+len(body)))
+	return append(b, body...)
+}
+
+func TestRunMRTImport(t *testing.T) {
+	// Peer AS 65001, Local AS 65000, IPv4 peer 192.0.2.1, local 192.0.2.2
+	peer := []byte{0, 0, 0xfd, 0xe9, 0, 0, 0xfd, 0xe8, 0, 0, 0, 1, 192, 0, 2, 1, 192, 0, 2, 2}
+	update := []byte{
+		0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+		0x00, 0x31, 0x02,
+		0x00, 0x00,
+		0x00, 0x14,
+		0x40, 0x01, 0x01, 0x00,
+		0x40, 0x02, 0x06, 0x02, 0x01, 0x00, 0x00, 0xfd, 0xe9,
+		0x40, 0x03, 0x04, 192, 0, 2, 1,
+		8, 10,
+	}
+	update[17] = byte(len(update))
+	var file []byte
+	file = append(file, mrtRecord(5, append(append([]byte{}, peer...), 0, 5, 0, 6))...)
+	file = append(file, mrtRecord(4, append(append([]byte{}, peer...), update...))...)
+	fn := filepath.Join(t.TempDir(), "updates.mrt")
+	if err := os.WriteFile(fn, file, 0o644); err != nil {
+		t.Fatalf("failed to write mrt file: %v", err)
+	}
+
+	pub := &recordingPublisher{}
+	cfg := &config.Config{
+		Publisher:       pub,
+		MRTImportConfig: &config.MRTImportConfig{Files: []string{fn}},
+	}
+	if err := runMRTImport(cfg); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(pub.types) != 2 || pub.types[0] != bmp.PeerStateChangeMsg || pub.types[1] != bmp.UnicastPrefixV4Msg {
+		t.Fatalf("expected peer and unicast_prefix_v4 messages got %v", pub.types)
+	}
+
+	// The announcements and the withdraws of a prefix are published in order
+	withdraw := []byte{
+		0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+		0x00, 0x19, 0x02,
+		0x00, 0x02, 8, 10,
+		0x00, 0x00,
+	}
+	file = file[:0]
+	file = append(file, mrtRecord(5, append(append([]byte{}, peer...), 0, 5, 0, 6))...)
+	for i := 0; i < 200; i++ {
+		file = append(file, mrtRecord(4, append(append([]byte{}, peer...), update...))...)
+		file = append(file, mrtRecord(4, append(append([]byte{}, peer...), withdraw...))...)
+	}
+	if err := os.WriteFile(fn, file, 0o644); err != nil {
+		t.Fatalf("failed to write mrt file: %v", err)
+	}
+	pub = &recordingPublisher{}
+	cfg.Publisher = pub
+	if err := runMRTImport(cfg); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := "add"
+	n := 0
+	for i, msgType := range pub.types {
+		if msgType != bmp.UnicastPrefixV4Msg {
+			continue
+		}
+		var m struct {
+			Action string `json:"action"`
+			IsEOR  bool   `json:"is_eor"`
+		}
+		if err := json.Unmarshal(pub.msgs[i], &m); err != nil {
+			t.Fatal(err)
+		}
+		// The withdraws without NLRI also produce an End-of-RIB message
+		if m.IsEOR {
+			continue
+		}
+		if m.Action != want {
+			t.Fatalf("message %d action = %q, want %q", n, m.Action, want)
+		}
+		if want == "add" {
+			want = "del"
+		} else {
+			want = "add"
+		}
+		n++
+	}
+	if n != 400 {
+		t.Errorf("published %d unicast_prefix_v4 messages, want 400", n)
+	}
+
+	cfg.MRTImportConfig.Files = []string{filepath.Join(t.TempDir(), "missing.mrt")}
+	if err := runMRTImport(cfg); err == nil {
+		t.Fatal("expected error for missing file, got nil")
+	}
+}
+
+func TestApplyConfigOverrides_MRTImport(t *testing.T) {
+	fs := newTestFlagSet()
+	if err := fs.Set("mrt-import", "a.mrt.gz, b.bz2,,"); err != nil {
+		t.Fatalf("failed to set flag: %v", err)
+	}
+	if err := fs.Set("mrt-import-router", "10.0.0.1"); err != nil {
+		t.Fatalf("failed to set flag: %v", err)
+	}
+	cfg := &config.Config{}
+	if err := applyConfigOverrides(cfg, fs); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.MRTImportConfig == nil || len(cfg.MRTImportConfig.Files) != 2 || cfg.MRTImportConfig.Files[1] != "b.bz2" {
+		t.Fatalf("unexpected MRTImportConfig %+v", cfg.MRTImportConfig)
+	}
+	if cfg.MRTImportConfig.RouterIP != "10.0.0.1" {
+		t.Errorf("RouterIP = %q, want 10.0.0.1", cfg.MRTImportConfig.RouterIP)
+	}
+}
@@ -0,0 +1,302 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/sbezverk/gobmp/pkg/bgp"
+	"github.com/sbezverk/gobmp/pkg/message"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// FromMessage returns the protobuf message of the parsed message struct msg of
+// a BMP message type, passed as a pointer or a pointer to a pointer. The
+// prefix messages, the bulk of the published messages, are built from their
+// struct, the other messages are converted from their JSON encoding.
+func FromMessage(msgType int, msg interface{}) (proto.Message, error) {
+	switch m := msg.(type) {
+	case **message.UnicastPrefix:
+		if m != nil {
+			return FromMessage(msgType, *m)
+		}
+	case *message.UnicastPrefix:
+		if m != nil {
+			return unicastPrefix(m)
+		}
+	case **message.L3VPNPrefix:
+		if m != nil {
+			return FromMessage(msgType, *m)
+		}
+	case *message.L3VPNPrefix:
+		if m != nil {
+			return l3vpnPrefix(m)
+		}
+	}
+	b, err := json.Marshal(msg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal message of type %d with error: %w", msgType, err)
+	}
+
+	return FromJSON(msgType, b)
+}
+
+// Marshal returns the protobuf encoding of the parsed message struct msg of a
+// BMP message type.
+func Marshal(msgType int, msg interface{}) ([]byte, error) {
+	m, err := FromMessage(msgType, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return proto.Marshal(m)
+}
+
+func unicastPrefix(u *message.UnicastPrefix) (*UnicastPrefix, error) {
+	m := &UnicastPrefix{
+		Action:                u.Action,
+		Sequence:              int64(u.Sequence),
+		Hash:                  u.Hash,
+		RouterHash:            u.RouterHash,
+		RouterIp:              u.RouterIP,
+		PeerHash:              u.PeerHash,
+		PeerIp:                u.PeerIP,
+		PeerType:              uint32(u.PeerType),
+		PeerAsn:               u.PeerASN,
+		Timestamp:             u.Timestamp,
+		Prefix:                u.Prefix,
+		PrefixLen:             u.PrefixLen,
+		IsIpv4:                u.IsIPv4,
+		OriginAs:              u.OriginAS,
+		Nexthop:               u.Nexthop,
+		IsNexthopIpv4:         u.IsNexthopIPv4,
+		PathId:                u.PathID,
+		Labels:                u.Labels,
+		Color:                 u.Color,
+		OriginValidation:      u.OriginValidation,
+		IsEor:                 u.IsEOR,
+		LeakSuspected:         u.LeakSuspected,
+		LeakReason:            u.LeakReason,
+		IsAdjRibInPostPolicy:  u.IsAdjRIBInPost,
+		IsAdjRibOutPostPolicy: u.IsAdjRIBOutPost,
+		IsAdjRibOut:           u.IsAdjRIBOut,
+		IsLocRib:              u.IsLocRIB,
+		IsLocRibFiltered:      u.IsLocRIBFiltered,
+		TableName:             u.TableName,
+		UserLabels:            u.UserLabels,
+		BaseAttrHash:          u.BaseAttrHash,
+		OriginAsName:          u.OriginASName,
+		OriginAsOrg:           u.OriginASOrg,
+		PeerAsName:            u.PeerASName,
+		Country:               u.Country,
+	}
+	var err error
+	if m.BaseAttrs, err = baseAttributes(u.BaseAttributes); err != nil {
+		return nil, err
+	}
+	if u.PrefixSID != nil {
+		m.PrefixSid = &PSid{}
+		if err := fromJSONValue(u.PrefixSID, m.PrefixSid); err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+func l3vpnPrefix(u *message.L3VPNPrefix) (*L3VPNPrefix, error) {
+	m := &L3VPNPrefix{
+		Action:                u.Action,
+		Sequence:              int64(u.Sequence),
+		Hash:                  u.Hash,
+		RouterHash:            u.RouterHash,
+		RouterIp:              u.RouterIP,
+		PeerHash:              u.PeerHash,
+		PeerIp:                u.PeerIP,
+		PeerType:              uint32(u.PeerType),
+		PeerAsn:               u.PeerASN,
+		Timestamp:             u.Timestamp,
+		Prefix:                u.Prefix,
+		PrefixLen:             u.PrefixLen,
+		IsIpv4:                u.IsIPv4,
+		OriginAs:              u.OriginAS,
+		Nexthop:               u.Nexthop,
+		ClusterList:           u.ClusterList,
+		IsNexthopIpv4:         u.IsNexthopIPv4,
+		PathId:                u.PathID,
+		Labels:                u.Labels,
+		OriginValidation:      u.OriginValidation,
+		VpnRd:                 u.VPNRD,
+		VpnRdType:             uint32(u.VPNRDType),
+		RouteTargets:          u.RouteTargets,
+		Vrf:                   u.VRF,
+		IsEor:                 u.IsEOR,
+		IsAdjRibInPostPolicy:  u.IsAdjRIBInPost,
+		IsAdjRibOutPostPolicy: u.IsAdjRIBOutPost,
+		IsAdjRibOut:           u.IsAdjRIBOut,
+		IsLocRib:              u.IsLocRIB,
+		IsLocRibFiltered:      u.IsLocRIBFiltered,
+		TableName:             u.TableName,
+		UserLabels:            u.UserLabels,
+		BaseAttrHash:          u.BaseAttrHash,
+	}
+	var err error
+	if m.BaseAttrs, err = baseAttributes(u.BaseAttributes); err != nil {
+		return nil, err
+	}
+	if u.PrefixSID != nil {
+		m.PrefixSid = &PSid{}
+		if err := fromJSONValue(u.PrefixSID, m.PrefixSid); err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// baseAttributes returns the protobuf message of the base attributes ba, the
+// attributes of the common path attributes are built from their struct, the
+// seldom present ones are converted from their JSON encoding.
+func baseAttributes(ba *bgp.BaseAttributes) (*BaseAttributes, error) {
+	if ba == nil {
+		return nil, nil
+	}
+	m := &BaseAttributes{
+		BaseAttrHash:           ba.BaseAttrHash,
+		Origin:                 ba.Origin,
+		AsPath:                 ba.ASPath,
+		AsPathCount:            ba.ASPathCount,
+		Nexthop:                ba.Nexthop,
+		Med:                    ba.MED,
+		LocalPref:              ba.LocalPref,
+		IsAtomicAgg:            ba.IsAtomicAgg,
+		Aggregator:             ba.Aggregator,
+		CommunityList:          ba.CommunityList,
+		WellKnownCommunityList: ba.WellKnownCommunityList,
+		OriginatorId:           ba.OriginatorID,
+		ClusterList:            ba.ClusterList,
+		ExtCommunityList:       ba.ExtCommunityList,
+		As4Path:                ba.AS4Path,
+		As4PathCount:           ba.AS4PathCount,
+		As4Aggregator:          ba.AS4Aggregator,
+		TunnelEncapAttr:        ba.TunnelEncapAttr,
+		TunnelEncapMalformed:   ba.TunnelEncapMalformed,
+		Ipv6ExtCommunityList:   ba.IPv6ExtCommunityList,
+		LargeCommunityList:     ba.LgCommunityList,
+		Otc:                    ba.OTC,
+	}
+	if len(ba.ASPathSegments) != 0 {
+		m.AsPathSegments = make([]*ASPathSegment, len(ba.ASPathSegments))
+		for i, s := range ba.ASPathSegments {
+			m.AsPathSegments[i] = &ASPathSegment{Type: uint32(s.Type), Asns: s.ASNs}
+		}
+	}
+	var err error
+	if m.ExtCommunities, err = extCommunities(ba.ExtCommunities); err != nil {
+		return nil, err
+	}
+	if m.Ipv6ExtCommunities, err = extCommunities(ba.IPv6ExtCommunities); err != nil {
+		return nil, err
+	}
+	if ba.PMSITunnel != nil {
+		m.PmsiTunnel = &PMSITunnel{}
+		if err := fromJSONValue(ba.PMSITunnel, m.PmsiTunnel); err != nil {
+			return nil, err
+		}
+	}
+	if ba.TunnelEncap != nil {
+		m.TunnelEncap = &TunnelEncapsulation{}
+		if err := fromJSONValue(ba.TunnelEncap, m.TunnelEncap); err != nil {
+			return nil, err
+		}
+	}
+	if ba.AIGP != nil {
+		m.Aigp = &AIGP{}
+		if err := fromJSONValue(ba.AIGP, m.Aigp); err != nil {
+			return nil, err
+		}
+	}
+	if ba.BGPPrefixSID != nil {
+		m.BgpPrefixSid = &BGPPrefixSID{}
+		if err := fromJSONValue(ba.BGPPrefixSID, m.BgpPrefixSid); err != nil {
+			return nil, err
+		}
+	}
+	if ba.DPath != nil {
+		m.DPath = &DPath{}
+		if err := fromJSONValue(ba.DPath, m.DPath); err != nil {
+			return nil, err
+		}
+	}
+	if ba.SFP != nil {
+		m.Sfp = &SFP{}
+		if err := fromJSONValue(ba.SFP, m.Sfp); err != nil {
+			return nil, err
+		}
+	}
+	if ba.BFDDiscriminator != nil {
+		m.BfdDiscriminator = &BFDDiscriminator{}
+		if err := fromJSONValue(ba.BFDDiscriminator, m.BfdDiscriminator); err != nil {
+			return nil, err
+		}
+	}
+	if ba.AttrSet != nil {
+		m.AttrSet = &AttrSet{}
+		if err := fromJSONValue(ba.AttrSet, m.AttrSet); err != nil {
+			return nil, err
+		}
+	}
+	for _, a := range ba.UnknownAttributes {
+		u := &UnknownPathAttribute{}
+		if err := fromJSONValue(a, u); err != nil {
+			return nil, err
+		}
+		m.UnknownAttributes = append(m.UnknownAttributes, u)
+	}
+	for _, a := range ba.AttrErrors {
+		e := &PathAttributeError{}
+		if err := fromJSONValue(a, e); err != nil {
+			return nil, err
+		}
+		m.AttrErrors = append(m.AttrErrors, e)
+	}
+
+	return m, nil
+}
+
+func extCommunities(ecs []bgp.ExtCommunityDetail) ([]*ExtCommunityDetail, error) {
+	if len(ecs) == 0 {
+		return nil, nil
+	}
+	m := make([]*ExtCommunityDetail, len(ecs))
+	for i, ec := range ecs {
+		m[i] = &ExtCommunityDetail{Type: uint32(ec.Type), Name: ec.Name, Value: ec.Value, Raw: ec.Raw}
+		if ec.SubType != nil {
+			st := uint32(*ec.SubType)
+			m[i].Subtype = &st
+		}
+		if ec.Decoded != nil {
+			m[i].Decoded = &structpb.Value{}
+			if err := fromJSONValue(ec.Decoded, m[i].Decoded); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return m, nil
+}
+
+// fromJSONValue sets the protobuf message m to the value v converted from its
+// JSON encoding.
+func fromJSONValue(v interface{}, m proto.Message) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	if err := protojson.Unmarshal(b, m); err != nil {
+		return fmt.Errorf("failed to convert %T to protobuf with error: %w", v, err)
+	}
+
+	return nil
+}
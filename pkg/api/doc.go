@@ -0,0 +1,6 @@
+// Package api defines the goBMP gRPC subscription API and the protobuf
+// encoding of the published messages, the Go code is generated from
+// gobmp.proto and messages.proto.
+package api
+
+//go:generate protoc -I ../.. --go_out=../.. --go_opt=paths=source_relative --go-grpc_out=../.. --go-grpc_opt=paths=source_relative pkg/api/messages.proto pkg/api/gobmp.proto
@@ -0,0 +1,106 @@
+package api
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/pub"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// NewMessage returns the empty protobuf message of a parsed BMP message type,
+// false when the type is not a parsed message type.
+func NewMessage(msgType int) (proto.Message, bool) {
+	switch msgType {
+	case bmp.PeerStateChangeMsg:
+		return &PeerStateChange{}, true
+	case bmp.UnicastPrefixMsg, bmp.UnicastPrefixV4Msg, bmp.UnicastPrefixV6Msg:
+		return &UnicastPrefix{}, true
+	case bmp.LSNodeMsg:
+		return &LSNode{}, true
+	case bmp.LSLinkMsg:
+		return &LSLink{}, true
+	case bmp.L3VPNMsg, bmp.L3VPNV4Msg, bmp.L3VPNV6Msg:
+		return &L3VPNPrefix{}, true
+	case bmp.LSPrefixMsg:
+		return &LSPrefix{}, true
+	case bmp.LSSRv6SIDMsg:
+		return &LSSRv6SID{}, true
+	case bmp.EVPNMsg:
+		return &EVPNPrefix{}, true
+	case bmp.SRPolicyMsg, bmp.SRPolicyV4Msg, bmp.SRPolicyV6Msg:
+		return &SRPolicy{}, true
+	case bmp.FlowspecMsg, bmp.FlowspecV4Msg, bmp.FlowspecV6Msg:
+		return &Flowspec{}, true
+	case bmp.VPLSMsg:
+		return &VPLSPrefix{}, true
+	case bmp.MulticastV4Msg, bmp.MulticastV6Msg:
+		return &MulticastPrefix{}, true
+	case bmp.RTCV4Msg, bmp.RTCV6Msg:
+		return &RTCPrefix{}, true
+	case bmp.MCASTVPNV4Msg, bmp.MCASTVPNV6Msg, bmp.MVPNV4Msg, bmp.MVPNV6Msg:
+		return &MCASTVPNPrefix{}, true
+	case bmp.StatsReportMsg:
+		return &Stats{}, true
+	case bmp.LSTopologyChangeMsg:
+		return &TopologyEvent{}, true
+	case bmp.SRPolicyResolvedMsg:
+		return &ResolvedSRPolicy{}, true
+	case bmp.ChurnStatsMsg:
+		return &ChurnStats{}, true
+	case bmp.FlapEventMsg:
+		return &FlapEvent{}, true
+	case bmp.HijackEventMsg:
+		return &HijackEvent{}, true
+	case bmp.RouteLeakMsg:
+		return &RouteLeak{}, true
+	case bmp.PeerSyncMsg:
+		return &PeerSync{}, true
+	case bmp.BaseAttributeMsg:
+		return &BaseAttribute{}, true
+	}
+	return nil, false
+}
+
+// databaseFields are the ArangoDB document fields of the JSON messages, which
+// goBMP does not set and the protobuf messages do not carry.
+var databaseFields = [][]byte{[]byte(`"_key"`), []byte(`"_id"`), []byte(`"_rev"`)}
+
+// FromJSON returns the protobuf message of the JSON message msg of a parsed
+// BMP message type. A JSON key without a protobuf field is an error rather
+// than a field silently missing from the message, the keys of a message
+// carrying database fields are decoded in the same single pass and discarded.
+func FromJSON(msgType int, msg []byte) (proto.Message, error) {
+	m, ok := NewMessage(msgType)
+	if !ok {
+		return nil, fmt.Errorf("message type %d has no protobuf definition", msgType)
+	}
+	var opts protojson.UnmarshalOptions
+	for _, f := range databaseFields {
+		if bytes.Contains(msg, f) {
+			opts.DiscardUnknown = true
+			break
+		}
+	}
+	if err := opts.Unmarshal(msg, m); err != nil {
+		return nil, fmt.Errorf("failed to convert message of type %d to protobuf with error: %w", msgType, err)
+	}
+
+	return m, nil
+}
+
+// Encode returns the JSON message msg of a BMP message type in the encoding
+// enc, BMP raw and OpenBMP parsed messages are returned as they are.
+func Encode(enc pub.Encoding, msgType int, msg []byte) ([]byte, error) {
+	if enc != pub.EncodingProtobuf || msgType == bmp.BMPRawMsg || bmp.IsOpenBMPMsg(msgType) {
+		return msg, nil
+	}
+	m, err := FromJSON(msgType, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return proto.Marshal(m)
+}
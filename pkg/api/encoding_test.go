@@ -0,0 +1,468 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/sbezverk/gobmp/pkg/bgp"
+	"github.com/sbezverk/gobmp/pkg/bgpls"
+	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/churn"
+	"github.com/sbezverk/gobmp/pkg/flowspec"
+	"github.com/sbezverk/gobmp/pkg/hijack"
+	"github.com/sbezverk/gobmp/pkg/message"
+	"github.com/sbezverk/gobmp/pkg/pub"
+	"github.com/sbezverk/gobmp/pkg/sr"
+	"github.com/sbezverk/gobmp/pkg/srpolicy"
+	"github.com/sbezverk/gobmp/pkg/srv6"
+	"github.com/sbezverk/gobmp/pkg/topology"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+func typeOf[T any]() reflect.Type {
+	return reflect.TypeOf((*T)(nil)).Elem()
+}
+
+// impls are the implementations of the interface types of the published
+// messages, the faker fills a new value of one of them.
+var impls = map[reflect.Type][]reflect.Type{
+	typeOf[sr.CapabilityFlags]():    {typeOf[*sr.ISISCapFlags](), typeOf[*sr.UnknownProtoFlags]()},
+	typeOf[sr.PrefixSIDFlags]():     {typeOf[*sr.ISISFlags](), typeOf[*sr.OSPFFlags](), typeOf[*sr.UnknownProtoFlags]()},
+	typeOf[sr.AdjacencySIDFlags]():  {typeOf[*sr.AdjISISFlags](), typeOf[*sr.AdjOSPFFlags](), typeOf[*sr.UnknownProtoFlags]()},
+	typeOf[bgpls.PrefixAttrFlags](): {typeOf[*bgpls.ISISFlags](), typeOf[*bgpls.OSPFFlags](), typeOf[*bgpls.OSPFv3Flags](), typeOf[*bgpls.UnknownProtoFlags]()},
+	typeOf[bgpls.RangeTLVFlags]():   {typeOf[*bgpls.ISISRangeFlags](), typeOf[*bgpls.OSPFRangeFlags](), typeOf[*bgpls.UnknownProtoRangeFlags]()},
+	typeOf[flowspec.Spec]():         {typeOf[*flowspec.PrefixSpec](), typeOf[*flowspec.GenericSpec]()},
+	typeOf[srv6.SubTLV]():           {typeOf[*srv6.UnknownSrv6SubTLV]()},
+	typeOf[srv6.SvcSubTLV]():        {typeOf[*srv6.InformationSubTLV]()},
+	typeOf[srv6.SvcSubSubTLV]():     {typeOf[*srv6.SIDStructureSubSubTLV]()},
+	typeOf[interface{}]():           {typeOf[map[string]string]()},
+}
+
+// segment returns a segment of a type decoded from bytes set to 0x11.
+func segment(f func([]byte) (srpolicy.Segment, error), l int) func() (interface{}, error) {
+	return func() (interface{}, error) {
+		return f(bytes.Repeat([]byte{0x11}, l))
+	}
+}
+
+func bindingSID(l int) func() (interface{}, error) {
+	return func() (interface{}, error) {
+		b, err := srpolicy.UnmarshalBSIDSTLV(bytes.Repeat([]byte{0x11}, l))
+		if err != nil {
+			return nil, err
+		}
+		return &srpolicy.BindingSID{Type: b.GetType(), BSID: b}, nil
+	}
+}
+
+// values are the values of the types the faker does not fill, the types
+// without values are filled.
+var values = map[reflect.Type][]func() (interface{}, error){
+	typeOf[srpolicy.Segment](): {
+		segment(srpolicy.UnmarshalTypeASegment, 6),
+		segment(srpolicy.UnmarshalTypeBSegment, 18),
+		segment(srpolicy.UnmarshalTypeCSegment, 10),
+		segment(srpolicy.UnmarshalTypeDSegment, 22),
+		segment(srpolicy.UnmarshalTypeESegment, 14),
+		segment(srpolicy.UnmarshalTypeFSegment, 14),
+		segment(srpolicy.UnmarshalTypeGSegment, 46),
+		segment(srpolicy.UnmarshalTypeHSegment, 38),
+		segment(srpolicy.UnmarshalTypeISegment, 38),
+		segment(srpolicy.UnmarshalTypeJSegment, 58),
+		segment(srpolicy.UnmarshalTypeKSegment, 58),
+	},
+	typeOf[*srpolicy.BindingSID](): {bindingSID(2), bindingSID(6), bindingSID(18)},
+	typeOf[json.RawMessage](): {
+		func() (interface{}, error) { return json.RawMessage(`{"value":1}`), nil },
+	},
+}
+
+// faker fills a value with non zero values, variant selects the interface
+// implementations and the values of the types listed in values.
+type faker struct {
+	variant int
+}
+
+func (f *faker) fill(v reflect.Value, depth int) error {
+	if depth > 10 {
+		return nil
+	}
+	if vs, ok := values[v.Type()]; ok {
+		x, err := vs[f.variant%len(vs)]()
+		if err != nil {
+			return err
+		}
+		v.Set(reflect.ValueOf(x))
+		return nil
+	}
+	switch v.Kind() {
+	case reflect.Interface:
+		ts := impls[v.Type()]
+		if len(ts) == 0 {
+			return fmt.Errorf("no implementation of %s", v.Type())
+		}
+		t := ts[f.variant%len(ts)]
+		x := reflect.New(t).Elem()
+		if err := f.fill(x, depth+1); err != nil {
+			return err
+		}
+		v.Set(x)
+	case reflect.Ptr:
+		v.Set(reflect.New(v.Type().Elem()))
+		return f.fill(v.Elem(), depth+1)
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			// The _key, _id and _rev database fields are not set by goBMP
+			if !v.Field(i).CanSet() || strings.HasPrefix(v.Type().Field(i).Tag.Get("json"), "_") {
+				continue
+			}
+			if err := f.fill(v.Field(i), depth+1); err != nil {
+				return fmt.Errorf("%s.%s: %w", v.Type(), v.Type().Field(i).Name, err)
+			}
+		}
+	case reflect.Slice:
+		n := 1
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			n = 4
+		}
+		v.Set(reflect.MakeSlice(v.Type(), n, n))
+		for i := 0; i < n; i++ {
+			if err := f.fill(v.Index(i), depth+1); err != nil {
+				return err
+			}
+		}
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if err := f.fill(v.Index(i), depth+1); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		k := reflect.New(v.Type().Key()).Elem()
+		e := reflect.New(v.Type().Elem()).Elem()
+		if err := f.fill(k, depth+1); err != nil {
+			return err
+		}
+		if err := f.fill(e, depth+1); err != nil {
+			return err
+		}
+		v.Set(reflect.MakeMap(v.Type()))
+		v.SetMapIndex(k, e)
+	case reflect.Bool:
+		v.SetBool(true)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(int64(1 + f.variant))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v.SetUint(uint64(1 + f.variant))
+	case reflect.Float32, reflect.Float64:
+		v.SetFloat(1.5)
+	case reflect.String:
+		v.SetString("192.0.2.1")
+	}
+
+	return nil
+}
+
+// roundTrip encodes the protobuf messages with the JSON keys of the parsed
+// messages.
+var roundTrip = protojson.MarshalOptions{UseProtoNames: true}
+
+// jsonLeaves returns the non zero values of the JSON document b by their path,
+// the numbers in their decimal form as protojson encodes the 64 bit integers
+// as strings.
+func jsonLeaves(b []byte) (map[string]string, error) {
+	var v interface{}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return nil, err
+	}
+	leaves := make(map[string]string)
+	var walk func(string, interface{})
+	walk = func(path string, v interface{}) {
+		switch x := v.(type) {
+		case map[string]interface{}:
+			for k, e := range x {
+				walk(path+"/"+k, e)
+			}
+		case []interface{}:
+			for i, e := range x {
+				walk(path+"/"+strconv.Itoa(i), e)
+			}
+		case float64:
+			if x != 0 {
+				leaves[path] = strconv.FormatFloat(x, 'f', -1, 64)
+			}
+		case string:
+			if x != "" {
+				leaves[path] = x
+			}
+		case bool:
+			if x {
+				leaves[path] = "true"
+			}
+		}
+	}
+	walk("", v)
+
+	return leaves, nil
+}
+
+func TestSchema(t *testing.T) {
+	for it := range impls {
+		for _, c := range impls[it] {
+			if !c.Implements(it) {
+				t.Fatalf("%s does not implement %s", c, it)
+			}
+		}
+	}
+	tests := []struct {
+		msgType int
+		msg     interface{}
+	}{
+		{msgType: bmp.PeerStateChangeMsg, msg: &message.PeerStateChange{}},
+		{msgType: bmp.UnicastPrefixMsg, msg: &message.UnicastPrefix{}},
+		{msgType: bmp.UnicastPrefixV4Msg, msg: &message.UnicastPrefix{}},
+		{msgType: bmp.UnicastPrefixV6Msg, msg: &message.UnicastPrefix{}},
+		{msgType: bmp.LSNodeMsg, msg: &message.LSNode{}},
+		{msgType: bmp.LSLinkMsg, msg: &message.LSLink{}},
+		{msgType: bmp.L3VPNMsg, msg: &message.L3VPNPrefix{}},
+		{msgType: bmp.L3VPNV4Msg, msg: &message.L3VPNPrefix{}},
+		{msgType: bmp.L3VPNV6Msg, msg: &message.L3VPNPrefix{}},
+		{msgType: bmp.LSPrefixMsg, msg: &message.LSPrefix{}},
+		{msgType: bmp.LSSRv6SIDMsg, msg: &message.LSSRv6SID{}},
+		{msgType: bmp.EVPNMsg, msg: &message.EVPNPrefix{}},
+		{msgType: bmp.SRPolicyMsg, msg: &message.SRPolicy{}},
+		{msgType: bmp.SRPolicyV4Msg, msg: &message.SRPolicy{}},
+		{msgType: bmp.SRPolicyV6Msg, msg: &message.SRPolicy{}},
+		{msgType: bmp.FlowspecMsg, msg: &message.Flowspec{}},
+		{msgType: bmp.FlowspecV4Msg, msg: &message.Flowspec{}},
+		{msgType: bmp.FlowspecV6Msg, msg: &message.Flowspec{}},
+		{msgType: bmp.VPLSMsg, msg: &message.VPLSPrefix{}},
+		{msgType: bmp.MulticastV4Msg, msg: &message.MulticastPrefix{}},
+		{msgType: bmp.MulticastV6Msg, msg: &message.MulticastPrefix{}},
+		{msgType: bmp.RTCV4Msg, msg: &message.RTCPrefix{}},
+		{msgType: bmp.RTCV6Msg, msg: &message.RTCPrefix{}},
+		{msgType: bmp.MCASTVPNV4Msg, msg: &message.MCASTVPNPrefix{}},
+		{msgType: bmp.MCASTVPNV6Msg, msg: &message.MCASTVPNPrefix{}},
+		{msgType: bmp.MVPNV4Msg, msg: &message.MVPNPrefix{}},
+		{msgType: bmp.MVPNV6Msg, msg: &message.MVPNPrefix{}},
+		{msgType: bmp.StatsReportMsg, msg: &message.Stats{}},
+		{msgType: bmp.LSTopologyChangeMsg, msg: &topology.Event{}},
+		{msgType: bmp.SRPolicyResolvedMsg, msg: &topology.ResolvedSRPolicy{}},
+		{msgType: bmp.ChurnStatsMsg, msg: &churn.Stats{}},
+		{msgType: bmp.FlapEventMsg, msg: &churn.FlapEvent{}},
+		{msgType: bmp.HijackEventMsg, msg: &hijack.Event{}},
+		{msgType: bmp.RouteLeakMsg, msg: &message.RouteLeak{}},
+		{msgType: bmp.PeerSyncMsg, msg: &message.PeerSync{}},
+		{msgType: bmp.BaseAttributeMsg, msg: &message.BaseAttribute{}},
+	}
+	covered := make(map[int]bool, len(tests))
+	for _, tt := range tests {
+		covered[tt.msgType] = true
+	}
+	for msgType, name := range bmp.MessageTypeNames {
+		if !covered[msgType] {
+			t.Errorf("message type %s has no schema test", name)
+		}
+	}
+	// Every JSON key must have a protobuf field, unknown keys are errors
+	strict := protojson.UnmarshalOptions{}
+	for _, tt := range tests {
+		for variant := 0; variant < 11; variant++ {
+			name := fmt.Sprintf("%s/%d", bmp.MessageTypeNames[tt.msgType], variant)
+			t.Run(name, func(t *testing.T) {
+				v := reflect.New(reflect.TypeOf(tt.msg).Elem())
+				f := &faker{variant: variant}
+				if err := f.fill(v.Elem(), 0); err != nil {
+					t.Fatalf("failed to fill %T: %v", tt.msg, err)
+				}
+				b, err := json.Marshal(v.Interface())
+				if err != nil {
+					t.Fatalf("json.Marshal() error: %v", err)
+				}
+				m, ok := NewMessage(tt.msgType)
+				if !ok {
+					t.Fatalf("NewMessage(%d) has no message", tt.msgType)
+				}
+				if err := strict.Unmarshal(b, m); err != nil {
+					t.Fatalf("%s does not decode %s: %v", m.ProtoReflect().Descriptor().FullName(), b, err)
+				}
+				// The messages built from their struct match their JSON conversion
+				got, err := FromMessage(tt.msgType, v.Interface())
+				if err != nil {
+					t.Fatalf("FromMessage() error: %v", err)
+				}
+				if !proto.Equal(got, m) {
+					t.Errorf("FromMessage() =\n%v\nwant\n%v", got, m)
+				}
+				// The JSON encoding of the protobuf message carries the values
+				// of the JSON message
+				pb, err := roundTrip.Marshal(got)
+				if err != nil {
+					t.Fatalf("protojson.Marshal() error: %v", err)
+				}
+				want, err := jsonLeaves(b)
+				if err != nil {
+					t.Fatal(err)
+				}
+				leaves, err := jsonLeaves(pb)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !reflect.DeepEqual(leaves, want) {
+					for k, v := range want {
+						if leaves[k] != v {
+							t.Errorf("%s = %q after the round trip, want %q", k, leaves[k], v)
+						}
+					}
+					for k, v := range leaves {
+						if _, ok := want[k]; !ok {
+							t.Errorf("%s = %q after the round trip, want no value", k, v)
+						}
+					}
+				}
+			})
+		}
+	}
+}
+
+func TestEncode(t *testing.T) {
+	color := uint32(100)
+	msg, err := json.Marshal(&message.UnicastPrefix{
+		Action:         "add",
+		RouterIP:       "10.0.0.1",
+		Prefix:         "192.0.2.0",
+		PrefixLen:      24,
+		IsIPv4:         true,
+		Color:          &color,
+		BaseAttributes: &bgp.BaseAttributes{ASPath: []uint32{65000, 65001}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := Encode(pub.EncodingJSON, bmp.UnicastPrefixV4Msg, msg)
+	if err != nil || !bytes.Equal(b, msg) {
+		t.Errorf("Encode(json) = %s, %v, want the JSON message", b, err)
+	}
+	if b, err = Encode(pub.EncodingProtobuf, bmp.UnicastPrefixV4Msg, msg); err != nil {
+		t.Fatalf("Encode(protobuf) error: %v", err)
+	}
+	u := &UnicastPrefix{}
+	if err := proto.Unmarshal(b, u); err != nil {
+		t.Fatalf("proto.Unmarshal() error: %v", err)
+	}
+	if u.GetAction() != "add" || u.GetRouterIp() != "10.0.0.1" || u.GetPrefix() != "192.0.2.0" || u.GetPrefixLen() != 24 || !u.GetIsIpv4() ||
+		u.GetColor() != 100 || len(u.GetBaseAttrs().GetAsPath()) != 2 {
+		t.Errorf("unicast prefix = %v", u)
+	}
+	raw := []byte{0x03, 0x00, 0x00, 0x00, 0x06, 0x04}
+	if b, err = Encode(pub.EncodingProtobuf, bmp.BMPRawMsg, raw); err != nil || !bytes.Equal(b, raw) {
+		t.Errorf("Encode(protobuf) of a raw message = %x, %v, want %x", b, err, raw)
+	}
+	tsv := []byte("V: 1.7\nC_HASH_ID: 0\nT: peer\nL: 0\nR: 0\n\n")
+	if b, err = Encode(pub.EncodingProtobuf, bmp.OpenBMPPeerMsg, tsv); err != nil || !bytes.Equal(b, tsv) {
+		t.Errorf("Encode(protobuf) of an OpenBMP message = %q, %v, want %q", b, err, tsv)
+	}
+	if _, err = Encode(pub.EncodingProtobuf, 9999, msg); err == nil {
+		t.Error("Encode(protobuf) of an unknown message type succeeded")
+	}
+	if _, err = Encode(pub.EncodingProtobuf, bmp.UnicastPrefixV4Msg, []byte(`{"prefix_len":"x"}`)); err == nil {
+		t.Error("Encode(protobuf) of an invalid message succeeded")
+	}
+}
+
+func TestMarshal(t *testing.T) {
+	u := &message.UnicastPrefix{Action: "add", Prefix: "192.0.2.0", PrefixLen: 24, IsIPv4: true}
+	for _, msg := range []interface{}{u, &u} {
+		b, err := Marshal(bmp.UnicastPrefixV4Msg, msg)
+		if err != nil {
+			t.Fatalf("Marshal(%T) error: %v", msg, err)
+		}
+		got := &UnicastPrefix{}
+		if err := proto.Unmarshal(b, got); err != nil {
+			t.Fatalf("proto.Unmarshal() error: %v", err)
+		}
+		if got.GetPrefix() != "192.0.2.0" || got.GetPrefixLen() != 24 || !got.GetIsIpv4() {
+			t.Errorf("Marshal(%T) = %v", msg, got)
+		}
+	}
+	if _, err := Marshal(9999, &message.PeerSync{}); err == nil {
+		t.Error("Marshal() of an unknown message type succeeded")
+	}
+	// The JSON keys without a protobuf field are errors, but for the database
+	// fields
+	if _, err := FromJSON(bmp.UnicastPrefixV4Msg, []byte(`{"prefix":"192.0.2.0","unknown_key":1}`)); err == nil {
+		t.Error("FromJSON() of an unknown key succeeded")
+	}
+	if m, err := FromJSON(bmp.UnicastPrefixV4Msg, []byte(`{"_key":"k","_id":"i","_rev":"r","prefix":"192.0.2.0"}`)); err != nil || m.(*UnicastPrefix).GetPrefix() != "192.0.2.0" {
+		t.Errorf("FromJSON() of the database fields = %v, %v", m, err)
+	}
+}
+
+// benchmarkPrefix is a unicast prefix with the attributes of a typical
+// Internet route.
+func benchmarkPrefix() *message.UnicastPrefix {
+	return &message.UnicastPrefix{
+		Action:        "add",
+		RouterHash:    "7d1b2e3b1a6e4c3c1d3b7e2a1f0c9d8e",
+		RouterIP:      "10.0.0.1",
+		PeerHash:      "2b7e151628aed2a6abf7158809cf4f3c",
+		PeerIP:        "192.0.2.1",
+		PeerASN:       65001,
+		Timestamp:     "2026-10-18T12:00:00.000000Z",
+		Prefix:        "198.51.100.0",
+		PrefixLen:     24,
+		IsIPv4:        true,
+		OriginAS:      64500,
+		Nexthop:       "192.0.2.1",
+		IsNexthopIPv4: true,
+		BaseAttributes: &bgp.BaseAttributes{
+			Origin:           "igp",
+			ASPath:           []uint32{65001, 174, 3356, 64500},
+			ASPathCount:      4,
+			Nexthop:          "192.0.2.1",
+			MED:              100,
+			LocalPref:        200,
+			CommunityList:    []string{"65001:100", "65001:200", "174:21000"},
+			ExtCommunityList: []string{"rt=65001:1"},
+		},
+	}
+}
+
+func BenchmarkEncode(b *testing.B) {
+	u := benchmarkPrefix()
+	b.Run("json", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := json.Marshal(u); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("protobuf", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := Marshal(bmp.UnicastPrefixV4Msg, u); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	// protobuf_from_json is the conversion of the publishers given JSON
+	b.Run("protobuf_from_json", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			j, err := json.Marshal(u)
+			if err != nil {
+				b.Fatal(err)
+			}
+			if _, err := Encode(pub.EncodingProtobuf, bmp.UnicastPrefixV4Msg, j); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
@@ -0,0 +1,796 @@
+// goBMP subscription API, streams the parsed messages of a collector to its
+// gRPC subscribers. The streamed messages are defined in messages.proto.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        v5.29.3
+// source: pkg/api/gobmp.proto
+
+package api
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AFI int32
+
+const (
+	AFI_AFI_UNSPECIFIED AFI = 0
+	AFI_AFI_IPV4        AFI = 1
+	AFI_AFI_IPV6        AFI = 2
+)
+
+// Enum value maps for AFI.
+var (
+	AFI_name = map[int32]string{
+		0: "AFI_UNSPECIFIED",
+		1: "AFI_IPV4",
+		2: "AFI_IPV6",
+	}
+	AFI_value = map[string]int32{
+		"AFI_UNSPECIFIED": 0,
+		"AFI_IPV4":        1,
+		"AFI_IPV6":        2,
+	}
+)
+
+func (x AFI) Enum() *AFI {
+	p := new(AFI)
+	*p = x
+	return p
+}
+
+func (x AFI) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AFI) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_api_gobmp_proto_enumTypes[0].Descriptor()
+}
+
+func (AFI) Type() protoreflect.EnumType {
+	return &file_pkg_api_gobmp_proto_enumTypes[0]
+}
+
+func (x AFI) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AFI.Descriptor instead.
+func (AFI) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_api_gobmp_proto_rawDescGZIP(), []int{0}
+}
+
+// SubscribeRequest selects the streamed messages, an empty list matches all
+// messages and a message must match every non empty list.
+type SubscribeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// types are message types, the topic names without the "gobmp.parsed."
+	// prefix, e.g. "peer" or "unicast_prefix_v4".
+	Types []string `protobuf:"bytes,1,rep,name=types,proto3" json:"types,omitempty"`
+	// routers are the addresses of the monitored routers.
+	Routers []string `protobuf:"bytes,2,rep,name=routers,proto3" json:"routers,omitempty"`
+	// peers are the addresses of the BGP peers of the monitored routers.
+	Peers []string `protobuf:"bytes,3,rep,name=peers,proto3" json:"peers,omitempty"`
+	// afis match the messages carrying the is_ipv4 field.
+	Afis []AFI `protobuf:"varint,4,rep,packed,name=afis,proto3,enum=gobmp.api.AFI" json:"afis,omitempty"`
+	// prefixes are prefix ranges in CIDR notation, a range matches the messages
+	// of its prefix and of the more specific prefixes.
+	Prefixes      []string `protobuf:"bytes,5,rep,name=prefixes,proto3" json:"prefixes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeRequest) Reset() {
+	*x = SubscribeRequest{}
+	mi := &file_pkg_api_gobmp_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeRequest) ProtoMessage() {}
+
+func (x *SubscribeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_gobmp_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_gobmp_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SubscribeRequest) GetTypes() []string {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *SubscribeRequest) GetRouters() []string {
+	if x != nil {
+		return x.Routers
+	}
+	return nil
+}
+
+func (x *SubscribeRequest) GetPeers() []string {
+	if x != nil {
+		return x.Peers
+	}
+	return nil
+}
+
+func (x *SubscribeRequest) GetAfis() []AFI {
+	if x != nil {
+		return x.Afis
+	}
+	return nil
+}
+
+func (x *SubscribeRequest) GetPrefixes() []string {
+	if x != nil {
+		return x.Prefixes
+	}
+	return nil
+}
+
+type Message struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// type is the message type, see SubscribeRequest.types.
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// key is the message hash used as the Kafka record key.
+	Key []byte `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// Types that are valid to be assigned to Body:
+	//
+	//	*Message_Peer
+	//	*Message_UnicastPrefix
+	//	*Message_L3VpnPrefix
+	//	*Message_LsNode
+	//	*Message_LsLink
+	//	*Message_LsPrefix
+	//	*Message_LsSrv6Sid
+	//	*Message_EvpnPrefix
+	//	*Message_SrPolicy
+	//	*Message_Flowspec
+	//	*Message_VplsPrefix
+	//	*Message_Stats
+	//	*Message_TopologyEvent
+	//	*Message_ResolvedSrPolicy
+	//	*Message_ChurnStats
+	//	*Message_FlapEvent
+	//	*Message_HijackEvent
+	//	*Message_RouteLeak
+	//	*Message_PeerSync
+	//	*Message_MulticastPrefix
+	//	*Message_McastVpnPrefix
+	//	*Message_RtcPrefix
+	//	*Message_BaseAttribute
+	//	*Message_Json
+	Body          isMessage_Body `protobuf_oneof:"body"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Message) Reset() {
+	*x = Message{}
+	mi := &file_pkg_api_gobmp_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Message) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Message) ProtoMessage() {}
+
+func (x *Message) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_gobmp_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Message.ProtoReflect.Descriptor instead.
+func (*Message) Descriptor() ([]byte, []int) {
+	return file_pkg_api_gobmp_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Message) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Message) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *Message) GetBody() isMessage_Body {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+func (x *Message) GetPeer() *PeerStateChange {
+	if x != nil {
+		if x, ok := x.Body.(*Message_Peer); ok {
+			return x.Peer
+		}
+	}
+	return nil
+}
+
+func (x *Message) GetUnicastPrefix() *UnicastPrefix {
+	if x != nil {
+		if x, ok := x.Body.(*Message_UnicastPrefix); ok {
+			return x.UnicastPrefix
+		}
+	}
+	return nil
+}
+
+func (x *Message) GetL3VpnPrefix() *L3VPNPrefix {
+	if x != nil {
+		if x, ok := x.Body.(*Message_L3VpnPrefix); ok {
+			return x.L3VpnPrefix
+		}
+	}
+	return nil
+}
+
+func (x *Message) GetLsNode() *LSNode {
+	if x != nil {
+		if x, ok := x.Body.(*Message_LsNode); ok {
+			return x.LsNode
+		}
+	}
+	return nil
+}
+
+func (x *Message) GetLsLink() *LSLink {
+	if x != nil {
+		if x, ok := x.Body.(*Message_LsLink); ok {
+			return x.LsLink
+		}
+	}
+	return nil
+}
+
+func (x *Message) GetLsPrefix() *LSPrefix {
+	if x != nil {
+		if x, ok := x.Body.(*Message_LsPrefix); ok {
+			return x.LsPrefix
+		}
+	}
+	return nil
+}
+
+func (x *Message) GetLsSrv6Sid() *LSSRv6SID {
+	if x != nil {
+		if x, ok := x.Body.(*Message_LsSrv6Sid); ok {
+			return x.LsSrv6Sid
+		}
+	}
+	return nil
+}
+
+func (x *Message) GetEvpnPrefix() *EVPNPrefix {
+	if x != nil {
+		if x, ok := x.Body.(*Message_EvpnPrefix); ok {
+			return x.EvpnPrefix
+		}
+	}
+	return nil
+}
+
+func (x *Message) GetSrPolicy() *SRPolicy {
+	if x != nil {
+		if x, ok := x.Body.(*Message_SrPolicy); ok {
+			return x.SrPolicy
+		}
+	}
+	return nil
+}
+
+func (x *Message) GetFlowspec() *Flowspec {
+	if x != nil {
+		if x, ok := x.Body.(*Message_Flowspec); ok {
+			return x.Flowspec
+		}
+	}
+	return nil
+}
+
+func (x *Message) GetVplsPrefix() *VPLSPrefix {
+	if x != nil {
+		if x, ok := x.Body.(*Message_VplsPrefix); ok {
+			return x.VplsPrefix
+		}
+	}
+	return nil
+}
+
+func (x *Message) GetStats() *Stats {
+	if x != nil {
+		if x, ok := x.Body.(*Message_Stats); ok {
+			return x.Stats
+		}
+	}
+	return nil
+}
+
+func (x *Message) GetTopologyEvent() *TopologyEvent {
+	if x != nil {
+		if x, ok := x.Body.(*Message_TopologyEvent); ok {
+			return x.TopologyEvent
+		}
+	}
+	return nil
+}
+
+func (x *Message) GetResolvedSrPolicy() *ResolvedSRPolicy {
+	if x != nil {
+		if x, ok := x.Body.(*Message_ResolvedSrPolicy); ok {
+			return x.ResolvedSrPolicy
+		}
+	}
+	return nil
+}
+
+func (x *Message) GetChurnStats() *ChurnStats {
+	if x != nil {
+		if x, ok := x.Body.(*Message_ChurnStats); ok {
+			return x.ChurnStats
+		}
+	}
+	return nil
+}
+
+func (x *Message) GetFlapEvent() *FlapEvent {
+	if x != nil {
+		if x, ok := x.Body.(*Message_FlapEvent); ok {
+			return x.FlapEvent
+		}
+	}
+	return nil
+}
+
+func (x *Message) GetHijackEvent() *HijackEvent {
+	if x != nil {
+		if x, ok := x.Body.(*Message_HijackEvent); ok {
+			return x.HijackEvent
+		}
+	}
+	return nil
+}
+
+func (x *Message) GetRouteLeak() *RouteLeak {
+	if x != nil {
+		if x, ok := x.Body.(*Message_RouteLeak); ok {
+			return x.RouteLeak
+		}
+	}
+	return nil
+}
+
+func (x *Message) GetPeerSync() *PeerSync {
+	if x != nil {
+		if x, ok := x.Body.(*Message_PeerSync); ok {
+			return x.PeerSync
+		}
+	}
+	return nil
+}
+
+func (x *Message) GetMulticastPrefix() *MulticastPrefix {
+	if x != nil {
+		if x, ok := x.Body.(*Message_MulticastPrefix); ok {
+			return x.MulticastPrefix
+		}
+	}
+	return nil
+}
+
+func (x *Message) GetMcastVpnPrefix() *MCASTVPNPrefix {
+	if x != nil {
+		if x, ok := x.Body.(*Message_McastVpnPrefix); ok {
+			return x.McastVpnPrefix
+		}
+	}
+	return nil
+}
+
+func (x *Message) GetRtcPrefix() *RTCPrefix {
+	if x != nil {
+		if x, ok := x.Body.(*Message_RtcPrefix); ok {
+			return x.RtcPrefix
+		}
+	}
+	return nil
+}
+
+func (x *Message) GetBaseAttribute() *BaseAttribute {
+	if x != nil {
+		if x, ok := x.Body.(*Message_BaseAttribute); ok {
+			return x.BaseAttribute
+		}
+	}
+	return nil
+}
+
+func (x *Message) GetJson() *structpb.Struct {
+	if x != nil {
+		if x, ok := x.Body.(*Message_Json); ok {
+			return x.Json
+		}
+	}
+	return nil
+}
+
+type isMessage_Body interface {
+	isMessage_Body()
+}
+
+type Message_Peer struct {
+	Peer *PeerStateChange `protobuf:"bytes,10,opt,name=peer,proto3,oneof"`
+}
+
+type Message_UnicastPrefix struct {
+	UnicastPrefix *UnicastPrefix `protobuf:"bytes,11,opt,name=unicast_prefix,json=unicastPrefix,proto3,oneof"`
+}
+
+type Message_L3VpnPrefix struct {
+	L3VpnPrefix *L3VPNPrefix `protobuf:"bytes,12,opt,name=l3vpn_prefix,json=l3vpnPrefix,proto3,oneof"`
+}
+
+type Message_LsNode struct {
+	LsNode *LSNode `protobuf:"bytes,13,opt,name=ls_node,json=lsNode,proto3,oneof"`
+}
+
+type Message_LsLink struct {
+	LsLink *LSLink `protobuf:"bytes,14,opt,name=ls_link,json=lsLink,proto3,oneof"`
+}
+
+type Message_LsPrefix struct {
+	LsPrefix *LSPrefix `protobuf:"bytes,15,opt,name=ls_prefix,json=lsPrefix,proto3,oneof"`
+}
+
+type Message_LsSrv6Sid struct {
+	LsSrv6Sid *LSSRv6SID `protobuf:"bytes,16,opt,name=ls_srv6_sid,json=lsSrv6Sid,proto3,oneof"`
+}
+
+type Message_EvpnPrefix struct {
+	EvpnPrefix *EVPNPrefix `protobuf:"bytes,17,opt,name=evpn_prefix,json=evpnPrefix,proto3,oneof"`
+}
+
+type Message_SrPolicy struct {
+	SrPolicy *SRPolicy `protobuf:"bytes,18,opt,name=sr_policy,json=srPolicy,proto3,oneof"`
+}
+
+type Message_Flowspec struct {
+	Flowspec *Flowspec `protobuf:"bytes,19,opt,name=flowspec,proto3,oneof"`
+}
+
+type Message_VplsPrefix struct {
+	VplsPrefix *VPLSPrefix `protobuf:"bytes,20,opt,name=vpls_prefix,json=vplsPrefix,proto3,oneof"`
+}
+
+type Message_Stats struct {
+	Stats *Stats `protobuf:"bytes,21,opt,name=stats,proto3,oneof"`
+}
+
+type Message_TopologyEvent struct {
+	TopologyEvent *TopologyEvent `protobuf:"bytes,22,opt,name=topology_event,json=topologyEvent,proto3,oneof"`
+}
+
+type Message_ResolvedSrPolicy struct {
+	ResolvedSrPolicy *ResolvedSRPolicy `protobuf:"bytes,23,opt,name=resolved_sr_policy,json=resolvedSrPolicy,proto3,oneof"`
+}
+
+type Message_ChurnStats struct {
+	ChurnStats *ChurnStats `protobuf:"bytes,24,opt,name=churn_stats,json=churnStats,proto3,oneof"`
+}
+
+type Message_FlapEvent struct {
+	FlapEvent *FlapEvent `protobuf:"bytes,25,opt,name=flap_event,json=flapEvent,proto3,oneof"`
+}
+
+type Message_HijackEvent struct {
+	HijackEvent *HijackEvent `protobuf:"bytes,26,opt,name=hijack_event,json=hijackEvent,proto3,oneof"`
+}
+
+type Message_RouteLeak struct {
+	RouteLeak *RouteLeak `protobuf:"bytes,27,opt,name=route_leak,json=routeLeak,proto3,oneof"`
+}
+
+type Message_PeerSync struct {
+	PeerSync *PeerSync `protobuf:"bytes,28,opt,name=peer_sync,json=peerSync,proto3,oneof"`
+}
+
+type Message_MulticastPrefix struct {
+	MulticastPrefix *MulticastPrefix `protobuf:"bytes,29,opt,name=multicast_prefix,json=multicastPrefix,proto3,oneof"`
+}
+
+type Message_McastVpnPrefix struct {
+	McastVpnPrefix *MCASTVPNPrefix `protobuf:"bytes,30,opt,name=mcast_vpn_prefix,json=mcastVpnPrefix,proto3,oneof"`
+}
+
+type Message_RtcPrefix struct {
+	RtcPrefix *RTCPrefix `protobuf:"bytes,31,opt,name=rtc_prefix,json=rtcPrefix,proto3,oneof"`
+}
+
+type Message_BaseAttribute struct {
+	BaseAttribute *BaseAttribute `protobuf:"bytes,32,opt,name=base_attribute,json=baseAttribute,proto3,oneof"`
+}
+
+type Message_Json struct {
+	// json carries the messages without a typed definition.
+	Json *structpb.Struct `protobuf:"bytes,100,opt,name=json,proto3,oneof"`
+}
+
+func (*Message_Peer) isMessage_Body() {}
+
+func (*Message_UnicastPrefix) isMessage_Body() {}
+
+func (*Message_L3VpnPrefix) isMessage_Body() {}
+
+func (*Message_LsNode) isMessage_Body() {}
+
+func (*Message_LsLink) isMessage_Body() {}
+
+func (*Message_LsPrefix) isMessage_Body() {}
+
+func (*Message_LsSrv6Sid) isMessage_Body() {}
+
+func (*Message_EvpnPrefix) isMessage_Body() {}
+
+func (*Message_SrPolicy) isMessage_Body() {}
+
+func (*Message_Flowspec) isMessage_Body() {}
+
+func (*Message_VplsPrefix) isMessage_Body() {}
+
+func (*Message_Stats) isMessage_Body() {}
+
+func (*Message_TopologyEvent) isMessage_Body() {}
+
+func (*Message_ResolvedSrPolicy) isMessage_Body() {}
+
+func (*Message_ChurnStats) isMessage_Body() {}
+
+func (*Message_FlapEvent) isMessage_Body() {}
+
+func (*Message_HijackEvent) isMessage_Body() {}
+
+func (*Message_RouteLeak) isMessage_Body() {}
+
+func (*Message_PeerSync) isMessage_Body() {}
+
+func (*Message_MulticastPrefix) isMessage_Body() {}
+
+func (*Message_McastVpnPrefix) isMessage_Body() {}
+
+func (*Message_RtcPrefix) isMessage_Body() {}
+
+func (*Message_BaseAttribute) isMessage_Body() {}
+
+func (*Message_Json) isMessage_Body() {}
+
+var File_pkg_api_gobmp_proto protoreflect.FileDescriptor
+
+const file_pkg_api_gobmp_proto_rawDesc = "" +
+	"\n" +
+	"\x13pkg/api/gobmp.proto\x12\tgobmp.api\x1a\x1cgoogle/protobuf/struct.proto\x1a\x16pkg/api/messages.proto\"\x98\x01\n" +
+	"\x10SubscribeRequest\x12\x14\n" +
+	"\x05types\x18\x01 \x03(\tR\x05types\x12\x18\n" +
+	"\arouters\x18\x02 \x03(\tR\arouters\x12\x14\n" +
+	"\x05peers\x18\x03 \x03(\tR\x05peers\x12\"\n" +
+	"\x04afis\x18\x04 \x03(\x0e2\x0e.gobmp.api.AFIR\x04afis\x12\x1a\n" +
+	"\bprefixes\x18\x05 \x03(\tR\bprefixes\"\x98\v\n" +
+	"\aMessage\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\fR\x03key\x120\n" +
+	"\x04peer\x18\n" +
+	" \x01(\v2\x1a.gobmp.api.PeerStateChangeH\x00R\x04peer\x12A\n" +
+	"\x0eunicast_prefix\x18\v \x01(\v2\x18.gobmp.api.UnicastPrefixH\x00R\runicastPrefix\x12;\n" +
+	"\fl3vpn_prefix\x18\f \x01(\v2\x16.gobmp.api.L3VPNPrefixH\x00R\vl3vpnPrefix\x12,\n" +
+	"\als_node\x18\r \x01(\v2\x11.gobmp.api.LSNodeH\x00R\x06lsNode\x12,\n" +
+	"\als_link\x18\x0e \x01(\v2\x11.gobmp.api.LSLinkH\x00R\x06lsLink\x122\n" +
+	"\tls_prefix\x18\x0f \x01(\v2\x13.gobmp.api.LSPrefixH\x00R\blsPrefix\x126\n" +
+	"\vls_srv6_sid\x18\x10 \x01(\v2\x14.gobmp.api.LSSRv6SIDH\x00R\tlsSrv6Sid\x128\n" +
+	"\vevpn_prefix\x18\x11 \x01(\v2\x15.gobmp.api.EVPNPrefixH\x00R\n" +
+	"evpnPrefix\x122\n" +
+	"\tsr_policy\x18\x12 \x01(\v2\x13.gobmp.api.SRPolicyH\x00R\bsrPolicy\x121\n" +
+	"\bflowspec\x18\x13 \x01(\v2\x13.gobmp.api.FlowspecH\x00R\bflowspec\x128\n" +
+	"\vvpls_prefix\x18\x14 \x01(\v2\x15.gobmp.api.VPLSPrefixH\x00R\n" +
+	"vplsPrefix\x12(\n" +
+	"\x05stats\x18\x15 \x01(\v2\x10.gobmp.api.StatsH\x00R\x05stats\x12A\n" +
+	"\x0etopology_event\x18\x16 \x01(\v2\x18.gobmp.api.TopologyEventH\x00R\rtopologyEvent\x12K\n" +
+	"\x12resolved_sr_policy\x18\x17 \x01(\v2\x1b.gobmp.api.ResolvedSRPolicyH\x00R\x10resolvedSrPolicy\x128\n" +
+	"\vchurn_stats\x18\x18 \x01(\v2\x15.gobmp.api.ChurnStatsH\x00R\n" +
+	"churnStats\x125\n" +
+	"\n" +
+	"flap_event\x18\x19 \x01(\v2\x14.gobmp.api.FlapEventH\x00R\tflapEvent\x12;\n" +
+	"\fhijack_event\x18\x1a \x01(\v2\x16.gobmp.api.HijackEventH\x00R\vhijackEvent\x125\n" +
+	"\n" +
+	"route_leak\x18\x1b \x01(\v2\x14.gobmp.api.RouteLeakH\x00R\trouteLeak\x122\n" +
+	"\tpeer_sync\x18\x1c \x01(\v2\x13.gobmp.api.PeerSyncH\x00R\bpeerSync\x12G\n" +
+	"\x10multicast_prefix\x18\x1d \x01(\v2\x1a.gobmp.api.MulticastPrefixH\x00R\x0fmulticastPrefix\x12E\n" +
+	"\x10mcast_vpn_prefix\x18\x1e \x01(\v2\x19.gobmp.api.MCASTVPNPrefixH\x00R\x0emcastVpnPrefix\x125\n" +
+	"\n" +
+	"rtc_prefix\x18\x1f \x01(\v2\x14.gobmp.api.RTCPrefixH\x00R\trtcPrefix\x12A\n" +
+	"\x0ebase_attribute\x18  \x01(\v2\x18.gobmp.api.BaseAttributeH\x00R\rbaseAttribute\x12-\n" +
+	"\x04json\x18d \x01(\v2\x17.google.protobuf.StructH\x00R\x04jsonB\x06\n" +
+	"\x04body*6\n" +
+	"\x03AFI\x12\x13\n" +
+	"\x0fAFI_UNSPECIFIED\x10\x00\x12\f\n" +
+	"\bAFI_IPV4\x10\x01\x12\f\n" +
+	"\bAFI_IPV6\x10\x022G\n" +
+	"\x05GoBMP\x12>\n" +
+	"\tSubscribe\x12\x1b.gobmp.api.SubscribeRequest\x1a\x12.gobmp.api.Message0\x01B#Z!github.com/sbezverk/gobmp/pkg/apib\x06proto3"
+
+var (
+	file_pkg_api_gobmp_proto_rawDescOnce sync.Once
+	file_pkg_api_gobmp_proto_rawDescData []byte
+)
+
+func file_pkg_api_gobmp_proto_rawDescGZIP() []byte {
+	file_pkg_api_gobmp_proto_rawDescOnce.Do(func() {
+		file_pkg_api_gobmp_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_pkg_api_gobmp_proto_rawDesc), len(file_pkg_api_gobmp_proto_rawDesc)))
+	})
+	return file_pkg_api_gobmp_proto_rawDescData
+}
+
+var file_pkg_api_gobmp_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_pkg_api_gobmp_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_pkg_api_gobmp_proto_goTypes = []any{
+	(AFI)(0),                 // 0: gobmp.api.AFI
+	(*SubscribeRequest)(nil), // 1: gobmp.api.SubscribeRequest
+	(*Message)(nil),          // 2: gobmp.api.Message
+	(*PeerStateChange)(nil),  // 3: gobmp.api.PeerStateChange
+	(*UnicastPrefix)(nil),    // 4: gobmp.api.UnicastPrefix
+	(*L3VPNPrefix)(nil),      // 5: gobmp.api.L3VPNPrefix
+	(*LSNode)(nil),           // 6: gobmp.api.LSNode
+	(*LSLink)(nil),           // 7: gobmp.api.LSLink
+	(*LSPrefix)(nil),         // 8: gobmp.api.LSPrefix
+	(*LSSRv6SID)(nil),        // 9: gobmp.api.LSSRv6SID
+	(*EVPNPrefix)(nil),       // 10: gobmp.api.EVPNPrefix
+	(*SRPolicy)(nil),         // 11: gobmp.api.SRPolicy
+	(*Flowspec)(nil),         // 12: gobmp.api.Flowspec
+	(*VPLSPrefix)(nil),       // 13: gobmp.api.VPLSPrefix
+	(*Stats)(nil),            // 14: gobmp.api.Stats
+	(*TopologyEvent)(nil),    // 15: gobmp.api.TopologyEvent
+	(*ResolvedSRPolicy)(nil), // 16: gobmp.api.ResolvedSRPolicy
+	(*ChurnStats)(nil),       // 17: gobmp.api.ChurnStats
+	(*FlapEvent)(nil),        // 18: gobmp.api.FlapEvent
+	(*HijackEvent)(nil),      // 19: gobmp.api.HijackEvent
+	(*RouteLeak)(nil),        // 20: gobmp.api.RouteLeak
+	(*PeerSync)(nil),         // 21: gobmp.api.PeerSync
+	(*MulticastPrefix)(nil),  // 22: gobmp.api.MulticastPrefix
+	(*MCASTVPNPrefix)(nil),   // 23: gobmp.api.MCASTVPNPrefix
+	(*RTCPrefix)(nil),        // 24: gobmp.api.RTCPrefix
+	(*BaseAttribute)(nil),    // 25: gobmp.api.BaseAttribute
+	(*structpb.Struct)(nil),  // 26: google.protobuf.Struct
+}
+var file_pkg_api_gobmp_proto_depIdxs = []int32{
+	0,  // 0: gobmp.api.SubscribeRequest.afis:type_name -> gobmp.api.AFI
+	3,  // 1: gobmp.api.Message.peer:type_name -> gobmp.api.PeerStateChange
+	4,  // 2: gobmp.api.Message.unicast_prefix:type_name -> gobmp.api.UnicastPrefix
+	5,  // 3: gobmp.api.Message.l3vpn_prefix:type_name -> gobmp.api.L3VPNPrefix
+	6,  // 4: gobmp.api.Message.ls_node:type_name -> gobmp.api.LSNode
+	7,  // 5: gobmp.api.Message.ls_link:type_name -> gobmp.api.LSLink
+	8,  // 6: gobmp.api.Message.ls_prefix:type_name -> gobmp.api.LSPrefix
+	9,  // 7: gobmp.api.Message.ls_srv6_sid:type_name -> gobmp.api.LSSRv6SID
+	10, // 8: gobmp.api.Message.evpn_prefix:type_name -> gobmp.api.EVPNPrefix
+	11, // 9: gobmp.api.Message.sr_policy:type_name -> gobmp.api.SRPolicy
+	12, // 10: gobmp.api.Message.flowspec:type_name -> gobmp.api.Flowspec
+	13, // 11: gobmp.api.Message.vpls_prefix:type_name -> gobmp.api.VPLSPrefix
+	14, // 12: gobmp.api.Message.stats:type_name -> gobmp.api.Stats
+	15, // 13: gobmp.api.Message.topology_event:type_name -> gobmp.api.TopologyEvent
+	16, // 14: gobmp.api.Message.resolved_sr_policy:type_name -> gobmp.api.ResolvedSRPolicy
+	17, // 15: gobmp.api.Message.churn_stats:type_name -> gobmp.api.ChurnStats
+	18, // 16: gobmp.api.Message.flap_event:type_name -> gobmp.api.FlapEvent
+	19, // 17: gobmp.api.Message.hijack_event:type_name -> gobmp.api.HijackEvent
+	20, // 18: gobmp.api.Message.route_leak:type_name -> gobmp.api.RouteLeak
+	21, // 19: gobmp.api.Message.peer_sync:type_name -> gobmp.api.PeerSync
+	22, // 20: gobmp.api.Message.multicast_prefix:type_name -> gobmp.api.MulticastPrefix
+	23, // 21: gobmp.api.Message.mcast_vpn_prefix:type_name -> gobmp.api.MCASTVPNPrefix
+	24, // 22: gobmp.api.Message.rtc_prefix:type_name -> gobmp.api.RTCPrefix
+	25, // 23: gobmp.api.Message.base_attribute:type_name -> gobmp.api.BaseAttribute
+	26, // 24: gobmp.api.Message.json:type_name -> google.protobuf.Struct
+	1,  // 25: gobmp.api.GoBMP.Subscribe:input_type -> gobmp.api.SubscribeRequest
+	2,  // 26: gobmp.api.GoBMP.Subscribe:output_type -> gobmp.api.Message
+	26, // [26:27] is the sub-list for method output_type
+	25, // [25:26] is the sub-list for method input_type
+	25, // [25:25] is the sub-list for extension type_name
+	25, // [25:25] is the sub-list for extension extendee
+	0,  // [0:25] is the sub-list for field type_name
+}
+
+func init() { file_pkg_api_gobmp_proto_init() }
+func file_pkg_api_gobmp_proto_init() {
+	if File_pkg_api_gobmp_proto != nil {
+		return
+	}
+	file_pkg_api_messages_proto_init()
+	file_pkg_api_gobmp_proto_msgTypes[1].OneofWrappers = []any{
+		(*Message_Peer)(nil),
+		(*Message_UnicastPrefix)(nil),
+		(*Message_L3VpnPrefix)(nil),
+		(*Message_LsNode)(nil),
+		(*Message_LsLink)(nil),
+		(*Message_LsPrefix)(nil),
+		(*Message_LsSrv6Sid)(nil),
+		(*Message_EvpnPrefix)(nil),
+		(*Message_SrPolicy)(nil),
+		(*Message_Flowspec)(nil),
+		(*Message_VplsPrefix)(nil),
+		(*Message_Stats)(nil),
+		(*Message_TopologyEvent)(nil),
+		(*Message_ResolvedSrPolicy)(nil),
+		(*Message_ChurnStats)(nil),
+		(*Message_FlapEvent)(nil),
+		(*Message_HijackEvent)(nil),
+		(*Message_RouteLeak)(nil),
+		(*Message_PeerSync)(nil),
+		(*Message_MulticastPrefix)(nil),
+		(*Message_McastVpnPrefix)(nil),
+		(*Message_RtcPrefix)(nil),
+		(*Message_BaseAttribute)(nil),
+		(*Message_Json)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pkg_api_gobmp_proto_rawDesc), len(file_pkg_api_gobmp_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_pkg_api_gobmp_proto_goTypes,
+		DependencyIndexes: file_pkg_api_gobmp_proto_depIdxs,
+		EnumInfos:         file_pkg_api_gobmp_proto_enumTypes,
+		MessageInfos:      file_pkg_api_gobmp_proto_msgTypes,
+	}.Build()
+	File_pkg_api_gobmp_proto = out.File
+	file_pkg_api_gobmp_proto_goTypes = nil
+	file_pkg_api_gobmp_proto_depIdxs = nil
+}
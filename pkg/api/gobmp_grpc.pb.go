@@ -0,0 +1,135 @@
+// goBMP subscription API, streams the parsed messages of a collector to its
+// gRPC subscribers. The streamed messages are defined in messages.proto.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: pkg/api/gobmp.proto
+
+package api
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	GoBMP_Subscribe_FullMethodName = "/gobmp.api.GoBMP/Subscribe"
+)
+
+// GoBMPClient is the client API for GoBMP service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type GoBMPClient interface {
+	// Subscribe streams the messages matching the request until the client
+	// cancels the call, the collector stops or the subscriber falls behind by
+	// more messages than the collector buffers, the stream then ends with
+	// RESOURCE_EXHAUSTED.
+	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Message], error)
+}
+
+type goBMPClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGoBMPClient(cc grpc.ClientConnInterface) GoBMPClient {
+	return &goBMPClient{cc}
+}
+
+func (c *goBMPClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Message], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GoBMP_ServiceDesc.Streams[0], GoBMP_Subscribe_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SubscribeRequest, Message]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GoBMP_SubscribeClient = grpc.ServerStreamingClient[Message]
+
+// GoBMPServer is the server API for GoBMP service.
+// All implementations must embed UnimplementedGoBMPServer
+// for forward compatibility.
+type GoBMPServer interface {
+	// Subscribe streams the messages matching the request until the client
+	// cancels the call, the collector stops or the subscriber falls behind by
+	// more messages than the collector buffers, the stream then ends with
+	// RESOURCE_EXHAUSTED.
+	Subscribe(*SubscribeRequest, grpc.ServerStreamingServer[Message]) error
+	mustEmbedUnimplementedGoBMPServer()
+}
+
+// UnimplementedGoBMPServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGoBMPServer struct{}
+
+func (UnimplementedGoBMPServer) Subscribe(*SubscribeRequest, grpc.ServerStreamingServer[Message]) error {
+	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
+func (UnimplementedGoBMPServer) mustEmbedUnimplementedGoBMPServer() {}
+func (UnimplementedGoBMPServer) testEmbeddedByValue()               {}
+
+// UnsafeGoBMPServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GoBMPServer will
+// result in compilation errors.
+type UnsafeGoBMPServer interface {
+	mustEmbedUnimplementedGoBMPServer()
+}
+
+func RegisterGoBMPServer(s grpc.ServiceRegistrar, srv GoBMPServer) {
+	// If the following call pancis, it indicates UnimplementedGoBMPServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&GoBMP_ServiceDesc, srv)
+}
+
+func _GoBMP_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoBMPServer).Subscribe(m, &grpc.GenericServerStream[SubscribeRequest, Message]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GoBMP_SubscribeServer = grpc.ServerStreamingServer[Message]
+
+// GoBMP_ServiceDesc is the grpc.ServiceDesc for GoBMP service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GoBMP_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "gobmp.api.GoBMP",
+	HandlerType: (*GoBMPServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Subscribe",
+			Handler:       _GoBMP_Subscribe_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "pkg/api/gobmp.proto",
+}
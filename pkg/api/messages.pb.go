@@ -0,0 +1,14361 @@
+// goBMP published messages, the protobuf encoding of the messages goBMP
+// publishes in JSON.
+//
+// The fields carry the names of the JSON keys and a message is converted by
+// decoding its JSON encoding with protojson. The message of each Go type is
+// named after the type and the fields are numbered in the order of the Go
+// struct fields, the fields added to the Go types are appended.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        v5.29.3
+// source: pkg/api/messages.proto
+
+package api
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// PeerStateChange mirrors message.PeerStateChange.
+type PeerStateChange struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Action      string                 `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	Sequence    int64                  `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Hash        string                 `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	RouterHash  string                 `protobuf:"bytes,4,opt,name=router_hash,json=routerHash,proto3" json:"router_hash,omitempty"`
+	Name        string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	RemoteBgpId string                 `protobuf:"bytes,6,opt,name=remote_bgp_id,json=remoteBgpId,proto3" json:"remote_bgp_id,omitempty"`
+	RouterIp    string                 `protobuf:"bytes,7,opt,name=router_ip,json=routerIp,proto3" json:"router_ip,omitempty"`
+	Timestamp   string                 `protobuf:"bytes,8,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	RemoteAsn   uint32                 `protobuf:"varint,9,opt,name=remote_asn,json=remoteAsn,proto3" json:"remote_asn,omitempty"`
+	RemoteIp    string                 `protobuf:"bytes,10,opt,name=remote_ip,json=remoteIp,proto3" json:"remote_ip,omitempty"`
+	PeerType    uint32                 `protobuf:"varint,11,opt,name=peer_type,json=peerType,proto3" json:"peer_type,omitempty"`
+	PeerRd      string                 `protobuf:"bytes,12,opt,name=peer_rd,json=peerRd,proto3" json:"peer_rd,omitempty"`
+	RemotePort  int64                  `protobuf:"varint,13,opt,name=remote_port,json=remotePort,proto3" json:"remote_port,omitempty"`
+	LocalAsn    uint32                 `protobuf:"varint,14,opt,name=local_asn,json=localAsn,proto3" json:"local_asn,omitempty"`
+	LocalIp     string                 `protobuf:"bytes,15,opt,name=local_ip,json=localIp,proto3" json:"local_ip,omitempty"`
+	LocalPort   int64                  `protobuf:"varint,16,opt,name=local_port,json=localPort,proto3" json:"local_port,omitempty"`
+	LocalBgpId  string                 `protobuf:"bytes,17,opt,name=local_bgp_id,json=localBgpId,proto3" json:"local_bgp_id,omitempty"`
+	InfoData    []byte                 `protobuf:"bytes,18,opt,name=info_data,json=infoData,proto3" json:"info_data,omitempty"`
+	// adv_cap and recv_cap map the capability codes to the lists of
+	// CapabilityData.
+	AdvCap                map[uint32]*structpb.ListValue `protobuf:"bytes,19,rep,name=adv_cap,json=advCap,proto3" json:"adv_cap,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	RecvCap               map[uint32]*structpb.ListValue `protobuf:"bytes,20,rep,name=recv_cap,json=recvCap,proto3" json:"recv_cap,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	RemoteHolddown        int64                          `protobuf:"varint,21,opt,name=remote_holddown,json=remoteHolddown,proto3" json:"remote_holddown,omitempty"`
+	AdvHolddown           int64                          `protobuf:"varint,22,opt,name=adv_holddown,json=advHolddown,proto3" json:"adv_holddown,omitempty"`
+	BmpReason             int64                          `protobuf:"varint,23,opt,name=bmp_reason,json=bmpReason,proto3" json:"bmp_reason,omitempty"`
+	BmpErrorCode          int64                          `protobuf:"varint,24,opt,name=bmp_error_code,json=bmpErrorCode,proto3" json:"bmp_error_code,omitempty"`
+	BmpErrorSubCode       int64                          `protobuf:"varint,25,opt,name=bmp_error_sub_code,json=bmpErrorSubCode,proto3" json:"bmp_error_sub_code,omitempty"`
+	ErrorText             string                         `protobuf:"bytes,26,opt,name=error_text,json=errorText,proto3" json:"error_text,omitempty"`
+	IsL                   bool                           `protobuf:"varint,27,opt,name=is_l,json=isL,proto3" json:"is_l,omitempty"`
+	IsPrepolicy           bool                           `protobuf:"varint,28,opt,name=is_prepolicy,json=isPrepolicy,proto3" json:"is_prepolicy,omitempty"`
+	IsIpv4                bool                           `protobuf:"varint,29,opt,name=is_ipv4,json=isIpv4,proto3" json:"is_ipv4,omitempty"`
+	TableName             string                         `protobuf:"bytes,30,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	IsAdjRibInPostPolicy  bool                           `protobuf:"varint,31,opt,name=is_adj_rib_in_post_policy,json=isAdjRibInPostPolicy,proto3" json:"is_adj_rib_in_post_policy,omitempty"`
+	IsAdjRibOutPostPolicy bool                           `protobuf:"varint,32,opt,name=is_adj_rib_out_post_policy,json=isAdjRibOutPostPolicy,proto3" json:"is_adj_rib_out_post_policy,omitempty"`
+	IsAdjRibOut           bool                           `protobuf:"varint,33,opt,name=is_adj_rib_out,json=isAdjRibOut,proto3" json:"is_adj_rib_out,omitempty"`
+	IsLocRib              bool                           `protobuf:"varint,34,opt,name=is_loc_rib,json=isLocRib,proto3" json:"is_loc_rib,omitempty"`
+	IsLocRibFiltered      bool                           `protobuf:"varint,35,opt,name=is_loc_rib_filtered,json=isLocRibFiltered,proto3" json:"is_loc_rib_filtered,omitempty"`
+	PeerHash              string                         `protobuf:"bytes,36,opt,name=peer_hash,json=peerHash,proto3" json:"peer_hash,omitempty"`
+	UserLabels            map[string]string              `protobuf:"bytes,37,rep,name=user_labels,json=userLabels,proto3" json:"user_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	RemoteAsName          string                         `protobuf:"bytes,38,opt,name=remote_as_name,json=remoteAsName,proto3" json:"remote_as_name,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *PeerStateChange) Reset() {
+	*x = PeerStateChange{}
+	mi := &file_pkg_api_messages_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PeerStateChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerStateChange) ProtoMessage() {}
+
+func (x *PeerStateChange) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerStateChange.ProtoReflect.Descriptor instead.
+func (*PeerStateChange) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PeerStateChange) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *PeerStateChange) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *PeerStateChange) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *PeerStateChange) GetRouterHash() string {
+	if x != nil {
+		return x.RouterHash
+	}
+	return ""
+}
+
+func (x *PeerStateChange) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PeerStateChange) GetRemoteBgpId() string {
+	if x != nil {
+		return x.RemoteBgpId
+	}
+	return ""
+}
+
+func (x *PeerStateChange) GetRouterIp() string {
+	if x != nil {
+		return x.RouterIp
+	}
+	return ""
+}
+
+func (x *PeerStateChange) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *PeerStateChange) GetRemoteAsn() uint32 {
+	if x != nil {
+		return x.RemoteAsn
+	}
+	return 0
+}
+
+func (x *PeerStateChange) GetRemoteIp() string {
+	if x != nil {
+		return x.RemoteIp
+	}
+	return ""
+}
+
+func (x *PeerStateChange) GetPeerType() uint32 {
+	if x != nil {
+		return x.PeerType
+	}
+	return 0
+}
+
+func (x *PeerStateChange) GetPeerRd() string {
+	if x != nil {
+		return x.PeerRd
+	}
+	return ""
+}
+
+func (x *PeerStateChange) GetRemotePort() int64 {
+	if x != nil {
+		return x.RemotePort
+	}
+	return 0
+}
+
+func (x *PeerStateChange) GetLocalAsn() uint32 {
+	if x != nil {
+		return x.LocalAsn
+	}
+	return 0
+}
+
+func (x *PeerStateChange) GetLocalIp() string {
+	if x != nil {
+		return x.LocalIp
+	}
+	return ""
+}
+
+func (x *PeerStateChange) GetLocalPort() int64 {
+	if x != nil {
+		return x.LocalPort
+	}
+	return 0
+}
+
+func (x *PeerStateChange) GetLocalBgpId() string {
+	if x != nil {
+		return x.LocalBgpId
+	}
+	return ""
+}
+
+func (x *PeerStateChange) GetInfoData() []byte {
+	if x != nil {
+		return x.InfoData
+	}
+	return nil
+}
+
+func (x *PeerStateChange) GetAdvCap() map[uint32]*structpb.ListValue {
+	if x != nil {
+		return x.AdvCap
+	}
+	return nil
+}
+
+func (x *PeerStateChange) GetRecvCap() map[uint32]*structpb.ListValue {
+	if x != nil {
+		return x.RecvCap
+	}
+	return nil
+}
+
+func (x *PeerStateChange) GetRemoteHolddown() int64 {
+	if x != nil {
+		return x.RemoteHolddown
+	}
+	return 0
+}
+
+func (x *PeerStateChange) GetAdvHolddown() int64 {
+	if x != nil {
+		return x.AdvHolddown
+	}
+	return 0
+}
+
+func (x *PeerStateChange) GetBmpReason() int64 {
+	if x != nil {
+		return x.BmpReason
+	}
+	return 0
+}
+
+func (x *PeerStateChange) GetBmpErrorCode() int64 {
+	if x != nil {
+		return x.BmpErrorCode
+	}
+	return 0
+}
+
+func (x *PeerStateChange) GetBmpErrorSubCode() int64 {
+	if x != nil {
+		return x.BmpErrorSubCode
+	}
+	return 0
+}
+
+func (x *PeerStateChange) GetErrorText() string {
+	if x != nil {
+		return x.ErrorText
+	}
+	return ""
+}
+
+func (x *PeerStateChange) GetIsL() bool {
+	if x != nil {
+		return x.IsL
+	}
+	return false
+}
+
+func (x *PeerStateChange) GetIsPrepolicy() bool {
+	if x != nil {
+		return x.IsPrepolicy
+	}
+	return false
+}
+
+func (x *PeerStateChange) GetIsIpv4() bool {
+	if x != nil {
+		return x.IsIpv4
+	}
+	return false
+}
+
+func (x *PeerStateChange) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+func (x *PeerStateChange) GetIsAdjRibInPostPolicy() bool {
+	if x != nil {
+		return x.IsAdjRibInPostPolicy
+	}
+	return false
+}
+
+func (x *PeerStateChange) GetIsAdjRibOutPostPolicy() bool {
+	if x != nil {
+		return x.IsAdjRibOutPostPolicy
+	}
+	return false
+}
+
+func (x *PeerStateChange) GetIsAdjRibOut() bool {
+	if x != nil {
+		return x.IsAdjRibOut
+	}
+	return false
+}
+
+func (x *PeerStateChange) GetIsLocRib() bool {
+	if x != nil {
+		return x.IsLocRib
+	}
+	return false
+}
+
+func (x *PeerStateChange) GetIsLocRibFiltered() bool {
+	if x != nil {
+		return x.IsLocRibFiltered
+	}
+	return false
+}
+
+func (x *PeerStateChange) GetPeerHash() string {
+	if x != nil {
+		return x.PeerHash
+	}
+	return ""
+}
+
+func (x *PeerStateChange) GetUserLabels() map[string]string {
+	if x != nil {
+		return x.UserLabels
+	}
+	return nil
+}
+
+func (x *PeerStateChange) GetRemoteAsName() string {
+	if x != nil {
+		return x.RemoteAsName
+	}
+	return ""
+}
+
+// CapabilityData mirrors bgp.CapabilityData.
+type CapabilityData struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CapabilityValue []byte                 `protobuf:"bytes,1,opt,name=capability_value,json=capabilityValue,proto3" json:"capability_value,omitempty"`
+	CapabilityDescr string                 `protobuf:"bytes,2,opt,name=capability_descr,json=capabilityDescr,proto3" json:"capability_descr,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CapabilityData) Reset() {
+	*x = CapabilityData{}
+	mi := &file_pkg_api_messages_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CapabilityData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CapabilityData) ProtoMessage() {}
+
+func (x *CapabilityData) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CapabilityData.ProtoReflect.Descriptor instead.
+func (*CapabilityData) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CapabilityData) GetCapabilityValue() []byte {
+	if x != nil {
+		return x.CapabilityValue
+	}
+	return nil
+}
+
+func (x *CapabilityData) GetCapabilityDescr() string {
+	if x != nil {
+		return x.CapabilityDescr
+	}
+	return ""
+}
+
+// UnicastPrefix mirrors message.UnicastPrefix.
+type UnicastPrefix struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Action                string                 `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	Sequence              int64                  `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Hash                  string                 `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	RouterHash            string                 `protobuf:"bytes,4,opt,name=router_hash,json=routerHash,proto3" json:"router_hash,omitempty"`
+	RouterIp              string                 `protobuf:"bytes,5,opt,name=router_ip,json=routerIp,proto3" json:"router_ip,omitempty"`
+	BaseAttrs             *BaseAttributes        `protobuf:"bytes,6,opt,name=base_attrs,json=baseAttrs,proto3" json:"base_attrs,omitempty"`
+	PeerHash              string                 `protobuf:"bytes,7,opt,name=peer_hash,json=peerHash,proto3" json:"peer_hash,omitempty"`
+	PeerIp                string                 `protobuf:"bytes,8,opt,name=peer_ip,json=peerIp,proto3" json:"peer_ip,omitempty"`
+	PeerType              uint32                 `protobuf:"varint,9,opt,name=peer_type,json=peerType,proto3" json:"peer_type,omitempty"`
+	PeerAsn               uint32                 `protobuf:"varint,10,opt,name=peer_asn,json=peerAsn,proto3" json:"peer_asn,omitempty"`
+	Timestamp             string                 `protobuf:"bytes,11,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Prefix                string                 `protobuf:"bytes,12,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	PrefixLen             int32                  `protobuf:"varint,13,opt,name=prefix_len,json=prefixLen,proto3" json:"prefix_len,omitempty"`
+	IsIpv4                bool                   `protobuf:"varint,14,opt,name=is_ipv4,json=isIpv4,proto3" json:"is_ipv4,omitempty"`
+	OriginAs              uint32                 `protobuf:"varint,15,opt,name=origin_as,json=originAs,proto3" json:"origin_as,omitempty"`
+	Nexthop               string                 `protobuf:"bytes,16,opt,name=nexthop,proto3" json:"nexthop,omitempty"`
+	IsNexthopIpv4         bool                   `protobuf:"varint,17,opt,name=is_nexthop_ipv4,json=isNexthopIpv4,proto3" json:"is_nexthop_ipv4,omitempty"`
+	PathId                int32                  `protobuf:"varint,18,opt,name=path_id,json=pathId,proto3" json:"path_id,omitempty"`
+	Labels                []uint32               `protobuf:"varint,19,rep,packed,name=labels,proto3" json:"labels,omitempty"`
+	Color                 *uint32                `protobuf:"varint,20,opt,name=color,proto3,oneof" json:"color,omitempty"`
+	OriginValidation      *string                `protobuf:"bytes,21,opt,name=origin_validation,json=originValidation,proto3,oneof" json:"origin_validation,omitempty"`
+	PrefixSid             *PSid                  `protobuf:"bytes,22,opt,name=prefix_sid,json=prefixSid,proto3" json:"prefix_sid,omitempty"`
+	IsEor                 bool                   `protobuf:"varint,23,opt,name=is_eor,json=isEor,proto3" json:"is_eor,omitempty"`
+	LeakSuspected         bool                   `protobuf:"varint,24,opt,name=leak_suspected,json=leakSuspected,proto3" json:"leak_suspected,omitempty"`
+	LeakReason            string                 `protobuf:"bytes,25,opt,name=leak_reason,json=leakReason,proto3" json:"leak_reason,omitempty"`
+	IsAdjRibInPostPolicy  bool                   `protobuf:"varint,26,opt,name=is_adj_rib_in_post_policy,json=isAdjRibInPostPolicy,proto3" json:"is_adj_rib_in_post_policy,omitempty"`
+	IsAdjRibOutPostPolicy bool                   `protobuf:"varint,27,opt,name=is_adj_rib_out_post_policy,json=isAdjRibOutPostPolicy,proto3" json:"is_adj_rib_out_post_policy,omitempty"`
+	IsAdjRibOut           bool                   `protobuf:"varint,28,opt,name=is_adj_rib_out,json=isAdjRibOut,proto3" json:"is_adj_rib_out,omitempty"`
+	IsLocRib              bool                   `protobuf:"varint,29,opt,name=is_loc_rib,json=isLocRib,proto3" json:"is_loc_rib,omitempty"`
+	IsLocRibFiltered      bool                   `protobuf:"varint,30,opt,name=is_loc_rib_filtered,json=isLocRibFiltered,proto3" json:"is_loc_rib_filtered,omitempty"`
+	TableName             string                 `protobuf:"bytes,31,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	UserLabels            map[string]string      `protobuf:"bytes,32,rep,name=user_labels,json=userLabels,proto3" json:"user_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	BaseAttrHash          string                 `protobuf:"bytes,33,opt,name=base_attr_hash,json=baseAttrHash,proto3" json:"base_attr_hash,omitempty"`
+	OriginAsName          string                 `protobuf:"bytes,34,opt,name=origin_as_name,json=originAsName,proto3" json:"origin_as_name,omitempty"`
+	OriginAsOrg           string                 `protobuf:"bytes,35,opt,name=origin_as_org,json=originAsOrg,proto3" json:"origin_as_org,omitempty"`
+	PeerAsName            string                 `protobuf:"bytes,36,opt,name=peer_as_name,json=peerAsName,proto3" json:"peer_as_name,omitempty"`
+	Country               string                 `protobuf:"bytes,37,opt,name=country,proto3" json:"country,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *UnicastPrefix) Reset() {
+	*x = UnicastPrefix{}
+	mi := &file_pkg_api_messages_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnicastPrefix) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnicastPrefix) ProtoMessage() {}
+
+func (x *UnicastPrefix) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnicastPrefix.ProtoReflect.Descriptor instead.
+func (*UnicastPrefix) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UnicastPrefix) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *UnicastPrefix) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *UnicastPrefix) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *UnicastPrefix) GetRouterHash() string {
+	if x != nil {
+		return x.RouterHash
+	}
+	return ""
+}
+
+func (x *UnicastPrefix) GetRouterIp() string {
+	if x != nil {
+		return x.RouterIp
+	}
+	return ""
+}
+
+func (x *UnicastPrefix) GetBaseAttrs() *BaseAttributes {
+	if x != nil {
+		return x.BaseAttrs
+	}
+	return nil
+}
+
+func (x *UnicastPrefix) GetPeerHash() string {
+	if x != nil {
+		return x.PeerHash
+	}
+	return ""
+}
+
+func (x *UnicastPrefix) GetPeerIp() string {
+	if x != nil {
+		return x.PeerIp
+	}
+	return ""
+}
+
+func (x *UnicastPrefix) GetPeerType() uint32 {
+	if x != nil {
+		return x.PeerType
+	}
+	return 0
+}
+
+func (x *UnicastPrefix) GetPeerAsn() uint32 {
+	if x != nil {
+		return x.PeerAsn
+	}
+	return 0
+}
+
+func (x *UnicastPrefix) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *UnicastPrefix) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *UnicastPrefix) GetPrefixLen() int32 {
+	if x != nil {
+		return x.PrefixLen
+	}
+	return 0
+}
+
+func (x *UnicastPrefix) GetIsIpv4() bool {
+	if x != nil {
+		return x.IsIpv4
+	}
+	return false
+}
+
+func (x *UnicastPrefix) GetOriginAs() uint32 {
+	if x != nil {
+		return x.OriginAs
+	}
+	return 0
+}
+
+func (x *UnicastPrefix) GetNexthop() string {
+	if x != nil {
+		return x.Nexthop
+	}
+	return ""
+}
+
+func (x *UnicastPrefix) GetIsNexthopIpv4() bool {
+	if x != nil {
+		return x.IsNexthopIpv4
+	}
+	return false
+}
+
+func (x *UnicastPrefix) GetPathId() int32 {
+	if x != nil {
+		return x.PathId
+	}
+	return 0
+}
+
+func (x *UnicastPrefix) GetLabels() []uint32 {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *UnicastPrefix) GetColor() uint32 {
+	if x != nil && x.Color != nil {
+		return *x.Color
+	}
+	return 0
+}
+
+func (x *UnicastPrefix) GetOriginValidation() string {
+	if x != nil && x.OriginValidation != nil {
+		return *x.OriginValidation
+	}
+	return ""
+}
+
+func (x *UnicastPrefix) GetPrefixSid() *PSid {
+	if x != nil {
+		return x.PrefixSid
+	}
+	return nil
+}
+
+func (x *UnicastPrefix) GetIsEor() bool {
+	if x != nil {
+		return x.IsEor
+	}
+	return false
+}
+
+func (x *UnicastPrefix) GetLeakSuspected() bool {
+	if x != nil {
+		return x.LeakSuspected
+	}
+	return false
+}
+
+func (x *UnicastPrefix) GetLeakReason() string {
+	if x != nil {
+		return x.LeakReason
+	}
+	return ""
+}
+
+func (x *UnicastPrefix) GetIsAdjRibInPostPolicy() bool {
+	if x != nil {
+		return x.IsAdjRibInPostPolicy
+	}
+	return false
+}
+
+func (x *UnicastPrefix) GetIsAdjRibOutPostPolicy() bool {
+	if x != nil {
+		return x.IsAdjRibOutPostPolicy
+	}
+	return false
+}
+
+func (x *UnicastPrefix) GetIsAdjRibOut() bool {
+	if x != nil {
+		return x.IsAdjRibOut
+	}
+	return false
+}
+
+func (x *UnicastPrefix) GetIsLocRib() bool {
+	if x != nil {
+		return x.IsLocRib
+	}
+	return false
+}
+
+func (x *UnicastPrefix) GetIsLocRibFiltered() bool {
+	if x != nil {
+		return x.IsLocRibFiltered
+	}
+	return false
+}
+
+func (x *UnicastPrefix) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+func (x *UnicastPrefix) GetUserLabels() map[string]string {
+	if x != nil {
+		return x.UserLabels
+	}
+	return nil
+}
+
+func (x *UnicastPrefix) GetBaseAttrHash() string {
+	if x != nil {
+		return x.BaseAttrHash
+	}
+	return ""
+}
+
+func (x *UnicastPrefix) GetOriginAsName() string {
+	if x != nil {
+		return x.OriginAsName
+	}
+	return ""
+}
+
+func (x *UnicastPrefix) GetOriginAsOrg() string {
+	if x != nil {
+		return x.OriginAsOrg
+	}
+	return ""
+}
+
+func (x *UnicastPrefix) GetPeerAsName() string {
+	if x != nil {
+		return x.PeerAsName
+	}
+	return ""
+}
+
+func (x *UnicastPrefix) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+// BaseAttributes mirrors bgp.BaseAttributes.
+type BaseAttributes struct {
+	state                  protoimpl.MessageState  `protogen:"open.v1"`
+	BaseAttrHash           string                  `protobuf:"bytes,1,opt,name=base_attr_hash,json=baseAttrHash,proto3" json:"base_attr_hash,omitempty"`
+	Origin                 string                  `protobuf:"bytes,2,opt,name=origin,proto3" json:"origin,omitempty"`
+	AsPath                 []uint32                `protobuf:"varint,3,rep,packed,name=as_path,json=asPath,proto3" json:"as_path,omitempty"`
+	AsPathCount            int32                   `protobuf:"varint,4,opt,name=as_path_count,json=asPathCount,proto3" json:"as_path_count,omitempty"`
+	AsPathSegments         []*ASPathSegment        `protobuf:"bytes,5,rep,name=as_path_segments,json=asPathSegments,proto3" json:"as_path_segments,omitempty"`
+	Nexthop                string                  `protobuf:"bytes,6,opt,name=nexthop,proto3" json:"nexthop,omitempty"`
+	Med                    uint32                  `protobuf:"varint,7,opt,name=med,proto3" json:"med,omitempty"`
+	LocalPref              uint32                  `protobuf:"varint,8,opt,name=local_pref,json=localPref,proto3" json:"local_pref,omitempty"`
+	IsAtomicAgg            bool                    `protobuf:"varint,9,opt,name=is_atomic_agg,json=isAtomicAgg,proto3" json:"is_atomic_agg,omitempty"`
+	Aggregator             []byte                  `protobuf:"bytes,10,opt,name=aggregator,proto3" json:"aggregator,omitempty"`
+	CommunityList          []string                `protobuf:"bytes,11,rep,name=community_list,json=communityList,proto3" json:"community_list,omitempty"`
+	WellKnownCommunityList []string                `protobuf:"bytes,12,rep,name=well_known_community_list,json=wellKnownCommunityList,proto3" json:"well_known_community_list,omitempty"`
+	OriginatorId           string                  `protobuf:"bytes,13,opt,name=originator_id,json=originatorId,proto3" json:"originator_id,omitempty"`
+	ClusterList            string                  `protobuf:"bytes,14,opt,name=cluster_list,json=clusterList,proto3" json:"cluster_list,omitempty"`
+	ExtCommunityList       []string                `protobuf:"bytes,15,rep,name=ext_community_list,json=extCommunityList,proto3" json:"ext_community_list,omitempty"`
+	ExtCommunities         []*ExtCommunityDetail   `protobuf:"bytes,16,rep,name=ext_communities,json=extCommunities,proto3" json:"ext_communities,omitempty"`
+	As4Path                []uint32                `protobuf:"varint,17,rep,packed,name=as4_path,json=as4Path,proto3" json:"as4_path,omitempty"`
+	As4PathCount           int32                   `protobuf:"varint,18,opt,name=as4_path_count,json=as4PathCount,proto3" json:"as4_path_count,omitempty"`
+	As4Aggregator          []byte                  `protobuf:"bytes,19,opt,name=as4_aggregator,json=as4Aggregator,proto3" json:"as4_aggregator,omitempty"`
+	PmsiTunnel             *PMSITunnel             `protobuf:"bytes,20,opt,name=pmsi_tunnel,json=pmsiTunnel,proto3" json:"pmsi_tunnel,omitempty"`
+	TunnelEncapAttr        []byte                  `protobuf:"bytes,21,opt,name=tunnel_encap_attr,json=tunnelEncapAttr,proto3" json:"tunnel_encap_attr,omitempty"`
+	TunnelEncap            *TunnelEncapsulation    `protobuf:"bytes,22,opt,name=tunnel_encap,json=tunnelEncap,proto3" json:"tunnel_encap,omitempty"`
+	TunnelEncapMalformed   bool                    `protobuf:"varint,23,opt,name=tunnel_encap_malformed,json=tunnelEncapMalformed,proto3" json:"tunnel_encap_malformed,omitempty"`
+	Ipv6ExtCommunityList   []string                `protobuf:"bytes,24,rep,name=ipv6_ext_community_list,json=ipv6ExtCommunityList,proto3" json:"ipv6_ext_community_list,omitempty"`
+	Ipv6ExtCommunities     []*ExtCommunityDetail   `protobuf:"bytes,25,rep,name=ipv6_ext_communities,json=ipv6ExtCommunities,proto3" json:"ipv6_ext_communities,omitempty"`
+	Aigp                   *AIGP                   `protobuf:"bytes,26,opt,name=aigp,proto3" json:"aigp,omitempty"`
+	LargeCommunityList     []string                `protobuf:"bytes,27,rep,name=large_community_list,json=largeCommunityList,proto3" json:"large_community_list,omitempty"`
+	BgpPrefixSid           *BGPPrefixSID           `protobuf:"bytes,28,opt,name=bgp_prefix_sid,json=bgpPrefixSid,proto3" json:"bgp_prefix_sid,omitempty"`
+	Otc                    uint32                  `protobuf:"varint,29,opt,name=otc,proto3" json:"otc,omitempty"`
+	DPath                  *DPath                  `protobuf:"bytes,30,opt,name=d_path,json=dPath,proto3" json:"d_path,omitempty"`
+	Sfp                    *SFP                    `protobuf:"bytes,31,opt,name=sfp,proto3" json:"sfp,omitempty"`
+	BfdDiscriminator       *BFDDiscriminator       `protobuf:"bytes,32,opt,name=bfd_discriminator,json=bfdDiscriminator,proto3" json:"bfd_discriminator,omitempty"`
+	AttrSet                *AttrSet                `protobuf:"bytes,33,opt,name=attr_set,json=attrSet,proto3" json:"attr_set,omitempty"`
+	UnknownAttributes      []*UnknownPathAttribute `protobuf:"bytes,34,rep,name=unknown_attributes,json=unknownAttributes,proto3" json:"unknown_attributes,omitempty"`
+	AttrErrors             []*PathAttributeError   `protobuf:"bytes,35,rep,name=attr_errors,json=attrErrors,proto3" json:"attr_errors,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *BaseAttributes) Reset() {
+	*x = BaseAttributes{}
+	mi := &file_pkg_api_messages_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BaseAttributes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BaseAttributes) ProtoMessage() {}
+
+func (x *BaseAttributes) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BaseAttributes.ProtoReflect.Descriptor instead.
+func (*BaseAttributes) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BaseAttributes) GetBaseAttrHash() string {
+	if x != nil {
+		return x.BaseAttrHash
+	}
+	return ""
+}
+
+func (x *BaseAttributes) GetOrigin() string {
+	if x != nil {
+		return x.Origin
+	}
+	return ""
+}
+
+func (x *BaseAttributes) GetAsPath() []uint32 {
+	if x != nil {
+		return x.AsPath
+	}
+	return nil
+}
+
+func (x *BaseAttributes) GetAsPathCount() int32 {
+	if x != nil {
+		return x.AsPathCount
+	}
+	return 0
+}
+
+func (x *BaseAttributes) GetAsPathSegments() []*ASPathSegment {
+	if x != nil {
+		return x.AsPathSegments
+	}
+	return nil
+}
+
+func (x *BaseAttributes) GetNexthop() string {
+	if x != nil {
+		return x.Nexthop
+	}
+	return ""
+}
+
+func (x *BaseAttributes) GetMed() uint32 {
+	if x != nil {
+		return x.Med
+	}
+	return 0
+}
+
+func (x *BaseAttributes) GetLocalPref() uint32 {
+	if x != nil {
+		return x.LocalPref
+	}
+	return 0
+}
+
+func (x *BaseAttributes) GetIsAtomicAgg() bool {
+	if x != nil {
+		return x.IsAtomicAgg
+	}
+	return false
+}
+
+func (x *BaseAttributes) GetAggregator() []byte {
+	if x != nil {
+		return x.Aggregator
+	}
+	return nil
+}
+
+func (x *BaseAttributes) GetCommunityList() []string {
+	if x != nil {
+		return x.CommunityList
+	}
+	return nil
+}
+
+func (x *BaseAttributes) GetWellKnownCommunityList() []string {
+	if x != nil {
+		return x.WellKnownCommunityList
+	}
+	return nil
+}
+
+func (x *BaseAttributes) GetOriginatorId() string {
+	if x != nil {
+		return x.OriginatorId
+	}
+	return ""
+}
+
+func (x *BaseAttributes) GetClusterList() string {
+	if x != nil {
+		return x.ClusterList
+	}
+	return ""
+}
+
+func (x *BaseAttributes) GetExtCommunityList() []string {
+	if x != nil {
+		return x.ExtCommunityList
+	}
+	return nil
+}
+
+func (x *BaseAttributes) GetExtCommunities() []*ExtCommunityDetail {
+	if x != nil {
+		return x.ExtCommunities
+	}
+	return nil
+}
+
+func (x *BaseAttributes) GetAs4Path() []uint32 {
+	if x != nil {
+		return x.As4Path
+	}
+	return nil
+}
+
+func (x *BaseAttributes) GetAs4PathCount() int32 {
+	if x != nil {
+		return x.As4PathCount
+	}
+	return 0
+}
+
+func (x *BaseAttributes) GetAs4Aggregator() []byte {
+	if x != nil {
+		return x.As4Aggregator
+	}
+	return nil
+}
+
+func (x *BaseAttributes) GetPmsiTunnel() *PMSITunnel {
+	if x != nil {
+		return x.PmsiTunnel
+	}
+	return nil
+}
+
+func (x *BaseAttributes) GetTunnelEncapAttr() []byte {
+	if x != nil {
+		return x.TunnelEncapAttr
+	}
+	return nil
+}
+
+func (x *BaseAttributes) GetTunnelEncap() *TunnelEncapsulation {
+	if x != nil {
+		return x.TunnelEncap
+	}
+	return nil
+}
+
+func (x *BaseAttributes) GetTunnelEncapMalformed() bool {
+	if x != nil {
+		return x.TunnelEncapMalformed
+	}
+	return false
+}
+
+func (x *BaseAttributes) GetIpv6ExtCommunityList() []string {
+	if x != nil {
+		return x.Ipv6ExtCommunityList
+	}
+	return nil
+}
+
+func (x *BaseAttributes) GetIpv6ExtCommunities() []*ExtCommunityDetail {
+	if x != nil {
+		return x.Ipv6ExtCommunities
+	}
+	return nil
+}
+
+func (x *BaseAttributes) GetAigp() *AIGP {
+	if x != nil {
+		return x.Aigp
+	}
+	return nil
+}
+
+func (x *BaseAttributes) GetLargeCommunityList() []string {
+	if x != nil {
+		return x.LargeCommunityList
+	}
+	return nil
+}
+
+func (x *BaseAttributes) GetBgpPrefixSid() *BGPPrefixSID {
+	if x != nil {
+		return x.BgpPrefixSid
+	}
+	return nil
+}
+
+func (x *BaseAttributes) GetOtc() uint32 {
+	if x != nil {
+		return x.Otc
+	}
+	return 0
+}
+
+func (x *BaseAttributes) GetDPath() *DPath {
+	if x != nil {
+		return x.DPath
+	}
+	return nil
+}
+
+func (x *BaseAttributes) GetSfp() *SFP {
+	if x != nil {
+		return x.Sfp
+	}
+	return nil
+}
+
+func (x *BaseAttributes) GetBfdDiscriminator() *BFDDiscriminator {
+	if x != nil {
+		return x.BfdDiscriminator
+	}
+	return nil
+}
+
+func (x *BaseAttributes) GetAttrSet() *AttrSet {
+	if x != nil {
+		return x.AttrSet
+	}
+	return nil
+}
+
+func (x *BaseAttributes) GetUnknownAttributes() []*UnknownPathAttribute {
+	if x != nil {
+		return x.UnknownAttributes
+	}
+	return nil
+}
+
+func (x *BaseAttributes) GetAttrErrors() []*PathAttributeError {
+	if x != nil {
+		return x.AttrErrors
+	}
+	return nil
+}
+
+// ASPathSegment mirrors bgp.ASPathSegment.
+type ASPathSegment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          uint32                 `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	Asns          []uint32               `protobuf:"varint,2,rep,packed,name=asns,proto3" json:"asns,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ASPathSegment) Reset() {
+	*x = ASPathSegment{}
+	mi := &file_pkg_api_messages_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ASPathSegment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ASPathSegment) ProtoMessage() {}
+
+func (x *ASPathSegment) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ASPathSegment.ProtoReflect.Descriptor instead.
+func (*ASPathSegment) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ASPathSegment) GetType() uint32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *ASPathSegment) GetAsns() []uint32 {
+	if x != nil {
+		return x.Asns
+	}
+	return nil
+}
+
+// ExtCommunityDetail mirrors bgp.ExtCommunityDetail.
+type ExtCommunityDetail struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          uint32                 `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	Subtype       *uint32                `protobuf:"varint,2,opt,name=subtype,proto3,oneof" json:"subtype,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Value         string                 `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	Decoded       *structpb.Value        `protobuf:"bytes,5,opt,name=decoded,proto3" json:"decoded,omitempty"`
+	Raw           string                 `protobuf:"bytes,6,opt,name=raw,proto3" json:"raw,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExtCommunityDetail) Reset() {
+	*x = ExtCommunityDetail{}
+	mi := &file_pkg_api_messages_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExtCommunityDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtCommunityDetail) ProtoMessage() {}
+
+func (x *ExtCommunityDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtCommunityDetail.ProtoReflect.Descriptor instead.
+func (*ExtCommunityDetail) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ExtCommunityDetail) GetType() uint32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *ExtCommunityDetail) GetSubtype() uint32 {
+	if x != nil && x.Subtype != nil {
+		return *x.Subtype
+	}
+	return 0
+}
+
+func (x *ExtCommunityDetail) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ExtCommunityDetail) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *ExtCommunityDetail) GetDecoded() *structpb.Value {
+	if x != nil {
+		return x.Decoded
+	}
+	return nil
+}
+
+func (x *ExtCommunityDetail) GetRaw() string {
+	if x != nil {
+		return x.Raw
+	}
+	return ""
+}
+
+// PMSITunnel mirrors pmsi.PMSITunnel.
+type PMSITunnel struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Flags            uint32                 `protobuf:"varint,1,opt,name=flags,proto3" json:"flags,omitempty"`
+	TunnelType       uint32                 `protobuf:"varint,2,opt,name=tunnel_type,json=tunnelType,proto3" json:"tunnel_type,omitempty"`
+	MplsLabel        *uint32                `protobuf:"varint,3,opt,name=mpls_label,json=mplsLabel,proto3,oneof" json:"mpls_label,omitempty"`
+	TunnelIdentifier []byte                 `protobuf:"bytes,4,opt,name=tunnel_identifier,json=tunnelIdentifier,proto3" json:"tunnel_identifier,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *PMSITunnel) Reset() {
+	*x = PMSITunnel{}
+	mi := &file_pkg_api_messages_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PMSITunnel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PMSITunnel) ProtoMessage() {}
+
+func (x *PMSITunnel) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PMSITunnel.ProtoReflect.Descriptor instead.
+func (*PMSITunnel) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PMSITunnel) GetFlags() uint32 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+func (x *PMSITunnel) GetTunnelType() uint32 {
+	if x != nil {
+		return x.TunnelType
+	}
+	return 0
+}
+
+func (x *PMSITunnel) GetMplsLabel() uint32 {
+	if x != nil && x.MplsLabel != nil {
+		return *x.MplsLabel
+	}
+	return 0
+}
+
+func (x *PMSITunnel) GetTunnelIdentifier() []byte {
+	if x != nil {
+		return x.TunnelIdentifier
+	}
+	return nil
+}
+
+// TunnelEncapsulation mirrors tunnel.TunnelEncapsulation.
+type TunnelEncapsulation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tunnels       []*Tunnel              `protobuf:"bytes,1,rep,name=tunnels,proto3" json:"tunnels,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TunnelEncapsulation) Reset() {
+	*x = TunnelEncapsulation{}
+	mi := &file_pkg_api_messages_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TunnelEncapsulation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TunnelEncapsulation) ProtoMessage() {}
+
+func (x *TunnelEncapsulation) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TunnelEncapsulation.ProtoReflect.Descriptor instead.
+func (*TunnelEncapsulation) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *TunnelEncapsulation) GetTunnels() []*Tunnel {
+	if x != nil {
+		return x.Tunnels
+	}
+	return nil
+}
+
+// Tunnel mirrors tunnel.Tunnel.
+type Tunnel struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          uint32                 `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	TypeName      string                 `protobuf:"bytes,2,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"`
+	Length        uint32                 `protobuf:"varint,3,opt,name=length,proto3" json:"length,omitempty"`
+	SubTlvs       []*TunnelSubTLV        `protobuf:"bytes,4,rep,name=sub_tlvs,json=subTlvs,proto3" json:"sub_tlvs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Tunnel) Reset() {
+	*x = Tunnel{}
+	mi := &file_pkg_api_messages_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tunnel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tunnel) ProtoMessage() {}
+
+func (x *Tunnel) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tunnel.ProtoReflect.Descriptor instead.
+func (*Tunnel) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Tunnel) GetType() uint32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *Tunnel) GetTypeName() string {
+	if x != nil {
+		return x.TypeName
+	}
+	return ""
+}
+
+func (x *Tunnel) GetLength() uint32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *Tunnel) GetSubTlvs() []*TunnelSubTLV {
+	if x != nil {
+		return x.SubTlvs
+	}
+	return nil
+}
+
+// TunnelSubTLV mirrors tunnel.SubTLV.
+type TunnelSubTLV struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          uint32                 `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	TypeName      string                 `protobuf:"bytes,2,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"`
+	Length        uint32                 `protobuf:"varint,3,opt,name=length,proto3" json:"length,omitempty"`
+	Value         *structpb.Value        `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TunnelSubTLV) Reset() {
+	*x = TunnelSubTLV{}
+	mi := &file_pkg_api_messages_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TunnelSubTLV) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TunnelSubTLV) ProtoMessage() {}
+
+func (x *TunnelSubTLV) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TunnelSubTLV.ProtoReflect.Descriptor instead.
+func (*TunnelSubTLV) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *TunnelSubTLV) GetType() uint32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *TunnelSubTLV) GetTypeName() string {
+	if x != nil {
+		return x.TypeName
+	}
+	return ""
+}
+
+func (x *TunnelSubTLV) GetLength() uint32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *TunnelSubTLV) GetValue() *structpb.Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+// AIGP mirrors bgp.AIGP.
+type AIGP struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tlvs          []*AIGPTLV             `protobuf:"bytes,1,rep,name=tlvs,proto3" json:"tlvs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AIGP) Reset() {
+	*x = AIGP{}
+	mi := &file_pkg_api_messages_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AIGP) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AIGP) ProtoMessage() {}
+
+func (x *AIGP) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AIGP.ProtoReflect.Descriptor instead.
+func (*AIGP) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AIGP) GetTlvs() []*AIGPTLV {
+	if x != nil {
+		return x.Tlvs
+	}
+	return nil
+}
+
+// AIGPTLV mirrors bgp.AIGPTLV.
+type AIGPTLV struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          uint32                 `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	Length        uint32                 `protobuf:"varint,2,opt,name=length,proto3" json:"length,omitempty"`
+	Value         uint64                 `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AIGPTLV) Reset() {
+	*x = AIGPTLV{}
+	mi := &file_pkg_api_messages_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AIGPTLV) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AIGPTLV) ProtoMessage() {}
+
+func (x *AIGPTLV) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AIGPTLV.ProtoReflect.Descriptor instead.
+func (*AIGPTLV) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *AIGPTLV) GetType() uint32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *AIGPTLV) GetLength() uint32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *AIGPTLV) GetValue() uint64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+// BGPPrefixSID mirrors bgp.BGPPrefixSID.
+type BGPPrefixSID struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tlvs          []*BGPPrefixSIDTLV     `protobuf:"bytes,1,rep,name=tlvs,proto3" json:"tlvs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BGPPrefixSID) Reset() {
+	*x = BGPPrefixSID{}
+	mi := &file_pkg_api_messages_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BGPPrefixSID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BGPPrefixSID) ProtoMessage() {}
+
+func (x *BGPPrefixSID) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BGPPrefixSID.ProtoReflect.Descriptor instead.
+func (*BGPPrefixSID) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *BGPPrefixSID) GetTlvs() []*BGPPrefixSIDTLV {
+	if x != nil {
+		return x.Tlvs
+	}
+	return nil
+}
+
+// BGPPrefixSIDTLV mirrors bgp.BGPPrefixSIDTLV.
+type BGPPrefixSIDTLV struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Type           uint32                 `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	Length         uint32                 `protobuf:"varint,2,opt,name=length,proto3" json:"length,omitempty"`
+	LabelIndex     *BGPLabelIndexTLV      `protobuf:"bytes,3,opt,name=label_index,json=labelIndex,proto3" json:"label_index,omitempty"`
+	OriginatorSrgb *BGPOriginatorSRGBTLV  `protobuf:"bytes,4,opt,name=originator_srgb,json=originatorSrgb,proto3" json:"originator_srgb,omitempty"`
+	UnknownValue   []byte                 `protobuf:"bytes,5,opt,name=unknown_value,json=unknownValue,proto3" json:"unknown_value,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *BGPPrefixSIDTLV) Reset() {
+	*x = BGPPrefixSIDTLV{}
+	mi := &file_pkg_api_messages_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BGPPrefixSIDTLV) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BGPPrefixSIDTLV) ProtoMessage() {}
+
+func (x *BGPPrefixSIDTLV) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BGPPrefixSIDTLV.ProtoReflect.Descriptor instead.
+func (*BGPPrefixSIDTLV) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *BGPPrefixSIDTLV) GetType() uint32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *BGPPrefixSIDTLV) GetLength() uint32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *BGPPrefixSIDTLV) GetLabelIndex() *BGPLabelIndexTLV {
+	if x != nil {
+		return x.LabelIndex
+	}
+	return nil
+}
+
+func (x *BGPPrefixSIDTLV) GetOriginatorSrgb() *BGPOriginatorSRGBTLV {
+	if x != nil {
+		return x.OriginatorSrgb
+	}
+	return nil
+}
+
+func (x *BGPPrefixSIDTLV) GetUnknownValue() []byte {
+	if x != nil {
+		return x.UnknownValue
+	}
+	return nil
+}
+
+// BGPLabelIndexTLV mirrors bgp.LabelIndexTLV.
+type BGPLabelIndexTLV struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Flags         uint32                 `protobuf:"varint,1,opt,name=flags,proto3" json:"flags,omitempty"`
+	LabelIndex    uint32                 `protobuf:"varint,2,opt,name=label_index,json=labelIndex,proto3" json:"label_index,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BGPLabelIndexTLV) Reset() {
+	*x = BGPLabelIndexTLV{}
+	mi := &file_pkg_api_messages_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BGPLabelIndexTLV) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BGPLabelIndexTLV) ProtoMessage() {}
+
+func (x *BGPLabelIndexTLV) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BGPLabelIndexTLV.ProtoReflect.Descriptor instead.
+func (*BGPLabelIndexTLV) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *BGPLabelIndexTLV) GetFlags() uint32 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+func (x *BGPLabelIndexTLV) GetLabelIndex() uint32 {
+	if x != nil {
+		return x.LabelIndex
+	}
+	return 0
+}
+
+// BGPOriginatorSRGBTLV mirrors bgp.OriginatorSRGBTLV.
+type BGPOriginatorSRGBTLV struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Flags         uint32                 `protobuf:"varint,1,opt,name=flags,proto3" json:"flags,omitempty"`
+	Ranges        []*SRGBRange           `protobuf:"bytes,2,rep,name=ranges,proto3" json:"ranges,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BGPOriginatorSRGBTLV) Reset() {
+	*x = BGPOriginatorSRGBTLV{}
+	mi := &file_pkg_api_messages_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BGPOriginatorSRGBTLV) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BGPOriginatorSRGBTLV) ProtoMessage() {}
+
+func (x *BGPOriginatorSRGBTLV) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BGPOriginatorSRGBTLV.ProtoReflect.Descriptor instead.
+func (*BGPOriginatorSRGBTLV) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *BGPOriginatorSRGBTLV) GetFlags() uint32 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+func (x *BGPOriginatorSRGBTLV) GetRanges() []*SRGBRange {
+	if x != nil {
+		return x.Ranges
+	}
+	return nil
+}
+
+// SRGBRange mirrors bgp.SRGBRange.
+type SRGBRange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          uint32                 `protobuf:"varint,1,opt,name=base,proto3" json:"base,omitempty"`
+	Range         uint32                 `protobuf:"varint,2,opt,name=range,proto3" json:"range,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SRGBRange) Reset() {
+	*x = SRGBRange{}
+	mi := &file_pkg_api_messages_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SRGBRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SRGBRange) ProtoMessage() {}
+
+func (x *SRGBRange) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SRGBRange.ProtoReflect.Descriptor instead.
+func (*SRGBRange) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SRGBRange) GetBase() uint32 {
+	if x != nil {
+		return x.Base
+	}
+	return 0
+}
+
+func (x *SRGBRange) GetRange() uint32 {
+	if x != nil {
+		return x.Range
+	}
+	return 0
+}
+
+// DPath mirrors bgp.DPath.
+type DPath struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Segments      []*DPathSegment        `protobuf:"bytes,1,rep,name=segments,proto3" json:"segments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DPath) Reset() {
+	*x = DPath{}
+	mi := &file_pkg_api_messages_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DPath) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DPath) ProtoMessage() {}
+
+func (x *DPath) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DPath.ProtoReflect.Descriptor instead.
+func (*DPath) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DPath) GetSegments() []*DPathSegment {
+	if x != nil {
+		return x.Segments
+	}
+	return nil
+}
+
+// DPathSegment mirrors bgp.DPathSegment.
+type DPathSegment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          uint32                 `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	Domains       []*DPathDomain         `protobuf:"bytes,2,rep,name=domains,proto3" json:"domains,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DPathSegment) Reset() {
+	*x = DPathSegment{}
+	mi := &file_pkg_api_messages_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DPathSegment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DPathSegment) ProtoMessage() {}
+
+func (x *DPathSegment) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DPathSegment.ProtoReflect.Descriptor instead.
+func (*DPathSegment) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DPathSegment) GetType() uint32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *DPathSegment) GetDomains() []*DPathDomain {
+	if x != nil {
+		return x.Domains
+	}
+	return nil
+}
+
+// DPathDomain mirrors bgp.DPathDomain.
+type DPathDomain struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GlobalAdmin   uint32                 `protobuf:"varint,1,opt,name=global_admin,json=globalAdmin,proto3" json:"global_admin,omitempty"`
+	LocalAdmin    uint32                 `protobuf:"varint,2,opt,name=local_admin,json=localAdmin,proto3" json:"local_admin,omitempty"`
+	IsfSafiType   uint32                 `protobuf:"varint,3,opt,name=isf_safi_type,json=isfSafiType,proto3" json:"isf_safi_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DPathDomain) Reset() {
+	*x = DPathDomain{}
+	mi := &file_pkg_api_messages_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DPathDomain) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DPathDomain) ProtoMessage() {}
+
+func (x *DPathDomain) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DPathDomain.ProtoReflect.Descriptor instead.
+func (*DPathDomain) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DPathDomain) GetGlobalAdmin() uint32 {
+	if x != nil {
+		return x.GlobalAdmin
+	}
+	return 0
+}
+
+func (x *DPathDomain) GetLocalAdmin() uint32 {
+	if x != nil {
+		return x.LocalAdmin
+	}
+	return 0
+}
+
+func (x *DPathDomain) GetIsfSafiType() uint32 {
+	if x != nil {
+		return x.IsfSafiType
+	}
+	return 0
+}
+
+// SFP mirrors bgp.SFP.
+type SFP struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Associations  []*SFPAssociation      `protobuf:"bytes,1,rep,name=associations,proto3" json:"associations,omitempty"`
+	Paths         []*SFPPath             `protobuf:"bytes,2,rep,name=paths,proto3" json:"paths,omitempty"`
+	Tlvs          []*SFPTLV              `protobuf:"bytes,3,rep,name=tlvs,proto3" json:"tlvs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SFP) Reset() {
+	*x = SFP{}
+	mi := &file_pkg_api_messages_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SFP) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SFP) ProtoMessage() {}
+
+func (x *SFP) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SFP.ProtoReflect.Descriptor instead.
+func (*SFP) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SFP) GetAssociations() []*SFPAssociation {
+	if x != nil {
+		return x.Associations
+	}
+	return nil
+}
+
+func (x *SFP) GetPaths() []*SFPPath {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+func (x *SFP) GetTlvs() []*SFPTLV {
+	if x != nil {
+		return x.Tlvs
+	}
+	return nil
+}
+
+// SFPAssociation mirrors bgp.SFPAssociation.
+type SFPAssociation struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	AssociationType  uint32                 `protobuf:"varint,1,opt,name=association_type,json=associationType,proto3" json:"association_type,omitempty"`
+	AssociatedSfprRd string                 `protobuf:"bytes,2,opt,name=associated_sfpr_rd,json=associatedSfprRd,proto3" json:"associated_sfpr_rd,omitempty"`
+	AssociatedSpi    uint32                 `protobuf:"varint,3,opt,name=associated_spi,json=associatedSpi,proto3" json:"associated_spi,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SFPAssociation) Reset() {
+	*x = SFPAssociation{}
+	mi := &file_pkg_api_messages_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SFPAssociation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SFPAssociation) ProtoMessage() {}
+
+func (x *SFPAssociation) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SFPAssociation.ProtoReflect.Descriptor instead.
+func (*SFPAssociation) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SFPAssociation) GetAssociationType() uint32 {
+	if x != nil {
+		return x.AssociationType
+	}
+	return 0
+}
+
+func (x *SFPAssociation) GetAssociatedSfprRd() string {
+	if x != nil {
+		return x.AssociatedSfprRd
+	}
+	return ""
+}
+
+func (x *SFPAssociation) GetAssociatedSpi() uint32 {
+	if x != nil {
+		return x.AssociatedSpi
+	}
+	return 0
+}
+
+// SFPPath mirrors bgp.SFPPath.
+type SFPPath struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Spi           uint32                 `protobuf:"varint,1,opt,name=spi,proto3" json:"spi,omitempty"`
+	Hops          []*SFPHop              `protobuf:"bytes,2,rep,name=hops,proto3" json:"hops,omitempty"`
+	SubTlvs       []*SFPTLV              `protobuf:"bytes,3,rep,name=sub_tlvs,json=subTlvs,proto3" json:"sub_tlvs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SFPPath) Reset() {
+	*x = SFPPath{}
+	mi := &file_pkg_api_messages_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SFPPath) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SFPPath) ProtoMessage() {}
+
+func (x *SFPPath) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SFPPath.ProtoReflect.Descriptor instead.
+func (*SFPPath) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SFPPath) GetSpi() uint32 {
+	if x != nil {
+		return x.Spi
+	}
+	return 0
+}
+
+func (x *SFPPath) GetHops() []*SFPHop {
+	if x != nil {
+		return x.Hops
+	}
+	return nil
+}
+
+func (x *SFPPath) GetSubTlvs() []*SFPTLV {
+	if x != nil {
+		return x.SubTlvs
+	}
+	return nil
+}
+
+// SFPHop mirrors bgp.SFPHop.
+type SFPHop struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServiceIndex  uint32                 `protobuf:"varint,1,opt,name=service_index,json=serviceIndex,proto3" json:"service_index,omitempty"`
+	Sfts          []*SFPSFT              `protobuf:"bytes,2,rep,name=sfts,proto3" json:"sfts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SFPHop) Reset() {
+	*x = SFPHop{}
+	mi := &file_pkg_api_messages_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SFPHop) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SFPHop) ProtoMessage() {}
+
+func (x *SFPHop) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SFPHop.ProtoReflect.Descriptor instead.
+func (*SFPHop) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SFPHop) GetServiceIndex() uint32 {
+	if x != nil {
+		return x.ServiceIndex
+	}
+	return 0
+}
+
+func (x *SFPHop) GetSfts() []*SFPSFT {
+	if x != nil {
+		return x.Sfts
+	}
+	return nil
+}
+
+// SFPSFT mirrors bgp.SFPSFT.
+type SFPSFT struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sft           uint32                 `protobuf:"varint,1,opt,name=sft,proto3" json:"sft,omitempty"`
+	SfirRds       []string               `protobuf:"bytes,2,rep,name=sfir_rds,json=sfirRds,proto3" json:"sfir_rds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SFPSFT) Reset() {
+	*x = SFPSFT{}
+	mi := &file_pkg_api_messages_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SFPSFT) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SFPSFT) ProtoMessage() {}
+
+func (x *SFPSFT) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SFPSFT.ProtoReflect.Descriptor instead.
+func (*SFPSFT) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SFPSFT) GetSft() uint32 {
+	if x != nil {
+		return x.Sft
+	}
+	return 0
+}
+
+func (x *SFPSFT) GetSfirRds() []string {
+	if x != nil {
+		return x.SfirRds
+	}
+	return nil
+}
+
+// SFPTLV mirrors bgp.SFPTLV.
+type SFPTLV struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          uint32                 `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	Value         []byte                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SFPTLV) Reset() {
+	*x = SFPTLV{}
+	mi := &file_pkg_api_messages_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SFPTLV) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SFPTLV) ProtoMessage() {}
+
+func (x *SFPTLV) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SFPTLV.ProtoReflect.Descriptor instead.
+func (*SFPTLV) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SFPTLV) GetType() uint32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *SFPTLV) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+// BFDDiscriminator mirrors bgp.BFDDiscriminator.
+type BFDDiscriminator struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mode          uint32                 `protobuf:"varint,1,opt,name=mode,proto3" json:"mode,omitempty"`
+	Discriminator uint32                 `protobuf:"varint,2,opt,name=discriminator,proto3" json:"discriminator,omitempty"`
+	SourceIp      string                 `protobuf:"bytes,3,opt,name=source_ip,json=sourceIp,proto3" json:"source_ip,omitempty"`
+	Tlvs          []*BFDDTLV             `protobuf:"bytes,4,rep,name=tlvs,proto3" json:"tlvs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BFDDiscriminator) Reset() {
+	*x = BFDDiscriminator{}
+	mi := &file_pkg_api_messages_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BFDDiscriminator) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BFDDiscriminator) ProtoMessage() {}
+
+func (x *BFDDiscriminator) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BFDDiscriminator.ProtoReflect.Descriptor instead.
+func (*BFDDiscriminator) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *BFDDiscriminator) GetMode() uint32 {
+	if x != nil {
+		return x.Mode
+	}
+	return 0
+}
+
+func (x *BFDDiscriminator) GetDiscriminator() uint32 {
+	if x != nil {
+		return x.Discriminator
+	}
+	return 0
+}
+
+func (x *BFDDiscriminator) GetSourceIp() string {
+	if x != nil {
+		return x.SourceIp
+	}
+	return ""
+}
+
+func (x *BFDDiscriminator) GetTlvs() []*BFDDTLV {
+	if x != nil {
+		return x.Tlvs
+	}
+	return nil
+}
+
+// BFDDTLV mirrors bgp.BFDDTLV.
+type BFDDTLV struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          uint32                 `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	Value         []byte                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BFDDTLV) Reset() {
+	*x = BFDDTLV{}
+	mi := &file_pkg_api_messages_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BFDDTLV) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BFDDTLV) ProtoMessage() {}
+
+func (x *BFDDTLV) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BFDDTLV.ProtoReflect.Descriptor instead.
+func (*BFDDTLV) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *BFDDTLV) GetType() uint32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *BFDDTLV) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+// AttrSet mirrors bgp.AttrSet.
+type AttrSet struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OriginAs       uint32                 `protobuf:"varint,1,opt,name=origin_as,json=originAs,proto3" json:"origin_as,omitempty"`
+	PathAttributes *BaseAttributes        `protobuf:"bytes,2,opt,name=path_attributes,json=pathAttributes,proto3" json:"path_attributes,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AttrSet) Reset() {
+	*x = AttrSet{}
+	mi := &file_pkg_api_messages_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttrSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttrSet) ProtoMessage() {}
+
+func (x *AttrSet) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttrSet.ProtoReflect.Descriptor instead.
+func (*AttrSet) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *AttrSet) GetOriginAs() uint32 {
+	if x != nil {
+		return x.OriginAs
+	}
+	return 0
+}
+
+func (x *AttrSet) GetPathAttributes() *BaseAttributes {
+	if x != nil {
+		return x.PathAttributes
+	}
+	return nil
+}
+
+// UnknownPathAttribute mirrors bgp.UnknownPathAttribute.
+type UnknownPathAttribute struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          uint32                 `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	Flags         uint32                 `protobuf:"varint,2,opt,name=flags,proto3" json:"flags,omitempty"`
+	Value         []byte                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnknownPathAttribute) Reset() {
+	*x = UnknownPathAttribute{}
+	mi := &file_pkg_api_messages_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnknownPathAttribute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnknownPathAttribute) ProtoMessage() {}
+
+func (x *UnknownPathAttribute) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnknownPathAttribute.ProtoReflect.Descriptor instead.
+func (*UnknownPathAttribute) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *UnknownPathAttribute) GetType() uint32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *UnknownPathAttribute) GetFlags() uint32 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+func (x *UnknownPathAttribute) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+// PathAttributeError mirrors bgp.PathAttributeError.
+type PathAttributeError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          uint32                 `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PathAttributeError) Reset() {
+	*x = PathAttributeError{}
+	mi := &file_pkg_api_messages_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PathAttributeError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PathAttributeError) ProtoMessage() {}
+
+func (x *PathAttributeError) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PathAttributeError.ProtoReflect.Descriptor instead.
+func (*PathAttributeError) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *PathAttributeError) GetType() uint32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *PathAttributeError) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// PSid mirrors prefixsid.PSid.
+type PSid struct {
+	state          protoimpl.MessageState      `protogen:"open.v1"`
+	LabelIndex     *PrefixSIDLabelIndexTLV     `protobuf:"bytes,1,opt,name=label_index,json=labelIndex,proto3" json:"label_index,omitempty"`
+	OriginatorSrgb *PrefixSIDOriginatorSRGBTLV `protobuf:"bytes,2,opt,name=originator_srgb,json=originatorSrgb,proto3" json:"originator_srgb,omitempty"`
+	Srv6L3Service  *L3Service                  `protobuf:"bytes,3,opt,name=srv6_l3_service,json=srv6L3Service,proto3" json:"srv6_l3_service,omitempty"`
+	Srv6L2Service  *L2Service                  `protobuf:"bytes,4,opt,name=srv6_l2_service,json=srv6L2Service,proto3" json:"srv6_l2_service,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PSid) Reset() {
+	*x = PSid{}
+	mi := &file_pkg_api_messages_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PSid) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PSid) ProtoMessage() {}
+
+func (x *PSid) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PSid.ProtoReflect.Descriptor instead.
+func (*PSid) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *PSid) GetLabelIndex() *PrefixSIDLabelIndexTLV {
+	if x != nil {
+		return x.LabelIndex
+	}
+	return nil
+}
+
+func (x *PSid) GetOriginatorSrgb() *PrefixSIDOriginatorSRGBTLV {
+	if x != nil {
+		return x.OriginatorSrgb
+	}
+	return nil
+}
+
+func (x *PSid) GetSrv6L3Service() *L3Service {
+	if x != nil {
+		return x.Srv6L3Service
+	}
+	return nil
+}
+
+func (x *PSid) GetSrv6L2Service() *L2Service {
+	if x != nil {
+		return x.Srv6L2Service
+	}
+	return nil
+}
+
+// PrefixSIDLabelIndexTLV mirrors prefixsid.LabelIndexTLV.
+type PrefixSIDLabelIndexTLV struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Flags         uint32                 `protobuf:"varint,1,opt,name=flags,proto3" json:"flags,omitempty"`
+	LastIndex     uint32                 `protobuf:"varint,2,opt,name=last_index,json=lastIndex,proto3" json:"last_index,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrefixSIDLabelIndexTLV) Reset() {
+	*x = PrefixSIDLabelIndexTLV{}
+	mi := &file_pkg_api_messages_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrefixSIDLabelIndexTLV) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrefixSIDLabelIndexTLV) ProtoMessage() {}
+
+func (x *PrefixSIDLabelIndexTLV) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrefixSIDLabelIndexTLV.ProtoReflect.Descriptor instead.
+func (*PrefixSIDLabelIndexTLV) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *PrefixSIDLabelIndexTLV) GetFlags() uint32 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+func (x *PrefixSIDLabelIndexTLV) GetLastIndex() uint32 {
+	if x != nil {
+		return x.LastIndex
+	}
+	return 0
+}
+
+// PrefixSIDOriginatorSRGBTLV mirrors prefixsid.OriginatorSRGBTLV.
+type PrefixSIDOriginatorSRGBTLV struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Flags         uint32                 `protobuf:"varint,1,opt,name=flags,proto3" json:"flags,omitempty"`
+	Srgb          []*SRGB                `protobuf:"bytes,2,rep,name=srgb,proto3" json:"srgb,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrefixSIDOriginatorSRGBTLV) Reset() {
+	*x = PrefixSIDOriginatorSRGBTLV{}
+	mi := &file_pkg_api_messages_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrefixSIDOriginatorSRGBTLV) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrefixSIDOriginatorSRGBTLV) ProtoMessage() {}
+
+func (x *PrefixSIDOriginatorSRGBTLV) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrefixSIDOriginatorSRGBTLV.ProtoReflect.Descriptor instead.
+func (*PrefixSIDOriginatorSRGBTLV) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *PrefixSIDOriginatorSRGBTLV) GetFlags() uint32 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+func (x *PrefixSIDOriginatorSRGBTLV) GetSrgb() []*SRGB {
+	if x != nil {
+		return x.Srgb
+	}
+	return nil
+}
+
+// SRGB mirrors prefixsid.SRGB.
+type SRGB struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	First         uint32                 `protobuf:"varint,1,opt,name=first,proto3" json:"first,omitempty"`
+	Number        uint32                 `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SRGB) Reset() {
+	*x = SRGB{}
+	mi := &file_pkg_api_messages_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SRGB) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SRGB) ProtoMessage() {}
+
+func (x *SRGB) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SRGB.ProtoReflect.Descriptor instead.
+func (*SRGB) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SRGB) GetFirst() uint32 {
+	if x != nil {
+		return x.First
+	}
+	return 0
+}
+
+func (x *SRGB) GetNumber() uint32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+// L3Service mirrors srv6.L3Service.
+type L3Service struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// sub_tlvs maps the sub-TLV types to the lists of SRv6 Service
+	// Information Sub-TLVs.
+	SubTlvs       map[uint32]*structpb.ListValue `protobuf:"bytes,1,rep,name=sub_tlvs,json=subTlvs,proto3" json:"sub_tlvs,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *L3Service) Reset() {
+	*x = L3Service{}
+	mi := &file_pkg_api_messages_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *L3Service) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*L3Service) ProtoMessage() {}
+
+func (x *L3Service) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use L3Service.ProtoReflect.Descriptor instead.
+func (*L3Service) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *L3Service) GetSubTlvs() map[uint32]*structpb.ListValue {
+	if x != nil {
+		return x.SubTlvs
+	}
+	return nil
+}
+
+// L2Service mirrors srv6.L2Service.
+type L2Service struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *L2Service) Reset() {
+	*x = L2Service{}
+	mi := &file_pkg_api_messages_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *L2Service) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*L2Service) ProtoMessage() {}
+
+func (x *L2Service) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use L2Service.ProtoReflect.Descriptor instead.
+func (*L2Service) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{36}
+}
+
+// LSNode mirrors message.LSNode.
+type LSNode struct {
+	state                 protoimpl.MessageState     `protogen:"open.v1"`
+	Action                string                     `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	Sequence              int64                      `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Hash                  string                     `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	RouterHash            string                     `protobuf:"bytes,4,opt,name=router_hash,json=routerHash,proto3" json:"router_hash,omitempty"`
+	DomainId              int64                      `protobuf:"varint,5,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	RouterIp              string                     `protobuf:"bytes,6,opt,name=router_ip,json=routerIp,proto3" json:"router_ip,omitempty"`
+	PeerHash              string                     `protobuf:"bytes,7,opt,name=peer_hash,json=peerHash,proto3" json:"peer_hash,omitempty"`
+	PeerIp                string                     `protobuf:"bytes,8,opt,name=peer_ip,json=peerIp,proto3" json:"peer_ip,omitempty"`
+	PeerType              uint32                     `protobuf:"varint,9,opt,name=peer_type,json=peerType,proto3" json:"peer_type,omitempty"`
+	PeerAsn               uint32                     `protobuf:"varint,10,opt,name=peer_asn,json=peerAsn,proto3" json:"peer_asn,omitempty"`
+	Timestamp             string                     `protobuf:"bytes,11,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	IgpRouterId           string                     `protobuf:"bytes,12,opt,name=igp_router_id,json=igpRouterId,proto3" json:"igp_router_id,omitempty"`
+	RouterId              string                     `protobuf:"bytes,13,opt,name=router_id,json=routerId,proto3" json:"router_id,omitempty"`
+	Asn                   uint32                     `protobuf:"varint,14,opt,name=asn,proto3" json:"asn,omitempty"`
+	LsId                  uint32                     `protobuf:"varint,15,opt,name=ls_id,json=lsId,proto3" json:"ls_id,omitempty"`
+	MtIdTlv               []*MultiTopologyIdentifier `protobuf:"bytes,16,rep,name=mt_id_tlv,json=mtIdTlv,proto3" json:"mt_id_tlv,omitempty"`
+	AreaId                string                     `protobuf:"bytes,17,opt,name=area_id,json=areaId,proto3" json:"area_id,omitempty"`
+	Protocol              string                     `protobuf:"bytes,18,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	ProtocolId            uint32                     `protobuf:"varint,19,opt,name=protocol_id,json=protocolId,proto3" json:"protocol_id,omitempty"`
+	NodeFlags             *NodeAttrFlags             `protobuf:"bytes,20,opt,name=node_flags,json=nodeFlags,proto3" json:"node_flags,omitempty"`
+	Name                  string                     `protobuf:"bytes,21,opt,name=name,proto3" json:"name,omitempty"`
+	LsSrCapabilities      *SRCapability              `protobuf:"bytes,22,opt,name=ls_sr_capabilities,json=lsSrCapabilities,proto3" json:"ls_sr_capabilities,omitempty"`
+	SrAlgorithm           []int64                    `protobuf:"varint,23,rep,packed,name=sr_algorithm,json=srAlgorithm,proto3" json:"sr_algorithm,omitempty"`
+	SrLocalBlock          *LocalBlock                `protobuf:"bytes,24,opt,name=sr_local_block,json=srLocalBlock,proto3" json:"sr_local_block,omitempty"`
+	Srv6CapabilitiesTlv   *SRv6CapabilityTLV         `protobuf:"bytes,25,opt,name=srv6_capabilities_tlv,json=srv6CapabilitiesTlv,proto3" json:"srv6_capabilities_tlv,omitempty"`
+	NodeMsd               []*MSDTV                   `protobuf:"bytes,26,rep,name=node_msd,json=nodeMsd,proto3" json:"node_msd,omitempty"`
+	FlexAlgoDefinition    []*FlexAlgoDefinition      `protobuf:"bytes,27,rep,name=flex_algo_definition,json=flexAlgoDefinition,proto3" json:"flex_algo_definition,omitempty"`
+	OpaqueNodeAttribute   []string                   `protobuf:"bytes,28,rep,name=opaque_node_attribute,json=opaqueNodeAttribute,proto3" json:"opaque_node_attribute,omitempty"`
+	IsAdjRibInPostPolicy  bool                       `protobuf:"varint,29,opt,name=is_adj_rib_in_post_policy,json=isAdjRibInPostPolicy,proto3" json:"is_adj_rib_in_post_policy,omitempty"`
+	IsAdjRibOutPostPolicy bool                       `protobuf:"varint,30,opt,name=is_adj_rib_out_post_policy,json=isAdjRibOutPostPolicy,proto3" json:"is_adj_rib_out_post_policy,omitempty"`
+	IsAdjRibOut           bool                       `protobuf:"varint,31,opt,name=is_adj_rib_out,json=isAdjRibOut,proto3" json:"is_adj_rib_out,omitempty"`
+	IsLocRib              bool                       `protobuf:"varint,32,opt,name=is_loc_rib,json=isLocRib,proto3" json:"is_loc_rib,omitempty"`
+	IsLocRibFiltered      bool                       `protobuf:"varint,33,opt,name=is_loc_rib_filtered,json=isLocRibFiltered,proto3" json:"is_loc_rib_filtered,omitempty"`
+	TableName             string                     `protobuf:"bytes,34,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	RouteDistinguisher    string                     `protobuf:"bytes,35,opt,name=route_distinguisher,json=routeDistinguisher,proto3" json:"route_distinguisher,omitempty"`
+	UserLabels            map[string]string          `protobuf:"bytes,36,rep,name=user_labels,json=userLabels,proto3" json:"user_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *LSNode) Reset() {
+	*x = LSNode{}
+	mi := &file_pkg_api_messages_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LSNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LSNode) ProtoMessage() {}
+
+func (x *LSNode) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LSNode.ProtoReflect.Descriptor instead.
+func (*LSNode) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *LSNode) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *LSNode) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *LSNode) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *LSNode) GetRouterHash() string {
+	if x != nil {
+		return x.RouterHash
+	}
+	return ""
+}
+
+func (x *LSNode) GetDomainId() int64 {
+	if x != nil {
+		return x.DomainId
+	}
+	return 0
+}
+
+func (x *LSNode) GetRouterIp() string {
+	if x != nil {
+		return x.RouterIp
+	}
+	return ""
+}
+
+func (x *LSNode) GetPeerHash() string {
+	if x != nil {
+		return x.PeerHash
+	}
+	return ""
+}
+
+func (x *LSNode) GetPeerIp() string {
+	if x != nil {
+		return x.PeerIp
+	}
+	return ""
+}
+
+func (x *LSNode) GetPeerType() uint32 {
+	if x != nil {
+		return x.PeerType
+	}
+	return 0
+}
+
+func (x *LSNode) GetPeerAsn() uint32 {
+	if x != nil {
+		return x.PeerAsn
+	}
+	return 0
+}
+
+func (x *LSNode) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *LSNode) GetIgpRouterId() string {
+	if x != nil {
+		return x.IgpRouterId
+	}
+	return ""
+}
+
+func (x *LSNode) GetRouterId() string {
+	if x != nil {
+		return x.RouterId
+	}
+	return ""
+}
+
+func (x *LSNode) GetAsn() uint32 {
+	if x != nil {
+		return x.Asn
+	}
+	return 0
+}
+
+func (x *LSNode) GetLsId() uint32 {
+	if x != nil {
+		return x.LsId
+	}
+	return 0
+}
+
+func (x *LSNode) GetMtIdTlv() []*MultiTopologyIdentifier {
+	if x != nil {
+		return x.MtIdTlv
+	}
+	return nil
+}
+
+func (x *LSNode) GetAreaId() string {
+	if x != nil {
+		return x.AreaId
+	}
+	return ""
+}
+
+func (x *LSNode) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *LSNode) GetProtocolId() uint32 {
+	if x != nil {
+		return x.ProtocolId
+	}
+	return 0
+}
+
+func (x *LSNode) GetNodeFlags() *NodeAttrFlags {
+	if x != nil {
+		return x.NodeFlags
+	}
+	return nil
+}
+
+func (x *LSNode) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LSNode) GetLsSrCapabilities() *SRCapability {
+	if x != nil {
+		return x.LsSrCapabilities
+	}
+	return nil
+}
+
+func (x *LSNode) GetSrAlgorithm() []int64 {
+	if x != nil {
+		return x.SrAlgorithm
+	}
+	return nil
+}
+
+func (x *LSNode) GetSrLocalBlock() *LocalBlock {
+	if x != nil {
+		return x.SrLocalBlock
+	}
+	return nil
+}
+
+func (x *LSNode) GetSrv6CapabilitiesTlv() *SRv6CapabilityTLV {
+	if x != nil {
+		return x.Srv6CapabilitiesTlv
+	}
+	return nil
+}
+
+func (x *LSNode) GetNodeMsd() []*MSDTV {
+	if x != nil {
+		return x.NodeMsd
+	}
+	return nil
+}
+
+func (x *LSNode) GetFlexAlgoDefinition() []*FlexAlgoDefinition {
+	if x != nil {
+		return x.FlexAlgoDefinition
+	}
+	return nil
+}
+
+func (x *LSNode) GetOpaqueNodeAttribute() []string {
+	if x != nil {
+		return x.OpaqueNodeAttribute
+	}
+	return nil
+}
+
+func (x *LSNode) GetIsAdjRibInPostPolicy() bool {
+	if x != nil {
+		return x.IsAdjRibInPostPolicy
+	}
+	return false
+}
+
+func (x *LSNode) GetIsAdjRibOutPostPolicy() bool {
+	if x != nil {
+		return x.IsAdjRibOutPostPolicy
+	}
+	return false
+}
+
+func (x *LSNode) GetIsAdjRibOut() bool {
+	if x != nil {
+		return x.IsAdjRibOut
+	}
+	return false
+}
+
+func (x *LSNode) GetIsLocRib() bool {
+	if x != nil {
+		return x.IsLocRib
+	}
+	return false
+}
+
+func (x *LSNode) GetIsLocRibFiltered() bool {
+	if x != nil {
+		return x.IsLocRibFiltered
+	}
+	return false
+}
+
+func (x *LSNode) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+func (x *LSNode) GetRouteDistinguisher() string {
+	if x != nil {
+		return x.RouteDistinguisher
+	}
+	return ""
+}
+
+func (x *LSNode) GetUserLabels() map[string]string {
+	if x != nil {
+		return x.UserLabels
+	}
+	return nil
+}
+
+// MultiTopologyIdentifier mirrors base.MultiTopologyIdentifier.
+type MultiTopologyIdentifier struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OFlag         bool                   `protobuf:"varint,1,opt,name=o_flag,json=oFlag,proto3" json:"o_flag,omitempty"`
+	AFlag         bool                   `protobuf:"varint,2,opt,name=a_flag,json=aFlag,proto3" json:"a_flag,omitempty"`
+	MtId          uint32                 `protobuf:"varint,3,opt,name=mt_id,json=mtId,proto3" json:"mt_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MultiTopologyIdentifier) Reset() {
+	*x = MultiTopologyIdentifier{}
+	mi := &file_pkg_api_messages_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultiTopologyIdentifier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiTopologyIdentifier) ProtoMessage() {}
+
+func (x *MultiTopologyIdentifier) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiTopologyIdentifier.ProtoReflect.Descriptor instead.
+func (*MultiTopologyIdentifier) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *MultiTopologyIdentifier) GetOFlag() bool {
+	if x != nil {
+		return x.OFlag
+	}
+	return false
+}
+
+func (x *MultiTopologyIdentifier) GetAFlag() bool {
+	if x != nil {
+		return x.AFlag
+	}
+	return false
+}
+
+func (x *MultiTopologyIdentifier) GetMtId() uint32 {
+	if x != nil {
+		return x.MtId
+	}
+	return 0
+}
+
+// NodeAttrFlags mirrors bgpls.NodeAttrFlags.
+type NodeAttrFlags struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OFlag         bool                   `protobuf:"varint,1,opt,name=o_flag,json=oFlag,proto3" json:"o_flag,omitempty"`
+	TFlag         bool                   `protobuf:"varint,2,opt,name=t_flag,json=tFlag,proto3" json:"t_flag,omitempty"`
+	EFlag         bool                   `protobuf:"varint,3,opt,name=e_flag,json=eFlag,proto3" json:"e_flag,omitempty"`
+	BFlag         bool                   `protobuf:"varint,4,opt,name=b_flag,json=bFlag,proto3" json:"b_flag,omitempty"`
+	RFlag         bool                   `protobuf:"varint,5,opt,name=r_flag,json=rFlag,proto3" json:"r_flag,omitempty"`
+	VFlag         bool                   `protobuf:"varint,6,opt,name=v_flag,json=vFlag,proto3" json:"v_flag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NodeAttrFlags) Reset() {
+	*x = NodeAttrFlags{}
+	mi := &file_pkg_api_messages_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeAttrFlags) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeAttrFlags) ProtoMessage() {}
+
+func (x *NodeAttrFlags) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeAttrFlags.ProtoReflect.Descriptor instead.
+func (*NodeAttrFlags) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *NodeAttrFlags) GetOFlag() bool {
+	if x != nil {
+		return x.OFlag
+	}
+	return false
+}
+
+func (x *NodeAttrFlags) GetTFlag() bool {
+	if x != nil {
+		return x.TFlag
+	}
+	return false
+}
+
+func (x *NodeAttrFlags) GetEFlag() bool {
+	if x != nil {
+		return x.EFlag
+	}
+	return false
+}
+
+func (x *NodeAttrFlags) GetBFlag() bool {
+	if x != nil {
+		return x.BFlag
+	}
+	return false
+}
+
+func (x *NodeAttrFlags) GetRFlag() bool {
+	if x != nil {
+		return x.RFlag
+	}
+	return false
+}
+
+func (x *NodeAttrFlags) GetVFlag() bool {
+	if x != nil {
+		return x.VFlag
+	}
+	return false
+}
+
+// SRCapability mirrors sr.Capability.
+type SRCapability struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Flags              *ProtocolFlags         `protobuf:"bytes,1,opt,name=flags,proto3" json:"flags,omitempty"`
+	SrCapabilitySubtlv []*SRCapabilitySubTLV  `protobuf:"bytes,2,rep,name=sr_capability_subtlv,json=srCapabilitySubtlv,proto3" json:"sr_capability_subtlv,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *SRCapability) Reset() {
+	*x = SRCapability{}
+	mi := &file_pkg_api_messages_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SRCapability) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SRCapability) ProtoMessage() {}
+
+func (x *SRCapability) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SRCapability.ProtoReflect.Descriptor instead.
+func (*SRCapability) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *SRCapability) GetFlags() *ProtocolFlags {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+func (x *SRCapability) GetSrCapabilitySubtlv() []*SRCapabilitySubTLV {
+	if x != nil {
+		return x.SrCapabilitySubtlv
+	}
+	return nil
+}
+
+// SRCapabilitySubTLV mirrors sr.CapabilitySubTLV.
+type SRCapabilitySubTLV struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Range         uint32                 `protobuf:"varint,1,opt,name=range,proto3" json:"range,omitempty"`
+	Sid           uint32                 `protobuf:"varint,2,opt,name=sid,proto3" json:"sid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SRCapabilitySubTLV) Reset() {
+	*x = SRCapabilitySubTLV{}
+	mi := &file_pkg_api_messages_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SRCapabilitySubTLV) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SRCapabilitySubTLV) ProtoMessage() {}
+
+func (x *SRCapabilitySubTLV) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SRCapabilitySubTLV.ProtoReflect.Descriptor instead.
+func (*SRCapabilitySubTLV) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *SRCapabilitySubTLV) GetRange() uint32 {
+	if x != nil {
+		return x.Range
+	}
+	return 0
+}
+
+func (x *SRCapabilitySubTLV) GetSid() uint32 {
+	if x != nil {
+		return x.Sid
+	}
+	return 0
+}
+
+// LocalBlock mirrors sr.LocalBlock.
+type LocalBlock struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Flags         uint32                 `protobuf:"varint,1,opt,name=flags,proto3" json:"flags,omitempty"`
+	Subranges     []*LocalBlockTLV       `protobuf:"bytes,2,rep,name=subranges,proto3" json:"subranges,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LocalBlock) Reset() {
+	*x = LocalBlock{}
+	mi := &file_pkg_api_messages_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LocalBlock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocalBlock) ProtoMessage() {}
+
+func (x *LocalBlock) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocalBlock.ProtoReflect.Descriptor instead.
+func (*LocalBlock) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *LocalBlock) GetFlags() uint32 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+func (x *LocalBlock) GetSubranges() []*LocalBlockTLV {
+	if x != nil {
+		return x.Subranges
+	}
+	return nil
+}
+
+// LocalBlockTLV mirrors sr.LocalBlockTLV.
+type LocalBlockTLV struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RangeSize     uint32                 `protobuf:"varint,1,opt,name=range_size,json=rangeSize,proto3" json:"range_size,omitempty"`
+	Label         *uint32                `protobuf:"varint,2,opt,name=label,proto3,oneof" json:"label,omitempty"`
+	Index         *uint32                `protobuf:"varint,3,opt,name=index,proto3,oneof" json:"index,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LocalBlockTLV) Reset() {
+	*x = LocalBlockTLV{}
+	mi := &file_pkg_api_messages_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LocalBlockTLV) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocalBlockTLV) ProtoMessage() {}
+
+func (x *LocalBlockTLV) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocalBlockTLV.ProtoReflect.Descriptor instead.
+func (*LocalBlockTLV) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *LocalBlockTLV) GetRangeSize() uint32 {
+	if x != nil {
+		return x.RangeSize
+	}
+	return 0
+}
+
+func (x *LocalBlockTLV) GetLabel() uint32 {
+	if x != nil && x.Label != nil {
+		return *x.Label
+	}
+	return 0
+}
+
+func (x *LocalBlockTLV) GetIndex() uint32 {
+	if x != nil && x.Index != nil {
+		return *x.Index
+	}
+	return 0
+}
+
+// SRv6CapabilityTLV mirrors srv6.CapabilityTLV.
+type SRv6CapabilityTLV struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OFlag         bool                   `protobuf:"varint,1,opt,name=o_flag,json=oFlag,proto3" json:"o_flag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SRv6CapabilityTLV) Reset() {
+	*x = SRv6CapabilityTLV{}
+	mi := &file_pkg_api_messages_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SRv6CapabilityTLV) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SRv6CapabilityTLV) ProtoMessage() {}
+
+func (x *SRv6CapabilityTLV) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SRv6CapabilityTLV.ProtoReflect.Descriptor instead.
+func (*SRv6CapabilityTLV) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *SRv6CapabilityTLV) GetOFlag() bool {
+	if x != nil {
+		return x.OFlag
+	}
+	return false
+}
+
+// MSDTV mirrors base.MSDTV.
+type MSDTV struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MsdType       uint32                 `protobuf:"varint,1,opt,name=msd_type,json=msdType,proto3" json:"msd_type,omitempty"`
+	MsdValue      uint32                 `protobuf:"varint,2,opt,name=msd_value,json=msdValue,proto3" json:"msd_value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MSDTV) Reset() {
+	*x = MSDTV{}
+	mi := &file_pkg_api_messages_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MSDTV) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MSDTV) ProtoMessage() {}
+
+func (x *MSDTV) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MSDTV.ProtoReflect.Descriptor instead.
+func (*MSDTV) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *MSDTV) GetMsdType() uint32 {
+	if x != nil {
+		return x.MsdType
+	}
+	return 0
+}
+
+func (x *MSDTV) GetMsdValue() uint32 {
+	if x != nil {
+		return x.MsdValue
+	}
+	return 0
+}
+
+// FlexAlgoDefinition mirrors bgpls.FlexAlgoDefinition.
+type FlexAlgoDefinition struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	FlexAlgo        uint32                 `protobuf:"varint,1,opt,name=flex_algo,json=flexAlgo,proto3" json:"flex_algo,omitempty"`
+	MetricType      uint32                 `protobuf:"varint,2,opt,name=metric_type,json=metricType,proto3" json:"metric_type,omitempty"`
+	CalculationType uint32                 `protobuf:"varint,3,opt,name=calculation_type,json=calculationType,proto3" json:"calculation_type,omitempty"`
+	Priority        uint32                 `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty"`
+	SubTlv          *FADSubTLV             `protobuf:"bytes,5,opt,name=sub_tlv,json=subTlv,proto3" json:"sub_tlv,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *FlexAlgoDefinition) Reset() {
+	*x = FlexAlgoDefinition{}
+	mi := &file_pkg_api_messages_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlexAlgoDefinition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlexAlgoDefinition) ProtoMessage() {}
+
+func (x *FlexAlgoDefinition) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlexAlgoDefinition.ProtoReflect.Descriptor instead.
+func (*FlexAlgoDefinition) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *FlexAlgoDefinition) GetFlexAlgo() uint32 {
+	if x != nil {
+		return x.FlexAlgo
+	}
+	return 0
+}
+
+func (x *FlexAlgoDefinition) GetMetricType() uint32 {
+	if x != nil {
+		return x.MetricType
+	}
+	return 0
+}
+
+func (x *FlexAlgoDefinition) GetCalculationType() uint32 {
+	if x != nil {
+		return x.CalculationType
+	}
+	return 0
+}
+
+func (x *FlexAlgoDefinition) GetPriority() uint32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *FlexAlgoDefinition) GetSubTlv() *FADSubTLV {
+	if x != nil {
+		return x.SubTlv
+	}
+	return nil
+}
+
+// FADSubTLV mirrors bgpls.FADSubTLV.
+type FADSubTLV struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExcludeAny    []uint32               `protobuf:"varint,1,rep,packed,name=exclude_any,json=excludeAny,proto3" json:"exclude_any,omitempty"`
+	IncludeAny    []uint32               `protobuf:"varint,2,rep,packed,name=include_any,json=includeAny,proto3" json:"include_any,omitempty"`
+	IncludeAll    []uint32               `protobuf:"varint,3,rep,packed,name=include_all,json=includeAll,proto3" json:"include_all,omitempty"`
+	Flags         *FADSubTLVFlags        `protobuf:"bytes,4,opt,name=flags,proto3" json:"flags,omitempty"`
+	ExcludeSrlg   []uint32               `protobuf:"varint,5,rep,packed,name=exclude_srlg,json=excludeSrlg,proto3" json:"exclude_srlg,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FADSubTLV) Reset() {
+	*x = FADSubTLV{}
+	mi := &file_pkg_api_messages_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FADSubTLV) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FADSubTLV) ProtoMessage() {}
+
+func (x *FADSubTLV) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FADSubTLV.ProtoReflect.Descriptor instead.
+func (*FADSubTLV) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *FADSubTLV) GetExcludeAny() []uint32 {
+	if x != nil {
+		return x.ExcludeAny
+	}
+	return nil
+}
+
+func (x *FADSubTLV) GetIncludeAny() []uint32 {
+	if x != nil {
+		return x.IncludeAny
+	}
+	return nil
+}
+
+func (x *FADSubTLV) GetIncludeAll() []uint32 {
+	if x != nil {
+		return x.IncludeAll
+	}
+	return nil
+}
+
+func (x *FADSubTLV) GetFlags() *FADSubTLVFlags {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+func (x *FADSubTLV) GetExcludeSrlg() []uint32 {
+	if x != nil {
+		return x.ExcludeSrlg
+	}
+	return nil
+}
+
+// FADSubTLVFlags mirrors bgpls.FADSubTLVFlags.
+type FADSubTLVFlags struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MFlag         bool                   `protobuf:"varint,1,opt,name=m_flag,json=mFlag,proto3" json:"m_flag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FADSubTLVFlags) Reset() {
+	*x = FADSubTLVFlags{}
+	mi := &file_pkg_api_messages_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FADSubTLVFlags) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FADSubTLVFlags) ProtoMessage() {}
+
+func (x *FADSubTLVFlags) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FADSubTLVFlags.ProtoReflect.Descriptor instead.
+func (*FADSubTLVFlags) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *FADSubTLVFlags) GetMFlag() bool {
+	if x != nil {
+		return x.MFlag
+	}
+	return false
+}
+
+// LSLink mirrors message.LSLink.
+type LSLink struct {
+	state                 protoimpl.MessageState   `protogen:"open.v1"`
+	Action                string                   `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	Sequence              int64                    `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Hash                  string                   `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	RouterHash            string                   `protobuf:"bytes,4,opt,name=router_hash,json=routerHash,proto3" json:"router_hash,omitempty"`
+	RouterIp              string                   `protobuf:"bytes,5,opt,name=router_ip,json=routerIp,proto3" json:"router_ip,omitempty"`
+	DomainId              int64                    `protobuf:"varint,6,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	PeerHash              string                   `protobuf:"bytes,7,opt,name=peer_hash,json=peerHash,proto3" json:"peer_hash,omitempty"`
+	PeerIp                string                   `protobuf:"bytes,8,opt,name=peer_ip,json=peerIp,proto3" json:"peer_ip,omitempty"`
+	PeerType              uint32                   `protobuf:"varint,9,opt,name=peer_type,json=peerType,proto3" json:"peer_type,omitempty"`
+	PeerAsn               uint32                   `protobuf:"varint,10,opt,name=peer_asn,json=peerAsn,proto3" json:"peer_asn,omitempty"`
+	Timestamp             string                   `protobuf:"bytes,11,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	IgpRouterId           string                   `protobuf:"bytes,12,opt,name=igp_router_id,json=igpRouterId,proto3" json:"igp_router_id,omitempty"`
+	RouterId              string                   `protobuf:"bytes,13,opt,name=router_id,json=routerId,proto3" json:"router_id,omitempty"`
+	LsId                  uint32                   `protobuf:"varint,14,opt,name=ls_id,json=lsId,proto3" json:"ls_id,omitempty"`
+	Protocol              string                   `protobuf:"bytes,15,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	ProtocolId            uint32                   `protobuf:"varint,16,opt,name=protocol_id,json=protocolId,proto3" json:"protocol_id,omitempty"`
+	AreaId                string                   `protobuf:"bytes,17,opt,name=area_id,json=areaId,proto3" json:"area_id,omitempty"`
+	Nexthop               string                   `protobuf:"bytes,18,opt,name=nexthop,proto3" json:"nexthop,omitempty"`
+	MtIdTlv               *MultiTopologyIdentifier `protobuf:"bytes,19,opt,name=mt_id_tlv,json=mtIdTlv,proto3" json:"mt_id_tlv,omitempty"`
+	LocalLinkId           uint32                   `protobuf:"varint,20,opt,name=local_link_id,json=localLinkId,proto3" json:"local_link_id,omitempty"`
+	RemoteLinkId          uint32                   `protobuf:"varint,21,opt,name=remote_link_id,json=remoteLinkId,proto3" json:"remote_link_id,omitempty"`
+	LocalLinkIp           string                   `protobuf:"bytes,22,opt,name=local_link_ip,json=localLinkIp,proto3" json:"local_link_ip,omitempty"`
+	RemoteLinkIp          string                   `protobuf:"bytes,23,opt,name=remote_link_ip,json=remoteLinkIp,proto3" json:"remote_link_ip,omitempty"`
+	IgpMetric             uint32                   `protobuf:"varint,24,opt,name=igp_metric,json=igpMetric,proto3" json:"igp_metric,omitempty"`
+	AdminGroup            uint32                   `protobuf:"varint,25,opt,name=admin_group,json=adminGroup,proto3" json:"admin_group,omitempty"`
+	MaxLinkBw             uint32                   `protobuf:"varint,26,opt,name=max_link_bw,json=maxLinkBw,proto3" json:"max_link_bw,omitempty"`
+	MaxResvBw             uint32                   `protobuf:"varint,27,opt,name=max_resv_bw,json=maxResvBw,proto3" json:"max_resv_bw,omitempty"`
+	UnresvBw              []uint32                 `protobuf:"varint,28,rep,packed,name=unresv_bw,json=unresvBw,proto3" json:"unresv_bw,omitempty"`
+	MaxLinkBwKbps         uint64                   `protobuf:"varint,29,opt,name=max_link_bw_kbps,json=maxLinkBwKbps,proto3" json:"max_link_bw_kbps,omitempty"`
+	MaxResvBwKbps         uint64                   `protobuf:"varint,30,opt,name=max_resv_bw_kbps,json=maxResvBwKbps,proto3" json:"max_resv_bw_kbps,omitempty"`
+	UnresvBwKbps          []uint64                 `protobuf:"varint,31,rep,packed,name=unresv_bw_kbps,json=unresvBwKbps,proto3" json:"unresv_bw_kbps,omitempty"`
+	TeDefaultMetric       uint32                   `protobuf:"varint,32,opt,name=te_default_metric,json=teDefaultMetric,proto3" json:"te_default_metric,omitempty"`
+	LinkProtection        uint32                   `protobuf:"varint,33,opt,name=link_protection,json=linkProtection,proto3" json:"link_protection,omitempty"`
+	MplsProtoMask         uint32                   `protobuf:"varint,34,opt,name=mpls_proto_mask,json=mplsProtoMask,proto3" json:"mpls_proto_mask,omitempty"`
+	Srlg                  []uint32                 `protobuf:"varint,35,rep,packed,name=srlg,proto3" json:"srlg,omitempty"`
+	LinkName              string                   `protobuf:"bytes,36,opt,name=link_name,json=linkName,proto3" json:"link_name,omitempty"`
+	RemoteNodeHash        string                   `protobuf:"bytes,37,opt,name=remote_node_hash,json=remoteNodeHash,proto3" json:"remote_node_hash,omitempty"`
+	LocalNodeHash         string                   `protobuf:"bytes,38,opt,name=local_node_hash,json=localNodeHash,proto3" json:"local_node_hash,omitempty"`
+	RemoteIgpRouterId     string                   `protobuf:"bytes,39,opt,name=remote_igp_router_id,json=remoteIgpRouterId,proto3" json:"remote_igp_router_id,omitempty"`
+	RemoteRouterId        string                   `protobuf:"bytes,40,opt,name=remote_router_id,json=remoteRouterId,proto3" json:"remote_router_id,omitempty"`
+	LocalNodeAsn          uint32                   `protobuf:"varint,41,opt,name=local_node_asn,json=localNodeAsn,proto3" json:"local_node_asn,omitempty"`
+	RemoteNodeAsn         uint32                   `protobuf:"varint,42,opt,name=remote_node_asn,json=remoteNodeAsn,proto3" json:"remote_node_asn,omitempty"`
+	BgpRouterId           string                   `protobuf:"bytes,43,opt,name=bgp_router_id,json=bgpRouterId,proto3" json:"bgp_router_id,omitempty"`
+	BgpRemoteRouterId     string                   `protobuf:"bytes,44,opt,name=bgp_remote_router_id,json=bgpRemoteRouterId,proto3" json:"bgp_remote_router_id,omitempty"`
+	MemberAs              uint32                   `protobuf:"varint,45,opt,name=member_as,json=memberAs,proto3" json:"member_as,omitempty"`
+	PeerNodeSid           *PeerSID                 `protobuf:"bytes,46,opt,name=peer_node_sid,json=peerNodeSid,proto3" json:"peer_node_sid,omitempty"`
+	PeerAdjSid            *PeerSID                 `protobuf:"bytes,47,opt,name=peer_adj_sid,json=peerAdjSid,proto3" json:"peer_adj_sid,omitempty"`
+	PeerSetSid            *PeerSID                 `protobuf:"bytes,48,opt,name=peer_set_sid,json=peerSetSid,proto3" json:"peer_set_sid,omitempty"`
+	Srv6BgpPeerNodeSid    *BGPPeerNodeSID          `protobuf:"bytes,49,opt,name=srv6_bgp_peer_node_sid,json=srv6BgpPeerNodeSid,proto3" json:"srv6_bgp_peer_node_sid,omitempty"`
+	Srv6EndxSid           []*EndXSIDTLV            `protobuf:"bytes,50,rep,name=srv6_endx_sid,json=srv6EndxSid,proto3" json:"srv6_endx_sid,omitempty"`
+	LsAdjacencySid        []*AdjacencySIDTLV       `protobuf:"bytes,51,rep,name=ls_adjacency_sid,json=lsAdjacencySid,proto3" json:"ls_adjacency_sid,omitempty"`
+	LinkMsd               []*MSDTV                 `protobuf:"bytes,52,rep,name=link_msd,json=linkMsd,proto3" json:"link_msd,omitempty"`
+	AppSpecLinkAttr       []*AppSpecLinkAttr       `protobuf:"bytes,53,rep,name=app_spec_link_attr,json=appSpecLinkAttr,proto3" json:"app_spec_link_attr,omitempty"`
+	UnidirLinkDelay       uint32                   `protobuf:"varint,54,opt,name=unidir_link_delay,json=unidirLinkDelay,proto3" json:"unidir_link_delay,omitempty"`
+	UnidirLinkDelayMinMax []uint32                 `protobuf:"varint,55,rep,packed,name=unidir_link_delay_min_max,json=unidirLinkDelayMinMax,proto3" json:"unidir_link_delay_min_max,omitempty"`
+	UnidirDelayVariation  uint32                   `protobuf:"varint,56,opt,name=unidir_delay_variation,json=unidirDelayVariation,proto3" json:"unidir_delay_variation,omitempty"`
+	UnidirPacketLoss      uint32                   `protobuf:"varint,57,opt,name=unidir_packet_loss,json=unidirPacketLoss,proto3" json:"unidir_packet_loss,omitempty"`
+	UnidirResidualBw      uint32                   `protobuf:"varint,58,opt,name=unidir_residual_bw,json=unidirResidualBw,proto3" json:"unidir_residual_bw,omitempty"`
+	UnidirAvailableBw     uint32                   `protobuf:"varint,59,opt,name=unidir_available_bw,json=unidirAvailableBw,proto3" json:"unidir_available_bw,omitempty"`
+	UnidirBwUtilization   uint32                   `protobuf:"varint,60,opt,name=unidir_bw_utilization,json=unidirBwUtilization,proto3" json:"unidir_bw_utilization,omitempty"`
+	OpaqueLinkAttribute   []string                 `protobuf:"bytes,61,rep,name=opaque_link_attribute,json=opaqueLinkAttribute,proto3" json:"opaque_link_attribute,omitempty"`
+	ExtAdminGroup         []uint32                 `protobuf:"varint,62,rep,packed,name=ext_admin_group,json=extAdminGroup,proto3" json:"ext_admin_group,omitempty"`
+	L2BundleMember        []*L2BundleMember        `protobuf:"bytes,63,rep,name=l2_bundle_member,json=l2BundleMember,proto3" json:"l2_bundle_member,omitempty"`
+	IsAdjRibInPostPolicy  bool                     `protobuf:"varint,64,opt,name=is_adj_rib_in_post_policy,json=isAdjRibInPostPolicy,proto3" json:"is_adj_rib_in_post_policy,omitempty"`
+	IsAdjRibOutPostPolicy bool                     `protobuf:"varint,65,opt,name=is_adj_rib_out_post_policy,json=isAdjRibOutPostPolicy,proto3" json:"is_adj_rib_out_post_policy,omitempty"`
+	IsAdjRibOut           bool                     `protobuf:"varint,66,opt,name=is_adj_rib_out,json=isAdjRibOut,proto3" json:"is_adj_rib_out,omitempty"`
+	IsLocRib              bool                     `protobuf:"varint,67,opt,name=is_loc_rib,json=isLocRib,proto3" json:"is_loc_rib,omitempty"`
+	IsLocRibFiltered      bool                     `protobuf:"varint,68,opt,name=is_loc_rib_filtered,json=isLocRibFiltered,proto3" json:"is_loc_rib_filtered,omitempty"`
+	TableName             string                   `protobuf:"bytes,69,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	RouteDistinguisher    string                   `protobuf:"bytes,70,opt,name=route_distinguisher,json=routeDistinguisher,proto3" json:"route_distinguisher,omitempty"`
+	UserLabels            map[string]string        `protobuf:"bytes,71,rep,name=user_labels,json=userLabels,proto3" json:"user_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *LSLink) Reset() {
+	*x = LSLink{}
+	mi := &file_pkg_api_messages_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LSLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LSLink) ProtoMessage() {}
+
+func (x *LSLink) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LSLink.ProtoReflect.Descriptor instead.
+func (*LSLink) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *LSLink) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *LSLink) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *LSLink) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *LSLink) GetRouterHash() string {
+	if x != nil {
+		return x.RouterHash
+	}
+	return ""
+}
+
+func (x *LSLink) GetRouterIp() string {
+	if x != nil {
+		return x.RouterIp
+	}
+	return ""
+}
+
+func (x *LSLink) GetDomainId() int64 {
+	if x != nil {
+		return x.DomainId
+	}
+	return 0
+}
+
+func (x *LSLink) GetPeerHash() string {
+	if x != nil {
+		return x.PeerHash
+	}
+	return ""
+}
+
+func (x *LSLink) GetPeerIp() string {
+	if x != nil {
+		return x.PeerIp
+	}
+	return ""
+}
+
+func (x *LSLink) GetPeerType() uint32 {
+	if x != nil {
+		return x.PeerType
+	}
+	return 0
+}
+
+func (x *LSLink) GetPeerAsn() uint32 {
+	if x != nil {
+		return x.PeerAsn
+	}
+	return 0
+}
+
+func (x *LSLink) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *LSLink) GetIgpRouterId() string {
+	if x != nil {
+		return x.IgpRouterId
+	}
+	return ""
+}
+
+func (x *LSLink) GetRouterId() string {
+	if x != nil {
+		return x.RouterId
+	}
+	return ""
+}
+
+func (x *LSLink) GetLsId() uint32 {
+	if x != nil {
+		return x.LsId
+	}
+	return 0
+}
+
+func (x *LSLink) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *LSLink) GetProtocolId() uint32 {
+	if x != nil {
+		return x.ProtocolId
+	}
+	return 0
+}
+
+func (x *LSLink) GetAreaId() string {
+	if x != nil {
+		return x.AreaId
+	}
+	return ""
+}
+
+func (x *LSLink) GetNexthop() string {
+	if x != nil {
+		return x.Nexthop
+	}
+	return ""
+}
+
+func (x *LSLink) GetMtIdTlv() *MultiTopologyIdentifier {
+	if x != nil {
+		return x.MtIdTlv
+	}
+	return nil
+}
+
+func (x *LSLink) GetLocalLinkId() uint32 {
+	if x != nil {
+		return x.LocalLinkId
+	}
+	return 0
+}
+
+func (x *LSLink) GetRemoteLinkId() uint32 {
+	if x != nil {
+		return x.RemoteLinkId
+	}
+	return 0
+}
+
+func (x *LSLink) GetLocalLinkIp() string {
+	if x != nil {
+		return x.LocalLinkIp
+	}
+	return ""
+}
+
+func (x *LSLink) GetRemoteLinkIp() string {
+	if x != nil {
+		return x.RemoteLinkIp
+	}
+	return ""
+}
+
+func (x *LSLink) GetIgpMetric() uint32 {
+	if x != nil {
+		return x.IgpMetric
+	}
+	return 0
+}
+
+func (x *LSLink) GetAdminGroup() uint32 {
+	if x != nil {
+		return x.AdminGroup
+	}
+	return 0
+}
+
+func (x *LSLink) GetMaxLinkBw() uint32 {
+	if x != nil {
+		return x.MaxLinkBw
+	}
+	return 0
+}
+
+func (x *LSLink) GetMaxResvBw() uint32 {
+	if x != nil {
+		return x.MaxResvBw
+	}
+	return 0
+}
+
+func (x *LSLink) GetUnresvBw() []uint32 {
+	if x != nil {
+		return x.UnresvBw
+	}
+	return nil
+}
+
+func (x *LSLink) GetMaxLinkBwKbps() uint64 {
+	if x != nil {
+		return x.MaxLinkBwKbps
+	}
+	return 0
+}
+
+func (x *LSLink) GetMaxResvBwKbps() uint64 {
+	if x != nil {
+		return x.MaxResvBwKbps
+	}
+	return 0
+}
+
+func (x *LSLink) GetUnresvBwKbps() []uint64 {
+	if x != nil {
+		return x.UnresvBwKbps
+	}
+	return nil
+}
+
+func (x *LSLink) GetTeDefaultMetric() uint32 {
+	if x != nil {
+		return x.TeDefaultMetric
+	}
+	return 0
+}
+
+func (x *LSLink) GetLinkProtection() uint32 {
+	if x != nil {
+		return x.LinkProtection
+	}
+	return 0
+}
+
+func (x *LSLink) GetMplsProtoMask() uint32 {
+	if x != nil {
+		return x.MplsProtoMask
+	}
+	return 0
+}
+
+func (x *LSLink) GetSrlg() []uint32 {
+	if x != nil {
+		return x.Srlg
+	}
+	return nil
+}
+
+func (x *LSLink) GetLinkName() string {
+	if x != nil {
+		return x.LinkName
+	}
+	return ""
+}
+
+func (x *LSLink) GetRemoteNodeHash() string {
+	if x != nil {
+		return x.RemoteNodeHash
+	}
+	return ""
+}
+
+func (x *LSLink) GetLocalNodeHash() string {
+	if x != nil {
+		return x.LocalNodeHash
+	}
+	return ""
+}
+
+func (x *LSLink) GetRemoteIgpRouterId() string {
+	if x != nil {
+		return x.RemoteIgpRouterId
+	}
+	return ""
+}
+
+func (x *LSLink) GetRemoteRouterId() string {
+	if x != nil {
+		return x.RemoteRouterId
+	}
+	return ""
+}
+
+func (x *LSLink) GetLocalNodeAsn() uint32 {
+	if x != nil {
+		return x.LocalNodeAsn
+	}
+	return 0
+}
+
+func (x *LSLink) GetRemoteNodeAsn() uint32 {
+	if x != nil {
+		return x.RemoteNodeAsn
+	}
+	return 0
+}
+
+func (x *LSLink) GetBgpRouterId() string {
+	if x != nil {
+		return x.BgpRouterId
+	}
+	return ""
+}
+
+func (x *LSLink) GetBgpRemoteRouterId() string {
+	if x != nil {
+		return x.BgpRemoteRouterId
+	}
+	return ""
+}
+
+func (x *LSLink) GetMemberAs() uint32 {
+	if x != nil {
+		return x.MemberAs
+	}
+	return 0
+}
+
+func (x *LSLink) GetPeerNodeSid() *PeerSID {
+	if x != nil {
+		return x.PeerNodeSid
+	}
+	return nil
+}
+
+func (x *LSLink) GetPeerAdjSid() *PeerSID {
+	if x != nil {
+		return x.PeerAdjSid
+	}
+	return nil
+}
+
+func (x *LSLink) GetPeerSetSid() *PeerSID {
+	if x != nil {
+		return x.PeerSetSid
+	}
+	return nil
+}
+
+func (x *LSLink) GetSrv6BgpPeerNodeSid() *BGPPeerNodeSID {
+	if x != nil {
+		return x.Srv6BgpPeerNodeSid
+	}
+	return nil
+}
+
+func (x *LSLink) GetSrv6EndxSid() []*EndXSIDTLV {
+	if x != nil {
+		return x.Srv6EndxSid
+	}
+	return nil
+}
+
+func (x *LSLink) GetLsAdjacencySid() []*AdjacencySIDTLV {
+	if x != nil {
+		return x.LsAdjacencySid
+	}
+	return nil
+}
+
+func (x *LSLink) GetLinkMsd() []*MSDTV {
+	if x != nil {
+		return x.LinkMsd
+	}
+	return nil
+}
+
+func (x *LSLink) GetAppSpecLinkAttr() []*AppSpecLinkAttr {
+	if x != nil {
+		return x.AppSpecLinkAttr
+	}
+	return nil
+}
+
+func (x *LSLink) GetUnidirLinkDelay() uint32 {
+	if x != nil {
+		return x.UnidirLinkDelay
+	}
+	return 0
+}
+
+func (x *LSLink) GetUnidirLinkDelayMinMax() []uint32 {
+	if x != nil {
+		return x.UnidirLinkDelayMinMax
+	}
+	return nil
+}
+
+func (x *LSLink) GetUnidirDelayVariation() uint32 {
+	if x != nil {
+		return x.UnidirDelayVariation
+	}
+	return 0
+}
+
+func (x *LSLink) GetUnidirPacketLoss() uint32 {
+	if x != nil {
+		return x.UnidirPacketLoss
+	}
+	return 0
+}
+
+func (x *LSLink) GetUnidirResidualBw() uint32 {
+	if x != nil {
+		return x.UnidirResidualBw
+	}
+	return 0
+}
+
+func (x *LSLink) GetUnidirAvailableBw() uint32 {
+	if x != nil {
+		return x.UnidirAvailableBw
+	}
+	return 0
+}
+
+func (x *LSLink) GetUnidirBwUtilization() uint32 {
+	if x != nil {
+		return x.UnidirBwUtilization
+	}
+	return 0
+}
+
+func (x *LSLink) GetOpaqueLinkAttribute() []string {
+	if x != nil {
+		return x.OpaqueLinkAttribute
+	}
+	return nil
+}
+
+func (x *LSLink) GetExtAdminGroup() []uint32 {
+	if x != nil {
+		return x.ExtAdminGroup
+	}
+	return nil
+}
+
+func (x *LSLink) GetL2BundleMember() []*L2BundleMember {
+	if x != nil {
+		return x.L2BundleMember
+	}
+	return nil
+}
+
+func (x *LSLink) GetIsAdjRibInPostPolicy() bool {
+	if x != nil {
+		return x.IsAdjRibInPostPolicy
+	}
+	return false
+}
+
+func (x *LSLink) GetIsAdjRibOutPostPolicy() bool {
+	if x != nil {
+		return x.IsAdjRibOutPostPolicy
+	}
+	return false
+}
+
+func (x *LSLink) GetIsAdjRibOut() bool {
+	if x != nil {
+		return x.IsAdjRibOut
+	}
+	return false
+}
+
+func (x *LSLink) GetIsLocRib() bool {
+	if x != nil {
+		return x.IsLocRib
+	}
+	return false
+}
+
+func (x *LSLink) GetIsLocRibFiltered() bool {
+	if x != nil {
+		return x.IsLocRibFiltered
+	}
+	return false
+}
+
+func (x *LSLink) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+func (x *LSLink) GetRouteDistinguisher() string {
+	if x != nil {
+		return x.RouteDistinguisher
+	}
+	return ""
+}
+
+func (x *LSLink) GetUserLabels() map[string]string {
+	if x != nil {
+		return x.UserLabels
+	}
+	return nil
+}
+
+// PeerSID mirrors sr.PeerSID.
+type PeerSID struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Flags         *PeerFlags             `protobuf:"bytes,1,opt,name=flags,proto3" json:"flags,omitempty"`
+	Weight        uint32                 `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	Sid           uint32                 `protobuf:"varint,3,opt,name=sid,proto3" json:"sid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PeerSID) Reset() {
+	*x = PeerSID{}
+	mi := &file_pkg_api_messages_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PeerSID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerSID) ProtoMessage() {}
+
+func (x *PeerSID) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerSID.ProtoReflect.Descriptor instead.
+func (*PeerSID) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *PeerSID) GetFlags() *PeerFlags {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+func (x *PeerSID) GetWeight() uint32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *PeerSID) GetSid() uint32 {
+	if x != nil {
+		return x.Sid
+	}
+	return 0
+}
+
+// PeerFlags mirrors sr.PeerFlags.
+type PeerFlags struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VFlag         bool                   `protobuf:"varint,1,opt,name=v_flag,json=vFlag,proto3" json:"v_flag,omitempty"`
+	LFlag         bool                   `protobuf:"varint,2,opt,name=l_flag,json=lFlag,proto3" json:"l_flag,omitempty"`
+	BFlag         bool                   `protobuf:"varint,3,opt,name=b_flag,json=bFlag,proto3" json:"b_flag,omitempty"`
+	PFlag         bool                   `protobuf:"varint,4,opt,name=p_flag,json=pFlag,proto3" json:"p_flag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PeerFlags) Reset() {
+	*x = PeerFlags{}
+	mi := &file_pkg_api_messages_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PeerFlags) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerFlags) ProtoMessage() {}
+
+func (x *PeerFlags) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerFlags.ProtoReflect.Descriptor instead.
+func (*PeerFlags) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *PeerFlags) GetVFlag() bool {
+	if x != nil {
+		return x.VFlag
+	}
+	return false
+}
+
+func (x *PeerFlags) GetLFlag() bool {
+	if x != nil {
+		return x.LFlag
+	}
+	return false
+}
+
+func (x *PeerFlags) GetBFlag() bool {
+	if x != nil {
+		return x.BFlag
+	}
+	return false
+}
+
+func (x *PeerFlags) GetPFlag() bool {
+	if x != nil {
+		return x.PFlag
+	}
+	return false
+}
+
+// BGPPeerNodeSID mirrors srv6.BGPPeerNodeSID.
+type BGPPeerNodeSID struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Flags         *BGPPeerNodeFlags      `protobuf:"bytes,1,opt,name=flags,proto3" json:"flags,omitempty"`
+	Weight        uint32                 `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	PeerAsn       uint32                 `protobuf:"varint,3,opt,name=peer_asn,json=peerAsn,proto3" json:"peer_asn,omitempty"`
+	PeerId        []byte                 `protobuf:"bytes,4,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BGPPeerNodeSID) Reset() {
+	*x = BGPPeerNodeSID{}
+	mi := &file_pkg_api_messages_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BGPPeerNodeSID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BGPPeerNodeSID) ProtoMessage() {}
+
+func (x *BGPPeerNodeSID) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BGPPeerNodeSID.ProtoReflect.Descriptor instead.
+func (*BGPPeerNodeSID) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *BGPPeerNodeSID) GetFlags() *BGPPeerNodeFlags {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+func (x *BGPPeerNodeSID) GetWeight() uint32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *BGPPeerNodeSID) GetPeerAsn() uint32 {
+	if x != nil {
+		return x.PeerAsn
+	}
+	return 0
+}
+
+func (x *BGPPeerNodeSID) GetPeerId() []byte {
+	if x != nil {
+		return x.PeerId
+	}
+	return nil
+}
+
+// BGPPeerNodeFlags mirrors srv6.BGPPeerNodeFlags.
+type BGPPeerNodeFlags struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BFlag         bool                   `protobuf:"varint,1,opt,name=b_flag,json=bFlag,proto3" json:"b_flag,omitempty"`
+	SFlag         bool                   `protobuf:"varint,2,opt,name=s_flag,json=sFlag,proto3" json:"s_flag,omitempty"`
+	PFlag         bool                   `protobuf:"varint,3,opt,name=p_flag,json=pFlag,proto3" json:"p_flag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BGPPeerNodeFlags) Reset() {
+	*x = BGPPeerNodeFlags{}
+	mi := &file_pkg_api_messages_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BGPPeerNodeFlags) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BGPPeerNodeFlags) ProtoMessage() {}
+
+func (x *BGPPeerNodeFlags) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BGPPeerNodeFlags.ProtoReflect.Descriptor instead.
+func (*BGPPeerNodeFlags) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *BGPPeerNodeFlags) GetBFlag() bool {
+	if x != nil {
+		return x.BFlag
+	}
+	return false
+}
+
+func (x *BGPPeerNodeFlags) GetSFlag() bool {
+	if x != nil {
+		return x.SFlag
+	}
+	return false
+}
+
+func (x *BGPPeerNodeFlags) GetPFlag() bool {
+	if x != nil {
+		return x.PFlag
+	}
+	return false
+}
+
+// EndXSIDTLV mirrors srv6.EndXSIDTLV.
+type EndXSIDTLV struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Type             uint32                 `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	Length           uint32                 `protobuf:"varint,2,opt,name=length,proto3" json:"length,omitempty"`
+	EndpointBehavior uint32                 `protobuf:"varint,3,opt,name=endpoint_behavior,json=endpointBehavior,proto3" json:"endpoint_behavior,omitempty"`
+	Flags            *EndXSIDFlags          `protobuf:"bytes,4,opt,name=flags,proto3" json:"flags,omitempty"`
+	Algorithm        uint32                 `protobuf:"varint,5,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	Weight           uint32                 `protobuf:"varint,6,opt,name=weight,proto3" json:"weight,omitempty"`
+	Sid              string                 `protobuf:"bytes,7,opt,name=sid,proto3" json:"sid,omitempty"`
+	SubTlvs          []*SRv6SubTLV          `protobuf:"bytes,8,rep,name=sub_tlvs,json=subTlvs,proto3" json:"sub_tlvs,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *EndXSIDTLV) Reset() {
+	*x = EndXSIDTLV{}
+	mi := &file_pkg_api_messages_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EndXSIDTLV) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndXSIDTLV) ProtoMessage() {}
+
+func (x *EndXSIDTLV) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndXSIDTLV.ProtoReflect.Descriptor instead.
+func (*EndXSIDTLV) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *EndXSIDTLV) GetType() uint32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *EndXSIDTLV) GetLength() uint32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *EndXSIDTLV) GetEndpointBehavior() uint32 {
+	if x != nil {
+		return x.EndpointBehavior
+	}
+	return 0
+}
+
+func (x *EndXSIDTLV) GetFlags() *EndXSIDFlags {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+func (x *EndXSIDTLV) GetAlgorithm() uint32 {
+	if x != nil {
+		return x.Algorithm
+	}
+	return 0
+}
+
+func (x *EndXSIDTLV) GetWeight() uint32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *EndXSIDTLV) GetSid() string {
+	if x != nil {
+		return x.Sid
+	}
+	return ""
+}
+
+func (x *EndXSIDTLV) GetSubTlvs() []*SRv6SubTLV {
+	if x != nil {
+		return x.SubTlvs
+	}
+	return nil
+}
+
+// EndXSIDFlags mirrors srv6.EndXSIDFlags.
+type EndXSIDFlags struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BFlag         bool                   `protobuf:"varint,1,opt,name=b_flag,json=bFlag,proto3" json:"b_flag,omitempty"`
+	SFlag         bool                   `protobuf:"varint,2,opt,name=s_flag,json=sFlag,proto3" json:"s_flag,omitempty"`
+	PFlag         bool                   `protobuf:"varint,3,opt,name=p_flag,json=pFlag,proto3" json:"p_flag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EndXSIDFlags) Reset() {
+	*x = EndXSIDFlags{}
+	mi := &file_pkg_api_messages_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EndXSIDFlags) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndXSIDFlags) ProtoMessage() {}
+
+func (x *EndXSIDFlags) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndXSIDFlags.ProtoReflect.Descriptor instead.
+func (*EndXSIDFlags) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *EndXSIDFlags) GetBFlag() bool {
+	if x != nil {
+		return x.BFlag
+	}
+	return false
+}
+
+func (x *EndXSIDFlags) GetSFlag() bool {
+	if x != nil {
+		return x.SFlag
+	}
+	return false
+}
+
+func (x *EndXSIDFlags) GetPFlag() bool {
+	if x != nil {
+		return x.PFlag
+	}
+	return false
+}
+
+// AdjacencySIDTLV mirrors sr.AdjacencySIDTLV.
+type AdjacencySIDTLV struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Flags         *ProtocolFlags         `protobuf:"bytes,1,opt,name=flags,proto3" json:"flags,omitempty"`
+	Weight        uint32                 `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	Sid           uint32                 `protobuf:"varint,3,opt,name=sid,proto3" json:"sid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdjacencySIDTLV) Reset() {
+	*x = AdjacencySIDTLV{}
+	mi := &file_pkg_api_messages_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdjacencySIDTLV) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdjacencySIDTLV) ProtoMessage() {}
+
+func (x *AdjacencySIDTLV) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdjacencySIDTLV.ProtoReflect.Descriptor instead.
+func (*AdjacencySIDTLV) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *AdjacencySIDTLV) GetFlags() *ProtocolFlags {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+func (x *AdjacencySIDTLV) GetWeight() uint32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *AdjacencySIDTLV) GetSid() uint32 {
+	if x != nil {
+		return x.Sid
+	}
+	return 0
+}
+
+// AppSpecLinkAttr mirrors bgpls.AppSpecLinkAttr.
+type AppSpecLinkAttr struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	SaibmLength     uint32                 `protobuf:"varint,1,opt,name=saibm_length,json=saibmLength,proto3" json:"saibm_length,omitempty"`
+	UdaibmLength    uint32                 `protobuf:"varint,2,opt,name=udaibm_length,json=udaibmLength,proto3" json:"udaibm_length,omitempty"`
+	StdAppIdBitMask []byte                 `protobuf:"bytes,3,opt,name=std_app_id_bit_mask,json=stdAppIdBitMask,proto3" json:"std_app_id_bit_mask,omitempty"`
+	UdAppIdBitMask  []byte                 `protobuf:"bytes,4,opt,name=ud_app_id_bit_mask,json=udAppIdBitMask,proto3" json:"ud_app_id_bit_mask,omitempty"`
+	SubTlvs         []*SubTLV              `protobuf:"bytes,5,rep,name=sub_tlvs,json=subTlvs,proto3" json:"sub_tlvs,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AppSpecLinkAttr) Reset() {
+	*x = AppSpecLinkAttr{}
+	mi := &file_pkg_api_messages_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppSpecLinkAttr) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppSpecLinkAttr) ProtoMessage() {}
+
+func (x *AppSpecLinkAttr) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppSpecLinkAttr.ProtoReflect.Descriptor instead.
+func (*AppSpecLinkAttr) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *AppSpecLinkAttr) GetSaibmLength() uint32 {
+	if x != nil {
+		return x.SaibmLength
+	}
+	return 0
+}
+
+func (x *AppSpecLinkAttr) GetUdaibmLength() uint32 {
+	if x != nil {
+		return x.UdaibmLength
+	}
+	return 0
+}
+
+func (x *AppSpecLinkAttr) GetStdAppIdBitMask() []byte {
+	if x != nil {
+		return x.StdAppIdBitMask
+	}
+	return nil
+}
+
+func (x *AppSpecLinkAttr) GetUdAppIdBitMask() []byte {
+	if x != nil {
+		return x.UdAppIdBitMask
+	}
+	return nil
+}
+
+func (x *AppSpecLinkAttr) GetSubTlvs() []*SubTLV {
+	if x != nil {
+		return x.SubTlvs
+	}
+	return nil
+}
+
+// SubTLV mirrors base.SubTLV.
+type SubTLV struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SubTlvType    uint32                 `protobuf:"varint,1,opt,name=sub_tlv_type,json=subTlvType,proto3" json:"sub_tlv_type,omitempty"`
+	SubTlvValue   []byte                 `protobuf:"bytes,2,opt,name=sub_tlv_value,json=subTlvValue,proto3" json:"sub_tlv_value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubTLV) Reset() {
+	*x = SubTLV{}
+	mi := &file_pkg_api_messages_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubTLV) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubTLV) ProtoMessage() {}
+
+func (x *SubTLV) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubTLV.ProtoReflect.Descriptor instead.
+func (*SubTLV) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *SubTLV) GetSubTlvType() uint32 {
+	if x != nil {
+		return x.SubTlvType
+	}
+	return 0
+}
+
+func (x *SubTLV) GetSubTlvValue() []byte {
+	if x != nil {
+		return x.SubTlvValue
+	}
+	return nil
+}
+
+// L2BundleMember mirrors bgpls.L2BundleMember.
+type L2BundleMember struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	MemberDescriptor      uint32                 `protobuf:"varint,1,opt,name=member_descriptor,json=memberDescriptor,proto3" json:"member_descriptor,omitempty"`
+	AdminGroup            uint32                 `protobuf:"varint,2,opt,name=admin_group,json=adminGroup,proto3" json:"admin_group,omitempty"`
+	ExtAdminGroup         []uint32               `protobuf:"varint,3,rep,packed,name=ext_admin_group,json=extAdminGroup,proto3" json:"ext_admin_group,omitempty"`
+	MaxLinkBwKbps         uint64                 `protobuf:"varint,4,opt,name=max_link_bw_kbps,json=maxLinkBwKbps,proto3" json:"max_link_bw_kbps,omitempty"`
+	MaxResvBwKbps         uint64                 `protobuf:"varint,5,opt,name=max_resv_bw_kbps,json=maxResvBwKbps,proto3" json:"max_resv_bw_kbps,omitempty"`
+	UnresvBwKbps          []uint64               `protobuf:"varint,6,rep,packed,name=unresv_bw_kbps,json=unresvBwKbps,proto3" json:"unresv_bw_kbps,omitempty"`
+	LinkProtection        uint32                 `protobuf:"varint,7,opt,name=link_protection,json=linkProtection,proto3" json:"link_protection,omitempty"`
+	LsAdjacencySid        []*AdjacencySIDTLV     `protobuf:"bytes,8,rep,name=ls_adjacency_sid,json=lsAdjacencySid,proto3" json:"ls_adjacency_sid,omitempty"`
+	UnidirLinkDelay       uint32                 `protobuf:"varint,9,opt,name=unidir_link_delay,json=unidirLinkDelay,proto3" json:"unidir_link_delay,omitempty"`
+	UnidirLinkDelayMinMax []uint32               `protobuf:"varint,10,rep,packed,name=unidir_link_delay_min_max,json=unidirLinkDelayMinMax,proto3" json:"unidir_link_delay_min_max,omitempty"`
+	UnidirDelayVariation  uint32                 `protobuf:"varint,11,opt,name=unidir_delay_variation,json=unidirDelayVariation,proto3" json:"unidir_delay_variation,omitempty"`
+	UnidirPacketLoss      uint32                 `protobuf:"varint,12,opt,name=unidir_packet_loss,json=unidirPacketLoss,proto3" json:"unidir_packet_loss,omitempty"`
+	UnidirResidualBw      uint32                 `protobuf:"varint,13,opt,name=unidir_residual_bw,json=unidirResidualBw,proto3" json:"unidir_residual_bw,omitempty"`
+	UnidirAvailableBw     uint32                 `protobuf:"varint,14,opt,name=unidir_available_bw,json=unidirAvailableBw,proto3" json:"unidir_available_bw,omitempty"`
+	UnidirBwUtilization   uint32                 `protobuf:"varint,15,opt,name=unidir_bw_utilization,json=unidirBwUtilization,proto3" json:"unidir_bw_utilization,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *L2BundleMember) Reset() {
+	*x = L2BundleMember{}
+	mi := &file_pkg_api_messages_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *L2BundleMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*L2BundleMember) ProtoMessage() {}
+
+func (x *L2BundleMember) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use L2BundleMember.ProtoReflect.Descriptor instead.
+func (*L2BundleMember) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *L2BundleMember) GetMemberDescriptor() uint32 {
+	if x != nil {
+		return x.MemberDescriptor
+	}
+	return 0
+}
+
+func (x *L2BundleMember) GetAdminGroup() uint32 {
+	if x != nil {
+		return x.AdminGroup
+	}
+	return 0
+}
+
+func (x *L2BundleMember) GetExtAdminGroup() []uint32 {
+	if x != nil {
+		return x.ExtAdminGroup
+	}
+	return nil
+}
+
+func (x *L2BundleMember) GetMaxLinkBwKbps() uint64 {
+	if x != nil {
+		return x.MaxLinkBwKbps
+	}
+	return 0
+}
+
+func (x *L2BundleMember) GetMaxResvBwKbps() uint64 {
+	if x != nil {
+		return x.MaxResvBwKbps
+	}
+	return 0
+}
+
+func (x *L2BundleMember) GetUnresvBwKbps() []uint64 {
+	if x != nil {
+		return x.UnresvBwKbps
+	}
+	return nil
+}
+
+func (x *L2BundleMember) GetLinkProtection() uint32 {
+	if x != nil {
+		return x.LinkProtection
+	}
+	return 0
+}
+
+func (x *L2BundleMember) GetLsAdjacencySid() []*AdjacencySIDTLV {
+	if x != nil {
+		return x.LsAdjacencySid
+	}
+	return nil
+}
+
+func (x *L2BundleMember) GetUnidirLinkDelay() uint32 {
+	if x != nil {
+		return x.UnidirLinkDelay
+	}
+	return 0
+}
+
+func (x *L2BundleMember) GetUnidirLinkDelayMinMax() []uint32 {
+	if x != nil {
+		return x.UnidirLinkDelayMinMax
+	}
+	return nil
+}
+
+func (x *L2BundleMember) GetUnidirDelayVariation() uint32 {
+	if x != nil {
+		return x.UnidirDelayVariation
+	}
+	return 0
+}
+
+func (x *L2BundleMember) GetUnidirPacketLoss() uint32 {
+	if x != nil {
+		return x.UnidirPacketLoss
+	}
+	return 0
+}
+
+func (x *L2BundleMember) GetUnidirResidualBw() uint32 {
+	if x != nil {
+		return x.UnidirResidualBw
+	}
+	return 0
+}
+
+func (x *L2BundleMember) GetUnidirAvailableBw() uint32 {
+	if x != nil {
+		return x.UnidirAvailableBw
+	}
+	return 0
+}
+
+func (x *L2BundleMember) GetUnidirBwUtilization() uint32 {
+	if x != nil {
+		return x.UnidirBwUtilization
+	}
+	return 0
+}
+
+// MulticastPrefix mirrors message.MulticastPrefix.
+type MulticastPrefix struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Action                string                 `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	Sequence              int64                  `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Hash                  string                 `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	RouterHash            string                 `protobuf:"bytes,4,opt,name=router_hash,json=routerHash,proto3" json:"router_hash,omitempty"`
+	RouterIp              string                 `protobuf:"bytes,5,opt,name=router_ip,json=routerIp,proto3" json:"router_ip,omitempty"`
+	BaseAttrs             *BaseAttributes        `protobuf:"bytes,6,opt,name=base_attrs,json=baseAttrs,proto3" json:"base_attrs,omitempty"`
+	PeerHash              string                 `protobuf:"bytes,7,opt,name=peer_hash,json=peerHash,proto3" json:"peer_hash,omitempty"`
+	PeerIp                string                 `protobuf:"bytes,8,opt,name=peer_ip,json=peerIp,proto3" json:"peer_ip,omitempty"`
+	PeerType              uint32                 `protobuf:"varint,9,opt,name=peer_type,json=peerType,proto3" json:"peer_type,omitempty"`
+	PeerAsn               uint32                 `protobuf:"varint,10,opt,name=peer_asn,json=peerAsn,proto3" json:"peer_asn,omitempty"`
+	Timestamp             string                 `protobuf:"bytes,11,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Prefix                string                 `protobuf:"bytes,12,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	PrefixLen             int32                  `protobuf:"varint,13,opt,name=prefix_len,json=prefixLen,proto3" json:"prefix_len,omitempty"`
+	IsIpv4                bool                   `protobuf:"varint,14,opt,name=is_ipv4,json=isIpv4,proto3" json:"is_ipv4,omitempty"`
+	OriginAs              uint32                 `protobuf:"varint,15,opt,name=origin_as,json=originAs,proto3" json:"origin_as,omitempty"`
+	Nexthop               string                 `protobuf:"bytes,16,opt,name=nexthop,proto3" json:"nexthop,omitempty"`
+	IsNexthopIpv4         bool                   `protobuf:"varint,17,opt,name=is_nexthop_ipv4,json=isNexthopIpv4,proto3" json:"is_nexthop_ipv4,omitempty"`
+	PathId                int32                  `protobuf:"varint,18,opt,name=path_id,json=pathId,proto3" json:"path_id,omitempty"`
+	IsEor                 bool                   `protobuf:"varint,19,opt,name=is_eor,json=isEor,proto3" json:"is_eor,omitempty"`
+	IsAdjRibInPostPolicy  bool                   `protobuf:"varint,20,opt,name=is_adj_rib_in_post_policy,json=isAdjRibInPostPolicy,proto3" json:"is_adj_rib_in_post_policy,omitempty"`
+	IsAdjRibOutPostPolicy bool                   `protobuf:"varint,21,opt,name=is_adj_rib_out_post_policy,json=isAdjRibOutPostPolicy,proto3" json:"is_adj_rib_out_post_policy,omitempty"`
+	IsAdjRibOut           bool                   `protobuf:"varint,22,opt,name=is_adj_rib_out,json=isAdjRibOut,proto3" json:"is_adj_rib_out,omitempty"`
+	IsLocRib              bool                   `protobuf:"varint,23,opt,name=is_loc_rib,json=isLocRib,proto3" json:"is_loc_rib,omitempty"`
+	IsLocRibFiltered      bool                   `protobuf:"varint,24,opt,name=is_loc_rib_filtered,json=isLocRibFiltered,proto3" json:"is_loc_rib_filtered,omitempty"`
+	TableName             string                 `protobuf:"bytes,25,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	UserLabels            map[string]string      `protobuf:"bytes,26,rep,name=user_labels,json=userLabels,proto3" json:"user_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *MulticastPrefix) Reset() {
+	*x = MulticastPrefix{}
+	mi := &file_pkg_api_messages_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MulticastPrefix) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MulticastPrefix) ProtoMessage() {}
+
+func (x *MulticastPrefix) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MulticastPrefix.ProtoReflect.Descriptor instead.
+func (*MulticastPrefix) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *MulticastPrefix) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *MulticastPrefix) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *MulticastPrefix) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *MulticastPrefix) GetRouterHash() string {
+	if x != nil {
+		return x.RouterHash
+	}
+	return ""
+}
+
+func (x *MulticastPrefix) GetRouterIp() string {
+	if x != nil {
+		return x.RouterIp
+	}
+	return ""
+}
+
+func (x *MulticastPrefix) GetBaseAttrs() *BaseAttributes {
+	if x != nil {
+		return x.BaseAttrs
+	}
+	return nil
+}
+
+func (x *MulticastPrefix) GetPeerHash() string {
+	if x != nil {
+		return x.PeerHash
+	}
+	return ""
+}
+
+func (x *MulticastPrefix) GetPeerIp() string {
+	if x != nil {
+		return x.PeerIp
+	}
+	return ""
+}
+
+func (x *MulticastPrefix) GetPeerType() uint32 {
+	if x != nil {
+		return x.PeerType
+	}
+	return 0
+}
+
+func (x *MulticastPrefix) GetPeerAsn() uint32 {
+	if x != nil {
+		return x.PeerAsn
+	}
+	return 0
+}
+
+func (x *MulticastPrefix) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *MulticastPrefix) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *MulticastPrefix) GetPrefixLen() int32 {
+	if x != nil {
+		return x.PrefixLen
+	}
+	return 0
+}
+
+func (x *MulticastPrefix) GetIsIpv4() bool {
+	if x != nil {
+		return x.IsIpv4
+	}
+	return false
+}
+
+func (x *MulticastPrefix) GetOriginAs() uint32 {
+	if x != nil {
+		return x.OriginAs
+	}
+	return 0
+}
+
+func (x *MulticastPrefix) GetNexthop() string {
+	if x != nil {
+		return x.Nexthop
+	}
+	return ""
+}
+
+func (x *MulticastPrefix) GetIsNexthopIpv4() bool {
+	if x != nil {
+		return x.IsNexthopIpv4
+	}
+	return false
+}
+
+func (x *MulticastPrefix) GetPathId() int32 {
+	if x != nil {
+		return x.PathId
+	}
+	return 0
+}
+
+func (x *MulticastPrefix) GetIsEor() bool {
+	if x != nil {
+		return x.IsEor
+	}
+	return false
+}
+
+func (x *MulticastPrefix) GetIsAdjRibInPostPolicy() bool {
+	if x != nil {
+		return x.IsAdjRibInPostPolicy
+	}
+	return false
+}
+
+func (x *MulticastPrefix) GetIsAdjRibOutPostPolicy() bool {
+	if x != nil {
+		return x.IsAdjRibOutPostPolicy
+	}
+	return false
+}
+
+func (x *MulticastPrefix) GetIsAdjRibOut() bool {
+	if x != nil {
+		return x.IsAdjRibOut
+	}
+	return false
+}
+
+func (x *MulticastPrefix) GetIsLocRib() bool {
+	if x != nil {
+		return x.IsLocRib
+	}
+	return false
+}
+
+func (x *MulticastPrefix) GetIsLocRibFiltered() bool {
+	if x != nil {
+		return x.IsLocRibFiltered
+	}
+	return false
+}
+
+func (x *MulticastPrefix) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+func (x *MulticastPrefix) GetUserLabels() map[string]string {
+	if x != nil {
+		return x.UserLabels
+	}
+	return nil
+}
+
+// MCASTVPNPrefix mirrors message.MCASTVPNPrefix.
+type MCASTVPNPrefix struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Action                string                 `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	Sequence              int64                  `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Hash                  string                 `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	RouterHash            string                 `protobuf:"bytes,4,opt,name=router_hash,json=routerHash,proto3" json:"router_hash,omitempty"`
+	RouterIp              string                 `protobuf:"bytes,5,opt,name=router_ip,json=routerIp,proto3" json:"router_ip,omitempty"`
+	BaseAttrs             *BaseAttributes        `protobuf:"bytes,6,opt,name=base_attrs,json=baseAttrs,proto3" json:"base_attrs,omitempty"`
+	PeerHash              string                 `protobuf:"bytes,7,opt,name=peer_hash,json=peerHash,proto3" json:"peer_hash,omitempty"`
+	PeerIp                string                 `protobuf:"bytes,8,opt,name=peer_ip,json=peerIp,proto3" json:"peer_ip,omitempty"`
+	PeerType              uint32                 `protobuf:"varint,9,opt,name=peer_type,json=peerType,proto3" json:"peer_type,omitempty"`
+	PeerAsn               uint32                 `protobuf:"varint,10,opt,name=peer_asn,json=peerAsn,proto3" json:"peer_asn,omitempty"`
+	Timestamp             string                 `protobuf:"bytes,11,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	RouteType             uint32                 `protobuf:"varint,12,opt,name=route_type,json=routeType,proto3" json:"route_type,omitempty"`
+	Rd                    string                 `protobuf:"bytes,13,opt,name=rd,proto3" json:"rd,omitempty"`
+	OriginatorIp          string                 `protobuf:"bytes,14,opt,name=originator_ip,json=originatorIp,proto3" json:"originator_ip,omitempty"`
+	MulticastSource       string                 `protobuf:"bytes,15,opt,name=multicast_source,json=multicastSource,proto3" json:"multicast_source,omitempty"`
+	MulticastGroup        string                 `protobuf:"bytes,16,opt,name=multicast_group,json=multicastGroup,proto3" json:"multicast_group,omitempty"`
+	SourceAs              uint32                 `protobuf:"varint,17,opt,name=source_as,json=sourceAs,proto3" json:"source_as,omitempty"`
+	RouteKey              string                 `protobuf:"bytes,18,opt,name=route_key,json=routeKey,proto3" json:"route_key,omitempty"`
+	Nexthop               string                 `protobuf:"bytes,19,opt,name=nexthop,proto3" json:"nexthop,omitempty"`
+	IsIpv4                bool                   `protobuf:"varint,20,opt,name=is_ipv4,json=isIpv4,proto3" json:"is_ipv4,omitempty"`
+	IsNexthopIpv4         bool                   `protobuf:"varint,21,opt,name=is_nexthop_ipv4,json=isNexthopIpv4,proto3" json:"is_nexthop_ipv4,omitempty"`
+	IsEor                 bool                   `protobuf:"varint,22,opt,name=is_eor,json=isEor,proto3" json:"is_eor,omitempty"`
+	IsAdjRibInPostPolicy  bool                   `protobuf:"varint,23,opt,name=is_adj_rib_in_post_policy,json=isAdjRibInPostPolicy,proto3" json:"is_adj_rib_in_post_policy,omitempty"`
+	IsAdjRibOutPostPolicy bool                   `protobuf:"varint,24,opt,name=is_adj_rib_out_post_policy,json=isAdjRibOutPostPolicy,proto3" json:"is_adj_rib_out_post_policy,omitempty"`
+	IsAdjRibOut           bool                   `protobuf:"varint,25,opt,name=is_adj_rib_out,json=isAdjRibOut,proto3" json:"is_adj_rib_out,omitempty"`
+	IsLocRib              bool                   `protobuf:"varint,26,opt,name=is_loc_rib,json=isLocRib,proto3" json:"is_loc_rib,omitempty"`
+	IsLocRibFiltered      bool                   `protobuf:"varint,27,opt,name=is_loc_rib_filtered,json=isLocRibFiltered,proto3" json:"is_loc_rib_filtered,omitempty"`
+	TableName             string                 `protobuf:"bytes,28,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	UserLabels            map[string]string      `protobuf:"bytes,29,rep,name=user_labels,json=userLabels,proto3" json:"user_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *MCASTVPNPrefix) Reset() {
+	*x = MCASTVPNPrefix{}
+	mi := &file_pkg_api_messages_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MCASTVPNPrefix) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MCASTVPNPrefix) ProtoMessage() {}
+
+func (x *MCASTVPNPrefix) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MCASTVPNPrefix.ProtoReflect.Descriptor instead.
+func (*MCASTVPNPrefix) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *MCASTVPNPrefix) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *MCASTVPNPrefix) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *MCASTVPNPrefix) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *MCASTVPNPrefix) GetRouterHash() string {
+	if x != nil {
+		return x.RouterHash
+	}
+	return ""
+}
+
+func (x *MCASTVPNPrefix) GetRouterIp() string {
+	if x != nil {
+		return x.RouterIp
+	}
+	return ""
+}
+
+func (x *MCASTVPNPrefix) GetBaseAttrs() *BaseAttributes {
+	if x != nil {
+		return x.BaseAttrs
+	}
+	return nil
+}
+
+func (x *MCASTVPNPrefix) GetPeerHash() string {
+	if x != nil {
+		return x.PeerHash
+	}
+	return ""
+}
+
+func (x *MCASTVPNPrefix) GetPeerIp() string {
+	if x != nil {
+		return x.PeerIp
+	}
+	return ""
+}
+
+func (x *MCASTVPNPrefix) GetPeerType() uint32 {
+	if x != nil {
+		return x.PeerType
+	}
+	return 0
+}
+
+func (x *MCASTVPNPrefix) GetPeerAsn() uint32 {
+	if x != nil {
+		return x.PeerAsn
+	}
+	return 0
+}
+
+func (x *MCASTVPNPrefix) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *MCASTVPNPrefix) GetRouteType() uint32 {
+	if x != nil {
+		return x.RouteType
+	}
+	return 0
+}
+
+func (x *MCASTVPNPrefix) GetRd() string {
+	if x != nil {
+		return x.Rd
+	}
+	return ""
+}
+
+func (x *MCASTVPNPrefix) GetOriginatorIp() string {
+	if x != nil {
+		return x.OriginatorIp
+	}
+	return ""
+}
+
+func (x *MCASTVPNPrefix) GetMulticastSource() string {
+	if x != nil {
+		return x.MulticastSource
+	}
+	return ""
+}
+
+func (x *MCASTVPNPrefix) GetMulticastGroup() string {
+	if x != nil {
+		return x.MulticastGroup
+	}
+	return ""
+}
+
+func (x *MCASTVPNPrefix) GetSourceAs() uint32 {
+	if x != nil {
+		return x.SourceAs
+	}
+	return 0
+}
+
+func (x *MCASTVPNPrefix) GetRouteKey() string {
+	if x != nil {
+		return x.RouteKey
+	}
+	return ""
+}
+
+func (x *MCASTVPNPrefix) GetNexthop() string {
+	if x != nil {
+		return x.Nexthop
+	}
+	return ""
+}
+
+func (x *MCASTVPNPrefix) GetIsIpv4() bool {
+	if x != nil {
+		return x.IsIpv4
+	}
+	return false
+}
+
+func (x *MCASTVPNPrefix) GetIsNexthopIpv4() bool {
+	if x != nil {
+		return x.IsNexthopIpv4
+	}
+	return false
+}
+
+func (x *MCASTVPNPrefix) GetIsEor() bool {
+	if x != nil {
+		return x.IsEor
+	}
+	return false
+}
+
+func (x *MCASTVPNPrefix) GetIsAdjRibInPostPolicy() bool {
+	if x != nil {
+		return x.IsAdjRibInPostPolicy
+	}
+	return false
+}
+
+func (x *MCASTVPNPrefix) GetIsAdjRibOutPostPolicy() bool {
+	if x != nil {
+		return x.IsAdjRibOutPostPolicy
+	}
+	return false
+}
+
+func (x *MCASTVPNPrefix) GetIsAdjRibOut() bool {
+	if x != nil {
+		return x.IsAdjRibOut
+	}
+	return false
+}
+
+func (x *MCASTVPNPrefix) GetIsLocRib() bool {
+	if x != nil {
+		return x.IsLocRib
+	}
+	return false
+}
+
+func (x *MCASTVPNPrefix) GetIsLocRibFiltered() bool {
+	if x != nil {
+		return x.IsLocRibFiltered
+	}
+	return false
+}
+
+func (x *MCASTVPNPrefix) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+func (x *MCASTVPNPrefix) GetUserLabels() map[string]string {
+	if x != nil {
+		return x.UserLabels
+	}
+	return nil
+}
+
+// RTCPrefix mirrors message.RTCPrefix.
+type RTCPrefix struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Action                string                 `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	Sequence              int64                  `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Hash                  string                 `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	RouterHash            string                 `protobuf:"bytes,4,opt,name=router_hash,json=routerHash,proto3" json:"router_hash,omitempty"`
+	RouterIp              string                 `protobuf:"bytes,5,opt,name=router_ip,json=routerIp,proto3" json:"router_ip,omitempty"`
+	BaseAttrs             *BaseAttributes        `protobuf:"bytes,6,opt,name=base_attrs,json=baseAttrs,proto3" json:"base_attrs,omitempty"`
+	PeerHash              string                 `protobuf:"bytes,7,opt,name=peer_hash,json=peerHash,proto3" json:"peer_hash,omitempty"`
+	PeerIp                string                 `protobuf:"bytes,8,opt,name=peer_ip,json=peerIp,proto3" json:"peer_ip,omitempty"`
+	PeerType              uint32                 `protobuf:"varint,9,opt,name=peer_type,json=peerType,proto3" json:"peer_type,omitempty"`
+	PeerAsn               uint32                 `protobuf:"varint,10,opt,name=peer_asn,json=peerAsn,proto3" json:"peer_asn,omitempty"`
+	Timestamp             string                 `protobuf:"bytes,11,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Length                uint32                 `protobuf:"varint,12,opt,name=length,proto3" json:"length,omitempty"`
+	OriginAs              uint32                 `protobuf:"varint,13,opt,name=origin_as,json=originAs,proto3" json:"origin_as,omitempty"`
+	RouteTarget           string                 `protobuf:"bytes,14,opt,name=route_target,json=routeTarget,proto3" json:"route_target,omitempty"`
+	IsIpv4                bool                   `protobuf:"varint,15,opt,name=is_ipv4,json=isIpv4,proto3" json:"is_ipv4,omitempty"`
+	IsEor                 bool                   `protobuf:"varint,16,opt,name=is_eor,json=isEor,proto3" json:"is_eor,omitempty"`
+	IsAdjRibInPostPolicy  bool                   `protobuf:"varint,17,opt,name=is_adj_rib_in_post_policy,json=isAdjRibInPostPolicy,proto3" json:"is_adj_rib_in_post_policy,omitempty"`
+	IsAdjRibOutPostPolicy bool                   `protobuf:"varint,18,opt,name=is_adj_rib_out_post_policy,json=isAdjRibOutPostPolicy,proto3" json:"is_adj_rib_out_post_policy,omitempty"`
+	IsAdjRibOut           bool                   `protobuf:"varint,19,opt,name=is_adj_rib_out,json=isAdjRibOut,proto3" json:"is_adj_rib_out,omitempty"`
+	IsLocRib              bool                   `protobuf:"varint,20,opt,name=is_loc_rib,json=isLocRib,proto3" json:"is_loc_rib,omitempty"`
+	IsLocRibFiltered      bool                   `protobuf:"varint,21,opt,name=is_loc_rib_filtered,json=isLocRibFiltered,proto3" json:"is_loc_rib_filtered,omitempty"`
+	TableName             string                 `protobuf:"bytes,22,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	UserLabels            map[string]string      `protobuf:"bytes,23,rep,name=user_labels,json=userLabels,proto3" json:"user_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *RTCPrefix) Reset() {
+	*x = RTCPrefix{}
+	mi := &file_pkg_api_messages_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RTCPrefix) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RTCPrefix) ProtoMessage() {}
+
+func (x *RTCPrefix) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RTCPrefix.ProtoReflect.Descriptor instead.
+func (*RTCPrefix) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *RTCPrefix) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *RTCPrefix) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *RTCPrefix) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *RTCPrefix) GetRouterHash() string {
+	if x != nil {
+		return x.RouterHash
+	}
+	return ""
+}
+
+func (x *RTCPrefix) GetRouterIp() string {
+	if x != nil {
+		return x.RouterIp
+	}
+	return ""
+}
+
+func (x *RTCPrefix) GetBaseAttrs() *BaseAttributes {
+	if x != nil {
+		return x.BaseAttrs
+	}
+	return nil
+}
+
+func (x *RTCPrefix) GetPeerHash() string {
+	if x != nil {
+		return x.PeerHash
+	}
+	return ""
+}
+
+func (x *RTCPrefix) GetPeerIp() string {
+	if x != nil {
+		return x.PeerIp
+	}
+	return ""
+}
+
+func (x *RTCPrefix) GetPeerType() uint32 {
+	if x != nil {
+		return x.PeerType
+	}
+	return 0
+}
+
+func (x *RTCPrefix) GetPeerAsn() uint32 {
+	if x != nil {
+		return x.PeerAsn
+	}
+	return 0
+}
+
+func (x *RTCPrefix) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *RTCPrefix) GetLength() uint32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *RTCPrefix) GetOriginAs() uint32 {
+	if x != nil {
+		return x.OriginAs
+	}
+	return 0
+}
+
+func (x *RTCPrefix) GetRouteTarget() string {
+	if x != nil {
+		return x.RouteTarget
+	}
+	return ""
+}
+
+func (x *RTCPrefix) GetIsIpv4() bool {
+	if x != nil {
+		return x.IsIpv4
+	}
+	return false
+}
+
+func (x *RTCPrefix) GetIsEor() bool {
+	if x != nil {
+		return x.IsEor
+	}
+	return false
+}
+
+func (x *RTCPrefix) GetIsAdjRibInPostPolicy() bool {
+	if x != nil {
+		return x.IsAdjRibInPostPolicy
+	}
+	return false
+}
+
+func (x *RTCPrefix) GetIsAdjRibOutPostPolicy() bool {
+	if x != nil {
+		return x.IsAdjRibOutPostPolicy
+	}
+	return false
+}
+
+func (x *RTCPrefix) GetIsAdjRibOut() bool {
+	if x != nil {
+		return x.IsAdjRibOut
+	}
+	return false
+}
+
+func (x *RTCPrefix) GetIsLocRib() bool {
+	if x != nil {
+		return x.IsLocRib
+	}
+	return false
+}
+
+func (x *RTCPrefix) GetIsLocRibFiltered() bool {
+	if x != nil {
+		return x.IsLocRibFiltered
+	}
+	return false
+}
+
+func (x *RTCPrefix) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+func (x *RTCPrefix) GetUserLabels() map[string]string {
+	if x != nil {
+		return x.UserLabels
+	}
+	return nil
+}
+
+// L3VPNPrefix mirrors message.L3VPNPrefix.
+type L3VPNPrefix struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Action                string                 `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	Sequence              int64                  `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Hash                  string                 `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	RouterHash            string                 `protobuf:"bytes,4,opt,name=router_hash,json=routerHash,proto3" json:"router_hash,omitempty"`
+	RouterIp              string                 `protobuf:"bytes,5,opt,name=router_ip,json=routerIp,proto3" json:"router_ip,omitempty"`
+	BaseAttrs             *BaseAttributes        `protobuf:"bytes,6,opt,name=base_attrs,json=baseAttrs,proto3" json:"base_attrs,omitempty"`
+	PeerHash              string                 `protobuf:"bytes,7,opt,name=peer_hash,json=peerHash,proto3" json:"peer_hash,omitempty"`
+	PeerIp                string                 `protobuf:"bytes,8,opt,name=peer_ip,json=peerIp,proto3" json:"peer_ip,omitempty"`
+	PeerType              uint32                 `protobuf:"varint,9,opt,name=peer_type,json=peerType,proto3" json:"peer_type,omitempty"`
+	PeerAsn               uint32                 `protobuf:"varint,10,opt,name=peer_asn,json=peerAsn,proto3" json:"peer_asn,omitempty"`
+	Timestamp             string                 `protobuf:"bytes,11,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Prefix                string                 `protobuf:"bytes,12,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	PrefixLen             int32                  `protobuf:"varint,13,opt,name=prefix_len,json=prefixLen,proto3" json:"prefix_len,omitempty"`
+	IsIpv4                bool                   `protobuf:"varint,14,opt,name=is_ipv4,json=isIpv4,proto3" json:"is_ipv4,omitempty"`
+	OriginAs              uint32                 `protobuf:"varint,15,opt,name=origin_as,json=originAs,proto3" json:"origin_as,omitempty"`
+	Nexthop               string                 `protobuf:"bytes,16,opt,name=nexthop,proto3" json:"nexthop,omitempty"`
+	ClusterList           string                 `protobuf:"bytes,17,opt,name=cluster_list,json=clusterList,proto3" json:"cluster_list,omitempty"`
+	IsNexthopIpv4         bool                   `protobuf:"varint,18,opt,name=is_nexthop_ipv4,json=isNexthopIpv4,proto3" json:"is_nexthop_ipv4,omitempty"`
+	PathId                int32                  `protobuf:"varint,19,opt,name=path_id,json=pathId,proto3" json:"path_id,omitempty"`
+	Labels                []uint32               `protobuf:"varint,20,rep,packed,name=labels,proto3" json:"labels,omitempty"`
+	OriginValidation      *string                `protobuf:"bytes,21,opt,name=origin_validation,json=originValidation,proto3,oneof" json:"origin_validation,omitempty"`
+	VpnRd                 string                 `protobuf:"bytes,22,opt,name=vpn_rd,json=vpnRd,proto3" json:"vpn_rd,omitempty"`
+	VpnRdType             uint32                 `protobuf:"varint,23,opt,name=vpn_rd_type,json=vpnRdType,proto3" json:"vpn_rd_type,omitempty"`
+	RouteTargets          []string               `protobuf:"bytes,24,rep,name=route_targets,json=routeTargets,proto3" json:"route_targets,omitempty"`
+	Vrf                   string                 `protobuf:"bytes,25,opt,name=vrf,proto3" json:"vrf,omitempty"`
+	PrefixSid             *PSid                  `protobuf:"bytes,26,opt,name=prefix_sid,json=prefixSid,proto3" json:"prefix_sid,omitempty"`
+	IsEor                 bool                   `protobuf:"varint,27,opt,name=is_eor,json=isEor,proto3" json:"is_eor,omitempty"`
+	IsAdjRibInPostPolicy  bool                   `protobuf:"varint,28,opt,name=is_adj_rib_in_post_policy,json=isAdjRibInPostPolicy,proto3" json:"is_adj_rib_in_post_policy,omitempty"`
+	IsAdjRibOutPostPolicy bool                   `protobuf:"varint,29,opt,name=is_adj_rib_out_post_policy,json=isAdjRibOutPostPolicy,proto3" json:"is_adj_rib_out_post_policy,omitempty"`
+	IsAdjRibOut           bool                   `protobuf:"varint,30,opt,name=is_adj_rib_out,json=isAdjRibOut,proto3" json:"is_adj_rib_out,omitempty"`
+	IsLocRib              bool                   `protobuf:"varint,31,opt,name=is_loc_rib,json=isLocRib,proto3" json:"is_loc_rib,omitempty"`
+	IsLocRibFiltered      bool                   `protobuf:"varint,32,opt,name=is_loc_rib_filtered,json=isLocRibFiltered,proto3" json:"is_loc_rib_filtered,omitempty"`
+	TableName             string                 `protobuf:"bytes,33,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	UserLabels            map[string]string      `protobuf:"bytes,34,rep,name=user_labels,json=userLabels,proto3" json:"user_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	BaseAttrHash          string                 `protobuf:"bytes,35,opt,name=base_attr_hash,json=baseAttrHash,proto3" json:"base_attr_hash,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *L3VPNPrefix) Reset() {
+	*x = L3VPNPrefix{}
+	mi := &file_pkg_api_messages_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *L3VPNPrefix) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*L3VPNPrefix) ProtoMessage() {}
+
+func (x *L3VPNPrefix) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use L3VPNPrefix.ProtoReflect.Descriptor instead.
+func (*L3VPNPrefix) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *L3VPNPrefix) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *L3VPNPrefix) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *L3VPNPrefix) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *L3VPNPrefix) GetRouterHash() string {
+	if x != nil {
+		return x.RouterHash
+	}
+	return ""
+}
+
+func (x *L3VPNPrefix) GetRouterIp() string {
+	if x != nil {
+		return x.RouterIp
+	}
+	return ""
+}
+
+func (x *L3VPNPrefix) GetBaseAttrs() *BaseAttributes {
+	if x != nil {
+		return x.BaseAttrs
+	}
+	return nil
+}
+
+func (x *L3VPNPrefix) GetPeerHash() string {
+	if x != nil {
+		return x.PeerHash
+	}
+	return ""
+}
+
+func (x *L3VPNPrefix) GetPeerIp() string {
+	if x != nil {
+		return x.PeerIp
+	}
+	return ""
+}
+
+func (x *L3VPNPrefix) GetPeerType() uint32 {
+	if x != nil {
+		return x.PeerType
+	}
+	return 0
+}
+
+func (x *L3VPNPrefix) GetPeerAsn() uint32 {
+	if x != nil {
+		return x.PeerAsn
+	}
+	return 0
+}
+
+func (x *L3VPNPrefix) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *L3VPNPrefix) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *L3VPNPrefix) GetPrefixLen() int32 {
+	if x != nil {
+		return x.PrefixLen
+	}
+	return 0
+}
+
+func (x *L3VPNPrefix) GetIsIpv4() bool {
+	if x != nil {
+		return x.IsIpv4
+	}
+	return false
+}
+
+func (x *L3VPNPrefix) GetOriginAs() uint32 {
+	if x != nil {
+		return x.OriginAs
+	}
+	return 0
+}
+
+func (x *L3VPNPrefix) GetNexthop() string {
+	if x != nil {
+		return x.Nexthop
+	}
+	return ""
+}
+
+func (x *L3VPNPrefix) GetClusterList() string {
+	if x != nil {
+		return x.ClusterList
+	}
+	return ""
+}
+
+func (x *L3VPNPrefix) GetIsNexthopIpv4() bool {
+	if x != nil {
+		return x.IsNexthopIpv4
+	}
+	return false
+}
+
+func (x *L3VPNPrefix) GetPathId() int32 {
+	if x != nil {
+		return x.PathId
+	}
+	return 0
+}
+
+func (x *L3VPNPrefix) GetLabels() []uint32 {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *L3VPNPrefix) GetOriginValidation() string {
+	if x != nil && x.OriginValidation != nil {
+		return *x.OriginValidation
+	}
+	return ""
+}
+
+func (x *L3VPNPrefix) GetVpnRd() string {
+	if x != nil {
+		return x.VpnRd
+	}
+	return ""
+}
+
+func (x *L3VPNPrefix) GetVpnRdType() uint32 {
+	if x != nil {
+		return x.VpnRdType
+	}
+	return 0
+}
+
+func (x *L3VPNPrefix) GetRouteTargets() []string {
+	if x != nil {
+		return x.RouteTargets
+	}
+	return nil
+}
+
+func (x *L3VPNPrefix) GetVrf() string {
+	if x != nil {
+		return x.Vrf
+	}
+	return ""
+}
+
+func (x *L3VPNPrefix) GetPrefixSid() *PSid {
+	if x != nil {
+		return x.PrefixSid
+	}
+	return nil
+}
+
+func (x *L3VPNPrefix) GetIsEor() bool {
+	if x != nil {
+		return x.IsEor
+	}
+	return false
+}
+
+func (x *L3VPNPrefix) GetIsAdjRibInPostPolicy() bool {
+	if x != nil {
+		return x.IsAdjRibInPostPolicy
+	}
+	return false
+}
+
+func (x *L3VPNPrefix) GetIsAdjRibOutPostPolicy() bool {
+	if x != nil {
+		return x.IsAdjRibOutPostPolicy
+	}
+	return false
+}
+
+func (x *L3VPNPrefix) GetIsAdjRibOut() bool {
+	if x != nil {
+		return x.IsAdjRibOut
+	}
+	return false
+}
+
+func (x *L3VPNPrefix) GetIsLocRib() bool {
+	if x != nil {
+		return x.IsLocRib
+	}
+	return false
+}
+
+func (x *L3VPNPrefix) GetIsLocRibFiltered() bool {
+	if x != nil {
+		return x.IsLocRibFiltered
+	}
+	return false
+}
+
+func (x *L3VPNPrefix) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+func (x *L3VPNPrefix) GetUserLabels() map[string]string {
+	if x != nil {
+		return x.UserLabels
+	}
+	return nil
+}
+
+func (x *L3VPNPrefix) GetBaseAttrHash() string {
+	if x != nil {
+		return x.BaseAttrHash
+	}
+	return ""
+}
+
+// LSPrefix mirrors message.LSPrefix.
+type LSPrefix struct {
+	state                 protoimpl.MessageState   `protogen:"open.v1"`
+	Action                string                   `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	Sequence              int64                    `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Hash                  string                   `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	RouterHash            string                   `protobuf:"bytes,4,opt,name=router_hash,json=routerHash,proto3" json:"router_hash,omitempty"`
+	RouterIp              string                   `protobuf:"bytes,5,opt,name=router_ip,json=routerIp,proto3" json:"router_ip,omitempty"`
+	DomainId              int64                    `protobuf:"varint,6,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	PeerHash              string                   `protobuf:"bytes,7,opt,name=peer_hash,json=peerHash,proto3" json:"peer_hash,omitempty"`
+	PeerIp                string                   `protobuf:"bytes,8,opt,name=peer_ip,json=peerIp,proto3" json:"peer_ip,omitempty"`
+	PeerType              uint32                   `protobuf:"varint,9,opt,name=peer_type,json=peerType,proto3" json:"peer_type,omitempty"`
+	PeerAsn               uint32                   `protobuf:"varint,10,opt,name=peer_asn,json=peerAsn,proto3" json:"peer_asn,omitempty"`
+	Timestamp             string                   `protobuf:"bytes,11,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	IgpRouterId           string                   `protobuf:"bytes,12,opt,name=igp_router_id,json=igpRouterId,proto3" json:"igp_router_id,omitempty"`
+	RouterId              string                   `protobuf:"bytes,13,opt,name=router_id,json=routerId,proto3" json:"router_id,omitempty"`
+	LsId                  uint32                   `protobuf:"varint,14,opt,name=ls_id,json=lsId,proto3" json:"ls_id,omitempty"`
+	ProtocolId            uint32                   `protobuf:"varint,15,opt,name=protocol_id,json=protocolId,proto3" json:"protocol_id,omitempty"`
+	Protocol              string                   `protobuf:"bytes,16,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	AreaId                string                   `protobuf:"bytes,17,opt,name=area_id,json=areaId,proto3" json:"area_id,omitempty"`
+	Nexthop               string                   `protobuf:"bytes,18,opt,name=nexthop,proto3" json:"nexthop,omitempty"`
+	LocalNodeHash         string                   `protobuf:"bytes,19,opt,name=local_node_hash,json=localNodeHash,proto3" json:"local_node_hash,omitempty"`
+	MtIdTlv               *MultiTopologyIdentifier `protobuf:"bytes,20,opt,name=mt_id_tlv,json=mtIdTlv,proto3" json:"mt_id_tlv,omitempty"`
+	OspfRouteType         uint32                   `protobuf:"varint,21,opt,name=ospf_route_type,json=ospfRouteType,proto3" json:"ospf_route_type,omitempty"`
+	IgpFlags              *IGPFlags                `protobuf:"bytes,22,opt,name=igp_flags,json=igpFlags,proto3" json:"igp_flags,omitempty"`
+	RouteTag              []uint32                 `protobuf:"varint,23,rep,packed,name=route_tag,json=routeTag,proto3" json:"route_tag,omitempty"`
+	ExtRouteTag           []uint64                 `protobuf:"varint,24,rep,packed,name=ext_route_tag,json=extRouteTag,proto3" json:"ext_route_tag,omitempty"`
+	OspfFwdAddr           string                   `protobuf:"bytes,25,opt,name=ospf_fwd_addr,json=ospfFwdAddr,proto3" json:"ospf_fwd_addr,omitempty"`
+	Prefix                string                   `protobuf:"bytes,26,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	PrefixLen             int32                    `protobuf:"varint,27,opt,name=prefix_len,json=prefixLen,proto3" json:"prefix_len,omitempty"`
+	PrefixMetric          uint32                   `protobuf:"varint,28,opt,name=prefix_metric,json=prefixMetric,proto3" json:"prefix_metric,omitempty"`
+	PrefixAttrTlvs        *PrefixAttrTLVs          `protobuf:"bytes,29,opt,name=prefix_attr_tlvs,json=prefixAttrTlvs,proto3" json:"prefix_attr_tlvs,omitempty"`
+	FlexAlgoPrefixMetric  []*FlexAlgoPrefixMetric  `protobuf:"bytes,30,rep,name=flex_algo_prefix_metric,json=flexAlgoPrefixMetric,proto3" json:"flex_algo_prefix_metric,omitempty"`
+	Srv6Locator           *LocatorTLV              `protobuf:"bytes,31,opt,name=srv6_locator,json=srv6Locator,proto3" json:"srv6_locator,omitempty"`
+	OpaquePrefixAttribute []string                 `protobuf:"bytes,32,rep,name=opaque_prefix_attribute,json=opaquePrefixAttribute,proto3" json:"opaque_prefix_attribute,omitempty"`
+	IsAdjRibInPostPolicy  bool                     `protobuf:"varint,33,opt,name=is_adj_rib_in_post_policy,json=isAdjRibInPostPolicy,proto3" json:"is_adj_rib_in_post_policy,omitempty"`
+	IsAdjRibOutPostPolicy bool                     `protobuf:"varint,34,opt,name=is_adj_rib_out_post_policy,json=isAdjRibOutPostPolicy,proto3" json:"is_adj_rib_out_post_policy,omitempty"`
+	IsAdjRibOut           bool                     `protobuf:"varint,35,opt,name=is_adj_rib_out,json=isAdjRibOut,proto3" json:"is_adj_rib_out,omitempty"`
+	IsLocRib              bool                     `protobuf:"varint,36,opt,name=is_loc_rib,json=isLocRib,proto3" json:"is_loc_rib,omitempty"`
+	IsLocRibFiltered      bool                     `protobuf:"varint,37,opt,name=is_loc_rib_filtered,json=isLocRibFiltered,proto3" json:"is_loc_rib_filtered,omitempty"`
+	TableName             string                   `protobuf:"bytes,38,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	RouteDistinguisher    string                   `protobuf:"bytes,39,opt,name=route_distinguisher,json=routeDistinguisher,proto3" json:"route_distinguisher,omitempty"`
+	UserLabels            map[string]string        `protobuf:"bytes,40,rep,name=user_labels,json=userLabels,proto3" json:"user_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *LSPrefix) Reset() {
+	*x = LSPrefix{}
+	mi := &file_pkg_api_messages_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LSPrefix) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LSPrefix) ProtoMessage() {}
+
+func (x *LSPrefix) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LSPrefix.ProtoReflect.Descriptor instead.
+func (*LSPrefix) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *LSPrefix) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *LSPrefix) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *LSPrefix) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *LSPrefix) GetRouterHash() string {
+	if x != nil {
+		return x.RouterHash
+	}
+	return ""
+}
+
+func (x *LSPrefix) GetRouterIp() string {
+	if x != nil {
+		return x.RouterIp
+	}
+	return ""
+}
+
+func (x *LSPrefix) GetDomainId() int64 {
+	if x != nil {
+		return x.DomainId
+	}
+	return 0
+}
+
+func (x *LSPrefix) GetPeerHash() string {
+	if x != nil {
+		return x.PeerHash
+	}
+	return ""
+}
+
+func (x *LSPrefix) GetPeerIp() string {
+	if x != nil {
+		return x.PeerIp
+	}
+	return ""
+}
+
+func (x *LSPrefix) GetPeerType() uint32 {
+	if x != nil {
+		return x.PeerType
+	}
+	return 0
+}
+
+func (x *LSPrefix) GetPeerAsn() uint32 {
+	if x != nil {
+		return x.PeerAsn
+	}
+	return 0
+}
+
+func (x *LSPrefix) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *LSPrefix) GetIgpRouterId() string {
+	if x != nil {
+		return x.IgpRouterId
+	}
+	return ""
+}
+
+func (x *LSPrefix) GetRouterId() string {
+	if x != nil {
+		return x.RouterId
+	}
+	return ""
+}
+
+func (x *LSPrefix) GetLsId() uint32 {
+	if x != nil {
+		return x.LsId
+	}
+	return 0
+}
+
+func (x *LSPrefix) GetProtocolId() uint32 {
+	if x != nil {
+		return x.ProtocolId
+	}
+	return 0
+}
+
+func (x *LSPrefix) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *LSPrefix) GetAreaId() string {
+	if x != nil {
+		return x.AreaId
+	}
+	return ""
+}
+
+func (x *LSPrefix) GetNexthop() string {
+	if x != nil {
+		return x.Nexthop
+	}
+	return ""
+}
+
+func (x *LSPrefix) GetLocalNodeHash() string {
+	if x != nil {
+		return x.LocalNodeHash
+	}
+	return ""
+}
+
+func (x *LSPrefix) GetMtIdTlv() *MultiTopologyIdentifier {
+	if x != nil {
+		return x.MtIdTlv
+	}
+	return nil
+}
+
+func (x *LSPrefix) GetOspfRouteType() uint32 {
+	if x != nil {
+		return x.OspfRouteType
+	}
+	return 0
+}
+
+func (x *LSPrefix) GetIgpFlags() *IGPFlags {
+	if x != nil {
+		return x.IgpFlags
+	}
+	return nil
+}
+
+func (x *LSPrefix) GetRouteTag() []uint32 {
+	if x != nil {
+		return x.RouteTag
+	}
+	return nil
+}
+
+func (x *LSPrefix) GetExtRouteTag() []uint64 {
+	if x != nil {
+		return x.ExtRouteTag
+	}
+	return nil
+}
+
+func (x *LSPrefix) GetOspfFwdAddr() string {
+	if x != nil {
+		return x.OspfFwdAddr
+	}
+	return ""
+}
+
+func (x *LSPrefix) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *LSPrefix) GetPrefixLen() int32 {
+	if x != nil {
+		return x.PrefixLen
+	}
+	return 0
+}
+
+func (x *LSPrefix) GetPrefixMetric() uint32 {
+	if x != nil {
+		return x.PrefixMetric
+	}
+	return 0
+}
+
+func (x *LSPrefix) GetPrefixAttrTlvs() *PrefixAttrTLVs {
+	if x != nil {
+		return x.PrefixAttrTlvs
+	}
+	return nil
+}
+
+func (x *LSPrefix) GetFlexAlgoPrefixMetric() []*FlexAlgoPrefixMetric {
+	if x != nil {
+		return x.FlexAlgoPrefixMetric
+	}
+	return nil
+}
+
+func (x *LSPrefix) GetSrv6Locator() *LocatorTLV {
+	if x != nil {
+		return x.Srv6Locator
+	}
+	return nil
+}
+
+func (x *LSPrefix) GetOpaquePrefixAttribute() []string {
+	if x != nil {
+		return x.OpaquePrefixAttribute
+	}
+	return nil
+}
+
+func (x *LSPrefix) GetIsAdjRibInPostPolicy() bool {
+	if x != nil {
+		return x.IsAdjRibInPostPolicy
+	}
+	return false
+}
+
+func (x *LSPrefix) GetIsAdjRibOutPostPolicy() bool {
+	if x != nil {
+		return x.IsAdjRibOutPostPolicy
+	}
+	return false
+}
+
+func (x *LSPrefix) GetIsAdjRibOut() bool {
+	if x != nil {
+		return x.IsAdjRibOut
+	}
+	return false
+}
+
+func (x *LSPrefix) GetIsLocRib() bool {
+	if x != nil {
+		return x.IsLocRib
+	}
+	return false
+}
+
+func (x *LSPrefix) GetIsLocRibFiltered() bool {
+	if x != nil {
+		return x.IsLocRibFiltered
+	}
+	return false
+}
+
+func (x *LSPrefix) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+func (x *LSPrefix) GetRouteDistinguisher() string {
+	if x != nil {
+		return x.RouteDistinguisher
+	}
+	return ""
+}
+
+func (x *LSPrefix) GetUserLabels() map[string]string {
+	if x != nil {
+		return x.UserLabels
+	}
+	return nil
+}
+
+// IGPFlags mirrors bgpls.IGPFlags.
+type IGPFlags struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DFlag         bool                   `protobuf:"varint,1,opt,name=d_flag,json=dFlag,proto3" json:"d_flag,omitempty"`
+	NFlag         bool                   `protobuf:"varint,2,opt,name=n_flag,json=nFlag,proto3" json:"n_flag,omitempty"`
+	LFlag         bool                   `protobuf:"varint,3,opt,name=l_flag,json=lFlag,proto3" json:"l_flag,omitempty"`
+	PFlag         bool                   `protobuf:"varint,4,opt,name=p_flag,json=pFlag,proto3" json:"p_flag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IGPFlags) Reset() {
+	*x = IGPFlags{}
+	mi := &file_pkg_api_messages_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IGPFlags) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IGPFlags) ProtoMessage() {}
+
+func (x *IGPFlags) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IGPFlags.ProtoReflect.Descriptor instead.
+func (*IGPFlags) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *IGPFlags) GetDFlag() bool {
+	if x != nil {
+		return x.DFlag
+	}
+	return false
+}
+
+func (x *IGPFlags) GetNFlag() bool {
+	if x != nil {
+		return x.NFlag
+	}
+	return false
+}
+
+func (x *IGPFlags) GetLFlag() bool {
+	if x != nil {
+		return x.LFlag
+	}
+	return false
+}
+
+func (x *IGPFlags) GetPFlag() bool {
+	if x != nil {
+		return x.PFlag
+	}
+	return false
+}
+
+// PrefixAttrTLVs mirrors bgpls.PrefixAttrTLVs.
+type PrefixAttrTLVs struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	LsPrefixSid    []*PrefixSIDTLV        `protobuf:"bytes,1,rep,name=ls_prefix_sid,json=lsPrefixSid,proto3" json:"ls_prefix_sid,omitempty"`
+	Range          *RangeTLV              `protobuf:"bytes,2,opt,name=range,proto3" json:"range,omitempty"`
+	Flags          *ProtocolFlags         `protobuf:"bytes,3,opt,name=flags,proto3" json:"flags,omitempty"`
+	SourceRouterId string                 `protobuf:"bytes,4,opt,name=source_router_id,json=sourceRouterId,proto3" json:"source_router_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PrefixAttrTLVs) Reset() {
+	*x = PrefixAttrTLVs{}
+	mi := &file_pkg_api_messages_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrefixAttrTLVs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrefixAttrTLVs) ProtoMessage() {}
+
+func (x *PrefixAttrTLVs) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrefixAttrTLVs.ProtoReflect.Descriptor instead.
+func (*PrefixAttrTLVs) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *PrefixAttrTLVs) GetLsPrefixSid() []*PrefixSIDTLV {
+	if x != nil {
+		return x.LsPrefixSid
+	}
+	return nil
+}
+
+func (x *PrefixAttrTLVs) GetRange() *RangeTLV {
+	if x != nil {
+		return x.Range
+	}
+	return nil
+}
+
+func (x *PrefixAttrTLVs) GetFlags() *ProtocolFlags {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+func (x *PrefixAttrTLVs) GetSourceRouterId() string {
+	if x != nil {
+		return x.SourceRouterId
+	}
+	return ""
+}
+
+// PrefixSIDTLV mirrors sr.PrefixSIDTLV.
+type PrefixSIDTLV struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Flags         *ProtocolFlags         `protobuf:"bytes,1,opt,name=flags,proto3" json:"flags,omitempty"`
+	Algo          uint32                 `protobuf:"varint,2,opt,name=algo,proto3" json:"algo,omitempty"`
+	PrefixSid     uint32                 `protobuf:"varint,3,opt,name=prefix_sid,json=prefixSid,proto3" json:"prefix_sid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrefixSIDTLV) Reset() {
+	*x = PrefixSIDTLV{}
+	mi := &file_pkg_api_messages_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrefixSIDTLV) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrefixSIDTLV) ProtoMessage() {}
+
+func (x *PrefixSIDTLV) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrefixSIDTLV.ProtoReflect.Descriptor instead.
+func (*PrefixSIDTLV) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *PrefixSIDTLV) GetFlags() *ProtocolFlags {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+func (x *PrefixSIDTLV) GetAlgo() uint32 {
+	if x != nil {
+		return x.Algo
+	}
+	return 0
+}
+
+func (x *PrefixSIDTLV) GetPrefixSid() uint32 {
+	if x != nil {
+		return x.PrefixSid
+	}
+	return 0
+}
+
+// RangeTLV mirrors bgpls.RangeTLV.
+type RangeTLV struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Flags         *ProtocolFlags         `protobuf:"bytes,1,opt,name=flags,proto3" json:"flags,omitempty"`
+	RangeSize     uint32                 `protobuf:"varint,2,opt,name=range_size,json=rangeSize,proto3" json:"range_size,omitempty"`
+	PrefixSid     []*PrefixSIDTLV        `protobuf:"bytes,3,rep,name=prefix_sid,json=prefixSid,proto3" json:"prefix_sid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RangeTLV) Reset() {
+	*x = RangeTLV{}
+	mi := &file_pkg_api_messages_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RangeTLV) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RangeTLV) ProtoMessage() {}
+
+func (x *RangeTLV) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RangeTLV.ProtoReflect.Descriptor instead.
+func (*RangeTLV) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *RangeTLV) GetFlags() *ProtocolFlags {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+func (x *RangeTLV) GetRangeSize() uint32 {
+	if x != nil {
+		return x.RangeSize
+	}
+	return 0
+}
+
+func (x *RangeTLV) GetPrefixSid() []*PrefixSIDTLV {
+	if x != nil {
+		return x.PrefixSid
+	}
+	return nil
+}
+
+// FlexAlgoPrefixMetric mirrors bgpls.FlexAlgoPrefixMetric.
+type FlexAlgoPrefixMetric struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FlexAlgo      uint32                 `protobuf:"varint,1,opt,name=flex_algo,json=flexAlgo,proto3" json:"flex_algo,omitempty"`
+	Metric        uint32                 `protobuf:"varint,2,opt,name=metric,proto3" json:"metric,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlexAlgoPrefixMetric) Reset() {
+	*x = FlexAlgoPrefixMetric{}
+	mi := &file_pkg_api_messages_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlexAlgoPrefixMetric) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlexAlgoPrefixMetric) ProtoMessage() {}
+
+func (x *FlexAlgoPrefixMetric) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlexAlgoPrefixMetric.ProtoReflect.Descriptor instead.
+func (*FlexAlgoPrefixMetric) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *FlexAlgoPrefixMetric) GetFlexAlgo() uint32 {
+	if x != nil {
+		return x.FlexAlgo
+	}
+	return 0
+}
+
+func (x *FlexAlgoPrefixMetric) GetMetric() uint32 {
+	if x != nil {
+		return x.Metric
+	}
+	return 0
+}
+
+// LocatorTLV mirrors srv6.LocatorTLV.
+type LocatorTLV struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Flags         *LocatorFlags          `protobuf:"bytes,1,opt,name=flags,proto3" json:"flags,omitempty"`
+	Algo          uint32                 `protobuf:"varint,2,opt,name=algo,proto3" json:"algo,omitempty"`
+	Metric        uint32                 `protobuf:"varint,3,opt,name=metric,proto3" json:"metric,omitempty"`
+	SubTlvs       []*SubTLV              `protobuf:"bytes,4,rep,name=sub_tlvs,json=subTlvs,proto3" json:"sub_tlvs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LocatorTLV) Reset() {
+	*x = LocatorTLV{}
+	mi := &file_pkg_api_messages_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LocatorTLV) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocatorTLV) ProtoMessage() {}
+
+func (x *LocatorTLV) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocatorTLV.ProtoReflect.Descriptor instead.
+func (*LocatorTLV) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *LocatorTLV) GetFlags() *LocatorFlags {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+func (x *LocatorTLV) GetAlgo() uint32 {
+	if x != nil {
+		return x.Algo
+	}
+	return 0
+}
+
+func (x *LocatorTLV) GetMetric() uint32 {
+	if x != nil {
+		return x.Metric
+	}
+	return 0
+}
+
+func (x *LocatorTLV) GetSubTlvs() []*SubTLV {
+	if x != nil {
+		return x.SubTlvs
+	}
+	return nil
+}
+
+// LocatorFlags mirrors srv6.LocatorFlags.
+type LocatorFlags struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DFlag         bool                   `protobuf:"varint,1,opt,name=d_flag,json=dFlag,proto3" json:"d_flag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LocatorFlags) Reset() {
+	*x = LocatorFlags{}
+	mi := &file_pkg_api_messages_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LocatorFlags) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocatorFlags) ProtoMessage() {}
+
+func (x *LocatorFlags) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocatorFlags.ProtoReflect.Descriptor instead.
+func (*LocatorFlags) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *LocatorFlags) GetDFlag() bool {
+	if x != nil {
+		return x.DFlag
+	}
+	return false
+}
+
+// LSSRv6SID mirrors message.LSSRv6SID.
+type LSSRv6SID struct {
+	state                 protoimpl.MessageState   `protogen:"open.v1"`
+	Action                string                   `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	Sequence              int64                    `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Hash                  string                   `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	RouterHash            string                   `protobuf:"bytes,4,opt,name=router_hash,json=routerHash,proto3" json:"router_hash,omitempty"`
+	RouterIp              string                   `protobuf:"bytes,5,opt,name=router_ip,json=routerIp,proto3" json:"router_ip,omitempty"`
+	DomainId              int64                    `protobuf:"varint,6,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	PeerHash              string                   `protobuf:"bytes,7,opt,name=peer_hash,json=peerHash,proto3" json:"peer_hash,omitempty"`
+	PeerIp                string                   `protobuf:"bytes,8,opt,name=peer_ip,json=peerIp,proto3" json:"peer_ip,omitempty"`
+	PeerType              uint32                   `protobuf:"varint,9,opt,name=peer_type,json=peerType,proto3" json:"peer_type,omitempty"`
+	PeerAsn               uint32                   `protobuf:"varint,10,opt,name=peer_asn,json=peerAsn,proto3" json:"peer_asn,omitempty"`
+	Timestamp             string                   `protobuf:"bytes,11,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	IgpRouterId           string                   `protobuf:"bytes,12,opt,name=igp_router_id,json=igpRouterId,proto3" json:"igp_router_id,omitempty"`
+	LocalNodeAsn          uint32                   `protobuf:"varint,13,opt,name=local_node_asn,json=localNodeAsn,proto3" json:"local_node_asn,omitempty"`
+	RouterId              string                   `protobuf:"bytes,14,opt,name=router_id,json=routerId,proto3" json:"router_id,omitempty"`
+	LsId                  uint32                   `protobuf:"varint,15,opt,name=ls_id,json=lsId,proto3" json:"ls_id,omitempty"`
+	AreaId                string                   `protobuf:"bytes,16,opt,name=area_id,json=areaId,proto3" json:"area_id,omitempty"`
+	ProtocolId            uint32                   `protobuf:"varint,17,opt,name=protocol_id,json=protocolId,proto3" json:"protocol_id,omitempty"`
+	Protocol              string                   `protobuf:"bytes,18,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	Nexthop               string                   `protobuf:"bytes,19,opt,name=nexthop,proto3" json:"nexthop,omitempty"`
+	LocalNodeHash         string                   `protobuf:"bytes,20,opt,name=local_node_hash,json=localNodeHash,proto3" json:"local_node_hash,omitempty"`
+	MtIdTlv               *MultiTopologyIdentifier `protobuf:"bytes,21,opt,name=mt_id_tlv,json=mtIdTlv,proto3" json:"mt_id_tlv,omitempty"`
+	IgpFlags              uint32                   `protobuf:"varint,22,opt,name=igp_flags,json=igpFlags,proto3" json:"igp_flags,omitempty"`
+	RouteTag              uint32                   `protobuf:"varint,23,opt,name=route_tag,json=routeTag,proto3" json:"route_tag,omitempty"`
+	ExtRouteTag           uint32                   `protobuf:"varint,24,opt,name=ext_route_tag,json=extRouteTag,proto3" json:"ext_route_tag,omitempty"`
+	OspfFwdAddr           string                   `protobuf:"bytes,25,opt,name=ospf_fwd_addr,json=ospfFwdAddr,proto3" json:"ospf_fwd_addr,omitempty"`
+	IgpMetric             uint32                   `protobuf:"varint,26,opt,name=igp_metric,json=igpMetric,proto3" json:"igp_metric,omitempty"`
+	Prefix                string                   `protobuf:"bytes,27,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	PrefixLen             int32                    `protobuf:"varint,28,opt,name=prefix_len,json=prefixLen,proto3" json:"prefix_len,omitempty"`
+	Srv6Sid               string                   `protobuf:"bytes,29,opt,name=srv6_sid,json=srv6Sid,proto3" json:"srv6_sid,omitempty"`
+	Srv6EndpointBehavior  *EndpointBehavior        `protobuf:"bytes,30,opt,name=srv6_endpoint_behavior,json=srv6EndpointBehavior,proto3" json:"srv6_endpoint_behavior,omitempty"`
+	Srv6BgpPeerNodeSid    *BGPPeerNodeSID          `protobuf:"bytes,31,opt,name=srv6_bgp_peer_node_sid,json=srv6BgpPeerNodeSid,proto3" json:"srv6_bgp_peer_node_sid,omitempty"`
+	Srv6SidStructure      *SIDStructure            `protobuf:"bytes,32,opt,name=srv6_sid_structure,json=srv6SidStructure,proto3" json:"srv6_sid_structure,omitempty"`
+	IsAdjRibInPostPolicy  bool                     `protobuf:"varint,33,opt,name=is_adj_rib_in_post_policy,json=isAdjRibInPostPolicy,proto3" json:"is_adj_rib_in_post_policy,omitempty"`
+	IsAdjRibOutPostPolicy bool                     `protobuf:"varint,34,opt,name=is_adj_rib_out_post_policy,json=isAdjRibOutPostPolicy,proto3" json:"is_adj_rib_out_post_policy,omitempty"`
+	IsAdjRibOut           bool                     `protobuf:"varint,35,opt,name=is_adj_rib_out,json=isAdjRibOut,proto3" json:"is_adj_rib_out,omitempty"`
+	IsLocRib              bool                     `protobuf:"varint,36,opt,name=is_loc_rib,json=isLocRib,proto3" json:"is_loc_rib,omitempty"`
+	IsLocRibFiltered      bool                     `protobuf:"varint,37,opt,name=is_loc_rib_filtered,json=isLocRibFiltered,proto3" json:"is_loc_rib_filtered,omitempty"`
+	TableName             string                   `protobuf:"bytes,38,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	RouteDistinguisher    string                   `protobuf:"bytes,39,opt,name=route_distinguisher,json=routeDistinguisher,proto3" json:"route_distinguisher,omitempty"`
+	UserLabels            map[string]string        `protobuf:"bytes,40,rep,name=user_labels,json=userLabels,proto3" json:"user_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *LSSRv6SID) Reset() {
+	*x = LSSRv6SID{}
+	mi := &file_pkg_api_messages_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LSSRv6SID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LSSRv6SID) ProtoMessage() {}
+
+func (x *LSSRv6SID) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LSSRv6SID.ProtoReflect.Descriptor instead.
+func (*LSSRv6SID) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *LSSRv6SID) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *LSSRv6SID) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *LSSRv6SID) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *LSSRv6SID) GetRouterHash() string {
+	if x != nil {
+		return x.RouterHash
+	}
+	return ""
+}
+
+func (x *LSSRv6SID) GetRouterIp() string {
+	if x != nil {
+		return x.RouterIp
+	}
+	return ""
+}
+
+func (x *LSSRv6SID) GetDomainId() int64 {
+	if x != nil {
+		return x.DomainId
+	}
+	return 0
+}
+
+func (x *LSSRv6SID) GetPeerHash() string {
+	if x != nil {
+		return x.PeerHash
+	}
+	return ""
+}
+
+func (x *LSSRv6SID) GetPeerIp() string {
+	if x != nil {
+		return x.PeerIp
+	}
+	return ""
+}
+
+func (x *LSSRv6SID) GetPeerType() uint32 {
+	if x != nil {
+		return x.PeerType
+	}
+	return 0
+}
+
+func (x *LSSRv6SID) GetPeerAsn() uint32 {
+	if x != nil {
+		return x.PeerAsn
+	}
+	return 0
+}
+
+func (x *LSSRv6SID) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *LSSRv6SID) GetIgpRouterId() string {
+	if x != nil {
+		return x.IgpRouterId
+	}
+	return ""
+}
+
+func (x *LSSRv6SID) GetLocalNodeAsn() uint32 {
+	if x != nil {
+		return x.LocalNodeAsn
+	}
+	return 0
+}
+
+func (x *LSSRv6SID) GetRouterId() string {
+	if x != nil {
+		return x.RouterId
+	}
+	return ""
+}
+
+func (x *LSSRv6SID) GetLsId() uint32 {
+	if x != nil {
+		return x.LsId
+	}
+	return 0
+}
+
+func (x *LSSRv6SID) GetAreaId() string {
+	if x != nil {
+		return x.AreaId
+	}
+	return ""
+}
+
+func (x *LSSRv6SID) GetProtocolId() uint32 {
+	if x != nil {
+		return x.ProtocolId
+	}
+	return 0
+}
+
+func (x *LSSRv6SID) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *LSSRv6SID) GetNexthop() string {
+	if x != nil {
+		return x.Nexthop
+	}
+	return ""
+}
+
+func (x *LSSRv6SID) GetLocalNodeHash() string {
+	if x != nil {
+		return x.LocalNodeHash
+	}
+	return ""
+}
+
+func (x *LSSRv6SID) GetMtIdTlv() *MultiTopologyIdentifier {
+	if x != nil {
+		return x.MtIdTlv
+	}
+	return nil
+}
+
+func (x *LSSRv6SID) GetIgpFlags() uint32 {
+	if x != nil {
+		return x.IgpFlags
+	}
+	return 0
+}
+
+func (x *LSSRv6SID) GetRouteTag() uint32 {
+	if x != nil {
+		return x.RouteTag
+	}
+	return 0
+}
+
+func (x *LSSRv6SID) GetExtRouteTag() uint32 {
+	if x != nil {
+		return x.ExtRouteTag
+	}
+	return 0
+}
+
+func (x *LSSRv6SID) GetOspfFwdAddr() string {
+	if x != nil {
+		return x.OspfFwdAddr
+	}
+	return ""
+}
+
+func (x *LSSRv6SID) GetIgpMetric() uint32 {
+	if x != nil {
+		return x.IgpMetric
+	}
+	return 0
+}
+
+func (x *LSSRv6SID) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *LSSRv6SID) GetPrefixLen() int32 {
+	if x != nil {
+		return x.PrefixLen
+	}
+	return 0
+}
+
+func (x *LSSRv6SID) GetSrv6Sid() string {
+	if x != nil {
+		return x.Srv6Sid
+	}
+	return ""
+}
+
+func (x *LSSRv6SID) GetSrv6EndpointBehavior() *EndpointBehavior {
+	if x != nil {
+		return x.Srv6EndpointBehavior
+	}
+	return nil
+}
+
+func (x *LSSRv6SID) GetSrv6BgpPeerNodeSid() *BGPPeerNodeSID {
+	if x != nil {
+		return x.Srv6BgpPeerNodeSid
+	}
+	return nil
+}
+
+func (x *LSSRv6SID) GetSrv6SidStructure() *SIDStructure {
+	if x != nil {
+		return x.Srv6SidStructure
+	}
+	return nil
+}
+
+func (x *LSSRv6SID) GetIsAdjRibInPostPolicy() bool {
+	if x != nil {
+		return x.IsAdjRibInPostPolicy
+	}
+	return false
+}
+
+func (x *LSSRv6SID) GetIsAdjRibOutPostPolicy() bool {
+	if x != nil {
+		return x.IsAdjRibOutPostPolicy
+	}
+	return false
+}
+
+func (x *LSSRv6SID) GetIsAdjRibOut() bool {
+	if x != nil {
+		return x.IsAdjRibOut
+	}
+	return false
+}
+
+func (x *LSSRv6SID) GetIsLocRib() bool {
+	if x != nil {
+		return x.IsLocRib
+	}
+	return false
+}
+
+func (x *LSSRv6SID) GetIsLocRibFiltered() bool {
+	if x != nil {
+		return x.IsLocRibFiltered
+	}
+	return false
+}
+
+func (x *LSSRv6SID) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+func (x *LSSRv6SID) GetRouteDistinguisher() string {
+	if x != nil {
+		return x.RouteDistinguisher
+	}
+	return ""
+}
+
+func (x *LSSRv6SID) GetUserLabels() map[string]string {
+	if x != nil {
+		return x.UserLabels
+	}
+	return nil
+}
+
+// EndpointBehavior mirrors srv6.EndpointBehavior.
+type EndpointBehavior struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	EndpointBehavior uint32                 `protobuf:"varint,1,opt,name=endpoint_behavior,json=endpointBehavior,proto3" json:"endpoint_behavior,omitempty"`
+	Flag             uint32                 `protobuf:"varint,2,opt,name=flag,proto3" json:"flag,omitempty"`
+	Algo             uint32                 `protobuf:"varint,3,opt,name=algo,proto3" json:"algo,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *EndpointBehavior) Reset() {
+	*x = EndpointBehavior{}
+	mi := &file_pkg_api_messages_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EndpointBehavior) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndpointBehavior) ProtoMessage() {}
+
+func (x *EndpointBehavior) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndpointBehavior.ProtoReflect.Descriptor instead.
+func (*EndpointBehavior) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *EndpointBehavior) GetEndpointBehavior() uint32 {
+	if x != nil {
+		return x.EndpointBehavior
+	}
+	return 0
+}
+
+func (x *EndpointBehavior) GetFlag() uint32 {
+	if x != nil {
+		return x.Flag
+	}
+	return 0
+}
+
+func (x *EndpointBehavior) GetAlgo() uint32 {
+	if x != nil {
+		return x.Algo
+	}
+	return 0
+}
+
+// SIDStructure mirrors srv6.SIDStructure.
+type SIDStructure struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Type               uint32                 `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	Length             uint32                 `protobuf:"varint,2,opt,name=length,proto3" json:"length,omitempty"`
+	LocatorBlockLength uint32                 `protobuf:"varint,3,opt,name=locator_block_length,json=locatorBlockLength,proto3" json:"locator_block_length,omitempty"`
+	LocatorNodeLength  uint32                 `protobuf:"varint,4,opt,name=locator_node_length,json=locatorNodeLength,proto3" json:"locator_node_length,omitempty"`
+	FunctionLength     uint32                 `protobuf:"varint,5,opt,name=function_length,json=functionLength,proto3" json:"function_length,omitempty"`
+	ArgumentLength     uint32                 `protobuf:"varint,6,opt,name=argument_length,json=argumentLength,proto3" json:"argument_length,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *SIDStructure) Reset() {
+	*x = SIDStructure{}
+	mi := &file_pkg_api_messages_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SIDStructure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SIDStructure) ProtoMessage() {}
+
+func (x *SIDStructure) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SIDStructure.ProtoReflect.Descriptor instead.
+func (*SIDStructure) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *SIDStructure) GetType() uint32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *SIDStructure) GetLength() uint32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *SIDStructure) GetLocatorBlockLength() uint32 {
+	if x != nil {
+		return x.LocatorBlockLength
+	}
+	return 0
+}
+
+func (x *SIDStructure) GetLocatorNodeLength() uint32 {
+	if x != nil {
+		return x.LocatorNodeLength
+	}
+	return 0
+}
+
+func (x *SIDStructure) GetFunctionLength() uint32 {
+	if x != nil {
+		return x.FunctionLength
+	}
+	return 0
+}
+
+func (x *SIDStructure) GetArgumentLength() uint32 {
+	if x != nil {
+		return x.ArgumentLength
+	}
+	return 0
+}
+
+// EVPNPrefix mirrors message.EVPNPrefix.
+type EVPNPrefix struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Action                string                 `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	Sequence              int64                  `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Hash                  string                 `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	RouterHash            string                 `protobuf:"bytes,4,opt,name=router_hash,json=routerHash,proto3" json:"router_hash,omitempty"`
+	RouterIp              string                 `protobuf:"bytes,5,opt,name=router_ip,json=routerIp,proto3" json:"router_ip,omitempty"`
+	BaseAttrs             *BaseAttributes        `protobuf:"bytes,6,opt,name=base_attrs,json=baseAttrs,proto3" json:"base_attrs,omitempty"`
+	PeerHash              string                 `protobuf:"bytes,7,opt,name=peer_hash,json=peerHash,proto3" json:"peer_hash,omitempty"`
+	RemoteBgpId           string                 `protobuf:"bytes,8,opt,name=remote_bgp_id,json=remoteBgpId,proto3" json:"remote_bgp_id,omitempty"`
+	PeerIp                string                 `protobuf:"bytes,9,opt,name=peer_ip,json=peerIp,proto3" json:"peer_ip,omitempty"`
+	PeerType              uint32                 `protobuf:"varint,10,opt,name=peer_type,json=peerType,proto3" json:"peer_type,omitempty"`
+	PeerAsn               uint32                 `protobuf:"varint,11,opt,name=peer_asn,json=peerAsn,proto3" json:"peer_asn,omitempty"`
+	Timestamp             string                 `protobuf:"bytes,12,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	IsIpv4                bool                   `protobuf:"varint,13,opt,name=is_ipv4,json=isIpv4,proto3" json:"is_ipv4,omitempty"`
+	OriginAs              uint32                 `protobuf:"varint,14,opt,name=origin_as,json=originAs,proto3" json:"origin_as,omitempty"`
+	Nexthop               string                 `protobuf:"bytes,15,opt,name=nexthop,proto3" json:"nexthop,omitempty"`
+	ClusterList           string                 `protobuf:"bytes,16,opt,name=cluster_list,json=clusterList,proto3" json:"cluster_list,omitempty"`
+	IsNexthopIpv4         bool                   `protobuf:"varint,17,opt,name=is_nexthop_ipv4,json=isNexthopIpv4,proto3" json:"is_nexthop_ipv4,omitempty"`
+	PathId                int32                  `protobuf:"varint,18,opt,name=path_id,json=pathId,proto3" json:"path_id,omitempty"`
+	Labels                []uint32               `protobuf:"varint,19,rep,packed,name=labels,proto3" json:"labels,omitempty"`
+	Rawlabels             []uint32               `protobuf:"varint,20,rep,packed,name=rawlabels,proto3" json:"rawlabels,omitempty"`
+	VpnRd                 string                 `protobuf:"bytes,21,opt,name=vpn_rd,json=vpnRd,proto3" json:"vpn_rd,omitempty"`
+	VpnRdType             uint32                 `protobuf:"varint,22,opt,name=vpn_rd_type,json=vpnRdType,proto3" json:"vpn_rd_type,omitempty"`
+	EthSegmentId          string                 `protobuf:"bytes,23,opt,name=eth_segment_id,json=ethSegmentId,proto3" json:"eth_segment_id,omitempty"`
+	EthTag                []byte                 `protobuf:"bytes,24,opt,name=eth_tag,json=ethTag,proto3" json:"eth_tag,omitempty"`
+	IpAddress             string                 `protobuf:"bytes,25,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	IpLen                 uint32                 `protobuf:"varint,26,opt,name=ip_len,json=ipLen,proto3" json:"ip_len,omitempty"`
+	GwAddress             string                 `protobuf:"bytes,27,opt,name=gw_address,json=gwAddress,proto3" json:"gw_address,omitempty"`
+	Mac                   string                 `protobuf:"bytes,28,opt,name=mac,proto3" json:"mac,omitempty"`
+	MacLen                uint32                 `protobuf:"varint,29,opt,name=mac_len,json=macLen,proto3" json:"mac_len,omitempty"`
+	RouteType             uint32                 `protobuf:"varint,30,opt,name=route_type,json=routeType,proto3" json:"route_type,omitempty"`
+	PmsiTunnel            *PMSITunnel            `protobuf:"bytes,31,opt,name=pmsi_tunnel,json=pmsiTunnel,proto3" json:"pmsi_tunnel,omitempty"`
+	MacMobility           *ECMACMobility         `protobuf:"bytes,32,opt,name=mac_mobility,json=macMobility,proto3" json:"mac_mobility,omitempty"`
+	EsiLabel              *ECESILabel            `protobuf:"bytes,33,opt,name=esi_label,json=esiLabel,proto3" json:"esi_label,omitempty"`
+	RouterMac             string                 `protobuf:"bytes,34,opt,name=router_mac,json=routerMac,proto3" json:"router_mac,omitempty"`
+	DefaultGateway        bool                   `protobuf:"varint,35,opt,name=default_gateway,json=defaultGateway,proto3" json:"default_gateway,omitempty"`
+	Layer2Attributes      *ECLayer2Attributes    `protobuf:"bytes,36,opt,name=layer2_attributes,json=layer2Attributes,proto3" json:"layer2_attributes,omitempty"`
+	DfElection            *ECDFElection          `protobuf:"bytes,37,opt,name=df_election,json=dfElection,proto3" json:"df_election,omitempty"`
+	RouteTargets          []string               `protobuf:"bytes,38,rep,name=route_targets,json=routeTargets,proto3" json:"route_targets,omitempty"`
+	IsAdjRibInPostPolicy  bool                   `protobuf:"varint,39,opt,name=is_adj_rib_in_post_policy,json=isAdjRibInPostPolicy,proto3" json:"is_adj_rib_in_post_policy,omitempty"`
+	IsAdjRibOutPostPolicy bool                   `protobuf:"varint,40,opt,name=is_adj_rib_out_post_policy,json=isAdjRibOutPostPolicy,proto3" json:"is_adj_rib_out_post_policy,omitempty"`
+	IsAdjRibOut           bool                   `protobuf:"varint,41,opt,name=is_adj_rib_out,json=isAdjRibOut,proto3" json:"is_adj_rib_out,omitempty"`
+	IsLocRib              bool                   `protobuf:"varint,42,opt,name=is_loc_rib,json=isLocRib,proto3" json:"is_loc_rib,omitempty"`
+	IsLocRibFiltered      bool                   `protobuf:"varint,43,opt,name=is_loc_rib_filtered,json=isLocRibFiltered,proto3" json:"is_loc_rib_filtered,omitempty"`
+	TableName             string                 `protobuf:"bytes,44,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	UserLabels            map[string]string      `protobuf:"bytes,45,rep,name=user_labels,json=userLabels,proto3" json:"user_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *EVPNPrefix) Reset() {
+	*x = EVPNPrefix{}
+	mi := &file_pkg_api_messages_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EVPNPrefix) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EVPNPrefix) ProtoMessage() {}
+
+func (x *EVPNPrefix) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EVPNPrefix.ProtoReflect.Descriptor instead.
+func (*EVPNPrefix) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *EVPNPrefix) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *EVPNPrefix) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *EVPNPrefix) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *EVPNPrefix) GetRouterHash() string {
+	if x != nil {
+		return x.RouterHash
+	}
+	return ""
+}
+
+func (x *EVPNPrefix) GetRouterIp() string {
+	if x != nil {
+		return x.RouterIp
+	}
+	return ""
+}
+
+func (x *EVPNPrefix) GetBaseAttrs() *BaseAttributes {
+	if x != nil {
+		return x.BaseAttrs
+	}
+	return nil
+}
+
+func (x *EVPNPrefix) GetPeerHash() string {
+	if x != nil {
+		return x.PeerHash
+	}
+	return ""
+}
+
+func (x *EVPNPrefix) GetRemoteBgpId() string {
+	if x != nil {
+		return x.RemoteBgpId
+	}
+	return ""
+}
+
+func (x *EVPNPrefix) GetPeerIp() string {
+	if x != nil {
+		return x.PeerIp
+	}
+	return ""
+}
+
+func (x *EVPNPrefix) GetPeerType() uint32 {
+	if x != nil {
+		return x.PeerType
+	}
+	return 0
+}
+
+func (x *EVPNPrefix) GetPeerAsn() uint32 {
+	if x != nil {
+		return x.PeerAsn
+	}
+	return 0
+}
+
+func (x *EVPNPrefix) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *EVPNPrefix) GetIsIpv4() bool {
+	if x != nil {
+		return x.IsIpv4
+	}
+	return false
+}
+
+func (x *EVPNPrefix) GetOriginAs() uint32 {
+	if x != nil {
+		return x.OriginAs
+	}
+	return 0
+}
+
+func (x *EVPNPrefix) GetNexthop() string {
+	if x != nil {
+		return x.Nexthop
+	}
+	return ""
+}
+
+func (x *EVPNPrefix) GetClusterList() string {
+	if x != nil {
+		return x.ClusterList
+	}
+	return ""
+}
+
+func (x *EVPNPrefix) GetIsNexthopIpv4() bool {
+	if x != nil {
+		return x.IsNexthopIpv4
+	}
+	return false
+}
+
+func (x *EVPNPrefix) GetPathId() int32 {
+	if x != nil {
+		return x.PathId
+	}
+	return 0
+}
+
+func (x *EVPNPrefix) GetLabels() []uint32 {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *EVPNPrefix) GetRawlabels() []uint32 {
+	if x != nil {
+		return x.Rawlabels
+	}
+	return nil
+}
+
+func (x *EVPNPrefix) GetVpnRd() string {
+	if x != nil {
+		return x.VpnRd
+	}
+	return ""
+}
+
+func (x *EVPNPrefix) GetVpnRdType() uint32 {
+	if x != nil {
+		return x.VpnRdType
+	}
+	return 0
+}
+
+func (x *EVPNPrefix) GetEthSegmentId() string {
+	if x != nil {
+		return x.EthSegmentId
+	}
+	return ""
+}
+
+func (x *EVPNPrefix) GetEthTag() []byte {
+	if x != nil {
+		return x.EthTag
+	}
+	return nil
+}
+
+func (x *EVPNPrefix) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *EVPNPrefix) GetIpLen() uint32 {
+	if x != nil {
+		return x.IpLen
+	}
+	return 0
+}
+
+func (x *EVPNPrefix) GetGwAddress() string {
+	if x != nil {
+		return x.GwAddress
+	}
+	return ""
+}
+
+func (x *EVPNPrefix) GetMac() string {
+	if x != nil {
+		return x.Mac
+	}
+	return ""
+}
+
+func (x *EVPNPrefix) GetMacLen() uint32 {
+	if x != nil {
+		return x.MacLen
+	}
+	return 0
+}
+
+func (x *EVPNPrefix) GetRouteType() uint32 {
+	if x != nil {
+		return x.RouteType
+	}
+	return 0
+}
+
+func (x *EVPNPrefix) GetPmsiTunnel() *PMSITunnel {
+	if x != nil {
+		return x.PmsiTunnel
+	}
+	return nil
+}
+
+func (x *EVPNPrefix) GetMacMobility() *ECMACMobility {
+	if x != nil {
+		return x.MacMobility
+	}
+	return nil
+}
+
+func (x *EVPNPrefix) GetEsiLabel() *ECESILabel {
+	if x != nil {
+		return x.EsiLabel
+	}
+	return nil
+}
+
+func (x *EVPNPrefix) GetRouterMac() string {
+	if x != nil {
+		return x.RouterMac
+	}
+	return ""
+}
+
+func (x *EVPNPrefix) GetDefaultGateway() bool {
+	if x != nil {
+		return x.DefaultGateway
+	}
+	return false
+}
+
+func (x *EVPNPrefix) GetLayer2Attributes() *ECLayer2Attributes {
+	if x != nil {
+		return x.Layer2Attributes
+	}
+	return nil
+}
+
+func (x *EVPNPrefix) GetDfElection() *ECDFElection {
+	if x != nil {
+		return x.DfElection
+	}
+	return nil
+}
+
+func (x *EVPNPrefix) GetRouteTargets() []string {
+	if x != nil {
+		return x.RouteTargets
+	}
+	return nil
+}
+
+func (x *EVPNPrefix) GetIsAdjRibInPostPolicy() bool {
+	if x != nil {
+		return x.IsAdjRibInPostPolicy
+	}
+	return false
+}
+
+func (x *EVPNPrefix) GetIsAdjRibOutPostPolicy() bool {
+	if x != nil {
+		return x.IsAdjRibOutPostPolicy
+	}
+	return false
+}
+
+func (x *EVPNPrefix) GetIsAdjRibOut() bool {
+	if x != nil {
+		return x.IsAdjRibOut
+	}
+	return false
+}
+
+func (x *EVPNPrefix) GetIsLocRib() bool {
+	if x != nil {
+		return x.IsLocRib
+	}
+	return false
+}
+
+func (x *EVPNPrefix) GetIsLocRibFiltered() bool {
+	if x != nil {
+		return x.IsLocRibFiltered
+	}
+	return false
+}
+
+func (x *EVPNPrefix) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+func (x *EVPNPrefix) GetUserLabels() map[string]string {
+	if x != nil {
+		return x.UserLabels
+	}
+	return nil
+}
+
+// ECMACMobility mirrors bgp.ECMACMobility.
+type ECMACMobility struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sticky        bool                   `protobuf:"varint,1,opt,name=sticky,proto3" json:"sticky,omitempty"`
+	Sequence      uint32                 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ECMACMobility) Reset() {
+	*x = ECMACMobility{}
+	mi := &file_pkg_api_messages_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ECMACMobility) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ECMACMobility) ProtoMessage() {}
+
+func (x *ECMACMobility) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ECMACMobility.ProtoReflect.Descriptor instead.
+func (*ECMACMobility) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ECMACMobility) GetSticky() bool {
+	if x != nil {
+		return x.Sticky
+	}
+	return false
+}
+
+func (x *ECMACMobility) GetSequence() uint32 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+// ECESILabel mirrors bgp.ECESILabel.
+type ECESILabel struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SingleActive  bool                   `protobuf:"varint,1,opt,name=single_active,json=singleActive,proto3" json:"single_active,omitempty"`
+	Label         uint32                 `protobuf:"varint,2,opt,name=label,proto3" json:"label,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ECESILabel) Reset() {
+	*x = ECESILabel{}
+	mi := &file_pkg_api_messages_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ECESILabel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ECESILabel) ProtoMessage() {}
+
+func (x *ECESILabel) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ECESILabel.ProtoReflect.Descriptor instead.
+func (*ECESILabel) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ECESILabel) GetSingleActive() bool {
+	if x != nil {
+		return x.SingleActive
+	}
+	return false
+}
+
+func (x *ECESILabel) GetLabel() uint32 {
+	if x != nil {
+		return x.Label
+	}
+	return 0
+}
+
+// ECLayer2Attributes mirrors bgp.ECLayer2Attributes.
+type ECLayer2Attributes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ControlFlags  uint32                 `protobuf:"varint,1,opt,name=control_flags,json=controlFlags,proto3" json:"control_flags,omitempty"`
+	Mtu           uint32                 `protobuf:"varint,2,opt,name=mtu,proto3" json:"mtu,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ECLayer2Attributes) Reset() {
+	*x = ECLayer2Attributes{}
+	mi := &file_pkg_api_messages_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ECLayer2Attributes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ECLayer2Attributes) ProtoMessage() {}
+
+func (x *ECLayer2Attributes) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ECLayer2Attributes.ProtoReflect.Descriptor instead.
+func (*ECLayer2Attributes) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ECLayer2Attributes) GetControlFlags() uint32 {
+	if x != nil {
+		return x.ControlFlags
+	}
+	return 0
+}
+
+func (x *ECLayer2Attributes) GetMtu() uint32 {
+	if x != nil {
+		return x.Mtu
+	}
+	return 0
+}
+
+// ECDFElection mirrors bgp.ECDFElection.
+type ECDFElection struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Algorithm     uint32                 `protobuf:"varint,1,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	Capabilities  uint32                 `protobuf:"varint,2,opt,name=capabilities,proto3" json:"capabilities,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ECDFElection) Reset() {
+	*x = ECDFElection{}
+	mi := &file_pkg_api_messages_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ECDFElection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ECDFElection) ProtoMessage() {}
+
+func (x *ECDFElection) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ECDFElection.ProtoReflect.Descriptor instead.
+func (*ECDFElection) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ECDFElection) GetAlgorithm() uint32 {
+	if x != nil {
+		return x.Algorithm
+	}
+	return 0
+}
+
+func (x *ECDFElection) GetCapabilities() uint32 {
+	if x != nil {
+		return x.Capabilities
+	}
+	return 0
+}
+
+// VPLSPrefix mirrors message.VPLSPrefix.
+type VPLSPrefix struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Action                string                 `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	Sequence              int64                  `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Hash                  string                 `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	RouterHash            string                 `protobuf:"bytes,4,opt,name=router_hash,json=routerHash,proto3" json:"router_hash,omitempty"`
+	RouterIp              string                 `protobuf:"bytes,5,opt,name=router_ip,json=routerIp,proto3" json:"router_ip,omitempty"`
+	BaseAttrs             *BaseAttributes        `protobuf:"bytes,6,opt,name=base_attrs,json=baseAttrs,proto3" json:"base_attrs,omitempty"`
+	PeerHash              string                 `protobuf:"bytes,7,opt,name=peer_hash,json=peerHash,proto3" json:"peer_hash,omitempty"`
+	RemoteBgpId           string                 `protobuf:"bytes,8,opt,name=remote_bgp_id,json=remoteBgpId,proto3" json:"remote_bgp_id,omitempty"`
+	PeerIp                string                 `protobuf:"bytes,9,opt,name=peer_ip,json=peerIp,proto3" json:"peer_ip,omitempty"`
+	PeerType              uint32                 `protobuf:"varint,10,opt,name=peer_type,json=peerType,proto3" json:"peer_type,omitempty"`
+	PeerAsn               uint32                 `protobuf:"varint,11,opt,name=peer_asn,json=peerAsn,proto3" json:"peer_asn,omitempty"`
+	Timestamp             string                 `protobuf:"bytes,12,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	IsIpv4                bool                   `protobuf:"varint,13,opt,name=is_ipv4,json=isIpv4,proto3" json:"is_ipv4,omitempty"`
+	OriginAs              uint32                 `protobuf:"varint,14,opt,name=origin_as,json=originAs,proto3" json:"origin_as,omitempty"`
+	Nexthop               string                 `protobuf:"bytes,15,opt,name=nexthop,proto3" json:"nexthop,omitempty"`
+	IsNexthopIpv4         bool                   `protobuf:"varint,16,opt,name=is_nexthop_ipv4,json=isNexthopIpv4,proto3" json:"is_nexthop_ipv4,omitempty"`
+	VpnRd                 string                 `protobuf:"bytes,17,opt,name=vpn_rd,json=vpnRd,proto3" json:"vpn_rd,omitempty"`
+	RfcType               string                 `protobuf:"bytes,18,opt,name=rfc_type,json=rfcType,proto3" json:"rfc_type,omitempty"`
+	VeId                  *uint32                `protobuf:"varint,19,opt,name=ve_id,json=veId,proto3,oneof" json:"ve_id,omitempty"`
+	VeBlockOffset         *uint32                `protobuf:"varint,20,opt,name=ve_block_offset,json=veBlockOffset,proto3,oneof" json:"ve_block_offset,omitempty"`
+	VeBlockSize           *uint32                `protobuf:"varint,21,opt,name=ve_block_size,json=veBlockSize,proto3,oneof" json:"ve_block_size,omitempty"`
+	LabelBase             *uint32                `protobuf:"varint,22,opt,name=label_base,json=labelBase,proto3,oneof" json:"label_base,omitempty"`
+	LabelBlockEnd         *uint32                `protobuf:"varint,23,opt,name=label_block_end,json=labelBlockEnd,proto3,oneof" json:"label_block_end,omitempty"`
+	PeAddress             *string                `protobuf:"bytes,24,opt,name=pe_address,json=peAddress,proto3,oneof" json:"pe_address,omitempty"`
+	EncapType             *string                `protobuf:"bytes,25,opt,name=encap_type,json=encapType,proto3,oneof" json:"encap_type,omitempty"`
+	ControlWord           *bool                  `protobuf:"varint,26,opt,name=control_word,json=controlWord,proto3,oneof" json:"control_word,omitempty"`
+	SequencedDelivery     *bool                  `protobuf:"varint,27,opt,name=sequenced_delivery,json=sequencedDelivery,proto3,oneof" json:"sequenced_delivery,omitempty"`
+	Mtu                   *uint32                `protobuf:"varint,28,opt,name=mtu,proto3,oneof" json:"mtu,omitempty"`
+	RouteTargets          []string               `protobuf:"bytes,29,rep,name=route_targets,json=routeTargets,proto3" json:"route_targets,omitempty"`
+	IsAdjRibInPostPolicy  bool                   `protobuf:"varint,30,opt,name=is_adj_rib_in_post_policy,json=isAdjRibInPostPolicy,proto3" json:"is_adj_rib_in_post_policy,omitempty"`
+	IsAdjRibOutPostPolicy bool                   `protobuf:"varint,31,opt,name=is_adj_rib_out_post_policy,json=isAdjRibOutPostPolicy,proto3" json:"is_adj_rib_out_post_policy,omitempty"`
+	IsAdjRibOut           bool                   `protobuf:"varint,32,opt,name=is_adj_rib_out,json=isAdjRibOut,proto3" json:"is_adj_rib_out,omitempty"`
+	IsLocRib              bool                   `protobuf:"varint,33,opt,name=is_loc_rib,json=isLocRib,proto3" json:"is_loc_rib,omitempty"`
+	IsLocRibFiltered      bool                   `protobuf:"varint,34,opt,name=is_loc_rib_filtered,json=isLocRibFiltered,proto3" json:"is_loc_rib_filtered,omitempty"`
+	TableName             string                 `protobuf:"bytes,35,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	UserLabels            map[string]string      `protobuf:"bytes,36,rep,name=user_labels,json=userLabels,proto3" json:"user_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *VPLSPrefix) Reset() {
+	*x = VPLSPrefix{}
+	mi := &file_pkg_api_messages_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VPLSPrefix) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VPLSPrefix) ProtoMessage() {}
+
+func (x *VPLSPrefix) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VPLSPrefix.ProtoReflect.Descriptor instead.
+func (*VPLSPrefix) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *VPLSPrefix) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *VPLSPrefix) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *VPLSPrefix) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *VPLSPrefix) GetRouterHash() string {
+	if x != nil {
+		return x.RouterHash
+	}
+	return ""
+}
+
+func (x *VPLSPrefix) GetRouterIp() string {
+	if x != nil {
+		return x.RouterIp
+	}
+	return ""
+}
+
+func (x *VPLSPrefix) GetBaseAttrs() *BaseAttributes {
+	if x != nil {
+		return x.BaseAttrs
+	}
+	return nil
+}
+
+func (x *VPLSPrefix) GetPeerHash() string {
+	if x != nil {
+		return x.PeerHash
+	}
+	return ""
+}
+
+func (x *VPLSPrefix) GetRemoteBgpId() string {
+	if x != nil {
+		return x.RemoteBgpId
+	}
+	return ""
+}
+
+func (x *VPLSPrefix) GetPeerIp() string {
+	if x != nil {
+		return x.PeerIp
+	}
+	return ""
+}
+
+func (x *VPLSPrefix) GetPeerType() uint32 {
+	if x != nil {
+		return x.PeerType
+	}
+	return 0
+}
+
+func (x *VPLSPrefix) GetPeerAsn() uint32 {
+	if x != nil {
+		return x.PeerAsn
+	}
+	return 0
+}
+
+func (x *VPLSPrefix) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *VPLSPrefix) GetIsIpv4() bool {
+	if x != nil {
+		return x.IsIpv4
+	}
+	return false
+}
+
+func (x *VPLSPrefix) GetOriginAs() uint32 {
+	if x != nil {
+		return x.OriginAs
+	}
+	return 0
+}
+
+func (x *VPLSPrefix) GetNexthop() string {
+	if x != nil {
+		return x.Nexthop
+	}
+	return ""
+}
+
+func (x *VPLSPrefix) GetIsNexthopIpv4() bool {
+	if x != nil {
+		return x.IsNexthopIpv4
+	}
+	return false
+}
+
+func (x *VPLSPrefix) GetVpnRd() string {
+	if x != nil {
+		return x.VpnRd
+	}
+	return ""
+}
+
+func (x *VPLSPrefix) GetRfcType() string {
+	if x != nil {
+		return x.RfcType
+	}
+	return ""
+}
+
+func (x *VPLSPrefix) GetVeId() uint32 {
+	if x != nil && x.VeId != nil {
+		return *x.VeId
+	}
+	return 0
+}
+
+func (x *VPLSPrefix) GetVeBlockOffset() uint32 {
+	if x != nil && x.VeBlockOffset != nil {
+		return *x.VeBlockOffset
+	}
+	return 0
+}
+
+func (x *VPLSPrefix) GetVeBlockSize() uint32 {
+	if x != nil && x.VeBlockSize != nil {
+		return *x.VeBlockSize
+	}
+	return 0
+}
+
+func (x *VPLSPrefix) GetLabelBase() uint32 {
+	if x != nil && x.LabelBase != nil {
+		return *x.LabelBase
+	}
+	return 0
+}
+
+func (x *VPLSPrefix) GetLabelBlockEnd() uint32 {
+	if x != nil && x.LabelBlockEnd != nil {
+		return *x.LabelBlockEnd
+	}
+	return 0
+}
+
+func (x *VPLSPrefix) GetPeAddress() string {
+	if x != nil && x.PeAddress != nil {
+		return *x.PeAddress
+	}
+	return ""
+}
+
+func (x *VPLSPrefix) GetEncapType() string {
+	if x != nil && x.EncapType != nil {
+		return *x.EncapType
+	}
+	return ""
+}
+
+func (x *VPLSPrefix) GetControlWord() bool {
+	if x != nil && x.ControlWord != nil {
+		return *x.ControlWord
+	}
+	return false
+}
+
+func (x *VPLSPrefix) GetSequencedDelivery() bool {
+	if x != nil && x.SequencedDelivery != nil {
+		return *x.SequencedDelivery
+	}
+	return false
+}
+
+func (x *VPLSPrefix) GetMtu() uint32 {
+	if x != nil && x.Mtu != nil {
+		return *x.Mtu
+	}
+	return 0
+}
+
+func (x *VPLSPrefix) GetRouteTargets() []string {
+	if x != nil {
+		return x.RouteTargets
+	}
+	return nil
+}
+
+func (x *VPLSPrefix) GetIsAdjRibInPostPolicy() bool {
+	if x != nil {
+		return x.IsAdjRibInPostPolicy
+	}
+	return false
+}
+
+func (x *VPLSPrefix) GetIsAdjRibOutPostPolicy() bool {
+	if x != nil {
+		return x.IsAdjRibOutPostPolicy
+	}
+	return false
+}
+
+func (x *VPLSPrefix) GetIsAdjRibOut() bool {
+	if x != nil {
+		return x.IsAdjRibOut
+	}
+	return false
+}
+
+func (x *VPLSPrefix) GetIsLocRib() bool {
+	if x != nil {
+		return x.IsLocRib
+	}
+	return false
+}
+
+func (x *VPLSPrefix) GetIsLocRibFiltered() bool {
+	if x != nil {
+		return x.IsLocRibFiltered
+	}
+	return false
+}
+
+func (x *VPLSPrefix) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+func (x *VPLSPrefix) GetUserLabels() map[string]string {
+	if x != nil {
+		return x.UserLabels
+	}
+	return nil
+}
+
+// SRPolicy mirrors message.SRPolicy.
+type SRPolicy struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Action                string                 `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	Sequence              int64                  `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Hash                  string                 `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	RouterHash            string                 `protobuf:"bytes,4,opt,name=router_hash,json=routerHash,proto3" json:"router_hash,omitempty"`
+	RouterIp              string                 `protobuf:"bytes,5,opt,name=router_ip,json=routerIp,proto3" json:"router_ip,omitempty"`
+	BaseAttrs             *BaseAttributes        `protobuf:"bytes,6,opt,name=base_attrs,json=baseAttrs,proto3" json:"base_attrs,omitempty"`
+	PeerHash              string                 `protobuf:"bytes,7,opt,name=peer_hash,json=peerHash,proto3" json:"peer_hash,omitempty"`
+	PeerIp                string                 `protobuf:"bytes,8,opt,name=peer_ip,json=peerIp,proto3" json:"peer_ip,omitempty"`
+	PeerType              uint32                 `protobuf:"varint,9,opt,name=peer_type,json=peerType,proto3" json:"peer_type,omitempty"`
+	PeerAsn               uint32                 `protobuf:"varint,10,opt,name=peer_asn,json=peerAsn,proto3" json:"peer_asn,omitempty"`
+	Timestamp             string                 `protobuf:"bytes,11,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	IsIpv4                bool                   `protobuf:"varint,12,opt,name=is_ipv4,json=isIpv4,proto3" json:"is_ipv4,omitempty"`
+	OriginAs              uint32                 `protobuf:"varint,13,opt,name=origin_as,json=originAs,proto3" json:"origin_as,omitempty"`
+	Nexthop               string                 `protobuf:"bytes,14,opt,name=nexthop,proto3" json:"nexthop,omitempty"`
+	ClusterList           string                 `protobuf:"bytes,15,opt,name=cluster_list,json=clusterList,proto3" json:"cluster_list,omitempty"`
+	IsNexthopIpv4         bool                   `protobuf:"varint,16,opt,name=is_nexthop_ipv4,json=isNexthopIpv4,proto3" json:"is_nexthop_ipv4,omitempty"`
+	PathId                int32                  `protobuf:"varint,17,opt,name=path_id,json=pathId,proto3" json:"path_id,omitempty"`
+	Labels                []uint32               `protobuf:"varint,18,rep,packed,name=labels,proto3" json:"labels,omitempty"`
+	Distinguisher         uint32                 `protobuf:"varint,19,opt,name=distinguisher,proto3" json:"distinguisher,omitempty"`
+	Color                 uint32                 `protobuf:"varint,20,opt,name=color,proto3" json:"color,omitempty"`
+	Endpoint              []byte                 `protobuf:"bytes,21,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	PolicyName            string                 `protobuf:"bytes,22,opt,name=policy_name,json=policyName,proto3" json:"policy_name,omitempty"`
+	BindingSid            *BindingSID            `protobuf:"bytes,23,opt,name=binding_sid,json=bindingSid,proto3" json:"binding_sid,omitempty"`
+	Srv6BindingSid        []*SRv6BindingSID      `protobuf:"bytes,24,rep,name=srv6_binding_sid,json=srv6BindingSid,proto3" json:"srv6_binding_sid,omitempty"`
+	PreferenceSubtlv      *Preference            `protobuf:"bytes,25,opt,name=preference_subtlv,json=preferenceSubtlv,proto3" json:"preference_subtlv,omitempty"`
+	PrioritySubtlv        uint32                 `protobuf:"varint,26,opt,name=priority_subtlv,json=prioritySubtlv,proto3" json:"priority_subtlv,omitempty"`
+	PolicyPathName        string                 `protobuf:"bytes,27,opt,name=policy_path_name,json=policyPathName,proto3" json:"policy_path_name,omitempty"`
+	EnlpSubtlv            *ENLP                  `protobuf:"bytes,28,opt,name=enlp_subtlv,json=enlpSubtlv,proto3" json:"enlp_subtlv,omitempty"`
+	SegmentListSubtlv     []*SegmentList         `protobuf:"bytes,29,rep,name=segment_list_subtlv,json=segmentListSubtlv,proto3" json:"segment_list_subtlv,omitempty"`
+	IsAdjRibInPostPolicy  bool                   `protobuf:"varint,30,opt,name=is_adj_rib_in_post_policy,json=isAdjRibInPostPolicy,proto3" json:"is_adj_rib_in_post_policy,omitempty"`
+	IsAdjRibOutPostPolicy bool                   `protobuf:"varint,31,opt,name=is_adj_rib_out_post_policy,json=isAdjRibOutPostPolicy,proto3" json:"is_adj_rib_out_post_policy,omitempty"`
+	IsAdjRibOut           bool                   `protobuf:"varint,32,opt,name=is_adj_rib_out,json=isAdjRibOut,proto3" json:"is_adj_rib_out,omitempty"`
+	IsLocRib              bool                   `protobuf:"varint,33,opt,name=is_loc_rib,json=isLocRib,proto3" json:"is_loc_rib,omitempty"`
+	IsLocRibFiltered      bool                   `protobuf:"varint,34,opt,name=is_loc_rib_filtered,json=isLocRibFiltered,proto3" json:"is_loc_rib_filtered,omitempty"`
+	TableName             string                 `protobuf:"bytes,35,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	UserLabels            map[string]string      `protobuf:"bytes,36,rep,name=user_labels,json=userLabels,proto3" json:"user_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *SRPolicy) Reset() {
+	*x = SRPolicy{}
+	mi := &file_pkg_api_messages_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SRPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SRPolicy) ProtoMessage() {}
+
+func (x *SRPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SRPolicy.ProtoReflect.Descriptor instead.
+func (*SRPolicy) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *SRPolicy) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *SRPolicy) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *SRPolicy) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *SRPolicy) GetRouterHash() string {
+	if x != nil {
+		return x.RouterHash
+	}
+	return ""
+}
+
+func (x *SRPolicy) GetRouterIp() string {
+	if x != nil {
+		return x.RouterIp
+	}
+	return ""
+}
+
+func (x *SRPolicy) GetBaseAttrs() *BaseAttributes {
+	if x != nil {
+		return x.BaseAttrs
+	}
+	return nil
+}
+
+func (x *SRPolicy) GetPeerHash() string {
+	if x != nil {
+		return x.PeerHash
+	}
+	return ""
+}
+
+func (x *SRPolicy) GetPeerIp() string {
+	if x != nil {
+		return x.PeerIp
+	}
+	return ""
+}
+
+func (x *SRPolicy) GetPeerType() uint32 {
+	if x != nil {
+		return x.PeerType
+	}
+	return 0
+}
+
+func (x *SRPolicy) GetPeerAsn() uint32 {
+	if x != nil {
+		return x.PeerAsn
+	}
+	return 0
+}
+
+func (x *SRPolicy) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *SRPolicy) GetIsIpv4() bool {
+	if x != nil {
+		return x.IsIpv4
+	}
+	return false
+}
+
+func (x *SRPolicy) GetOriginAs() uint32 {
+	if x != nil {
+		return x.OriginAs
+	}
+	return 0
+}
+
+func (x *SRPolicy) GetNexthop() string {
+	if x != nil {
+		return x.Nexthop
+	}
+	return ""
+}
+
+func (x *SRPolicy) GetClusterList() string {
+	if x != nil {
+		return x.ClusterList
+	}
+	return ""
+}
+
+func (x *SRPolicy) GetIsNexthopIpv4() bool {
+	if x != nil {
+		return x.IsNexthopIpv4
+	}
+	return false
+}
+
+func (x *SRPolicy) GetPathId() int32 {
+	if x != nil {
+		return x.PathId
+	}
+	return 0
+}
+
+func (x *SRPolicy) GetLabels() []uint32 {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *SRPolicy) GetDistinguisher() uint32 {
+	if x != nil {
+		return x.Distinguisher
+	}
+	return 0
+}
+
+func (x *SRPolicy) GetColor() uint32 {
+	if x != nil {
+		return x.Color
+	}
+	return 0
+}
+
+func (x *SRPolicy) GetEndpoint() []byte {
+	if x != nil {
+		return x.Endpoint
+	}
+	return nil
+}
+
+func (x *SRPolicy) GetPolicyName() string {
+	if x != nil {
+		return x.PolicyName
+	}
+	return ""
+}
+
+func (x *SRPolicy) GetBindingSid() *BindingSID {
+	if x != nil {
+		return x.BindingSid
+	}
+	return nil
+}
+
+func (x *SRPolicy) GetSrv6BindingSid() []*SRv6BindingSID {
+	if x != nil {
+		return x.Srv6BindingSid
+	}
+	return nil
+}
+
+func (x *SRPolicy) GetPreferenceSubtlv() *Preference {
+	if x != nil {
+		return x.PreferenceSubtlv
+	}
+	return nil
+}
+
+func (x *SRPolicy) GetPrioritySubtlv() uint32 {
+	if x != nil {
+		return x.PrioritySubtlv
+	}
+	return 0
+}
+
+func (x *SRPolicy) GetPolicyPathName() string {
+	if x != nil {
+		return x.PolicyPathName
+	}
+	return ""
+}
+
+func (x *SRPolicy) GetEnlpSubtlv() *ENLP {
+	if x != nil {
+		return x.EnlpSubtlv
+	}
+	return nil
+}
+
+func (x *SRPolicy) GetSegmentListSubtlv() []*SegmentList {
+	if x != nil {
+		return x.SegmentListSubtlv
+	}
+	return nil
+}
+
+func (x *SRPolicy) GetIsAdjRibInPostPolicy() bool {
+	if x != nil {
+		return x.IsAdjRibInPostPolicy
+	}
+	return false
+}
+
+func (x *SRPolicy) GetIsAdjRibOutPostPolicy() bool {
+	if x != nil {
+		return x.IsAdjRibOutPostPolicy
+	}
+	return false
+}
+
+func (x *SRPolicy) GetIsAdjRibOut() bool {
+	if x != nil {
+		return x.IsAdjRibOut
+	}
+	return false
+}
+
+func (x *SRPolicy) GetIsLocRib() bool {
+	if x != nil {
+		return x.IsLocRib
+	}
+	return false
+}
+
+func (x *SRPolicy) GetIsLocRibFiltered() bool {
+	if x != nil {
+		return x.IsLocRibFiltered
+	}
+	return false
+}
+
+func (x *SRPolicy) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+func (x *SRPolicy) GetUserLabels() map[string]string {
+	if x != nil {
+		return x.UserLabels
+	}
+	return nil
+}
+
+// BindingSID mirrors srpolicy.BindingSID.
+type BindingSID struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BsidType      int64                  `protobuf:"varint,1,opt,name=bsid_type,json=bsidType,proto3" json:"bsid_type,omitempty"`
+	Bsid          *BindingSIDValue       `protobuf:"bytes,2,opt,name=bsid,proto3" json:"bsid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BindingSID) Reset() {
+	*x = BindingSID{}
+	mi := &file_pkg_api_messages_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BindingSID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BindingSID) ProtoMessage() {}
+
+func (x *BindingSID) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BindingSID.ProtoReflect.Descriptor instead.
+func (*BindingSID) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *BindingSID) GetBsidType() int64 {
+	if x != nil {
+		return x.BsidType
+	}
+	return 0
+}
+
+func (x *BindingSID) GetBsid() *BindingSIDValue {
+	if x != nil {
+		return x.Bsid
+	}
+	return nil
+}
+
+// SRv6BindingSID mirrors srpolicy.SRv6BindingSID.
+type SRv6BindingSID struct {
+	state                        protoimpl.MessageState `protogen:"open.v1"`
+	Flags                        uint32                 `protobuf:"varint,1,opt,name=flags,proto3" json:"flags,omitempty"`
+	Srv6Bsid                     []byte                 `protobuf:"bytes,2,opt,name=srv6_bsid,json=srv6Bsid,proto3" json:"srv6_bsid,omitempty"`
+	EndpointBehaviorSidStructure *SRv6EndpointBehavior  `protobuf:"bytes,3,opt,name=endpoint_behavior_sid_structure,json=endpointBehaviorSidStructure,proto3" json:"endpoint_behavior_sid_structure,omitempty"`
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
+}
+
+func (x *SRv6BindingSID) Reset() {
+	*x = SRv6BindingSID{}
+	mi := &file_pkg_api_messages_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SRv6BindingSID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SRv6BindingSID) ProtoMessage() {}
+
+func (x *SRv6BindingSID) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SRv6BindingSID.ProtoReflect.Descriptor instead.
+func (*SRv6BindingSID) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *SRv6BindingSID) GetFlags() uint32 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+func (x *SRv6BindingSID) GetSrv6Bsid() []byte {
+	if x != nil {
+		return x.Srv6Bsid
+	}
+	return nil
+}
+
+func (x *SRv6BindingSID) GetEndpointBehaviorSidStructure() *SRv6EndpointBehavior {
+	if x != nil {
+		return x.EndpointBehaviorSidStructure
+	}
+	return nil
+}
+
+// SRv6EndpointBehavior mirrors srpolicy.SRv6EndpointBehavior.
+type SRv6EndpointBehavior struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	EndpointBehavior   uint32                 `protobuf:"varint,1,opt,name=endpoint_behavior,json=endpointBehavior,proto3" json:"endpoint_behavior,omitempty"`
+	LocatorBlockLength uint32                 `protobuf:"varint,2,opt,name=locator_block_length,json=locatorBlockLength,proto3" json:"locator_block_length,omitempty"`
+	LocatorNodeLength  uint32                 `protobuf:"varint,3,opt,name=locator_node_length,json=locatorNodeLength,proto3" json:"locator_node_length,omitempty"`
+	FunctionLength     uint32                 `protobuf:"varint,4,opt,name=function_length,json=functionLength,proto3" json:"function_length,omitempty"`
+	ArgumentLength     uint32                 `protobuf:"varint,5,opt,name=argument_length,json=argumentLength,proto3" json:"argument_length,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *SRv6EndpointBehavior) Reset() {
+	*x = SRv6EndpointBehavior{}
+	mi := &file_pkg_api_messages_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SRv6EndpointBehavior) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SRv6EndpointBehavior) ProtoMessage() {}
+
+func (x *SRv6EndpointBehavior) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SRv6EndpointBehavior.ProtoReflect.Descriptor instead.
+func (*SRv6EndpointBehavior) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *SRv6EndpointBehavior) GetEndpointBehavior() uint32 {
+	if x != nil {
+		return x.EndpointBehavior
+	}
+	return 0
+}
+
+func (x *SRv6EndpointBehavior) GetLocatorBlockLength() uint32 {
+	if x != nil {
+		return x.LocatorBlockLength
+	}
+	return 0
+}
+
+func (x *SRv6EndpointBehavior) GetLocatorNodeLength() uint32 {
+	if x != nil {
+		return x.LocatorNodeLength
+	}
+	return 0
+}
+
+func (x *SRv6EndpointBehavior) GetFunctionLength() uint32 {
+	if x != nil {
+		return x.FunctionLength
+	}
+	return 0
+}
+
+func (x *SRv6EndpointBehavior) GetArgumentLength() uint32 {
+	if x != nil {
+		return x.ArgumentLength
+	}
+	return 0
+}
+
+// Preference mirrors srpolicy.Preference.
+type Preference struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Flags         uint32                 `protobuf:"varint,1,opt,name=flags,proto3" json:"flags,omitempty"`
+	Preference    uint32                 `protobuf:"varint,2,opt,name=preference,proto3" json:"preference,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Preference) Reset() {
+	*x = Preference{}
+	mi := &file_pkg_api_messages_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Preference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Preference) ProtoMessage() {}
+
+func (x *Preference) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Preference.ProtoReflect.Descriptor instead.
+func (*Preference) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *Preference) GetFlags() uint32 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+func (x *Preference) GetPreference() uint32 {
+	if x != nil {
+		return x.Preference
+	}
+	return 0
+}
+
+// ENLP mirrors srpolicy.ENLP.
+type ENLP struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Flags         uint32                 `protobuf:"varint,1,opt,name=flags,proto3" json:"flags,omitempty"`
+	Enlp          uint32                 `protobuf:"varint,2,opt,name=enlp,proto3" json:"enlp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ENLP) Reset() {
+	*x = ENLP{}
+	mi := &file_pkg_api_messages_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ENLP) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ENLP) ProtoMessage() {}
+
+func (x *ENLP) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ENLP.ProtoReflect.Descriptor instead.
+func (*ENLP) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *ENLP) GetFlags() uint32 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+func (x *ENLP) GetEnlp() uint32 {
+	if x != nil {
+		return x.Enlp
+	}
+	return 0
+}
+
+// SegmentList mirrors srpolicy.SegmentList.
+type SegmentList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WeightSubtlv  *Weight                `protobuf:"bytes,1,opt,name=weight_subtlv,json=weightSubtlv,proto3" json:"weight_subtlv,omitempty"`
+	Segments      []*Segment             `protobuf:"bytes,2,rep,name=segments,proto3" json:"segments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SegmentList) Reset() {
+	*x = SegmentList{}
+	mi := &file_pkg_api_messages_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SegmentList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SegmentList) ProtoMessage() {}
+
+func (x *SegmentList) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SegmentList.ProtoReflect.Descriptor instead.
+func (*SegmentList) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *SegmentList) GetWeightSubtlv() *Weight {
+	if x != nil {
+		return x.WeightSubtlv
+	}
+	return nil
+}
+
+func (x *SegmentList) GetSegments() []*Segment {
+	if x != nil {
+		return x.Segments
+	}
+	return nil
+}
+
+// Weight mirrors srpolicy.Weight.
+type Weight struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Flags         uint32                 `protobuf:"varint,1,opt,name=flags,proto3" json:"flags,omitempty"`
+	Weight        uint32                 `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Weight) Reset() {
+	*x = Weight{}
+	mi := &file_pkg_api_messages_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Weight) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Weight) ProtoMessage() {}
+
+func (x *Weight) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Weight.ProtoReflect.Descriptor instead.
+func (*Weight) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *Weight) GetFlags() uint32 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+func (x *Weight) GetWeight() uint32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+// Flowspec mirrors message.Flowspec.
+type Flowspec struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Action                string                 `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	Sequence              int64                  `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	RouterHash            string                 `protobuf:"bytes,3,opt,name=router_hash,json=routerHash,proto3" json:"router_hash,omitempty"`
+	RouterIp              string                 `protobuf:"bytes,4,opt,name=router_ip,json=routerIp,proto3" json:"router_ip,omitempty"`
+	BaseAttrs             *BaseAttributes        `protobuf:"bytes,5,opt,name=base_attrs,json=baseAttrs,proto3" json:"base_attrs,omitempty"`
+	PeerIp                string                 `protobuf:"bytes,6,opt,name=peer_ip,json=peerIp,proto3" json:"peer_ip,omitempty"`
+	PeerType              uint32                 `protobuf:"varint,7,opt,name=peer_type,json=peerType,proto3" json:"peer_type,omitempty"`
+	PeerAsn               uint32                 `protobuf:"varint,8,opt,name=peer_asn,json=peerAsn,proto3" json:"peer_asn,omitempty"`
+	Timestamp             string                 `protobuf:"bytes,9,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	IsIpv4                bool                   `protobuf:"varint,10,opt,name=is_ipv4,json=isIpv4,proto3" json:"is_ipv4,omitempty"`
+	OriginAs              uint32                 `protobuf:"varint,11,opt,name=origin_as,json=originAs,proto3" json:"origin_as,omitempty"`
+	Nexthop               string                 `protobuf:"bytes,12,opt,name=nexthop,proto3" json:"nexthop,omitempty"`
+	IsNexthopIpv4         bool                   `protobuf:"varint,13,opt,name=is_nexthop_ipv4,json=isNexthopIpv4,proto3" json:"is_nexthop_ipv4,omitempty"`
+	PathId                int32                  `protobuf:"varint,14,opt,name=path_id,json=pathId,proto3" json:"path_id,omitempty"`
+	SpecHash              string                 `protobuf:"bytes,15,opt,name=spec_hash,json=specHash,proto3" json:"spec_hash,omitempty"`
+	Spec                  []*FlowspecSpec        `protobuf:"bytes,16,rep,name=spec,proto3" json:"spec,omitempty"`
+	Rd                    string                 `protobuf:"bytes,17,opt,name=rd,proto3" json:"rd,omitempty"`
+	IsAdjRibInPostPolicy  bool                   `protobuf:"varint,18,opt,name=is_adj_rib_in_post_policy,json=isAdjRibInPostPolicy,proto3" json:"is_adj_rib_in_post_policy,omitempty"`
+	IsAdjRibOutPostPolicy bool                   `protobuf:"varint,19,opt,name=is_adj_rib_out_post_policy,json=isAdjRibOutPostPolicy,proto3" json:"is_adj_rib_out_post_policy,omitempty"`
+	IsAdjRibOut           bool                   `protobuf:"varint,20,opt,name=is_adj_rib_out,json=isAdjRibOut,proto3" json:"is_adj_rib_out,omitempty"`
+	IsLocRib              bool                   `protobuf:"varint,21,opt,name=is_loc_rib,json=isLocRib,proto3" json:"is_loc_rib,omitempty"`
+	IsLocRibFiltered      bool                   `protobuf:"varint,22,opt,name=is_loc_rib_filtered,json=isLocRibFiltered,proto3" json:"is_loc_rib_filtered,omitempty"`
+	TableName             string                 `protobuf:"bytes,23,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	UserLabels            map[string]string      `protobuf:"bytes,24,rep,name=user_labels,json=userLabels,proto3" json:"user_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *Flowspec) Reset() {
+	*x = Flowspec{}
+	mi := &file_pkg_api_messages_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Flowspec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Flowspec) ProtoMessage() {}
+
+func (x *Flowspec) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Flowspec.ProtoReflect.Descriptor instead.
+func (*Flowspec) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *Flowspec) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *Flowspec) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *Flowspec) GetRouterHash() string {
+	if x != nil {
+		return x.RouterHash
+	}
+	return ""
+}
+
+func (x *Flowspec) GetRouterIp() string {
+	if x != nil {
+		return x.RouterIp
+	}
+	return ""
+}
+
+func (x *Flowspec) GetBaseAttrs() *BaseAttributes {
+	if x != nil {
+		return x.BaseAttrs
+	}
+	return nil
+}
+
+func (x *Flowspec) GetPeerIp() string {
+	if x != nil {
+		return x.PeerIp
+	}
+	return ""
+}
+
+func (x *Flowspec) GetPeerType() uint32 {
+	if x != nil {
+		return x.PeerType
+	}
+	return 0
+}
+
+func (x *Flowspec) GetPeerAsn() uint32 {
+	if x != nil {
+		return x.PeerAsn
+	}
+	return 0
+}
+
+func (x *Flowspec) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *Flowspec) GetIsIpv4() bool {
+	if x != nil {
+		return x.IsIpv4
+	}
+	return false
+}
+
+func (x *Flowspec) GetOriginAs() uint32 {
+	if x != nil {
+		return x.OriginAs
+	}
+	return 0
+}
+
+func (x *Flowspec) GetNexthop() string {
+	if x != nil {
+		return x.Nexthop
+	}
+	return ""
+}
+
+func (x *Flowspec) GetIsNexthopIpv4() bool {
+	if x != nil {
+		return x.IsNexthopIpv4
+	}
+	return false
+}
+
+func (x *Flowspec) GetPathId() int32 {
+	if x != nil {
+		return x.PathId
+	}
+	return 0
+}
+
+func (x *Flowspec) GetSpecHash() string {
+	if x != nil {
+		return x.SpecHash
+	}
+	return ""
+}
+
+func (x *Flowspec) GetSpec() []*FlowspecSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *Flowspec) GetRd() string {
+	if x != nil {
+		return x.Rd
+	}
+	return ""
+}
+
+func (x *Flowspec) GetIsAdjRibInPostPolicy() bool {
+	if x != nil {
+		return x.IsAdjRibInPostPolicy
+	}
+	return false
+}
+
+func (x *Flowspec) GetIsAdjRibOutPostPolicy() bool {
+	if x != nil {
+		return x.IsAdjRibOutPostPolicy
+	}
+	return false
+}
+
+func (x *Flowspec) GetIsAdjRibOut() bool {
+	if x != nil {
+		return x.IsAdjRibOut
+	}
+	return false
+}
+
+func (x *Flowspec) GetIsLocRib() bool {
+	if x != nil {
+		return x.IsLocRib
+	}
+	return false
+}
+
+func (x *Flowspec) GetIsLocRibFiltered() bool {
+	if x != nil {
+		return x.IsLocRibFiltered
+	}
+	return false
+}
+
+func (x *Flowspec) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+func (x *Flowspec) GetUserLabels() map[string]string {
+	if x != nil {
+		return x.UserLabels
+	}
+	return nil
+}
+
+// Stats mirrors message.Stats.
+type Stats struct {
+	state                      protoimpl.MessageState `protogen:"open.v1"`
+	Sequence                   int64                  `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	RouterHash                 string                 `protobuf:"bytes,2,opt,name=router_hash,json=routerHash,proto3" json:"router_hash,omitempty"`
+	RouterIp                   string                 `protobuf:"bytes,3,opt,name=router_ip,json=routerIp,proto3" json:"router_ip,omitempty"`
+	PeerType                   uint32                 `protobuf:"varint,4,opt,name=peer_type,json=peerType,proto3" json:"peer_type,omitempty"`
+	RemoteBgpId                string                 `protobuf:"bytes,5,opt,name=remote_bgp_id,json=remoteBgpId,proto3" json:"remote_bgp_id,omitempty"`
+	RemoteAsn                  uint32                 `protobuf:"varint,6,opt,name=remote_asn,json=remoteAsn,proto3" json:"remote_asn,omitempty"`
+	RemoteIp                   string                 `protobuf:"bytes,7,opt,name=remote_ip,json=remoteIp,proto3" json:"remote_ip,omitempty"`
+	PeerRd                     string                 `protobuf:"bytes,8,opt,name=peer_rd,json=peerRd,proto3" json:"peer_rd,omitempty"`
+	Timestamp                  string                 `protobuf:"bytes,9,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	DuplicatePrefix            uint32                 `protobuf:"varint,10,opt,name=duplicate_prefix,json=duplicatePrefix,proto3" json:"duplicate_prefix,omitempty"`
+	DuplicateWithdraws         uint32                 `protobuf:"varint,11,opt,name=duplicate_withdraws,json=duplicateWithdraws,proto3" json:"duplicate_withdraws,omitempty"`
+	InvalidatedDueCluster      uint32                 `protobuf:"varint,12,opt,name=invalidated_due_cluster,json=invalidatedDueCluster,proto3" json:"invalidated_due_cluster,omitempty"`
+	InvalidatedDueAspath       uint32                 `protobuf:"varint,13,opt,name=invalidated_due_aspath,json=invalidatedDueAspath,proto3" json:"invalidated_due_aspath,omitempty"`
+	InvalidatedDueOriginatorId uint32                 `protobuf:"varint,14,opt,name=invalidated_due_originator_id,json=invalidatedDueOriginatorId,proto3" json:"invalidated_due_originator_id,omitempty"`
+	InvalidatedDueAsconfed     uint32                 `protobuf:"varint,15,opt,name=invalidated_due_asconfed,json=invalidatedDueAsconfed,proto3" json:"invalidated_due_asconfed,omitempty"`
+	AdsRibIn                   uint64                 `protobuf:"varint,16,opt,name=ads_rib_in,json=adsRibIn,proto3" json:"ads_rib_in,omitempty"`
+	LocalRib                   uint64                 `protobuf:"varint,17,opt,name=local_rib,json=localRib,proto3" json:"local_rib,omitempty"`
+	UpdatesAsWithdraw          uint32                 `protobuf:"varint,18,opt,name=updates_as_withdraw,json=updatesAsWithdraw,proto3" json:"updates_as_withdraw,omitempty"`
+	PrefixesAsWithdraw         uint32                 `protobuf:"varint,19,opt,name=prefixes_as_withdraw,json=prefixesAsWithdraw,proto3" json:"prefixes_as_withdraw,omitempty"`
+	PrefixesRejectedInbound    uint32                 `protobuf:"varint,20,opt,name=prefixes_rejected_inbound,json=prefixesRejectedInbound,proto3" json:"prefixes_rejected_inbound,omitempty"`
+	PerAfiAdjRibsIn            []*AFISAFIStat         `protobuf:"bytes,21,rep,name=per_afi_adj_ribs_in,json=perAfiAdjRibsIn,proto3" json:"per_afi_adj_ribs_in,omitempty"`
+	PerAfiLocRib               []*AFISAFIStat         `protobuf:"bytes,22,rep,name=per_afi_loc_rib,json=perAfiLocRib,proto3" json:"per_afi_loc_rib,omitempty"`
+	DuplicateUpdates           uint32                 `protobuf:"varint,23,opt,name=duplicate_updates,json=duplicateUpdates,proto3" json:"duplicate_updates,omitempty"`
+	PrePolicyAdjRibOut         uint64                 `protobuf:"varint,24,opt,name=pre_policy_adj_rib_out,json=prePolicyAdjRibOut,proto3" json:"pre_policy_adj_rib_out,omitempty"`
+	PostPolicyAdjRibOut        uint64                 `protobuf:"varint,25,opt,name=post_policy_adj_rib_out,json=postPolicyAdjRibOut,proto3" json:"post_policy_adj_rib_out,omitempty"`
+	PerAfiPrePolicyAdjRibOut   []*AFISAFIStat         `protobuf:"bytes,26,rep,name=per_afi_pre_policy_adj_rib_out,json=perAfiPrePolicyAdjRibOut,proto3" json:"per_afi_pre_policy_adj_rib_out,omitempty"`
+	PerAfiPostPolicyAdjRibOut  []*AFISAFIStat         `protobuf:"bytes,27,rep,name=per_afi_post_policy_adj_rib_out,json=perAfiPostPolicyAdjRibOut,proto3" json:"per_afi_post_policy_adj_rib_out,omitempty"`
+	PeerHash                   string                 `protobuf:"bytes,28,opt,name=peer_hash,json=peerHash,proto3" json:"peer_hash,omitempty"`
+	UserLabels                 map[string]string      `protobuf:"bytes,29,rep,name=user_labels,json=userLabels,proto3" json:"user_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *Stats) Reset() {
+	*x = Stats{}
+	mi := &file_pkg_api_messages_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Stats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Stats) ProtoMessage() {}
+
+func (x *Stats) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Stats.ProtoReflect.Descriptor instead.
+func (*Stats) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *Stats) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *Stats) GetRouterHash() string {
+	if x != nil {
+		return x.RouterHash
+	}
+	return ""
+}
+
+func (x *Stats) GetRouterIp() string {
+	if x != nil {
+		return x.RouterIp
+	}
+	return ""
+}
+
+func (x *Stats) GetPeerType() uint32 {
+	if x != nil {
+		return x.PeerType
+	}
+	return 0
+}
+
+func (x *Stats) GetRemoteBgpId() string {
+	if x != nil {
+		return x.RemoteBgpId
+	}
+	return ""
+}
+
+func (x *Stats) GetRemoteAsn() uint32 {
+	if x != nil {
+		return x.RemoteAsn
+	}
+	return 0
+}
+
+func (x *Stats) GetRemoteIp() string {
+	if x != nil {
+		return x.RemoteIp
+	}
+	return ""
+}
+
+func (x *Stats) GetPeerRd() string {
+	if x != nil {
+		return x.PeerRd
+	}
+	return ""
+}
+
+func (x *Stats) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *Stats) GetDuplicatePrefix() uint32 {
+	if x != nil {
+		return x.DuplicatePrefix
+	}
+	return 0
+}
+
+func (x *Stats) GetDuplicateWithdraws() uint32 {
+	if x != nil {
+		return x.DuplicateWithdraws
+	}
+	return 0
+}
+
+func (x *Stats) GetInvalidatedDueCluster() uint32 {
+	if x != nil {
+		return x.InvalidatedDueCluster
+	}
+	return 0
+}
+
+func (x *Stats) GetInvalidatedDueAspath() uint32 {
+	if x != nil {
+		return x.InvalidatedDueAspath
+	}
+	return 0
+}
+
+func (x *Stats) GetInvalidatedDueOriginatorId() uint32 {
+	if x != nil {
+		return x.InvalidatedDueOriginatorId
+	}
+	return 0
+}
+
+func (x *Stats) GetInvalidatedDueAsconfed() uint32 {
+	if x != nil {
+		return x.InvalidatedDueAsconfed
+	}
+	return 0
+}
+
+func (x *Stats) GetAdsRibIn() uint64 {
+	if x != nil {
+		return x.AdsRibIn
+	}
+	return 0
+}
+
+func (x *Stats) GetLocalRib() uint64 {
+	if x != nil {
+		return x.LocalRib
+	}
+	return 0
+}
+
+func (x *Stats) GetUpdatesAsWithdraw() uint32 {
+	if x != nil {
+		return x.UpdatesAsWithdraw
+	}
+	return 0
+}
+
+func (x *Stats) GetPrefixesAsWithdraw() uint32 {
+	if x != nil {
+		return x.PrefixesAsWithdraw
+	}
+	return 0
+}
+
+func (x *Stats) GetPrefixesRejectedInbound() uint32 {
+	if x != nil {
+		return x.PrefixesRejectedInbound
+	}
+	return 0
+}
+
+func (x *Stats) GetPerAfiAdjRibsIn() []*AFISAFIStat {
+	if x != nil {
+		return x.PerAfiAdjRibsIn
+	}
+	return nil
+}
+
+func (x *Stats) GetPerAfiLocRib() []*AFISAFIStat {
+	if x != nil {
+		return x.PerAfiLocRib
+	}
+	return nil
+}
+
+func (x *Stats) GetDuplicateUpdates() uint32 {
+	if x != nil {
+		return x.DuplicateUpdates
+	}
+	return 0
+}
+
+func (x *Stats) GetPrePolicyAdjRibOut() uint64 {
+	if x != nil {
+		return x.PrePolicyAdjRibOut
+	}
+	return 0
+}
+
+func (x *Stats) GetPostPolicyAdjRibOut() uint64 {
+	if x != nil {
+		return x.PostPolicyAdjRibOut
+	}
+	return 0
+}
+
+func (x *Stats) GetPerAfiPrePolicyAdjRibOut() []*AFISAFIStat {
+	if x != nil {
+		return x.PerAfiPrePolicyAdjRibOut
+	}
+	return nil
+}
+
+func (x *Stats) GetPerAfiPostPolicyAdjRibOut() []*AFISAFIStat {
+	if x != nil {
+		return x.PerAfiPostPolicyAdjRibOut
+	}
+	return nil
+}
+
+func (x *Stats) GetPeerHash() string {
+	if x != nil {
+		return x.PeerHash
+	}
+	return ""
+}
+
+func (x *Stats) GetUserLabels() map[string]string {
+	if x != nil {
+		return x.UserLabels
+	}
+	return nil
+}
+
+// AFISAFIStat mirrors message.AFISAFIStat.
+type AFISAFIStat struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Afi           uint32                 `protobuf:"varint,1,opt,name=afi,proto3" json:"afi,omitempty"`
+	Safi          uint32                 `protobuf:"varint,2,opt,name=safi,proto3" json:"safi,omitempty"`
+	Count         uint64                 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AFISAFIStat) Reset() {
+	*x = AFISAFIStat{}
+	mi := &file_pkg_api_messages_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AFISAFIStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AFISAFIStat) ProtoMessage() {}
+
+func (x *AFISAFIStat) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AFISAFIStat.ProtoReflect.Descriptor instead.
+func (*AFISAFIStat) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *AFISAFIStat) GetAfi() uint32 {
+	if x != nil {
+		return x.Afi
+	}
+	return 0
+}
+
+func (x *AFISAFIStat) GetSafi() uint32 {
+	if x != nil {
+		return x.Safi
+	}
+	return 0
+}
+
+func (x *AFISAFIStat) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// RouteLeak mirrors message.RouteLeak.
+type RouteLeak struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	RouterHash            string                 `protobuf:"bytes,1,opt,name=router_hash,json=routerHash,proto3" json:"router_hash,omitempty"`
+	RouterIp              string                 `protobuf:"bytes,2,opt,name=router_ip,json=routerIp,proto3" json:"router_ip,omitempty"`
+	PeerHash              string                 `protobuf:"bytes,3,opt,name=peer_hash,json=peerHash,proto3" json:"peer_hash,omitempty"`
+	PeerIp                string                 `protobuf:"bytes,4,opt,name=peer_ip,json=peerIp,proto3" json:"peer_ip,omitempty"`
+	PeerAsn               uint32                 `protobuf:"varint,5,opt,name=peer_asn,json=peerAsn,proto3" json:"peer_asn,omitempty"`
+	PeerRole              string                 `protobuf:"bytes,6,opt,name=peer_role,json=peerRole,proto3" json:"peer_role,omitempty"`
+	Prefix                string                 `protobuf:"bytes,7,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	PrefixLen             int32                  `protobuf:"varint,8,opt,name=prefix_len,json=prefixLen,proto3" json:"prefix_len,omitempty"`
+	IsIpv4                bool                   `protobuf:"varint,9,opt,name=is_ipv4,json=isIpv4,proto3" json:"is_ipv4,omitempty"`
+	PathId                int32                  `protobuf:"varint,10,opt,name=path_id,json=pathId,proto3" json:"path_id,omitempty"`
+	Otc                   uint32                 `protobuf:"varint,11,opt,name=otc,proto3" json:"otc,omitempty"`
+	AsPath                []uint32               `protobuf:"varint,12,rep,packed,name=as_path,json=asPath,proto3" json:"as_path,omitempty"`
+	Reason                string                 `protobuf:"bytes,13,opt,name=reason,proto3" json:"reason,omitempty"`
+	IsAdjRibInPostPolicy  bool                   `protobuf:"varint,14,opt,name=is_adj_rib_in_post_policy,json=isAdjRibInPostPolicy,proto3" json:"is_adj_rib_in_post_policy,omitempty"`
+	IsAdjRibOut           bool                   `protobuf:"varint,15,opt,name=is_adj_rib_out,json=isAdjRibOut,proto3" json:"is_adj_rib_out,omitempty"`
+	IsAdjRibOutPostPolicy bool                   `protobuf:"varint,16,opt,name=is_adj_rib_out_post_policy,json=isAdjRibOutPostPolicy,proto3" json:"is_adj_rib_out_post_policy,omitempty"`
+	Timestamp             string                 `protobuf:"bytes,17,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	UserLabels            map[string]string      `protobuf:"bytes,18,rep,name=user_labels,json=userLabels,proto3" json:"user_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *RouteLeak) Reset() {
+	*x = RouteLeak{}
+	mi := &file_pkg_api_messages_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RouteLeak) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteLeak) ProtoMessage() {}
+
+func (x *RouteLeak) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouteLeak.ProtoReflect.Descriptor instead.
+func (*RouteLeak) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *RouteLeak) GetRouterHash() string {
+	if x != nil {
+		return x.RouterHash
+	}
+	return ""
+}
+
+func (x *RouteLeak) GetRouterIp() string {
+	if x != nil {
+		return x.RouterIp
+	}
+	return ""
+}
+
+func (x *RouteLeak) GetPeerHash() string {
+	if x != nil {
+		return x.PeerHash
+	}
+	return ""
+}
+
+func (x *RouteLeak) GetPeerIp() string {
+	if x != nil {
+		return x.PeerIp
+	}
+	return ""
+}
+
+func (x *RouteLeak) GetPeerAsn() uint32 {
+	if x != nil {
+		return x.PeerAsn
+	}
+	return 0
+}
+
+func (x *RouteLeak) GetPeerRole() string {
+	if x != nil {
+		return x.PeerRole
+	}
+	return ""
+}
+
+func (x *RouteLeak) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *RouteLeak) GetPrefixLen() int32 {
+	if x != nil {
+		return x.PrefixLen
+	}
+	return 0
+}
+
+func (x *RouteLeak) GetIsIpv4() bool {
+	if x != nil {
+		return x.IsIpv4
+	}
+	return false
+}
+
+func (x *RouteLeak) GetPathId() int32 {
+	if x != nil {
+		return x.PathId
+	}
+	return 0
+}
+
+func (x *RouteLeak) GetOtc() uint32 {
+	if x != nil {
+		return x.Otc
+	}
+	return 0
+}
+
+func (x *RouteLeak) GetAsPath() []uint32 {
+	if x != nil {
+		return x.AsPath
+	}
+	return nil
+}
+
+func (x *RouteLeak) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RouteLeak) GetIsAdjRibInPostPolicy() bool {
+	if x != nil {
+		return x.IsAdjRibInPostPolicy
+	}
+	return false
+}
+
+func (x *RouteLeak) GetIsAdjRibOut() bool {
+	if x != nil {
+		return x.IsAdjRibOut
+	}
+	return false
+}
+
+func (x *RouteLeak) GetIsAdjRibOutPostPolicy() bool {
+	if x != nil {
+		return x.IsAdjRibOutPostPolicy
+	}
+	return false
+}
+
+func (x *RouteLeak) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *RouteLeak) GetUserLabels() map[string]string {
+	if x != nil {
+		return x.UserLabels
+	}
+	return nil
+}
+
+// PeerSync mirrors message.PeerSync.
+type PeerSync struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	RouterHash      string                 `protobuf:"bytes,1,opt,name=router_hash,json=routerHash,proto3" json:"router_hash,omitempty"`
+	RouterIp        string                 `protobuf:"bytes,2,opt,name=router_ip,json=routerIp,proto3" json:"router_ip,omitempty"`
+	PeerHash        string                 `protobuf:"bytes,3,opt,name=peer_hash,json=peerHash,proto3" json:"peer_hash,omitempty"`
+	PeerIp          string                 `protobuf:"bytes,4,opt,name=peer_ip,json=peerIp,proto3" json:"peer_ip,omitempty"`
+	PeerAsn         uint32                 `protobuf:"varint,5,opt,name=peer_asn,json=peerAsn,proto3" json:"peer_asn,omitempty"`
+	PeerType        uint32                 `protobuf:"varint,6,opt,name=peer_type,json=peerType,proto3" json:"peer_type,omitempty"`
+	PeerRd          string                 `protobuf:"bytes,7,opt,name=peer_rd,json=peerRd,proto3" json:"peer_rd,omitempty"`
+	Afi             uint32                 `protobuf:"varint,8,opt,name=afi,proto3" json:"afi,omitempty"`
+	Safi            uint32                 `protobuf:"varint,9,opt,name=safi,proto3" json:"safi,omitempty"`
+	Rib             string                 `protobuf:"bytes,10,opt,name=rib,proto3" json:"rib,omitempty"`
+	TimeToSync      float64                `protobuf:"fixed64,11,opt,name=time_to_sync,json=timeToSync,proto3" json:"time_to_sync,omitempty"`
+	Prefixes        uint64                 `protobuf:"varint,12,opt,name=prefixes,proto3" json:"prefixes,omitempty"`
+	GracefulRestart bool                   `protobuf:"varint,13,opt,name=graceful_restart,json=gracefulRestart,proto3" json:"graceful_restart,omitempty"`
+	Llgr            bool                   `protobuf:"varint,14,opt,name=llgr,proto3" json:"llgr,omitempty"`
+	Timestamp       string                 `protobuf:"bytes,15,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	UserLabels      map[string]string      `protobuf:"bytes,16,rep,name=user_labels,json=userLabels,proto3" json:"user_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PeerSync) Reset() {
+	*x = PeerSync{}
+	mi := &file_pkg_api_messages_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PeerSync) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerSync) ProtoMessage() {}
+
+func (x *PeerSync) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerSync.ProtoReflect.Descriptor instead.
+func (*PeerSync) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *PeerSync) GetRouterHash() string {
+	if x != nil {
+		return x.RouterHash
+	}
+	return ""
+}
+
+func (x *PeerSync) GetRouterIp() string {
+	if x != nil {
+		return x.RouterIp
+	}
+	return ""
+}
+
+func (x *PeerSync) GetPeerHash() string {
+	if x != nil {
+		return x.PeerHash
+	}
+	return ""
+}
+
+func (x *PeerSync) GetPeerIp() string {
+	if x != nil {
+		return x.PeerIp
+	}
+	return ""
+}
+
+func (x *PeerSync) GetPeerAsn() uint32 {
+	if x != nil {
+		return x.PeerAsn
+	}
+	return 0
+}
+
+func (x *PeerSync) GetPeerType() uint32 {
+	if x != nil {
+		return x.PeerType
+	}
+	return 0
+}
+
+func (x *PeerSync) GetPeerRd() string {
+	if x != nil {
+		return x.PeerRd
+	}
+	return ""
+}
+
+func (x *PeerSync) GetAfi() uint32 {
+	if x != nil {
+		return x.Afi
+	}
+	return 0
+}
+
+func (x *PeerSync) GetSafi() uint32 {
+	if x != nil {
+		return x.Safi
+	}
+	return 0
+}
+
+func (x *PeerSync) GetRib() string {
+	if x != nil {
+		return x.Rib
+	}
+	return ""
+}
+
+func (x *PeerSync) GetTimeToSync() float64 {
+	if x != nil {
+		return x.TimeToSync
+	}
+	return 0
+}
+
+func (x *PeerSync) GetPrefixes() uint64 {
+	if x != nil {
+		return x.Prefixes
+	}
+	return 0
+}
+
+func (x *PeerSync) GetGracefulRestart() bool {
+	if x != nil {
+		return x.GracefulRestart
+	}
+	return false
+}
+
+func (x *PeerSync) GetLlgr() bool {
+	if x != nil {
+		return x.Llgr
+	}
+	return false
+}
+
+func (x *PeerSync) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *PeerSync) GetUserLabels() map[string]string {
+	if x != nil {
+		return x.UserLabels
+	}
+	return nil
+}
+
+// BaseAttribute mirrors message.BaseAttribute.
+type BaseAttribute struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RouterHash    string                 `protobuf:"bytes,1,opt,name=router_hash,json=routerHash,proto3" json:"router_hash,omitempty"`
+	RouterIp      string                 `protobuf:"bytes,2,opt,name=router_ip,json=routerIp,proto3" json:"router_ip,omitempty"`
+	PeerHash      string                 `protobuf:"bytes,3,opt,name=peer_hash,json=peerHash,proto3" json:"peer_hash,omitempty"`
+	PeerIp        string                 `protobuf:"bytes,4,opt,name=peer_ip,json=peerIp,proto3" json:"peer_ip,omitempty"`
+	PeerAsn       uint32                 `protobuf:"varint,5,opt,name=peer_asn,json=peerAsn,proto3" json:"peer_asn,omitempty"`
+	BaseAttrHash  string                 `protobuf:"bytes,6,opt,name=base_attr_hash,json=baseAttrHash,proto3" json:"base_attr_hash,omitempty"`
+	BaseAttrs     *BaseAttributes        `protobuf:"bytes,7,opt,name=base_attrs,json=baseAttrs,proto3" json:"base_attrs,omitempty"`
+	Timestamp     string                 `protobuf:"bytes,8,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	UserLabels    map[string]string      `protobuf:"bytes,9,rep,name=user_labels,json=userLabels,proto3" json:"user_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BaseAttribute) Reset() {
+	*x = BaseAttribute{}
+	mi := &file_pkg_api_messages_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BaseAttribute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BaseAttribute) ProtoMessage() {}
+
+func (x *BaseAttribute) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BaseAttribute.ProtoReflect.Descriptor instead.
+func (*BaseAttribute) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *BaseAttribute) GetRouterHash() string {
+	if x != nil {
+		return x.RouterHash
+	}
+	return ""
+}
+
+func (x *BaseAttribute) GetRouterIp() string {
+	if x != nil {
+		return x.RouterIp
+	}
+	return ""
+}
+
+func (x *BaseAttribute) GetPeerHash() string {
+	if x != nil {
+		return x.PeerHash
+	}
+	return ""
+}
+
+func (x *BaseAttribute) GetPeerIp() string {
+	if x != nil {
+		return x.PeerIp
+	}
+	return ""
+}
+
+func (x *BaseAttribute) GetPeerAsn() uint32 {
+	if x != nil {
+		return x.PeerAsn
+	}
+	return 0
+}
+
+func (x *BaseAttribute) GetBaseAttrHash() string {
+	if x != nil {
+		return x.BaseAttrHash
+	}
+	return ""
+}
+
+func (x *BaseAttribute) GetBaseAttrs() *BaseAttributes {
+	if x != nil {
+		return x.BaseAttrs
+	}
+	return nil
+}
+
+func (x *BaseAttribute) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *BaseAttribute) GetUserLabels() map[string]string {
+	if x != nil {
+		return x.UserLabels
+	}
+	return nil
+}
+
+// TopologyEvent mirrors topology.Event.
+type TopologyEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Action        string                 `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Topology      *TopologyKey           `protobuf:"bytes,3,opt,name=topology,proto3" json:"topology,omitempty"`
+	Timestamp     string                 `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Node          *TopologyNode          `protobuf:"bytes,5,opt,name=node,proto3" json:"node,omitempty"`
+	Link          *TopologyLink          `protobuf:"bytes,6,opt,name=link,proto3" json:"link,omitempty"`
+	Prefix        *TopologyPrefix        `protobuf:"bytes,7,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Srv6Sid       *TopologySRv6SID       `protobuf:"bytes,8,opt,name=srv6_sid,json=srv6Sid,proto3" json:"srv6_sid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TopologyEvent) Reset() {
+	*x = TopologyEvent{}
+	mi := &file_pkg_api_messages_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopologyEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopologyEvent) ProtoMessage() {}
+
+func (x *TopologyEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopologyEvent.ProtoReflect.Descriptor instead.
+func (*TopologyEvent) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *TopologyEvent) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *TopologyEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *TopologyEvent) GetTopology() *TopologyKey {
+	if x != nil {
+		return x.Topology
+	}
+	return nil
+}
+
+func (x *TopologyEvent) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *TopologyEvent) GetNode() *TopologyNode {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+func (x *TopologyEvent) GetLink() *TopologyLink {
+	if x != nil {
+		return x.Link
+	}
+	return nil
+}
+
+func (x *TopologyEvent) GetPrefix() *TopologyPrefix {
+	if x != nil {
+		return x.Prefix
+	}
+	return nil
+}
+
+func (x *TopologyEvent) GetSrv6Sid() *TopologySRv6SID {
+	if x != nil {
+		return x.Srv6Sid
+	}
+	return nil
+}
+
+// TopologyKey mirrors topology.Key.
+type TopologyKey struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DomainId      int64                  `protobuf:"varint,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	ProtocolId    uint32                 `protobuf:"varint,2,opt,name=protocol_id,json=protocolId,proto3" json:"protocol_id,omitempty"`
+	AreaId        string                 `protobuf:"bytes,3,opt,name=area_id,json=areaId,proto3" json:"area_id,omitempty"`
+	MtId          uint32                 `protobuf:"varint,4,opt,name=mt_id,json=mtId,proto3" json:"mt_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TopologyKey) Reset() {
+	*x = TopologyKey{}
+	mi := &file_pkg_api_messages_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopologyKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopologyKey) ProtoMessage() {}
+
+func (x *TopologyKey) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopologyKey.ProtoReflect.Descriptor instead.
+func (*TopologyKey) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *TopologyKey) GetDomainId() int64 {
+	if x != nil {
+		return x.DomainId
+	}
+	return 0
+}
+
+func (x *TopologyKey) GetProtocolId() uint32 {
+	if x != nil {
+		return x.ProtocolId
+	}
+	return 0
+}
+
+func (x *TopologyKey) GetAreaId() string {
+	if x != nil {
+		return x.AreaId
+	}
+	return ""
+}
+
+func (x *TopologyKey) GetMtId() uint32 {
+	if x != nil {
+		return x.MtId
+	}
+	return 0
+}
+
+// TopologyNode mirrors topology.Node.
+type TopologyNode struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	IgpRouterId        string                 `protobuf:"bytes,1,opt,name=igp_router_id,json=igpRouterId,proto3" json:"igp_router_id,omitempty"`
+	Name               string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	RouterId           string                 `protobuf:"bytes,3,opt,name=router_id,json=routerId,proto3" json:"router_id,omitempty"`
+	Asn                uint32                 `protobuf:"varint,4,opt,name=asn,proto3" json:"asn,omitempty"`
+	SrAlgorithm        []int64                `protobuf:"varint,5,rep,packed,name=sr_algorithm,json=srAlgorithm,proto3" json:"sr_algorithm,omitempty"`
+	FlexAlgoDefinition []*FlexAlgoDefinition  `protobuf:"bytes,6,rep,name=flex_algo_definition,json=flexAlgoDefinition,proto3" json:"flex_algo_definition,omitempty"`
+	Srgb               []*TopologyLabelRange  `protobuf:"bytes,7,rep,name=srgb,proto3" json:"srgb,omitempty"`
+	Srlb               []*TopologyLabelRange  `protobuf:"bytes,8,rep,name=srlb,proto3" json:"srlb,omitempty"`
+	Prefixes           []*TopologyPrefix      `protobuf:"bytes,9,rep,name=prefixes,proto3" json:"prefixes,omitempty"`
+	Srv6Sids           []string               `protobuf:"bytes,10,rep,name=srv6_sids,json=srv6Sids,proto3" json:"srv6_sids,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *TopologyNode) Reset() {
+	*x = TopologyNode{}
+	mi := &file_pkg_api_messages_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopologyNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopologyNode) ProtoMessage() {}
+
+func (x *TopologyNode) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopologyNode.ProtoReflect.Descriptor instead.
+func (*TopologyNode) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *TopologyNode) GetIgpRouterId() string {
+	if x != nil {
+		return x.IgpRouterId
+	}
+	return ""
+}
+
+func (x *TopologyNode) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TopologyNode) GetRouterId() string {
+	if x != nil {
+		return x.RouterId
+	}
+	return ""
+}
+
+func (x *TopologyNode) GetAsn() uint32 {
+	if x != nil {
+		return x.Asn
+	}
+	return 0
+}
+
+func (x *TopologyNode) GetSrAlgorithm() []int64 {
+	if x != nil {
+		return x.SrAlgorithm
+	}
+	return nil
+}
+
+func (x *TopologyNode) GetFlexAlgoDefinition() []*FlexAlgoDefinition {
+	if x != nil {
+		return x.FlexAlgoDefinition
+	}
+	return nil
+}
+
+func (x *TopologyNode) GetSrgb() []*TopologyLabelRange {
+	if x != nil {
+		return x.Srgb
+	}
+	return nil
+}
+
+func (x *TopologyNode) GetSrlb() []*TopologyLabelRange {
+	if x != nil {
+		return x.Srlb
+	}
+	return nil
+}
+
+func (x *TopologyNode) GetPrefixes() []*TopologyPrefix {
+	if x != nil {
+		return x.Prefixes
+	}
+	return nil
+}
+
+func (x *TopologyNode) GetSrv6Sids() []string {
+	if x != nil {
+		return x.Srv6Sids
+	}
+	return nil
+}
+
+// TopologyLabelRange mirrors topology.LabelRange.
+type TopologyLabelRange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          uint32                 `protobuf:"varint,1,opt,name=base,proto3" json:"base,omitempty"`
+	Size          uint32                 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TopologyLabelRange) Reset() {
+	*x = TopologyLabelRange{}
+	mi := &file_pkg_api_messages_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopologyLabelRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopologyLabelRange) ProtoMessage() {}
+
+func (x *TopologyLabelRange) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopologyLabelRange.ProtoReflect.Descriptor instead.
+func (*TopologyLabelRange) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *TopologyLabelRange) GetBase() uint32 {
+	if x != nil {
+		return x.Base
+	}
+	return 0
+}
+
+func (x *TopologyLabelRange) GetSize() uint32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+// TopologyPrefix mirrors topology.Prefix.
+type TopologyPrefix struct {
+	state                protoimpl.MessageState  `protogen:"open.v1"`
+	Node                 string                  `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Prefix               string                  `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	PrefixLen            int32                   `protobuf:"varint,3,opt,name=prefix_len,json=prefixLen,proto3" json:"prefix_len,omitempty"`
+	PrefixMetric         uint32                  `protobuf:"varint,4,opt,name=prefix_metric,json=prefixMetric,proto3" json:"prefix_metric,omitempty"`
+	FlexAlgoPrefixMetric []*FlexAlgoPrefixMetric `protobuf:"bytes,5,rep,name=flex_algo_prefix_metric,json=flexAlgoPrefixMetric,proto3" json:"flex_algo_prefix_metric,omitempty"`
+	PrefixSid            []*PrefixSIDTLV         `protobuf:"bytes,6,rep,name=prefix_sid,json=prefixSid,proto3" json:"prefix_sid,omitempty"`
+	Srv6Locator          bool                    `protobuf:"varint,7,opt,name=srv6_locator,json=srv6Locator,proto3" json:"srv6_locator,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *TopologyPrefix) Reset() {
+	*x = TopologyPrefix{}
+	mi := &file_pkg_api_messages_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopologyPrefix) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopologyPrefix) ProtoMessage() {}
+
+func (x *TopologyPrefix) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopologyPrefix.ProtoReflect.Descriptor instead.
+func (*TopologyPrefix) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *TopologyPrefix) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *TopologyPrefix) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *TopologyPrefix) GetPrefixLen() int32 {
+	if x != nil {
+		return x.PrefixLen
+	}
+	return 0
+}
+
+func (x *TopologyPrefix) GetPrefixMetric() uint32 {
+	if x != nil {
+		return x.PrefixMetric
+	}
+	return 0
+}
+
+func (x *TopologyPrefix) GetFlexAlgoPrefixMetric() []*FlexAlgoPrefixMetric {
+	if x != nil {
+		return x.FlexAlgoPrefixMetric
+	}
+	return nil
+}
+
+func (x *TopologyPrefix) GetPrefixSid() []*PrefixSIDTLV {
+	if x != nil {
+		return x.PrefixSid
+	}
+	return nil
+}
+
+func (x *TopologyPrefix) GetSrv6Locator() bool {
+	if x != nil {
+		return x.Srv6Locator
+	}
+	return false
+}
+
+// TopologyLink mirrors topology.Link.
+type TopologyLink struct {
+	state              protoimpl.MessageState  `protogen:"open.v1"`
+	LocalNode          string                  `protobuf:"bytes,1,opt,name=local_node,json=localNode,proto3" json:"local_node,omitempty"`
+	RemoteNode         string                  `protobuf:"bytes,2,opt,name=remote_node,json=remoteNode,proto3" json:"remote_node,omitempty"`
+	LocalLinkIp        string                  `protobuf:"bytes,3,opt,name=local_link_ip,json=localLinkIp,proto3" json:"local_link_ip,omitempty"`
+	RemoteLinkIp       string                  `protobuf:"bytes,4,opt,name=remote_link_ip,json=remoteLinkIp,proto3" json:"remote_link_ip,omitempty"`
+	LocalLinkId        uint32                  `protobuf:"varint,5,opt,name=local_link_id,json=localLinkId,proto3" json:"local_link_id,omitempty"`
+	RemoteLinkId       uint32                  `protobuf:"varint,6,opt,name=remote_link_id,json=remoteLinkId,proto3" json:"remote_link_id,omitempty"`
+	IgpMetric          uint32                  `protobuf:"varint,7,opt,name=igp_metric,json=igpMetric,proto3" json:"igp_metric,omitempty"`
+	TeMetric           uint32                  `protobuf:"varint,8,opt,name=te_metric,json=teMetric,proto3" json:"te_metric,omitempty"`
+	Delay              uint32                  `protobuf:"varint,9,opt,name=delay,proto3" json:"delay,omitempty"`
+	AdminGroup         uint32                  `protobuf:"varint,10,opt,name=admin_group,json=adminGroup,proto3" json:"admin_group,omitempty"`
+	ExtAdminGroup      []uint32                `protobuf:"varint,11,rep,packed,name=ext_admin_group,json=extAdminGroup,proto3" json:"ext_admin_group,omitempty"`
+	Srlg               []uint32                `protobuf:"varint,12,rep,packed,name=srlg,proto3" json:"srlg,omitempty"`
+	AdjSid             []*AdjacencySIDTLV      `protobuf:"bytes,13,rep,name=adj_sid,json=adjSid,proto3" json:"adj_sid,omitempty"`
+	Srv6EndxSid        []string                `protobuf:"bytes,14,rep,name=srv6_endx_sid,json=srv6EndxSid,proto3" json:"srv6_endx_sid,omitempty"`
+	FlexAlgoAttributes *TopologyLinkAttributes `protobuf:"bytes,15,opt,name=flex_algo_attributes,json=flexAlgoAttributes,proto3" json:"flex_algo_attributes,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *TopologyLink) Reset() {
+	*x = TopologyLink{}
+	mi := &file_pkg_api_messages_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopologyLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopologyLink) ProtoMessage() {}
+
+func (x *TopologyLink) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopologyLink.ProtoReflect.Descriptor instead.
+func (*TopologyLink) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *TopologyLink) GetLocalNode() string {
+	if x != nil {
+		return x.LocalNode
+	}
+	return ""
+}
+
+func (x *TopologyLink) GetRemoteNode() string {
+	if x != nil {
+		return x.RemoteNode
+	}
+	return ""
+}
+
+func (x *TopologyLink) GetLocalLinkIp() string {
+	if x != nil {
+		return x.LocalLinkIp
+	}
+	return ""
+}
+
+func (x *TopologyLink) GetRemoteLinkIp() string {
+	if x != nil {
+		return x.RemoteLinkIp
+	}
+	return ""
+}
+
+func (x *TopologyLink) GetLocalLinkId() uint32 {
+	if x != nil {
+		return x.LocalLinkId
+	}
+	return 0
+}
+
+func (x *TopologyLink) GetRemoteLinkId() uint32 {
+	if x != nil {
+		return x.RemoteLinkId
+	}
+	return 0
+}
+
+func (x *TopologyLink) GetIgpMetric() uint32 {
+	if x != nil {
+		return x.IgpMetric
+	}
+	return 0
+}
+
+func (x *TopologyLink) GetTeMetric() uint32 {
+	if x != nil {
+		return x.TeMetric
+	}
+	return 0
+}
+
+func (x *TopologyLink) GetDelay() uint32 {
+	if x != nil {
+		return x.Delay
+	}
+	return 0
+}
+
+func (x *TopologyLink) GetAdminGroup() uint32 {
+	if x != nil {
+		return x.AdminGroup
+	}
+	return 0
+}
+
+func (x *TopologyLink) GetExtAdminGroup() []uint32 {
+	if x != nil {
+		return x.ExtAdminGroup
+	}
+	return nil
+}
+
+func (x *TopologyLink) GetSrlg() []uint32 {
+	if x != nil {
+		return x.Srlg
+	}
+	return nil
+}
+
+func (x *TopologyLink) GetAdjSid() []*AdjacencySIDTLV {
+	if x != nil {
+		return x.AdjSid
+	}
+	return nil
+}
+
+func (x *TopologyLink) GetSrv6EndxSid() []string {
+	if x != nil {
+		return x.Srv6EndxSid
+	}
+	return nil
+}
+
+func (x *TopologyLink) GetFlexAlgoAttributes() *TopologyLinkAttributes {
+	if x != nil {
+		return x.FlexAlgoAttributes
+	}
+	return nil
+}
+
+// TopologyLinkAttributes mirrors topology.LinkAttributes.
+type TopologyLinkAttributes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeMetric      uint32                 `protobuf:"varint,1,opt,name=te_metric,json=teMetric,proto3" json:"te_metric,omitempty"`
+	Delay         uint32                 `protobuf:"varint,2,opt,name=delay,proto3" json:"delay,omitempty"`
+	AdminGroup    uint32                 `protobuf:"varint,3,opt,name=admin_group,json=adminGroup,proto3" json:"admin_group,omitempty"`
+	ExtAdminGroup []uint32               `protobuf:"varint,4,rep,packed,name=ext_admin_group,json=extAdminGroup,proto3" json:"ext_admin_group,omitempty"`
+	Srlg          []uint32               `protobuf:"varint,5,rep,packed,name=srlg,proto3" json:"srlg,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TopologyLinkAttributes) Reset() {
+	*x = TopologyLinkAttributes{}
+	mi := &file_pkg_api_messages_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopologyLinkAttributes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopologyLinkAttributes) ProtoMessage() {}
+
+func (x *TopologyLinkAttributes) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopologyLinkAttributes.ProtoReflect.Descriptor instead.
+func (*TopologyLinkAttributes) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *TopologyLinkAttributes) GetTeMetric() uint32 {
+	if x != nil {
+		return x.TeMetric
+	}
+	return 0
+}
+
+func (x *TopologyLinkAttributes) GetDelay() uint32 {
+	if x != nil {
+		return x.Delay
+	}
+	return 0
+}
+
+func (x *TopologyLinkAttributes) GetAdminGroup() uint32 {
+	if x != nil {
+		return x.AdminGroup
+	}
+	return 0
+}
+
+func (x *TopologyLinkAttributes) GetExtAdminGroup() []uint32 {
+	if x != nil {
+		return x.ExtAdminGroup
+	}
+	return nil
+}
+
+func (x *TopologyLinkAttributes) GetSrlg() []uint32 {
+	if x != nil {
+		return x.Srlg
+	}
+	return nil
+}
+
+// TopologySRv6SID mirrors topology.SRv6SID.
+type TopologySRv6SID struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Node          string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Srv6Sid       string                 `protobuf:"bytes,2,opt,name=srv6_sid,json=srv6Sid,proto3" json:"srv6_sid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TopologySRv6SID) Reset() {
+	*x = TopologySRv6SID{}
+	mi := &file_pkg_api_messages_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopologySRv6SID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopologySRv6SID) ProtoMessage() {}
+
+func (x *TopologySRv6SID) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopologySRv6SID.ProtoReflect.Descriptor instead.
+func (*TopologySRv6SID) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *TopologySRv6SID) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *TopologySRv6SID) GetSrv6Sid() string {
+	if x != nil {
+		return x.Srv6Sid
+	}
+	return ""
+}
+
+// ResolvedSRPolicy mirrors topology.ResolvedSRPolicy.
+type ResolvedSRPolicy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Action        string                 `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	Hash          string                 `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	RouterIp      string                 `protobuf:"bytes,3,opt,name=router_ip,json=routerIp,proto3" json:"router_ip,omitempty"`
+	PeerIp        string                 `protobuf:"bytes,4,opt,name=peer_ip,json=peerIp,proto3" json:"peer_ip,omitempty"`
+	Timestamp     string                 `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Distinguisher uint32                 `protobuf:"varint,6,opt,name=distinguisher,proto3" json:"distinguisher,omitempty"`
+	Color         uint32                 `protobuf:"varint,7,opt,name=color,proto3" json:"color,omitempty"`
+	Endpoint      string                 `protobuf:"bytes,8,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	PolicyName    string                 `protobuf:"bytes,9,opt,name=policy_name,json=policyName,proto3" json:"policy_name,omitempty"`
+	SegmentLists  []*ResolvedSegmentList `protobuf:"bytes,10,rep,name=segment_lists,json=segmentLists,proto3" json:"segment_lists,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolvedSRPolicy) Reset() {
+	*x = ResolvedSRPolicy{}
+	mi := &file_pkg_api_messages_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolvedSRPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolvedSRPolicy) ProtoMessage() {}
+
+func (x *ResolvedSRPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolvedSRPolicy.ProtoReflect.Descriptor instead.
+func (*ResolvedSRPolicy) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *ResolvedSRPolicy) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ResolvedSRPolicy) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *ResolvedSRPolicy) GetRouterIp() string {
+	if x != nil {
+		return x.RouterIp
+	}
+	return ""
+}
+
+func (x *ResolvedSRPolicy) GetPeerIp() string {
+	if x != nil {
+		return x.PeerIp
+	}
+	return ""
+}
+
+func (x *ResolvedSRPolicy) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *ResolvedSRPolicy) GetDistinguisher() uint32 {
+	if x != nil {
+		return x.Distinguisher
+	}
+	return 0
+}
+
+func (x *ResolvedSRPolicy) GetColor() uint32 {
+	if x != nil {
+		return x.Color
+	}
+	return 0
+}
+
+func (x *ResolvedSRPolicy) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *ResolvedSRPolicy) GetPolicyName() string {
+	if x != nil {
+		return x.PolicyName
+	}
+	return ""
+}
+
+func (x *ResolvedSRPolicy) GetSegmentLists() []*ResolvedSegmentList {
+	if x != nil {
+		return x.SegmentLists
+	}
+	return nil
+}
+
+// ResolvedSegmentList mirrors topology.ResolvedSegmentList.
+type ResolvedSegmentList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Weight        uint32                 `protobuf:"varint,1,opt,name=weight,proto3" json:"weight,omitempty"`
+	Topology      *TopologyKey           `protobuf:"bytes,2,opt,name=topology,proto3" json:"topology,omitempty"`
+	Hops          []*TopologyHop         `protobuf:"bytes,3,rep,name=hops,proto3" json:"hops,omitempty"`
+	Errors        []string               `protobuf:"bytes,4,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolvedSegmentList) Reset() {
+	*x = ResolvedSegmentList{}
+	mi := &file_pkg_api_messages_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolvedSegmentList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolvedSegmentList) ProtoMessage() {}
+
+func (x *ResolvedSegmentList) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolvedSegmentList.ProtoReflect.Descriptor instead.
+func (*ResolvedSegmentList) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *ResolvedSegmentList) GetWeight() uint32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *ResolvedSegmentList) GetTopology() *TopologyKey {
+	if x != nil {
+		return x.Topology
+	}
+	return nil
+}
+
+func (x *ResolvedSegmentList) GetHops() []*TopologyHop {
+	if x != nil {
+		return x.Hops
+	}
+	return nil
+}
+
+func (x *ResolvedSegmentList) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+// TopologyHop mirrors topology.Hop.
+type TopologyHop struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Segment       int64                  `protobuf:"varint,1,opt,name=segment,proto3" json:"segment,omitempty"`
+	SegmentType   string                 `protobuf:"bytes,2,opt,name=segment_type,json=segmentType,proto3" json:"segment_type,omitempty"`
+	Kind          string                 `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	Sid           string                 `protobuf:"bytes,4,opt,name=sid,proto3" json:"sid,omitempty"`
+	Node          string                 `protobuf:"bytes,5,opt,name=node,proto3" json:"node,omitempty"`
+	NodeName      string                 `protobuf:"bytes,6,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
+	Link          *TopologyLink          `protobuf:"bytes,7,opt,name=link,proto3" json:"link,omitempty"`
+	Prefix        string                 `protobuf:"bytes,8,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TopologyHop) Reset() {
+	*x = TopologyHop{}
+	mi := &file_pkg_api_messages_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopologyHop) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopologyHop) ProtoMessage() {}
+
+func (x *TopologyHop) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopologyHop.ProtoReflect.Descriptor instead.
+func (*TopologyHop) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *TopologyHop) GetSegment() int64 {
+	if x != nil {
+		return x.Segment
+	}
+	return 0
+}
+
+func (x *TopologyHop) GetSegmentType() string {
+	if x != nil {
+		return x.SegmentType
+	}
+	return ""
+}
+
+func (x *TopologyHop) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *TopologyHop) GetSid() string {
+	if x != nil {
+		return x.Sid
+	}
+	return ""
+}
+
+func (x *TopologyHop) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *TopologyHop) GetNodeName() string {
+	if x != nil {
+		return x.NodeName
+	}
+	return ""
+}
+
+func (x *TopologyHop) GetLink() *TopologyLink {
+	if x != nil {
+		return x.Link
+	}
+	return nil
+}
+
+func (x *TopologyHop) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+// ChurnStats mirrors churn.Stats.
+type ChurnStats struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	RouterHash       string                 `protobuf:"bytes,1,opt,name=router_hash,json=routerHash,proto3" json:"router_hash,omitempty"`
+	RouterIp         string                 `protobuf:"bytes,2,opt,name=router_ip,json=routerIp,proto3" json:"router_ip,omitempty"`
+	PeerIp           string                 `protobuf:"bytes,3,opt,name=peer_ip,json=peerIp,proto3" json:"peer_ip,omitempty"`
+	PeerAsn          uint32                 `protobuf:"varint,4,opt,name=peer_asn,json=peerAsn,proto3" json:"peer_asn,omitempty"`
+	Rib              string                 `protobuf:"bytes,5,opt,name=rib,proto3" json:"rib,omitempty"`
+	Afi              string                 `protobuf:"bytes,6,opt,name=afi,proto3" json:"afi,omitempty"`
+	Timestamp        string                 `protobuf:"bytes,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	IntervalSecs     float64                `protobuf:"fixed64,8,opt,name=interval_secs,json=intervalSecs,proto3" json:"interval_secs,omitempty"`
+	Updates          uint64                 `protobuf:"varint,9,opt,name=updates,proto3" json:"updates,omitempty"`
+	Withdraws        uint64                 `protobuf:"varint,10,opt,name=withdraws,proto3" json:"withdraws,omitempty"`
+	UpdateRate       float64                `protobuf:"fixed64,11,opt,name=update_rate,json=updateRate,proto3" json:"update_rate,omitempty"`
+	WithdrawRate     float64                `protobuf:"fixed64,12,opt,name=withdraw_rate,json=withdrawRate,proto3" json:"withdraw_rate,omitempty"`
+	FlappingPrefixes int64                  `protobuf:"varint,13,opt,name=flapping_prefixes,json=flappingPrefixes,proto3" json:"flapping_prefixes,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ChurnStats) Reset() {
+	*x = ChurnStats{}
+	mi := &file_pkg_api_messages_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChurnStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChurnStats) ProtoMessage() {}
+
+func (x *ChurnStats) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChurnStats.ProtoReflect.Descriptor instead.
+func (*ChurnStats) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *ChurnStats) GetRouterHash() string {
+	if x != nil {
+		return x.RouterHash
+	}
+	return ""
+}
+
+func (x *ChurnStats) GetRouterIp() string {
+	if x != nil {
+		return x.RouterIp
+	}
+	return ""
+}
+
+func (x *ChurnStats) GetPeerIp() string {
+	if x != nil {
+		return x.PeerIp
+	}
+	return ""
+}
+
+func (x *ChurnStats) GetPeerAsn() uint32 {
+	if x != nil {
+		return x.PeerAsn
+	}
+	return 0
+}
+
+func (x *ChurnStats) GetRib() string {
+	if x != nil {
+		return x.Rib
+	}
+	return ""
+}
+
+func (x *ChurnStats) GetAfi() string {
+	if x != nil {
+		return x.Afi
+	}
+	return ""
+}
+
+func (x *ChurnStats) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *ChurnStats) GetIntervalSecs() float64 {
+	if x != nil {
+		return x.IntervalSecs
+	}
+	return 0
+}
+
+func (x *ChurnStats) GetUpdates() uint64 {
+	if x != nil {
+		return x.Updates
+	}
+	return 0
+}
+
+func (x *ChurnStats) GetWithdraws() uint64 {
+	if x != nil {
+		return x.Withdraws
+	}
+	return 0
+}
+
+func (x *ChurnStats) GetUpdateRate() float64 {
+	if x != nil {
+		return x.UpdateRate
+	}
+	return 0
+}
+
+func (x *ChurnStats) GetWithdrawRate() float64 {
+	if x != nil {
+		return x.WithdrawRate
+	}
+	return 0
+}
+
+func (x *ChurnStats) GetFlappingPrefixes() int64 {
+	if x != nil {
+		return x.FlappingPrefixes
+	}
+	return 0
+}
+
+// FlapEvent mirrors churn.FlapEvent.
+type FlapEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Action        string                 `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	RouterHash    string                 `protobuf:"bytes,2,opt,name=router_hash,json=routerHash,proto3" json:"router_hash,omitempty"`
+	RouterIp      string                 `protobuf:"bytes,3,opt,name=router_ip,json=routerIp,proto3" json:"router_ip,omitempty"`
+	PeerIp        string                 `protobuf:"bytes,4,opt,name=peer_ip,json=peerIp,proto3" json:"peer_ip,omitempty"`
+	PeerAsn       uint32                 `protobuf:"varint,5,opt,name=peer_asn,json=peerAsn,proto3" json:"peer_asn,omitempty"`
+	Rib           string                 `protobuf:"bytes,6,opt,name=rib,proto3" json:"rib,omitempty"`
+	Afi           string                 `protobuf:"bytes,7,opt,name=afi,proto3" json:"afi,omitempty"`
+	VpnRd         string                 `protobuf:"bytes,8,opt,name=vpn_rd,json=vpnRd,proto3" json:"vpn_rd,omitempty"`
+	Prefix        string                 `protobuf:"bytes,9,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	PrefixLen     int32                  `protobuf:"varint,10,opt,name=prefix_len,json=prefixLen,proto3" json:"prefix_len,omitempty"`
+	PathId        int32                  `protobuf:"varint,11,opt,name=path_id,json=pathId,proto3" json:"path_id,omitempty"`
+	Penalty       float64                `protobuf:"fixed64,12,opt,name=penalty,proto3" json:"penalty,omitempty"`
+	Flaps         int64                  `protobuf:"varint,13,opt,name=flaps,proto3" json:"flaps,omitempty"`
+	Timestamp     string                 `protobuf:"bytes,14,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlapEvent) Reset() {
+	*x = FlapEvent{}
+	mi := &file_pkg_api_messages_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlapEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlapEvent) ProtoMessage() {}
+
+func (x *FlapEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlapEvent.ProtoReflect.Descriptor instead.
+func (*FlapEvent) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *FlapEvent) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *FlapEvent) GetRouterHash() string {
+	if x != nil {
+		return x.RouterHash
+	}
+	return ""
+}
+
+func (x *FlapEvent) GetRouterIp() string {
+	if x != nil {
+		return x.RouterIp
+	}
+	return ""
+}
+
+func (x *FlapEvent) GetPeerIp() string {
+	if x != nil {
+		return x.PeerIp
+	}
+	return ""
+}
+
+func (x *FlapEvent) GetPeerAsn() uint32 {
+	if x != nil {
+		return x.PeerAsn
+	}
+	return 0
+}
+
+func (x *FlapEvent) GetRib() string {
+	if x != nil {
+		return x.Rib
+	}
+	return ""
+}
+
+func (x *FlapEvent) GetAfi() string {
+	if x != nil {
+		return x.Afi
+	}
+	return ""
+}
+
+func (x *FlapEvent) GetVpnRd() string {
+	if x != nil {
+		return x.VpnRd
+	}
+	return ""
+}
+
+func (x *FlapEvent) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *FlapEvent) GetPrefixLen() int32 {
+	if x != nil {
+		return x.PrefixLen
+	}
+	return 0
+}
+
+func (x *FlapEvent) GetPathId() int32 {
+	if x != nil {
+		return x.PathId
+	}
+	return 0
+}
+
+func (x *FlapEvent) GetPenalty() float64 {
+	if x != nil {
+		return x.Penalty
+	}
+	return 0
+}
+
+func (x *FlapEvent) GetFlaps() int64 {
+	if x != nil {
+		return x.Flaps
+	}
+	return 0
+}
+
+func (x *FlapEvent) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+// HijackEvent mirrors hijack.Event.
+type HijackEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Action        string                 `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Prefix        string                 `protobuf:"bytes,3,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	OwnedPrefix   string                 `protobuf:"bytes,4,opt,name=owned_prefix,json=ownedPrefix,proto3" json:"owned_prefix,omitempty"`
+	OriginAs      uint32                 `protobuf:"varint,5,opt,name=origin_as,json=originAs,proto3" json:"origin_as,omitempty"`
+	FirstHopAs    uint32                 `protobuf:"varint,6,opt,name=first_hop_as,json=firstHopAs,proto3" json:"first_hop_as,omitempty"`
+	Origins       []uint32               `protobuf:"varint,7,rep,packed,name=origins,proto3" json:"origins,omitempty"`
+	RouterHash    string                 `protobuf:"bytes,8,opt,name=router_hash,json=routerHash,proto3" json:"router_hash,omitempty"`
+	RouterIp      string                 `protobuf:"bytes,9,opt,name=router_ip,json=routerIp,proto3" json:"router_ip,omitempty"`
+	PeerIp        string                 `protobuf:"bytes,10,opt,name=peer_ip,json=peerIp,proto3" json:"peer_ip,omitempty"`
+	PeerAsn       uint32                 `protobuf:"varint,11,opt,name=peer_asn,json=peerAsn,proto3" json:"peer_asn,omitempty"`
+	AsPath        []uint32               `protobuf:"varint,12,rep,packed,name=as_path,json=asPath,proto3" json:"as_path,omitempty"`
+	Timestamp     string                 `protobuf:"bytes,13,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HijackEvent) Reset() {
+	*x = HijackEvent{}
+	mi := &file_pkg_api_messages_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HijackEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HijackEvent) ProtoMessage() {}
+
+func (x *HijackEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HijackEvent.ProtoReflect.Descriptor instead.
+func (*HijackEvent) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *HijackEvent) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *HijackEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *HijackEvent) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *HijackEvent) GetOwnedPrefix() string {
+	if x != nil {
+		return x.OwnedPrefix
+	}
+	return ""
+}
+
+func (x *HijackEvent) GetOriginAs() uint32 {
+	if x != nil {
+		return x.OriginAs
+	}
+	return 0
+}
+
+func (x *HijackEvent) GetFirstHopAs() uint32 {
+	if x != nil {
+		return x.FirstHopAs
+	}
+	return 0
+}
+
+func (x *HijackEvent) GetOrigins() []uint32 {
+	if x != nil {
+		return x.Origins
+	}
+	return nil
+}
+
+func (x *HijackEvent) GetRouterHash() string {
+	if x != nil {
+		return x.RouterHash
+	}
+	return ""
+}
+
+func (x *HijackEvent) GetRouterIp() string {
+	if x != nil {
+		return x.RouterIp
+	}
+	return ""
+}
+
+func (x *HijackEvent) GetPeerIp() string {
+	if x != nil {
+		return x.PeerIp
+	}
+	return ""
+}
+
+func (x *HijackEvent) GetPeerAsn() uint32 {
+	if x != nil {
+		return x.PeerAsn
+	}
+	return 0
+}
+
+func (x *HijackEvent) GetAsPath() []uint32 {
+	if x != nil {
+		return x.AsPath
+	}
+	return nil
+}
+
+func (x *HijackEvent) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+// ProtocolFlags carries the flags of the IS-IS, OSPF or unknown protocol SR
+// and BGP-LS flags of sr.CapabilityFlags, sr.PrefixSIDFlags,
+// sr.AdjacencySIDFlags, bgpls.PrefixAttrFlags and bgpls.RangeTLVFlags, only
+// the flags of the protocol are set, flags carries the flags of unknown
+// protocols.
+type ProtocolFlags struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Flags         uint32                 `protobuf:"varint,1,opt,name=flags,proto3" json:"flags,omitempty"`
+	AFlag         bool                   `protobuf:"varint,2,opt,name=a_flag,json=aFlag,proto3" json:"a_flag,omitempty"`
+	BFlag         bool                   `protobuf:"varint,3,opt,name=b_flag,json=bFlag,proto3" json:"b_flag,omitempty"`
+	DFlag         bool                   `protobuf:"varint,4,opt,name=d_flag,json=dFlag,proto3" json:"d_flag,omitempty"`
+	DnFlag        bool                   `protobuf:"varint,5,opt,name=dn_flag,json=dnFlag,proto3" json:"dn_flag,omitempty"`
+	EFlag         bool                   `protobuf:"varint,6,opt,name=e_flag,json=eFlag,proto3" json:"e_flag,omitempty"`
+	FFlag         bool                   `protobuf:"varint,7,opt,name=f_flag,json=fFlag,proto3" json:"f_flag,omitempty"`
+	GFlag         bool                   `protobuf:"varint,8,opt,name=g_flag,json=gFlag,proto3" json:"g_flag,omitempty"`
+	IFlag         bool                   `protobuf:"varint,9,opt,name=i_flag,json=iFlag,proto3" json:"i_flag,omitempty"`
+	IaFlag        bool                   `protobuf:"varint,10,opt,name=ia_flag,json=iaFlag,proto3" json:"ia_flag,omitempty"`
+	LFlag         bool                   `protobuf:"varint,11,opt,name=l_flag,json=lFlag,proto3" json:"l_flag,omitempty"`
+	LaFlag        bool                   `protobuf:"varint,12,opt,name=la_flag,json=laFlag,proto3" json:"la_flag,omitempty"`
+	MFlag         bool                   `protobuf:"varint,13,opt,name=m_flag,json=mFlag,proto3" json:"m_flag,omitempty"`
+	NFlag         bool                   `protobuf:"varint,14,opt,name=n_flag,json=nFlag,proto3" json:"n_flag,omitempty"`
+	NpFlag        bool                   `protobuf:"varint,15,opt,name=np_flag,json=npFlag,proto3" json:"np_flag,omitempty"`
+	NuFlag        bool                   `protobuf:"varint,16,opt,name=nu_flag,json=nuFlag,proto3" json:"nu_flag,omitempty"`
+	PFlag         bool                   `protobuf:"varint,17,opt,name=p_flag,json=pFlag,proto3" json:"p_flag,omitempty"`
+	RFlag         bool                   `protobuf:"varint,18,opt,name=r_flag,json=rFlag,proto3" json:"r_flag,omitempty"`
+	SFlag         bool                   `protobuf:"varint,19,opt,name=s_flag,json=sFlag,proto3" json:"s_flag,omitempty"`
+	VFlag         bool                   `protobuf:"varint,20,opt,name=v_flag,json=vFlag,proto3" json:"v_flag,omitempty"`
+	XFlag         bool                   `protobuf:"varint,21,opt,name=x_flag,json=xFlag,proto3" json:"x_flag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProtocolFlags) Reset() {
+	*x = ProtocolFlags{}
+	mi := &file_pkg_api_messages_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProtocolFlags) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtocolFlags) ProtoMessage() {}
+
+func (x *ProtocolFlags) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtocolFlags.ProtoReflect.Descriptor instead.
+func (*ProtocolFlags) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *ProtocolFlags) GetFlags() uint32 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+func (x *ProtocolFlags) GetAFlag() bool {
+	if x != nil {
+		return x.AFlag
+	}
+	return false
+}
+
+func (x *ProtocolFlags) GetBFlag() bool {
+	if x != nil {
+		return x.BFlag
+	}
+	return false
+}
+
+func (x *ProtocolFlags) GetDFlag() bool {
+	if x != nil {
+		return x.DFlag
+	}
+	return false
+}
+
+func (x *ProtocolFlags) GetDnFlag() bool {
+	if x != nil {
+		return x.DnFlag
+	}
+	return false
+}
+
+func (x *ProtocolFlags) GetEFlag() bool {
+	if x != nil {
+		return x.EFlag
+	}
+	return false
+}
+
+func (x *ProtocolFlags) GetFFlag() bool {
+	if x != nil {
+		return x.FFlag
+	}
+	return false
+}
+
+func (x *ProtocolFlags) GetGFlag() bool {
+	if x != nil {
+		return x.GFlag
+	}
+	return false
+}
+
+func (x *ProtocolFlags) GetIFlag() bool {
+	if x != nil {
+		return x.IFlag
+	}
+	return false
+}
+
+func (x *ProtocolFlags) GetIaFlag() bool {
+	if x != nil {
+		return x.IaFlag
+	}
+	return false
+}
+
+func (x *ProtocolFlags) GetLFlag() bool {
+	if x != nil {
+		return x.LFlag
+	}
+	return false
+}
+
+func (x *ProtocolFlags) GetLaFlag() bool {
+	if x != nil {
+		return x.LaFlag
+	}
+	return false
+}
+
+func (x *ProtocolFlags) GetMFlag() bool {
+	if x != nil {
+		return x.MFlag
+	}
+	return false
+}
+
+func (x *ProtocolFlags) GetNFlag() bool {
+	if x != nil {
+		return x.NFlag
+	}
+	return false
+}
+
+func (x *ProtocolFlags) GetNpFlag() bool {
+	if x != nil {
+		return x.NpFlag
+	}
+	return false
+}
+
+func (x *ProtocolFlags) GetNuFlag() bool {
+	if x != nil {
+		return x.NuFlag
+	}
+	return false
+}
+
+func (x *ProtocolFlags) GetPFlag() bool {
+	if x != nil {
+		return x.PFlag
+	}
+	return false
+}
+
+func (x *ProtocolFlags) GetRFlag() bool {
+	if x != nil {
+		return x.RFlag
+	}
+	return false
+}
+
+func (x *ProtocolFlags) GetSFlag() bool {
+	if x != nil {
+		return x.SFlag
+	}
+	return false
+}
+
+func (x *ProtocolFlags) GetVFlag() bool {
+	if x != nil {
+		return x.VFlag
+	}
+	return false
+}
+
+func (x *ProtocolFlags) GetXFlag() bool {
+	if x != nil {
+		return x.XFlag
+	}
+	return false
+}
+
+// SRv6SubTLV mirrors srv6.UnknownSrv6SubTLV, the srv6.SubTLV of the SRv6
+// End.X SID TLV.
+type SRv6SubTLV struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          uint32                 `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	Length        uint32                 `protobuf:"varint,2,opt,name=length,proto3" json:"length,omitempty"`
+	Value         []byte                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SRv6SubTLV) Reset() {
+	*x = SRv6SubTLV{}
+	mi := &file_pkg_api_messages_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SRv6SubTLV) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SRv6SubTLV) ProtoMessage() {}
+
+func (x *SRv6SubTLV) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SRv6SubTLV.ProtoReflect.Descriptor instead.
+func (*SRv6SubTLV) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *SRv6SubTLV) GetType() uint32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *SRv6SubTLV) GetLength() uint32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *SRv6SubTLV) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+// BindingSIDValue carries the label or SRv6 srpolicy.BSID of the bsid_type
+// of BindingSID.
+type BindingSIDValue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Flags         uint32                 `protobuf:"varint,1,opt,name=flags,proto3" json:"flags,omitempty"`
+	LabelBsid     uint32                 `protobuf:"varint,2,opt,name=label_bsid,json=labelBsid,proto3" json:"label_bsid,omitempty"`
+	Srv6Bsid      []byte                 `protobuf:"bytes,3,opt,name=srv6_bsid,json=srv6Bsid,proto3" json:"srv6_bsid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BindingSIDValue) Reset() {
+	*x = BindingSIDValue{}
+	mi := &file_pkg_api_messages_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BindingSIDValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BindingSIDValue) ProtoMessage() {}
+
+func (x *BindingSIDValue) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BindingSIDValue.ProtoReflect.Descriptor instead.
+func (*BindingSIDValue) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *BindingSIDValue) GetFlags() uint32 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+func (x *BindingSIDValue) GetLabelBsid() uint32 {
+	if x != nil {
+		return x.LabelBsid
+	}
+	return 0
+}
+
+func (x *BindingSIDValue) GetSrv6Bsid() []byte {
+	if x != nil {
+		return x.Srv6Bsid
+	}
+	return nil
+}
+
+// Segment carries the srpolicy.Segment of the types A to K, only the fields
+// of the segment_type are set.
+type Segment struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	SegmentType          int32                  `protobuf:"varint,1,opt,name=segment_type,json=segmentType,proto3" json:"segment_type,omitempty"`
+	Flags                *SegmentFlags          `protobuf:"bytes,2,opt,name=flags,proto3" json:"flags,omitempty"`
+	Label                uint32                 `protobuf:"varint,3,opt,name=label,proto3" json:"label,omitempty"`
+	Tc                   uint32                 `protobuf:"varint,4,opt,name=tc,proto3" json:"tc,omitempty"`
+	S                    bool                   `protobuf:"varint,5,opt,name=s,proto3" json:"s,omitempty"`
+	Ttl                  uint32                 `protobuf:"varint,6,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	Srv6Sid              []byte                 `protobuf:"bytes,7,opt,name=srv6_sid,json=srv6Sid,proto3" json:"srv6_sid,omitempty"`
+	SrAlgorithm          uint32                 `protobuf:"varint,8,opt,name=sr_algorithm,json=srAlgorithm,proto3" json:"sr_algorithm,omitempty"`
+	Ipv4Address          []byte                 `protobuf:"bytes,9,opt,name=ipv4_address,json=ipv4Address,proto3" json:"ipv4_address,omitempty"`
+	Ipv6Address          []byte                 `protobuf:"bytes,10,opt,name=ipv6_address,json=ipv6Address,proto3" json:"ipv6_address,omitempty"`
+	Sid                  *uint32                `protobuf:"varint,11,opt,name=sid,proto3,oneof" json:"sid,omitempty"`
+	LocalInterfaceId     uint32                 `protobuf:"varint,12,opt,name=local_interface_id,json=localInterfaceId,proto3" json:"local_interface_id,omitempty"`
+	RemoteInterfaceId    uint32                 `protobuf:"varint,13,opt,name=remote_interface_id,json=remoteInterfaceId,proto3" json:"remote_interface_id,omitempty"`
+	LocalIpv4Address     []byte                 `protobuf:"bytes,14,opt,name=local_ipv4_address,json=localIpv4Address,proto3" json:"local_ipv4_address,omitempty"`
+	RemoteIpv4Address    []byte                 `protobuf:"bytes,15,opt,name=remote_ipv4_address,json=remoteIpv4Address,proto3" json:"remote_ipv4_address,omitempty"`
+	LocalIpv6Address     []byte                 `protobuf:"bytes,16,opt,name=local_ipv6_address,json=localIpv6Address,proto3" json:"local_ipv6_address,omitempty"`
+	RemoteIpv6Address    []byte                 `protobuf:"bytes,17,opt,name=remote_ipv6_address,json=remoteIpv6Address,proto3" json:"remote_ipv6_address,omitempty"`
+	Ipv6NodeAddress      []byte                 `protobuf:"bytes,18,opt,name=ipv6_node_address,json=ipv6NodeAddress,proto3" json:"ipv6_node_address,omitempty"`
+	Srv6EndpointBehavior []byte                 `protobuf:"bytes,19,opt,name=srv6_endpoint_behavior,json=srv6EndpointBehavior,proto3" json:"srv6_endpoint_behavior,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *Segment) Reset() {
+	*x = Segment{}
+	mi := &file_pkg_api_messages_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Segment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Segment) ProtoMessage() {}
+
+func (x *Segment) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Segment.ProtoReflect.Descriptor instead.
+func (*Segment) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *Segment) GetSegmentType() int32 {
+	if x != nil {
+		return x.SegmentType
+	}
+	return 0
+}
+
+func (x *Segment) GetFlags() *SegmentFlags {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+func (x *Segment) GetLabel() uint32 {
+	if x != nil {
+		return x.Label
+	}
+	return 0
+}
+
+func (x *Segment) GetTc() uint32 {
+	if x != nil {
+		return x.Tc
+	}
+	return 0
+}
+
+func (x *Segment) GetS() bool {
+	if x != nil {
+		return x.S
+	}
+	return false
+}
+
+func (x *Segment) GetTtl() uint32 {
+	if x != nil {
+		return x.Ttl
+	}
+	return 0
+}
+
+func (x *Segment) GetSrv6Sid() []byte {
+	if x != nil {
+		return x.Srv6Sid
+	}
+	return nil
+}
+
+func (x *Segment) GetSrAlgorithm() uint32 {
+	if x != nil {
+		return x.SrAlgorithm
+	}
+	return 0
+}
+
+func (x *Segment) GetIpv4Address() []byte {
+	if x != nil {
+		return x.Ipv4Address
+	}
+	return nil
+}
+
+func (x *Segment) GetIpv6Address() []byte {
+	if x != nil {
+		return x.Ipv6Address
+	}
+	return nil
+}
+
+func (x *Segment) GetSid() uint32 {
+	if x != nil && x.Sid != nil {
+		return *x.Sid
+	}
+	return 0
+}
+
+func (x *Segment) GetLocalInterfaceId() uint32 {
+	if x != nil {
+		return x.LocalInterfaceId
+	}
+	return 0
+}
+
+func (x *Segment) GetRemoteInterfaceId() uint32 {
+	if x != nil {
+		return x.RemoteInterfaceId
+	}
+	return 0
+}
+
+func (x *Segment) GetLocalIpv4Address() []byte {
+	if x != nil {
+		return x.LocalIpv4Address
+	}
+	return nil
+}
+
+func (x *Segment) GetRemoteIpv4Address() []byte {
+	if x != nil {
+		return x.RemoteIpv4Address
+	}
+	return nil
+}
+
+func (x *Segment) GetLocalIpv6Address() []byte {
+	if x != nil {
+		return x.LocalIpv6Address
+	}
+	return nil
+}
+
+func (x *Segment) GetRemoteIpv6Address() []byte {
+	if x != nil {
+		return x.RemoteIpv6Address
+	}
+	return nil
+}
+
+func (x *Segment) GetIpv6NodeAddress() []byte {
+	if x != nil {
+		return x.Ipv6NodeAddress
+	}
+	return nil
+}
+
+func (x *Segment) GetSrv6EndpointBehavior() []byte {
+	if x != nil {
+		return x.Srv6EndpointBehavior
+	}
+	return nil
+}
+
+// SegmentFlags mirrors srpolicy.SegmentFlags.
+type SegmentFlags struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VFlag         bool                   `protobuf:"varint,1,opt,name=v_flag,json=vFlag,proto3" json:"v_flag,omitempty"`
+	AFlag         bool                   `protobuf:"varint,2,opt,name=a_flag,json=aFlag,proto3" json:"a_flag,omitempty"`
+	SFlag         bool                   `protobuf:"varint,3,opt,name=s_flag,json=sFlag,proto3" json:"s_flag,omitempty"`
+	BFlag         bool                   `protobuf:"varint,4,opt,name=b_flag,json=bFlag,proto3" json:"b_flag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SegmentFlags) Reset() {
+	*x = SegmentFlags{}
+	mi := &file_pkg_api_messages_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SegmentFlags) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SegmentFlags) ProtoMessage() {}
+
+func (x *SegmentFlags) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SegmentFlags.ProtoReflect.Descriptor instead.
+func (*SegmentFlags) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *SegmentFlags) GetVFlag() bool {
+	if x != nil {
+		return x.VFlag
+	}
+	return false
+}
+
+func (x *SegmentFlags) GetAFlag() bool {
+	if x != nil {
+		return x.AFlag
+	}
+	return false
+}
+
+func (x *SegmentFlags) GetSFlag() bool {
+	if x != nil {
+		return x.SFlag
+	}
+	return false
+}
+
+func (x *SegmentFlags) GetBFlag() bool {
+	if x != nil {
+		return x.BFlag
+	}
+	return false
+}
+
+// FlowspecSpec carries the flowspec.PrefixSpec or flowspec.GenericSpec of the
+// flowspec.Spec of a component type.
+type FlowspecSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          uint32                 `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	PrefixLen     uint32                 `protobuf:"varint,2,opt,name=prefix_len,json=prefixLen,proto3" json:"prefix_len,omitempty"`
+	PrefixOffset  uint32                 `protobuf:"varint,3,opt,name=prefix_offset,json=prefixOffset,proto3" json:"prefix_offset,omitempty"`
+	Prefix        []byte                 `protobuf:"bytes,4,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	OpValPairs    []*FlowspecOpVal       `protobuf:"bytes,5,rep,name=op_val_pairs,json=opValPairs,proto3" json:"op_val_pairs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlowspecSpec) Reset() {
+	*x = FlowspecSpec{}
+	mi := &file_pkg_api_messages_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlowspecSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlowspecSpec) ProtoMessage() {}
+
+func (x *FlowspecSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlowspecSpec.ProtoReflect.Descriptor instead.
+func (*FlowspecSpec) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *FlowspecSpec) GetType() uint32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *FlowspecSpec) GetPrefixLen() uint32 {
+	if x != nil {
+		return x.PrefixLen
+	}
+	return 0
+}
+
+func (x *FlowspecSpec) GetPrefixOffset() uint32 {
+	if x != nil {
+		return x.PrefixOffset
+	}
+	return 0
+}
+
+func (x *FlowspecSpec) GetPrefix() []byte {
+	if x != nil {
+		return x.Prefix
+	}
+	return nil
+}
+
+func (x *FlowspecSpec) GetOpValPairs() []*FlowspecOpVal {
+	if x != nil {
+		return x.OpValPairs
+	}
+	return nil
+}
+
+// FlowspecOpVal mirrors flowspec.OpVal.
+type FlowspecOpVal struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Operator      *FlowspecOperator      `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	Value         []byte                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlowspecOpVal) Reset() {
+	*x = FlowspecOpVal{}
+	mi := &file_pkg_api_messages_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlowspecOpVal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlowspecOpVal) ProtoMessage() {}
+
+func (x *FlowspecOpVal) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlowspecOpVal.ProtoReflect.Descriptor instead.
+func (*FlowspecOpVal) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *FlowspecOpVal) GetOperator() *FlowspecOperator {
+	if x != nil {
+		return x.Operator
+	}
+	return nil
+}
+
+func (x *FlowspecOpVal) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+// FlowspecOperator mirrors flowspec.Operator.
+type FlowspecOperator struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EndOfListBit  bool                   `protobuf:"varint,1,opt,name=end_of_list_bit,json=endOfListBit,proto3" json:"end_of_list_bit,omitempty"`
+	AndBit        bool                   `protobuf:"varint,2,opt,name=and_bit,json=andBit,proto3" json:"and_bit,omitempty"`
+	ValueLength   uint32                 `protobuf:"varint,3,opt,name=value_length,json=valueLength,proto3" json:"value_length,omitempty"`
+	LessThan      bool                   `protobuf:"varint,4,opt,name=less_than,json=lessThan,proto3" json:"less_than,omitempty"`
+	GreaterThan   bool                   `protobuf:"varint,5,opt,name=greater_than,json=greaterThan,proto3" json:"greater_than,omitempty"`
+	Equal         bool                   `protobuf:"varint,6,opt,name=equal,proto3" json:"equal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlowspecOperator) Reset() {
+	*x = FlowspecOperator{}
+	mi := &file_pkg_api_messages_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlowspecOperator) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlowspecOperator) ProtoMessage() {}
+
+func (x *FlowspecOperator) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_messages_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlowspecOperator.ProtoReflect.Descriptor instead.
+func (*FlowspecOperator) Descriptor() ([]byte, []int) {
+	return file_pkg_api_messages_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *FlowspecOperator) GetEndOfListBit() bool {
+	if x != nil {
+		return x.EndOfListBit
+	}
+	return false
+}
+
+func (x *FlowspecOperator) GetAndBit() bool {
+	if x != nil {
+		return x.AndBit
+	}
+	return false
+}
+
+func (x *FlowspecOperator) GetValueLength() uint32 {
+	if x != nil {
+		return x.ValueLength
+	}
+	return 0
+}
+
+func (x *FlowspecOperator) GetLessThan() bool {
+	if x != nil {
+		return x.LessThan
+	}
+	return false
+}
+
+func (x *FlowspecOperator) GetGreaterThan() bool {
+	if x != nil {
+		return x.GreaterThan
+	}
+	return false
+}
+
+func (x *FlowspecOperator) GetEqual() bool {
+	if x != nil {
+		return x.Equal
+	}
+	return false
+}
+
+var File_pkg_api_messages_proto protoreflect.FileDescriptor
+
+const file_pkg_api_messages_proto_rawDesc = "" +
+	"\n" +
+	"\x16pkg/api/messages.proto\x12\tgobmp.api\x1a\x1cgoogle/protobuf/struct.proto\"\xca\f\n" +
+	"\x0fPeerStateChange\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12\x1a\n" +
+	"\bsequence\x18\x02 \x01(\x03R\bsequence\x12\x12\n" +
+	"\x04hash\x18\x03 \x01(\tR\x04hash\x12\x1f\n" +
+	"\vrouter_hash\x18\x04 \x01(\tR\n" +
+	"routerHash\x12\x12\n" +
+	"\x04name\x18\x05 \x01(\tR\x04name\x12\"\n" +
+	"\rremote_bgp_id\x18\x06 \x01(\tR\vremoteBgpId\x12\x1b\n" +
+	"\trouter_ip\x18\a \x01(\tR\brouterIp\x12\x1c\n" +
+	"\ttimestamp\x18\b \x01(\tR\ttimestamp\x12\x1d\n" +
+	"\n" +
+	"remote_asn\x18\t \x01(\rR\tremoteAsn\x12\x1b\n" +
+	"\tremote_ip\x18\n" +
+	" \x01(\tR\bremoteIp\x12\x1b\n" +
+	"\tpeer_type\x18\v \x01(\rR\bpeerType\x12\x17\n" +
+	"\apeer_rd\x18\f \x01(\tR\x06peerRd\x12\x1f\n" +
+	"\vremote_port\x18\r \x01(\x03R\n" +
+	"remotePort\x12\x1b\n" +
+	"\tlocal_asn\x18\x0e \x01(\rR\blocalAsn\x12\x19\n" +
+	"\blocal_ip\x18\x0f \x01(\tR\alocalIp\x12\x1d\n" +
+	"\n" +
+	"local_port\x18\x10 \x01(\x03R\tlocalPort\x12 \n" +
+	"\flocal_bgp_id\x18\x11 \x01(\tR\n" +
+	"localBgpId\x12\x1b\n" +
+	"\tinfo_data\x18\x12 \x01(\fR\binfoData\x12?\n" +
+	"\aadv_cap\x18\x13 \x03(\v2&.gobmp.api.PeerStateChange.AdvCapEntryR\x06advCap\x12B\n" +
+	"\brecv_cap\x18\x14 \x03(\v2'.gobmp.api.PeerStateChange.RecvCapEntryR\arecvCap\x12'\n" +
+	"\x0fremote_holddown\x18\x15 \x01(\x03R\x0eremoteHolddown\x12!\n" +
+	"\fadv_holddown\x18\x16 \x01(\x03R\vadvHolddown\x12\x1d\n" +
+	"\n" +
+	"bmp_reason\x18\x17 \x01(\x03R\tbmpReason\x12$\n" +
+	"\x0ebmp_error_code\x18\x18 \x01(\x03R\fbmpErrorCode\x12+\n" +
+	"\x12bmp_error_sub_code\x18\x19 \x01(\x03R\x0fbmpErrorSubCode\x12\x1d\n" +
+	"\n" +
+	"error_text\x18\x1a \x01(\tR\terrorText\x12\x11\n" +
+	"\x04is_l\x18\x1b \x01(\bR\x03isL\x12!\n" +
+	"\fis_prepolicy\x18\x1c \x01(\bR\visPrepolicy\x12\x17\n" +
+	"\ais_ipv4\x18\x1d \x01(\bR\x06isIpv4\x12\x1d\n" +
+	"\n" +
+	"table_name\x18\x1e \x01(\tR\ttableName\x127\n" +
+	"\x19is_adj_rib_in_post_policy\x18\x1f \x01(\bR\x14isAdjRibInPostPolicy\x129\n" +
+	"\x1ais_adj_rib_out_post_policy\x18  \x01(\bR\x15isAdjRibOutPostPolicy\x12#\n" +
+	"\x0eis_adj_rib_out\x18! \x01(\bR\visAdjRibOut\x12\x1c\n" +
+	"\n" +
+	"is_loc_rib\x18\" \x01(\bR\bisLocRib\x12-\n" +
+	"\x13is_loc_rib_filtered\x18# \x01(\bR\x10isLocRibFiltered\x12\x1b\n" +
+	"\tpeer_hash\x18$ \x01(\tR\bpeerHash\x12K\n" +
+	"\vuser_labels\x18% \x03(\v2*.gobmp.api.PeerStateChange.UserLabelsEntryR\n" +
+	"userLabels\x12$\n" +
+	"\x0eremote_as_name\x18& \x01(\tR\fremoteAsName\x1aU\n" +
+	"\vAdvCapEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\rR\x03key\x120\n" +
+	"\x05value\x18\x02 \x01(\v2\x1a.google.protobuf.ListValueR\x05value:\x028\x01\x1aV\n" +
+	"\fRecvCapEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\rR\x03key\x120\n" +
+	"\x05value\x18\x02 \x01(\v2\x1a.google.protobuf.ListValueR\x05value:\x028\x01\x1a=\n" +
+	"\x0fUserLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"f\n" +
+	"\x0eCapabilityData\x12)\n" +
+	"\x10capability_value\x18\x01 \x01(\fR\x0fcapabilityValue\x12)\n" +
+	"\x10capability_descr\x18\x02 \x01(\tR\x0fcapabilityDescr\"\xf2\n" +
+	"\n" +
+	"\rUnicastPrefix\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12\x1a\n" +
+	"\bsequence\x18\x02 \x01(\x03R\bsequence\x12\x12\n" +
+	"\x04hash\x18\x03 \x01(\tR\x04hash\x12\x1f\n" +
+	"\vrouter_hash\x18\x04 \x01(\tR\n" +
+	"routerHash\x12\x1b\n" +
+	"\trouter_ip\x18\x05 \x01(\tR\brouterIp\x128\n" +
+	"\n" +
+	"base_attrs\x18\x06 \x01(\v2\x19.gobmp.api.BaseAttributesR\tbaseAttrs\x12\x1b\n" +
+	"\tpeer_hash\x18\a \x01(\tR\bpeerHash\x12\x17\n" +
+	"\apeer_ip\x18\b \x01(\tR\x06peerIp\x12\x1b\n" +
+	"\tpeer_type\x18\t \x01(\rR\bpeerType\x12\x19\n" +
+	"\bpeer_asn\x18\n" +
+	" \x01(\rR\apeerAsn\x12\x1c\n" +
+	"\ttimestamp\x18\v \x01(\tR\ttimestamp\x12\x16\n" +
+	"\x06prefix\x18\f \x01(\tR\x06prefix\x12\x1d\n" +
+	"\n" +
+	"prefix_len\x18\r \x01(\x05R\tprefixLen\x12\x17\n" +
+	"\ais_ipv4\x18\x0e \x01(\bR\x06isIpv4\x12\x1b\n" +
+	"\torigin_as\x18\x0f \x01(\rR\boriginAs\x12\x18\n" +
+	"\anexthop\x18\x10 \x01(\tR\anexthop\x12&\n" +
+	"\x0fis_nexthop_ipv4\x18\x11 \x01(\bR\risNexthopIpv4\x12\x17\n" +
+	"\apath_id\x18\x12 \x01(\x05R\x06pathId\x12\x16\n" +
+	"\x06labels\x18\x13 \x03(\rR\x06labels\x12\x19\n" +
+	"\x05color\x18\x14 \x01(\rH\x00R\x05color\x88\x01\x01\x120\n" +
+	"\x11origin_validation\x18\x15 \x01(\tH\x01R\x10originValidation\x88\x01\x01\x12.\n" +
+	"\n" +
+	"prefix_sid\x18\x16 \x01(\v2\x0f.gobmp.api.PSidR\tprefixSid\x12\x15\n" +
+	"\x06is_eor\x18\x17 \x01(\bR\x05isEor\x12%\n" +
+	"\x0eleak_suspected\x18\x18 \x01(\bR\rleakSuspected\x12\x1f\n" +
+	"\vleak_reason\x18\x19 \x01(\tR\n" +
+	"leakReason\x127\n" +
+	"\x19is_adj_rib_in_post_policy\x18\x1a \x01(\bR\x14isAdjRibInPostPolicy\x129\n" +
+	"\x1ais_adj_rib_out_post_policy\x18\x1b \x01(\bR\x15isAdjRibOutPostPolicy\x12#\n" +
+	"\x0eis_adj_rib_out\x18\x1c \x01(\bR\visAdjRibOut\x12\x1c\n" +
+	"\n" +
+	"is_loc_rib\x18\x1d \x01(\bR\bisLocRib\x12-\n" +
+	"\x13is_loc_rib_filtered\x18\x1e \x01(\bR\x10isLocRibFiltered\x12\x1d\n" +
+	"\n" +
+	"table_name\x18\x1f \x01(\tR\ttableName\x12I\n" +
+	"\vuser_labels\x18  \x03(\v2(.gobmp.api.UnicastPrefix.UserLabelsEntryR\n" +
+	"userLabels\x12$\n" +
+	"\x0ebase_attr_hash\x18! \x01(\tR\fbaseAttrHash\x12$\n" +
+	"\x0eorigin_as_name\x18\" \x01(\tR\foriginAsName\x12\"\n" +
+	"\rorigin_as_org\x18# \x01(\tR\voriginAsOrg\x12 \n" +
+	"\fpeer_as_name\x18$ \x01(\tR\n" +
+	"peerAsName\x12\x18\n" +
+	"\acountry\x18% \x01(\tR\acountry\x1a=\n" +
+	"\x0fUserLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\b\n" +
+	"\x06_colorB\x14\n" +
+	"\x12_origin_validation\"\xc7\f\n" +
+	"\x0eBaseAttributes\x12$\n" +
+	"\x0ebase_attr_hash\x18\x01 \x01(\tR\fbaseAttrHash\x12\x16\n" +
+	"\x06origin\x18\x02 \x01(\tR\x06origin\x12\x17\n" +
+	"\aas_path\x18\x03 \x03(\rR\x06asPath\x12\"\n" +
+	"\ras_path_count\x18\x04 \x01(\x05R\vasPathCount\x12B\n" +
+	"\x10as_path_segments\x18\x05 \x03(\v2\x18.gobmp.api.ASPathSegmentR\x0easPathSegments\x12\x18\n" +
+	"\anexthop\x18\x06 \x01(\tR\anexthop\x12\x10\n" +
+	"\x03med\x18\a \x01(\rR\x03med\x12\x1d\n" +
+	"\n" +
+	"local_pref\x18\b \x01(\rR\tlocalPref\x12\"\n" +
+	"\ris_atomic_agg\x18\t \x01(\bR\visAtomicAgg\x12\x1e\n" +
+	"\n" +
+	"aggregator\x18\n" +
+	" \x01(\fR\n" +
+	"aggregator\x12%\n" +
+	"\x0ecommunity_list\x18\v \x03(\tR\rcommunityList\x129\n" +
+	"\x19well_known_community_list\x18\f \x03(\tR\x16wellKnownCommunityList\x12#\n" +
+	"\roriginator_id\x18\r \x01(\tR\foriginatorId\x12!\n" +
+	"\fcluster_list\x18\x0e \x01(\tR\vclusterList\x12,\n" +
+	"\x12ext_community_list\x18\x0f \x03(\tR\x10extCommunityList\x12F\n" +
+	"\x0fext_communities\x18\x10 \x03(\v2\x1d.gobmp.api.ExtCommunityDetailR\x0eextCommunities\x12\x19\n" +
+	"\bas4_path\x18\x11 \x03(\rR\aas4Path\x12$\n" +
+	"\x0eas4_path_count\x18\x12 \x01(\x05R\fas4PathCount\x12%\n" +
+	"\x0eas4_aggregator\x18\x13 \x01(\fR\ras4Aggregator\x126\n" +
+	"\vpmsi_tunnel\x18\x14 \x01(\v2\x15.gobmp.api.PMSITunnelR\n" +
+	"pmsiTunnel\x12*\n" +
+	"\x11tunnel_encap_attr\x18\x15 \x01(\fR\x0ftunnelEncapAttr\x12A\n" +
+	"\ftunnel_encap\x18\x16 \x01(\v2\x1e.gobmp.api.TunnelEncapsulationR\vtunnelEncap\x124\n" +
+	"\x16tunnel_encap_malformed\x18\x17 \x01(\bR\x14tunnelEncapMalformed\x125\n" +
+	"\x17ipv6_ext_community_list\x18\x18 \x03(\tR\x14ipv6ExtCommunityList\x12O\n" +
+	"\x14ipv6_ext_communities\x18\x19 \x03(\v2\x1d.gobmp.api.ExtCommunityDetailR\x12ipv6ExtCommunities\x12#\n" +
+	"\x04aigp\x18\x1a \x01(\v2\x0f.gobmp.api.AIGPR\x04aigp\x120\n" +
+	"\x14large_community_list\x18\x1b \x03(\tR\x12largeCommunityList\x12=\n" +
+	"\x0ebgp_prefix_sid\x18\x1c \x01(\v2\x17.gobmp.api.BGPPrefixSIDR\fbgpPrefixSid\x12\x10\n" +
+	"\x03otc\x18\x1d \x01(\rR\x03otc\x12'\n" +
+	"\x06d_path\x18\x1e \x01(\v2\x10.gobmp.api.DPathR\x05dPath\x12 \n" +
+	"\x03sfp\x18\x1f \x01(\v2\x0e.gobmp.api.SFPR\x03sfp\x12H\n" +
+	"\x11bfd_discriminator\x18  \x01(\v2\x1b.gobmp.api.BFDDiscriminatorR\x10bfdDiscriminator\x12-\n" +
+	"\battr_set\x18! \x01(\v2\x12.gobmp.api.AttrSetR\aattrSet\x12N\n" +
+	"\x12unknown_attributes\x18\" \x03(\v2\x1f.gobmp.api.UnknownPathAttributeR\x11unknownAttributes\x12>\n" +
+	"\vattr_errors\x18# \x03(\v2\x1d.gobmp.api.PathAttributeErrorR\n" +
+	"attrErrors\"7\n" +
+	"\rASPathSegment\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\rR\x04type\x12\x12\n" +
+	"\x04asns\x18\x02 \x03(\rR\x04asns\"\xc1\x01\n" +
+	"\x12ExtCommunityDetail\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\rR\x04type\x12\x1d\n" +
+	"\asubtype\x18\x02 \x01(\rH\x00R\asubtype\x88\x01\x01\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x14\n" +
+	"\x05value\x18\x04 \x01(\tR\x05value\x120\n" +
+	"\adecoded\x18\x05 \x01(\v2\x16.google.protobuf.ValueR\adecoded\x12\x10\n" +
+	"\x03raw\x18\x06 \x01(\tR\x03rawB\n" +
+	"\n" +
+	"\b_subtype\"\xa3\x01\n" +
+	"\n" +
+	"PMSITunnel\x12\x14\n" +
+	"\x05flags\x18\x01 \x01(\rR\x05flags\x12\x1f\n" +
+	"\vtunnel_type\x18\x02 \x01(\rR\n" +
+	"tunnelType\x12\"\n" +
+	"\n" +
+	"mpls_label\x18\x03 \x01(\rH\x00R\tmplsLabel\x88\x01\x01\x12+\n" +
+	"\x11tunnel_identifier\x18\x04 \x01(\fR\x10tunnelIdentifierB\r\n" +
+	"\v_mpls_label\"B\n" +
+	"\x13TunnelEncapsulation\x12+\n" +
+	"\atunnels\x18\x01 \x03(\v2\x11.gobmp.api.TunnelR\atunnels\"\x85\x01\n" +
+	"\x06Tunnel\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\rR\x04type\x12\x1b\n" +
+	"\ttype_name\x18\x02 \x01(\tR\btypeName\x12\x16\n" +
+	"\x06length\x18\x03 \x01(\rR\x06length\x122\n" +
+	"\bsub_tlvs\x18\x04 \x03(\v2\x17.gobmp.api.TunnelSubTLVR\asubTlvs\"\x85\x01\n" +
+	"\fTunnelSubTLV\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\rR\x04type\x12\x1b\n" +
+	"\ttype_name\x18\x02 \x01(\tR\btypeName\x12\x16\n" +
+	"\x06length\x18\x03 \x01(\rR\x06length\x12,\n" +
+	"\x05value\x18\x04 \x01(\v2\x16.google.protobuf.ValueR\x05value\".\n" +
+	"\x04AIGP\x12&\n" +
+	"\x04tlvs\x18\x01 \x03(\v2\x12.gobmp.api.AIGPTLVR\x04tlvs\"K\n" +
+	"\aAIGPTLV\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\rR\x04type\x12\x16\n" +
+	"\x06length\x18\x02 \x01(\rR\x06length\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\x04R\x05value\">\n" +
+	"\fBGPPrefixSID\x12.\n" +
+	"\x04tlvs\x18\x01 \x03(\v2\x1a.gobmp.api.BGPPrefixSIDTLVR\x04tlvs\"\xea\x01\n" +
+	"\x0fBGPPrefixSIDTLV\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\rR\x04type\x12\x16\n" +
+	"\x06length\x18\x02 \x01(\rR\x06length\x12<\n" +
+	"\vlabel_index\x18\x03 \x01(\v2\x1b.gobmp.api.BGPLabelIndexTLVR\n" +
+	"labelIndex\x12H\n" +
+	"\x0foriginator_srgb\x18\x04 \x01(\v2\x1f.gobmp.api.BGPOriginatorSRGBTLVR\x0eoriginatorSrgb\x12#\n" +
+	"\runknown_value\x18\x05 \x01(\fR\funknownValue\"I\n" +
+	"\x10BGPLabelIndexTLV\x12\x14\n" +
+	"\x05flags\x18\x01 \x01(\rR\x05flags\x12\x1f\n" +
+	"\vlabel_index\x18\x02 \x01(\rR\n" +
+	"labelIndex\"Z\n" +
+	"\x14BGPOriginatorSRGBTLV\x12\x14\n" +
+	"\x05flags\x18\x01 \x01(\rR\x05flags\x12,\n" +
+	"\x06ranges\x18\x02 \x03(\v2\x14.gobmp.api.SRGBRangeR\x06ranges\"5\n" +
+	"\tSRGBRange\x12\x12\n" +
+	"\x04base\x18\x01 \x01(\rR\x04base\x12\x14\n" +
+	"\x05range\x18\x02 \x01(\rR\x05range\"<\n" +
+	"\x05DPath\x123\n" +
+	"\bsegments\x18\x01 \x03(\v2\x17.gobmp.api.DPathSegmentR\bsegments\"T\n" +
+	"\fDPathSegment\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\rR\x04type\x120\n" +
+	"\adomains\x18\x02 \x03(\v2\x16.gobmp.api.DPathDomainR\adomains\"u\n" +
+	"\vDPathDomain\x12!\n" +
+	"\fglobal_admin\x18\x01 \x01(\rR\vglobalAdmin\x12\x1f\n" +
+	"\vlocal_admin\x18\x02 \x01(\rR\n" +
+	"localAdmin\x12\"\n" +
+	"\risf_safi_type\x18\x03 \x01(\rR\visfSafiType\"\x95\x01\n" +
+	"\x03SFP\x12=\n" +
+	"\fassociations\x18\x01 \x03(\v2\x19.gobmp.api.SFPAssociationR\fassociations\x12(\n" +
+	"\x05paths\x18\x02 \x03(\v2\x12.gobmp.api.SFPPathR\x05paths\x12%\n" +
+	"\x04tlvs\x18\x03 \x03(\v2\x11.gobmp.api.SFPTLVR\x04tlvs\"\x90\x01\n" +
+	"\x0eSFPAssociation\x12)\n" +
+	"\x10association_type\x18\x01 \x01(\rR\x0fassociationType\x12,\n" +
+	"\x12associated_sfpr_rd\x18\x02 \x01(\tR\x10associatedSfprRd\x12%\n" +
+	"\x0eassociated_spi\x18\x03 \x01(\rR\rassociatedSpi\"p\n" +
+	"\aSFPPath\x12\x10\n" +
+	"\x03spi\x18\x01 \x01(\rR\x03spi\x12%\n" +
+	"\x04hops\x18\x02 \x03(\v2\x11.gobmp.api.SFPHopR\x04hops\x12,\n" +
+	"\bsub_tlvs\x18\x03 \x03(\v2\x11.gobmp.api.SFPTLVR\asubTlvs\"T\n" +
+	"\x06SFPHop\x12#\n" +
+	"\rservice_index\x18\x01 \x01(\rR\fserviceIndex\x12%\n" +
+	"\x04sfts\x18\x02 \x03(\v2\x11.gobmp.api.SFPSFTR\x04sfts\"5\n" +
+	"\x06SFPSFT\x12\x10\n" +
+	"\x03sft\x18\x01 \x01(\rR\x03sft\x12\x19\n" +
+	"\bsfir_rds\x18\x02 \x03(\tR\asfirRds\"2\n" +
+	"\x06SFPTLV\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\rR\x04type\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\fR\x05value\"\x91\x01\n" +
+	"\x10BFDDiscriminator\x12\x12\n" +
+	"\x04mode\x18\x01 \x01(\rR\x04mode\x12$\n" +
+	"\rdiscriminator\x18\x02 \x01(\rR\rdiscriminator\x12\x1b\n" +
+	"\tsource_ip\x18\x03 \x01(\tR\bsourceIp\x12&\n" +
+	"\x04tlvs\x18\x04 \x03(\v2\x12.gobmp.api.BFDDTLVR\x04tlvs\"3\n" +
+	"\aBFDDTLV\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\rR\x04type\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\fR\x05value\"j\n" +
+	"\aAttrSet\x12\x1b\n" +
+	"\torigin_as\x18\x01 \x01(\rR\boriginAs\x12B\n" +
+	"\x0fpath_attributes\x18\x02 \x01(\v2\x19.gobmp.api.BaseAttributesR\x0epathAttributes\"V\n" +
+	"\x14UnknownPathAttribute\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\rR\x04type\x12\x14\n" +
+	"\x05flags\x18\x02 \x01(\rR\x05flags\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\fR\x05value\">\n" +
+	"\x12PathAttributeError\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\rR\x04type\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"\x96\x02\n" +
+	"\x04PSid\x12B\n" +
+	"\vlabel_index\x18\x01 \x01(\v2!.gobmp.api.PrefixSIDLabelIndexTLVR\n" +
+	"labelIndex\x12N\n" +
+	"\x0foriginator_srgb\x18\x02 \x01(\v2%.gobmp.api.PrefixSIDOriginatorSRGBTLVR\x0eoriginatorSrgb\x12<\n" +
+	"\x0fsrv6_l3_service\x18\x03 \x01(\v2\x14.gobmp.api.L3ServiceR\rsrv6L3Service\x12<\n" +
+	"\x0fsrv6_l2_service\x18\x04 \x01(\v2\x14.gobmp.api.L2ServiceR\rsrv6L2Service\"M\n" +
+	"\x16PrefixSIDLabelIndexTLV\x12\x14\n" +
+	"\x05flags\x18\x01 \x01(\rR\x05flags\x12\x1d\n" +
+	"\n" +
+	"last_index\x18\x02 \x01(\rR\tlastIndex\"W\n" +
+	"\x1aPrefixSIDOriginatorSRGBTLV\x12\x14\n" +
+	"\x05flags\x18\x01 \x01(\rR\x05flags\x12#\n" +
+	"\x04srgb\x18\x02 \x03(\v2\x0f.gobmp.api.SRGBR\x04srgb\"4\n" +
+	"\x04SRGB\x12\x14\n" +
+	"\x05first\x18\x01 \x01(\rR\x05first\x12\x16\n" +
+	"\x06number\x18\x02 \x01(\rR\x06number\"\xa1\x01\n" +
+	"\tL3Service\x12<\n" +
+	"\bsub_tlvs\x18\x01 \x03(\v2!.gobmp.api.L3Service.SubTlvsEntryR\asubTlvs\x1aV\n" +
+	"\fSubTlvsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\rR\x03key\x120\n" +
+	"\x05value\x18\x02 \x01(\v2\x1a.google.protobuf.ListValueR\x05value:\x028\x01\"\v\n" +
+	"\tL2Service\"\xe6\v\n" +
+	"\x06LSNode\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12\x1a\n" +
+	"\bsequence\x18\x02 \x01(\x03R\bsequence\x12\x12\n" +
+	"\x04hash\x18\x03 \x01(\tR\x04hash\x12\x1f\n" +
+	"\vrouter_hash\x18\x04 \x01(\tR\n" +
+	"routerHash\x12\x1b\n" +
+	"\tdomain_id\x18\x05 \x01(\x03R\bdomainId\x12\x1b\n" +
+	"\trouter_ip\x18\x06 \x01(\tR\brouterIp\x12\x1b\n" +
+	"\tpeer_hash\x18\a \x01(\tR\bpeerHash\x12\x17\n" +
+	"\apeer_ip\x18\b \x01(\tR\x06peerIp\x12\x1b\n" +
+	"\tpeer_type\x18\t \x01(\rR\bpeerType\x12\x19\n" +
+	"\bpeer_asn\x18\n" +
+	" \x01(\rR\apeerAsn\x12\x1c\n" +
+	"\ttimestamp\x18\v \x01(\tR\ttimestamp\x12\"\n" +
+	"\rigp_router_id\x18\f \x01(\tR\vigpRouterId\x12\x1b\n" +
+	"\trouter_id\x18\r \x01(\tR\brouterId\x12\x10\n" +
+	"\x03asn\x18\x0e \x01(\rR\x03asn\x12\x13\n" +
+	"\x05ls_id\x18\x0f \x01(\rR\x04lsId\x12>\n" +
+	"\tmt_id_tlv\x18\x10 \x03(\v2\".gobmp.api.MultiTopologyIdentifierR\amtIdTlv\x12\x17\n" +
+	"\aarea_id\x18\x11 \x01(\tR\x06areaId\x12\x1a\n" +
+	"\bprotocol\x18\x12 \x01(\tR\bprotocol\x12\x1f\n" +
+	"\vprotocol_id\x18\x13 \x01(\rR\n" +
+	"protocolId\x127\n" +
+	"\n" +
+	"node_flags\x18\x14 \x01(\v2\x18.gobmp.api.NodeAttrFlagsR\tnodeFlags\x12\x12\n" +
+	"\x04name\x18\x15 \x01(\tR\x04name\x12E\n" +
+	"\x12ls_sr_capabilities\x18\x16 \x01(\v2\x17.gobmp.api.SRCapabilityR\x10lsSrCapabilities\x12!\n" +
+	"\fsr_algorithm\x18\x17 \x03(\x03R\vsrAlgorithm\x12;\n" +
+	"\x0esr_local_block\x18\x18 \x01(\v2\x15.gobmp.api.LocalBlockR\fsrLocalBlock\x12P\n" +
+	"\x15srv6_capabilities_tlv\x18\x19 \x01(\v2\x1c.gobmp.api.SRv6CapabilityTLVR\x13srv6CapabilitiesTlv\x12+\n" +
+	"\bnode_msd\x18\x1a \x03(\v2\x10.gobmp.api.MSDTVR\anodeMsd\x12O\n" +
+	"\x14flex_algo_definition\x18\x1b \x03(\v2\x1d.gobmp.api.FlexAlgoDefinitionR\x12flexAlgoDefinition\x122\n" +
+	"\x15opaque_node_attribute\x18\x1c \x03(\tR\x13opaqueNodeAttribute\x127\n" +
+	"\x19is_adj_rib_in_post_policy\x18\x1d \x01(\bR\x14isAdjRibInPostPolicy\x129\n" +
+	"\x1ais_adj_rib_out_post_policy\x18\x1e \x01(\bR\x15isAdjRibOutPostPolicy\x12#\n" +
+	"\x0eis_adj_rib_out\x18\x1f \x01(\bR\visAdjRibOut\x12\x1c\n" +
+	"\n" +
+	"is_loc_rib\x18  \x01(\bR\bisLocRib\x12-\n" +
+	"\x13is_loc_rib_filtered\x18! \x01(\bR\x10isLocRibFiltered\x12\x1d\n" +
+	"\n" +
+	"table_name\x18\" \x01(\tR\ttableName\x12/\n" +
+	"\x13route_distinguisher\x18# \x01(\tR\x12routeDistinguisher\x12B\n" +
+	"\vuser_labels\x18$ \x03(\v2!.gobmp.api.LSNode.UserLabelsEntryR\n" +
+	"userLabels\x1a=\n" +
+	"\x0fUserLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\\\n" +
+	"\x17MultiTopologyIdentifier\x12\x15\n" +
+	"\x06o_flag\x18\x01 \x01(\bR\x05oFlag\x12\x15\n" +
+	"\x06a_flag\x18\x02 \x01(\bR\x05aFlag\x12\x13\n" +
+	"\x05mt_id\x18\x03 \x01(\rR\x04mtId\"\x99\x01\n" +
+	"\rNodeAttrFlags\x12\x15\n" +
+	"\x06o_flag\x18\x01 \x01(\bR\x05oFlag\x12\x15\n" +
+	"\x06t_flag\x18\x02 \x01(\bR\x05tFlag\x12\x15\n" +
+	"\x06e_flag\x18\x03 \x01(\bR\x05eFlag\x12\x15\n" +
+	"\x06b_flag\x18\x04 \x01(\bR\x05bFlag\x12\x15\n" +
+	"\x06r_flag\x18\x05 \x01(\bR\x05rFlag\x12\x15\n" +
+	"\x06v_flag\x18\x06 \x01(\bR\x05vFlag\"\x8f\x01\n" +
+	"\fSRCapability\x12.\n" +
+	"\x05flags\x18\x01 \x01(\v2\x18.gobmp.api.ProtocolFlagsR\x05flags\x12O\n" +
+	"\x14sr_capability_subtlv\x18\x02 \x03(\v2\x1d.gobmp.api.SRCapabilitySubTLVR\x12srCapabilitySubtlv\"<\n" +
+	"\x12SRCapabilitySubTLV\x12\x14\n" +
+	"\x05range\x18\x01 \x01(\rR\x05range\x12\x10\n" +
+	"\x03sid\x18\x02 \x01(\rR\x03sid\"Z\n" +
+	"\n" +
+	"LocalBlock\x12\x14\n" +
+	"\x05flags\x18\x01 \x01(\rR\x05flags\x126\n" +
+	"\tsubranges\x18\x02 \x03(\v2\x18.gobmp.api.LocalBlockTLVR\tsubranges\"x\n" +
+	"\rLocalBlockTLV\x12\x1d\n" +
+	"\n" +
+	"range_size\x18\x01 \x01(\rR\trangeSize\x12\x19\n" +
+	"\x05label\x18\x02 \x01(\rH\x00R\x05label\x88\x01\x01\x12\x19\n" +
+	"\x05index\x18\x03 \x01(\rH\x01R\x05index\x88\x01\x01B\b\n" +
+	"\x06_labelB\b\n" +
+	"\x06_index\"*\n" +
+	"\x11SRv6CapabilityTLV\x12\x15\n" +
+	"\x06o_flag\x18\x01 \x01(\bR\x05oFlag\"?\n" +
+	"\x05MSDTV\x12\x19\n" +
+	"\bmsd_type\x18\x01 \x01(\rR\amsdType\x12\x1b\n" +
+	"\tmsd_value\x18\x02 \x01(\rR\bmsdValue\"\xc8\x01\n" +
+	"\x12FlexAlgoDefinition\x12\x1b\n" +
+	"\tflex_algo\x18\x01 \x01(\rR\bflexAlgo\x12\x1f\n" +
+	"\vmetric_type\x18\x02 \x01(\rR\n" +
+	"metricType\x12)\n" +
+	"\x10calculation_type\x18\x03 \x01(\rR\x0fcalculationType\x12\x1a\n" +
+	"\bpriority\x18\x04 \x01(\rR\bpriority\x12-\n" +
+	"\asub_tlv\x18\x05 \x01(\v2\x14.gobmp.api.FADSubTLVR\x06subTlv\"\xc2\x01\n" +
+	"\tFADSubTLV\x12\x1f\n" +
+	"\vexclude_any\x18\x01 \x03(\rR\n" +
+	"excludeAny\x12\x1f\n" +
+	"\vinclude_any\x18\x02 \x03(\rR\n" +
+	"includeAny\x12\x1f\n" +
+	"\vinclude_all\x18\x03 \x03(\rR\n" +
+	"includeAll\x12/\n" +
+	"\x05flags\x18\x04 \x01(\v2\x19.gobmp.api.FADSubTLVFlagsR\x05flags\x12!\n" +
+	"\fexclude_srlg\x18\x05 \x03(\rR\vexcludeSrlg\"'\n" +
+	"\x0eFADSubTLVFlags\x12\x15\n" +
+	"\x06m_flag\x18\x01 \x01(\bR\x05mFlag\"\xa1\x17\n" +
+	"\x06LSLink\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12\x1a\n" +
+	"\bsequence\x18\x02 \x01(\x03R\bsequence\x12\x12\n" +
+	"\x04hash\x18\x03 \x01(\tR\x04hash\x12\x1f\n" +
+	"\vrouter_hash\x18\x04 \x01(\tR\n" +
+	"routerHash\x12\x1b\n" +
+	"\trouter_ip\x18\x05 \x01(\tR\brouterIp\x12\x1b\n" +
+	"\tdomain_id\x18\x06 \x01(\x03R\bdomainId\x12\x1b\n" +
+	"\tpeer_hash\x18\a \x01(\tR\bpeerHash\x12\x17\n" +
+	"\apeer_ip\x18\b \x01(\tR\x06peerIp\x12\x1b\n" +
+	"\tpeer_type\x18\t \x01(\rR\bpeerType\x12\x19\n" +
+	"\bpeer_asn\x18\n" +
+	" \x01(\rR\apeerAsn\x12\x1c\n" +
+	"\ttimestamp\x18\v \x01(\tR\ttimestamp\x12\"\n" +
+	"\rigp_router_id\x18\f \x01(\tR\vigpRouterId\x12\x1b\n" +
+	"\trouter_id\x18\r \x01(\tR\brouterId\x12\x13\n" +
+	"\x05ls_id\x18\x0e \x01(\rR\x04lsId\x12\x1a\n" +
+	"\bprotocol\x18\x0f \x01(\tR\bprotocol\x12\x1f\n" +
+	"\vprotocol_id\x18\x10 \x01(\rR\n" +
+	"protocolId\x12\x17\n" +
+	"\aarea_id\x18\x11 \x01(\tR\x06areaId\x12\x18\n" +
+	"\anexthop\x18\x12 \x01(\tR\anexthop\x12>\n" +
+	"\tmt_id_tlv\x18\x13 \x01(\v2\".gobmp.api.MultiTopologyIdentifierR\amtIdTlv\x12\"\n" +
+	"\rlocal_link_id\x18\x14 \x01(\rR\vlocalLinkId\x12$\n" +
+	"\x0eremote_link_id\x18\x15 \x01(\rR\fremoteLinkId\x12\"\n" +
+	"\rlocal_link_ip\x18\x16 \x01(\tR\vlocalLinkIp\x12$\n" +
+	"\x0eremote_link_ip\x18\x17 \x01(\tR\fremoteLinkIp\x12\x1d\n" +
+	"\n" +
+	"igp_metric\x18\x18 \x01(\rR\tigpMetric\x12\x1f\n" +
+	"\vadmin_group\x18\x19 \x01(\rR\n" +
+	"adminGroup\x12\x1e\n" +
+	"\vmax_link_bw\x18\x1a \x01(\rR\tmaxLinkBw\x12\x1e\n" +
+	"\vmax_resv_bw\x18\x1b \x01(\rR\tmaxResvBw\x12\x1b\n" +
+	"\tunresv_bw\x18\x1c \x03(\rR\bunresvBw\x12'\n" +
+	"\x10max_link_bw_kbps\x18\x1d \x01(\x04R\rmaxLinkBwKbps\x12'\n" +
+	"\x10max_resv_bw_kbps\x18\x1e \x01(\x04R\rmaxResvBwKbps\x12$\n" +
+	"\x0eunresv_bw_kbps\x18\x1f \x03(\x04R\funresvBwKbps\x12*\n" +
+	"\x11te_default_metric\x18  \x01(\rR\x0fteDefaultMetric\x12'\n" +
+	"\x0flink_protection\x18! \x01(\rR\x0elinkProtection\x12&\n" +
+	"\x0fmpls_proto_mask\x18\" \x01(\rR\rmplsProtoMask\x12\x12\n" +
+	"\x04srlg\x18# \x03(\rR\x04srlg\x12\x1b\n" +
+	"\tlink_name\x18$ \x01(\tR\blinkName\x12(\n" +
+	"\x10remote_node_hash\x18% \x01(\tR\x0eremoteNodeHash\x12&\n" +
+	"\x0flocal_node_hash\x18& \x01(\tR\rlocalNodeHash\x12/\n" +
+	"\x14remote_igp_router_id\x18' \x01(\tR\x11remoteIgpRouterId\x12(\n" +
+	"\x10remote_router_id\x18( \x01(\tR\x0eremoteRouterId\x12$\n" +
+	"\x0elocal_node_asn\x18) \x01(\rR\flocalNodeAsn\x12&\n" +
+	"\x0fremote_node_asn\x18* \x01(\rR\rremoteNodeAsn\x12\"\n" +
+	"\rbgp_router_id\x18+ \x01(\tR\vbgpRouterId\x12/\n" +
+	"\x14bgp_remote_router_id\x18, \x01(\tR\x11bgpRemoteRouterId\x12\x1b\n" +
+	"\tmember_as\x18- \x01(\rR\bmemberAs\x126\n" +
+	"\rpeer_node_sid\x18. \x01(\v2\x12.gobmp.api.PeerSIDR\vpeerNodeSid\x124\n" +
+	"\fpeer_adj_sid\x18/ \x01(\v2\x12.gobmp.api.PeerSIDR\n" +
+	"peerAdjSid\x124\n" +
+	"\fpeer_set_sid\x180 \x01(\v2\x12.gobmp.api.PeerSIDR\n" +
+	"peerSetSid\x12M\n" +
+	"\x16srv6_bgp_peer_node_sid\x181 \x01(\v2\x19.gobmp.api.BGPPeerNodeSIDR\x12srv6BgpPeerNodeSid\x129\n" +
+	"\rsrv6_endx_sid\x182 \x03(\v2\x15.gobmp.api.EndXSIDTLVR\vsrv6EndxSid\x12D\n" +
+	"\x10ls_adjacency_sid\x183 \x03(\v2\x1a.gobmp.api.AdjacencySIDTLVR\x0elsAdjacencySid\x12+\n" +
+	"\blink_msd\x184 \x03(\v2\x10.gobmp.api.MSDTVR\alinkMsd\x12G\n" +
+	"\x12app_spec_link_attr\x185 \x03(\v2\x1a.gobmp.api.AppSpecLinkAttrR\x0fappSpecLinkAttr\x12*\n" +
+	"\x11unidir_link_delay\x186 \x01(\rR\x0funidirLinkDelay\x128\n" +
+	"\x19unidir_link_delay_min_max\x187 \x03(\rR\x15unidirLinkDelayMinMax\x124\n" +
+	"\x16unidir_delay_variation\x188 \x01(\rR\x14unidirDelayVariation\x12,\n" +
+	"\x12unidir_packet_loss\x189 \x01(\rR\x10unidirPacketLoss\x12,\n" +
+	"\x12unidir_residual_bw\x18: \x01(\rR\x10unidirResidualBw\x12.\n" +
+	"\x13unidir_available_bw\x18; \x01(\rR\x11unidirAvailableBw\x122\n" +
+	"\x15unidir_bw_utilization\x18< \x01(\rR\x13unidirBwUtilization\x122\n" +
+	"\x15opaque_link_attribute\x18= \x03(\tR\x13opaqueLinkAttribute\x12&\n" +
+	"\x0fext_admin_group\x18> \x03(\rR\rextAdminGroup\x12C\n" +
+	"\x10l2_bundle_member\x18? \x03(\v2\x19.gobmp.api.L2BundleMemberR\x0el2BundleMember\x127\n" +
+	"\x19is_adj_rib_in_post_policy\x18@ \x01(\bR\x14isAdjRibInPostPolicy\x129\n" +
+	"\x1ais_adj_rib_out_post_policy\x18A \x01(\bR\x15isAdjRibOutPostPolicy\x12#\n" +
+	"\x0eis_adj_rib_out\x18B \x01(\bR\visAdjRibOut\x12\x1c\n" +
+	"\n" +
+	"is_loc_rib\x18C \x01(\bR\bisLocRib\x12-\n" +
+	"\x13is_loc_rib_filtered\x18D \x01(\bR\x10isLocRibFiltered\x12\x1d\n" +
+	"\n" +
+	"table_name\x18E \x01(\tR\ttableName\x12/\n" +
+	"\x13route_distinguisher\x18F \x01(\tR\x12routeDistinguisher\x12B\n" +
+	"\vuser_labels\x18G \x03(\v2!.gobmp.api.LSLink.UserLabelsEntryR\n" +
+	"userLabels\x1a=\n" +
+	"\x0fUserLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"_\n" +
+	"\aPeerSID\x12*\n" +
+	"\x05flags\x18\x01 \x01(\v2\x14.gobmp.api.PeerFlagsR\x05flags\x12\x16\n" +
+	"\x06weight\x18\x02 \x01(\rR\x06weight\x12\x10\n" +
+	"\x03sid\x18\x03 \x01(\rR\x03sid\"g\n" +
+	"\tPeerFlags\x12\x15\n" +
+	"\x06v_flag\x18\x01 \x01(\bR\x05vFlag\x12\x15\n" +
+	"\x06l_flag\x18\x02 \x01(\bR\x05lFlag\x12\x15\n" +
+	"\x06b_flag\x18\x03 \x01(\bR\x05bFlag\x12\x15\n" +
+	"\x06p_flag\x18\x04 \x01(\bR\x05pFlag\"\x8f\x01\n" +
+	"\x0eBGPPeerNodeSID\x121\n" +
+	"\x05flags\x18\x01 \x01(\v2\x1b.gobmp.api.BGPPeerNodeFlagsR\x05flags\x12\x16\n" +
+	"\x06weight\x18\x02 \x01(\rR\x06weight\x12\x19\n" +
+	"\bpeer_asn\x18\x03 \x01(\rR\apeerAsn\x12\x17\n" +
+	"\apeer_id\x18\x04 \x01(\fR\x06peerId\"W\n" +
+	"\x10BGPPeerNodeFlags\x12\x15\n" +
+	"\x06b_flag\x18\x01 \x01(\bR\x05bFlag\x12\x15\n" +
+	"\x06s_flag\x18\x02 \x01(\bR\x05sFlag\x12\x15\n" +
+	"\x06p_flag\x18\x03 \x01(\bR\x05pFlag\"\x8e\x02\n" +
+	"\n" +
+	"EndXSIDTLV\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\rR\x04type\x12\x16\n" +
+	"\x06length\x18\x02 \x01(\rR\x06length\x12+\n" +
+	"\x11endpoint_behavior\x18\x03 \x01(\rR\x10endpointBehavior\x12-\n" +
+	"\x05flags\x18\x04 \x01(\v2\x17.gobmp.api.EndXSIDFlagsR\x05flags\x12\x1c\n" +
+	"\talgorithm\x18\x05 \x01(\rR\talgorithm\x12\x16\n" +
+	"\x06weight\x18\x06 \x01(\rR\x06weight\x12\x10\n" +
+	"\x03sid\x18\a \x01(\tR\x03sid\x120\n" +
+	"\bsub_tlvs\x18\b \x03(\v2\x15.gobmp.api.SRv6SubTLVR\asubTlvs\"S\n" +
+	"\fEndXSIDFlags\x12\x15\n" +
+	"\x06b_flag\x18\x01 \x01(\bR\x05bFlag\x12\x15\n" +
+	"\x06s_flag\x18\x02 \x01(\bR\x05sFlag\x12\x15\n" +
+	"\x06p_flag\x18\x03 \x01(\bR\x05pFlag\"k\n" +
+	"\x0fAdjacencySIDTLV\x12.\n" +
+	"\x05flags\x18\x01 \x01(\v2\x18.gobmp.api.ProtocolFlagsR\x05flags\x12\x16\n" +
+	"\x06weight\x18\x02 \x01(\rR\x06weight\x12\x10\n" +
+	"\x03sid\x18\x03 \x01(\rR\x03sid\"\xe1\x01\n" +
+	"\x0fAppSpecLinkAttr\x12!\n" +
+	"\fsaibm_length\x18\x01 \x01(\rR\vsaibmLength\x12#\n" +
+	"\rudaibm_length\x18\x02 \x01(\rR\fudaibmLength\x12,\n" +
+	"\x13std_app_id_bit_mask\x18\x03 \x01(\fR\x0fstdAppIdBitMask\x12*\n" +
+	"\x12ud_app_id_bit_mask\x18\x04 \x01(\fR\x0eudAppIdBitMask\x12,\n" +
+	"\bsub_tlvs\x18\x05 \x03(\v2\x11.gobmp.api.SubTLVR\asubTlvs\"N\n" +
+	"\x06SubTLV\x12 \n" +
+	"\fsub_tlv_type\x18\x01 \x01(\rR\n" +
+	"subTlvType\x12\"\n" +
+	"\rsub_tlv_value\x18\x02 \x01(\fR\vsubTlvValue\"\xc9\x05\n" +
+	"\x0eL2BundleMember\x12+\n" +
+	"\x11member_descriptor\x18\x01 \x01(\rR\x10memberDescriptor\x12\x1f\n" +
+	"\vadmin_group\x18\x02 \x01(\rR\n" +
+	"adminGroup\x12&\n" +
+	"\x0fext_admin_group\x18\x03 \x03(\rR\rextAdminGroup\x12'\n" +
+	"\x10max_link_bw_kbps\x18\x04 \x01(\x04R\rmaxLinkBwKbps\x12'\n" +
+	"\x10max_resv_bw_kbps\x18\x05 \x01(\x04R\rmaxResvBwKbps\x12$\n" +
+	"\x0eunresv_bw_kbps\x18\x06 \x03(\x04R\funresvBwKbps\x12'\n" +
+	"\x0flink_protection\x18\a \x01(\rR\x0elinkProtection\x12D\n" +
+	"\x10ls_adjacency_sid\x18\b \x03(\v2\x1a.gobmp.api.AdjacencySIDTLVR\x0elsAdjacencySid\x12*\n" +
+	"\x11unidir_link_delay\x18\t \x01(\rR\x0funidirLinkDelay\x128\n" +
+	"\x19unidir_link_delay_min_max\x18\n" +
+	" \x03(\rR\x15unidirLinkDelayMinMax\x124\n" +
+	"\x16unidir_delay_variation\x18\v \x01(\rR\x14unidirDelayVariation\x12,\n" +
+	"\x12unidir_packet_loss\x18\f \x01(\rR\x10unidirPacketLoss\x12,\n" +
+	"\x12unidir_residual_bw\x18\r \x01(\rR\x10unidirResidualBw\x12.\n" +
+	"\x13unidir_available_bw\x18\x0e \x01(\rR\x11unidirAvailableBw\x122\n" +
+	"\x15unidir_bw_utilization\x18\x0f \x01(\rR\x13unidirBwUtilization\"\xcd\a\n" +
+	"\x0fMulticastPrefix\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12\x1a\n" +
+	"\bsequence\x18\x02 \x01(\x03R\bsequence\x12\x12\n" +
+	"\x04hash\x18\x03 \x01(\tR\x04hash\x12\x1f\n" +
+	"\vrouter_hash\x18\x04 \x01(\tR\n" +
+	"routerHash\x12\x1b\n" +
+	"\trouter_ip\x18\x05 \x01(\tR\brouterIp\x128\n" +
+	"\n" +
+	"base_attrs\x18\x06 \x01(\v2\x19.gobmp.api.BaseAttributesR\tbaseAttrs\x12\x1b\n" +
+	"\tpeer_hash\x18\a \x01(\tR\bpeerHash\x12\x17\n" +
+	"\apeer_ip\x18\b \x01(\tR\x06peerIp\x12\x1b\n" +
+	"\tpeer_type\x18\t \x01(\rR\bpeerType\x12\x19\n" +
+	"\bpeer_asn\x18\n" +
+	" \x01(\rR\apeerAsn\x12\x1c\n" +
+	"\ttimestamp\x18\v \x01(\tR\ttimestamp\x12\x16\n" +
+	"\x06prefix\x18\f \x01(\tR\x06prefix\x12\x1d\n" +
+	"\n" +
+	"prefix_len\x18\r \x01(\x05R\tprefixLen\x12\x17\n" +
+	"\ais_ipv4\x18\x0e \x01(\bR\x06isIpv4\x12\x1b\n" +
+	"\torigin_as\x18\x0f \x01(\rR\boriginAs\x12\x18\n" +
+	"\anexthop\x18\x10 \x01(\tR\anexthop\x12&\n" +
+	"\x0fis_nexthop_ipv4\x18\x11 \x01(\bR\risNexthopIpv4\x12\x17\n" +
+	"\apath_id\x18\x12 \x01(\x05R\x06pathId\x12\x15\n" +
+	"\x06is_eor\x18\x13 \x01(\bR\x05isEor\x127\n" +
+	"\x19is_adj_rib_in_post_policy\x18\x14 \x01(\bR\x14isAdjRibInPostPolicy\x129\n" +
+	"\x1ais_adj_rib_out_post_policy\x18\x15 \x01(\bR\x15isAdjRibOutPostPolicy\x12#\n" +
+	"\x0eis_adj_rib_out\x18\x16 \x01(\bR\visAdjRibOut\x12\x1c\n" +
+	"\n" +
+	"is_loc_rib\x18\x17 \x01(\bR\bisLocRib\x12-\n" +
+	"\x13is_loc_rib_filtered\x18\x18 \x01(\bR\x10isLocRibFiltered\x12\x1d\n" +
+	"\n" +
+	"table_name\x18\x19 \x01(\tR\ttableName\x12K\n" +
+	"\vuser_labels\x18\x1a \x03(\v2*.gobmp.api.MulticastPrefix.UserLabelsEntryR\n" +
+	"userLabels\x1a=\n" +
+	"\x0fUserLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xc0\b\n" +
+	"\x0eMCASTVPNPrefix\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12\x1a\n" +
+	"\bsequence\x18\x02 \x01(\x03R\bsequence\x12\x12\n" +
+	"\x04hash\x18\x03 \x01(\tR\x04hash\x12\x1f\n" +
+	"\vrouter_hash\x18\x04 \x01(\tR\n" +
+	"routerHash\x12\x1b\n" +
+	"\trouter_ip\x18\x05 \x01(\tR\brouterIp\x128\n" +
+	"\n" +
+	"base_attrs\x18\x06 \x01(\v2\x19.gobmp.api.BaseAttributesR\tbaseAttrs\x12\x1b\n" +
+	"\tpeer_hash\x18\a \x01(\tR\bpeerHash\x12\x17\n" +
+	"\apeer_ip\x18\b \x01(\tR\x06peerIp\x12\x1b\n" +
+	"\tpeer_type\x18\t \x01(\rR\bpeerType\x12\x19\n" +
+	"\bpeer_asn\x18\n" +
+	" \x01(\rR\apeerAsn\x12\x1c\n" +
+	"\ttimestamp\x18\v \x01(\tR\ttimestamp\x12\x1d\n" +
+	"\n" +
+	"route_type\x18\f \x01(\rR\trouteType\x12\x0e\n" +
+	"\x02rd\x18\r \x01(\tR\x02rd\x12#\n" +
+	"\roriginator_ip\x18\x0e \x01(\tR\foriginatorIp\x12)\n" +
+	"\x10multicast_source\x18\x0f \x01(\tR\x0fmulticastSource\x12'\n" +
+	"\x0fmulticast_group\x18\x10 \x01(\tR\x0emulticastGroup\x12\x1b\n" +
+	"\tsource_as\x18\x11 \x01(\rR\bsourceAs\x12\x1b\n" +
+	"\troute_key\x18\x12 \x01(\tR\brouteKey\x12\x18\n" +
+	"\anexthop\x18\x13 \x01(\tR\anexthop\x12\x17\n" +
+	"\ais_ipv4\x18\x14 \x01(\bR\x06isIpv4\x12&\n" +
+	"\x0fis_nexthop_ipv4\x18\x15 \x01(\bR\risNexthopIpv4\x12\x15\n" +
+	"\x06is_eor\x18\x16 \x01(\bR\x05isEor\x127\n" +
+	"\x19is_adj_rib_in_post_policy\x18\x17 \x01(\bR\x14isAdjRibInPostPolicy\x129\n" +
+	"\x1ais_adj_rib_out_post_policy\x18\x18 \x01(\bR\x15isAdjRibOutPostPolicy\x12#\n" +
+	"\x0eis_adj_rib_out\x18\x19 \x01(\bR\visAdjRibOut\x12\x1c\n" +
+	"\n" +
+	"is_loc_rib\x18\x1a \x01(\bR\bisLocRib\x12-\n" +
+	"\x13is_loc_rib_filtered\x18\x1b \x01(\bR\x10isLocRibFiltered\x12\x1d\n" +
+	"\n" +
+	"table_name\x18\x1c \x01(\tR\ttableName\x12J\n" +
+	"\vuser_labels\x18\x1d \x03(\v2).gobmp.api.MCASTVPNPrefix.UserLabelsEntryR\n" +
+	"userLabels\x1a=\n" +
+	"\x0fUserLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xea\x06\n" +
+	"\tRTCPrefix\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12\x1a\n" +
+	"\bsequence\x18\x02 \x01(\x03R\bsequence\x12\x12\n" +
+	"\x04hash\x18\x03 \x01(\tR\x04hash\x12\x1f\n" +
+	"\vrouter_hash\x18\x04 \x01(\tR\n" +
+	"routerHash\x12\x1b\n" +
+	"\trouter_ip\x18\x05 \x01(\tR\brouterIp\x128\n" +
+	"\n" +
+	"base_attrs\x18\x06 \x01(\v2\x19.gobmp.api.BaseAttributesR\tbaseAttrs\x12\x1b\n" +
+	"\tpeer_hash\x18\a \x01(\tR\bpeerHash\x12\x17\n" +
+	"\apeer_ip\x18\b \x01(\tR\x06peerIp\x12\x1b\n" +
+	"\tpeer_type\x18\t \x01(\rR\bpeerType\x12\x19\n" +
+	"\bpeer_asn\x18\n" +
+	" \x01(\rR\apeerAsn\x12\x1c\n" +
+	"\ttimestamp\x18\v \x01(\tR\ttimestamp\x12\x16\n" +
+	"\x06length\x18\f \x01(\rR\x06length\x12\x1b\n" +
+	"\torigin_as\x18\r \x01(\rR\boriginAs\x12!\n" +
+	"\froute_target\x18\x0e \x01(\tR\vrouteTarget\x12\x17\n" +
+	"\ais_ipv4\x18\x0f \x01(\bR\x06isIpv4\x12\x15\n" +
+	"\x06is_eor\x18\x10 \x01(\bR\x05isEor\x127\n" +
+	"\x19is_adj_rib_in_post_policy\x18\x11 \x01(\bR\x14isAdjRibInPostPolicy\x129\n" +
+	"\x1ais_adj_rib_out_post_policy\x18\x12 \x01(\bR\x15isAdjRibOutPostPolicy\x12#\n" +
+	"\x0eis_adj_rib_out\x18\x13 \x01(\bR\visAdjRibOut\x12\x1c\n" +
+	"\n" +
+	"is_loc_rib\x18\x14 \x01(\bR\bisLocRib\x12-\n" +
+	"\x13is_loc_rib_filtered\x18\x15 \x01(\bR\x10isLocRibFiltered\x12\x1d\n" +
+	"\n" +
+	"table_name\x18\x16 \x01(\tR\ttableName\x12E\n" +
+	"\vuser_labels\x18\x17 \x03(\v2$.gobmp.api.RTCPrefix.UserLabelsEntryR\n" +
+	"userLabels\x1a=\n" +
+	"\x0fUserLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x8c\n" +
+	"\n" +
+	"\vL3VPNPrefix\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12\x1a\n" +
+	"\bsequence\x18\x02 \x01(\x03R\bsequence\x12\x12\n" +
+	"\x04hash\x18\x03 \x01(\tR\x04hash\x12\x1f\n" +
+	"\vrouter_hash\x18\x04 \x01(\tR\n" +
+	"routerHash\x12\x1b\n" +
+	"\trouter_ip\x18\x05 \x01(\tR\brouterIp\x128\n" +
+	"\n" +
+	"base_attrs\x18\x06 \x01(\v2\x19.gobmp.api.BaseAttributesR\tbaseAttrs\x12\x1b\n" +
+	"\tpeer_hash\x18\a \x01(\tR\bpeerHash\x12\x17\n" +
+	"\apeer_ip\x18\b \x01(\tR\x06peerIp\x12\x1b\n" +
+	"\tpeer_type\x18\t \x01(\rR\bpeerType\x12\x19\n" +
+	"\bpeer_asn\x18\n" +
+	" \x01(\rR\apeerAsn\x12\x1c\n" +
+	"\ttimestamp\x18\v \x01(\tR\ttimestamp\x12\x16\n" +
+	"\x06prefix\x18\f \x01(\tR\x06prefix\x12\x1d\n" +
+	"\n" +
+	"prefix_len\x18\r \x01(\x05R\tprefixLen\x12\x17\n" +
+	"\ais_ipv4\x18\x0e \x01(\bR\x06isIpv4\x12\x1b\n" +
+	"\torigin_as\x18\x0f \x01(\rR\boriginAs\x12\x18\n" +
+	"\anexthop\x18\x10 \x01(\tR\anexthop\x12!\n" +
+	"\fcluster_list\x18\x11 \x01(\tR\vclusterList\x12&\n" +
+	"\x0fis_nexthop_ipv4\x18\x12 \x01(\bR\risNexthopIpv4\x12\x17\n" +
+	"\apath_id\x18\x13 \x01(\x05R\x06pathId\x12\x16\n" +
+	"\x06labels\x18\x14 \x03(\rR\x06labels\x120\n" +
+	"\x11origin_validation\x18\x15 \x01(\tH\x00R\x10originValidation\x88\x01\x01\x12\x15\n" +
+	"\x06vpn_rd\x18\x16 \x01(\tR\x05vpnRd\x12\x1e\n" +
+	"\vvpn_rd_type\x18\x17 \x01(\rR\tvpnRdType\x12#\n" +
+	"\rroute_targets\x18\x18 \x03(\tR\frouteTargets\x12\x10\n" +
+	"\x03vrf\x18\x19 \x01(\tR\x03vrf\x12.\n" +
+	"\n" +
+	"prefix_sid\x18\x1a \x01(\v2\x0f.gobmp.api.PSidR\tprefixSid\x12\x15\n" +
+	"\x06is_eor\x18\x1b \x01(\bR\x05isEor\x127\n" +
+	"\x19is_adj_rib_in_post_policy\x18\x1c \x01(\bR\x14isAdjRibInPostPolicy\x129\n" +
+	"\x1ais_adj_rib_out_post_policy\x18\x1d \x01(\bR\x15isAdjRibOutPostPolicy\x12#\n" +
+	"\x0eis_adj_rib_out\x18\x1e \x01(\bR\visAdjRibOut\x12\x1c\n" +
+	"\n" +
+	"is_loc_rib\x18\x1f \x01(\bR\bisLocRib\x12-\n" +
+	"\x13is_loc_rib_filtered\x18  \x01(\bR\x10isLocRibFiltered\x12\x1d\n" +
+	"\n" +
+	"table_name\x18! \x01(\tR\ttableName\x12G\n" +
+	"\vuser_labels\x18\" \x03(\v2&.gobmp.api.L3VPNPrefix.UserLabelsEntryR\n" +
+	"userLabels\x12$\n" +
+	"\x0ebase_attr_hash\x18# \x01(\tR\fbaseAttrHash\x1a=\n" +
+	"\x0fUserLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x14\n" +
+	"\x12_origin_validation\"\xcc\f\n" +
+	"\bLSPrefix\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12\x1a\n" +
+	"\bsequence\x18\x02 \x01(\x03R\bsequence\x12\x12\n" +
+	"\x04hash\x18\x03 \x01(\tR\x04hash\x12\x1f\n" +
+	"\vrouter_hash\x18\x04 \x01(\tR\n" +
+	"routerHash\x12\x1b\n" +
+	"\trouter_ip\x18\x05 \x01(\tR\brouterIp\x12\x1b\n" +
+	"\tdomain_id\x18\x06 \x01(\x03R\bdomainId\x12\x1b\n" +
+	"\tpeer_hash\x18\a \x01(\tR\bpeerHash\x12\x17\n" +
+	"\apeer_ip\x18\b \x01(\tR\x06peerIp\x12\x1b\n" +
+	"\tpeer_type\x18\t \x01(\rR\bpeerType\x12\x19\n" +
+	"\bpeer_asn\x18\n" +
+	" \x01(\rR\apeerAsn\x12\x1c\n" +
+	"\ttimestamp\x18\v \x01(\tR\ttimestamp\x12\"\n" +
+	"\rigp_router_id\x18\f \x01(\tR\vigpRouterId\x12\x1b\n" +
+	"\trouter_id\x18\r \x01(\tR\brouterId\x12\x13\n" +
+	"\x05ls_id\x18\x0e \x01(\rR\x04lsId\x12\x1f\n" +
+	"\vprotocol_id\x18\x0f \x01(\rR\n" +
+	"protocolId\x12\x1a\n" +
+	"\bprotocol\x18\x10 \x01(\tR\bprotocol\x12\x17\n" +
+	"\aarea_id\x18\x11 \x01(\tR\x06areaId\x12\x18\n" +
+	"\anexthop\x18\x12 \x01(\tR\anexthop\x12&\n" +
+	"\x0flocal_node_hash\x18\x13 \x01(\tR\rlocalNodeHash\x12>\n" +
+	"\tmt_id_tlv\x18\x14 \x01(\v2\".gobmp.api.MultiTopologyIdentifierR\amtIdTlv\x12&\n" +
+	"\x0fospf_route_type\x18\x15 \x01(\rR\rospfRouteType\x120\n" +
+	"\tigp_flags\x18\x16 \x01(\v2\x13.gobmp.api.IGPFlagsR\bigpFlags\x12\x1b\n" +
+	"\troute_tag\x18\x17 \x03(\rR\brouteTag\x12\"\n" +
+	"\rext_route_tag\x18\x18 \x03(\x04R\vextRouteTag\x12\"\n" +
+	"\rospf_fwd_addr\x18\x19 \x01(\tR\vospfFwdAddr\x12\x16\n" +
+	"\x06prefix\x18\x1a \x01(\tR\x06prefix\x12\x1d\n" +
+	"\n" +
+	"prefix_len\x18\x1b \x01(\x05R\tprefixLen\x12#\n" +
+	"\rprefix_metric\x18\x1c \x01(\rR\fprefixMetric\x12C\n" +
+	"\x10prefix_attr_tlvs\x18\x1d \x01(\v2\x19.gobmp.api.PrefixAttrTLVsR\x0eprefixAttrTlvs\x12V\n" +
+	"\x17flex_algo_prefix_metric\x18\x1e \x03(\v2\x1f.gobmp.api.FlexAlgoPrefixMetricR\x14flexAlgoPrefixMetric\x128\n" +
+	"\fsrv6_locator\x18\x1f \x01(\v2\x15.gobmp.api.LocatorTLVR\vsrv6Locator\x126\n" +
+	"\x17opaque_prefix_attribute\x18  \x03(\tR\x15opaquePrefixAttribute\x127\n" +
+	"\x19is_adj_rib_in_post_policy\x18! \x01(\bR\x14isAdjRibInPostPolicy\x129\n" +
+	"\x1ais_adj_rib_out_post_policy\x18\" \x01(\bR\x15isAdjRibOutPostPolicy\x12#\n" +
+	"\x0eis_adj_rib_out\x18# \x01(\bR\visAdjRibOut\x12\x1c\n" +
+	"\n" +
+	"is_loc_rib\x18$ \x01(\bR\bisLocRib\x12-\n" +
+	"\x13is_loc_rib_filtered\x18% \x01(\bR\x10isLocRibFiltered\x12\x1d\n" +
+	"\n" +
+	"table_name\x18& \x01(\tR\ttableName\x12/\n" +
+	"\x13route_distinguisher\x18' \x01(\tR\x12routeDistinguisher\x12D\n" +
+	"\vuser_labels\x18( \x03(\v2#.gobmp.api.LSPrefix.UserLabelsEntryR\n" +
+	"userLabels\x1a=\n" +
+	"\x0fUserLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"f\n" +
+	"\bIGPFlags\x12\x15\n" +
+	"\x06d_flag\x18\x01 \x01(\bR\x05dFlag\x12\x15\n" +
+	"\x06n_flag\x18\x02 \x01(\bR\x05nFlag\x12\x15\n" +
+	"\x06l_flag\x18\x03 \x01(\bR\x05lFlag\x12\x15\n" +
+	"\x06p_flag\x18\x04 \x01(\bR\x05pFlag\"\xd2\x01\n" +
+	"\x0ePrefixAttrTLVs\x12;\n" +
+	"\rls_prefix_sid\x18\x01 \x03(\v2\x17.gobmp.api.PrefixSIDTLVR\vlsPrefixSid\x12)\n" +
+	"\x05range\x18\x02 \x01(\v2\x13.gobmp.api.RangeTLVR\x05range\x12.\n" +
+	"\x05flags\x18\x03 \x01(\v2\x18.gobmp.api.ProtocolFlagsR\x05flags\x12(\n" +
+	"\x10source_router_id\x18\x04 \x01(\tR\x0esourceRouterId\"q\n" +
+	"\fPrefixSIDTLV\x12.\n" +
+	"\x05flags\x18\x01 \x01(\v2\x18.gobmp.api.ProtocolFlagsR\x05flags\x12\x12\n" +
+	"\x04algo\x18\x02 \x01(\rR\x04algo\x12\x1d\n" +
+	"\n" +
+	"prefix_sid\x18\x03 \x01(\rR\tprefixSid\"\x91\x01\n" +
+	"\bRangeTLV\x12.\n" +
+	"\x05flags\x18\x01 \x01(\v2\x18.gobmp.api.ProtocolFlagsR\x05flags\x12\x1d\n" +
+	"\n" +
+	"range_size\x18\x02 \x01(\rR\trangeSize\x126\n" +
+	"\n" +
+	"prefix_sid\x18\x03 \x03(\v2\x17.gobmp.api.PrefixSIDTLVR\tprefixSid\"K\n" +
+	"\x14FlexAlgoPrefixMetric\x12\x1b\n" +
+	"\tflex_algo\x18\x01 \x01(\rR\bflexAlgo\x12\x16\n" +
+	"\x06metric\x18\x02 \x01(\rR\x06metric\"\x95\x01\n" +
+	"\n" +
+	"LocatorTLV\x12-\n" +
+	"\x05flags\x18\x01 \x01(\v2\x17.gobmp.api.LocatorFlagsR\x05flags\x12\x12\n" +
+	"\x04algo\x18\x02 \x01(\rR\x04algo\x12\x16\n" +
+	"\x06metric\x18\x03 \x01(\rR\x06metric\x12,\n" +
+	"\bsub_tlvs\x18\x04 \x03(\v2\x11.gobmp.api.SubTLVR\asubTlvs\"%\n" +
+	"\fLocatorFlags\x12\x15\n" +
+	"\x06d_flag\x18\x01 \x01(\bR\x05dFlag\"\xa6\f\n" +
+	"\tLSSRv6SID\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12\x1a\n" +
+	"\bsequence\x18\x02 \x01(\x03R\bsequence\x12\x12\n" +
+	"\x04hash\x18\x03 \x01(\tR\x04hash\x12\x1f\n" +
+	"\vrouter_hash\x18\x04 \x01(\tR\n" +
+	"routerHash\x12\x1b\n" +
+	"\trouter_ip\x18\x05 \x01(\tR\brouterIp\x12\x1b\n" +
+	"\tdomain_id\x18\x06 \x01(\x03R\bdomainId\x12\x1b\n" +
+	"\tpeer_hash\x18\a \x01(\tR\bpeerHash\x12\x17\n" +
+	"\apeer_ip\x18\b \x01(\tR\x06peerIp\x12\x1b\n" +
+	"\tpeer_type\x18\t \x01(\rR\bpeerType\x12\x19\n" +
+	"\bpeer_asn\x18\n" +
+	" \x01(\rR\apeerAsn\x12\x1c\n" +
+	"\ttimestamp\x18\v \x01(\tR\ttimestamp\x12\"\n" +
+	"\rigp_router_id\x18\f \x01(\tR\vigpRouterId\x12$\n" +
+	"\x0elocal_node_asn\x18\r \x01(\rR\flocalNodeAsn\x12\x1b\n" +
+	"\trouter_id\x18\x0e \x01(\tR\brouterId\x12\x13\n" +
+	"\x05ls_id\x18\x0f \x01(\rR\x04lsId\x12\x17\n" +
+	"\aarea_id\x18\x10 \x01(\tR\x06areaId\x12\x1f\n" +
+	"\vprotocol_id\x18\x11 \x01(\rR\n" +
+	"protocolId\x12\x1a\n" +
+	"\bprotocol\x18\x12 \x01(\tR\bprotocol\x12\x18\n" +
+	"\anexthop\x18\x13 \x01(\tR\anexthop\x12&\n" +
+	"\x0flocal_node_hash\x18\x14 \x01(\tR\rlocalNodeHash\x12>\n" +
+	"\tmt_id_tlv\x18\x15 \x01(\v2\".gobmp.api.MultiTopologyIdentifierR\amtIdTlv\x12\x1b\n" +
+	"\tigp_flags\x18\x16 \x01(\rR\bigpFlags\x12\x1b\n" +
+	"\troute_tag\x18\x17 \x01(\rR\brouteTag\x12\"\n" +
+	"\rext_route_tag\x18\x18 \x01(\rR\vextRouteTag\x12\"\n" +
+	"\rospf_fwd_addr\x18\x19 \x01(\tR\vospfFwdAddr\x12\x1d\n" +
+	"\n" +
+	"igp_metric\x18\x1a \x01(\rR\tigpMetric\x12\x16\n" +
+	"\x06prefix\x18\x1b \x01(\tR\x06prefix\x12\x1d\n" +
+	"\n" +
+	"prefix_len\x18\x1c \x01(\x05R\tprefixLen\x12\x19\n" +
+	"\bsrv6_sid\x18\x1d \x01(\tR\asrv6Sid\x12Q\n" +
+	"\x16srv6_endpoint_behavior\x18\x1e \x01(\v2\x1b.gobmp.api.EndpointBehaviorR\x14srv6EndpointBehavior\x12M\n" +
+	"\x16srv6_bgp_peer_node_sid\x18\x1f \x01(\v2\x19.gobmp.api.BGPPeerNodeSIDR\x12srv6BgpPeerNodeSid\x12E\n" +
+	"\x12srv6_sid_structure\x18  \x01(\v2\x17.gobmp.api.SIDStructureR\x10srv6SidStructure\x127\n" +
+	"\x19is_adj_rib_in_post_policy\x18! \x01(\bR\x14isAdjRibInPostPolicy\x129\n" +
+	"\x1ais_adj_rib_out_post_policy\x18\" \x01(\bR\x15isAdjRibOutPostPolicy\x12#\n" +
+	"\x0eis_adj_rib_out\x18# \x01(\bR\visAdjRibOut\x12\x1c\n" +
+	"\n" +
+	"is_loc_rib\x18$ \x01(\bR\bisLocRib\x12-\n" +
+	"\x13is_loc_rib_filtered\x18% \x01(\bR\x10isLocRibFiltered\x12\x1d\n" +
+	"\n" +
+	"table_name\x18& \x01(\tR\ttableName\x12/\n" +
+	"\x13route_distinguisher\x18' \x01(\tR\x12routeDistinguisher\x12E\n" +
+	"\vuser_labels\x18( \x03(\v2$.gobmp.api.LSSRv6SID.UserLabelsEntryR\n" +
+	"userLabels\x1a=\n" +
+	"\x0fUserLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"g\n" +
+	"\x10EndpointBehavior\x12+\n" +
+	"\x11endpoint_behavior\x18\x01 \x01(\rR\x10endpointBehavior\x12\x12\n" +
+	"\x04flag\x18\x02 \x01(\rR\x04flag\x12\x12\n" +
+	"\x04algo\x18\x03 \x01(\rR\x04algo\"\xee\x01\n" +
+	"\fSIDStructure\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\rR\x04type\x12\x16\n" +
+	"\x06length\x18\x02 \x01(\rR\x06length\x120\n" +
+	"\x14locator_block_length\x18\x03 \x01(\rR\x12locatorBlockLength\x12.\n" +
+	"\x13locator_node_length\x18\x04 \x01(\rR\x11locatorNodeLength\x12'\n" +
+	"\x0ffunction_length\x18\x05 \x01(\rR\x0efunctionLength\x12'\n" +
+	"\x0fargument_length\x18\x06 \x01(\rR\x0eargumentLength\"\xa3\r\n" +
+	"\n" +
+	"EVPNPrefix\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12\x1a\n" +
+	"\bsequence\x18\x02 \x01(\x03R\bsequence\x12\x12\n" +
+	"\x04hash\x18\x03 \x01(\tR\x04hash\x12\x1f\n" +
+	"\vrouter_hash\x18\x04 \x01(\tR\n" +
+	"routerHash\x12\x1b\n" +
+	"\trouter_ip\x18\x05 \x01(\tR\brouterIp\x128\n" +
+	"\n" +
+	"base_attrs\x18\x06 \x01(\v2\x19.gobmp.api.BaseAttributesR\tbaseAttrs\x12\x1b\n" +
+	"\tpeer_hash\x18\a \x01(\tR\bpeerHash\x12\"\n" +
+	"\rremote_bgp_id\x18\b \x01(\tR\vremoteBgpId\x12\x17\n" +
+	"\apeer_ip\x18\t \x01(\tR\x06peerIp\x12\x1b\n" +
+	"\tpeer_type\x18\n" +
+	" \x01(\rR\bpeerType\x12\x19\n" +
+	"\bpeer_asn\x18\v \x01(\rR\apeerAsn\x12\x1c\n" +
+	"\ttimestamp\x18\f \x01(\tR\ttimestamp\x12\x17\n" +
+	"\ais_ipv4\x18\r \x01(\bR\x06isIpv4\x12\x1b\n" +
+	"\torigin_as\x18\x0e \x01(\rR\boriginAs\x12\x18\n" +
+	"\anexthop\x18\x0f \x01(\tR\anexthop\x12!\n" +
+	"\fcluster_list\x18\x10 \x01(\tR\vclusterList\x12&\n" +
+	"\x0fis_nexthop_ipv4\x18\x11 \x01(\bR\risNexthopIpv4\x12\x17\n" +
+	"\apath_id\x18\x12 \x01(\x05R\x06pathId\x12\x16\n" +
+	"\x06labels\x18\x13 \x03(\rR\x06labels\x12\x1c\n" +
+	"\trawlabels\x18\x14 \x03(\rR\trawlabels\x12\x15\n" +
+	"\x06vpn_rd\x18\x15 \x01(\tR\x05vpnRd\x12\x1e\n" +
+	"\vvpn_rd_type\x18\x16 \x01(\rR\tvpnRdType\x12$\n" +
+	"\x0eeth_segment_id\x18\x17 \x01(\tR\fethSegmentId\x12\x17\n" +
+	"\aeth_tag\x18\x18 \x01(\fR\x06ethTag\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\x19 \x01(\tR\tipAddress\x12\x15\n" +
+	"\x06ip_len\x18\x1a \x01(\rR\x05ipLen\x12\x1d\n" +
+	"\n" +
+	"gw_address\x18\x1b \x01(\tR\tgwAddress\x12\x10\n" +
+	"\x03mac\x18\x1c \x01(\tR\x03mac\x12\x17\n" +
+	"\amac_len\x18\x1d \x01(\rR\x06macLen\x12\x1d\n" +
+	"\n" +
+	"route_type\x18\x1e \x01(\rR\trouteType\x126\n" +
+	"\vpmsi_tunnel\x18\x1f \x01(\v2\x15.gobmp.api.PMSITunnelR\n" +
+	"pmsiTunnel\x12;\n" +
+	"\fmac_mobility\x18  \x01(\v2\x18.gobmp.api.ECMACMobilityR\vmacMobility\x122\n" +
+	"\tesi_label\x18! \x01(\v2\x15.gobmp.api.ECESILabelR\besiLabel\x12\x1d\n" +
+	"\n" +
+	"router_mac\x18\" \x01(\tR\trouterMac\x12'\n" +
+	"\x0fdefault_gateway\x18# \x01(\bR\x0edefaultGateway\x12J\n" +
+	"\x11layer2_attributes\x18$ \x01(\v2\x1d.gobmp.api.ECLayer2AttributesR\x10layer2Attributes\x128\n" +
+	"\vdf_election\x18% \x01(\v2\x17.gobmp.api.ECDFElectionR\n" +
+	"dfElection\x12#\n" +
+	"\rroute_targets\x18& \x03(\tR\frouteTargets\x127\n" +
+	"\x19is_adj_rib_in_post_policy\x18' \x01(\bR\x14isAdjRibInPostPolicy\x129\n" +
+	"\x1ais_adj_rib_out_post_policy\x18( \x01(\bR\x15isAdjRibOutPostPolicy\x12#\n" +
+	"\x0eis_adj_rib_out\x18) \x01(\bR\visAdjRibOut\x12\x1c\n" +
+	"\n" +
+	"is_loc_rib\x18* \x01(\bR\bisLocRib\x12-\n" +
+	"\x13is_loc_rib_filtered\x18+ \x01(\bR\x10isLocRibFiltered\x12\x1d\n" +
+	"\n" +
+	"table_name\x18, \x01(\tR\ttableName\x12F\n" +
+	"\vuser_labels\x18- \x03(\v2%.gobmp.api.EVPNPrefix.UserLabelsEntryR\n" +
+	"userLabels\x1a=\n" +
+	"\x0fUserLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"C\n" +
+	"\rECMACMobility\x12\x16\n" +
+	"\x06sticky\x18\x01 \x01(\bR\x06sticky\x12\x1a\n" +
+	"\bsequence\x18\x02 \x01(\rR\bsequence\"G\n" +
+	"\n" +
+	"ECESILabel\x12#\n" +
+	"\rsingle_active\x18\x01 \x01(\bR\fsingleActive\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\rR\x05label\"K\n" +
+	"\x12ECLayer2Attributes\x12#\n" +
+	"\rcontrol_flags\x18\x01 \x01(\rR\fcontrolFlags\x12\x10\n" +
+	"\x03mtu\x18\x02 \x01(\rR\x03mtu\"P\n" +
+	"\fECDFElection\x12\x1c\n" +
+	"\talgorithm\x18\x01 \x01(\rR\talgorithm\x12\"\n" +
+	"\fcapabilities\x18\x02 \x01(\rR\fcapabilities\"\xf4\v\n" +
+	"\n" +
+	"VPLSPrefix\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12\x1a\n" +
+	"\bsequence\x18\x02 \x01(\x03R\bsequence\x12\x12\n" +
+	"\x04hash\x18\x03 \x01(\tR\x04hash\x12\x1f\n" +
+	"\vrouter_hash\x18\x04 \x01(\tR\n" +
+	"routerHash\x12\x1b\n" +
+	"\trouter_ip\x18\x05 \x01(\tR\brouterIp\x128\n" +
+	"\n" +
+	"base_attrs\x18\x06 \x01(\v2\x19.gobmp.api.BaseAttributesR\tbaseAttrs\x12\x1b\n" +
+	"\tpeer_hash\x18\a \x01(\tR\bpeerHash\x12\"\n" +
+	"\rremote_bgp_id\x18\b \x01(\tR\vremoteBgpId\x12\x17\n" +
+	"\apeer_ip\x18\t \x01(\tR\x06peerIp\x12\x1b\n" +
+	"\tpeer_type\x18\n" +
+	" \x01(\rR\bpeerType\x12\x19\n" +
+	"\bpeer_asn\x18\v \x01(\rR\apeerAsn\x12\x1c\n" +
+	"\ttimestamp\x18\f \x01(\tR\ttimestamp\x12\x17\n" +
+	"\ais_ipv4\x18\r \x01(\bR\x06isIpv4\x12\x1b\n" +
+	"\torigin_as\x18\x0e \x01(\rR\boriginAs\x12\x18\n" +
+	"\anexthop\x18\x0f \x01(\tR\anexthop\x12&\n" +
+	"\x0fis_nexthop_ipv4\x18\x10 \x01(\bR\risNexthopIpv4\x12\x15\n" +
+	"\x06vpn_rd\x18\x11 \x01(\tR\x05vpnRd\x12\x19\n" +
+	"\brfc_type\x18\x12 \x01(\tR\arfcType\x12\x18\n" +
+	"\x05ve_id\x18\x13 \x01(\rH\x00R\x04veId\x88\x01\x01\x12+\n" +
+	"\x0fve_block_offset\x18\x14 \x01(\rH\x01R\rveBlockOffset\x88\x01\x01\x12'\n" +
+	"\rve_block_size\x18\x15 \x01(\rH\x02R\vveBlockSize\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"label_base\x18\x16 \x01(\rH\x03R\tlabelBase\x88\x01\x01\x12+\n" +
+	"\x0flabel_block_end\x18\x17 \x01(\rH\x04R\rlabelBlockEnd\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"pe_address\x18\x18 \x01(\tH\x05R\tpeAddress\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"encap_type\x18\x19 \x01(\tH\x06R\tencapType\x88\x01\x01\x12&\n" +
+	"\fcontrol_word\x18\x1a \x01(\bH\aR\vcontrolWord\x88\x01\x01\x122\n" +
+	"\x12sequenced_delivery\x18\x1b \x01(\bH\bR\x11sequencedDelivery\x88\x01\x01\x12\x15\n" +
+	"\x03mtu\x18\x1c \x01(\rH\tR\x03mtu\x88\x01\x01\x12#\n" +
+	"\rroute_targets\x18\x1d \x03(\tR\frouteTargets\x127\n" +
+	"\x19is_adj_rib_in_post_policy\x18\x1e \x01(\bR\x14isAdjRibInPostPolicy\x129\n" +
+	"\x1ais_adj_rib_out_post_policy\x18\x1f \x01(\bR\x15isAdjRibOutPostPolicy\x12#\n" +
+	"\x0eis_adj_rib_out\x18  \x01(\bR\visAdjRibOut\x12\x1c\n" +
+	"\n" +
+	"is_loc_rib\x18! \x01(\bR\bisLocRib\x12-\n" +
+	"\x13is_loc_rib_filtered\x18\" \x01(\bR\x10isLocRibFiltered\x12\x1d\n" +
+	"\n" +
+	"table_name\x18# \x01(\tR\ttableName\x12F\n" +
+	"\vuser_labels\x18$ \x03(\v2%.gobmp.api.VPLSPrefix.UserLabelsEntryR\n" +
+	"userLabels\x1a=\n" +
+	"\x0fUserLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\b\n" +
+	"\x06_ve_idB\x12\n" +
+	"\x10_ve_block_offsetB\x10\n" +
+	"\x0e_ve_block_sizeB\r\n" +
+	"\v_label_baseB\x12\n" +
+	"\x10_label_block_endB\r\n" +
+	"\v_pe_addressB\r\n" +
+	"\v_encap_typeB\x0f\n" +
+	"\r_control_wordB\x15\n" +
+	"\x13_sequenced_deliveryB\x06\n" +
+	"\x04_mtu\"\xb3\v\n" +
+	"\bSRPolicy\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12\x1a\n" +
+	"\bsequence\x18\x02 \x01(\x03R\bsequence\x12\x12\n" +
+	"\x04hash\x18\x03 \x01(\tR\x04hash\x12\x1f\n" +
+	"\vrouter_hash\x18\x04 \x01(\tR\n" +
+	"routerHash\x12\x1b\n" +
+	"\trouter_ip\x18\x05 \x01(\tR\brouterIp\x128\n" +
+	"\n" +
+	"base_attrs\x18\x06 \x01(\v2\x19.gobmp.api.BaseAttributesR\tbaseAttrs\x12\x1b\n" +
+	"\tpeer_hash\x18\a \x01(\tR\bpeerHash\x12\x17\n" +
+	"\apeer_ip\x18\b \x01(\tR\x06peerIp\x12\x1b\n" +
+	"\tpeer_type\x18\t \x01(\rR\bpeerType\x12\x19\n" +
+	"\bpeer_asn\x18\n" +
+	" \x01(\rR\apeerAsn\x12\x1c\n" +
+	"\ttimestamp\x18\v \x01(\tR\ttimestamp\x12\x17\n" +
+	"\ais_ipv4\x18\f \x01(\bR\x06isIpv4\x12\x1b\n" +
+	"\torigin_as\x18\r \x01(\rR\boriginAs\x12\x18\n" +
+	"\anexthop\x18\x0e \x01(\tR\anexthop\x12!\n" +
+	"\fcluster_list\x18\x0f \x01(\tR\vclusterList\x12&\n" +
+	"\x0fis_nexthop_ipv4\x18\x10 \x01(\bR\risNexthopIpv4\x12\x17\n" +
+	"\apath_id\x18\x11 \x01(\x05R\x06pathId\x12\x16\n" +
+	"\x06labels\x18\x12 \x03(\rR\x06labels\x12$\n" +
+	"\rdistinguisher\x18\x13 \x01(\rR\rdistinguisher\x12\x14\n" +
+	"\x05color\x18\x14 \x01(\rR\x05color\x12\x1a\n" +
+	"\bendpoint\x18\x15 \x01(\fR\bendpoint\x12\x1f\n" +
+	"\vpolicy_name\x18\x16 \x01(\tR\n" +
+	"policyName\x126\n" +
+	"\vbinding_sid\x18\x17 \x01(\v2\x15.gobmp.api.BindingSIDR\n" +
+	"bindingSid\x12C\n" +
+	"\x10srv6_binding_sid\x18\x18 \x03(\v2\x19.gobmp.api.SRv6BindingSIDR\x0esrv6BindingSid\x12B\n" +
+	"\x11preference_subtlv\x18\x19 \x01(\v2\x15.gobmp.api.PreferenceR\x10preferenceSubtlv\x12'\n" +
+	"\x0fpriority_subtlv\x18\x1a \x01(\rR\x0eprioritySubtlv\x12(\n" +
+	"\x10policy_path_name\x18\x1b \x01(\tR\x0epolicyPathName\x120\n" +
+	"\venlp_subtlv\x18\x1c \x01(\v2\x0f.gobmp.api.ENLPR\n" +
+	"enlpSubtlv\x12F\n" +
+	"\x13segment_list_subtlv\x18\x1d \x03(\v2\x16.gobmp.api.SegmentListR\x11segmentListSubtlv\x127\n" +
+	"\x19is_adj_rib_in_post_policy\x18\x1e \x01(\bR\x14isAdjRibInPostPolicy\x129\n" +
+	"\x1ais_adj_rib_out_post_policy\x18\x1f \x01(\bR\x15isAdjRibOutPostPolicy\x12#\n" +
+	"\x0eis_adj_rib_out\x18  \x01(\bR\visAdjRibOut\x12\x1c\n" +
+	"\n" +
+	"is_loc_rib\x18! \x01(\bR\bisLocRib\x12-\n" +
+	"\x13is_loc_rib_filtered\x18\" \x01(\bR\x10isLocRibFiltered\x12\x1d\n" +
+	"\n" +
+	"table_name\x18# \x01(\tR\ttableName\x12D\n" +
+	"\vuser_labels\x18$ \x03(\v2#.gobmp.api.SRPolicy.UserLabelsEntryR\n" +
+	"userLabels\x1a=\n" +
+	"\x0fUserLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"Y\n" +
+	"\n" +
+	"BindingSID\x12\x1b\n" +
+	"\tbsid_type\x18\x01 \x01(\x03R\bbsidType\x12.\n" +
+	"\x04bsid\x18\x02 \x01(\v2\x1a.gobmp.api.BindingSIDValueR\x04bsid\"\xab\x01\n" +
+	"\x0eSRv6BindingSID\x12\x14\n" +
+	"\x05flags\x18\x01 \x01(\rR\x05flags\x12\x1b\n" +
+	"\tsrv6_bsid\x18\x02 \x01(\fR\bsrv6Bsid\x12f\n" +
+	"\x1fendpoint_behavior_sid_structure\x18\x03 \x01(\v2\x1f.gobmp.api.SRv6EndpointBehaviorR\x1cendpointBehaviorSidStructure\"\xf7\x01\n" +
+	"\x14SRv6EndpointBehavior\x12+\n" +
+	"\x11endpoint_behavior\x18\x01 \x01(\rR\x10endpointBehavior\x120\n" +
+	"\x14locator_block_length\x18\x02 \x01(\rR\x12locatorBlockLength\x12.\n" +
+	"\x13locator_node_length\x18\x03 \x01(\rR\x11locatorNodeLength\x12'\n" +
+	"\x0ffunction_length\x18\x04 \x01(\rR\x0efunctionLength\x12'\n" +
+	"\x0fargument_length\x18\x05 \x01(\rR\x0eargumentLength\"B\n" +
+	"\n" +
+	"Preference\x12\x14\n" +
+	"\x05flags\x18\x01 \x01(\rR\x05flags\x12\x1e\n" +
+	"\n" +
+	"preference\x18\x02 \x01(\rR\n" +
+	"preference\"0\n" +
+	"\x04ENLP\x12\x14\n" +
+	"\x05flags\x18\x01 \x01(\rR\x05flags\x12\x12\n" +
+	"\x04enlp\x18\x02 \x01(\rR\x04enlp\"u\n" +
+	"\vSegmentList\x126\n" +
+	"\rweight_subtlv\x18\x01 \x01(\v2\x11.gobmp.api.WeightR\fweightSubtlv\x12.\n" +
+	"\bsegments\x18\x02 \x03(\v2\x12.gobmp.api.SegmentR\bsegments\"6\n" +
+	"\x06Weight\x12\x14\n" +
+	"\x05flags\x18\x01 \x01(\rR\x05flags\x12\x16\n" +
+	"\x06weight\x18\x02 \x01(\rR\x06weight\"\x9a\a\n" +
+	"\bFlowspec\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12\x1a\n" +
+	"\bsequence\x18\x02 \x01(\x03R\bsequence\x12\x1f\n" +
+	"\vrouter_hash\x18\x03 \x01(\tR\n" +
+	"routerHash\x12\x1b\n" +
+	"\trouter_ip\x18\x04 \x01(\tR\brouterIp\x128\n" +
+	"\n" +
+	"base_attrs\x18\x05 \x01(\v2\x19.gobmp.api.BaseAttributesR\tbaseAttrs\x12\x17\n" +
+	"\apeer_ip\x18\x06 \x01(\tR\x06peerIp\x12\x1b\n" +
+	"\tpeer_type\x18\a \x01(\rR\bpeerType\x12\x19\n" +
+	"\bpeer_asn\x18\b \x01(\rR\apeerAsn\x12\x1c\n" +
+	"\ttimestamp\x18\t \x01(\tR\ttimestamp\x12\x17\n" +
+	"\ais_ipv4\x18\n" +
+	" \x01(\bR\x06isIpv4\x12\x1b\n" +
+	"\torigin_as\x18\v \x01(\rR\boriginAs\x12\x18\n" +
+	"\anexthop\x18\f \x01(\tR\anexthop\x12&\n" +
+	"\x0fis_nexthop_ipv4\x18\r \x01(\bR\risNexthopIpv4\x12\x17\n" +
+	"\apath_id\x18\x0e \x01(\x05R\x06pathId\x12\x1b\n" +
+	"\tspec_hash\x18\x0f \x01(\tR\bspecHash\x12+\n" +
+	"\x04spec\x18\x10 \x03(\v2\x17.gobmp.api.FlowspecSpecR\x04spec\x12\x0e\n" +
+	"\x02rd\x18\x11 \x01(\tR\x02rd\x127\n" +
+	"\x19is_adj_rib_in_post_policy\x18\x12 \x01(\bR\x14isAdjRibInPostPolicy\x129\n" +
+	"\x1ais_adj_rib_out_post_policy\x18\x13 \x01(\bR\x15isAdjRibOutPostPolicy\x12#\n" +
+	"\x0eis_adj_rib_out\x18\x14 \x01(\bR\visAdjRibOut\x12\x1c\n" +
+	"\n" +
+	"is_loc_rib\x18\x15 \x01(\bR\bisLocRib\x12-\n" +
+	"\x13is_loc_rib_filtered\x18\x16 \x01(\bR\x10isLocRibFiltered\x12\x1d\n" +
+	"\n" +
+	"table_name\x18\x17 \x01(\tR\ttableName\x12D\n" +
+	"\vuser_labels\x18\x18 \x03(\v2#.gobmp.api.Flowspec.UserLabelsEntryR\n" +
+	"userLabels\x1a=\n" +
+	"\x0fUserLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xa6\v\n" +
+	"\x05Stats\x12\x1a\n" +
+	"\bsequence\x18\x01 \x01(\x03R\bsequence\x12\x1f\n" +
+	"\vrouter_hash\x18\x02 \x01(\tR\n" +
+	"routerHash\x12\x1b\n" +
+	"\trouter_ip\x18\x03 \x01(\tR\brouterIp\x12\x1b\n" +
+	"\tpeer_type\x18\x04 \x01(\rR\bpeerType\x12\"\n" +
+	"\rremote_bgp_id\x18\x05 \x01(\tR\vremoteBgpId\x12\x1d\n" +
+	"\n" +
+	"remote_asn\x18\x06 \x01(\rR\tremoteAsn\x12\x1b\n" +
+	"\tremote_ip\x18\a \x01(\tR\bremoteIp\x12\x17\n" +
+	"\apeer_rd\x18\b \x01(\tR\x06peerRd\x12\x1c\n" +
+	"\ttimestamp\x18\t \x01(\tR\ttimestamp\x12)\n" +
+	"\x10duplicate_prefix\x18\n" +
+	" \x01(\rR\x0fduplicatePrefix\x12/\n" +
+	"\x13duplicate_withdraws\x18\v \x01(\rR\x12duplicateWithdraws\x126\n" +
+	"\x17invalidated_due_cluster\x18\f \x01(\rR\x15invalidatedDueCluster\x124\n" +
+	"\x16invalidated_due_aspath\x18\r \x01(\rR\x14invalidatedDueAspath\x12A\n" +
+	"\x1dinvalidated_due_originator_id\x18\x0e \x01(\rR\x1ainvalidatedDueOriginatorId\x128\n" +
+	"\x18invalidated_due_asconfed\x18\x0f \x01(\rR\x16invalidatedDueAsconfed\x12\x1c\n" +
+	"\n" +
+	"ads_rib_in\x18\x10 \x01(\x04R\badsRibIn\x12\x1b\n" +
+	"\tlocal_rib\x18\x11 \x01(\x04R\blocalRib\x12.\n" +
+	"\x13updates_as_withdraw\x18\x12 \x01(\rR\x11updatesAsWithdraw\x120\n" +
+	"\x14prefixes_as_withdraw\x18\x13 \x01(\rR\x12prefixesAsWithdraw\x12:\n" +
+	"\x19prefixes_rejected_inbound\x18\x14 \x01(\rR\x17prefixesRejectedInbound\x12D\n" +
+	"\x13per_afi_adj_ribs_in\x18\x15 \x03(\v2\x16.gobmp.api.AFISAFIStatR\x0fperAfiAdjRibsIn\x12=\n" +
+	"\x0fper_afi_loc_rib\x18\x16 \x03(\v2\x16.gobmp.api.AFISAFIStatR\fperAfiLocRib\x12+\n" +
+	"\x11duplicate_updates\x18\x17 \x01(\rR\x10duplicateUpdates\x122\n" +
+	"\x16pre_policy_adj_rib_out\x18\x18 \x01(\x04R\x12prePolicyAdjRibOut\x124\n" +
+	"\x17post_policy_adj_rib_out\x18\x19 \x01(\x04R\x13postPolicyAdjRibOut\x12X\n" +
+	"\x1eper_afi_pre_policy_adj_rib_out\x18\x1a \x03(\v2\x16.gobmp.api.AFISAFIStatR\x18perAfiPrePolicyAdjRibOut\x12Z\n" +
+	"\x1fper_afi_post_policy_adj_rib_out\x18\x1b \x03(\v2\x16.gobmp.api.AFISAFIStatR\x19perAfiPostPolicyAdjRibOut\x12\x1b\n" +
+	"\tpeer_hash\x18\x1c \x01(\tR\bpeerHash\x12A\n" +
+	"\vuser_labels\x18\x1d \x03(\v2 .gobmp.api.Stats.UserLabelsEntryR\n" +
+	"userLabels\x1a=\n" +
+	"\x0fUserLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"I\n" +
+	"\vAFISAFIStat\x12\x10\n" +
+	"\x03afi\x18\x01 \x01(\rR\x03afi\x12\x12\n" +
+	"\x04safi\x18\x02 \x01(\rR\x04safi\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x04R\x05count\"\xa0\x05\n" +
+	"\tRouteLeak\x12\x1f\n" +
+	"\vrouter_hash\x18\x01 \x01(\tR\n" +
+	"routerHash\x12\x1b\n" +
+	"\trouter_ip\x18\x02 \x01(\tR\brouterIp\x12\x1b\n" +
+	"\tpeer_hash\x18\x03 \x01(\tR\bpeerHash\x12\x17\n" +
+	"\apeer_ip\x18\x04 \x01(\tR\x06peerIp\x12\x19\n" +
+	"\bpeer_asn\x18\x05 \x01(\rR\apeerAsn\x12\x1b\n" +
+	"\tpeer_role\x18\x06 \x01(\tR\bpeerRole\x12\x16\n" +
+	"\x06prefix\x18\a \x01(\tR\x06prefix\x12\x1d\n" +
+	"\n" +
+	"prefix_len\x18\b \x01(\x05R\tprefixLen\x12\x17\n" +
+	"\ais_ipv4\x18\t \x01(\bR\x06isIpv4\x12\x17\n" +
+	"\apath_id\x18\n" +
+	" \x01(\x05R\x06pathId\x12\x10\n" +
+	"\x03otc\x18\v \x01(\rR\x03otc\x12\x17\n" +
+	"\aas_path\x18\f \x03(\rR\x06asPath\x12\x16\n" +
+	"\x06reason\x18\r \x01(\tR\x06reason\x127\n" +
+	"\x19is_adj_rib_in_post_policy\x18\x0e \x01(\bR\x14isAdjRibInPostPolicy\x12#\n" +
+	"\x0eis_adj_rib_out\x18\x0f \x01(\bR\visAdjRibOut\x129\n" +
+	"\x1ais_adj_rib_out_post_policy\x18\x10 \x01(\bR\x15isAdjRibOutPostPolicy\x12\x1c\n" +
+	"\ttimestamp\x18\x11 \x01(\tR\ttimestamp\x12E\n" +
+	"\vuser_labels\x18\x12 \x03(\v2$.gobmp.api.RouteLeak.UserLabelsEntryR\n" +
+	"userLabels\x1a=\n" +
+	"\x0fUserLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xa7\x04\n" +
+	"\bPeerSync\x12\x1f\n" +
+	"\vrouter_hash\x18\x01 \x01(\tR\n" +
+	"routerHash\x12\x1b\n" +
+	"\trouter_ip\x18\x02 \x01(\tR\brouterIp\x12\x1b\n" +
+	"\tpeer_hash\x18\x03 \x01(\tR\bpeerHash\x12\x17\n" +
+	"\apeer_ip\x18\x04 \x01(\tR\x06peerIp\x12\x19\n" +
+	"\bpeer_asn\x18\x05 \x01(\rR\apeerAsn\x12\x1b\n" +
+	"\tpeer_type\x18\x06 \x01(\rR\bpeerType\x12\x17\n" +
+	"\apeer_rd\x18\a \x01(\tR\x06peerRd\x12\x10\n" +
+	"\x03afi\x18\b \x01(\rR\x03afi\x12\x12\n" +
+	"\x04safi\x18\t \x01(\rR\x04safi\x12\x10\n" +
+	"\x03rib\x18\n" +
+	" \x01(\tR\x03rib\x12 \n" +
+	"\ftime_to_sync\x18\v \x01(\x01R\n" +
+	"timeToSync\x12\x1a\n" +
+	"\bprefixes\x18\f \x01(\x04R\bprefixes\x12)\n" +
+	"\x10graceful_restart\x18\r \x01(\bR\x0fgracefulRestart\x12\x12\n" +
+	"\x04llgr\x18\x0e \x01(\bR\x04llgr\x12\x1c\n" +
+	"\ttimestamp\x18\x0f \x01(\tR\ttimestamp\x12D\n" +
+	"\vuser_labels\x18\x10 \x03(\v2#.gobmp.api.PeerSync.UserLabelsEntryR\n" +
+	"userLabels\x1a=\n" +
+	"\x0fUserLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xa6\x03\n" +
+	"\rBaseAttribute\x12\x1f\n" +
+	"\vrouter_hash\x18\x01 \x01(\tR\n" +
+	"routerHash\x12\x1b\n" +
+	"\trouter_ip\x18\x02 \x01(\tR\brouterIp\x12\x1b\n" +
+	"\tpeer_hash\x18\x03 \x01(\tR\bpeerHash\x12\x17\n" +
+	"\apeer_ip\x18\x04 \x01(\tR\x06peerIp\x12\x19\n" +
+	"\bpeer_asn\x18\x05 \x01(\rR\apeerAsn\x12$\n" +
+	"\x0ebase_attr_hash\x18\x06 \x01(\tR\fbaseAttrHash\x128\n" +
+	"\n" +
+	"base_attrs\x18\a \x01(\v2\x19.gobmp.api.BaseAttributesR\tbaseAttrs\x12\x1c\n" +
+	"\ttimestamp\x18\b \x01(\tR\ttimestamp\x12I\n" +
+	"\vuser_labels\x18\t \x03(\v2(.gobmp.api.BaseAttribute.UserLabelsEntryR\n" +
+	"userLabels\x1a=\n" +
+	"\x0fUserLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xd1\x02\n" +
+	"\rTopologyEvent\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x122\n" +
+	"\btopology\x18\x03 \x01(\v2\x16.gobmp.api.TopologyKeyR\btopology\x12\x1c\n" +
+	"\ttimestamp\x18\x04 \x01(\tR\ttimestamp\x12+\n" +
+	"\x04node\x18\x05 \x01(\v2\x17.gobmp.api.TopologyNodeR\x04node\x12+\n" +
+	"\x04link\x18\x06 \x01(\v2\x17.gobmp.api.TopologyLinkR\x04link\x121\n" +
+	"\x06prefix\x18\a \x01(\v2\x19.gobmp.api.TopologyPrefixR\x06prefix\x125\n" +
+	"\bsrv6_sid\x18\b \x01(\v2\x1a.gobmp.api.TopologySRv6SIDR\asrv6Sid\"y\n" +
+	"\vTopologyKey\x12\x1b\n" +
+	"\tdomain_id\x18\x01 \x01(\x03R\bdomainId\x12\x1f\n" +
+	"\vprotocol_id\x18\x02 \x01(\rR\n" +
+	"protocolId\x12\x17\n" +
+	"\aarea_id\x18\x03 \x01(\tR\x06areaId\x12\x13\n" +
+	"\x05mt_id\x18\x04 \x01(\rR\x04mtId\"\xa3\x03\n" +
+	"\fTopologyNode\x12\"\n" +
+	"\rigp_router_id\x18\x01 \x01(\tR\vigpRouterId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
+	"\trouter_id\x18\x03 \x01(\tR\brouterId\x12\x10\n" +
+	"\x03asn\x18\x04 \x01(\rR\x03asn\x12!\n" +
+	"\fsr_algorithm\x18\x05 \x03(\x03R\vsrAlgorithm\x12O\n" +
+	"\x14flex_algo_definition\x18\x06 \x03(\v2\x1d.gobmp.api.FlexAlgoDefinitionR\x12flexAlgoDefinition\x121\n" +
+	"\x04srgb\x18\a \x03(\v2\x1d.gobmp.api.TopologyLabelRangeR\x04srgb\x121\n" +
+	"\x04srlb\x18\b \x03(\v2\x1d.gobmp.api.TopologyLabelRangeR\x04srlb\x125\n" +
+	"\bprefixes\x18\t \x03(\v2\x19.gobmp.api.TopologyPrefixR\bprefixes\x12\x1b\n" +
+	"\tsrv6_sids\x18\n" +
+	" \x03(\tR\bsrv6Sids\"<\n" +
+	"\x12TopologyLabelRange\x12\x12\n" +
+	"\x04base\x18\x01 \x01(\rR\x04base\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\rR\x04size\"\xb3\x02\n" +
+	"\x0eTopologyPrefix\x12\x12\n" +
+	"\x04node\x18\x01 \x01(\tR\x04node\x12\x16\n" +
+	"\x06prefix\x18\x02 \x01(\tR\x06prefix\x12\x1d\n" +
+	"\n" +
+	"prefix_len\x18\x03 \x01(\x05R\tprefixLen\x12#\n" +
+	"\rprefix_metric\x18\x04 \x01(\rR\fprefixMetric\x12V\n" +
+	"\x17flex_algo_prefix_metric\x18\x05 \x03(\v2\x1f.gobmp.api.FlexAlgoPrefixMetricR\x14flexAlgoPrefixMetric\x126\n" +
+	"\n" +
+	"prefix_sid\x18\x06 \x03(\v2\x17.gobmp.api.PrefixSIDTLVR\tprefixSid\x12!\n" +
+	"\fsrv6_locator\x18\a \x01(\bR\vsrv6Locator\"\xbf\x04\n" +
+	"\fTopologyLink\x12\x1d\n" +
+	"\n" +
+	"local_node\x18\x01 \x01(\tR\tlocalNode\x12\x1f\n" +
+	"\vremote_node\x18\x02 \x01(\tR\n" +
+	"remoteNode\x12\"\n" +
+	"\rlocal_link_ip\x18\x03 \x01(\tR\vlocalLinkIp\x12$\n" +
+	"\x0eremote_link_ip\x18\x04 \x01(\tR\fremoteLinkIp\x12\"\n" +
+	"\rlocal_link_id\x18\x05 \x01(\rR\vlocalLinkId\x12$\n" +
+	"\x0eremote_link_id\x18\x06 \x01(\rR\fremoteLinkId\x12\x1d\n" +
+	"\n" +
+	"igp_metric\x18\a \x01(\rR\tigpMetric\x12\x1b\n" +
+	"\tte_metric\x18\b \x01(\rR\bteMetric\x12\x14\n" +
+	"\x05delay\x18\t \x01(\rR\x05delay\x12\x1f\n" +
+	"\vadmin_group\x18\n" +
+	" \x01(\rR\n" +
+	"adminGroup\x12&\n" +
+	"\x0fext_admin_group\x18\v \x03(\rR\rextAdminGroup\x12\x12\n" +
+	"\x04srlg\x18\f \x03(\rR\x04srlg\x123\n" +
+	"\aadj_sid\x18\r \x03(\v2\x1a.gobmp.api.AdjacencySIDTLVR\x06adjSid\x12\"\n" +
+	"\rsrv6_endx_sid\x18\x0e \x03(\tR\vsrv6EndxSid\x12S\n" +
+	"\x14flex_algo_attributes\x18\x0f \x01(\v2!.gobmp.api.TopologyLinkAttributesR\x12flexAlgoAttributes\"\xa8\x01\n" +
+	"\x16TopologyLinkAttributes\x12\x1b\n" +
+	"\tte_metric\x18\x01 \x01(\rR\bteMetric\x12\x14\n" +
+	"\x05delay\x18\x02 \x01(\rR\x05delay\x12\x1f\n" +
+	"\vadmin_group\x18\x03 \x01(\rR\n" +
+	"adminGroup\x12&\n" +
+	"\x0fext_admin_group\x18\x04 \x03(\rR\rextAdminGroup\x12\x12\n" +
+	"\x04srlg\x18\x05 \x03(\rR\x04srlg\"@\n" +
+	"\x0fTopologySRv6SID\x12\x12\n" +
+	"\x04node\x18\x01 \x01(\tR\x04node\x12\x19\n" +
+	"\bsrv6_sid\x18\x02 \x01(\tR\asrv6Sid\"\xd0\x02\n" +
+	"\x10ResolvedSRPolicy\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12\x12\n" +
+	"\x04hash\x18\x02 \x01(\tR\x04hash\x12\x1b\n" +
+	"\trouter_ip\x18\x03 \x01(\tR\brouterIp\x12\x17\n" +
+	"\apeer_ip\x18\x04 \x01(\tR\x06peerIp\x12\x1c\n" +
+	"\ttimestamp\x18\x05 \x01(\tR\ttimestamp\x12$\n" +
+	"\rdistinguisher\x18\x06 \x01(\rR\rdistinguisher\x12\x14\n" +
+	"\x05color\x18\a \x01(\rR\x05color\x12\x1a\n" +
+	"\bendpoint\x18\b \x01(\tR\bendpoint\x12\x1f\n" +
+	"\vpolicy_name\x18\t \x01(\tR\n" +
+	"policyName\x12C\n" +
+	"\rsegment_lists\x18\n" +
+	" \x03(\v2\x1e.gobmp.api.ResolvedSegmentListR\fsegmentLists\"\xa5\x01\n" +
+	"\x13ResolvedSegmentList\x12\x16\n" +
+	"\x06weight\x18\x01 \x01(\rR\x06weight\x122\n" +
+	"\btopology\x18\x02 \x01(\v2\x16.gobmp.api.TopologyKeyR\btopology\x12*\n" +
+	"\x04hops\x18\x03 \x03(\v2\x16.gobmp.api.TopologyHopR\x04hops\x12\x16\n" +
+	"\x06errors\x18\x04 \x03(\tR\x06errors\"\xe6\x01\n" +
+	"\vTopologyHop\x12\x18\n" +
+	"\asegment\x18\x01 \x01(\x03R\asegment\x12!\n" +
+	"\fsegment_type\x18\x02 \x01(\tR\vsegmentType\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12\x10\n" +
+	"\x03sid\x18\x04 \x01(\tR\x03sid\x12\x12\n" +
+	"\x04node\x18\x05 \x01(\tR\x04node\x12\x1b\n" +
+	"\tnode_name\x18\x06 \x01(\tR\bnodeName\x12+\n" +
+	"\x04link\x18\a \x01(\v2\x17.gobmp.api.TopologyLinkR\x04link\x12\x16\n" +
+	"\x06prefix\x18\b \x01(\tR\x06prefix\"\x90\x03\n" +
+	"\n" +
+	"ChurnStats\x12\x1f\n" +
+	"\vrouter_hash\x18\x01 \x01(\tR\n" +
+	"routerHash\x12\x1b\n" +
+	"\trouter_ip\x18\x02 \x01(\tR\brouterIp\x12\x17\n" +
+	"\apeer_ip\x18\x03 \x01(\tR\x06peerIp\x12\x19\n" +
+	"\bpeer_asn\x18\x04 \x01(\rR\apeerAsn\x12\x10\n" +
+	"\x03rib\x18\x05 \x01(\tR\x03rib\x12\x10\n" +
+	"\x03afi\x18\x06 \x01(\tR\x03afi\x12\x1c\n" +
+	"\ttimestamp\x18\a \x01(\tR\ttimestamp\x12#\n" +
+	"\rinterval_secs\x18\b \x01(\x01R\fintervalSecs\x12\x18\n" +
+	"\aupdates\x18\t \x01(\x04R\aupdates\x12\x1c\n" +
+	"\twithdraws\x18\n" +
+	" \x01(\x04R\twithdraws\x12\x1f\n" +
+	"\vupdate_rate\x18\v \x01(\x01R\n" +
+	"updateRate\x12#\n" +
+	"\rwithdraw_rate\x18\f \x01(\x01R\fwithdrawRate\x12+\n" +
+	"\x11flapping_prefixes\x18\r \x01(\x03R\x10flappingPrefixes\"\xee\x02\n" +
+	"\tFlapEvent\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12\x1f\n" +
+	"\vrouter_hash\x18\x02 \x01(\tR\n" +
+	"routerHash\x12\x1b\n" +
+	"\trouter_ip\x18\x03 \x01(\tR\brouterIp\x12\x17\n" +
+	"\apeer_ip\x18\x04 \x01(\tR\x06peerIp\x12\x19\n" +
+	"\bpeer_asn\x18\x05 \x01(\rR\apeerAsn\x12\x10\n" +
+	"\x03rib\x18\x06 \x01(\tR\x03rib\x12\x10\n" +
+	"\x03afi\x18\a \x01(\tR\x03afi\x12\x15\n" +
+	"\x06vpn_rd\x18\b \x01(\tR\x05vpnRd\x12\x16\n" +
+	"\x06prefix\x18\t \x01(\tR\x06prefix\x12\x1d\n" +
+	"\n" +
+	"prefix_len\x18\n" +
+	" \x01(\x05R\tprefixLen\x12\x17\n" +
+	"\apath_id\x18\v \x01(\x05R\x06pathId\x12\x18\n" +
+	"\apenalty\x18\f \x01(\x01R\apenalty\x12\x14\n" +
+	"\x05flaps\x18\r \x01(\x03R\x05flaps\x12\x1c\n" +
+	"\ttimestamp\x18\x0e \x01(\tR\ttimestamp\"\xf6\x02\n" +
+	"\vHijackEvent\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x16\n" +
+	"\x06prefix\x18\x03 \x01(\tR\x06prefix\x12!\n" +
+	"\fowned_prefix\x18\x04 \x01(\tR\vownedPrefix\x12\x1b\n" +
+	"\torigin_as\x18\x05 \x01(\rR\boriginAs\x12 \n" +
+	"\ffirst_hop_as\x18\x06 \x01(\rR\n" +
+	"firstHopAs\x12\x18\n" +
+	"\aorigins\x18\a \x03(\rR\aorigins\x12\x1f\n" +
+	"\vrouter_hash\x18\b \x01(\tR\n" +
+	"routerHash\x12\x1b\n" +
+	"\trouter_ip\x18\t \x01(\tR\brouterIp\x12\x17\n" +
+	"\apeer_ip\x18\n" +
+	" \x01(\tR\x06peerIp\x12\x19\n" +
+	"\bpeer_asn\x18\v \x01(\rR\apeerAsn\x12\x17\n" +
+	"\aas_path\x18\f \x03(\rR\x06asPath\x12\x1c\n" +
+	"\ttimestamp\x18\r \x01(\tR\ttimestamp\"\xfb\x03\n" +
+	"\rProtocolFlags\x12\x14\n" +
+	"\x05flags\x18\x01 \x01(\rR\x05flags\x12\x15\n" +
+	"\x06a_flag\x18\x02 \x01(\bR\x05aFlag\x12\x15\n" +
+	"\x06b_flag\x18\x03 \x01(\bR\x05bFlag\x12\x15\n" +
+	"\x06d_flag\x18\x04 \x01(\bR\x05dFlag\x12\x17\n" +
+	"\adn_flag\x18\x05 \x01(\bR\x06dnFlag\x12\x15\n" +
+	"\x06e_flag\x18\x06 \x01(\bR\x05eFlag\x12\x15\n" +
+	"\x06f_flag\x18\a \x01(\bR\x05fFlag\x12\x15\n" +
+	"\x06g_flag\x18\b \x01(\bR\x05gFlag\x12\x15\n" +
+	"\x06i_flag\x18\t \x01(\bR\x05iFlag\x12\x17\n" +
+	"\aia_flag\x18\n" +
+	" \x01(\bR\x06iaFlag\x12\x15\n" +
+	"\x06l_flag\x18\v \x01(\bR\x05lFlag\x12\x17\n" +
+	"\ala_flag\x18\f \x01(\bR\x06laFlag\x12\x15\n" +
+	"\x06m_flag\x18\r \x01(\bR\x05mFlag\x12\x15\n" +
+	"\x06n_flag\x18\x0e \x01(\bR\x05nFlag\x12\x17\n" +
+	"\anp_flag\x18\x0f \x01(\bR\x06npFlag\x12\x17\n" +
+	"\anu_flag\x18\x10 \x01(\bR\x06nuFlag\x12\x15\n" +
+	"\x06p_flag\x18\x11 \x01(\bR\x05pFlag\x12\x15\n" +
+	"\x06r_flag\x18\x12 \x01(\bR\x05rFlag\x12\x15\n" +
+	"\x06s_flag\x18\x13 \x01(\bR\x05sFlag\x12\x15\n" +
+	"\x06v_flag\x18\x14 \x01(\bR\x05vFlag\x12\x15\n" +
+	"\x06x_flag\x18\x15 \x01(\bR\x05xFlag\"N\n" +
+	"\n" +
+	"SRv6SubTLV\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\rR\x04type\x12\x16\n" +
+	"\x06length\x18\x02 \x01(\rR\x06length\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\fR\x05value\"c\n" +
+	"\x0fBindingSIDValue\x12\x14\n" +
+	"\x05flags\x18\x01 \x01(\rR\x05flags\x12\x1d\n" +
+	"\n" +
+	"label_bsid\x18\x02 \x01(\rR\tlabelBsid\x12\x1b\n" +
+	"\tsrv6_bsid\x18\x03 \x01(\fR\bsrv6Bsid\"\xc0\x05\n" +
+	"\aSegment\x12!\n" +
+	"\fsegment_type\x18\x01 \x01(\x05R\vsegmentType\x12-\n" +
+	"\x05flags\x18\x02 \x01(\v2\x17.gobmp.api.SegmentFlagsR\x05flags\x12\x14\n" +
+	"\x05label\x18\x03 \x01(\rR\x05label\x12\x0e\n" +
+	"\x02tc\x18\x04 \x01(\rR\x02tc\x12\f\n" +
+	"\x01s\x18\x05 \x01(\bR\x01s\x12\x10\n" +
+	"\x03ttl\x18\x06 \x01(\rR\x03ttl\x12\x19\n" +
+	"\bsrv6_sid\x18\a \x01(\fR\asrv6Sid\x12!\n" +
+	"\fsr_algorithm\x18\b \x01(\rR\vsrAlgorithm\x12!\n" +
+	"\fipv4_address\x18\t \x01(\fR\vipv4Address\x12!\n" +
+	"\fipv6_address\x18\n" +
+	" \x01(\fR\vipv6Address\x12\x15\n" +
+	"\x03sid\x18\v \x01(\rH\x00R\x03sid\x88\x01\x01\x12,\n" +
+	"\x12local_interface_id\x18\f \x01(\rR\x10localInterfaceId\x12.\n" +
+	"\x13remote_interface_id\x18\r \x01(\rR\x11remoteInterfaceId\x12,\n" +
+	"\x12local_ipv4_address\x18\x0e \x01(\fR\x10localIpv4Address\x12.\n" +
+	"\x13remote_ipv4_address\x18\x0f \x01(\fR\x11remoteIpv4Address\x12,\n" +
+	"\x12local_ipv6_address\x18\x10 \x01(\fR\x10localIpv6Address\x12.\n" +
+	"\x13remote_ipv6_address\x18\x11 \x01(\fR\x11remoteIpv6Address\x12*\n" +
+	"\x11ipv6_node_address\x18\x12 \x01(\fR\x0fipv6NodeAddress\x124\n" +
+	"\x16srv6_endpoint_behavior\x18\x13 \x01(\fR\x14srv6EndpointBehaviorB\x06\n" +
+	"\x04_sid\"j\n" +
+	"\fSegmentFlags\x12\x15\n" +
+	"\x06v_flag\x18\x01 \x01(\bR\x05vFlag\x12\x15\n" +
+	"\x06a_flag\x18\x02 \x01(\bR\x05aFlag\x12\x15\n" +
+	"\x06s_flag\x18\x03 \x01(\bR\x05sFlag\x12\x15\n" +
+	"\x06b_flag\x18\x04 \x01(\bR\x05bFlag\"\xba\x01\n" +
+	"\fFlowspecSpec\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\rR\x04type\x12\x1d\n" +
+	"\n" +
+	"prefix_len\x18\x02 \x01(\rR\tprefixLen\x12#\n" +
+	"\rprefix_offset\x18\x03 \x01(\rR\fprefixOffset\x12\x16\n" +
+	"\x06prefix\x18\x04 \x01(\fR\x06prefix\x12:\n" +
+	"\fop_val_pairs\x18\x05 \x03(\v2\x18.gobmp.api.FlowspecOpValR\n" +
+	"opValPairs\"^\n" +
+	"\rFlowspecOpVal\x127\n" +
+	"\boperator\x18\x01 \x01(\v2\x1b.gobmp.api.FlowspecOperatorR\boperator\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\fR\x05value\"\xcb\x01\n" +
+	"\x10FlowspecOperator\x12%\n" +
+	"\x0fend_of_list_bit\x18\x01 \x01(\bR\fendOfListBit\x12\x17\n" +
+	"\aand_bit\x18\x02 \x01(\bR\x06andBit\x12!\n" +
+	"\fvalue_length\x18\x03 \x01(\rR\vvalueLength\x12\x1b\n" +
+	"\tless_than\x18\x04 \x01(\bR\blessThan\x12!\n" +
+	"\fgreater_than\x18\x05 \x01(\bR\vgreaterThan\x12\x14\n" +
+	"\x05equal\x18\x06 \x01(\bR\x05equalB#Z!github.com/sbezverk/gobmp/pkg/apib\x06proto3"
+
+var (
+	file_pkg_api_messages_proto_rawDescOnce sync.Once
+	file_pkg_api_messages_proto_rawDescData []byte
+)
+
+func file_pkg_api_messages_proto_rawDescGZIP() []byte {
+	file_pkg_api_messages_proto_rawDescOnce.Do(func() {
+		file_pkg_api_messages_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_pkg_api_messages_proto_rawDesc), len(file_pkg_api_messages_proto_rawDesc)))
+	})
+	return file_pkg_api_messages_proto_rawDescData
+}
+
+var file_pkg_api_messages_proto_msgTypes = make([]protoimpl.MessageInfo, 138)
+var file_pkg_api_messages_proto_goTypes = []any{
+	(*PeerStateChange)(nil),            // 0: gobmp.api.PeerStateChange
+	(*CapabilityData)(nil),             // 1: gobmp.api.CapabilityData
+	(*UnicastPrefix)(nil),              // 2: gobmp.api.UnicastPrefix
+	(*BaseAttributes)(nil),             // 3: gobmp.api.BaseAttributes
+	(*ASPathSegment)(nil),              // 4: gobmp.api.ASPathSegment
+	(*ExtCommunityDetail)(nil),         // 5: gobmp.api.ExtCommunityDetail
+	(*PMSITunnel)(nil),                 // 6: gobmp.api.PMSITunnel
+	(*TunnelEncapsulation)(nil),        // 7: gobmp.api.TunnelEncapsulation
+	(*Tunnel)(nil),                     // 8: gobmp.api.Tunnel
+	(*TunnelSubTLV)(nil),               // 9: gobmp.api.TunnelSubTLV
+	(*AIGP)(nil),                       // 10: gobmp.api.AIGP
+	(*AIGPTLV)(nil),                    // 11: gobmp.api.AIGPTLV
+	(*BGPPrefixSID)(nil),               // 12: gobmp.api.BGPPrefixSID
+	(*BGPPrefixSIDTLV)(nil),            // 13: gobmp.api.BGPPrefixSIDTLV
+	(*BGPLabelIndexTLV)(nil),           // 14: gobmp.api.BGPLabelIndexTLV
+	(*BGPOriginatorSRGBTLV)(nil),       // 15: gobmp.api.BGPOriginatorSRGBTLV
+	(*SRGBRange)(nil),                  // 16: gobmp.api.SRGBRange
+	(*DPath)(nil),                      // 17: gobmp.api.DPath
+	(*DPathSegment)(nil),               // 18: gobmp.api.DPathSegment
+	(*DPathDomain)(nil),                // 19: gobmp.api.DPathDomain
+	(*SFP)(nil),                        // 20: gobmp.api.SFP
+	(*SFPAssociation)(nil),             // 21: gobmp.api.SFPAssociation
+	(*SFPPath)(nil),                    // 22: gobmp.api.SFPPath
+	(*SFPHop)(nil),                     // 23: gobmp.api.SFPHop
+	(*SFPSFT)(nil),                     // 24: gobmp.api.SFPSFT
+	(*SFPTLV)(nil),                     // 25: gobmp.api.SFPTLV
+	(*BFDDiscriminator)(nil),           // 26: gobmp.api.BFDDiscriminator
+	(*BFDDTLV)(nil),                    // 27: gobmp.api.BFDDTLV
+	(*AttrSet)(nil),                    // 28: gobmp.api.AttrSet
+	(*UnknownPathAttribute)(nil),       // 29: gobmp.api.UnknownPathAttribute
+	(*PathAttributeError)(nil),         // 30: gobmp.api.PathAttributeError
+	(*PSid)(nil),                       // 31: gobmp.api.PSid
+	(*PrefixSIDLabelIndexTLV)(nil),     // 32: gobmp.api.PrefixSIDLabelIndexTLV
+	(*PrefixSIDOriginatorSRGBTLV)(nil), // 33: gobmp.api.PrefixSIDOriginatorSRGBTLV
+	(*SRGB)(nil),                       // 34: gobmp.api.SRGB
+	(*L3Service)(nil),                  // 35: gobmp.api.L3Service
+	(*L2Service)(nil),                  // 36: gobmp.api.L2Service
+	(*LSNode)(nil),                     // 37: gobmp.api.LSNode
+	(*MultiTopologyIdentifier)(nil),    // 38: gobmp.api.MultiTopologyIdentifier
+	(*NodeAttrFlags)(nil),              // 39: gobmp.api.NodeAttrFlags
+	(*SRCapability)(nil),               // 40: gobmp.api.SRCapability
+	(*SRCapabilitySubTLV)(nil),         // 41: gobmp.api.SRCapabilitySubTLV
+	(*LocalBlock)(nil),                 // 42: gobmp.api.LocalBlock
+	(*LocalBlockTLV)(nil),              // 43: gobmp.api.LocalBlockTLV
+	(*SRv6CapabilityTLV)(nil),          // 44: gobmp.api.SRv6CapabilityTLV
+	(*MSDTV)(nil),                      // 45: gobmp.api.MSDTV
+	(*FlexAlgoDefinition)(nil),         // 46: gobmp.api.FlexAlgoDefinition
+	(*FADSubTLV)(nil),                  // 47: gobmp.api.FADSubTLV
+	(*FADSubTLVFlags)(nil),             // 48: gobmp.api.FADSubTLVFlags
+	(*LSLink)(nil),                     // 49: gobmp.api.LSLink
+	(*PeerSID)(nil),                    // 50: gobmp.api.PeerSID
+	(*PeerFlags)(nil),                  // 51: gobmp.api.PeerFlags
+	(*BGPPeerNodeSID)(nil),             // 52: gobmp.api.BGPPeerNodeSID
+	(*BGPPeerNodeFlags)(nil),           // 53: gobmp.api.BGPPeerNodeFlags
+	(*EndXSIDTLV)(nil),                 // 54: gobmp.api.EndXSIDTLV
+	(*EndXSIDFlags)(nil),               // 55: gobmp.api.EndXSIDFlags
+	(*AdjacencySIDTLV)(nil),            // 56: gobmp.api.AdjacencySIDTLV
+	(*AppSpecLinkAttr)(nil),            // 57: gobmp.api.AppSpecLinkAttr
+	(*SubTLV)(nil),                     // 58: gobmp.api.SubTLV
+	(*L2BundleMember)(nil),             // 59: gobmp.api.L2BundleMember
+	(*MulticastPrefix)(nil),            // 60: gobmp.api.MulticastPrefix
+	(*MCASTVPNPrefix)(nil),             // 61: gobmp.api.MCASTVPNPrefix
+	(*RTCPrefix)(nil),                  // 62: gobmp.api.RTCPrefix
+	(*L3VPNPrefix)(nil),                // 63: gobmp.api.L3VPNPrefix
+	(*LSPrefix)(nil),                   // 64: gobmp.api.LSPrefix
+	(*IGPFlags)(nil),                   // 65: gobmp.api.IGPFlags
+	(*PrefixAttrTLVs)(nil),             // 66: gobmp.api.PrefixAttrTLVs
+	(*PrefixSIDTLV)(nil),               // 67: gobmp.api.PrefixSIDTLV
+	(*RangeTLV)(nil),                   // 68: gobmp.api.RangeTLV
+	(*FlexAlgoPrefixMetric)(nil),       // 69: gobmp.api.FlexAlgoPrefixMetric
+	(*LocatorTLV)(nil),                 // 70: gobmp.api.LocatorTLV
+	(*LocatorFlags)(nil),               // 71: gobmp.api.LocatorFlags
+	(*LSSRv6SID)(nil),                  // 72: gobmp.api.LSSRv6SID
+	(*EndpointBehavior)(nil),           // 73: gobmp.api.EndpointBehavior
+	(*SIDStructure)(nil),               // 74: gobmp.api.SIDStructure
+	(*EVPNPrefix)(nil),                 // 75: gobmp.api.EVPNPrefix
+	(*ECMACMobility)(nil),              // 76: gobmp.api.ECMACMobility
+	(*ECESILabel)(nil),                 // 77: gobmp.api.ECESILabel
+	(*ECLayer2Attributes)(nil),         // 78: gobmp.api.ECLayer2Attributes
+	(*ECDFElection)(nil),               // 79: gobmp.api.ECDFElection
+	(*VPLSPrefix)(nil),                 // 80: gobmp.api.VPLSPrefix
+	(*SRPolicy)(nil),                   // 81: gobmp.api.SRPolicy
+	(*BindingSID)(nil),                 // 82: gobmp.api.BindingSID
+	(*SRv6BindingSID)(nil),             // 83: gobmp.api.SRv6BindingSID
+	(*SRv6EndpointBehavior)(nil),       // 84: gobmp.api.SRv6EndpointBehavior
+	(*Preference)(nil),                 // 85: gobmp.api.Preference
+	(*ENLP)(nil),                       // 86: gobmp.api.ENLP
+	(*SegmentList)(nil),                // 87: gobmp.api.SegmentList
+	(*Weight)(nil),                     // 88: gobmp.api.Weight
+	(*Flowspec)(nil),                   // 89: gobmp.api.Flowspec
+	(*Stats)(nil),                      // 90: gobmp.api.Stats
+	(*AFISAFIStat)(nil),                // 91: gobmp.api.AFISAFIStat
+	(*RouteLeak)(nil),                  // 92: gobmp.api.RouteLeak
+	(*PeerSync)(nil),                   // 93: gobmp.api.PeerSync
+	(*BaseAttribute)(nil),              // 94: gobmp.api.BaseAttribute
+	(*TopologyEvent)(nil),              // 95: gobmp.api.TopologyEvent
+	(*TopologyKey)(nil),                // 96: gobmp.api.TopologyKey
+	(*TopologyNode)(nil),               // 97: gobmp.api.TopologyNode
+	(*TopologyLabelRange)(nil),         // 98: gobmp.api.TopologyLabelRange
+	(*TopologyPrefix)(nil),             // 99: gobmp.api.TopologyPrefix
+	(*TopologyLink)(nil),               // 100: gobmp.api.TopologyLink
+	(*TopologyLinkAttributes)(nil),     // 101: gobmp.api.TopologyLinkAttributes
+	(*TopologySRv6SID)(nil),            // 102: gobmp.api.TopologySRv6SID
+	(*ResolvedSRPolicy)(nil),           // 103: gobmp.api.ResolvedSRPolicy
+	(*ResolvedSegmentList)(nil),        // 104: gobmp.api.ResolvedSegmentList
+	(*TopologyHop)(nil),                // 105: gobmp.api.TopologyHop
+	(*ChurnStats)(nil),                 // 106: gobmp.api.ChurnStats
+	(*FlapEvent)(nil),                  // 107: gobmp.api.FlapEvent
+	(*HijackEvent)(nil),                // 108: gobmp.api.HijackEvent
+	(*ProtocolFlags)(nil),              // 109: gobmp.api.ProtocolFlags
+	(*SRv6SubTLV)(nil),                 // 110: gobmp.api.SRv6SubTLV
+	(*BindingSIDValue)(nil),            // 111: gobmp.api.BindingSIDValue
+	(*Segment)(nil),                    // 112: gobmp.api.Segment
+	(*SegmentFlags)(nil),               // 113: gobmp.api.SegmentFlags
+	(*FlowspecSpec)(nil),               // 114: gobmp.api.FlowspecSpec
+	(*FlowspecOpVal)(nil),              // 115: gobmp.api.FlowspecOpVal
+	(*FlowspecOperator)(nil),           // 116: gobmp.api.FlowspecOperator
+	nil,                                // 117: gobmp.api.PeerStateChange.AdvCapEntry
+	nil,                                // 118: gobmp.api.PeerStateChange.RecvCapEntry
+	nil,                                // 119: gobmp.api.PeerStateChange.UserLabelsEntry
+	nil,                                // 120: gobmp.api.UnicastPrefix.UserLabelsEntry
+	nil,                                // 121: gobmp.api.L3Service.SubTlvsEntry
+	nil,                                // 122: gobmp.api.LSNode.UserLabelsEntry
+	nil,                                // 123: gobmp.api.LSLink.UserLabelsEntry
+	nil,                                // 124: gobmp.api.MulticastPrefix.UserLabelsEntry
+	nil,                                // 125: gobmp.api.MCASTVPNPrefix.UserLabelsEntry
+	nil,                                // 126: gobmp.api.RTCPrefix.UserLabelsEntry
+	nil,                                // 127: gobmp.api.L3VPNPrefix.UserLabelsEntry
+	nil,                                // 128: gobmp.api.LSPrefix.UserLabelsEntry
+	nil,                                // 129: gobmp.api.LSSRv6SID.UserLabelsEntry
+	nil,                                // 130: gobmp.api.EVPNPrefix.UserLabelsEntry
+	nil,                                // 131: gobmp.api.VPLSPrefix.UserLabelsEntry
+	nil,                                // 132: gobmp.api.SRPolicy.UserLabelsEntry
+	nil,                                // 133: gobmp.api.Flowspec.UserLabelsEntry
+	nil,                                // 134: gobmp.api.Stats.UserLabelsEntry
+	nil,                                // 135: gobmp.api.RouteLeak.UserLabelsEntry
+	nil,                                // 136: gobmp.api.PeerSync.UserLabelsEntry
+	nil,                                // 137: gobmp.api.BaseAttribute.UserLabelsEntry
+	(*structpb.Value)(nil),             // 138: google.protobuf.Value
+	(*structpb.ListValue)(nil),         // 139: google.protobuf.ListValue
+}
+var file_pkg_api_messages_proto_depIdxs = []int32{
+	117, // 0: gobmp.api.PeerStateChange.adv_cap:type_name -> gobmp.api.PeerStateChange.AdvCapEntry
+	118, // 1: gobmp.api.PeerStateChange.recv_cap:type_name -> gobmp.api.PeerStateChange.RecvCapEntry
+	119, // 2: gobmp.api.PeerStateChange.user_labels:type_name -> gobmp.api.PeerStateChange.UserLabelsEntry
+	3,   // 3: gobmp.api.UnicastPrefix.base_attrs:type_name -> gobmp.api.BaseAttributes
+	31,  // 4: gobmp.api.UnicastPrefix.prefix_sid:type_name -> gobmp.api.PSid
+	120, // 5: gobmp.api.UnicastPrefix.user_labels:type_name -> gobmp.api.UnicastPrefix.UserLabelsEntry
+	4,   // 6: gobmp.api.BaseAttributes.as_path_segments:type_name -> gobmp.api.ASPathSegment
+	5,   // 7: gobmp.api.BaseAttributes.ext_communities:type_name -> gobmp.api.ExtCommunityDetail
+	6,   // 8: gobmp.api.BaseAttributes.pmsi_tunnel:type_name -> gobmp.api.PMSITunnel
+	7,   // 9: gobmp.api.BaseAttributes.tunnel_encap:type_name -> gobmp.api.TunnelEncapsulation
+	5,   // 10: gobmp.api.BaseAttributes.ipv6_ext_communities:type_name -> gobmp.api.ExtCommunityDetail
+	10,  // 11: gobmp.api.BaseAttributes.aigp:type_name -> gobmp.api.AIGP
+	12,  // 12: gobmp.api.BaseAttributes.bgp_prefix_sid:type_name -> gobmp.api.BGPPrefixSID
+	17,  // 13: gobmp.api.BaseAttributes.d_path:type_name -> gobmp.api.DPath
+	20,  // 14: gobmp.api.BaseAttributes.sfp:type_name -> gobmp.api.SFP
+	26,  // 15: gobmp.api.BaseAttributes.bfd_discriminator:type_name -> gobmp.api.BFDDiscriminator
+	28,  // 16: gobmp.api.BaseAttributes.attr_set:type_name -> gobmp.api.AttrSet
+	29,  // 17: gobmp.api.BaseAttributes.unknown_attributes:type_name -> gobmp.api.UnknownPathAttribute
+	30,  // 18: gobmp.api.BaseAttributes.attr_errors:type_name -> gobmp.api.PathAttributeError
+	138, // 19: gobmp.api.ExtCommunityDetail.decoded:type_name -> google.protobuf.Value
+	8,   // 20: gobmp.api.TunnelEncapsulation.tunnels:type_name -> gobmp.api.Tunnel
+	9,   // 21: gobmp.api.Tunnel.sub_tlvs:type_name -> gobmp.api.TunnelSubTLV
+	138, // 22: gobmp.api.TunnelSubTLV.value:type_name -> google.protobuf.Value
+	11,  // 23: gobmp.api.AIGP.tlvs:type_name -> gobmp.api.AIGPTLV
+	13,  // 24: gobmp.api.BGPPrefixSID.tlvs:type_name -> gobmp.api.BGPPrefixSIDTLV
+	14,  // 25: gobmp.api.BGPPrefixSIDTLV.label_index:type_name -> gobmp.api.BGPLabelIndexTLV
+	15,  // 26: gobmp.api.BGPPrefixSIDTLV.originator_srgb:type_name -> gobmp.api.BGPOriginatorSRGBTLV
+	16,  // 27: gobmp.api.BGPOriginatorSRGBTLV.ranges:type_name -> gobmp.api.SRGBRange
+	18,  // 28: gobmp.api.DPath.segments:type_name -> gobmp.api.DPathSegment
+	19,  // 29: gobmp.api.DPathSegment.domains:type_name -> gobmp.api.DPathDomain
+	21,  // 30: gobmp.api.SFP.associations:type_name -> gobmp.api.SFPAssociation
+	22,  // 31: gobmp.api.SFP.paths:type_name -> gobmp.api.SFPPath
+	25,  // 32: gobmp.api.SFP.tlvs:type_name -> gobmp.api.SFPTLV
+	23,  // 33: gobmp.api.SFPPath.hops:type_name -> gobmp.api.SFPHop
+	25,  // 34: gobmp.api.SFPPath.sub_tlvs:type_name -> gobmp.api.SFPTLV
+	24,  // 35: gobmp.api.SFPHop.sfts:type_name -> gobmp.api.SFPSFT
+	27,  // 36: gobmp.api.BFDDiscriminator.tlvs:type_name -> gobmp.api.BFDDTLV
+	3,   // 37: gobmp.api.AttrSet.path_attributes:type_name -> gobmp.api.BaseAttributes
+	32,  // 38: gobmp.api.PSid.label_index:type_name -> gobmp.api.PrefixSIDLabelIndexTLV
+	33,  // 39: gobmp.api.PSid.originator_srgb:type_name -> gobmp.api.PrefixSIDOriginatorSRGBTLV
+	35,  // 40: gobmp.api.PSid.srv6_l3_service:type_name -> gobmp.api.L3Service
+	36,  // 41: gobmp.api.PSid.srv6_l2_service:type_name -> gobmp.api.L2Service
+	34,  // 42: gobmp.api.PrefixSIDOriginatorSRGBTLV.srgb:type_name -> gobmp.api.SRGB
+	121, // 43: gobmp.api.L3Service.sub_tlvs:type_name -> gobmp.api.L3Service.SubTlvsEntry
+	38,  // 44: gobmp.api.LSNode.mt_id_tlv:type_name -> gobmp.api.MultiTopologyIdentifier
+	39,  // 45: gobmp.api.LSNode.node_flags:type_name -> gobmp.api.NodeAttrFlags
+	40,  // 46: gobmp.api.LSNode.ls_sr_capabilities:type_name -> gobmp.api.SRCapability
+	42,  // 47: gobmp.api.LSNode.sr_local_block:type_name -> gobmp.api.LocalBlock
+	44,  // 48: gobmp.api.LSNode.srv6_capabilities_tlv:type_name -> gobmp.api.SRv6CapabilityTLV
+	45,  // 49: gobmp.api.LSNode.node_msd:type_name -> gobmp.api.MSDTV
+	46,  // 50: gobmp.api.LSNode.flex_algo_definition:type_name -> gobmp.api.FlexAlgoDefinition
+	122, // 51: gobmp.api.LSNode.user_labels:type_name -> gobmp.api.LSNode.UserLabelsEntry
+	109, // 52: gobmp.api.SRCapability.flags:type_name -> gobmp.api.ProtocolFlags
+	41,  // 53: gobmp.api.SRCapability.sr_capability_subtlv:type_name -> gobmp.api.SRCapabilitySubTLV
+	43,  // 54: gobmp.api.LocalBlock.subranges:type_name -> gobmp.api.LocalBlockTLV
+	47,  // 55: gobmp.api.FlexAlgoDefinition.sub_tlv:type_name -> gobmp.api.FADSubTLV
+	48,  // 56: gobmp.api.FADSubTLV.flags:type_name -> gobmp.api.FADSubTLVFlags
+	38,  // 57: gobmp.api.LSLink.mt_id_tlv:type_name -> gobmp.api.MultiTopologyIdentifier
+	50,  // 58: gobmp.api.LSLink.peer_node_sid:type_name -> gobmp.api.PeerSID
+	50,  // 59: gobmp.api.LSLink.peer_adj_sid:type_name -> gobmp.api.PeerSID
+	50,  // 60: gobmp.api.LSLink.peer_set_sid:type_name -> gobmp.api.PeerSID
+	52,  // 61: gobmp.api.LSLink.srv6_bgp_peer_node_sid:type_name -> gobmp.api.BGPPeerNodeSID
+	54,  // 62: gobmp.api.LSLink.srv6_endx_sid:type_name -> gobmp.api.EndXSIDTLV
+	56,  // 63: gobmp.api.LSLink.ls_adjacency_sid:type_name -> gobmp.api.AdjacencySIDTLV
+	45,  // 64: gobmp.api.LSLink.link_msd:type_name -> gobmp.api.MSDTV
+	57,  // 65: gobmp.api.LSLink.app_spec_link_attr:type_name -> gobmp.api.AppSpecLinkAttr
+	59,  // 66: gobmp.api.LSLink.l2_bundle_member:type_name -> gobmp.api.L2BundleMember
+	123, // 67: gobmp.api.LSLink.user_labels:type_name -> gobmp.api.LSLink.UserLabelsEntry
+	51,  // 68: gobmp.api.PeerSID.flags:type_name -> gobmp.api.PeerFlags
+	53,  // 69: gobmp.api.BGPPeerNodeSID.flags:type_name -> gobmp.api.BGPPeerNodeFlags
+	55,  // 70: gobmp.api.EndXSIDTLV.flags:type_name -> gobmp.api.EndXSIDFlags
+	110, // 71: gobmp.api.EndXSIDTLV.sub_tlvs:type_name -> gobmp.api.SRv6SubTLV
+	109, // 72: gobmp.api.AdjacencySIDTLV.flags:type_name -> gobmp.api.ProtocolFlags
+	58,  // 73: gobmp.api.AppSpecLinkAttr.sub_tlvs:type_name -> gobmp.api.SubTLV
+	56,  // 74: gobmp.api.L2BundleMember.ls_adjacency_sid:type_name -> gobmp.api.AdjacencySIDTLV
+	3,   // 75: gobmp.api.MulticastPrefix.base_attrs:type_name -> gobmp.api.BaseAttributes
+	124, // 76: gobmp.api.MulticastPrefix.user_labels:type_name -> gobmp.api.MulticastPrefix.UserLabelsEntry
+	3,   // 77: gobmp.api.MCASTVPNPrefix.base_attrs:type_name -> gobmp.api.BaseAttributes
+	125, // 78: gobmp.api.MCASTVPNPrefix.user_labels:type_name -> gobmp.api.MCASTVPNPrefix.UserLabelsEntry
+	3,   // 79: gobmp.api.RTCPrefix.base_attrs:type_name -> gobmp.api.BaseAttributes
+	126, // 80: gobmp.api.RTCPrefix.user_labels:type_name -> gobmp.api.RTCPrefix.UserLabelsEntry
+	3,   // 81: gobmp.api.L3VPNPrefix.base_attrs:type_name -> gobmp.api.BaseAttributes
+	31,  // 82: gobmp.api.L3VPNPrefix.prefix_sid:type_name -> gobmp.api.PSid
+	127, // 83: gobmp.api.L3VPNPrefix.user_labels:type_name -> gobmp.api.L3VPNPrefix.UserLabelsEntry
+	38,  // 84: gobmp.api.LSPrefix.mt_id_tlv:type_name -> gobmp.api.MultiTopologyIdentifier
+	65,  // 85: gobmp.api.LSPrefix.igp_flags:type_name -> gobmp.api.IGPFlags
+	66,  // 86: gobmp.api.LSPrefix.prefix_attr_tlvs:type_name -> gobmp.api.PrefixAttrTLVs
+	69,  // 87: gobmp.api.LSPrefix.flex_algo_prefix_metric:type_name -> gobmp.api.FlexAlgoPrefixMetric
+	70,  // 88: gobmp.api.LSPrefix.srv6_locator:type_name -> gobmp.api.LocatorTLV
+	128, // 89: gobmp.api.LSPrefix.user_labels:type_name -> gobmp.api.LSPrefix.UserLabelsEntry
+	67,  // 90: gobmp.api.PrefixAttrTLVs.ls_prefix_sid:type_name -> gobmp.api.PrefixSIDTLV
+	68,  // 91: gobmp.api.PrefixAttrTLVs.range:type_name -> gobmp.api.RangeTLV
+	109, // 92: gobmp.api.PrefixAttrTLVs.flags:type_name -> gobmp.api.ProtocolFlags
+	109, // 93: gobmp.api.PrefixSIDTLV.flags:type_name -> gobmp.api.ProtocolFlags
+	109, // 94: gobmp.api.RangeTLV.flags:type_name -> gobmp.api.ProtocolFlags
+	67,  // 95: gobmp.api.RangeTLV.prefix_sid:type_name -> gobmp.api.PrefixSIDTLV
+	71,  // 96: gobmp.api.LocatorTLV.flags:type_name -> gobmp.api.LocatorFlags
+	58,  // 97: gobmp.api.LocatorTLV.sub_tlvs:type_name -> gobmp.api.SubTLV
+	38,  // 98: gobmp.api.LSSRv6SID.mt_id_tlv:type_name -> gobmp.api.MultiTopologyIdentifier
+	73,  // 99: gobmp.api.LSSRv6SID.srv6_endpoint_behavior:type_name -> gobmp.api.EndpointBehavior
+	52,  // 100: gobmp.api.LSSRv6SID.srv6_bgp_peer_node_sid:type_name -> gobmp.api.BGPPeerNodeSID
+	74,  // 101: gobmp.api.LSSRv6SID.srv6_sid_structure:type_name -> gobmp.api.SIDStructure
+	129, // 102: gobmp.api.LSSRv6SID.user_labels:type_name -> gobmp.api.LSSRv6SID.UserLabelsEntry
+	3,   // 103: gobmp.api.EVPNPrefix.base_attrs:type_name -> gobmp.api.BaseAttributes
+	6,   // 104: gobmp.api.EVPNPrefix.pmsi_tunnel:type_name -> gobmp.api.PMSITunnel
+	76,  // 105: gobmp.api.EVPNPrefix.mac_mobility:type_name -> gobmp.api.ECMACMobility
+	77,  // 106: gobmp.api.EVPNPrefix.esi_label:type_name -> gobmp.api.ECESILabel
+	78,  // 107: gobmp.api.EVPNPrefix.layer2_attributes:type_name -> gobmp.api.ECLayer2Attributes
+	79,  // 108: gobmp.api.EVPNPrefix.df_election:type_name -> gobmp.api.ECDFElection
+	130, // 109: gobmp.api.EVPNPrefix.user_labels:type_name -> gobmp.api.EVPNPrefix.UserLabelsEntry
+	3,   // 110: gobmp.api.VPLSPrefix.base_attrs:type_name -> gobmp.api.BaseAttributes
+	131, // 111: gobmp.api.VPLSPrefix.user_labels:type_name -> gobmp.api.VPLSPrefix.UserLabelsEntry
+	3,   // 112: gobmp.api.SRPolicy.base_attrs:type_name -> gobmp.api.BaseAttributes
+	82,  // 113: gobmp.api.SRPolicy.binding_sid:type_name -> gobmp.api.BindingSID
+	83,  // 114: gobmp.api.SRPolicy.srv6_binding_sid:type_name -> gobmp.api.SRv6BindingSID
+	85,  // 115: gobmp.api.SRPolicy.preference_subtlv:type_name -> gobmp.api.Preference
+	86,  // 116: gobmp.api.SRPolicy.enlp_subtlv:type_name -> gobmp.api.ENLP
+	87,  // 117: gobmp.api.SRPolicy.segment_list_subtlv:type_name -> gobmp.api.SegmentList
+	132, // 118: gobmp.api.SRPolicy.user_labels:type_name -> gobmp.api.SRPolicy.UserLabelsEntry
+	111, // 119: gobmp.api.BindingSID.bsid:type_name -> gobmp.api.BindingSIDValue
+	84,  // 120: gobmp.api.SRv6BindingSID.endpoint_behavior_sid_structure:type_name -> gobmp.api.SRv6EndpointBehavior
+	88,  // 121: gobmp.api.SegmentList.weight_subtlv:type_name -> gobmp.api.Weight
+	112, // 122: gobmp.api.SegmentList.segments:type_name -> gobmp.api.Segment
+	3,   // 123: gobmp.api.Flowspec.base_attrs:type_name -> gobmp.api.BaseAttributes
+	114, // 124: gobmp.api.Flowspec.spec:type_name -> gobmp.api.FlowspecSpec
+	133, // 125: gobmp.api.Flowspec.user_labels:type_name -> gobmp.api.Flowspec.UserLabelsEntry
+	91,  // 126: gobmp.api.Stats.per_afi_adj_ribs_in:type_name -> gobmp.api.AFISAFIStat
+	91,  // 127: gobmp.api.Stats.per_afi_loc_rib:type_name -> gobmp.api.AFISAFIStat
+	91,  // 128: gobmp.api.Stats.per_afi_pre_policy_adj_rib_out:type_name -> gobmp.api.AFISAFIStat
+	91,  // 129: gobmp.api.Stats.per_afi_post_policy_adj_rib_out:type_name -> gobmp.api.AFISAFIStat
+	134, // 130: gobmp.api.Stats.user_labels:type_name -> gobmp.api.Stats.UserLabelsEntry
+	135, // 131: gobmp.api.RouteLeak.user_labels:type_name -> gobmp.api.RouteLeak.UserLabelsEntry
+	136, // 132: gobmp.api.PeerSync.user_labels:type_name -> gobmp.api.PeerSync.UserLabelsEntry
+	3,   // 133: gobmp.api.BaseAttribute.base_attrs:type_name -> gobmp.api.BaseAttributes
+	137, // 134: gobmp.api.BaseAttribute.user_labels:type_name -> gobmp.api.BaseAttribute.UserLabelsEntry
+	96,  // 135: gobmp.api.TopologyEvent.topology:type_name -> gobmp.api.TopologyKey
+	97,  // 136: gobmp.api.TopologyEvent.node:type_name -> gobmp.api.TopologyNode
+	100, // 137: gobmp.api.TopologyEvent.link:type_name -> gobmp.api.TopologyLink
+	99,  // 138: gobmp.api.TopologyEvent.prefix:type_name -> gobmp.api.TopologyPrefix
+	102, // 139: gobmp.api.TopologyEvent.srv6_sid:type_name -> gobmp.api.TopologySRv6SID
+	46,  // 140: gobmp.api.TopologyNode.flex_algo_definition:type_name -> gobmp.api.FlexAlgoDefinition
+	98,  // 141: gobmp.api.TopologyNode.srgb:type_name -> gobmp.api.TopologyLabelRange
+	98,  // 142: gobmp.api.TopologyNode.srlb:type_name -> gobmp.api.TopologyLabelRange
+	99,  // 143: gobmp.api.TopologyNode.prefixes:type_name -> gobmp.api.TopologyPrefix
+	69,  // 144: gobmp.api.TopologyPrefix.flex_algo_prefix_metric:type_name -> gobmp.api.FlexAlgoPrefixMetric
+	67,  // 145: gobmp.api.TopologyPrefix.prefix_sid:type_name -> gobmp.api.PrefixSIDTLV
+	56,  // 146: gobmp.api.TopologyLink.adj_sid:type_name -> gobmp.api.AdjacencySIDTLV
+	101, // 147: gobmp.api.TopologyLink.flex_algo_attributes:type_name -> gobmp.api.TopologyLinkAttributes
+	104, // 148: gobmp.api.ResolvedSRPolicy.segment_lists:type_name -> gobmp.api.ResolvedSegmentList
+	96,  // 149: gobmp.api.ResolvedSegmentList.topology:type_name -> gobmp.api.TopologyKey
+	105, // 150: gobmp.api.ResolvedSegmentList.hops:type_name -> gobmp.api.TopologyHop
+	100, // 151: gobmp.api.TopologyHop.link:type_name -> gobmp.api.TopologyLink
+	113, // 152: gobmp.api.Segment.flags:type_name -> gobmp.api.SegmentFlags
+	115, // 153: gobmp.api.FlowspecSpec.op_val_pairs:type_name -> gobmp.api.FlowspecOpVal
+	116, // 154: gobmp.api.FlowspecOpVal.operator:type_name -> gobmp.api.FlowspecOperator
+	139, // 155: gobmp.api.PeerStateChange.AdvCapEntry.value:type_name -> google.protobuf.ListValue
+	139, // 156: gobmp.api.PeerStateChange.RecvCapEntry.value:type_name -> google.protobuf.ListValue
+	139, // 157: gobmp.api.L3Service.SubTlvsEntry.value:type_name -> google.protobuf.ListValue
+	158, // [158:158] is the sub-list for method output_type
+	158, // [158:158] is the sub-list for method input_type
+	158, // [158:158] is the sub-list for extension type_name
+	158, // [158:158] is the sub-list for extension extendee
+	0,   // [0:158] is the sub-list for field type_name
+}
+
+func init() { file_pkg_api_messages_proto_init() }
+func file_pkg_api_messages_proto_init() {
+	if File_pkg_api_messages_proto != nil {
+		return
+	}
+	file_pkg_api_messages_proto_msgTypes[2].OneofWrappers = []any{}
+	file_pkg_api_messages_proto_msgTypes[5].OneofWrappers = []any{}
+	file_pkg_api_messages_proto_msgTypes[6].OneofWrappers = []any{}
+	file_pkg_api_messages_proto_msgTypes[43].OneofWrappers = []any{}
+	file_pkg_api_messages_proto_msgTypes[63].OneofWrappers = []any{}
+	file_pkg_api_messages_proto_msgTypes[80].OneofWrappers = []any{}
+	file_pkg_api_messages_proto_msgTypes[112].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pkg_api_messages_proto_rawDesc), len(file_pkg_api_messages_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   138,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_pkg_api_messages_proto_goTypes,
+		DependencyIndexes: file_pkg_api_messages_proto_depIdxs,
+		MessageInfos:      file_pkg_api_messages_proto_msgTypes,
+	}.Build()
+	File_pkg_api_messages_proto = out.File
+	file_pkg_api_messages_proto_goTypes = nil
+	file_pkg_api_messages_proto_depIdxs = nil
+}
@@ -0,0 +1,209 @@
+package avro
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+)
+
+// Encode returns the Avro binary encoding of the JSON message msg of the
+// schema type. The fields missing from msg are encoded as null when nullable
+// and as their zero value otherwise, the JSON keys without a field are ignored.
+func (s *Schema) Encode(msg []byte) ([]byte, error) {
+	d := json.NewDecoder(bytes.NewReader(msg))
+	d.UseNumber()
+	var v interface{}
+	if err := d.Decode(&v); err != nil {
+		return nil, fmt.Errorf("failed to decode JSON message with error: %w", err)
+	}
+	b, err := s.root.append(make([]byte, 0, len(msg)), v)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode %s with error: %w", s.root.name, err)
+	}
+
+	return b, nil
+}
+
+// append appends the encoding of the JSON value v of type n to b.
+func (n *node) append(b []byte, v interface{}) ([]byte, error) {
+	switch n.kind {
+	case kindNullable:
+		if v == nil {
+			return appendLong(b, 0), nil
+		}
+		return n.elem.append(appendLong(b, 1), v)
+	case kindBoolean:
+		switch v := v.(type) {
+		case nil:
+			return append(b, 0), nil
+		case bool:
+			if v {
+				return append(b, 1), nil
+			}
+			return append(b, 0), nil
+		}
+	case kindInt, kindLong:
+		if v == nil {
+			return appendLong(b, 0), nil
+		}
+		if num, ok := v.(json.Number); ok {
+			i, err := toLong(num)
+			if err != nil {
+				return nil, err
+			}
+			if n.kind == kindInt && (i < math.MinInt32 || i > math.MaxInt32) {
+				return nil, fmt.Errorf("%s overflows int", num)
+			}
+			return appendLong(b, i), nil
+		}
+	case kindFloat, kindDouble:
+		if v == nil {
+			v = json.Number("0")
+		}
+		if num, ok := v.(json.Number); ok {
+			f, err := num.Float64()
+			if err != nil {
+				return nil, err
+			}
+			if n.kind == kindFloat {
+				return binary.LittleEndian.AppendUint32(b, math.Float32bits(float32(f))), nil
+			}
+			return binary.LittleEndian.AppendUint64(b, math.Float64bits(f)), nil
+		}
+	case kindString:
+		switch v := v.(type) {
+		case nil:
+			return appendLong(b, 0), nil
+		case string:
+			return appendString(b, v), nil
+		}
+	case kindBytes:
+		switch v := v.(type) {
+		case nil:
+			return appendLong(b, 0), nil
+		case string:
+			p, err := base64.StdEncoding.DecodeString(v)
+			if err != nil {
+				return nil, err
+			}
+			return appendString(b, string(p)), nil
+		}
+	case kindJSON:
+		if v == nil {
+			return appendLong(b, 0), nil
+		}
+		p, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		return appendString(b, string(p)), nil
+	case kindArray:
+		switch v := v.(type) {
+		case nil:
+			return appendLong(b, 0), nil
+		case []interface{}:
+			if len(v) != 0 {
+				b = appendLong(b, int64(len(v)))
+			}
+			for i, e := range v {
+				var err error
+				if b, err = n.elem.append(b, e); err != nil {
+					return nil, fmt.Errorf("[%d]: %w", i, err)
+				}
+			}
+			return appendLong(b, 0), nil
+		}
+	case kindMap:
+		switch v := v.(type) {
+		case nil:
+			return appendLong(b, 0), nil
+		case map[string]interface{}:
+			keys := make([]string, 0, len(v))
+			for k := range v {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			if len(keys) != 0 {
+				b = appendLong(b, int64(len(keys)))
+			}
+			for _, k := range keys {
+				var err error
+				if b, err = n.elem.append(appendString(b, k), v[k]); err != nil {
+					return nil, fmt.Errorf("[%s]: %w", k, err)
+				}
+			}
+			return appendLong(b, 0), nil
+		}
+	case kindRecord:
+		m, ok := v.(map[string]interface{})
+		if !ok && v != nil {
+			break
+		}
+		for _, f := range n.fields {
+			var err error
+			if b, err = f.node.append(b, m[f.key]); err != nil {
+				return nil, fmt.Errorf("%s: %w", f.key, err)
+			}
+		}
+		return b, nil
+	}
+
+	return nil, fmt.Errorf("unexpected JSON value %v for %s", v, n.typeName())
+}
+
+func (n *node) typeName() string {
+	switch n.kind {
+	case kindBoolean:
+		return "boolean"
+	case kindInt:
+		return "int"
+	case kindLong:
+		return "long"
+	case kindFloat:
+		return "float"
+	case kindDouble:
+		return "double"
+	case kindString, kindJSON:
+		return "string"
+	case kindBytes:
+		return "bytes"
+	case kindArray:
+		return "array"
+	case kindMap:
+		return "map"
+	case kindNullable:
+		return "union"
+	}
+	return n.name
+}
+
+// toLong returns the long of a JSON number, the uint64 values above the long
+// range keep their two's complement bits.
+func toLong(num json.Number) (int64, error) {
+	if i, err := strconv.ParseInt(string(num), 10, 64); err == nil {
+		return i, nil
+	}
+	if u, err := strconv.ParseUint(string(num), 10, 64); err == nil {
+		return int64(u), nil
+	}
+	f, err := num.Float64()
+	if err != nil || f != math.Trunc(f) || f < math.MinInt64 || f >= math.MaxInt64 {
+		return 0, fmt.Errorf("%s is not a long", num)
+	}
+
+	return int64(f), nil
+}
+
+// appendLong appends the zig-zag variable length encoding of i.
+func appendLong(b []byte, i int64) []byte {
+	return binary.AppendUvarint(b, uint64(i<<1)^uint64(i>>63))
+}
+
+func appendString(b []byte, s string) []byte {
+	return append(appendLong(b, int64(len(s))), s...)
+}
@@ -0,0 +1,86 @@
+package avro
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestEncode(t *testing.T) {
+	s, err := NewSchema(reflect.TypeOf(testMessage{}))
+	if err != nil {
+		t.Fatalf("NewSchema() error: %v", err)
+	}
+	tests := []struct {
+		name    string
+		msg     string
+		want    []byte
+		wantErr bool
+	}{
+		{
+			name: "empty",
+			msg:  `{}`,
+			// null origin, "" action, 0 len, null weight, no hops, null labels,
+			// info, raw and decoded, false inline.A and null extra
+			want: []byte{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+		},
+		{
+			name: "all fields",
+			msg: `{"origin":"igp","action":"add","len":-1,"weight":1.5,` +
+				`"hops":[{"asn":65000,"next":{"asn":1}}],"labels":{"2":"b","1":"a"},"info":"AQI=",` +
+				`"raw":{"v":1},"decoded":[1,"x"],"inline":{"A":true},"extra-attrs":{"k":null},"unknown":1}`,
+			want: []byte{
+				0x02, 0x06, 'i', 'g', 'p', // origin
+				0x06, 'a', 'd', 'd', // action
+				0x01,                                                 // len
+				0x02, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf8, 0x3f, // weight
+				0x02, 0xd0, 0xf7, 0x07, 0x02, 0x02, 0x00, 0x00, // hops
+				0x02, 0x04, 0x02, '1', 0x02, 'a', 0x02, '2', 0x02, 'b', 0x00, // labels
+				0x02, 0x04, 0x01, 0x02, // info
+				0x02, 0x0e, '{', '"', 'v', '"', ':', '1', '}', // raw
+				0x02, 0x0e, '[', '1', ',', '"', 'x', '"', ']', // decoded
+				0x01,                              // inline.A
+				0x02, 0x02, 0x02, 'k', 0x00, 0x00, // extra, null is the empty JSON text
+			},
+		},
+		{name: "invalid JSON", msg: `{"action":`, wantErr: true},
+		{name: "string for int", msg: `{"len":"1"}`, wantErr: true},
+		{name: "int overflow", msg: `{"len":2147483648}`, wantErr: true},
+		{name: "fraction for int", msg: `{"len":1.5}`, wantErr: true},
+		{name: "number for string", msg: `{"action":1}`, wantErr: true},
+		{name: "invalid base64", msg: `{"info":"!"}`, wantErr: true},
+		{name: "object for array", msg: `{"hops":{}}`, wantErr: true},
+		{name: "array for record", msg: `{"inline":[]}`, wantErr: true},
+		{name: "not an object", msg: `[]`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := s.Encode([]byte(tt.msg))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Encode() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !bytes.Equal(got, tt.want) {
+				t.Errorf("Encode() = % x, want % x", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAppendLong(t *testing.T) {
+	tests := []struct {
+		i    int64
+		want []byte
+	}{
+		{0, []byte{0x00}},
+		{-1, []byte{0x01}},
+		{1, []byte{0x02}},
+		{-64, []byte{0x7f}},
+		{64, []byte{0x80, 0x01}},
+		{-9223372036854775808, []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01}},
+	}
+	for _, tt := range tests {
+		if got := appendLong(nil, tt.i); !bytes.Equal(got, tt.want) {
+			t.Errorf("appendLong(%d) = % x, want % x", tt.i, got, tt.want)
+		}
+	}
+}
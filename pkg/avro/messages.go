@@ -0,0 +1,79 @@
+package avro
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/churn"
+	"github.com/sbezverk/gobmp/pkg/hijack"
+	"github.com/sbezverk/gobmp/pkg/message"
+	"github.com/sbezverk/gobmp/pkg/topology"
+)
+
+// messageTypes maps the parsed BMP message types to the Go types of their
+// JSON messages.
+var messageTypes = map[int]reflect.Type{
+	bmp.PeerStateChangeMsg:  reflect.TypeOf(message.PeerStateChange{}),
+	bmp.UnicastPrefixMsg:    reflect.TypeOf(message.UnicastPrefix{}),
+	bmp.UnicastPrefixV4Msg:  reflect.TypeOf(message.UnicastPrefix{}),
+	bmp.UnicastPrefixV6Msg:  reflect.TypeOf(message.UnicastPrefix{}),
+	bmp.LSNodeMsg:           reflect.TypeOf(message.LSNode{}),
+	bmp.LSLinkMsg:           reflect.TypeOf(message.LSLink{}),
+	bmp.L3VPNMsg:            reflect.TypeOf(message.L3VPNPrefix{}),
+	bmp.L3VPNV4Msg:          reflect.TypeOf(message.L3VPNPrefix{}),
+	bmp.L3VPNV6Msg:          reflect.TypeOf(message.L3VPNPrefix{}),
+	bmp.LSPrefixMsg:         reflect.TypeOf(message.LSPrefix{}),
+	bmp.LSSRv6SIDMsg:        reflect.TypeOf(message.LSSRv6SID{}),
+	bmp.EVPNMsg:             reflect.TypeOf(message.EVPNPrefix{}),
+	bmp.SRPolicyMsg:         reflect.TypeOf(message.SRPolicy{}),
+	bmp.SRPolicyV4Msg:       reflect.TypeOf(message.SRPolicy{}),
+	bmp.SRPolicyV6Msg:       reflect.TypeOf(message.SRPolicy{}),
+	bmp.FlowspecMsg:         reflect.TypeOf(message.Flowspec{}),
+	bmp.FlowspecV4Msg:       reflect.TypeOf(message.Flowspec{}),
+	bmp.FlowspecV6Msg:       reflect.TypeOf(message.Flowspec{}),
+	bmp.VPLSMsg:             reflect.TypeOf(message.VPLSPrefix{}),
+	bmp.MulticastV4Msg:      reflect.TypeOf(message.MulticastPrefix{}),
+	bmp.MulticastV6Msg:      reflect.TypeOf(message.MulticastPrefix{}),
+	bmp.RTCV4Msg:            reflect.TypeOf(message.RTCPrefix{}),
+	bmp.RTCV6Msg:            reflect.TypeOf(message.RTCPrefix{}),
+	bmp.MCASTVPNV4Msg:       reflect.TypeOf(message.MCASTVPNPrefix{}),
+	bmp.MCASTVPNV6Msg:       reflect.TypeOf(message.MCASTVPNPrefix{}),
+	bmp.MVPNV4Msg:           reflect.TypeOf(message.MCASTVPNPrefix{}),
+	bmp.MVPNV6Msg:           reflect.TypeOf(message.MCASTVPNPrefix{}),
+	bmp.StatsReportMsg:      reflect.TypeOf(message.Stats{}),
+	bmp.LSTopologyChangeMsg: reflect.TypeOf(topology.Event{}),
+	bmp.SRPolicyResolvedMsg: reflect.TypeOf(topology.ResolvedSRPolicy{}),
+	bmp.ChurnStatsMsg:       reflect.TypeOf(churn.Stats{}),
+	bmp.FlapEventMsg:        reflect.TypeOf(churn.FlapEvent{}),
+	bmp.HijackEventMsg:      reflect.TypeOf(hijack.Event{}),
+	bmp.RouteLeakMsg:        reflect.TypeOf(message.RouteLeak{}),
+	bmp.PeerSyncMsg:         reflect.TypeOf(message.PeerSync{}),
+	bmp.BaseAttributeMsg:    reflect.TypeOf(message.BaseAttribute{}),
+}
+
+var (
+	schemasMu sync.Mutex
+	schemas   = make(map[reflect.Type]*Schema)
+)
+
+// MessageSchema returns the schema of a parsed BMP message type.
+func MessageSchema(msgType int) (*Schema, error) {
+	t, ok := messageTypes[msgType]
+	if !ok {
+		return nil, fmt.Errorf("message type %d has no avro schema", msgType)
+	}
+	schemasMu.Lock()
+	defer schemasMu.Unlock()
+	if s, ok := schemas[t]; ok {
+		return s, nil
+	}
+	s, err := NewSchema(t)
+	if err != nil {
+		return nil, err
+	}
+	schemas[t] = s
+
+	return s, nil
+}
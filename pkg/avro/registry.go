@@ -0,0 +1,224 @@
+package avro
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// SubjectStrategy defines the name of the registry subject of the schema of
+// a topic, as the Confluent subject name strategies.
+type SubjectStrategy string
+
+const (
+	// TopicNameStrategy names the subject <topic>-value, the default strategy.
+	TopicNameStrategy SubjectStrategy = "topic_name"
+	// RecordNameStrategy names the subject after the full name of the record.
+	RecordNameStrategy SubjectStrategy = "record_name"
+	// TopicRecordNameStrategy names the subject <topic>-<record full name>.
+	TopicRecordNameStrategy SubjectStrategy = "topic_record_name"
+)
+
+// ParseSubjectStrategy returns the SubjectStrategy of its name, an empty name
+// is TopicNameStrategy.
+func ParseSubjectStrategy(s string) (SubjectStrategy, error) {
+	switch SubjectStrategy(s) {
+	case "", TopicNameStrategy:
+		return TopicNameStrategy, nil
+	case RecordNameStrategy, TopicRecordNameStrategy:
+		return SubjectStrategy(s), nil
+	}
+	return "", fmt.Errorf("unknown subject name strategy %q, supported strategies are topic_name, record_name and topic_record_name", s)
+}
+
+// Subject returns the subject of the schema s of the records of a topic.
+func (st SubjectStrategy) Subject(topic string, s *Schema) string {
+	switch st {
+	case RecordNameStrategy:
+		return s.FullName()
+	case TopicRecordNameStrategy:
+		return topic + "-" + s.FullName()
+	}
+	return topic + "-value"
+}
+
+const (
+	// magicByte starts the records of the schema registry wire format, followed
+	// by the 4 bytes schema ID and the Avro binary encoding of the record.
+	magicByte = 0
+	// contentType is the media type of the schema registry API
+	contentType = "application/vnd.schemaregistry.v1+json"
+)
+
+const (
+	// minBackoff is the time a failed schema ID lookup is cached for, doubled
+	// up to maxBackoff by every consecutive failure of the subject.
+	minBackoff = time.Second
+	maxBackoff = time.Minute
+)
+
+// Registry is a client of a Confluent compatible schema registry, it caches
+// the schema ID of every subject and the failed lookups for a backoff.
+type Registry struct {
+	url      string
+	client   *http.Client
+	strategy SubjectStrategy
+	register bool
+	mu       sync.Mutex
+	ids      map[string]int
+	lookups  map[string]*lookup
+}
+
+// lookup is the registry request of the schema ID of a subject, done is
+// closed when id and err are set. A failed lookup is retried after retry.
+type lookup struct {
+	done    chan struct{}
+	id      int
+	err     error
+	backoff time.Duration
+	retry   time.Time
+}
+
+// NewRegistry returns the client of the schema registry at registryURL, the
+// schemas are registered when register is true and looked up otherwise.
+func NewRegistry(registryURL string, strategy SubjectStrategy, register bool) (*Registry, error) {
+	u, err := url.Parse(registryURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid schema registry url %q: %w", registryURL, err)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("invalid schema registry url %q: expected http(s)://host[:port]", registryURL)
+	}
+	if strategy, err = ParseSubjectStrategy(string(strategy)); err != nil {
+		return nil, err
+	}
+
+	return &Registry{
+		url:      strings.TrimSuffix(registryURL, "/"),
+		client:   &http.Client{Timeout: 10 * time.Second},
+		strategy: strategy,
+		register: register,
+		ids:      make(map[string]int),
+		lookups:  make(map[string]*lookup),
+	}, nil
+}
+
+// ID returns the registry ID of the schema s of the records of a topic. The
+// registry is requested once for the concurrent calls of a subject and not
+// before the backoff of its last failed request expires, the calls of the
+// other subjects are not held meanwhile.
+func (r *Registry) ID(topic string, s *Schema) (int, error) {
+	subject := r.strategy.Subject(topic, s)
+	r.mu.Lock()
+	if id, ok := r.ids[subject]; ok {
+		r.mu.Unlock()
+		return id, nil
+	}
+	backoff := minBackoff
+	if l, ok := r.lookups[subject]; ok {
+		select {
+		case <-l.done:
+			if time.Now().Before(l.retry) {
+				r.mu.Unlock()
+				return 0, l.err
+			}
+			backoff = min(2*l.backoff, maxBackoff)
+		default:
+			r.mu.Unlock()
+			<-l.done
+			return l.id, l.err
+		}
+	}
+	l := &lookup{done: make(chan struct{}), backoff: backoff}
+	r.lookups[subject] = l
+	r.mu.Unlock()
+
+	// Registering an already registered schema returns its ID, looking it up
+	// does not create a new version of the subject.
+	path := "/subjects/" + url.PathEscape(subject)
+	if r.register {
+		path += "/versions"
+	}
+	l.id, l.err = r.post(path, s)
+	r.mu.Lock()
+	if l.err != nil {
+		l.err = fmt.Errorf("failed to get the schema ID of subject %s with error: %w", subject, l.err)
+		l.retry = time.Now().Add(l.backoff)
+	} else {
+		r.ids[subject] = l.id
+		delete(r.lookups, subject)
+	}
+	r.mu.Unlock()
+	close(l.done)
+
+	return l.id, l.err
+}
+
+func (r *Registry) post(path string, s *Schema) (int, error) {
+	body, err := json.Marshal(struct {
+		Schema string `json:"schema"`
+	}{Schema: s.String()})
+	if err != nil {
+		return 0, err
+	}
+	resp, err := r.client.Post(r.url+path, contentType, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return 0, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		var e struct {
+			ErrorCode int    `json:"error_code"`
+			Message   string `json:"message"`
+		}
+		if json.Unmarshal(b, &e) == nil && e.Message != "" {
+			return 0, fmt.Errorf("schema registry returned %s, error code %d: %s", resp.Status, e.ErrorCode, e.Message)
+		}
+		return 0, fmt.Errorf("schema registry returned %s", resp.Status)
+	}
+	var res struct {
+		ID *int `json:"id"`
+	}
+	if err := json.Unmarshal(b, &res); err != nil {
+		return 0, fmt.Errorf("invalid schema registry response: %w", err)
+	}
+	if res.ID == nil {
+		return 0, fmt.Errorf("schema registry response has no id")
+	}
+
+	return *res.ID, nil
+}
+
+// Encode returns the JSON message msg of a parsed BMP message type published
+// to topic in the schema registry wire format, the schema ID of the message
+// schema followed by its Avro binary encoding.
+func (r *Registry) Encode(topic string, msgType int, msg []byte) ([]byte, error) {
+	s, err := MessageSchema(msgType)
+	if err != nil {
+		return nil, err
+	}
+	id, err := r.ID(topic, s)
+	if err != nil {
+		return nil, err
+	}
+	payload, err := s.Encode(msg)
+	if err != nil {
+		return nil, err
+	}
+	b := make([]byte, 0, 5+len(payload))
+	b = append(b, magicByte)
+	b = binary.BigEndian.AppendUint32(b, uint32(id))
+
+	return append(b, payload...), nil
+}
@@ -0,0 +1,249 @@
+package avro
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sbezverk/gobmp/pkg/bmp"
+)
+
+// testRegistry is an in-process stand-in of a Confluent schema registry
+// serving the register and lookup requests of the subjects, the requests of
+// the subject held are answered when hold is closed.
+type testRegistry struct {
+	mu       sync.Mutex
+	schemas  []string
+	subjects map[string][]int
+	requests int
+	held     string
+	hold     chan struct{}
+}
+
+func newTestRegistry(t *testing.T) (*testRegistry, string) {
+	r := &testRegistry{subjects: make(map[string][]int)}
+	srv := httptest.NewServer(r)
+	t.Cleanup(srv.Close)
+
+	return r, srv.URL
+}
+
+func (r *testRegistry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if r.hold != nil && strings.Contains(req.URL.Path, "/"+r.held) {
+		<-r.hold
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.requests++
+	w.Header().Set("Content-Type", contentType)
+	if req.Method != http.MethodPost || req.Header.Get("Content-Type") != contentType {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	var body struct {
+		Schema string `json:"schema"`
+	}
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil || !json.Valid([]byte(body.Schema)) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		_, _ = w.Write([]byte(`{"error_code":42201,"message":"Invalid schema"}`))
+		return
+	}
+	subject, register := strings.CutSuffix(strings.TrimPrefix(req.URL.Path, "/subjects/"), "/versions")
+	id := 0
+	for i, s := range r.schemas {
+		if s == body.Schema {
+			id = i + 1
+		}
+	}
+	registered := false
+	for _, i := range r.subjects[subject] {
+		registered = registered || i == id
+	}
+	switch {
+	case registered:
+	case !register:
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"error_code":40401,"message":"Subject '` + subject + `' not found."}`))
+		return
+	default:
+		if id == 0 {
+			r.schemas = append(r.schemas, body.Schema)
+			id = len(r.schemas)
+		}
+		r.subjects[subject] = append(r.subjects[subject], id)
+	}
+	_ = json.NewEncoder(w).Encode(map[string]int{"id": id})
+}
+
+func TestParseSubjectStrategy(t *testing.T) {
+	s, _ := MessageSchema(bmp.PeerStateChangeMsg)
+	tests := []struct {
+		name        string
+		wantSubject string
+		wantErr     bool
+	}{
+		{name: "", wantSubject: "gobmp.parsed.peer-value"},
+		{name: "topic_name", wantSubject: "gobmp.parsed.peer-value"},
+		{name: "record_name", wantSubject: "gobmp.message.PeerStateChange"},
+		{name: "topic_record_name", wantSubject: "gobmp.parsed.peer-gobmp.message.PeerStateChange"},
+		{name: "TopicNameStrategy", wantErr: true},
+	}
+	for _, tt := range tests {
+		st, err := ParseSubjectStrategy(tt.name)
+		if (err != nil) != tt.wantErr {
+			t.Fatalf("ParseSubjectStrategy(%q) error = %v, wantErr %v", tt.name, err, tt.wantErr)
+		}
+		if got := st.Subject("gobmp.parsed.peer", s); !tt.wantErr && got != tt.wantSubject {
+			t.Errorf("Subject() = %s, want %s", got, tt.wantSubject)
+		}
+	}
+}
+
+func TestNewRegistry(t *testing.T) {
+	for _, u := range []string{"localhost:8081", "ftp://localhost", "http://", "http://%zz"} {
+		if _, err := NewRegistry(u, TopicNameStrategy, true); err == nil {
+			t.Errorf("NewRegistry(%q) expected error", u)
+		}
+	}
+	if _, err := NewRegistry("http://localhost:8081", "subject", true); err == nil {
+		t.Error("NewRegistry() expected error for an unknown strategy")
+	}
+}
+
+func TestRegistryEncode(t *testing.T) {
+	tr, url := newTestRegistry(t)
+	r, err := NewRegistry(url+"/", RecordNameStrategy, true)
+	if err != nil {
+		t.Fatalf("NewRegistry() error: %v", err)
+	}
+	msg := []byte(`{"action":"add","prefix":"192.0.2.0","prefix_len":24}`)
+	var ids []int
+	for _, topic := range []string{"gobmp.parsed.unicast_prefix_v4", "gobmp.parsed.unicast_prefix_v6", "gobmp.parsed.peer"} {
+		msgType := bmp.UnicastPrefixV4Msg
+		if topic == "gobmp.parsed.peer" {
+			msgType = bmp.PeerStateChangeMsg
+		}
+		b, err := r.Encode(topic, msgType, msg)
+		if err != nil {
+			t.Fatalf("Encode() error: %v", err)
+		}
+		if len(b) < 5 || b[0] != 0 {
+			t.Fatalf("Encode() = % x, want the wire format header", b)
+		}
+		s, _ := MessageSchema(msgType)
+		payload, _ := s.Encode(msg)
+		if string(b[5:]) != string(payload) {
+			t.Errorf("Encode() payload = % x, want % x", b[5:], payload)
+		}
+		ids = append(ids, int(binary.BigEndian.Uint32(b[1:5])))
+	}
+	// Both unicast topics share the record subject
+	if ids[0] != 1 || ids[1] != 1 || ids[2] != 2 {
+		t.Errorf("schema IDs = %v, want [1 1 2]", ids)
+	}
+	if tr.requests != 2 {
+		t.Errorf("registry requests = %d, want 2, the IDs are cached", tr.requests)
+	}
+	if _, err := r.Encode("gobmp.parsed.peer", bmp.PeerStateChangeMsg, []byte(`{"action":1}`)); err == nil {
+		t.Error("Encode() expected error for an invalid message")
+	}
+	if _, err := r.Encode("gobmp.raw", bmp.BMPRawMsg, nil); err == nil {
+		t.Error("Encode() expected error for a raw message")
+	}
+
+	// A lookup finds the schemas registered in the subject only
+	l, err := NewRegistry(url, TopicNameStrategy, false)
+	if err != nil {
+		t.Fatalf("NewRegistry() error: %v", err)
+	}
+	_, err = l.Encode("gobmp.parsed.peer", bmp.PeerStateChangeMsg, msg)
+	if err == nil || !strings.Contains(err.Error(), "40401") {
+		t.Fatalf("Encode() error = %v, want the subject not found error", err)
+	}
+	s, _ := MessageSchema(bmp.PeerStateChangeMsg)
+	tr.mu.Lock()
+	tr.subjects["gobmp.parsed.peer-value"] = []int{2}
+	requests := tr.requests
+	tr.mu.Unlock()
+	// The failure is cached until its backoff expires
+	if _, err := l.ID("gobmp.parsed.peer", s); err == nil || tr.requests != requests {
+		t.Errorf("ID() = %v after %d requests, want the cached error", err, tr.requests-requests)
+	}
+	l.lookups["gobmp.parsed.peer-value"].retry = time.Time{}
+	if id, err := l.ID("gobmp.parsed.peer", s); err != nil || id != 2 {
+		t.Errorf("ID() = %d, %v, want 2", id, err)
+	}
+	if _, ok := l.lookups["gobmp.parsed.peer-value"]; ok {
+		t.Error("ID() kept the lookup of a found subject")
+	}
+}
+
+func TestRegistryConcurrentID(t *testing.T) {
+	tr, url := newTestRegistry(t)
+	tr.held, tr.hold = "gobmp.parsed.peer-value", make(chan struct{})
+	r, err := NewRegistry(url, TopicNameStrategy, true)
+	if err != nil {
+		t.Fatalf("NewRegistry() error: %v", err)
+	}
+	s, _ := MessageSchema(bmp.PeerStateChangeMsg)
+	var wg sync.WaitGroup
+	ids := make([]int, 8)
+	for i := range ids {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			ids[i], _ = r.ID("gobmp.parsed.peer", s)
+		}(i)
+	}
+	// The lookup of another subject is not held by the pending one
+	u, _ := MessageSchema(bmp.UnicastPrefixV4Msg)
+	if id, err := r.ID("gobmp.parsed.unicast_prefix_v4", u); err != nil || id != 1 {
+		t.Errorf("ID() = %d, %v, want 1", id, err)
+	}
+	close(tr.hold)
+	wg.Wait()
+	for i, id := range ids {
+		if id != 2 {
+			t.Errorf("ID() of call %d = %d, want 2", i, id)
+		}
+	}
+	if tr.requests != 2 {
+		t.Errorf("registry requests = %d, want 2, one per subject", tr.requests)
+	}
+}
+
+func TestRegistryBackoff(t *testing.T) {
+	tr, url := newTestRegistry(t)
+	r, err := NewRegistry(url, TopicNameStrategy, false)
+	if err != nil {
+		t.Fatalf("NewRegistry() error: %v", err)
+	}
+	s, _ := MessageSchema(bmp.PeerStateChangeMsg)
+	subject := "gobmp.parsed.peer-value"
+	for i, want := range []time.Duration{minBackoff, 2 * minBackoff, 4 * minBackoff} {
+		if _, err := r.ID("gobmp.parsed.peer", s); err == nil {
+			t.Fatal("ID() expected error for an unknown subject")
+		}
+		if tr.requests != i+1 {
+			t.Fatalf("registry requests = %d, want %d", tr.requests, i+1)
+		}
+		l := r.lookups[subject]
+		if l.backoff != want || l.retry.IsZero() {
+			t.Errorf("backoff = %v, want %v", l.backoff, want)
+		}
+		if _, err := r.ID("gobmp.parsed.peer", s); err == nil || tr.requests != i+1 {
+			t.Errorf("ID() = %v after %d requests, want the cached error", err, tr.requests)
+		}
+		l.retry = time.Time{}
+	}
+	r.lookups[subject].backoff = maxBackoff
+	_, _ = r.ID("gobmp.parsed.peer", s)
+	if got := r.lookups[subject].backoff; got != maxBackoff {
+		t.Errorf("backoff = %v, want at most %v", got, maxBackoff)
+	}
+}
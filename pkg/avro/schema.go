@@ -0,0 +1,353 @@
+// Package avro implements the Apache Avro binary encoding of the published
+// messages, with the schemas derived from the Go types of the messages, and a
+// Confluent compatible schema registry client.
+package avro
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// kind is the Avro type of a schema node, nullable is the ["null", T] union.
+type kind int
+
+const (
+	kindBoolean kind = iota
+	kindInt
+	kindLong
+	kindFloat
+	kindDouble
+	kindString
+	kindBytes
+	// kindJSON is a string carrying the JSON text of a value without a fixed
+	// structure, the interfaces and the types with a custom JSON encoding.
+	kindJSON
+	kindArray
+	kindMap
+	kindRecord
+	kindNullable
+)
+
+var (
+	jsonMarshaler = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshaler = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+type node struct {
+	kind kind
+	// elem is the type of the array items, the map values or the non null
+	// branch of a nullable union
+	elem *node
+	// name is the full name of a record
+	name   string
+	fields []field
+}
+
+type field struct {
+	// key is the JSON key of the field, name its Avro name
+	key  string
+	name string
+	node *node
+}
+
+// Schema is the Avro schema of a Go type, the records are named after the Go
+// types in the namespace of their package, gobmp.message.UnicastPrefix for
+// message.UnicastPrefix. The fields are named after the JSON keys, the pointer
+// and omitempty fields are nullable and the values of the interfaces and of the
+// types with a custom JSON encoding are carried as their JSON text, empty when
+// null.
+type Schema struct {
+	root *node
+	json string
+}
+
+// NewSchema returns the schema of the Go type t, a struct or a pointer to a
+// struct.
+func NewSchema(t reflect.Type) (*Schema, error) {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("type %s is not a struct", t)
+	}
+	b := &builder{records: make(map[reflect.Type]*node)}
+	root, err := b.node(t, "")
+	if err != nil {
+		return nil, err
+	}
+	j, err := json.Marshal(root.schema(make(map[string]bool)))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Schema{root: root, json: string(j)}, nil
+}
+
+// FullName returns the full name of the schema record.
+func (s *Schema) FullName() string {
+	return s.root.name
+}
+
+// String returns the JSON encoding of the schema.
+func (s *Schema) String() string {
+	return s.json
+}
+
+type builder struct {
+	records map[reflect.Type]*node
+}
+
+// node returns the schema node of t, name is the full name given to t when t
+// is an unnamed struct.
+func (b *builder) node(t reflect.Type, name string) (*node, error) {
+	if t.Implements(jsonMarshaler) || reflect.PointerTo(t).Implements(jsonMarshaler) {
+		return &node{kind: kindJSON}, nil
+	}
+	if t.Implements(textMarshaler) || reflect.PointerTo(t).Implements(textMarshaler) {
+		return &node{kind: kindString}, nil
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		return &node{kind: kindBoolean}, nil
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16:
+		return &node{kind: kindInt}, nil
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint32, reflect.Uint64:
+		return &node{kind: kindLong}, nil
+	case reflect.Float32:
+		return &node{kind: kindFloat}, nil
+	case reflect.Float64:
+		return &node{kind: kindDouble}, nil
+	case reflect.String:
+		return &node{kind: kindString}, nil
+	case reflect.Interface:
+		return &node{kind: kindJSON}, nil
+	case reflect.Ptr:
+		n, err := b.node(t.Elem(), name)
+		if err != nil {
+			return nil, err
+		}
+		return nullable(n), nil
+	case reflect.Slice, reflect.Array:
+		// encoding/json encodes the byte slices in base64, not the byte arrays
+		if t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8 {
+			return &node{kind: kindBytes}, nil
+		}
+		n, err := b.node(t.Elem(), name)
+		if err != nil {
+			return nil, err
+		}
+		return &node{kind: kindArray, elem: n}, nil
+	case reflect.Map:
+		switch t.Key().Kind() {
+		case reflect.String, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		default:
+			if !t.Key().Implements(textMarshaler) {
+				return nil, fmt.Errorf("unsupported map key type %s", t.Key())
+			}
+		}
+		n, err := b.node(t.Elem(), name)
+		if err != nil {
+			return nil, err
+		}
+		return &node{kind: kindMap, elem: n}, nil
+	case reflect.Struct:
+		return b.record(t, name)
+	}
+
+	return nil, fmt.Errorf("unsupported type %s", t)
+}
+
+func (b *builder) record(t reflect.Type, name string) (*node, error) {
+	if n, ok := b.records[t]; ok {
+		return n, nil
+	}
+	if t.Name() != "" {
+		name = fullName(t)
+	}
+	n := &node{kind: kindRecord, name: name}
+	// The record is registered before its fields for the recursive types
+	b.records[t] = n
+	seen := make(map[string]bool)
+	if err := b.fields(n, t, seen); err != nil {
+		return nil, fmt.Errorf("%s: %w", t, err)
+	}
+
+	return n, nil
+}
+
+// fields appends the fields of the struct t to the record n, the fields of
+// the embedded structs are promoted as encoding/json does.
+func (b *builder) fields(n *node, t reflect.Type, seen map[string]bool) error {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		key, opts, _ := strings.Cut(tag, ",")
+		ft := f.Type
+		if f.Anonymous && key == "" {
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				if err := b.fields(n, ft, seen); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if key == "" {
+			key = f.Name
+		}
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		var fn *node
+		if hasOption(opts, "string") {
+			fn = &node{kind: kindString}
+		} else {
+			var err error
+			if fn, err = b.node(ft, n.name+"_"+f.Name); err != nil {
+				return fmt.Errorf("field %s: %w", f.Name, err)
+			}
+		}
+		if hasOption(opts, "omitempty") {
+			fn = nullable(fn)
+		}
+		n.fields = append(n.fields, field{key: key, name: avroName(key), node: fn})
+	}
+
+	return nil
+}
+
+func hasOption(opts, opt string) bool {
+	for _, o := range strings.Split(opts, ",") {
+		if o == opt {
+			return true
+		}
+	}
+	return false
+}
+
+func nullable(n *node) *node {
+	if n.kind == kindNullable {
+		return n
+	}
+	return &node{kind: kindNullable, elem: n}
+}
+
+// fullName returns the Avro full name of a named Go type, the gobmp packages
+// are in the gobmp namespace.
+func fullName(t reflect.Type) string {
+	p := strings.TrimPrefix(t.PkgPath(), "github.com/sbezverk/gobmp/pkg/")
+	ns := strings.Split(p, "/")
+	for i := range ns {
+		ns[i] = avroName(ns[i])
+	}
+	if p != t.PkgPath() {
+		ns = append([]string{"gobmp"}, ns...)
+	}
+
+	return strings.Join(ns, ".") + "." + avroName(t.Name())
+}
+
+// avroName returns s with the characters not allowed in the Avro names
+// replaced by underscores.
+func avroName(s string) string {
+	b := []byte(s)
+	for i, c := range b {
+		if c != '_' && (c < 'a' || c > 'z') && (c < 'A' || c > 'Z') && (i == 0 || c < '0' || c > '9') {
+			b[i] = '_'
+		}
+	}
+	return string(b)
+}
+
+type recordSchema struct {
+	Type      string        `json:"type"`
+	Name      string        `json:"name"`
+	Namespace string        `json:"namespace,omitempty"`
+	Fields    []fieldSchema `json:"fields"`
+}
+
+type fieldSchema struct {
+	Name    string          `json:"name"`
+	Type    interface{}     `json:"type"`
+	Default json.RawMessage `json:"default,omitempty"`
+}
+
+type containerSchema struct {
+	Type   string      `json:"type"`
+	Items  interface{} `json:"items,omitempty"`
+	Values interface{} `json:"values,omitempty"`
+}
+
+// schema returns the JSON value of the schema of n, the records already
+// defined are referenced by their full name.
+func (n *node) schema(defined map[string]bool) interface{} {
+	switch n.kind {
+	case kindBoolean:
+		return "boolean"
+	case kindInt:
+		return "int"
+	case kindLong:
+		return "long"
+	case kindFloat:
+		return "float"
+	case kindDouble:
+		return "double"
+	case kindString, kindJSON:
+		return "string"
+	case kindBytes:
+		return "bytes"
+	case kindArray:
+		return containerSchema{Type: "array", Items: n.elem.schema(defined)}
+	case kindMap:
+		return containerSchema{Type: "map", Values: n.elem.schema(defined)}
+	case kindNullable:
+		return []interface{}{"null", n.elem.schema(defined)}
+	}
+	if defined[n.name] {
+		return n.name
+	}
+	defined[n.name] = true
+	r := recordSchema{Type: "record", Fields: make([]fieldSchema, 0, len(n.fields))}
+	r.Name = n.name
+	if i := strings.LastIndexByte(n.name, '.'); i >= 0 {
+		r.Namespace, r.Name = n.name[:i], n.name[i+1:]
+	}
+	for _, f := range n.fields {
+		r.Fields = append(r.Fields, fieldSchema{Name: f.name, Type: f.node.schema(defined), Default: f.node.defaultValue()})
+	}
+
+	return r
+}
+
+// defaultValue returns the default of a field of type n, the zero value of its
+// JSON encoding, none for the records.
+func (n *node) defaultValue() json.RawMessage {
+	switch n.kind {
+	case kindBoolean:
+		return json.RawMessage("false")
+	case kindInt, kindLong, kindFloat, kindDouble:
+		return json.RawMessage("0")
+	case kindString, kindJSON, kindBytes:
+		return json.RawMessage(`""`)
+	case kindArray:
+		return json.RawMessage("[]")
+	case kindMap:
+		return json.RawMessage("{}")
+	case kindNullable:
+		return json.RawMessage("null")
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package avro
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/sbezverk/gobmp/pkg/bmp"
+)
+
+type testBase struct {
+	Origin string `json:"origin,omitempty"`
+}
+
+type testHop struct {
+	ASN  uint32   `json:"asn"`
+	Next *testHop `json:"next,omitempty"`
+}
+
+type testMessage struct {
+	testBase
+	Action   string           `json:"action"`
+	Len      int32            `json:"len"`
+	Weight   *float64         `json:"weight,omitempty"`
+	Hops     []testHop        `json:"hops"`
+	Labels   map[uint8]string `json:"labels,omitempty"`
+	Info     []byte           `json:"info,omitempty"`
+	Raw      json.RawMessage  `json:"raw,omitempty"`
+	Decoded  interface{}      `json:"decoded,omitempty"`
+	Inline   struct{ A bool } `json:"inline"`
+	Ignored  string           `json:"-"`
+	internal string
+	Extra    map[string]interface{} `json:"extra-attrs,omitempty"`
+}
+
+func TestNewSchema(t *testing.T) {
+	s, err := NewSchema(reflect.TypeOf(&testMessage{}))
+	if err != nil {
+		t.Fatalf("NewSchema() error: %v", err)
+	}
+	want := `{"type":"record","name":"testMessage","namespace":"gobmp.avro","fields":[` +
+		`{"name":"origin","type":["null","string"],"default":null},` +
+		`{"name":"action","type":"string","default":""},` +
+		`{"name":"len","type":"int","default":0},` +
+		`{"name":"weight","type":["null","double"],"default":null},` +
+		`{"name":"hops","type":{"type":"array","items":{"type":"record","name":"testHop","namespace":"gobmp.avro","fields":[` +
+		`{"name":"asn","type":"long","default":0},` +
+		`{"name":"next","type":["null","gobmp.avro.testHop"],"default":null}]}},"default":[]},` +
+		`{"name":"labels","type":["null",{"type":"map","values":"string"}],"default":null},` +
+		`{"name":"info","type":["null","bytes"],"default":null},` +
+		`{"name":"raw","type":["null","string"],"default":null},` +
+		`{"name":"decoded","type":["null","string"],"default":null},` +
+		`{"name":"inline","type":{"type":"record","name":"testMessage_Inline","namespace":"gobmp.avro","fields":[` +
+		`{"name":"A","type":"boolean","default":false}]}},` +
+		`{"name":"extra_attrs","type":["null",{"type":"map","values":"string"}],"default":null}]}`
+	if s.String() != want {
+		t.Errorf("String() =\n%s\nwant\n%s", s.String(), want)
+	}
+	if s.FullName() != "gobmp.avro.testMessage" {
+		t.Errorf("FullName() = %s", s.FullName())
+	}
+	if _, err := NewSchema(reflect.TypeOf("")); err == nil {
+		t.Error("NewSchema() expected error for a string")
+	}
+	if _, err := NewSchema(reflect.TypeOf(struct{ C chan int }{})); err == nil {
+		t.Error("NewSchema() expected error for a chan field")
+	}
+}
+
+func TestMessageSchema(t *testing.T) {
+	for msgType := range messageTypes {
+		s, err := MessageSchema(msgType)
+		if err != nil {
+			t.Fatalf("MessageSchema(%d) error: %v", msgType, err)
+		}
+		if !json.Valid([]byte(s.String())) {
+			t.Fatalf("MessageSchema(%d) is not valid JSON", msgType)
+		}
+		// Every field is optional in the JSON messages
+		if _, err := s.Encode([]byte(`{}`)); err != nil {
+			t.Errorf("MessageSchema(%d).Encode({}) error: %v", msgType, err)
+		}
+	}
+	s, _ := MessageSchema(bmp.UnicastPrefixV4Msg)
+	if s2, _ := MessageSchema(bmp.UnicastPrefixV6Msg); s2 != s || s.FullName() != "gobmp.message.UnicastPrefix" {
+		t.Errorf("unicast prefix schemas differ or are misnamed: %s", s.FullName())
+	}
+	if _, err := MessageSchema(bmp.BMPRawMsg); err == nil {
+		t.Error("MessageSchema(BMPRawMsg) expected error")
+	}
+}
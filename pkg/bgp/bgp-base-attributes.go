@@ -44,14 +44,17 @@ type BaseAttributes struct {
 	CommunityList  []string        `json:"community_list,omitempty"`
 	// WellKnownCommunityList holds IANA symbolic names for any well-known
 	// communities present in CommunityList (RFC 1997 and related).
-	WellKnownCommunityList []string         `json:"well_known_community_list,omitempty"`
-	OriginatorID           string           `json:"originator_id,omitempty"`
-	ClusterList            string           `json:"cluster_list,omitempty"`
-	ExtCommunityList       []string         `json:"ext_community_list,omitempty"`
-	AS4Path                []uint32         `json:"as4_path,omitempty"`
-	AS4PathCount           int32            `json:"as4_path_count,omitempty"`
-	AS4Aggregator          []byte           `json:"as4_aggregator,omitempty"`
-	PMSITunnel             *pmsi.PMSITunnel `json:"pmsi_tunnel,omitempty"` // RFC 6514 PMSI Tunnel Attribute (Type 22)
+	WellKnownCommunityList []string `json:"well_known_community_list,omitempty"`
+	OriginatorID           string   `json:"originator_id,omitempty"`
+	ClusterList            string   `json:"cluster_list,omitempty"`
+	ExtCommunityList       []string `json:"ext_community_list,omitempty"`
+	// ExtCommunities is the optional structured form of ExtCommunityList,
+	// populated by PopulateExtCommunities.
+	ExtCommunities []ExtCommunityDetail `json:"ext_communities,omitempty"`
+	AS4Path        []uint32             `json:"as4_path,omitempty"`
+	AS4PathCount   int32                `json:"as4_path_count,omitempty"`
+	AS4Aggregator  []byte               `json:"as4_aggregator,omitempty"`
+	PMSITunnel     *pmsi.PMSITunnel     `json:"pmsi_tunnel,omitempty"` // RFC 6514 PMSI Tunnel Attribute (Type 22)
 	// TunnelEncapAttr retains the raw RFC 9012 Tunnel Encapsulation Attribute
 	// (path attribute type 23) bytes. Exposed in JSON so downstream consumers
 	// can recover the original payload when UnmarshalTunnelEncapsulation
@@ -66,30 +69,49 @@ type BaseAttributes struct {
 	TunnelEncapMalformed bool `json:"tunnel_encap_malformed,omitempty"`
 	// TraficEng
 	IPv6ExtCommunityList []string `json:"ipv6_ext_community_list,omitempty"` // RFC 5701
-	AIGP                 *AIGP    `json:"aigp,omitempty"`                    // RFC 7311 AIGP Attribute (Type 26)
+	// IPv6ExtCommunities is the optional structured form of
+	// IPv6ExtCommunityList, populated by PopulateExtCommunities.
+	IPv6ExtCommunities []ExtCommunityDetail `json:"ipv6_ext_communities,omitempty"`
+	AIGP               *AIGP                `json:"aigp,omitempty"` // RFC 7311 AIGP Attribute (Type 26)
 	// PEDistinguisherLable
 	LgCommunityList []string      `json:"large_community_list,omitempty"`
 	BGPPrefixSID    *BGPPrefixSID `json:"bgp_prefix_sid,omitempty"`
 	OTC             uint32        `json:"otc,omitempty"` // RFC 9234 Only to Customer (OTC) Attribute (Type 35)
+	// DPath is the decoded BGP Domain Path attribute (Type 36),
+	// draft-ietf-bess-evpn-ipvpn-interworking.
+	DPath *DPath `json:"d_path,omitempty"`
+	// SFP is the decoded RFC 9015 SFP attribute (Type 37).
+	SFP *SFP `json:"sfp,omitempty"`
+	// BFDDiscriminator is the decoded RFC 9026 BFD Discriminator attribute (Type 38).
+	BFDDiscriminator *BFDDiscriminator `json:"bfd_discriminator,omitempty"`
 	// SecPath
 	AttrSet *AttrSet `json:"attr_set,omitempty"` // RFC 6368 ATTR_SET Attribute (Type 128)
-	// UnknownAttributes preserves any path attribute whose Type code is not
-	// recognised by this parser. RFC 4271 §5 requires speakers to forward
-	// transitive unrecognised attributes with the Partial bit set; a passive
-	// BMP collector does not forward, but exposing the raw bytes lets
-	// downstream consumers see attributes the collector does not yet decode
-	// instead of silently dropping them.
+	// UnknownAttributes preserves any path attribute which is not fully
+	// decoded by this parser: Type codes that are not recognised, recognised
+	// codes without a decoder (deprecated or not yet implemented), and
+	// attributes whose decoder rejected the encoding. RFC 4271 §5 requires
+	// speakers to forward transitive unrecognised attributes with the Partial
+	// bit set; a passive BMP collector does not forward, but exposing the raw
+	// bytes lets downstream consumers see attributes the collector does not
+	// decode instead of silently dropping them.
 	UnknownAttributes []UnknownPathAttribute `json:"unknown_attributes,omitempty"`
+	// AttrErrors records per-attribute decode failures. Each failing
+	// attribute is also preserved in UnknownAttributes.
+	AttrErrors []PathAttributeError `json:"attr_errors,omitempty"`
 
 	// bgplsParsed memoizes the parsed BGP-LS Attribute (path attribute 29) so
 	// repeated GetBGPLSAttribute calls reuse a single allocation. Eager
 	// validation on receipt uses a non-allocating walk; this cache is populated
 	// on the first detailed decode requested by a producer.
 	bgplsParsed *bgpls.NLRI `json:"-"`
+	// extCommRaw and ipv6ExtCommRaw keep the values of path attributes 16
+	// and 25 so the structured communities are only decoded when requested.
+	extCommRaw     []byte `json:"-"`
+	ipv6ExtCommRaw []byte `json:"-"`
 }
 
-// UnknownPathAttribute is the raw form of a BGP path attribute whose Type
-// code is not recognised by unmarshalBaseAttrsFromSlice. Flags is the full
+// UnknownPathAttribute is the raw form of a BGP path attribute which is not
+// fully decoded by unmarshalBaseAttrsFromSlice. Flags is the full
 // flags byte (RFC 4271 §4.3 — Optional (0x80)/Transitive (0x40)/Partial (0x20)/Extended Length (0x10)
 // occupy the high nibble; low nibble bits 3-0 are reserved and MUST be zero).
 type UnknownPathAttribute struct {
@@ -98,6 +120,12 @@ type UnknownPathAttribute struct {
 	Value []byte `json:"value,omitempty"`
 }
 
+// PathAttributeError describes why a path attribute could not be decoded.
+type PathAttributeError struct {
+	Type  uint8  `json:"type"`
+	Error string `json:"error"`
+}
+
 func (ba *BaseAttributes) Equal(oba *BaseAttributes) (bool, []string) {
 	equal := true
 	diffs := make([]string, 0)
@@ -187,6 +215,10 @@ func (ba *BaseAttributes) Equal(oba *BaseAttributes) (bool, []string) {
 		equal = false
 		diffs = append(diffs, "ext_community_list mismatch")
 	}
+	if !equalExtCommunityDetails(ba.ExtCommunities, oba.ExtCommunities) {
+		equal = false
+		diffs = append(diffs, "ext_communities mismatch")
+	}
 	if !reflect.DeepEqual(sort.SortMergeComparableSlice(ba.AS4Path), sort.SortMergeComparableSlice(oba.AS4Path)) {
 		equal = false
 		diffs = append(diffs, "as4_path mismatch")
@@ -211,10 +243,26 @@ func (ba *BaseAttributes) Equal(oba *BaseAttributes) (bool, []string) {
 		equal = false
 		diffs = append(diffs, "ipv6_ext_community_list mismatch")
 	}
+	if !equalExtCommunityDetails(ba.IPv6ExtCommunities, oba.IPv6ExtCommunities) {
+		equal = false
+		diffs = append(diffs, "ipv6_ext_communities mismatch")
+	}
 	if ba.OTC != oba.OTC {
 		equal = false
 		diffs = append(diffs, "otc mismatch: "+strconv.FormatUint(uint64(ba.OTC), 10)+" and "+strconv.FormatUint(uint64(oba.OTC), 10))
 	}
+	if !reflect.DeepEqual(ba.DPath, oba.DPath) {
+		equal = false
+		diffs = append(diffs, "d_path mismatch")
+	}
+	if !reflect.DeepEqual(ba.SFP, oba.SFP) {
+		equal = false
+		diffs = append(diffs, "sfp mismatch")
+	}
+	if !reflect.DeepEqual(ba.BFDDiscriminator, oba.BFDDiscriminator) {
+		equal = false
+		diffs = append(diffs, "bfd_discriminator mismatch")
+	}
 	if asEqual, asDiffs := ba.AttrSet.Equal(oba.AttrSet); !asEqual {
 		equal = false
 		diffs = append(diffs, asDiffs...)
@@ -231,6 +279,12 @@ func (ba *BaseAttributes) Equal(oba *BaseAttributes) (bool, []string) {
 		equal = false
 		diffs = append(diffs, "unknown_attributes mismatch")
 	}
+	if len(ba.AttrErrors) != 0 || len(oba.AttrErrors) != 0 {
+		if !reflect.DeepEqual(ba.AttrErrors, oba.AttrErrors) {
+			equal = false
+			diffs = append(diffs, "attr_errors mismatch")
+		}
+	}
 
 	return equal, diffs
 
@@ -257,6 +311,56 @@ func equalUnknownAttributes(a, b []UnknownPathAttribute) bool {
 	return true
 }
 
+// equalExtCommunityDetails compares two structured community slices by their
+// raw encoding, order independent. Type, Name, Value and Decoded are all
+// derived from Raw, and Decoded does not survive a JSON round trip as the
+// same Go type, so comparing them would report false mismatches.
+func equalExtCommunityDetails(a, b []ExtCommunityDetail) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	ra := make([]string, len(a))
+	rb := make([]string, len(b))
+	for i := range a {
+		ra[i] = a[i].Raw
+		rb[i] = b[i].Raw
+	}
+	return reflect.DeepEqual(sort.SortMergeComparableSlice(ra), sort.SortMergeComparableSlice(rb))
+}
+
+// GetExtCommunities returns the structured form of the Extended Communities
+// (path attribute 16) carried by the update, nil when none are present.
+func (ba *BaseAttributes) GetExtCommunities() []ExtCommunityDetail {
+	if ba.ExtCommunities != nil {
+		return ba.ExtCommunities
+	}
+	if len(ba.extCommRaw) == 0 {
+		return nil
+	}
+	details, err := UnmarshalBGPExtCommunityDetail(ba.extCommRaw)
+	if err != nil {
+		return nil
+	}
+	return details
+}
+
+// GetIPv6ExtCommunities returns the structured form of the IPv6 Address
+// Specific Extended Communities (path attribute 25), nil when none are present.
+func (ba *BaseAttributes) GetIPv6ExtCommunities() []ExtCommunityDetail {
+	if ba.IPv6ExtCommunities != nil {
+		return ba.IPv6ExtCommunities
+	}
+	return UnmarshalBGPIPv6ExtCommunityDetail(ba.ipv6ExtCommRaw)
+}
+
+// PopulateExtCommunities fills ExtCommunities and IPv6ExtCommunities so the
+// structured representation is included in the JSON output alongside the
+// string lists.
+func (ba *BaseAttributes) PopulateExtCommunities() {
+	ba.ExtCommunities = ba.GetExtCommunities()
+	ba.IPv6ExtCommunities = ba.GetIPv6ExtCommunities()
+}
+
 // UnmarshalBGPBaseAttributes discovers all present Base Attributes in a BGP
 // Update and instantiates a BaseAttributes object. AS_PATH width is inferred
 // by heuristic; use UnmarshalBGPBaseAttributesWithAS4Hint when the caller has
@@ -319,32 +423,45 @@ func unmarshalBaseAttrsFromSlice(attrs []PathAttribute, as4hint *bool) (*BaseAtt
 			}
 		case 11:
 			// DPA (deprecated) - RFC 6938
+			baseAttr.preserveAttribute(attr)
 		case 12:
 			// ADVERTISER (deprecated) - RFC 1863, RFC 6938
+			baseAttr.preserveAttribute(attr)
 		case 13:
 			// RCID_PATH / CLUSTER_ID (deprecated) - RFC 1863, RFC 6938
+			baseAttr.preserveAttribute(attr)
 		case 14:
 			// MP_REACH_NLRI - RFC 4760 (parsed separately in path attribute parser)
 		case 15:
 			// MP_UNREACH_NLRI - RFC 4760 (parsed separately in path attribute parser)
 		case 16:
 			baseAttr.ExtCommunityList = unmarshalAttrExtCommunity(b)
+			baseAttr.extCommRaw = b
 		case 17:
-			baseAttr.AS4Path = unmarshalAttrAS4Path(b)
+			path, err := unmarshalAttrAS4Path(b)
+			if err != nil {
+				glog.Errorf("failed to unmarshal AS4_PATH attribute: %v", err)
+				baseAttr.recordAttrError(attr, err)
+			}
+			baseAttr.AS4Path = path
 			baseAttr.AS4PathCount = int32(len(baseAttr.AS4Path))
 		case 18:
 			baseAttr.AS4Aggregator = unmarshalAttrAS4Aggregator(b)
 		case 19:
 			// SAFI Specific Attribute (SSA, deprecated)
+			baseAttr.preserveAttribute(attr)
 		case 20:
 			// Connector Attribute (deprecated) - RFC 6037
+			baseAttr.preserveAttribute(attr)
 		case 21:
 			// AS_PATHLIMIT (deprecated) - draft-ietf-idr-as-pathlimit
+			baseAttr.preserveAttribute(attr)
 		case 22:
 			// RFC 6514 PMSI Tunnel Attribute
 			tunnel, err := pmsi.ParsePMSITunnel(b)
 			if err != nil {
 				glog.Errorf("failed to parse PMSI Tunnel attribute: %v", err)
+				baseAttr.recordAttrError(attr, err)
 			} else {
 				baseAttr.PMSITunnel = tunnel
 			}
@@ -361,26 +478,32 @@ func unmarshalBaseAttrsFromSlice(attrs []PathAttribute, as4hint *bool) (*BaseAtt
 			if err != nil {
 				glog.Errorf("failed to parse Tunnel Encapsulation attribute (path attribute type 23) per RFC 9012: %v", err)
 				baseAttr.TunnelEncapMalformed = true
+				baseAttr.recordAttrError(attr, err)
 			} else {
 				baseAttr.TunnelEncap = te
 			}
 		case 24:
 			// Traffic Engineering - RFC 5543
+			baseAttr.preserveAttribute(attr)
 		case 25:
 			// IPv6 Address Specific Extended Community - RFC 5701
 			baseAttr.IPv6ExtCommunityList = unmarshalAttrIPv6ExtCommunity(b)
+			baseAttr.ipv6ExtCommRaw = b
 		case 26:
 			// RFC 7311: AIGP Attribute
 			aigp, err := UnmarshalAIGP(b)
 			if err != nil {
 				glog.Errorf("failed to unmarshal AIGP attribute with error: %+v", err)
+				baseAttr.recordAttrError(attr, err)
 			} else {
 				baseAttr.AIGP = aigp
 			}
 		case 27:
 			// PE Distinguisher Labels - RFC 6514
+			baseAttr.preserveAttribute(attr)
 		case 28:
 			// BGP Entropy Label Capability Attribute (deprecated) - RFC 6790, RFC 7447
+			baseAttr.preserveAttribute(attr)
 		case 29:
 			// BGP-LS Attribute - RFC 9552 §5.3.
 			// Eagerly validate the TLV stream structure on receipt so a
@@ -395,28 +518,55 @@ func unmarshalBaseAttrsFromSlice(attrs []PathAttribute, as4hint *bool) (*BaseAtt
 			// forensics; we do not mutate the slice here.
 			if err := bgpls.ValidateBGPLSTLV(b); err != nil {
 				glog.Errorf("malformed BGP-LS Attribute (path attribute type 29); content will be skipped in emitted messages per RFC 7606 §3 / RFC 9552 §5.3: %v", err)
+				baseAttr.recordAttrError(attr, err)
 			}
 		case 30:
 			// Deprecated - RFC 8093
+			baseAttr.preserveAttribute(attr)
 		case 31:
 			// Deprecated - RFC 8093
+			baseAttr.preserveAttribute(attr)
 		case 32:
 			baseAttr.LgCommunityList = unmarshalAttrLgCommunity(b)
 		case 33:
 			// BGPsec_Path - RFC 8205
+			baseAttr.preserveAttribute(attr)
 		case 34:
 			// BGP Community Container Attribute (TEMPORARY) - draft-ietf-idr-wide-bgp-communities
+			baseAttr.preserveAttribute(attr)
 		case 35:
 			// Only to Customer (OTC) - RFC 9234
 			baseAttr.OTC = unmarshalAttrOTC(b)
 		case 36:
 			// BGP Domain Path (D-PATH, TEMPORARY) - draft-ietf-bess-evpn-ipvpn-interworking
+			dpath, err := UnmarshalDPath(b)
+			if err != nil {
+				glog.Errorf("failed to unmarshal D-PATH attribute: %v", err)
+				baseAttr.recordAttrError(attr, err)
+			} else {
+				baseAttr.DPath = dpath
+			}
 		case 37:
 			// SFP attribute - RFC 9015
+			sfp, err := UnmarshalSFP(b)
+			if err != nil {
+				glog.Errorf("failed to unmarshal SFP attribute: %v", err)
+				baseAttr.recordAttrError(attr, err)
+			} else {
+				baseAttr.SFP = sfp
+			}
 		case 38:
 			// BFD Discriminator - RFC 9026
+			bfd, err := UnmarshalBFDDiscriminator(b)
+			if err != nil {
+				glog.Errorf("failed to unmarshal BFD Discriminator attribute: %v", err)
+				baseAttr.recordAttrError(attr, err)
+			} else {
+				baseAttr.BFDDiscriminator = bfd
+			}
 		case 39:
 			// BGP Next Hop Dependent Characteristic (NHC, TEMPORARY) - draft-ietf-idr-nhc
+			baseAttr.preserveAttribute(attr)
 		case 40:
 			// RFC 8669: BGP Prefix-SID
 			var err error
@@ -424,16 +574,20 @@ func unmarshalBaseAttrsFromSlice(attrs []PathAttribute, as4hint *bool) (*BaseAtt
 			if err != nil {
 				baseAttr.BGPPrefixSID = nil
 				glog.Errorf("failed to unmarshal BGP Prefix-SID attribute with error: %+v", err)
+				baseAttr.recordAttrError(attr, err)
 			}
 		case 41:
 			// BIER - RFC 9793
+			baseAttr.preserveAttribute(attr)
 		case 42:
 			// Edge Metadata Path Attribute (TEMPORARY) - draft-ietf-idr-5g-edge-service-metadata
+			baseAttr.preserveAttribute(attr)
 		case 128:
 			// ATTR_SET - RFC 6368
 			attrSet, err := UnmarshalAttrSet(b)
 			if err != nil {
 				glog.Errorf("failed to unmarshal ATTR_SET attribute: %v", err)
+				baseAttr.recordAttrError(attr, err)
 			} else {
 				baseAttr.AttrSet = attrSet
 			}
@@ -445,16 +599,7 @@ func unmarshalBaseAttrsFromSlice(attrs []PathAttribute, as4hint *bool) (*BaseAtt
 			// Optional/Transitive forwarding distinction is not applied here;
 			// the full flags byte is preserved on UnknownPathAttribute so
 			// consumers can apply their own policy.
-			// b aliases the fresh per-attribute buffer allocated in
-			// unmarshalRawPathAttributes, so no extra copy is needed.
-			ua := UnknownPathAttribute{
-				Type:  attr.AttributeType,
-				Flags: attr.AttributeTypeFlags,
-			}
-			if len(b) > 0 {
-				ua.Value = b
-			}
-			baseAttr.UnknownAttributes = append(baseAttr.UnknownAttributes, ua)
+			baseAttr.preserveAttribute(attr)
 		}
 	}
 	// Hash the raw attribute bytes directly instead of marshaling to JSON
@@ -468,6 +613,30 @@ func unmarshalBaseAttrsFromSlice(attrs []PathAttribute, as4hint *bool) (*BaseAtt
 	return &baseAttr, nil
 }
 
+// preserveAttribute appends attr to UnknownAttributes keeping its full flags
+// byte and raw value. attr.Attribute aliases the fresh per-attribute buffer
+// allocated in unmarshalRawPathAttributes, so no extra copy is needed.
+func (ba *BaseAttributes) preserveAttribute(attr PathAttribute) {
+	ua := UnknownPathAttribute{
+		Type:  attr.AttributeType,
+		Flags: attr.AttributeTypeFlags,
+	}
+	if len(attr.Attribute) > 0 {
+		ua.Value = attr.Attribute
+	}
+	ba.UnknownAttributes = append(ba.UnknownAttributes, ua)
+}
+
+// recordAttrError records a decode failure of attr in AttrErrors and
+// preserves the raw attribute in UnknownAttributes.
+func (ba *BaseAttributes) recordAttrError(attr PathAttribute, err error) {
+	ba.AttrErrors = append(ba.AttrErrors, PathAttributeError{
+		Type:  attr.AttributeType,
+		Error: err.Error(),
+	})
+	ba.preserveAttribute(attr)
+}
+
 // unmarshalAttrOrigin returns the value of Origin attribute
 func unmarshalAttrOrigin(b []byte) string {
 	if len(b) == 0 {
@@ -710,13 +879,13 @@ func unmarshalAttrLgCommunity(b []byte) []string {
 	return s
 }
 
-// unmarshalAttrAS4Path returns a sequence of AS4 path segments
-func unmarshalAttrAS4Path(b []byte) []uint32 {
+// unmarshalAttrAS4Path returns a sequence of AS4 path segments, the ASes of
+// the segments preceding a truncated segment are returned with the error.
+func unmarshalAttrAS4Path(b []byte) ([]uint32, error) {
 	path := make([]uint32, 0, len(b)/4)
 	for p := 0; p < len(b); {
 		if p+2 > len(b) {
-			glog.Errorf("AS4_PATH truncated at segment header: offset %d, len %d", p, len(b))
-			break
+			return path, fmt.Errorf("AS4_PATH truncated at segment header: offset %d, len %d", p, len(b))
 		}
 		// Segment type byte
 		p++
@@ -724,8 +893,7 @@ func unmarshalAttrAS4Path(b []byte) []uint32 {
 		l := int(b[p])
 		p++
 		if p+l*4 > len(b) {
-			glog.Errorf("AS4_PATH truncated: segment needs %d bytes, have %d", l*4, len(b)-p)
-			break
+			return path, fmt.Errorf("AS4_PATH truncated: segment needs %d bytes, have %d", l*4, len(b)-p)
 		}
 		for n := 0; n < l; n++ {
 			as := binary.BigEndian.Uint32(b[p : p+4])
@@ -734,7 +902,7 @@ func unmarshalAttrAS4Path(b []byte) []uint32 {
 		}
 	}
 
-	return path
+	return path, nil
 }
 
 // getAttrAS4Aggregator returns the value of AS4 AGGREGATOR attribute
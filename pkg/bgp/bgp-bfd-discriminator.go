@@ -0,0 +1,86 @@
+package bgp
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+)
+
+const (
+	// BFDModeP2MP is the only BFD Mode defined by RFC 9026 §3.1.6
+	BFDModeP2MP = 1
+	// BFDSourceIPTLV is the IP Source Address optional TLV type
+	BFDSourceIPTLV = 1
+)
+
+// BFDDiscriminator defines the BFD Discriminator attribute structure per
+// RFC 9026 §3.1.6 (path attribute type 38).
+type BFDDiscriminator struct {
+	Mode          uint8     `json:"mode"`
+	Discriminator uint32    `json:"discriminator"`
+	SourceIP      string    `json:"source_ip,omitempty"`
+	TLVs          []BFDDTLV `json:"tlvs,omitempty"`
+}
+
+// BFDDTLV is an optional BFD Discriminator TLV other than the IP Source
+// Address TLV, retained with its raw value.
+type BFDDTLV struct {
+	Type  uint8  `json:"type"`
+	Value []byte `json:"value,omitempty"`
+}
+
+// UnmarshalBFDDiscriminator parses the BFD Discriminator attribute value.
+//
+// Wire format:
+//
+//	+-----------------------------------------+
+//	| BFD Mode (1 octet)                      |
+//	+-----------------------------------------+
+//	| Reserved (3 octets)                     |
+//	+-----------------------------------------+
+//	| BFD Discriminator (4 octets)            |
+//	+-----------------------------------------+
+//	| Optional TLVs (variable)                |
+//	+-----------------------------------------+
+//
+// Optional TLVs carry a 1 octet Type and a 1 octet Length. Type 1 is the IP
+// Source Address TLV whose value is a 4 or 16 octet address.
+func UnmarshalBFDDiscriminator(b []byte) (*BFDDiscriminator, error) {
+	if len(b) < 8 {
+		return nil, fmt.Errorf("invalid BFD Discriminator length: %d", len(b))
+	}
+	bfd := &BFDDiscriminator{
+		Mode:          b[0],
+		Discriminator: binary.BigEndian.Uint32(b[4:8]),
+	}
+	for p := 8; p < len(b); {
+		if p+2 > len(b) {
+			return nil, fmt.Errorf("BFD Discriminator TLV truncated at offset %d", p)
+		}
+		t := b[p]
+		l := int(b[p+1])
+		p += 2
+		if p+l > len(b) {
+			return nil, fmt.Errorf("BFD Discriminator TLV type %d length %d exceeds remaining %d bytes", t, l, len(b)-p)
+		}
+		switch t {
+		case BFDSourceIPTLV:
+			if l != net.IPv4len && l != net.IPv6len {
+				return nil, fmt.Errorf("invalid BFD IP Source Address TLV length: %d", l)
+			}
+			bfd.SourceIP = net.IP(b[p : p+l]).String()
+		default:
+			tlv := BFDDTLV{
+				Type: t,
+			}
+			if l > 0 {
+				tlv.Value = make([]byte, l)
+				copy(tlv.Value, b[p:p+l])
+			}
+			bfd.TLVs = append(bfd.TLVs, tlv)
+		}
+		p += l
+	}
+
+	return bfd, nil
+}
@@ -0,0 +1,75 @@
+package bgp
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestUnmarshalBFDDiscriminator(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  []byte
+		expect *BFDDiscriminator
+		fail   bool
+	}{
+		{
+			name:   "no optional tlvs",
+			input:  []byte{0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x30, 0x39},
+			expect: &BFDDiscriminator{Mode: BFDModeP2MP, Discriminator: 12345},
+		},
+		{
+			name: "ipv4 source address",
+			input: []byte{
+				0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x07,
+				0x01, 0x04, 0xc0, 0x00, 0x02, 0x01,
+			},
+			expect: &BFDDiscriminator{Mode: BFDModeP2MP, Discriminator: 7, SourceIP: "192.0.2.1"},
+		},
+		{
+			name: "ipv6 source address and unknown tlv",
+			input: []byte{
+				0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x08,
+				0x01, 0x10, 0x20, 0x01, 0x0d, 0xb8, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x01,
+				0x09, 0x02, 0xaa, 0xbb,
+			},
+			expect: &BFDDiscriminator{
+				Mode:          BFDModeP2MP,
+				Discriminator: 8,
+				SourceIP:      "2001:db8::1",
+				TLVs:          []BFDDTLV{{Type: 9, Value: []byte{0xaa, 0xbb}}},
+			},
+		},
+		{
+			name:  "too short",
+			input: []byte{0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+			fail:  true,
+		},
+		{
+			name:  "invalid source address length",
+			input: []byte{0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x07, 0x01, 0x02, 0x0a, 0x00},
+			fail:  true,
+		},
+		{
+			name:  "truncated tlv",
+			input: []byte{0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x07, 0x01, 0x04, 0x0a},
+			fail:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := UnmarshalBFDDiscriminator(tt.input)
+			if err != nil {
+				if !tt.fail {
+					t.Fatalf("supposed to succeed but failed with error: %+v", err)
+				}
+				return
+			}
+			if tt.fail {
+				t.Fatalf("supposed to fail but succeeded")
+			}
+			if !reflect.DeepEqual(tt.expect, got) {
+				t.Errorf("expected %+v, got %+v", tt.expect, got)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/binary"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/golang/glog"
 	"github.com/sbezverk/tools"
@@ -77,6 +78,42 @@ func (r BGPRole) String() string {
 	}
 }
 
+// ParseBGPRole returns the BGP Role named s, the names are the String values
+// of the roles, matched case insensitively, "_" is accepted for "-".
+func ParseBGPRole(s string) (BGPRole, error) {
+	switch strings.ReplaceAll(strings.ToLower(strings.TrimSpace(s)), "_", "-") {
+	case "provider":
+		return BGPRoleProvider, nil
+	case "rs":
+		return BGPRoleRS, nil
+	case "rs-client":
+		return BGPRoleRSClient, nil
+	case "customer":
+		return BGPRoleCustomer, nil
+	case "peer":
+		return BGPRolePeer, nil
+	}
+	return 0, fmt.Errorf("invalid bgp role %q", s)
+}
+
+// Remote returns the role of the remote side of a session with the local
+// role r, as defined by the allowed Role pairs of RFC 9234 Section 4.2.
+func (r BGPRole) Remote() (BGPRole, bool) {
+	switch r {
+	case BGPRoleProvider:
+		return BGPRoleCustomer, true
+	case BGPRoleCustomer:
+		return BGPRoleProvider, true
+	case BGPRoleRS:
+		return BGPRoleRSClient, true
+	case BGPRoleRSClient:
+		return BGPRoleRS, true
+	case BGPRolePeer:
+		return BGPRolePeer, true
+	}
+	return 0, false
+}
+
 func getAFISAFIString(afi uint16, safi uint8) string {
 	var afiStr, safiStr string
 	switch afi {
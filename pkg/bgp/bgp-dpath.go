@@ -0,0 +1,92 @@
+package bgp
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+const (
+	// DPathDomainSet is the unordered D-PATH segment type (DOMAIN_SET)
+	DPathDomainSet = 1
+	// DPathDomainSequence is the ordered D-PATH segment type (DOMAIN_SEQUENCE)
+	DPathDomainSequence = 2
+)
+
+// DPath defines the BGP Domain Path (D-PATH) attribute structure per
+// draft-ietf-bess-evpn-ipvpn-interworking §6 (path attribute type 36).
+type DPath struct {
+	Segments []DPathSegment `json:"segments,omitempty"`
+}
+
+// DPathSegment is a single D-PATH segment, either a DOMAIN_SET (1) or a
+// DOMAIN_SEQUENCE (2) of domain identifiers.
+type DPathSegment struct {
+	Type    uint8         `json:"type"`
+	Domains []DPathDomain `json:"domains,omitempty"`
+}
+
+// DPathDomain carries a 6 octet DOMAIN-ID, split into a 4 octet Global
+// Administrator and a 2 octet Local Administrator, followed by the 1 octet
+// Inter-Subnet Forwarding SAFI type of the domain.
+type DPathDomain struct {
+	GlobalAdmin uint32 `json:"global_admin"`
+	LocalAdmin  uint16 `json:"local_admin"`
+	ISFSAFIType uint8  `json:"isf_safi_type"`
+}
+
+// String returns the domain in the "GlobalAdmin:LocalAdmin" notation used by
+// the draft, suffixed with the ISF SAFI type.
+func (d DPathDomain) String() string {
+	return fmt.Sprintf("%d:%d/%d", d.GlobalAdmin, d.LocalAdmin, d.ISFSAFIType)
+}
+
+// UnmarshalDPath parses the D-PATH attribute value.
+//
+// Wire format, repeated for each segment:
+//
+//	+-----------------------------------------+
+//	| Segment Type (1 octet)                  |
+//	+-----------------------------------------+
+//	| Segment Length (1 octet)                |
+//	+-----------------------------------------+
+//	| Domain 1..N (7 octets each)             |
+//	+-----------------------------------------+
+//
+// Segment Length is the number of domains in the segment, each domain being
+// DOMAIN-ID (6 octets) followed by the ISF_SAFI_TYPE (1 octet).
+func UnmarshalDPath(b []byte) (*DPath, error) {
+	if len(b) == 0 {
+		return nil, fmt.Errorf("D-PATH attribute is empty")
+	}
+	dp := &DPath{
+		Segments: make([]DPathSegment, 0),
+	}
+	for p := 0; p < len(b); {
+		if p+2 > len(b) {
+			return nil, fmt.Errorf("D-PATH truncated at segment header: offset %d, len %d", p, len(b))
+		}
+		seg := DPathSegment{
+			Type: b[p],
+		}
+		if seg.Type != DPathDomainSet && seg.Type != DPathDomainSequence {
+			return nil, fmt.Errorf("D-PATH invalid segment type %d at offset %d", seg.Type, p)
+		}
+		l := int(b[p+1])
+		p += 2
+		if p+l*7 > len(b) {
+			return nil, fmt.Errorf("D-PATH truncated: segment at offset %d claims %d domains (%d bytes) but only %d bytes remain", p, l, l*7, len(b)-p)
+		}
+		seg.Domains = make([]DPathDomain, 0, l)
+		for n := 0; n < l; n++ {
+			seg.Domains = append(seg.Domains, DPathDomain{
+				GlobalAdmin: binary.BigEndian.Uint32(b[p : p+4]),
+				LocalAdmin:  binary.BigEndian.Uint16(b[p+4 : p+6]),
+				ISFSAFIType: b[p+6],
+			})
+			p += 7
+		}
+		dp.Segments = append(dp.Segments, seg)
+	}
+
+	return dp, nil
+}
@@ -0,0 +1,87 @@
+package bgp
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestUnmarshalDPath(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  []byte
+		expect *DPath
+		fail   bool
+	}{
+		{
+			name: "single sequence two domains",
+			input: []byte{
+				0x02, 0x02,
+				0x00, 0x00, 0xfd, 0xe8, 0x00, 0x01, 0x46,
+				0x00, 0x00, 0xfd, 0xe9, 0x00, 0x02, 0x80,
+			},
+			expect: &DPath{
+				Segments: []DPathSegment{
+					{
+						Type: DPathDomainSequence,
+						Domains: []DPathDomain{
+							{GlobalAdmin: 65000, LocalAdmin: 1, ISFSAFIType: 70},
+							{GlobalAdmin: 65001, LocalAdmin: 2, ISFSAFIType: 128},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "sequence followed by set",
+			input: []byte{
+				0x02, 0x01, 0x00, 0x00, 0x00, 0x64, 0x00, 0x0a, 0x46,
+				0x01, 0x01, 0x00, 0x00, 0x00, 0xc8, 0x00, 0x14, 0x80,
+			},
+			expect: &DPath{
+				Segments: []DPathSegment{
+					{Type: DPathDomainSequence, Domains: []DPathDomain{{GlobalAdmin: 100, LocalAdmin: 10, ISFSAFIType: 70}}},
+					{Type: DPathDomainSet, Domains: []DPathDomain{{GlobalAdmin: 200, LocalAdmin: 20, ISFSAFIType: 128}}},
+				},
+			},
+		},
+		{
+			name:  "empty",
+			input: []byte{},
+			fail:  true,
+		},
+		{
+			name:  "invalid segment type",
+			input: []byte{0x03, 0x00},
+			fail:  true,
+		},
+		{
+			name:  "truncated domain",
+			input: []byte{0x02, 0x01, 0x00, 0x00, 0x00, 0x64, 0x00},
+			fail:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := UnmarshalDPath(tt.input)
+			if err != nil {
+				if !tt.fail {
+					t.Fatalf("supposed to succeed but failed with error: %+v", err)
+				}
+				return
+			}
+			if tt.fail {
+				t.Fatalf("supposed to fail but succeeded")
+			}
+			if !reflect.DeepEqual(tt.expect, got) {
+				t.Errorf("expected %+v, got %+v", tt.expect, got)
+			}
+		})
+	}
+}
+
+func TestDPathDomainString(t *testing.T) {
+	d := DPathDomain{GlobalAdmin: 65000, LocalAdmin: 1, ISFSAFIType: 70}
+	if got, want := d.String(), "65000:1/70"; got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
@@ -78,6 +78,56 @@ func (o *OpenMessage) AddPathCapability() map[int]bool {
 	return m
 }
 
+// AFISAFI identifies an address family by its AFI and SAFI.
+type AFISAFI struct {
+	AFI  uint16
+	SAFI uint8
+}
+
+// GracefulRestartCapability returns the address families listed in the
+// Graceful Restart capability (code 64, RFC 4724) and true if the Open message
+// carries the capability.
+func (o *OpenMessage) GracefulRestartCapability() (map[AFISAFI]bool, bool) {
+	v, ok := o.Capabilities[64]
+	if !ok || len(v) == 0 {
+		return nil, false
+	}
+	m := make(map[AFISAFI]bool)
+	// Restart Flags and Restart Time are followed by 4 bytes AFI/SAFI/Flags tuples
+	b := v[0].Value
+	if len(b) < 2 || (len(b)-2)%4 != 0 {
+		glog.Errorf("invalid length of Graceful Restart capability %d", len(b))
+		return m, true
+	}
+	for p := 2; p < len(b); p += 4 {
+		m[AFISAFI{AFI: binary.BigEndian.Uint16(b[p : p+2]), SAFI: b[p+2]}] = true
+	}
+
+	return m, true
+}
+
+// LLGRCapability returns the address families listed in the Long-Lived
+// Graceful Restart capability (code 71, RFC 9494) and true if the Open message
+// carries the capability.
+func (o *OpenMessage) LLGRCapability() (map[AFISAFI]bool, bool) {
+	v, ok := o.Capabilities[71]
+	if !ok || len(v) == 0 {
+		return nil, false
+	}
+	m := make(map[AFISAFI]bool)
+	// 7 bytes AFI/SAFI/Flags/Long-lived Stale Time tuples
+	b := v[0].Value
+	if len(b)%7 != 0 {
+		glog.Errorf("invalid length of Long-Lived Graceful Restart capability %d", len(b))
+		return m, true
+	}
+	for p := 0; p < len(b); p += 7 {
+		m[AFISAFI{AFI: binary.BigEndian.Uint16(b[p : p+2]), SAFI: b[p+2]}] = true
+	}
+
+	return m, true
+}
+
 // IsMultiLabelCapable returns true or false if Open message originated by a bgp speaker
 // supporting Multiple Label Capability
 func (o *OpenMessage) IsMultiLabelCapable() bool {
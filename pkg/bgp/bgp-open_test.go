@@ -332,3 +332,64 @@ func TestBGPRoleString(t *testing.T) {
 		})
 	}
 }
+
+func TestParseBGPRole(t *testing.T) {
+	tests := []struct {
+		input  string
+		expect BGPRole
+		remote BGPRole
+		fail   bool
+	}{
+		{input: "provider", expect: BGPRoleProvider, remote: BGPRoleCustomer},
+		{input: "RS", expect: BGPRoleRS, remote: BGPRoleRSClient},
+		{input: "rs_client", expect: BGPRoleRSClient, remote: BGPRoleRS},
+		{input: " Customer ", expect: BGPRoleCustomer, remote: BGPRoleProvider},
+		{input: "Peer", expect: BGPRolePeer, remote: BGPRolePeer},
+		{input: "transit", fail: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			role, err := ParseBGPRole(tt.input)
+			if (err != nil) != tt.fail {
+				t.Fatalf("ParseBGPRole(%q) error = %v, fail %t", tt.input, err, tt.fail)
+			}
+			if tt.fail {
+				return
+			}
+			if role != tt.expect {
+				t.Errorf("expected %s, got %s", tt.expect, role)
+			}
+			if remote, ok := role.Remote(); !ok || remote != tt.remote {
+				t.Errorf("expected remote %s, got %s", tt.remote, remote)
+			}
+		})
+	}
+	if _, ok := BGPRole(255).Remote(); ok {
+		t.Error("unknown role has a remote role")
+	}
+}
+
+func TestGracefulRestartCapabilities(t *testing.T) {
+	o := &OpenMessage{Capabilities: Capability{
+		// Restart Time 120, IPv4 Unicast and L2VPN EVPN
+		64: {{Value: []byte{0x00, 0x78, 0x00, 0x01, 0x01, 0x80, 0x00, 0x19, 0x46, 0x00}}},
+		// IPv6 Unicast with a stale time of 3600
+		71: {{Value: []byte{0x00, 0x02, 0x01, 0x80, 0x00, 0x0e, 0x10}}},
+	}}
+	gr, ok := o.GracefulRestartCapability()
+	if !ok || len(gr) != 2 || !gr[AFISAFI{AFI: 1, SAFI: 1}] || !gr[AFISAFI{AFI: 25, SAFI: 70}] {
+		t.Errorf("GracefulRestartCapability() = %v, %t", gr, ok)
+	}
+	llgr, ok := o.LLGRCapability()
+	if !ok || len(llgr) != 1 || !llgr[AFISAFI{AFI: 2, SAFI: 1}] {
+		t.Errorf("LLGRCapability() = %v, %t", llgr, ok)
+	}
+
+	o = &OpenMessage{Capabilities: Capability{64: {{Value: []byte{0x00, 0x78, 0x00}}}}}
+	if gr, ok := o.GracefulRestartCapability(); !ok || len(gr) != 0 {
+		t.Errorf("malformed GracefulRestartCapability() = %v, %t", gr, ok)
+	}
+	if _, ok := (&OpenMessage{}).LLGRCapability(); ok {
+		t.Error("LLGRCapability() found in an Open message without it")
+	}
+}
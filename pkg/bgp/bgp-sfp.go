@@ -0,0 +1,209 @@
+package bgp
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/sbezverk/gobmp/pkg/base"
+)
+
+const (
+	// SFPAssociationTLV is the SFP attribute Association TLV type
+	SFPAssociationTLV = 1
+	// SFPPathTLV is the SFP attribute SFP TLV type
+	SFPPathTLV = 2
+	// SFPHopSubTLV is the SFP TLV Hop sub-TLV type
+	SFPHopSubTLV = 3
+	// SFPSFTSubTLV is the Hop sub-TLV SFT sub-TLV type
+	SFPSFTSubTLV = 4
+)
+
+// SFP defines the Service Function Chaining SFP attribute structure per
+// RFC 9015 §6 (path attribute type 37).
+type SFP struct {
+	Associations []SFPAssociation `json:"associations,omitempty"`
+	Paths        []SFPPath        `json:"paths,omitempty"`
+	// TLVs holds any top level TLV of a type not decoded above.
+	TLVs []SFPTLV `json:"tlvs,omitempty"`
+}
+
+// SFPAssociation is the decoded Association TLV (RFC 9015 §6.1).
+type SFPAssociation struct {
+	Type  uint8  `json:"association_type"`
+	SFPRD string `json:"associated_sfpr_rd"`
+	SPI   uint32 `json:"associated_spi"`
+}
+
+// SFPPath is the decoded SFP TLV (RFC 9015 §6.2).
+type SFPPath struct {
+	SPI  uint32   `json:"spi"`
+	Hops []SFPHop `json:"hops,omitempty"`
+	// SubTLVs holds MPLS related and any other non Hop sub-TLVs.
+	SubTLVs []SFPTLV `json:"sub_tlvs,omitempty"`
+}
+
+// SFPHop is the decoded Hop sub-TLV (RFC 9015 §6.3).
+type SFPHop struct {
+	ServiceIndex uint8    `json:"service_index"`
+	SFTs         []SFPSFT `json:"sfts,omitempty"`
+}
+
+// SFPSFT is the decoded SFT sub-TLV (RFC 9015 §6.4), the Service Function
+// Type with the list of SFIR Route Distinguishers eligible for the hop.
+type SFPSFT struct {
+	Type    uint16   `json:"sft"`
+	SFIRRDs []string `json:"sfir_rds,omitempty"`
+}
+
+// SFPTLV is a raw SFP attribute TLV or sub-TLV.
+type SFPTLV struct {
+	Type  uint8  `json:"type"`
+	Value []byte `json:"value,omitempty"`
+}
+
+// UnmarshalSFP parses the SFP attribute value, a sequence of TLVs each with a
+// 1 octet Type and a 2 octet Length.
+//
+// Association TLV (Type 1):
+//
+//	+-----------------------------------------+
+//	| Reserved (1 octet)                      |
+//	| Association Type (1 octet)              |
+//	| Associated SFPR-RD (8 octets)           |
+//	| Associated SPI (3 octets)               |
+//	+-----------------------------------------+
+//
+// SFP TLV (Type 2):
+//
+//	+-----------------------------------------+
+//	| Service Path Identifier (3 octets)      |
+//	| Hop Sub-TLVs (variable)                 |
+//	+-----------------------------------------+
+func UnmarshalSFP(b []byte) (*SFP, error) {
+	if len(b) == 0 {
+		return nil, fmt.Errorf("SFP attribute is empty")
+	}
+	tlvs, err := unmarshalSFPTLVs(b)
+	if err != nil {
+		return nil, err
+	}
+	sfp := &SFP{}
+	for _, tlv := range tlvs {
+		switch tlv.Type {
+		case SFPAssociationTLV:
+			if len(tlv.Value) != 13 {
+				return nil, fmt.Errorf("invalid SFP Association TLV length: %d", len(tlv.Value))
+			}
+			rd, err := base.MakeRD(tlv.Value[2:10])
+			if err != nil {
+				return nil, fmt.Errorf("invalid SFP Association TLV SFPR-RD: %w", err)
+			}
+			sfp.Associations = append(sfp.Associations, SFPAssociation{
+				Type:  tlv.Value[1],
+				SFPRD: rd.String(),
+				SPI:   uint24(tlv.Value[10:13]),
+			})
+		case SFPPathTLV:
+			path, err := unmarshalSFPPath(tlv.Value)
+			if err != nil {
+				return nil, err
+			}
+			sfp.Paths = append(sfp.Paths, *path)
+		default:
+			sfp.TLVs = append(sfp.TLVs, tlv)
+		}
+	}
+
+	return sfp, nil
+}
+
+func unmarshalSFPPath(b []byte) (*SFPPath, error) {
+	if len(b) < 3 {
+		return nil, fmt.Errorf("invalid SFP TLV length: %d", len(b))
+	}
+	path := &SFPPath{
+		SPI: uint24(b[0:3]),
+	}
+	subs, err := unmarshalSFPTLVs(b[3:])
+	if err != nil {
+		return nil, err
+	}
+	for _, sub := range subs {
+		if sub.Type != SFPHopSubTLV {
+			path.SubTLVs = append(path.SubTLVs, sub)
+			continue
+		}
+		hop, err := unmarshalSFPHop(sub.Value)
+		if err != nil {
+			return nil, err
+		}
+		path.Hops = append(path.Hops, *hop)
+	}
+
+	return path, nil
+}
+
+func unmarshalSFPHop(b []byte) (*SFPHop, error) {
+	if len(b) < 1 {
+		return nil, fmt.Errorf("invalid SFP Hop sub-TLV length: %d", len(b))
+	}
+	hop := &SFPHop{
+		ServiceIndex: b[0],
+	}
+	subs, err := unmarshalSFPTLVs(b[1:])
+	if err != nil {
+		return nil, err
+	}
+	for _, sub := range subs {
+		if sub.Type != SFPSFTSubTLV {
+			continue
+		}
+		if len(sub.Value) < 2 || (len(sub.Value)-2)%8 != 0 {
+			return nil, fmt.Errorf("invalid SFP SFT sub-TLV length: %d", len(sub.Value))
+		}
+		sft := SFPSFT{
+			Type: binary.BigEndian.Uint16(sub.Value[0:2]),
+		}
+		for p := 2; p < len(sub.Value); p += 8 {
+			rd, err := base.MakeRD(sub.Value[p : p+8])
+			if err != nil {
+				return nil, fmt.Errorf("invalid SFP SFT sub-TLV SFIR-RD: %w", err)
+			}
+			sft.SFIRRDs = append(sft.SFIRRDs, rd.String())
+		}
+		hop.SFTs = append(hop.SFTs, sft)
+	}
+
+	return hop, nil
+}
+
+// unmarshalSFPTLVs splits b into TLVs with 1 octet Type and 2 octet Length.
+func unmarshalSFPTLVs(b []byte) ([]SFPTLV, error) {
+	tlvs := make([]SFPTLV, 0)
+	for p := 0; p < len(b); {
+		if p+3 > len(b) {
+			return nil, fmt.Errorf("SFP TLV truncated at offset %d, len %d", p, len(b))
+		}
+		t := b[p]
+		l := int(binary.BigEndian.Uint16(b[p+1 : p+3]))
+		p += 3
+		if p+l > len(b) {
+			return nil, fmt.Errorf("SFP TLV type %d length %d exceeds remaining %d bytes", t, l, len(b)-p)
+		}
+		tlv := SFPTLV{
+			Type: t,
+		}
+		if l > 0 {
+			tlv.Value = make([]byte, l)
+			copy(tlv.Value, b[p:p+l])
+		}
+		tlvs = append(tlvs, tlv)
+		p += l
+	}
+
+	return tlvs, nil
+}
+
+func uint24(b []byte) uint32 {
+	return uint32(b[0])<<16 | uint32(b[1])<<8 | uint32(b[2])
+}
@@ -0,0 +1,100 @@
+package bgp
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestUnmarshalSFP(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  []byte
+		expect *SFP
+		fail   bool
+	}{
+		{
+			name: "association and path with one hop",
+			input: []byte{
+				// Association TLV
+				0x01, 0x00, 0x0d,
+				0x00, 0x01,
+				0x00, 0x00, 0xfd, 0xe8, 0x00, 0x00, 0x00, 0x01,
+				0x00, 0x00, 0x0b,
+				// SFP TLV
+				0x02, 0x00, 0x14,
+				0x00, 0x00, 0x0a,
+				// Hop sub-TLV
+				0x03, 0x00, 0x0e,
+				0xff,
+				// SFT sub-TLV
+				0x04, 0x00, 0x0a,
+				0x00, 0x2a,
+				0x00, 0x01, 0xc0, 0x00, 0x02, 0x01, 0x00, 0x05,
+			},
+			expect: &SFP{
+				Associations: []SFPAssociation{{Type: 1, SFPRD: "65000:1", SPI: 11}},
+				Paths: []SFPPath{
+					{
+						SPI: 10,
+						Hops: []SFPHop{
+							{
+								ServiceIndex: 255,
+								SFTs:         []SFPSFT{{Type: 42, SFIRRDs: []string{"192.0.2.1:5"}}},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "unknown tlv and path sub-tlv retained",
+			input: []byte{
+				0x02, 0x00, 0x07,
+				0x00, 0x00, 0x01,
+				0x05, 0x00, 0x01, 0x01,
+				0x63, 0x00, 0x01, 0xaa,
+			},
+			expect: &SFP{
+				Paths: []SFPPath{{SPI: 1, SubTLVs: []SFPTLV{{Type: 5, Value: []byte{0x01}}}}},
+				TLVs:  []SFPTLV{{Type: 99, Value: []byte{0xaa}}},
+			},
+		},
+		{
+			name:  "truncated tlv header",
+			input: []byte{0x02, 0x00},
+			fail:  true,
+		},
+		{
+			name:  "empty",
+			input: []byte{},
+			fail:  true,
+		},
+		{
+			name:  "invalid association length",
+			input: []byte{0x01, 0x00, 0x01, 0x00},
+			fail:  true,
+		},
+		{
+			name:  "invalid sft length",
+			input: []byte{0x02, 0x00, 0x0a, 0x00, 0x00, 0x01, 0x03, 0x00, 0x04, 0x01, 0x04, 0x00, 0x00},
+			fail:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := UnmarshalSFP(tt.input)
+			if err != nil {
+				if !tt.fail {
+					t.Fatalf("supposed to succeed but failed with error: %+v", err)
+				}
+				return
+			}
+			if tt.fail {
+				t.Fatalf("supposed to fail but succeeded")
+			}
+			if !reflect.DeepEqual(tt.expect, got) {
+				t.Errorf("expected %+v, got %+v", tt.expect, got)
+			}
+		})
+	}
+}
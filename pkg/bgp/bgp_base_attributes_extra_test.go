@@ -623,3 +623,53 @@ func TestBaseAttributes_TunnelEncapAttr_JSON(t *testing.T) {
 		t.Errorf("absent TunnelEncapAttr must be omitted, got %s", out)
 	}
 }
+
+// TestUnmarshalBGPBaseAttributes_DPathSFPBFD verifies that path attributes
+// 36 (D-PATH), 37 (SFP) and 38 (BFD Discriminator) are decoded into their
+// typed fields and participate in Equal.
+func TestUnmarshalBGPBaseAttributes_DPathSFPBFD(t *testing.T) {
+	raw := buildAttr(0xC0, 36, []byte{0x02, 0x01, 0x00, 0x00, 0xfd, 0xe8, 0x00, 0x01, 0x46})
+	raw = append(raw, buildAttr(0xC0, 37, []byte{0x02, 0x00, 0x03, 0x00, 0x00, 0x0a})...)
+	raw = append(raw, buildAttr(0x80, 38, []byte{0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x2a})...)
+
+	ba, err := UnmarshalBGPBaseAttributes(raw)
+	if err != nil {
+		t.Fatalf("UnmarshalBGPBaseAttributes failed: %v", err)
+	}
+	if ba.DPath == nil || len(ba.DPath.Segments) != 1 || ba.DPath.Segments[0].Domains[0].GlobalAdmin != 65000 {
+		t.Errorf("unexpected D-PATH: %+v", ba.DPath)
+	}
+	if ba.SFP == nil || len(ba.SFP.Paths) != 1 || ba.SFP.Paths[0].SPI != 10 {
+		t.Errorf("unexpected SFP: %+v", ba.SFP)
+	}
+	if ba.BFDDiscriminator == nil || ba.BFDDiscriminator.Discriminator != 42 {
+		t.Errorf("unexpected BFD Discriminator: %+v", ba.BFDDiscriminator)
+	}
+	if len(ba.UnknownAttributes) != 0 {
+		t.Errorf("expected no unknown attributes, got %+v", ba.UnknownAttributes)
+	}
+
+	b, err := json.Marshal(ba)
+	if err != nil {
+		t.Fatalf("json.Marshal failed: %v", err)
+	}
+	var rt BaseAttributes
+	if err := json.Unmarshal(b, &rt); err != nil {
+		t.Fatalf("json.Unmarshal failed: %v", err)
+	}
+	if eq, diffs := ba.Equal(&rt); !eq {
+		t.Errorf("round trip mismatch: %v", diffs)
+	}
+
+	rt.BFDDiscriminator.Discriminator = 43
+	rt.DPath = nil
+	eq, diffs := ba.Equal(&rt)
+	if eq {
+		t.Fatalf("expected mismatch after modification")
+	}
+	for _, want := range []string{"d_path mismatch", "bfd_discriminator mismatch"} {
+		if !strings.Contains(strings.Join(diffs, ";"), want) {
+			t.Errorf("expected diff %q in %v", want, diffs)
+		}
+	}
+}
@@ -264,3 +264,76 @@ func TestBaseAttributes_Equal_UnknownAttributes(t *testing.T) {
 		t.Error("Equal returned false for nil vs empty Value")
 	}
 }
+
+// TestUnknownPathAttribute_RecognisedNotDecoded verifies that recognised
+// attribute codes without a decoder are preserved like unrecognised ones.
+func TestUnknownPathAttribute_RecognisedNotDecoded(t *testing.T) {
+	for _, code := range []uint8{11, 12, 13, 19, 20, 21, 24, 27, 28, 30, 31, 33, 34, 39, 41, 42} {
+		ba, err := UnmarshalBGPBaseAttributes(buildAttr(0xC0, code, []byte{0x01, 0x02}))
+		if err != nil {
+			t.Fatalf("type %d: UnmarshalBGPBaseAttributes: %v", code, err)
+		}
+		if len(ba.UnknownAttributes) != 1 || ba.UnknownAttributes[0].Type != code ||
+			ba.UnknownAttributes[0].Flags != 0xC0 || !bytes.Equal(ba.UnknownAttributes[0].Value, []byte{0x01, 0x02}) {
+			t.Errorf("type %d: UnknownAttributes = %+v", code, ba.UnknownAttributes)
+		}
+		if len(ba.AttrErrors) != 0 {
+			t.Errorf("type %d: unexpected AttrErrors %+v", code, ba.AttrErrors)
+		}
+	}
+}
+
+// TestUnknownPathAttribute_DecodeError verifies that an attribute rejected
+// by its decoder is recorded in AttrErrors and preserved in UnknownAttributes.
+func TestUnknownPathAttribute_DecodeError(t *testing.T) {
+	cases := []struct {
+		name string
+		code uint8
+	}{
+		{"as4_path", 17},
+		{"pmsi tunnel", 22},
+		{"tunnel encapsulation", 23},
+		{"aigp", 26},
+		{"bgp-ls", 29},
+		{"d-path", 36},
+		{"sfp", 37},
+		{"bfd discriminator", 38},
+		{"prefix-sid", 40},
+		{"attr_set", 128},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ba, err := UnmarshalBGPBaseAttributes(buildAttr(0xC0, tc.code, []byte{0x01}))
+			if err != nil {
+				t.Fatalf("UnmarshalBGPBaseAttributes: %v", err)
+			}
+			if len(ba.AttrErrors) != 1 || ba.AttrErrors[0].Type != tc.code || ba.AttrErrors[0].Error == "" {
+				t.Fatalf("AttrErrors = %+v, want one entry with Type=%d", ba.AttrErrors, tc.code)
+			}
+			if len(ba.UnknownAttributes) != 1 || ba.UnknownAttributes[0].Type != tc.code ||
+				!bytes.Equal(ba.UnknownAttributes[0].Value, []byte{0x01}) {
+				t.Errorf("UnknownAttributes = %+v, want raw attribute preserved", ba.UnknownAttributes)
+			}
+			b, err := json.Marshal(ba)
+			if err != nil {
+				t.Fatalf("Marshal: %v", err)
+			}
+			if !strings.Contains(string(b), `"attr_errors":[{"type":`) {
+				t.Errorf("missing attr_errors; got %s", b)
+			}
+		})
+	}
+}
+
+// TestBaseAttributes_Equal_AttrErrors verifies BaseAttributes.Equal detects a
+// difference in AttrErrors.
+func TestBaseAttributes_Equal_AttrErrors(t *testing.T) {
+	a := &BaseAttributes{AttrErrors: []PathAttributeError{{Type: 40, Error: "bad"}}}
+	b := &BaseAttributes{}
+	if eq, diffs := a.Equal(b); eq || !strings.Contains(strings.Join(diffs, ";"), "attr_errors mismatch") {
+		t.Errorf("Equal = %t, diffs %v; want attr_errors mismatch", eq, diffs)
+	}
+	if eq, _ := a.Equal(&BaseAttributes{AttrErrors: []PathAttributeError{{Type: 40, Error: "bad"}}}); !eq {
+		t.Error("Equal returned false for identical AttrErrors")
+	}
+}
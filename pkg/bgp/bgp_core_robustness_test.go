@@ -11,11 +11,11 @@ func TestTypeN_ShortValue(t *testing.T) {
 		fn   func(uint8, []byte) string
 		want string
 	}{
-		{"type0 short", func(st uint8, v []byte) string { return type0(st, v) }, "invalid-type0"},
-		{"type1 short", func(st uint8, v []byte) string { return type1(st, v) }, "invalid-type1"},
-		{"type2 short", func(st uint8, v []byte) string { return type2(st, v) }, "invalid-type2"},
-		{"type3 short", func(st uint8, v []byte) string { return type3(st, v) }, "invalid-type3"},
-		{"type6 short", func(st uint8, v []byte) string { return type6(st, v) }, "invalid-type6"},
+		{"type0 short", func(st uint8, v []byte) string { s, _ := type0(st, v); return s }, "invalid-type0"},
+		{"type1 short", func(st uint8, v []byte) string { s, _ := type1(st, v); return s }, "invalid-type1"},
+		{"type2 short", func(st uint8, v []byte) string { s, _ := type2(st, v); return s }, "invalid-type2"},
+		{"type3 short", func(st uint8, v []byte) string { s, _ := type3(st, v); return s }, "invalid-type3"},
+		{"type6 short", func(st uint8, v []byte) string { s, _ := type6(st, v); return s }, "invalid-type6"},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -29,12 +29,12 @@ func TestTypeN_ShortValue(t *testing.T) {
 
 func TestTypeN_ValidValue(t *testing.T) {
 	// type0: 2-byte AS + 4-byte value
-	got := type0(0x02, []byte{0x00, 0x64, 0x00, 0x00, 0x00, 0x01})
+	got, _ := type0(0x02, []byte{0x00, 0x64, 0x00, 0x00, 0x00, 0x01})
 	if !strings.Contains(got, "100:1") {
 		t.Errorf("type0 got %q, want contains '100:1'", got)
 	}
 	// type3: opaque color
-	got = type3(0x0b, []byte{0x00, 0x00, 0x00, 0x0A})
+	got, _ = type3(0x0b, []byte{0x00, 0x00, 0x00, 0x0A})
 	if !strings.Contains(got, "10") {
 		t.Errorf("type3 got %q, want contains '10'", got)
 	}
@@ -99,7 +99,10 @@ func TestAddPathCapability_InvalidLength(t *testing.T) {
 func TestUnmarshalAttrAS4Path_Truncated(t *testing.T) {
 	// Segment type=2 (AS_SEQUENCE), length=2 ASes, but only 4 bytes of AS data (need 8)
 	b := []byte{0x02, 0x02, 0x00, 0x00, 0xFD, 0xE8}
-	path := unmarshalAttrAS4Path(b)
+	path, err := unmarshalAttrAS4Path(b)
+	if err == nil {
+		t.Error("truncated segment returned no error")
+	}
 	// Truncated segment is rejected entirely, no ASes appended
 	if len(path) != 0 {
 		t.Errorf("got %d ASes, want 0 (truncated segment skipped entirely)", len(path))
@@ -109,7 +112,10 @@ func TestUnmarshalAttrAS4Path_Truncated(t *testing.T) {
 func TestUnmarshalAttrAS4Path_Valid(t *testing.T) {
 	// Segment type=2 (AS_SEQUENCE), length=2, AS 65000 + AS 65001
 	b := []byte{0x02, 0x02, 0x00, 0x00, 0xFD, 0xE8, 0x00, 0x00, 0xFD, 0xE9}
-	path := unmarshalAttrAS4Path(b)
+	path, err := unmarshalAttrAS4Path(b)
+	if err != nil {
+		t.Fatalf("unmarshalAttrAS4Path() error: %v", err)
+	}
 	if len(path) != 2 {
 		t.Fatalf("got %d ASes, want 2", len(path))
 	}
@@ -120,7 +126,10 @@ func TestUnmarshalAttrAS4Path_Valid(t *testing.T) {
 
 func TestUnmarshalAttrAS4Path_TruncatedHeader(t *testing.T) {
 	// Only 1 byte — not enough for segment header
-	path := unmarshalAttrAS4Path([]byte{0x02})
+	path, err := unmarshalAttrAS4Path([]byte{0x02})
+	if err == nil {
+		t.Error("truncated segment header returned no error")
+	}
 	if len(path) != 0 {
 		t.Errorf("got %d ASes, want 0", len(path))
 	}
@@ -0,0 +1,223 @@
+package bgp
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"math"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// ExtCommunityDetail is a structured representation of a single Extended
+// Community (RFC 4360) or IPv6 Address Specific Extended Community (RFC 5701).
+// Name and Value are the two halves of the display string produced by the
+// type specific decoders (e.g. "rt" and "65000:100" for "rt=65000:100"),
+// Decoded carries a typed value object for known subtypes and Raw is the hex
+// encoding of the full community as received.
+type ExtCommunityDetail struct {
+	Type    uint8       `json:"type"`
+	SubType *uint8      `json:"subtype,omitempty"`
+	Name    string      `json:"name,omitempty"`
+	Value   string      `json:"value,omitempty"`
+	Decoded interface{} `json:"decoded,omitempty"`
+	Raw     string      `json:"raw"`
+}
+
+// ECAdministrator is the decoded value of an Administrator:Local Administrator
+// pair used by Route Target, Route Origin, Source AS, VRF Route Import and
+// similar subtypes. GlobalAdmin is an ASN or an IPv4/IPv6 address.
+type ECAdministrator struct {
+	GlobalAdmin string `json:"global_admin"`
+	LocalAdmin  uint32 `json:"local_admin"`
+}
+
+// ECLinkBandwidth is the decoded Link Bandwidth Extended Community
+// [draft-ietf-idr-link-bandwidth], Bandwidth is in bytes per second.
+type ECLinkBandwidth struct {
+	ASN       uint16  `json:"asn"`
+	Bandwidth float32 `json:"bandwidth"`
+}
+
+// ECColor is the decoded Color Extended Community (RFC 9012 §4.3), Flags
+// carry the Color-Only bits defined by RFC 9256 §8.8.1.
+type ECColor struct {
+	Flags uint16 `json:"flags,omitempty"`
+	Color uint32 `json:"color"`
+}
+
+// ECEncapsulation is the decoded Encapsulation Extended Community (RFC 9012 §4.1).
+type ECEncapsulation struct {
+	TunnelType uint16 `json:"tunnel_type"`
+}
+
+// ECDefaultGateway is the decoded Default Gateway Extended Community
+// (RFC 7432 §7.8). Its presence is the information, it carries no value.
+type ECDefaultGateway struct{}
+
+// ECOriginValidation is the decoded Origin Validation State Extended Community (RFC 8097).
+type ECOriginValidation struct {
+	State string `json:"state"`
+}
+
+// ECMACMobility is the decoded EVPN MAC Mobility Extended Community (RFC 7432 §7.7).
+type ECMACMobility struct {
+	Sticky   bool   `json:"sticky"`
+	Sequence uint32 `json:"sequence"`
+}
+
+// ECESILabel is the decoded EVPN ESI Label Extended Community (RFC 7432 §7.5).
+type ECESILabel struct {
+	SingleActive bool   `json:"single_active"`
+	Label        uint32 `json:"label"`
+}
+
+// ECMAC is the decoded value of EVPN subtypes carrying a MAC address: ES-Import
+// Route Target (RFC 7432 §7.6) and Router's MAC (RFC 9135 §8.1).
+type ECMAC struct {
+	MAC string `json:"mac"`
+}
+
+// ECLayer2Attributes is the decoded EVPN Layer 2 Attributes Extended
+// Community (RFC 8214 §3.1).
+type ECLayer2Attributes struct {
+	ControlFlags uint16 `json:"control_flags"`
+	MTU          uint16 `json:"mtu"`
+}
+
+// ECDFElection is the decoded EVPN DF Election Extended Community (RFC 8584 §2.2).
+type ECDFElection struct {
+	Algorithm    uint8  `json:"algorithm"`
+	Capabilities uint16 `json:"capabilities"`
+}
+
+// ECEVPNLinkBandwidth is the decoded EVPN Link Bandwidth Extended Community
+// [draft-ietf-bess-evpn-unequal-lb].
+type ECEVPNLinkBandwidth struct {
+	Units  uint8  `json:"units"`
+	Weight uint64 `json:"weight"`
+}
+
+// ECFlowspecTrafficRate is the decoded Flowspec traffic-rate action (RFC 8955 §7.3),
+// Rate is in bytes per second.
+type ECFlowspecTrafficRate struct {
+	ASN  uint16  `json:"asn"`
+	Rate float32 `json:"rate"`
+}
+
+// ECFlowspecTrafficAction is the decoded Flowspec traffic-action (RFC 8955 §7.3).
+type ECFlowspecTrafficAction struct {
+	Terminal bool `json:"terminal"`
+	Sample   bool `json:"sample"`
+}
+
+// ECFlowspecTrafficMarking is the decoded Flowspec traffic-marking action (RFC 8955 §7.3).
+type ECFlowspecTrafficMarking struct {
+	DSCP uint8 `json:"dscp"`
+}
+
+// Detail returns the structured representation of the Extended Community.
+func (ext *ExtCommunity) Detail() ExtCommunityDetail {
+	d := ExtCommunityDetail{
+		Type:    ext.Type,
+		SubType: ext.SubType,
+	}
+	raw := make([]byte, 0, 8)
+	raw = append(raw, ext.Type)
+	subType := uint8(0xff)
+	if ext.SubType != nil {
+		subType = *ext.SubType
+		raw = append(raw, subType)
+	}
+	raw = append(raw, ext.Value...)
+	d.Raw = hex.EncodeToString(raw)
+	s, v := ext.decode()
+	d.Name, d.Value = splitExtCommString(s)
+	if v != nil {
+		d.Decoded = v
+	}
+
+	return d
+}
+
+// UnmarshalBGPExtCommunityDetail builds a slice of structured Extended
+// Communities from the value of path attribute 16.
+func UnmarshalBGPExtCommunityDetail(b []byte) ([]ExtCommunityDetail, error) {
+	exts, err := UnmarshalBGPExtCommunity(b)
+	if err != nil {
+		return nil, err
+	}
+	if len(exts) == 0 {
+		return nil, nil
+	}
+	details := make([]ExtCommunityDetail, len(exts))
+	for i := range exts {
+		details[i] = exts[i].Detail()
+	}
+
+	return details, nil
+}
+
+// UnmarshalBGPIPv6ExtCommunityDetail builds a slice of structured IPv6 Address
+// Specific Extended Communities from the value of path attribute 25. Trailing
+// bytes not forming a complete 20 byte community are ignored, as in
+// unmarshalAttrIPv6ExtCommunity.
+func UnmarshalBGPIPv6ExtCommunityDetail(b []byte) []ExtCommunityDetail {
+	if len(b) < 20 {
+		return nil
+	}
+	details := make([]ExtCommunityDetail, 0, len(b)/20)
+	for p := 0; p+20 <= len(b); p += 20 {
+		subType := b[p+1]
+		value := b[p+2 : p+20]
+		d := ExtCommunityDetail{
+			Type:    b[p],
+			SubType: &subType,
+			Raw:     hex.EncodeToString(b[p : p+20]),
+		}
+		s, v := type5(subType, value)
+		d.Name, d.Value = splitExtCommString(s)
+		d.Decoded = v
+		details = append(details, d)
+	}
+
+	return details
+}
+
+// splitExtCommString splits the "name=value" display string returned by the
+// type specific decoders.
+func splitExtCommString(s string) (string, string) {
+	name, value, ok := strings.Cut(s, "=")
+	if !ok {
+		return "", s
+	}
+	return name, value
+}
+
+func as2Administrator(value []byte) *ECAdministrator {
+	return &ECAdministrator{
+		GlobalAdmin: strconv.Itoa(int(binary.BigEndian.Uint16(value[0:2]))),
+		LocalAdmin:  binary.BigEndian.Uint32(value[2:6]),
+	}
+}
+
+func ipv4Administrator(value []byte) *ECAdministrator {
+	return &ECAdministrator{
+		GlobalAdmin: net.IP(value[0:4]).To4().String(),
+		LocalAdmin:  uint32(binary.BigEndian.Uint16(value[4:6])),
+	}
+}
+
+func as4Administrator(value []byte) *ECAdministrator {
+	return &ECAdministrator{
+		GlobalAdmin: strconv.FormatUint(uint64(binary.BigEndian.Uint32(value[0:4])), 10),
+		LocalAdmin:  uint32(binary.BigEndian.Uint16(value[4:6])),
+	}
+}
+
+func linkBandwidth(value []byte) *ECLinkBandwidth {
+	return &ECLinkBandwidth{
+		ASN:       binary.BigEndian.Uint16(value[0:2]),
+		Bandwidth: math.Float32frombits(binary.BigEndian.Uint32(value[2:6])),
+	}
+}
@@ -0,0 +1,207 @@
+package bgp
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestExtCommunityDetail(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   []byte
+		expName string
+		expVal  string
+		decoded interface{}
+	}{
+		{
+			name:    "route target as2",
+			input:   []byte{0x00, 0x02, 0xfd, 0xe8, 0x00, 0x00, 0x00, 0x64},
+			expName: "rt",
+			expVal:  "65000:100",
+			decoded: &ECAdministrator{GlobalAdmin: "65000", LocalAdmin: 100},
+		},
+		{
+			name:    "route target ipv4",
+			input:   []byte{0x01, 0x02, 0xc0, 0x00, 0x02, 0x01, 0x00, 0x0a},
+			expName: "rt",
+			expVal:  "192.0.2.1:10",
+			decoded: &ECAdministrator{GlobalAdmin: "192.0.2.1", LocalAdmin: 10},
+		},
+		{
+			name:    "route target as4",
+			input:   []byte{0x02, 0x02, 0x00, 0x01, 0x00, 0x00, 0x00, 0x0a},
+			expName: "rt",
+			expVal:  "65536:10",
+			decoded: &ECAdministrator{GlobalAdmin: "65536", LocalAdmin: 10},
+		},
+		{
+			name:    "color",
+			input:   []byte{0x03, 0x0b, 0x40, 0x00, 0x00, 0x00, 0x00, 0x64},
+			expName: "color",
+			decoded: &ECColor{Flags: 0x4000, Color: 100},
+		},
+		{
+			name:    "encapsulation vxlan",
+			input:   []byte{0x03, 0x0c, 0x00, 0x00, 0x00, 0x00, 0x00, 0x08},
+			expName: "encap",
+			decoded: &ECEncapsulation{TunnelType: 8},
+		},
+		{
+			name:    "mac mobility sticky",
+			input:   []byte{0x06, 0x00, 0x01, 0x00, 0x00, 0x00, 0x00, 0x05},
+			expName: "macmob",
+			expVal:  "1:5",
+			decoded: &ECMACMobility{Sticky: true, Sequence: 5},
+		},
+		{
+			name:    "esi label single active",
+			input:   []byte{0x06, 0x01, 0x01, 0x00, 0x00, 0x00, 0x3e, 0x81},
+			expName: "esi-l",
+			decoded: &ECESILabel{SingleActive: true, Label: 1000},
+		},
+		{
+			name:    "router mac",
+			input:   []byte{0x06, 0x03, 0x0c, 0x03, 0x00, 0x00, 0x1b, 0x08},
+			expName: "rmac",
+			expVal:  "0C:03:00:00:1B:08",
+			decoded: &ECMAC{MAC: "0c:03:00:00:1b:08"},
+		},
+		{
+			name:    "layer 2 attributes",
+			input:   []byte{0x06, 0x04, 0x00, 0x03, 0x05, 0xdc, 0x00, 0x00},
+			expName: "l2attr",
+			decoded: &ECLayer2Attributes{ControlFlags: 3, MTU: 1500},
+		},
+		{
+			name:    "df election",
+			input:   []byte{0x06, 0x06, 0x01, 0x80, 0x00, 0x00, 0x00, 0x00},
+			expName: "df-elect",
+			decoded: &ECDFElection{Algorithm: 1, Capabilities: 0x8000},
+		},
+		{
+			name:    "link bandwidth non transitive",
+			input:   []byte{0x40, 0x04, 0xfd, 0xe8, 0x4b, 0x3e, 0xbc, 0x20},
+			expName: "link-bw",
+			decoded: &ECLinkBandwidth{ASN: 65000, Bandwidth: 12500000},
+		},
+		{
+			name:    "origin validation",
+			input:   []byte{0x43, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02},
+			expName: "ov-state",
+			expVal:  "invalid",
+			decoded: &ECOriginValidation{State: "invalid"},
+		},
+		{
+			name:    "flowspec traffic marking",
+			input:   []byte{0x80, 0x09, 0x00, 0x00, 0x00, 0x00, 0x00, 0x2e},
+			expName: "flowspec-traffic-remarking",
+			expVal:  "DSCP=46",
+			decoded: &ECFlowspecTrafficMarking{DSCP: 46},
+		},
+		{
+			name:    "unknown type no decoded value",
+			input:   []byte{0x0f, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07},
+			expName: "unknown",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			details, err := UnmarshalBGPExtCommunityDetail(tt.input)
+			if err != nil {
+				t.Fatalf("supposed to succeed but failed with error: %+v", err)
+			}
+			if len(details) != 1 {
+				t.Fatalf("expected 1 community, got %d", len(details))
+			}
+			d := details[0]
+			if d.Type != tt.input[0] {
+				t.Errorf("expected type %d, got %d", tt.input[0], d.Type)
+			}
+			if d.Name != tt.expName {
+				t.Errorf("expected name %q, got %q", tt.expName, d.Name)
+			}
+			if tt.expVal != "" && d.Value != tt.expVal {
+				t.Errorf("expected value %q, got %q", tt.expVal, d.Value)
+			}
+			if d.Raw != hexString(tt.input) {
+				t.Errorf("expected raw %s, got %s", hexString(tt.input), d.Raw)
+			}
+			if !reflect.DeepEqual(tt.decoded, d.Decoded) {
+				t.Errorf("expected decoded %+v, got %+v", tt.decoded, d.Decoded)
+			}
+		})
+	}
+}
+
+func hexString(b []byte) string {
+	const digits = "0123456789abcdef"
+	s := make([]byte, 0, len(b)*2)
+	for _, c := range b {
+		s = append(s, digits[c>>4], digits[c&0x0f])
+	}
+	return string(s)
+}
+
+func TestIPv6ExtCommunityDetail(t *testing.T) {
+	input := []byte{
+		0x00, 0x02,
+		0x20, 0x01, 0x0d, 0xb8, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x01,
+		0x00, 0x64,
+	}
+	details := UnmarshalBGPIPv6ExtCommunityDetail(input)
+	if len(details) != 1 {
+		t.Fatalf("expected 1 community, got %d", len(details))
+	}
+	d := details[0]
+	if d.Name != "rt" || d.Value != "2001:db8::1:100" {
+		t.Errorf("unexpected name/value %q/%q", d.Name, d.Value)
+	}
+	if d.SubType == nil || *d.SubType != 0x02 {
+		t.Errorf("unexpected subtype %v", d.SubType)
+	}
+	if !reflect.DeepEqual(d.Decoded, &ECAdministrator{GlobalAdmin: "2001:db8::1", LocalAdmin: 100}) {
+		t.Errorf("unexpected decoded value %+v", d.Decoded)
+	}
+	if d.Raw != hexString(input) {
+		t.Errorf("unexpected raw %s", d.Raw)
+	}
+}
+
+func TestBaseAttributes_PopulateExtCommunities(t *testing.T) {
+	raw := buildAttr(0xC0, 16, []byte{0x00, 0x02, 0xfd, 0xe8, 0x00, 0x00, 0x00, 0x64})
+	raw = append(raw, buildAttr(0xC0, 25, []byte{
+		0x00, 0x02,
+		0x20, 0x01, 0x0d, 0xb8, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x01,
+		0x00, 0x64,
+	})...)
+	ba, err := UnmarshalBGPBaseAttributes(raw)
+	if err != nil {
+		t.Fatalf("UnmarshalBGPBaseAttributes failed: %v", err)
+	}
+	if ba.ExtCommunities != nil || ba.IPv6ExtCommunities != nil {
+		t.Fatalf("structured communities must not be populated by default")
+	}
+	if got := ba.GetExtCommunities(); len(got) != 1 || got[0].Name != "rt" {
+		t.Errorf("unexpected GetExtCommunities result %+v", got)
+	}
+	ba.PopulateExtCommunities()
+	if len(ba.ExtCommunities) != 1 || len(ba.IPv6ExtCommunities) != 1 {
+		t.Fatalf("expected populated communities, got %+v and %+v", ba.ExtCommunities, ba.IPv6ExtCommunities)
+	}
+	b, err := json.Marshal(ba)
+	if err != nil {
+		t.Fatalf("json.Marshal failed: %v", err)
+	}
+	var rt BaseAttributes
+	if err := json.Unmarshal(b, &rt); err != nil {
+		t.Fatalf("json.Unmarshal failed: %v", err)
+	}
+	if eq, diffs := ba.Equal(&rt); !eq {
+		t.Errorf("round trip mismatch: %v", diffs)
+	}
+	rt.ExtCommunities[0].Raw = "0002fde800000065"
+	if eq, _ := ba.Equal(&rt); eq {
+		t.Errorf("expected ext_communities mismatch")
+	}
+}
@@ -265,64 +265,98 @@ func getSubType(m map[uint8]string, subType uint8) string {
 }
 
 // Transitive Two-Octet AS-Specific Extended Community
-func type0(subType uint8, value []byte) string {
+func type0(subType uint8, value []byte) (string, interface{}) {
 	if len(value) < 6 {
-		return fmt.Sprintf("invalid-type0-length=%d", len(value))
+		return fmt.Sprintf("invalid-type0-length=%d", len(value)), nil
 	}
 	switch subType {
 	case 0x04:
 		// Link Bandwidth: Local Administrator (value[2:6]) is IEEE 754 float32, bytes/sec
-		f := binary.BigEndian.Uint32(value[2:6])
-		return getSubType(transAS2SubTypes, subType) + fmt.Sprintf("%03f", math.Float32frombits(f))
+		lb := linkBandwidth(value)
+		return getSubType(transAS2SubTypes, subType) + fmt.Sprintf("%03f", lb.Bandwidth), lb
 	default:
-		return getSubType(transAS2SubTypes, subType) + fmt.Sprintf("%d:%d", binary.BigEndian.Uint16(value[0:2]), binary.BigEndian.Uint32(value[2:]))
+		s := getSubType(transAS2SubTypes, subType) + fmt.Sprintf("%d:%d", binary.BigEndian.Uint16(value[0:2]), binary.BigEndian.Uint32(value[2:]))
+		if _, ok := transAS2SubTypes[subType]; !ok || subType == 0x80 {
+			return s, nil
+		}
+		return s, as2Administrator(value)
 	}
 }
 
 // Transitive IPv4 Specific Extended Community
-func type1(subType uint8, value []byte) string {
+func type1(subType uint8, value []byte) (string, interface{}) {
 	if len(value) < 6 {
-		return fmt.Sprintf("invalid-type1-length=%d", len(value))
+		return fmt.Sprintf("invalid-type1-length=%d", len(value)), nil
+	}
+	s := getSubType(transIPv4SubTypes, subType) + fmt.Sprintf("%s:%d", net.IP(value[0:4]).To4().String(), binary.BigEndian.Uint16(value[4:]))
+	if _, ok := transIPv4SubTypes[subType]; !ok {
+		return s, nil
 	}
-	return getSubType(transIPv4SubTypes, subType) + fmt.Sprintf("%s:%d", net.IP(value[0:4]).To4().String(), binary.BigEndian.Uint16(value[4:]))
+	return s, ipv4Administrator(value)
 }
 
 // Transitive Four-Octet AS-Specific Extended Community
-func type2(subType uint8, value []byte) string {
+func type2(subType uint8, value []byte) (string, interface{}) {
 	if len(value) < 6 {
-		return fmt.Sprintf("invalid-type2-length=%d", len(value))
+		return fmt.Sprintf("invalid-type2-length=%d", len(value)), nil
 	}
-	return getSubType(transAS4SubTypes, subType) + fmt.Sprintf("%d:%d", binary.BigEndian.Uint32(value[0:4]), binary.BigEndian.Uint16(value[4:]))
+	s := getSubType(transAS4SubTypes, subType) + fmt.Sprintf("%d:%d", binary.BigEndian.Uint32(value[0:4]), binary.BigEndian.Uint16(value[4:]))
+	if _, ok := transAS4SubTypes[subType]; !ok {
+		return s, nil
+	}
+	return s, as4Administrator(value)
 }
 
 // Transitive Opaque Extended Community
-func type3(subType uint8, value []byte) string {
+func type3(subType uint8, value []byte) (string, interface{}) {
 	if len(value) < 4 {
-		return fmt.Sprintf("invalid-type3-length=%d", len(value))
+		return fmt.Sprintf("invalid-type3-length=%d", len(value)), nil
 	}
 	var s string
+	var v interface{}
 	switch subType {
 	case 0xb:
 		s = fmt.Sprintf("%d", binary.BigEndian.Uint32(value[0:4]))
+		if len(value) >= 6 {
+			v = &ECColor{
+				Flags: binary.BigEndian.Uint16(value[0:2]),
+				Color: binary.BigEndian.Uint32(value[2:6]),
+			}
+		}
 	case 0xc:
 		s = fmt.Sprintf("%d", binary.BigEndian.Uint16(value[2:4]))
+		if len(value) >= 6 {
+			v = &ECEncapsulation{
+				TunnelType: binary.BigEndian.Uint16(value[4:6]),
+			}
+		}
+	case 0xd:
+		s = fmt.Sprintf("%d", binary.BigEndian.Uint32(value[0:4]))
+		if len(value) >= 6 {
+			v = &ECDefaultGateway{}
+		}
 	default:
 		s = fmt.Sprintf("%d", binary.BigEndian.Uint32(value[0:4]))
 	}
-	return getSubType(transOpaqueSubTypes, subType) + s
+	return getSubType(transOpaqueSubTypes, subType) + s, v
 }
 
 // EVPN Extended Community
-func type6(subType uint8, value []byte) string {
+func type6(subType uint8, value []byte) (string, interface{}) {
 	if len(value) < 6 {
-		return fmt.Sprintf("invalid-type6-length=%d", len(value))
+		return fmt.Sprintf("invalid-type6-length=%d", len(value)), nil
 	}
 	var s string
+	var v interface{}
 	switch subType {
 	case 0x01:
 		l := make([]byte, 4)
 		copy(l, value[3:])
 		s = fmt.Sprintf("%d:%d", value[0], binary.BigEndian.Uint32(l))
+		v = &ECESILabel{
+			SingleActive: value[0]&0x01 != 0,
+			Label:        (uint32(value[3])<<16 | uint32(value[4])<<8 | uint32(value[5])) >> 4,
+		}
 	case 0x02:
 		fallthrough
 	case 0x03:
@@ -332,36 +366,56 @@ func type6(subType uint8, value []byte) string {
 				s += ":"
 			}
 		}
+		v = &ECMAC{
+			MAC: net.HardwareAddr(value[0:6]).String(),
+		}
 	case 0x00:
 		s = fmt.Sprintf("%d:%d", value[0], binary.BigEndian.Uint32(value[2:]))
+		v = &ECMACMobility{
+			Sticky:   value[0]&0x01 != 0,
+			Sequence: binary.BigEndian.Uint32(value[2:6]),
+		}
+	case 0x04:
+		s = fmt.Sprintf("%d", binary.BigEndian.Uint32(value[0:4]))
+		v = &ECLayer2Attributes{
+			ControlFlags: binary.BigEndian.Uint16(value[0:2]),
+			MTU:          binary.BigEndian.Uint16(value[2:4]),
+		}
 	case 0x06:
 		s = fmt.Sprintf("%d:0x%04x", value[0], binary.BigEndian.Uint16(value[1:]))
+		v = &ECDFElection{
+			Algorithm:    value[0] & 0x1f,
+			Capabilities: binary.BigEndian.Uint16(value[1:3]),
+		}
 	case 0x10:
 		// EVPN Link Bandwidth [draft-ietf-bess-evpn-unequal-lb]
 		// value[0] = Value-Units: 0 = Mbps, 1 = generalized weight
 		// value[1:6] = Value-Weight: 5-octet unsigned integer
-		units := value[0]
-		weight := uint64(value[1])<<32 | uint64(binary.BigEndian.Uint32(value[2:6]))
-		switch units {
+		lb := &ECEVPNLinkBandwidth{
+			Units:  value[0],
+			Weight: uint64(value[1])<<32 | uint64(binary.BigEndian.Uint32(value[2:6])),
+		}
+		switch lb.Units {
 		case 0:
-			s = fmt.Sprintf("%d Mbps", weight)
+			s = fmt.Sprintf("%d Mbps", lb.Weight)
 		case 1:
-			s = fmt.Sprintf("weight %d", weight)
+			s = fmt.Sprintf("weight %d", lb.Weight)
 		default:
-			s = fmt.Sprintf("units=%d weight=%d", units, weight)
+			s = fmt.Sprintf("units=%d weight=%d", lb.Units, lb.Weight)
 		}
+		v = lb
 	default:
 		s = fmt.Sprintf("%d", binary.BigEndian.Uint32(value[0:4]))
 	}
 
-	return getSubType(evpnSubTypes, subType) + s
+	return getSubType(evpnSubTypes, subType) + s, v
 }
 
 // Transitive IPv6 Address Specific Extended Community (RFC 5701)
 // Type 0x05, Format: Type (1) + Sub-Type (1) + IPv6 Address (16) + Local Admin (2)
-func type5(subType uint8, value []byte) string {
+func type5(subType uint8, value []byte) (string, interface{}) {
 	if len(value) < 18 {
-		return "invalid-ipv6-ec-length"
+		return "invalid-ipv6-ec-length", nil
 	}
 
 	// Extract IPv6 address (first 16 bytes)
@@ -380,37 +434,43 @@ func type5(subType uint8, value []byte) string {
 		s = "subtype-" + strconv.Itoa(int(subType)) + "=" + ipv6.String() + ":" + strconv.Itoa(int(localAdmin))
 	}
 
-	return s
+	return s, &ECAdministrator{
+		GlobalAdmin: ipv6.String(),
+		LocalAdmin:  uint32(localAdmin),
+	}
 }
 
 // 0x08 Flow spec redirect/mirror to IP next-hop [draft-simpson-idr-flowspec-redirect] 2012-09-28
-func type8(subType uint8, value []byte) string {
-	return ECPFlowspec + "redirect_to_ip_next_hop"
+func type8(subType uint8, value []byte) (string, interface{}) {
+	return ECPFlowspec + "redirect_to_ip_next_hop", nil
 }
 
 // Non-Transitive Two-Octet AS-Specific Extended Community
-func type40(subType uint8, value []byte) string {
+func type40(subType uint8, value []byte) (string, interface{}) {
 	if len(value) < 6 {
-		return fmt.Sprintf("invalid-type40-length=%d", len(value))
+		return fmt.Sprintf("invalid-type40-length=%d", len(value)), nil
 	}
 	var s string
+	var v interface{}
 	switch subType {
 	case 0x04:
 		// Link Bandwidth: Local Administrator (value[2:6]) is IEEE 754 float32, bytes/sec
-		f := binary.BigEndian.Uint32(value[2:6])
-		s = fmt.Sprintf("%03f", math.Float32frombits(f))
+		lb := linkBandwidth(value)
+		s = fmt.Sprintf("%03f", lb.Bandwidth)
+		v = lb
 	default:
 		s = fmt.Sprintf("%d", binary.BigEndian.Uint32(value[0:4]))
 
 	}
 
-	return getSubType(nonTransAS2SubTypes, subType) + s
+	return getSubType(nonTransAS2SubTypes, subType) + s, v
 }
 
 // Non-Transitive Opaque Extended Community (RFC 8097)
 // Type 0x43, Subtype 0x00 - Origin Validation State
-func type43(subType uint8, value []byte) string {
+func type43(subType uint8, value []byte) (string, interface{}) {
 	var s string
+	var v interface{}
 	switch subType {
 	case 0x00:
 		// RFC 8097: Last byte contains validation state
@@ -428,79 +488,103 @@ func type43(subType uint8, value []byte) string {
 				// RFC 8097: Values > 2 should trigger discard
 				s = fmt.Sprintf("unknown=%d", state)
 			}
+			if state <= 2 {
+				v = &ECOriginValidation{
+					State: s,
+				}
+			}
 		} else {
 			s = "invalid-length"
 		}
 	default:
 		s = fmt.Sprintf("unknown-subtype=%d", subType)
 	}
-	return getSubType(nonTransOpaqueSubTypes, subType) + s
+	return getSubType(nonTransOpaqueSubTypes, subType) + s, v
 }
 
 // Flowspec Extended Community
-func type80(subType uint8, value []byte) string {
+func type80(subType uint8, value []byte) (string, interface{}) {
 	var s string
+	var v interface{}
 
 	if len(value) == 6 {
 		switch subType {
 		case 0x06:
-			s = fmt.Sprintf("AS: %d Rate: %d bps", binary.BigEndian.Uint16(value[:2]), uint32(math.Float32frombits(binary.BigEndian.Uint32(value[2:])))*8)
+			tr := &ECFlowspecTrafficRate{
+				ASN:  binary.BigEndian.Uint16(value[0:2]),
+				Rate: math.Float32frombits(binary.BigEndian.Uint32(value[2:6])),
+			}
+			s = fmt.Sprintf("AS: %d Rate: %d bps", tr.ASN, uint32(tr.Rate)*8)
+			v = tr
 		case 0x08:
 			s = fmt.Sprintf("%d:%d", binary.BigEndian.Uint16(value[0:2]), binary.BigEndian.Uint32(value[2:]))
+			v = as2Administrator(value)
 		case 0x07:
 			// Traffic-Action Extended Community (RFC 8955 Section 7.3)
 			// Bit 47 (T): Terminal Action, Bit 46 (S): Sample
-			terminalAction := value[5]&0x01 != 0
-			sampleBit := value[5]&0x02 != 0
-			s = fmt.Sprintf("T=%t S=%t", terminalAction, sampleBit)
+			ta := &ECFlowspecTrafficAction{
+				Terminal: value[5]&0x01 != 0,
+				Sample:   value[5]&0x02 != 0,
+			}
+			s = fmt.Sprintf("T=%t S=%t", ta.Terminal, ta.Sample)
+			v = ta
 		case 0x09:
 			// Traffic Marking Extended Community (RFC 8955 Section 7.5)
 			// Lower 6 bits of last byte contain DSCP value
-			dscp := value[5] & 0x3F
-			s = fmt.Sprintf("DSCP=%d", dscp)
+			tm := &ECFlowspecTrafficMarking{
+				DSCP: value[5] & 0x3F,
+			}
+			s = fmt.Sprintf("DSCP=%d", tm.DSCP)
+			v = tm
 		default:
 			s = tools.MessageHex(value)
 		}
 	} else {
 		s = fmt.Sprintf("invalid value length of %d", len(value))
 	}
-	return getSubType(flowspecSubTypes, subType) + s
+	return getSubType(flowspecSubTypes, subType) + s, v
 }
 
-func type81(subType uint8, value []byte) string {
+func type81(subType uint8, value []byte) (string, interface{}) {
 	var s string
+	var v interface{}
 
 	if len(value) == 6 {
 		switch subType {
 		case 0x08:
 			s = fmt.Sprintf("%s:%d", net.IP(value[0:4]).To4().String(), binary.BigEndian.Uint16(value[4:]))
+			v = ipv4Administrator(value)
 		default:
 			s = tools.MessageHex(value)
 		}
 	} else {
 		s = fmt.Sprintf("invalid value length of %d", len(value))
 	}
-	return getSubType(flowspecSubTypes, subType) + s
+	return getSubType(flowspecSubTypes, subType) + s, v
 }
 
-func type82(subType uint8, value []byte) string {
+func type82(subType uint8, value []byte) (string, interface{}) {
 	var s string
+	var v interface{}
 
 	if len(value) == 6 {
 		switch subType {
 		case 0x08:
 			s = fmt.Sprintf("%d:%d", binary.BigEndian.Uint32(value[0:4]), binary.BigEndian.Uint16(value[4:]))
+			v = as4Administrator(value)
 		default:
 			s = tools.MessageHex(value)
 		}
 	} else {
 		s = fmt.Sprintf("invalid value length of %d", len(value))
 	}
-	return getSubType(flowspecSubTypes, subType) + s
+	return getSubType(flowspecSubTypes, subType) + s, v
 }
 
 // extComm defines a map with Extended Community as a key, it return a function to process a type specific sub type.
-var extComm = map[uint8]func(uint8, []byte) string{
+// The function returns the display string of the community and the typed value object of the sub types
+// with a structured representation, nil for the other sub types.
+var extComm = map[uint8]func(uint8, []byte) (string, interface{}){
 	0x0:  type0,
 	0x1:  type1,
 	0x2:  type2,
@@ -527,7 +611,8 @@ func unmarshalAttrIPv6ExtCommunity(b []byte) []string {
 	result := make([]string, 0, len(b)/20)
 	for p := 0; p+20 <= len(b); p += 20 {
 		subType := b[p+1]
-		result = append(result, type5(subType, b[p+2:p+20]))
+		s, _ := type5(subType, b[p+2:p+20])
+		result = append(result, s)
 	}
 	if len(result) == 0 {
 		return nil
@@ -536,6 +621,13 @@ func unmarshalAttrIPv6ExtCommunity(b []byte) []string {
 }
 
 func (ext *ExtCommunity) String() string {
+	s, _ := ext.decode()
+	return s
+}
+
+// decode returns the display string of the Extended Community and its typed
+// value object, nil when the sub type has no structured representation.
+func (ext *ExtCommunity) decode() (string, interface{}) {
 	var s string
 	// var prefix string
 	var subType uint8
@@ -548,7 +640,7 @@ func (ext *ExtCommunity) String() string {
 	if f == nil {
 		s = "unknown="
 		s += fmt.Sprintf("Type: %d Subtype: %d Value: %s", ext.Type, subType, tools.MessageHex(ext.Value))
-		return s
+		return s, nil
 	}
 	return f(subType, ext.Value)
 }
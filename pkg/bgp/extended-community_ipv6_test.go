@@ -13,7 +13,7 @@ func TestType5_RouteTarget(t *testing.T) {
 	copy(value[0:16], ipv6)
 	binary.BigEndian.PutUint16(value[16:18], 100)
 
-	result := type5(0x02, value)
+	result, _ := type5(0x02, value)
 	expected := "rt=2001:db8::1:100"
 
 	if result != expected {
@@ -28,7 +28,7 @@ func TestType5_RouteOrigin(t *testing.T) {
 	copy(value[0:16], ipv6)
 	binary.BigEndian.PutUint16(value[16:18], 200)
 
-	result := type5(0x03, value)
+	result, _ := type5(0x03, value)
 	expected := "ro=2001:db8::2:200"
 
 	if result != expected {
@@ -43,7 +43,7 @@ func TestType5_UnknownSubtype(t *testing.T) {
 	copy(value[0:16], ipv6)
 	binary.BigEndian.PutUint16(value[16:18], 300)
 
-	result := type5(0x99, value)
+	result, _ := type5(0x99, value)
 	expected := "subtype-153=fe80::1:300"
 
 	if result != expected {
@@ -55,7 +55,7 @@ func TestType5_InvalidLength(t *testing.T) {
 	// Test with insufficient data (less than 18 bytes)
 	value := make([]byte, 10)
 
-	result := type5(0x02, value)
+	result, _ := type5(0x02, value)
 	expected := "invalid-ipv6-ec-length"
 
 	if result != expected {
@@ -70,7 +70,7 @@ func TestType5_FullIPv6Address(t *testing.T) {
 	copy(value[0:16], ipv6)
 	binary.BigEndian.PutUint16(value[16:18], 65535)
 
-	result := type5(0x02, value)
+	result, _ := type5(0x02, value)
 	expected := "rt=2001:db8:85a3::8a2e:370:7334:65535"
 
 	if result != expected {
@@ -85,7 +85,7 @@ func TestType5_LocalhostIPv6(t *testing.T) {
 	copy(value[0:16], ipv6)
 	binary.BigEndian.PutUint16(value[16:18], 1)
 
-	result := type5(0x02, value)
+	result, _ := type5(0x02, value)
 	expected := "rt=::1:1"
 
 	if result != expected {
@@ -100,7 +100,7 @@ func TestType5_ZeroLocalAdmin(t *testing.T) {
 	copy(value[0:16], ipv6)
 	binary.BigEndian.PutUint16(value[16:18], 0)
 
-	result := type5(0x02, value)
+	result, _ := type5(0x02, value)
 	expected := "rt=2001:db8::1:0"
 
 	if result != expected {
@@ -115,7 +115,7 @@ func TestType5_MaxLocalAdmin(t *testing.T) {
 	copy(value[0:16], ipv6)
 	binary.BigEndian.PutUint16(value[16:18], 65535)
 
-	result := type5(0x03, value)
+	result, _ := type5(0x03, value)
 	expected := "ro=ff02::1:65535"
 
 	if result != expected {
@@ -152,7 +152,7 @@ func TestType5_CompressedIPv6(t *testing.T) {
 	copy(value[0:16], ipv6)
 	binary.BigEndian.PutUint16(value[16:18], 500)
 
-	result := type5(0x02, value)
+	result, _ := type5(0x02, value)
 	expected := "rt=192.0.2.1:500"
 
 	if result != expected {
@@ -145,8 +145,8 @@ func TestLinkBandwidth(t *testing.T) {
 }
 
 func TestLinkBandwidthTruncatedValue(t *testing.T) {
-	type0LinkBW := func(v []byte) string { return type0(0x04, v) }
-	type40LinkBW := func(v []byte) string { return type40(0x04, v) }
+	type0LinkBW := func(v []byte) string { s, _ := type0(0x04, v); return s }
+	type40LinkBW := func(v []byte) string { s, _ := type40(0x04, v); return s }
 
 	tests := []struct {
 		name   string
@@ -232,13 +232,13 @@ func TestEVPNLinkBandwidth(t *testing.T) {
 
 func TestEVPNLinkBandwidthTruncatedValue(t *testing.T) {
 	// type6 with subtype 0x10 but truncated value (less than 6 bytes)
-	result := type6(0x10, []byte{0x00, 0x00, 0x00})
+	result, _ := type6(0x10, []byte{0x00, 0x00, 0x00})
 	expect := "invalid-type6-length=3"
 	if result != expect {
 		t.Errorf("got %s, want %s", result, expect)
 	}
 
-	result = type6(0x10, nil)
+	result, _ = type6(0x10, nil)
 	expect = "invalid-type6-length=0"
 	if result != expect {
 		t.Errorf("got %s, want %s", result, expect)
@@ -35,7 +35,7 @@ func TestRFC8097_Type43_OriginValidationStates(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := type43(tt.subType, tt.value)
+			result, _ := type43(tt.subType, tt.value)
 			if result != tt.expected {
 				t.Errorf("type43(%d, %v) = %q, want %q", tt.subType, tt.value, result, tt.expected)
 			}
@@ -77,7 +77,7 @@ func TestRFC8097_Type43_UnknownStates(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			value := []byte{0x00, 0x00, 0x00, 0x00, 0x00, tt.state}
-			result := type43(0x00, value)
+			result, _ := type43(0x00, value)
 			if result != tt.expected {
 				t.Errorf("type43(0x00, state=%d) = %q, want %q", tt.state, result, tt.expected)
 			}
@@ -117,7 +117,7 @@ func TestRFC8097_Type43_InvalidLength(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := type43(0x00, tt.value)
+			result, _ := type43(0x00, tt.value)
 			if result != tt.expected {
 				t.Errorf("type43(0x00, %d bytes) = %q, want %q", len(tt.value), result, tt.expected)
 			}
@@ -153,7 +153,7 @@ func TestRFC8097_Type43_UnknownSubType(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			value := []byte{0x00, 0x00, 0x00, 0x00, 0x00, 0x00}
-			result := type43(tt.subType, value)
+			result, _ := type43(tt.subType, value)
 			if result != tt.expected {
 				t.Errorf("type43(%d, ...) = %q, want %q", tt.subType, result, tt.expected)
 			}
@@ -199,7 +199,7 @@ func TestRFC8097_Type43_ReservedBytesIgnored(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := type43(0x00, tt.value)
+			result, _ := type43(0x00, tt.value)
 			if result != tt.expected {
 				t.Errorf("type43() = %q, want %q", result, tt.expected)
 			}
@@ -384,7 +384,7 @@ func TestRFC8097_ExtCommunityDispatch(t *testing.T) {
 	if !ok {
 		t.Fatal("extComm[0x43] not registered")
 	}
-	result := handler(0x00, []byte{0x00, 0x00, 0x00, 0x00, 0x00, 0x00})
+	result, _ := handler(0x00, []byte{0x00, 0x00, 0x00, 0x00, 0x00, 0x00})
 	if result != "ov-state=valid" {
 		t.Errorf("extComm[0x43](0x00, ...) = %q, want %q", result, "ov-state=valid")
 	}
@@ -456,13 +456,13 @@ func TestRFC8097_ExtCommunity_IsRouteTarget_False(t *testing.T) {
 // 6 bytes is valid but 7+ bytes still works (uses index 5).
 func TestRFC8097_ValueLengthExactly6(t *testing.T) {
 	value6 := []byte{0x00, 0x00, 0x00, 0x00, 0x00, 0x01}
-	result := type43(0x00, value6)
+	result, _ := type43(0x00, value6)
 	if result != "ov-state=not-found" {
 		t.Errorf("6-byte value: got %q, want %q", result, "ov-state=not-found")
 	}
 
 	value7 := []byte{0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff}
-	result = type43(0x00, value7)
+	result, _ = type43(0x00, value7)
 	if result != "ov-state=invalid" {
 		t.Errorf("7-byte value: got %q, want %q", result, "ov-state=invalid")
 	}
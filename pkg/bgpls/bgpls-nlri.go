@@ -817,6 +817,37 @@ func (ls *NLRI) GetSRAdjacencySID(proto base.ProtoID) ([]*sr.AdjacencySIDTLV, er
 	return adjs, nil
 }
 
+// GetL2BundleMember returns L2 Bundle Member Attributes TLVs (type 1172), one
+// per bundle member link
+func (ls *NLRI) GetL2BundleMember(proto base.ProtoID) ([]*L2BundleMember, error) {
+	members := make([]*L2BundleMember, 0)
+	for _, tlv := range ls.LS {
+		if tlv.Type != 1172 {
+			continue
+		}
+		m, err := UnmarshalL2BundleMember(tlv.Value, proto)
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, m)
+	}
+
+	return members, nil
+}
+
+// GetExtAdminGroup returns the administrative groups set in the Extended
+// Administrative Group TLV (type 1173), nil when the TLV is not present
+func (ls *NLRI) GetExtAdminGroup() ([]uint32, error) {
+	for _, tlv := range ls.LS {
+		if tlv.Type != 1173 {
+			continue
+		}
+		return UnmarshalExtAdminGroup(tlv.Value)
+	}
+
+	return nil, nil
+}
+
 // GetSRBindingSID returns the SR Binding SID TLV (type 1201)
 func (ls *NLRI) GetSRBindingSID() (*SRBindingSID, error) {
 	for _, tlv := range ls.LS {
@@ -0,0 +1,96 @@
+package bgpls
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/golang/glog"
+	"github.com/sbezverk/gobmp/pkg/base"
+	"github.com/sbezverk/gobmp/pkg/sr"
+	"github.com/sbezverk/tools"
+)
+
+// L2BundleMember defines L2 Bundle Member Attributes TLV (1172) object, it
+// carries the attributes of a single member link of a Layer 2 bundle.
+// https://www.rfc-editor.org/rfc/rfc9085#section-2.2.3
+type L2BundleMember struct {
+	Descriptor            uint32                `json:"member_descriptor"`
+	AdminGroup            uint32                `json:"admin_group,omitempty"`
+	ExtAdminGroup         []uint32              `json:"ext_admin_group,omitempty"`
+	MaxLinkBWKbps         uint64                `json:"max_link_bw_kbps,omitempty"`
+	MaxResvBWKbps         uint64                `json:"max_resv_bw_kbps,omitempty"`
+	UnResvBWKbps          []uint64              `json:"unresv_bw_kbps,omitempty"`
+	LinkProtection        uint16                `json:"link_protection,omitempty"`
+	LSAdjacencySID        []*sr.AdjacencySIDTLV `json:"ls_adjacency_sid,omitempty"`
+	UnidirLinkDelay       uint32                `json:"unidir_link_delay,omitempty"`
+	UnidirLinkDelayMinMax []uint32              `json:"unidir_link_delay_min_max,omitempty"`
+	UnidirDelayVariation  uint32                `json:"unidir_delay_variation,omitempty"`
+	UnidirPacketLoss      uint32                `json:"unidir_packet_loss,omitempty"`
+	UnidirResidualBW      uint32                `json:"unidir_residual_bw,omitempty"`
+	UnidirAvailableBW     uint32                `json:"unidir_available_bw,omitempty"`
+	UnidirBWUtilization   uint32                `json:"unidir_bw_utilization,omitempty"`
+}
+
+// UnmarshalL2BundleMember builds L2 Bundle Member Attributes object, the
+// member link attributes are encoded as regular BGP-LS link attribute TLVs.
+func UnmarshalL2BundleMember(b []byte, proto base.ProtoID) (*L2BundleMember, error) {
+	if glog.V(6) {
+		glog.Infof("L2 Bundle Member Attributes Raw: %s", tools.MessageHex(b))
+	}
+	if len(b) < 4 {
+		return nil, fmt.Errorf("invalid length %d of L2 Bundle Member Attributes tlv", len(b))
+	}
+	m := &L2BundleMember{
+		Descriptor: binary.BigEndian.Uint32(b[:4]),
+	}
+	tlvs, err := UnmarshalBGPLSTLV(b[4:])
+	if err != nil {
+		return nil, err
+	}
+	attrs := &NLRI{LS: tlvs}
+	m.AdminGroup = attrs.GetAdminGroup()
+	if m.ExtAdminGroup, err = attrs.GetExtAdminGroup(); err != nil {
+		return nil, err
+	}
+	m.MaxLinkBWKbps = attrs.GetMaxLinkBandwidthKbps()
+	m.MaxResvBWKbps = attrs.GetMaxReservableLinkBandwidthKbps()
+	m.UnResvBWKbps = attrs.GetUnreservedLinkBandwidthKbps()
+	m.LinkProtection = attrs.GetLinkProtectionType()
+	adjs, err := attrs.GetSRAdjacencySID(proto)
+	if err != nil {
+		return nil, err
+	}
+	if len(adjs) != 0 {
+		m.LSAdjacencySID = adjs
+	}
+	m.UnidirLinkDelay = attrs.GetUnidirLinkDelay()
+	m.UnidirLinkDelayMinMax = attrs.GetUnidirLinkDelayMinMax()
+	m.UnidirDelayVariation = attrs.GetUnidirDelayVariation()
+	m.UnidirPacketLoss = attrs.GetUnidirLinkLoss()
+	m.UnidirResidualBW = attrs.GetUnidirResidualBandwidth()
+	m.UnidirAvailableBW = attrs.GetUnidirAvailableBandwidth()
+	m.UnidirBWUtilization = attrs.GetUnidirUtilizedBandwidth()
+
+	return m, nil
+}
+
+// UnmarshalExtAdminGroup decodes an Extended Administrative Group bitmap into
+// the list of the administrative groups it contains. Groups are numbered as in
+// RFC 7308, group 0 is the least significant bit of the first 32 bits word.
+// https://www.rfc-editor.org/rfc/rfc9104#section-2
+func UnmarshalExtAdminGroup(b []byte) ([]uint32, error) {
+	if len(b)%4 != 0 {
+		return nil, fmt.Errorf("invalid length %d of Extended Administrative Group tlv, must be a multiple of 4", len(b))
+	}
+	groups := make([]uint32, 0)
+	for w := 0; w < len(b)/4; w++ {
+		word := binary.BigEndian.Uint32(b[w*4 : w*4+4])
+		for bit := uint32(0); bit < 32; bit++ {
+			if word&(1<<bit) != 0 {
+				groups = append(groups, uint32(w)*32+bit)
+			}
+		}
+	}
+
+	return groups, nil
+}
@@ -0,0 +1,135 @@
+package bgpls
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sbezverk/gobmp/pkg/base"
+	"github.com/sbezverk/gobmp/pkg/sr"
+)
+
+func TestUnmarshalExtAdminGroup(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   []byte
+		expect  []uint32
+		wantErr bool
+	}{
+		{
+			name:   "single word",
+			input:  []byte{0x80, 0x00, 0x00, 0x05},
+			expect: []uint32{0, 2, 31},
+		},
+		{
+			name:   "two words",
+			input:  []byte{0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x01, 0x00},
+			expect: []uint32{0, 40},
+		},
+		{
+			name:   "empty bitmap",
+			input:  []byte{0x00, 0x00, 0x00, 0x00},
+			expect: []uint32{},
+		},
+		{
+			name:    "invalid length",
+			input:   []byte{0x00, 0x00, 0x01},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := UnmarshalExtAdminGroup(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("UnmarshalExtAdminGroup() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && !reflect.DeepEqual(got, tt.expect) {
+				t.Fatalf("UnmarshalExtAdminGroup() = %v, want %v", got, tt.expect)
+			}
+		})
+	}
+}
+
+func TestUnmarshalL2BundleMember(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   []byte
+		proto   base.ProtoID
+		expect  *L2BundleMember
+		wantErr bool
+	}{
+		{
+			name: "member with Adj-SID, bandwidth and delay",
+			input: []byte{
+				0x00, 0x00, 0x00, 0x07, // Member descriptor 7
+				0x04, 0x40, 0x00, 0x04, 0x00, 0x00, 0x00, 0x02, // 1088 Admin Group
+				0x04, 0x41, 0x00, 0x04, 0x4c, 0xee, 0x6b, 0x28, // 1089 Max Link BW 125000000 bytes/s
+				0x04, 0x4b, 0x00, 0x07, 0x30, 0x00, 0x00, 0x00, 0x00, 0x5d, 0xc1, // 1099 Adj-SID V/L label 24001
+				0x04, 0x5a, 0x00, 0x04, 0x00, 0x00, 0x00, 0x64, // 1114 Unidirectional Link Delay 100
+				0x04, 0x95, 0x00, 0x04, 0x00, 0x00, 0x00, 0x11, // 1173 Extended Admin Group 0, 4
+			},
+			proto: base.ISISL2,
+			expect: &L2BundleMember{
+				Descriptor:      7,
+				AdminGroup:      2,
+				ExtAdminGroup:   []uint32{0, 4},
+				MaxLinkBWKbps:   1000000,
+				LSAdjacencySID:  []*sr.AdjacencySIDTLV{{Flags: &sr.AdjISISFlags{VFlag: true, LFlag: true}, SID: 24001}},
+				UnidirLinkDelay: 100,
+			},
+		},
+		{
+			name:   "descriptor only",
+			input:  []byte{0x00, 0x00, 0x00, 0x01},
+			proto:  base.OSPFv2,
+			expect: &L2BundleMember{Descriptor: 1},
+		},
+		{
+			name:    "truncated descriptor",
+			input:   []byte{0x00, 0x00, 0x01},
+			proto:   base.ISISL2,
+			wantErr: true,
+		},
+		{
+			name:    "truncated member attribute",
+			input:   []byte{0x00, 0x00, 0x00, 0x01, 0x04, 0x40, 0x00, 0x04, 0x00},
+			proto:   base.ISISL2,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := UnmarshalL2BundleMember(tt.input, tt.proto)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("UnmarshalL2BundleMember() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && !reflect.DeepEqual(got, tt.expect) {
+				t.Fatalf("UnmarshalL2BundleMember() = %+v, want %+v", got, tt.expect)
+			}
+		})
+	}
+}
+
+func TestGetL2BundleMemberAndExtAdminGroup(t *testing.T) {
+	ls := &NLRI{LS: []TLV{
+		{Type: 1172, Length: 4, Value: []byte{0, 0, 0, 1}},
+		{Type: 1172, Length: 4, Value: []byte{0, 0, 0, 2}},
+		{Type: 1173, Length: 8, Value: []byte{0, 0, 0, 0, 0x80, 0, 0, 0}},
+	}}
+	members, err := ls.GetL2BundleMember(base.ISISL2)
+	if err != nil {
+		t.Fatalf("GetL2BundleMember() error = %v", err)
+	}
+	if len(members) != 2 || members[0].Descriptor != 1 || members[1].Descriptor != 2 {
+		t.Fatalf("GetL2BundleMember() = %+v", members)
+	}
+	eag, err := ls.GetExtAdminGroup()
+	if err != nil {
+		t.Fatalf("GetExtAdminGroup() error = %v", err)
+	}
+	if !reflect.DeepEqual(eag, []uint32{63}) {
+		t.Fatalf("GetExtAdminGroup() = %v, want [63]", eag)
+	}
+	if eag, err := (&NLRI{}).GetExtAdminGroup(); eag != nil || err != nil {
+		t.Fatalf("GetExtAdminGroup() without TLV = %v, %v", eag, err)
+	}
+}
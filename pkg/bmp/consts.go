@@ -74,10 +74,54 @@ const (
 	MVPNV4Msg = 208
 	// MVPNV6Msg defines BMP Route Monitoring message carrying MVPN IPv6 NLRI
 	MVPNV6Msg = 210
+	// LSTopologyChangeMsg defines message type of BGP-LS topology change events
+	LSTopologyChangeMsg = 20
+	// SRPolicyResolvedMsg defines message type of SR Policies with segment lists
+	// resolved against the BGP-LS topology
+	SRPolicyResolvedMsg = 21
+	// ChurnStatsMsg defines message type of periodic route churn statistics
+	ChurnStatsMsg = 22
+	// FlapEventMsg defines message type of route flap damping events
+	FlapEventMsg = 23
+	// HijackEventMsg defines message type of prefix hijack detection events
+	HijackEventMsg = 24
+	// RouteLeakMsg defines message type of suspected route leak events
+	RouteLeakMsg = 25
+	// PeerSyncMsg defines message type of per peer and address family initial table sync events
+	PeerSyncMsg = 26
+	// OpenBMPCollectorMsg defines message type of OpenBMP parsed collector messages
+	OpenBMPCollectorMsg = 27
+	// OpenBMPRouterMsg defines message type of OpenBMP parsed router messages
+	OpenBMPRouterMsg = 28
+	// OpenBMPPeerMsg defines message type of OpenBMP parsed peer messages
+	OpenBMPPeerMsg = 29
+	// OpenBMPBaseAttributeMsg defines message type of OpenBMP parsed base attribute messages
+	OpenBMPBaseAttributeMsg = 30
+	// OpenBMPUnicastPrefixMsg defines message type of OpenBMP parsed unicast prefix messages
+	OpenBMPUnicastPrefixMsg = 31
+	// OpenBMPL3VPNMsg defines message type of OpenBMP parsed L3VPN messages
+	OpenBMPL3VPNMsg = 32
+	// OpenBMPLSNodeMsg defines message type of OpenBMP parsed LS node messages
+	OpenBMPLSNodeMsg = 33
+	// OpenBMPLSLinkMsg defines message type of OpenBMP parsed LS link messages
+	OpenBMPLSLinkMsg = 34
+	// OpenBMPLSPrefixMsg defines message type of OpenBMP parsed LS prefix messages
+	OpenBMPLSPrefixMsg = 35
+	// OpenBMPStatMsg defines message type of OpenBMP parsed BMP statistics messages
+	OpenBMPStatMsg = 36
+	// BaseAttributeMsg defines message type of the unique base attributes
+	// referenced by the prefix messages
+	BaseAttributeMsg = 37
 	// BMPRawMsg defines BMP RAW message type for unprocessed BMP messages
 	BMPRawMsg = 255
 )
 
+// IsOpenBMPMsg returns true for the message types of the OpenBMP parsed
+// messages, published in the OpenBMP text format whatever the encoding.
+func IsOpenBMPMsg(t int) bool {
+	return t >= OpenBMPCollectorMsg && t <= OpenBMPStatMsg
+}
+
 // Peer Up Informational TLV types per RFC 9069 §4.4
 const (
 	// PeerUpTLVVRFTableName identifies a VRF/Table Name Informational TLV (type 3) per RFC 9069 §4.4
@@ -0,0 +1,57 @@
+package bmp
+
+import "strings"
+
+// MessageTypeNames maps the parsed message types to their names, the topic
+// names without the "gobmp.parsed." prefix. It is the name table of every
+// parsed message type the producer publishes.
+var MessageTypeNames = map[int]string{
+	PeerStateChangeMsg:  "peer",
+	UnicastPrefixMsg:    "unicast_prefix",
+	UnicastPrefixV4Msg:  "unicast_prefix_v4",
+	UnicastPrefixV6Msg:  "unicast_prefix_v6",
+	LSNodeMsg:           "ls_node",
+	LSLinkMsg:           "ls_link",
+	L3VPNMsg:            "l3vpn",
+	L3VPNV4Msg:          "l3vpn_v4",
+	L3VPNV6Msg:          "l3vpn_v6",
+	LSPrefixMsg:         "ls_prefix",
+	LSSRv6SIDMsg:        "ls_srv6_sid",
+	EVPNMsg:             "evpn",
+	SRPolicyMsg:         "sr_policy",
+	SRPolicyV4Msg:       "sr_policy_v4",
+	SRPolicyV6Msg:       "sr_policy_v6",
+	FlowspecMsg:         "flowspec",
+	FlowspecV4Msg:       "flowspec_v4",
+	FlowspecV6Msg:       "flowspec_v6",
+	VPLSMsg:             "vpls",
+	MulticastV4Msg:      "multicast_v4",
+	MulticastV6Msg:      "multicast_v6",
+	RTCV4Msg:            "rtc_v4",
+	RTCV6Msg:            "rtc_v6",
+	MCASTVPNV4Msg:       "mcast_vpn_v4",
+	MCASTVPNV6Msg:       "mcast_vpn_v6",
+	MVPNV4Msg:           "mvpn_v4",
+	MVPNV6Msg:           "mvpn_v6",
+	LSTopologyChangeMsg: "ls_topology_change",
+	SRPolicyResolvedMsg: "sr_policy_resolved",
+	ChurnStatsMsg:       "churn_stats",
+	FlapEventMsg:        "flap_event",
+	HijackEventMsg:      "hijack_event",
+	StatsReportMsg:      "statistics",
+	RouteLeakMsg:        "route_leak",
+	PeerSyncMsg:         "peer_sync",
+	BaseAttributeMsg:    "base_attribute",
+}
+
+// MessageTypes returns the parsed message types of a name, a name without the
+// _v4 or _v6 suffix also returns the split address family types.
+func MessageTypes(name string) []int {
+	var types []int
+	for t, n := range MessageTypeNames {
+		if n == name || strings.TrimSuffix(strings.TrimSuffix(n, "_v4"), "_v6") == name {
+			types = append(types, t)
+		}
+	}
+	return types
+}
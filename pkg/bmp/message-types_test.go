@@ -0,0 +1,76 @@
+package bmp
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestMessageTypeNames(t *testing.T) {
+	tests := []struct {
+		msgType int
+		name    string
+	}{
+		{PeerStateChangeMsg, "peer"},
+		{UnicastPrefixMsg, "unicast_prefix"},
+		{UnicastPrefixV4Msg, "unicast_prefix_v4"},
+		{UnicastPrefixV6Msg, "unicast_prefix_v6"},
+		{LSNodeMsg, "ls_node"},
+		{LSLinkMsg, "ls_link"},
+		{L3VPNMsg, "l3vpn"},
+		{L3VPNV4Msg, "l3vpn_v4"},
+		{L3VPNV6Msg, "l3vpn_v6"},
+		{LSPrefixMsg, "ls_prefix"},
+		{LSSRv6SIDMsg, "ls_srv6_sid"},
+		{EVPNMsg, "evpn"},
+		{SRPolicyMsg, "sr_policy"},
+		{SRPolicyV4Msg, "sr_policy_v4"},
+		{SRPolicyV6Msg, "sr_policy_v6"},
+		{FlowspecMsg, "flowspec"},
+		{FlowspecV4Msg, "flowspec_v4"},
+		{FlowspecV6Msg, "flowspec_v6"},
+		{VPLSMsg, "vpls"},
+		{MulticastV4Msg, "multicast_v4"},
+		{MulticastV6Msg, "multicast_v6"},
+		{RTCV4Msg, "rtc_v4"},
+		{RTCV6Msg, "rtc_v6"},
+		{MCASTVPNV4Msg, "mcast_vpn_v4"},
+		{MCASTVPNV6Msg, "mcast_vpn_v6"},
+		{MVPNV4Msg, "mvpn_v4"},
+		{MVPNV6Msg, "mvpn_v6"},
+		{LSTopologyChangeMsg, "ls_topology_change"},
+		{SRPolicyResolvedMsg, "sr_policy_resolved"},
+		{ChurnStatsMsg, "churn_stats"},
+		{FlapEventMsg, "flap_event"},
+		{HijackEventMsg, "hijack_event"},
+		{StatsReportMsg, "statistics"},
+		{RouteLeakMsg, "route_leak"},
+		{PeerSyncMsg, "peer_sync"},
+		{BaseAttributeMsg, "base_attribute"},
+	}
+	for _, tt := range tests {
+		if got := MessageTypeNames[tt.msgType]; got != tt.name {
+			t.Errorf("MessageTypeNames[%d] = %q, want %q", tt.msgType, got, tt.name)
+		}
+	}
+	if len(MessageTypeNames) != len(tests) {
+		t.Errorf("MessageTypeNames has %d names, want %d", len(MessageTypeNames), len(tests))
+	}
+	// The RAW and OpenBMP messages are not parsed messages
+	for _, msgType := range []int{BMPRawMsg, OpenBMPCollectorMsg, OpenBMPStatMsg} {
+		if n, ok := MessageTypeNames[msgType]; ok {
+			t.Errorf("MessageTypeNames[%d] = %q, want no name", msgType, n)
+		}
+	}
+}
+
+func TestMessageTypes(t *testing.T) {
+	got := MessageTypes("l3vpn")
+	sort.Ints(got)
+	if want := []int{L3VPNMsg, L3VPNV4Msg, L3VPNV6Msg}; !reflect.DeepEqual(got, want) {
+		t.Errorf("MessageTypes(l3vpn) = %v, want %v", got, want)
+	}
+	if got := MessageTypes("flap_event"); !reflect.DeepEqual(got, []int{FlapEventMsg}) {
+		t.Errorf("MessageTypes(flap_event) = %v, want [%d]", got, FlapEventMsg)
+	}
+}
@@ -18,6 +18,10 @@ var ErrNotAnUpdate = errors.New("route monitor: BGP message is not an UPDATE")
 // RouteMonitor defines a structure of BMP Route Monitoring message
 type RouteMonitor struct {
 	Update *bgp.Update
+	// RawMessage is the complete BGP UPDATE message (marker, length, type and
+	// body) the Route Monitor carried, kept for consumers such as the MRT
+	// writer which re-emit the message on the wire.
+	RawMessage []byte
 }
 
 // UnmarshalBMPRouteMonitorMessage builds a BMP Route Monitor object. AS_PATH
@@ -74,6 +78,7 @@ func unmarshalBMPRouteMonitorMessage(b []byte, as4hint *bool) (*RouteMonitor, er
 			return nil, err
 		}
 		rm.Update = u
+		rm.RawMessage = b
 	default:
 		return nil, fmt.Errorf("%w: got type %d", ErrNotAnUpdate, t)
 	}
@@ -0,0 +1,475 @@
+// Package churn measures the route churn seen by the collector: the update and
+// withdraw rates per router, peer, RIB and address family, and the flapping
+// prefixes detected with RFC 2439 route flap damping penalties.
+package churn
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/sbezverk/gobmp/pkg/bgp"
+	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/message"
+	"github.com/sbezverk/gobmp/pkg/pub"
+)
+
+// Defaults follow the commonly deployed RFC 2439 damping parameters.
+const (
+	DefaultInterval          = time.Minute
+	DefaultHalfLife          = 15 * time.Minute
+	DefaultWithdrawPenalty   = 1000
+	DefaultAttrChangePenalty = 500
+	DefaultSuppressThreshold = 2000
+	DefaultReuseThreshold    = 750
+	DefaultMaxPenalty        = 12000
+)
+
+// Config holds the churn analytics parameters, zero values select the defaults.
+type Config struct {
+	// Interval is the period of the churn_stats reports
+	Interval time.Duration `yaml:"interval"`
+	// HalfLife is the time for a prefix penalty to decay by half
+	HalfLife time.Duration `yaml:"half_life"`
+	// WithdrawPenalty and AttrChangePenalty are added to the prefix penalty on
+	// every withdrawal and every re-advertisement with changed attributes
+	WithdrawPenalty   float64 `yaml:"withdraw_penalty"`
+	AttrChangePenalty float64 `yaml:"attr_change_penalty"`
+	// A prefix is flapping once its penalty reaches SuppressThreshold, until
+	// it decays below ReuseThreshold. The penalty never exceeds MaxPenalty.
+	SuppressThreshold float64 `yaml:"suppress_threshold"`
+	ReuseThreshold    float64 `yaml:"reuse_threshold"`
+	MaxPenalty        float64 `yaml:"max_penalty"`
+}
+
+// Stats is the churn_stats message, the churn of a router's peer, RIB and
+// address family over the last report interval.
+type Stats struct {
+	RouterHash string  `json:"router_hash,omitempty"`
+	RouterIP   string  `json:"router_ip,omitempty"`
+	PeerIP     string  `json:"peer_ip,omitempty"`
+	PeerASN    uint32  `json:"peer_asn,omitempty"`
+	RIB        string  `json:"rib"`
+	AFI        string  `json:"afi"`
+	Timestamp  string  `json:"timestamp"`
+	Interval   float64 `json:"interval_secs"`
+	Updates    uint64  `json:"updates"`
+	Withdraws  uint64  `json:"withdraws"`
+	// UpdateRate and WithdrawRate are per second
+	UpdateRate   float64 `json:"update_rate"`
+	WithdrawRate float64 `json:"withdraw_rate"`
+	// FlappingPrefixes is the number of prefixes above the suppress threshold
+	FlappingPrefixes int `json:"flapping_prefixes"`
+}
+
+// FlapEvent is the flap_event message, published when a prefix penalty
+// reaches the suppress threshold and when it decays below the reuse threshold.
+type FlapEvent struct {
+	// Action is "suppress" or "reuse"
+	Action     string  `json:"action"`
+	RouterHash string  `json:"router_hash,omitempty"`
+	RouterIP   string  `json:"router_ip,omitempty"`
+	PeerIP     string  `json:"peer_ip,omitempty"`
+	PeerASN    uint32  `json:"peer_asn,omitempty"`
+	RIB        string  `json:"rib"`
+	AFI        string  `json:"afi"`
+	VPNRD      string  `json:"vpn_rd,omitempty"`
+	Prefix     string  `json:"prefix"`
+	PrefixLen  int32   `json:"prefix_len"`
+	PathID     int32   `json:"path_id,omitempty"`
+	Penalty    float64 `json:"penalty"`
+	Flaps      int     `json:"flaps"`
+	Timestamp  string  `json:"timestamp"`
+}
+
+type statsKey struct {
+	routerIP string
+	peerIP   string
+	rib      string
+	afi      string
+}
+
+type prefixKey struct {
+	statsKey
+	rd        string
+	prefix    string
+	prefixLen int32
+	pathID    int32
+}
+
+type counter struct {
+	routerHash string
+	peerASN    uint32
+	updates    uint64
+	withdraws  uint64
+}
+
+// prefixState is the damping state of a prefix, a prefix is tracked from its
+// first withdrawal until its penalty has decayed.
+type prefixState struct {
+	penalty    float64
+	updated    time.Time
+	flaps      int
+	withdrawn  bool
+	suppressed bool
+	attrs      *bgp.BaseAttributes
+}
+
+// route is the part of a produced route message used by the analytics.
+type route struct {
+	key        prefixKey
+	routerHash string
+	peerASN    uint32
+	withdraw   bool
+	attrs      *bgp.BaseAttributes
+}
+
+// Analyzer computes the churn analytics from the produced messages, it
+// implements message.Observer.
+type Analyzer struct {
+	mu         sync.Mutex
+	cfg        Config
+	publisher  pub.Publisher
+	now        func() time.Time
+	lastReport time.Time
+	counters   map[statsKey]*counter
+	prefixes   map[prefixKey]*prefixState
+	stopCh     chan struct{}
+	doneCh     chan struct{}
+}
+
+var _ message.Observer = &Analyzer{}
+
+// New returns an Analyzer publishing its reports to publisher, the reports
+// are started by Start.
+func New(publisher pub.Publisher, cfg *Config) (*Analyzer, error) {
+	c := Config{}
+	if cfg != nil {
+		c = *cfg
+	}
+	if c.Interval == 0 {
+		c.Interval = DefaultInterval
+	}
+	if c.HalfLife == 0 {
+		c.HalfLife = DefaultHalfLife
+	}
+	if c.WithdrawPenalty == 0 {
+		c.WithdrawPenalty = DefaultWithdrawPenalty
+	}
+	if c.AttrChangePenalty == 0 {
+		c.AttrChangePenalty = DefaultAttrChangePenalty
+	}
+	if c.SuppressThreshold == 0 {
+		c.SuppressThreshold = DefaultSuppressThreshold
+	}
+	if c.ReuseThreshold == 0 {
+		c.ReuseThreshold = DefaultReuseThreshold
+	}
+	if c.MaxPenalty == 0 {
+		c.MaxPenalty = DefaultMaxPenalty
+	}
+	if c.Interval < 0 || c.HalfLife < 0 {
+		return nil, fmt.Errorf("churn interval %s and half life %s must be positive", c.Interval, c.HalfLife)
+	}
+	if c.WithdrawPenalty < 0 || c.AttrChangePenalty < 0 {
+		return nil, fmt.Errorf("churn penalties %v and %v can not be negative", c.WithdrawPenalty, c.AttrChangePenalty)
+	}
+	if c.ReuseThreshold >= c.SuppressThreshold || c.SuppressThreshold > c.MaxPenalty {
+		return nil, fmt.Errorf("churn thresholds must satisfy reuse %v < suppress %v <= max penalty %v", c.ReuseThreshold, c.SuppressThreshold, c.MaxPenalty)
+	}
+
+	return &Analyzer{
+		cfg:        c,
+		publisher:  publisher,
+		now:        time.Now,
+		lastReport: time.Now(),
+		counters:   make(map[statsKey]*counter),
+		prefixes:   make(map[prefixKey]*prefixState),
+	}, nil
+}
+
+// Start starts the periodic churn_stats reports.
+func (a *Analyzer) Start() {
+	a.stopCh = make(chan struct{})
+	a.doneCh = make(chan struct{})
+	go func() {
+		defer close(a.doneCh)
+		ticker := time.NewTicker(a.cfg.Interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				a.report()
+			case <-a.stopCh:
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops the periodic reports and publishes the churn of the last,
+// partial, interval.
+func (a *Analyzer) Stop() {
+	if a == nil || a.stopCh == nil {
+		return
+	}
+	close(a.stopCh)
+	<-a.doneCh
+	a.stopCh = nil
+	a.report()
+}
+
+// Observe accounts a produced message.
+func (a *Analyzer) Observe(msgType int, msg interface{}) {
+	var r *route
+	switch m := msg.(type) {
+	case *message.UnicastPrefix:
+		if m.IsEOR {
+			return
+		}
+		afi := "ipv6_unicast"
+		if m.IsIPv4 {
+			afi = "ipv4_unicast"
+		}
+		r = &route{
+			key: prefixKey{
+				statsKey:  statsKey{routerIP: m.RouterIP, peerIP: m.PeerIP, rib: rib(m.IsLocRIB, m.IsAdjRIBOut, m.IsAdjRIBInPost, m.IsAdjRIBOutPost), afi: afi},
+				prefix:    m.Prefix,
+				prefixLen: m.PrefixLen,
+				pathID:    m.PathID,
+			},
+			routerHash: m.RouterHash,
+			peerASN:    m.PeerASN,
+			withdraw:   m.Action == "del",
+			attrs:      m.BaseAttributes,
+		}
+	case *message.L3VPNPrefix:
+		if m.IsEOR {
+			return
+		}
+		afi := "ipv6_vpn"
+		if m.IsIPv4 {
+			afi = "ipv4_vpn"
+		}
+		r = &route{
+			key: prefixKey{
+				statsKey:  statsKey{routerIP: m.RouterIP, peerIP: m.PeerIP, rib: rib(m.IsLocRIB, m.IsAdjRIBOut, m.IsAdjRIBInPost, m.IsAdjRIBOutPost), afi: afi},
+				rd:        m.VPNRD,
+				prefix:    m.Prefix,
+				prefixLen: m.PrefixLen,
+				pathID:    m.PathID,
+			},
+			routerHash: m.RouterHash,
+			peerASN:    m.PeerASN,
+			withdraw:   m.Action == "del",
+			attrs:      m.BaseAttributes,
+		}
+	case *message.PeerStateChange:
+		if m.Action == "down" {
+			a.peerDown(m.RouterIP, m.RemoteIP)
+		}
+		return
+	default:
+		return
+	}
+	if e := a.route(r); e != nil {
+		a.publish(bmp.FlapEventMsg, []byte(e.RouterHash), e)
+	}
+}
+
+func rib(locRIB, adjRIBOut, adjRIBInPost, adjRIBOutPost bool) string {
+	switch {
+	case locRIB:
+		return "loc_rib"
+	case adjRIBOut && adjRIBOutPost:
+		return "adj_rib_out_post"
+	case adjRIBOut:
+		return "adj_rib_out_pre"
+	case adjRIBInPost:
+		return "adj_rib_in_post"
+	}
+	return "adj_rib_in_pre"
+}
+
+// route accounts a route update and returns the flap event it triggers, if any.
+func (a *Analyzer) route(r *route) *FlapEvent {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	now := a.now()
+	c, ok := a.counters[r.key.statsKey]
+	if !ok {
+		c = &counter{}
+		a.counters[r.key.statsKey] = c
+	}
+	c.routerHash = r.routerHash
+	c.peerASN = r.peerASN
+	ps, tracked := a.prefixes[r.key]
+	if r.withdraw {
+		c.withdraws++
+		if !tracked {
+			ps = &prefixState{updated: now}
+			a.prefixes[r.key] = ps
+		}
+		if ps.withdrawn {
+			return nil
+		}
+		ps.withdrawn = true
+		ps.attrs = nil
+		return a.penalize(r, ps, a.cfg.WithdrawPenalty, now)
+	}
+	c.updates++
+	if !tracked {
+		return nil
+	}
+	if ps.withdrawn {
+		// Re-advertisement after a withdrawal, the flap was already penalized
+		ps.withdrawn = false
+		ps.attrs = r.attrs
+		return nil
+	}
+	changed := false
+	if ps.attrs != nil && r.attrs != nil && ps.attrs != r.attrs {
+		changed, _ = ps.attrs.Equal(r.attrs)
+		changed = !changed
+	}
+	ps.attrs = r.attrs
+	if !changed {
+		return nil
+	}
+
+	return a.penalize(r, ps, a.cfg.AttrChangePenalty, now)
+}
+
+// decay applies the exponential decay of the penalty since its last update.
+func (a *Analyzer) decay(ps *prefixState, now time.Time) {
+	if dt := now.Sub(ps.updated); dt > 0 {
+		ps.penalty *= math.Exp2(-dt.Seconds() / a.cfg.HalfLife.Seconds())
+	}
+	ps.updated = now
+}
+
+func (a *Analyzer) penalize(r *route, ps *prefixState, penalty float64, now time.Time) *FlapEvent {
+	a.decay(ps, now)
+	ps.penalty = math.Min(ps.penalty+penalty, a.cfg.MaxPenalty)
+	ps.flaps++
+	if ps.suppressed || ps.penalty < a.cfg.SuppressThreshold {
+		return nil
+	}
+	ps.suppressed = true
+
+	return flapEvent("suppress", r.key, r.routerHash, r.peerASN, ps, now)
+}
+
+func flapEvent(action string, k prefixKey, routerHash string, peerASN uint32, ps *prefixState, now time.Time) *FlapEvent {
+	return &FlapEvent{
+		Action:     action,
+		RouterHash: routerHash,
+		RouterIP:   k.routerIP,
+		PeerIP:     k.peerIP,
+		PeerASN:    peerASN,
+		RIB:        k.rib,
+		AFI:        k.afi,
+		VPNRD:      k.rd,
+		Prefix:     k.prefix,
+		PrefixLen:  k.prefixLen,
+		PathID:     k.pathID,
+		Penalty:    math.Round(ps.penalty),
+		Flaps:      ps.flaps,
+		Timestamp:  now.UTC().Format(time.RFC3339Nano),
+	}
+}
+
+// peerDown drops the damping state of the peer's prefixes, the routes of a
+// peer going down are not flaps.
+func (a *Analyzer) peerDown(routerIP, peerIP string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for k := range a.prefixes {
+		if k.routerIP == routerIP && k.peerIP == peerIP {
+			delete(a.prefixes, k)
+		}
+	}
+}
+
+// report publishes the churn_stats of the ended interval and the reuse events
+// of the prefixes whose penalty decayed below the reuse threshold.
+func (a *Analyzer) report() {
+	a.mu.Lock()
+	now := a.now()
+	interval := now.Sub(a.lastReport).Seconds()
+	a.lastReport = now
+	flapping := make(map[statsKey]int)
+	// tracked are the counters holding the router hash and peer ASN of the
+	// future reuse events of their prefixes
+	tracked := make(map[statsKey]bool)
+	events := make([]*FlapEvent, 0)
+	for k, ps := range a.prefixes {
+		a.decay(ps, now)
+		if ps.suppressed && ps.penalty < a.cfg.ReuseThreshold {
+			ps.suppressed = false
+			c := a.counters[k.statsKey]
+			events = append(events, flapEvent("reuse", k, c.routerHash, c.peerASN, ps, now))
+		}
+		if ps.suppressed {
+			flapping[k.statsKey]++
+		}
+		if !ps.suppressed && ps.penalty < 1 {
+			delete(a.prefixes, k)
+			continue
+		}
+		tracked[k.statsKey] = true
+	}
+	stats := make([]*Stats, 0, len(a.counters))
+	for k, c := range a.counters {
+		if c.updates == 0 && c.withdraws == 0 && flapping[k] == 0 {
+			if !tracked[k] {
+				delete(a.counters, k)
+			}
+			continue
+		}
+		s := &Stats{
+			RouterHash:       c.routerHash,
+			RouterIP:         k.routerIP,
+			PeerIP:           k.peerIP,
+			PeerASN:          c.peerASN,
+			RIB:              k.rib,
+			AFI:              k.afi,
+			Timestamp:        now.UTC().Format(time.RFC3339Nano),
+			Interval:         interval,
+			Updates:          c.updates,
+			Withdraws:        c.withdraws,
+			FlappingPrefixes: flapping[k],
+		}
+		if interval > 0 {
+			s.UpdateRate = float64(c.updates) / interval
+			s.WithdrawRate = float64(c.withdraws) / interval
+		}
+		c.updates, c.withdraws = 0, 0
+		stats = append(stats, s)
+	}
+	a.mu.Unlock()
+
+	for _, e := range events {
+		a.publish(bmp.FlapEventMsg, []byte(e.RouterHash), e)
+	}
+	for _, s := range stats {
+		a.publish(bmp.ChurnStatsMsg, []byte(s.RouterHash), s)
+	}
+}
+
+func (a *Analyzer) publish(msgType int, key []byte, msg interface{}) {
+	if a.publisher == nil {
+		return
+	}
+	b, err := json.Marshal(msg)
+	if err != nil {
+		glog.Errorf("failed to marshal churn message with error: %+v", err)
+		return
+	}
+	if err := a.publisher.PublishMessage(msgType, key, b); err != nil {
+		glog.Errorf("failed to publish churn message with error: %+v", err)
+	}
+}
@@ -0,0 +1,230 @@
+package churn
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/sbezverk/gobmp/pkg/bgp"
+	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/message"
+)
+
+type capture struct {
+	stats  []*Stats
+	events []*FlapEvent
+}
+
+func (c *capture) PublishMessage(msgType int, msgHash []byte, msg []byte) error {
+	switch msgType {
+	case bmp.ChurnStatsMsg:
+		s := &Stats{}
+		if err := json.Unmarshal(msg, s); err != nil {
+			return err
+		}
+		c.stats = append(c.stats, s)
+	case bmp.FlapEventMsg:
+		e := &FlapEvent{}
+		if err := json.Unmarshal(msg, e); err != nil {
+			return err
+		}
+		c.events = append(c.events, e)
+	}
+	return nil
+}
+
+func (c *capture) Stop() {}
+
+type clock struct {
+	t time.Time
+}
+
+func (c *clock) now() time.Time { return c.t }
+
+func newAnalyzer(t *testing.T, cfg *Config) (*Analyzer, *capture, *clock) {
+	t.Helper()
+	c := &capture{}
+	a, err := New(c, cfg)
+	if err != nil {
+		t.Fatalf("New() error: %v", err)
+	}
+	clk := &clock{t: time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)}
+	a.now = clk.now
+	a.lastReport = clk.t
+	return a, c, clk
+}
+
+func unicast(action string, med uint32) *message.UnicastPrefix {
+	return &message.UnicastPrefix{
+		Action:         action,
+		RouterHash:     "r1",
+		RouterIP:       "10.0.0.1",
+		PeerIP:         "192.168.0.1",
+		PeerASN:        65001,
+		Prefix:         "10.1.0.0",
+		PrefixLen:      16,
+		IsIPv4:         true,
+		BaseAttributes: &bgp.BaseAttributes{MED: med},
+	}
+}
+
+func TestNewConfigValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     *Config
+		wantErr bool
+	}{
+		{name: "defaults", cfg: nil},
+		{name: "custom thresholds", cfg: &Config{SuppressThreshold: 3000, ReuseThreshold: 1000}},
+		{name: "reuse above suppress", cfg: &Config{SuppressThreshold: 1000, ReuseThreshold: 1500}, wantErr: true},
+		{name: "suppress above max penalty", cfg: &Config{SuppressThreshold: 20000}, wantErr: true},
+		{name: "negative half life", cfg: &Config{HalfLife: -time.Minute}, wantErr: true},
+		{name: "negative penalty", cfg: &Config{WithdrawPenalty: -1}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := New(nil, tt.cfg); (err != nil) != tt.wantErr {
+				t.Fatalf("New() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestFlapSuppressAndReuse(t *testing.T) {
+	a, c, clk := newAnalyzer(t, nil)
+	// Withdraw 1000, re-advertise, withdraw 1000 decayed by 1 minute, the
+	// attribute change of the third advertisement crosses the 2000 threshold.
+	a.Observe(bmp.UnicastPrefixV4Msg, unicast("add", 10))
+	a.Observe(bmp.UnicastPrefixV4Msg, unicast("del", 0))
+	a.Observe(bmp.UnicastPrefixV4Msg, unicast("del", 0))
+	a.Observe(bmp.UnicastPrefixV4Msg, unicast("add", 10))
+	clk.t = clk.t.Add(time.Minute)
+	a.Observe(bmp.UnicastPrefixV4Msg, unicast("del", 0))
+	if len(c.events) != 0 {
+		t.Fatalf("unexpected flap events %+v", c.events)
+	}
+	a.Observe(bmp.UnicastPrefixV4Msg, unicast("add", 10))
+	a.Observe(bmp.UnicastPrefixV4Msg, unicast("add", 20))
+	if len(c.events) != 1 {
+		t.Fatalf("got %d flap events, want 1", len(c.events))
+	}
+	e := c.events[0]
+	if e.Action != "suppress" || e.Prefix != "10.1.0.0" || e.AFI != "ipv4_unicast" || e.RIB != "adj_rib_in_pre" || e.Flaps != 3 || e.PeerASN != 65001 {
+		t.Fatalf("suppress event = %+v", e)
+	}
+
+	a.report()
+	if len(c.stats) != 1 {
+		t.Fatalf("got %d churn stats, want 1", len(c.stats))
+	}
+	s := c.stats[0]
+	if s.Updates != 4 || s.Withdraws != 3 || s.FlappingPrefixes != 1 || s.Interval != 60 || s.WithdrawRate != 0.05 {
+		t.Fatalf("churn stats = %+v", s)
+	}
+
+	// 2 half lives later the penalty decayed below the reuse threshold
+	clk.t = clk.t.Add(30 * time.Minute)
+	a.report()
+	if len(c.events) != 2 || c.events[1].Action != "reuse" || c.events[1].Prefix != "10.1.0.0" {
+		t.Fatalf("flap events = %+v, want reuse", c.events)
+	}
+	if len(c.stats) != 1 {
+		t.Fatalf("idle peer reported churn stats %+v", c.stats[1:])
+	}
+}
+
+func TestPeerDownIsNotFlap(t *testing.T) {
+	a, c, _ := newAnalyzer(t, &Config{WithdrawPenalty: 1500})
+	a.Observe(bmp.UnicastPrefixV4Msg, unicast("del", 0))
+	a.Observe(bmp.PeerStateChangeMsg, &message.PeerStateChange{Action: "down", RouterIP: "10.0.0.1", RemoteIP: "192.168.0.1"})
+	a.Observe(bmp.UnicastPrefixV4Msg, unicast("add", 0))
+	a.Observe(bmp.UnicastPrefixV4Msg, unicast("del", 0))
+	if len(c.events) != 0 {
+		t.Fatalf("unexpected flap events %+v", c.events)
+	}
+	if len(a.prefixes) != 1 {
+		t.Fatalf("tracked %d prefixes, want 1", len(a.prefixes))
+	}
+}
+
+func TestStatsPerRIBAndAFI(t *testing.T) {
+	a, c, clk := newAnalyzer(t, nil)
+	post := unicast("add", 0)
+	post.IsAdjRIBInPost = true
+	a.Observe(bmp.UnicastPrefixV4Msg, unicast("add", 0))
+	a.Observe(bmp.UnicastPrefixV4Msg, post)
+	a.Observe(bmp.L3VPNV6Msg, &message.L3VPNPrefix{Action: "del", RouterIP: "10.0.0.1", PeerIP: "192.168.0.1", VPNRD: "65000:1", Prefix: "2001:db8::", PrefixLen: 64})
+	a.Observe(bmp.L3VPNV6Msg, &message.L3VPNPrefix{Action: "del", IsEOR: true, RouterIP: "10.0.0.1", PeerIP: "192.168.0.1"})
+	clk.t = clk.t.Add(10 * time.Second)
+	a.report()
+	got := make(map[string]*Stats)
+	for _, s := range c.stats {
+		got[s.RIB+"/"+s.AFI] = s
+	}
+	if len(got) != 3 {
+		t.Fatalf("churn stats = %+v", c.stats)
+	}
+	if s := got["adj_rib_in_post/ipv4_unicast"]; s == nil || s.Updates != 1 || s.UpdateRate != 0.1 {
+		t.Errorf("post policy stats = %+v", s)
+	}
+	if s := got["adj_rib_in_pre/ipv6_vpn"]; s == nil || s.Withdraws != 1 || s.Updates != 0 {
+		t.Errorf("vpn stats = %+v", s)
+	}
+}
+
+func TestObserveInPeerOrder(t *testing.T) {
+	a, _, _ := newAnalyzer(t, &Config{})
+	p := message.NewProducer(&capture{}, false)
+	if err := p.SetConfig(&message.Config{Observers: []message.Observer{a}}); err != nil {
+		t.Fatalf("SetConfig() error: %v", err)
+	}
+	queue, stop := make(chan bmp.Message), make(chan struct{})
+	defer close(stop)
+	go p.Producer(queue, stop)
+
+	hdr := make([]byte, 42)
+	copy(hdr[22:], []byte{192, 168, 0, 1, 0, 0, 0xfd, 0xe9, 192, 168, 0, 1})
+	ph, err := bmp.UnmarshalPerPeerHeader(hdr)
+	if err != nil {
+		t.Fatalf("UnmarshalPerPeerHeader() error: %v", err)
+	}
+	queue <- bmp.Message{PeerHeader: ph, Payload: &bmp.PeerUpMessage{
+		LocalAddress: append(make([]byte, 12), 10, 0, 0, 1),
+		SentOpen:     &bgp.OpenMessage{Version: 4, MyAS: 65000, HoldTime: 90, BGPID: []byte{10, 0, 0, 1}},
+		ReceivedOpen: &bgp.OpenMessage{Version: 4, MyAS: 65001, HoldTime: 90, BGPID: []byte{192, 168, 0, 1}},
+	}}
+	// Every prefix is announced, withdrawn and announced again
+	const prefixes = 500
+	for i := 0; i < prefixes; i++ {
+		nlri := []byte{0x18, 0x0a, byte(i >> 8), byte(i)}
+		announce := &bmp.RouteMonitor{Update: &bgp.Update{NLRI: nlri, BaseAttributes: &bgp.BaseAttributes{}}}
+		withdraw := &bmp.RouteMonitor{Update: &bgp.Update{WithdrawnRoutesLength: 4, WithdrawnRoutes: nlri, BaseAttributes: &bgp.BaseAttributes{}}}
+		for _, rm := range []*bmp.RouteMonitor{announce, withdraw, announce} {
+			queue <- bmp.Message{PeerHeader: ph, Payload: rm}
+		}
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		a.mu.Lock()
+		var updates, withdraws uint64
+		for _, c := range a.counters {
+			updates += c.updates
+			withdraws += c.withdraws
+		}
+		if updates == 2*prefixes && withdraws == prefixes {
+			for k, s := range a.prefixes {
+				if s.withdrawn {
+					t.Errorf("prefix %s/%d is withdrawn after its re-announcement", k.prefix, k.prefixLen)
+				}
+			}
+			a.mu.Unlock()
+			return
+		}
+		a.mu.Unlock()
+		if time.Now().After(deadline) {
+			t.Fatalf("observed %d updates and %d withdraws, want %d and %d", updates, withdraws, 2*prefixes, prefixes)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
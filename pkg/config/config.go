@@ -7,8 +7,18 @@ import (
 	"net"
 	"os"
 	"strconv"
+	"time"
 
+	"github.com/sbezverk/gobmp/pkg/avro"
+	"github.com/sbezverk/gobmp/pkg/bgp"
+	"github.com/sbezverk/gobmp/pkg/churn"
+	"github.com/sbezverk/gobmp/pkg/geoip"
+	"github.com/sbezverk/gobmp/pkg/inventory"
+	"github.com/sbezverk/gobmp/pkg/message"
+	"github.com/sbezverk/gobmp/pkg/profile"
 	"github.com/sbezverk/gobmp/pkg/pub"
+	"github.com/sbezverk/gobmp/pkg/rules"
+	"github.com/sbezverk/gobmp/pkg/vrf"
 	"gopkg.in/yaml.v3"
 )
 
@@ -25,6 +35,7 @@ const (
 	PublisherTypeDump                         // 1
 	PublisherTypeNATS                         // 2
 	PublisherTypeKafka                        // 3
+	PublisherTypeGRPC                         // 4
 )
 
 func (pt PublisherType) String() string {
@@ -35,6 +46,8 @@ func (pt PublisherType) String() string {
 		return "NATS"
 	case PublisherTypeKafka:
 		return "Kafka"
+	case PublisherTypeGRPC:
+		return "gRPC"
 	default:
 		return "Unknown"
 	}
@@ -52,14 +65,140 @@ type KafkaConfig struct {
 	KafkaSrv          string `yaml:"kafka_srv"`
 	KafkaTpRetnTimeMs int    `yaml:"kafka_tp_retn_time_ms"`
 	KafkaTopicPrefix  string `yaml:"kafka_topic_prefix"`
-	BmpRaw            bool   `yaml:"bmp_raw"`
-	AdminID           string `yaml:"admin_id"`
+	// BmpRaw enables the RAW mode, it is kept for the configurations written
+	// before RawConfig which enables the RAW mode of every publisher.
+	BmpRaw bool `yaml:"bmp_raw"`
+	// AdminID names the collector of the RAW and OpenBMP parsed messages, of
+	// every publisher.
+	AdminID string `yaml:"admin_id"`
+	// SchemaRegistry is the URL of the Confluent compatible schema registry of
+	// the avro encoding, SubjectNameStrategy names the subjects of the topics
+	// and the schemas are registered unless AutoRegisterSchemas is false.
+	SchemaRegistry      string               `yaml:"schema_registry"`
+	SubjectNameStrategy avro.SubjectStrategy `yaml:"subject_name_strategy"`
+	AutoRegisterSchemas *bool                `yaml:"auto_register_schemas"`
+	// OpenBMPParsed publishes the peer, prefix, BGP-LS and statistics messages
+	// in the OpenBMP v1.7 format on the openbmp.parsed topics.
+	OpenBMPParsed bool `yaml:"openbmp_parsed"`
+}
+
+// GRPCConfig enables the gRPC subscription API listening on Address,
+// BufferSize is the number of messages buffered per subscriber before it is
+// disconnected.
+type GRPCConfig struct {
+	Address    string `yaml:"address"`
+	BufferSize int    `yaml:"buffer_size"`
+}
+
+// MRTConfig configures the MRT (RFC 6396) export of collected routes.
+type MRTConfig struct {
+	Dir              string        `yaml:"dir"`
+	RotationInterval time.Duration `yaml:"rotation_interval"`
+	RIBInterval      time.Duration `yaml:"rib_interval"`
+}
+
+// L3VPNVRFConfig enables the VRF attribution of L3VPN routes, VRFs are learned
+// from RFC 9069 Loc-RIB instances and optionally mapped from Route Targets
+// listed in File.
+type L3VPNVRFConfig struct {
+	Enabled bool   `yaml:"enabled"`
+	File    string `yaml:"file"`
+	Topics  bool   `yaml:"topics"`
+}
+
+// ChurnConfig enables the route churn and flap analytics, zero parameters
+// select the churn package defaults.
+type ChurnConfig struct {
+	Enabled      bool `yaml:"enabled"`
+	churn.Config `yaml:",inline"`
+}
+
+// RouteLeakConfig enables the RFC 9234 route leak checks of unicast routes,
+// Roles maps peer addresses to the BGP Role of the peer ("provider", "rs",
+// "rs-client", "customer" or "peer") and takes precedence over the roles
+// learned from the Peer Up OPEN messages.
+type RouteLeakConfig struct {
+	Enabled bool              `yaml:"enabled"`
+	Roles   map[string]string `yaml:"roles"`
+}
+
+// RawConfig enables the RAW mode: the BMP messages are published in the
+// OpenBMP binary format on the raw topic of the publisher, keyed by router hash
+// and in the order received from the router. With Parsed the messages are also
+// parsed and published as usual, RouterGroup is the router group of the
+// OpenBMP header.
+type RawConfig struct {
+	Enabled     bool   `yaml:"enabled"`
+	Parsed      bool   `yaml:"parsed"`
+	RouterGroup string `yaml:"router_group"`
+}
+
+// OutputProfileConfig selects the fields of the published parsed messages,
+// Name is "full" (default), "compact" or "custom" with the Fields per message
+// type name.
+type OutputProfileConfig struct {
+	Name   string                    `yaml:"name"`
+	Fields map[string]profile.Fields `yaml:"fields"`
+}
+
+// BaseAttributeConfig enables the base_attribute messages: each unique set of
+// base attributes of the Unicast and L3VPN prefixes is published once per
+// router, CacheSize is the number of recently published hashes remembered per
+// producer. With Normalized the prefix messages carry the base_attr_hash of
+// their base attributes instead of base_attrs.
+type BaseAttributeConfig struct {
+	Enabled    bool `yaml:"enabled"`
+	Normalized bool `yaml:"normalized"`
+	CacheSize  int  `yaml:"cache_size"`
+}
+
+// InventoryConfig enables the labels of the YAML or CSV inventory File, keyed
+// by router address, peer address, peer ASN or RD. The file is reloaded when
+// it changes, checked every ReloadInterval, 30s when zero, a negative interval
+// disables the reloads.
+type InventoryConfig struct {
+	File           string        `yaml:"file"`
+	ReloadInterval time.Duration `yaml:"reload_interval"`
+}
+
+// GeoIPConfig enables the enrichment of the Unicast prefix and the peer
+// messages with the AS names of the MaxMind format ASNDatabase and the prefix
+// countries of the CountryDatabase, either can be empty. CacheSize is the
+// number of prefix countries cached, 100000 when zero. The database files are
+// reloaded when they change, checked every ReloadInterval, 1m when zero, a
+// negative interval disables the reloads.
+type GeoIPConfig struct {
+	ASNDatabase     string        `yaml:"asn_database"`
+	CountryDatabase string        `yaml:"country_database"`
+	CacheSize       int           `yaml:"cache_size"`
+	ReloadInterval  time.Duration `yaml:"reload_interval"`
+}
+
+// MRTImportConfig selects the MRT import input mode: the listed files are
+// replayed through the producer instead of serving BMP sessions.
+type MRTImportConfig struct {
+	Files    []string `yaml:"files"`
+	RouterIP string   `yaml:"router_ip"`
 }
 
 type Config struct {
 	// Computed fields — not persisted to YAML.
 	Publisher     pub.Publisher `yaml:"-"`
 	PublisherType PublisherType `yaml:"-"` // always inferred, never stored in YAML
+	// Observers receive every message produced, see LSTopology.
+	Observers []message.Observer `yaml:"-"`
+	// VRFMap is built from L3VPNVRFConfig.
+	VRFMap *vrf.Map `yaml:"-"`
+	// BGPRoles is built from RouteLeakConfig.Roles.
+	BGPRoles map[string]bgp.BGPRole `yaml:"-"`
+	// RuleEngine is built from Rules and RulesFile.
+	RuleEngine *rules.Engine `yaml:"-"`
+	// Inventory is loaded from InventoryConfig.
+	Inventory *inventory.Inventory `yaml:"-"`
+	// GeoIP is loaded from GeoIPConfig.
+	GeoIP *geoip.Enricher `yaml:"-"`
+	// Profile is built from OutputProfile.
+	Profile *profile.Profile `yaml:"-"`
 	// Fields from config file
 	KafkaConfig     *KafkaConfig `yaml:"kafka_config"`
 	NATSConfig      *NATSConfig  `yaml:"nats_config"`
@@ -69,6 +208,52 @@ type Config struct {
 	PerformancePort int          `yaml:"performance_port"` // > 0 enables pprof collection
 	ActiveMode      bool         `yaml:"active_mode"`
 	SpeakersList    []string     `yaml:"speakers_list"`
+	// Encoding is the encoding of the parsed messages published to Kafka, NATS
+	// and the dump publisher, json (default), protobuf or avro (Kafka only).
+	Encoding pub.Encoding `yaml:"encoding"`
+	// StructuredExtCommunities adds the typed ext_communities and
+	// ipv6_ext_communities lists to the published base attributes.
+	StructuredExtCommunities bool `yaml:"structured_ext_communities"`
+	// LSTopology enables the in-collector BGP-LS topology and the publishing
+	// of topology change events.
+	LSTopology bool `yaml:"ls_topology"`
+	// L3VPNVRFConfig enables the vrf field and the per-VRF topics of L3VPN messages.
+	L3VPNVRFConfig *L3VPNVRFConfig `yaml:"l3vpn_vrf_config"`
+	// ChurnConfig enables the churn_stats and flap_event messages.
+	ChurnConfig *ChurnConfig `yaml:"churn_config"`
+	// HijackPrefixes is the owned-prefix list file enabling the hijack_event
+	// messages.
+	HijackPrefixes string `yaml:"hijack_prefixes"`
+	// RouteLeakConfig enables the leak_suspected field of unicast messages and
+	// the route_leak messages.
+	RouteLeakConfig *RouteLeakConfig `yaml:"route_leak_config"`
+	// RawConfig enables the RAW messages, alone or alongside the parsed
+	// messages.
+	RawConfig *RawConfig `yaml:"raw_config"`
+	// Rules are the publishing rules dropping, sampling, labelling or routing
+	// the parsed messages to sub-topics, evaluated before the rules of
+	// RulesFile.
+	Rules []rules.Rule `yaml:"rules"`
+	// RulesFile is a YAML file of publishing rules.
+	RulesFile string `yaml:"rules_file"`
+	// OutputProfile selects the fields of the published parsed messages.
+	OutputProfile *OutputProfileConfig `yaml:"output_profile"`
+	// BaseAttributeConfig enables the base_attribute messages and the
+	// normalized prefix messages.
+	BaseAttributeConfig *BaseAttributeConfig `yaml:"base_attribute_config"`
+	// InventoryConfig adds the inventory labels of the routers, peers and RDs
+	// to the user_labels of the parsed messages.
+	InventoryConfig *InventoryConfig `yaml:"inventory_config"`
+	// GeoIPConfig adds the AS names and the countries of the local GeoIP
+	// databases to the Unicast prefix and the peer messages.
+	GeoIPConfig *GeoIPConfig `yaml:"geoip_config"`
+	// GRPCConfig enables the gRPC subscription API, alone or alongside the
+	// Kafka, NATS or dump publisher.
+	GRPCConfig *GRPCConfig `yaml:"grpc_config"`
+	// MRTConfig enables the MRT writer when its Dir is set.
+	MRTConfig *MRTConfig `yaml:"mrt_config"`
+	// MRTImportConfig enables the MRT import input mode when Files is set.
+	MRTImportConfig *MRTImportConfig `yaml:"mrt_import_config"`
 }
 
 func LoadConfig(path string) (*Config, error) {
@@ -144,3 +329,45 @@ func ValidateSpeakersList(speakers []string) error {
 
 	return nil
 }
+
+// ParseBGPRoles validates the peer address to BGP Role mapping and returns it
+// keyed by the canonical form of the peer addresses.
+func ParseBGPRoles(roles map[string]string) (map[string]bgp.BGPRole, error) {
+	parsed := make(map[string]bgp.BGPRole, len(roles))
+	for addr, name := range roles {
+		ip := net.ParseIP(addr)
+		if ip == nil {
+			return nil, fmt.Errorf("invalid peer address %q: not a valid IP literal", addr)
+		}
+		role, err := bgp.ParseBGPRole(name)
+		if err != nil {
+			return nil, fmt.Errorf("invalid role of peer %s: %w", addr, err)
+		}
+		parsed[ip.String()] = role
+	}
+
+	return parsed, nil
+}
+
+// ProducerConfig returns the settings of the parsed messages of the producers,
+// shared by the BMP sessions and the MRT import. The RAW message settings are
+// left to the BMP server.
+func (c *Config) ProducerConfig() *message.Config {
+	pc := &message.Config{
+		StructuredExtCommunities: c.StructuredExtCommunities,
+		Observers:                c.Observers,
+		VRFMap:                   c.VRFMap,
+		VRFTopics:                c.L3VPNVRFConfig != nil && c.L3VPNVRFConfig.Topics,
+		RouteLeak:                c.RouteLeakConfig != nil && c.RouteLeakConfig.Enabled,
+		BGPRoles:                 c.BGPRoles,
+		Rules:                    c.RuleEngine,
+		Profile:                  c.Profile,
+		Inventory:                c.Inventory,
+		GeoIP:                    c.GeoIP,
+	}
+	if b := c.BaseAttributeConfig; b != nil {
+		pc.BaseAttributes, pc.BaseAttributeCacheSize, pc.NormalizedBaseAttributes = b.Enabled, b.CacheSize, b.Normalized
+	}
+
+	return pc
+}
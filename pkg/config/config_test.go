@@ -5,6 +5,9 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
+
+	"github.com/sbezverk/gobmp/pkg/bgp"
 )
 
 func writeTemp(t *testing.T, content string) string {
@@ -279,3 +282,166 @@ func TestValidateSpeakersList(t *testing.T) {
 		})
 	}
 }
+
+func TestLoadConfig_ChurnConfig(t *testing.T) {
+	yml := `
+churn_config:
+  enabled: true
+  interval: 30s
+  half_life: 5m
+  suppress_threshold: 3000
+  reuse_threshold: 1000
+`
+	cfg, err := LoadConfig(writeTemp(t, yml))
+	if err != nil {
+		t.Fatalf("LoadConfig() unexpected error: %v", err)
+	}
+	c := cfg.ChurnConfig
+	if c == nil || !c.Enabled {
+		t.Fatalf("ChurnConfig = %+v, want enabled", c)
+	}
+	if c.Interval != 30*time.Second || c.HalfLife != 5*time.Minute || c.SuppressThreshold != 3000 || c.ReuseThreshold != 1000 {
+		t.Errorf("ChurnConfig = %+v", c)
+	}
+}
+
+func TestLoadConfig_Rules(t *testing.T) {
+	yml := `
+rules:
+  - name: lab
+    match:
+      peer_asns: [65099]
+      prefixes: ["10.0.0.0/8 le 24"]
+    action: route
+    subtopic: lab
+  - action: sample
+    sample: 10
+rules_file: /etc/gobmp/rules.yaml
+`
+	cfg, err := LoadConfig(writeTemp(t, yml))
+	if err != nil {
+		t.Fatalf("LoadConfig() unexpected error: %v", err)
+	}
+	if len(cfg.Rules) != 2 || cfg.RulesFile != "/etc/gobmp/rules.yaml" {
+		t.Fatalf("Rules = %+v, RulesFile = %q", cfg.Rules, cfg.RulesFile)
+	}
+	r := cfg.Rules[0]
+	if r.Name != "lab" || r.Action != "route" || r.Subtopic != "lab" || len(r.Match.PeerASNs) != 1 || r.Match.Prefixes[0] != "10.0.0.0/8 le 24" {
+		t.Errorf("Rules[0] = %+v", r)
+	}
+	if cfg.Rules[1].Sample != 10 {
+		t.Errorf("Rules[1] = %+v", cfg.Rules[1])
+	}
+}
+
+func TestLoadConfig_OutputProfile(t *testing.T) {
+	yml := `
+output_profile:
+  name: custom
+  fields:
+    unicast_prefix:
+      include: [prefix, prefix_len, base_attrs]
+    peer:
+      exclude: [_key, _id, _rev]
+`
+	cfg, err := LoadConfig(writeTemp(t, yml))
+	if err != nil {
+		t.Fatalf("LoadConfig() unexpected error: %v", err)
+	}
+	c := cfg.OutputProfile
+	if c == nil || c.Name != "custom" || len(c.Fields) != 2 {
+		t.Fatalf("OutputProfile = %+v", c)
+	}
+	if len(c.Fields["unicast_prefix"].Include) != 3 || len(c.Fields["peer"].Exclude) != 3 {
+		t.Errorf("OutputProfile.Fields = %+v", c.Fields)
+	}
+}
+
+func TestLoadConfig_BaseAttributes(t *testing.T) {
+	yml := `
+base_attribute_config:
+  enabled: true
+  normalized: true
+  cache_size: 500000
+`
+	cfg, err := LoadConfig(writeTemp(t, yml))
+	if err != nil {
+		t.Fatalf("LoadConfig() unexpected error: %v", err)
+	}
+	want := BaseAttributeConfig{Enabled: true, Normalized: true, CacheSize: 500000}
+	if c := cfg.BaseAttributeConfig; c == nil || *c != want {
+		t.Errorf("BaseAttributeConfig = %+v, want %+v", c, want)
+	}
+}
+
+func TestLoadConfig_Inventory(t *testing.T) {
+	yml := `
+inventory_config:
+  file: /etc/gobmp/inventory.csv
+  reload_interval: 1m
+`
+	cfg, err := LoadConfig(writeTemp(t, yml))
+	if err != nil {
+		t.Fatalf("LoadConfig() unexpected error: %v", err)
+	}
+	want := InventoryConfig{File: "/etc/gobmp/inventory.csv", ReloadInterval: time.Minute}
+	if c := cfg.InventoryConfig; c == nil || *c != want {
+		t.Errorf("InventoryConfig = %+v, want %+v", c, want)
+	}
+}
+
+func TestLoadConfig_GeoIP(t *testing.T) {
+	yml := `
+geoip_config:
+  asn_database: /var/lib/gobmp/GeoLite2-ASN.mmdb
+  country_database: /var/lib/gobmp/GeoLite2-Country.mmdb
+  cache_size: 500000
+  reload_interval: 1h
+`
+	cfg, err := LoadConfig(writeTemp(t, yml))
+	if err != nil {
+		t.Fatalf("LoadConfig() unexpected error: %v", err)
+	}
+	want := GeoIPConfig{
+		ASNDatabase:     "/var/lib/gobmp/GeoLite2-ASN.mmdb",
+		CountryDatabase: "/var/lib/gobmp/GeoLite2-Country.mmdb",
+		CacheSize:       500000,
+		ReloadInterval:  time.Hour,
+	}
+	if c := cfg.GeoIPConfig; c == nil || *c != want {
+		t.Errorf("GeoIPConfig = %+v, want %+v", c, want)
+	}
+}
+
+func TestParseBGPRoles(t *testing.T) {
+	yml := `
+route_leak_config:
+  enabled: true
+  roles:
+    192.0.2.1: customer
+    "2001:db8:0::1": RS-Client
+`
+	cfg, err := LoadConfig(writeTemp(t, yml))
+	if err != nil {
+		t.Fatalf("LoadConfig() unexpected error: %v", err)
+	}
+	if cfg.RouteLeakConfig == nil || !cfg.RouteLeakConfig.Enabled {
+		t.Fatalf("RouteLeakConfig = %+v, want enabled", cfg.RouteLeakConfig)
+	}
+	roles, err := ParseBGPRoles(cfg.RouteLeakConfig.Roles)
+	if err != nil {
+		t.Fatalf("ParseBGPRoles() unexpected error: %v", err)
+	}
+	if len(roles) != 2 || roles["192.0.2.1"] != bgp.BGPRoleCustomer || roles["2001:db8::1"] != bgp.BGPRoleRSClient {
+		t.Errorf("ParseBGPRoles() = %v", roles)
+	}
+
+	for _, invalid := range []map[string]string{
+		{"peer1": "customer"},
+		{"192.0.2.1": "transit"},
+	} {
+		if _, err := ParseBGPRoles(invalid); err == nil {
+			t.Errorf("ParseBGPRoles(%v) expected error", invalid)
+		}
+	}
+}
@@ -7,6 +7,8 @@ import (
 	"os"
 
 	"github.com/golang/glog"
+	"github.com/sbezverk/gobmp/pkg/api"
+	"github.com/sbezverk/gobmp/pkg/bmp"
 	"github.com/sbezverk/gobmp/pkg/pub"
 )
 
@@ -14,24 +16,63 @@ type msgOut struct {
 	MsgType int             `json:"msg_type"`
 	MsgHash string          `json:"msg_hash,omitempty"`
 	Msg     json.RawMessage `json:"msg_data,omitempty"`
+	// MsgProto carries the message in the protobuf encoding
+	MsgProto []byte `json:"msg_proto,omitempty"`
+	// MsgRaw carries the RAW messages, in the OpenBMP binary format
+	MsgRaw []byte `json:"msg_raw,omitempty"`
 }
 
 type pubwriter struct {
-	output *log.Logger
+	output   *log.Logger
+	encoding pub.Encoding
 }
 
 func (p *pubwriter) PublishMessage(msgType int, msgHash []byte, msg []byte) error {
-	if !json.Valid(msg) {
+	if msgType != bmp.BMPRawMsg && !json.Valid(msg) {
 		return fmt.Errorf("failed to publish message of type %d, hash: %s, invalid JSON detected in message data", msgType, string(msgHash))
 	}
 	m := msgOut{
 		MsgType: msgType,
 		MsgHash: string(msgHash),
-		Msg:     json.RawMessage(msg),
 	}
+	switch {
+	case msgType == bmp.BMPRawMsg:
+		m.MsgRaw = msg
+	case p.encoding == pub.EncodingProtobuf:
+		b, err := api.Encode(p.encoding, msgType, msg)
+		if err != nil {
+			return fmt.Errorf("failed to encode message of type %d, hash %s: %w", msgType, string(msgHash), err)
+		}
+		m.MsgProto = b
+	default:
+		m.Msg = json.RawMessage(msg)
+	}
+
+	return p.dump(&m)
+}
+
+// PublishStruct dumps a parsed message, the protobuf encoding is built from the
+// message struct. The dumper has no sub-topics.
+func (p *pubwriter) PublishStruct(msgType int, _ string, msgHash []byte, msg interface{}) error {
+	if p.encoding != pub.EncodingProtobuf {
+		b, err := json.Marshal(msg)
+		if err != nil {
+			return fmt.Errorf("failed to marshal message of type %d, hash %s: %w", msgType, string(msgHash), err)
+		}
+		return p.PublishMessage(msgType, msgHash, b)
+	}
+	b, err := api.Marshal(msgType, msg)
+	if err != nil {
+		return fmt.Errorf("failed to encode message of type %d, hash %s: %w", msgType, string(msgHash), err)
+	}
+
+	return p.dump(&msgOut{MsgType: msgType, MsgHash: string(msgHash), MsgProto: b})
+}
+
+func (p *pubwriter) dump(m *msgOut) error {
 	b, err := json.Marshal(m)
 	if err != nil {
-		return fmt.Errorf("failed to marshal message for publishing: type %d, hash %s: %w", msgType, string(msgHash), err)
+		return fmt.Errorf("failed to marshal message for publishing: type %d, hash %s: %w", m.MsgType, m.MsgHash, err)
 	}
 	p.output.Println(string(b))
 
@@ -42,10 +83,12 @@ func (p *pubwriter) Stop() {
 	glog.Info("gobmp's Dump Publisher is stopping...")
 }
 
-// NewDumper returns a new instance of standard out dumper.
-func NewDumper() pub.Publisher {
+// NewDumper returns a new instance of standard out dumper, the messages are
+// dumped in the encoding enc.
+func NewDumper(enc pub.Encoding) pub.Publisher {
 	pw := pubwriter{
-		output: log.New(os.Stdout, "", 0),
+		output:   log.New(os.Stdout, "", 0),
+		encoding: enc,
 	}
 
 	return &pw
@@ -6,6 +6,11 @@ import (
 	"log"
 	"reflect"
 	"testing"
+
+	"github.com/sbezverk/gobmp/pkg/api"
+	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/pub"
+	"google.golang.org/protobuf/proto"
 )
 
 func TestMessageAsValidJSON(t *testing.T) {
@@ -85,3 +90,51 @@ func TestMessageAsValidJSON(t *testing.T) {
 	}
 
 }
+
+func TestMessageAsProtobuf(t *testing.T) {
+	buff := new(bytes.Buffer)
+	pw := pubwriter{
+		output:   log.New(buff, "", 0),
+		encoding: pub.EncodingProtobuf,
+	}
+	msg := []byte(`{"action":"add","router_ip":"10.0.0.1","remote_asn":65000}`)
+	if err := pw.PublishMessage(bmp.PeerStateChangeMsg, []byte("hash1"), msg); err != nil {
+		t.Fatalf("PublishMessage() error = %v", err)
+	}
+	var got msgOut
+	if err := json.Unmarshal(buff.Bytes(), &got); err != nil {
+		t.Fatalf("Failed to unmarshal msgOut: %v", err)
+	}
+	if got.Msg != nil {
+		t.Errorf("Msg = %s, want none", string(got.Msg))
+	}
+	p := &api.PeerStateChange{}
+	if err := proto.Unmarshal(got.MsgProto, p); err != nil {
+		t.Fatalf("Failed to unmarshal protobuf message: %v", err)
+	}
+	if p.GetRouterIp() != "10.0.0.1" || p.GetRemoteAsn() != 65000 {
+		t.Errorf("MsgProto mismatch: got %v", p)
+	}
+	if err := pw.PublishMessage(9999, []byte("hash2"), msg); err == nil {
+		t.Error("PublishMessage() expected error for a message type without a protobuf definition")
+	}
+}
+
+func TestMessageRaw(t *testing.T) {
+	buff := new(bytes.Buffer)
+	pw := pubwriter{
+		output:   log.New(buff, "", 0),
+		encoding: pub.EncodingProtobuf,
+	}
+	raw := []byte{0x4f, 0x42, 0x4d, 0x50, 0x01, 0x07}
+	if err := pw.PublishMessage(bmp.BMPRawMsg, []byte("routerhash"), raw); err != nil {
+		t.Fatalf("PublishMessage() error = %v", err)
+	}
+	var got msgOut
+	if err := json.Unmarshal(buff.Bytes(), &got); err != nil {
+		t.Fatalf("Failed to unmarshal msgOut: %v", err)
+	}
+	if got.MsgHash != "routerhash" || !bytes.Equal(got.MsgRaw, raw) || got.Msg != nil || got.MsgProto != nil {
+		t.Errorf("msgOut = %+v", got)
+	}
+}
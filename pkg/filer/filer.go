@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"os"
 
+	"github.com/sbezverk/gobmp/pkg/api"
+	"github.com/sbezverk/gobmp/pkg/bmp"
 	"github.com/sbezverk/gobmp/pkg/pub"
 )
 
@@ -13,19 +15,58 @@ type MsgOut struct {
 	MsgType int             `json:"msg_type,omitempty"`
 	MsgHash string          `json:"msg_hash,omitempty"`
 	Msg     json.RawMessage `json:"msg_data,omitempty"`
+	// MsgProto carries the message in the protobuf encoding
+	MsgProto []byte `json:"msg_proto,omitempty"`
+	// MsgRaw carries the RAW messages, in the OpenBMP binary format
+	MsgRaw []byte `json:"msg_raw,omitempty"`
 }
 
 type pubfiler struct {
-	file *os.File
+	file     *os.File
+	encoding pub.Encoding
 }
 
 func (p *pubfiler) PublishMessage(msgType int, msgHash []byte, msg []byte) error {
 	m := MsgOut{
 		MsgType: msgType,
 		MsgHash: string(msgHash),
-		Msg:     json.RawMessage(msg),
 	}
-	b, err := json.Marshal(&m)
+	switch {
+	case msgType == bmp.BMPRawMsg:
+		m.MsgRaw = msg
+	case p.encoding == pub.EncodingProtobuf:
+		b, err := api.Encode(p.encoding, msgType, msg)
+		if err != nil {
+			return err
+		}
+		m.MsgProto = b
+	default:
+		m.Msg = json.RawMessage(msg)
+	}
+
+	return p.write(&m)
+}
+
+// PublishStruct stores a parsed message, the protobuf encoding is built from
+// the message struct. The filer has no sub-topics.
+func (p *pubfiler) PublishStruct(msgType int, _ string, msgHash []byte, msg interface{}) error {
+	if p.encoding != pub.EncodingProtobuf {
+		b, err := json.Marshal(msg)
+		if err != nil {
+			return err
+		}
+		return p.PublishMessage(msgType, msgHash, b)
+	}
+	b, err := api.Marshal(msgType, msg)
+	if err != nil {
+		return err
+	}
+
+	return p.write(&MsgOut{MsgType: msgType, MsgHash: string(msgHash), MsgProto: b})
+}
+
+func (p *pubfiler) write(m *MsgOut) error {
+	b, err := json.Marshal(m)
 	if err != nil {
 		return err
 	}
@@ -42,14 +83,16 @@ func (p *pubfiler) Stop() {
 	_ = p.file.Close()
 }
 
-// NewFiler returns a new instance of message filer.
-func NewFiler(file string) (pub.Publisher, error) {
+// NewFiler returns a new instance of message filer, the messages are stored in
+// the encoding enc.
+func NewFiler(file string, enc pub.Encoding) (pub.Publisher, error) {
 	f, err := os.Create(file)
 	if err != nil {
 		return nil, err
 	}
 	pw := pubfiler{
-		file: f,
+		file:     f,
+		encoding: enc,
 	}
 
 	return &pw, nil
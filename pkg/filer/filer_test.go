@@ -5,6 +5,11 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+
+	"github.com/sbezverk/gobmp/pkg/api"
+	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/pub"
+	"google.golang.org/protobuf/proto"
 )
 
 // TestMsgOutJSONEncoding verifies that MsgOut with json.RawMessage produces valid JSON.
@@ -101,7 +106,7 @@ func TestPublishMessageValidJSON(t *testing.T) {
 	tmpFile := filepath.Join(tmpDir, "test.json")
 
 	// Create filer
-	pub, err := NewFiler(tmpFile)
+	pub, err := NewFiler(tmpFile, pub.EncodingJSON)
 	if err != nil {
 		t.Fatalf("NewFiler failed: %v", err)
 	}
@@ -165,7 +170,7 @@ func TestPublishMessageMultiple(t *testing.T) {
 	tmpFile := filepath.Join(tmpDir, "test-multiple.json")
 
 	// Create filer
-	pub, err := NewFiler(tmpFile)
+	pub, err := NewFiler(tmpFile, pub.EncodingJSON)
 	if err != nil {
 		t.Fatalf("NewFiler failed: %v", err)
 	}
@@ -231,6 +236,69 @@ func TestPublishMessageMultiple(t *testing.T) {
 	}
 }
 
+// TestPublishMessageProtobuf verifies that the messages are stored in msg_proto
+// in the protobuf encoding.
+func TestPublishMessageProtobuf(t *testing.T) {
+	tmpFile := filepath.Join(t.TempDir(), "test.json")
+	p, err := NewFiler(tmpFile, pub.EncodingProtobuf)
+	if err != nil {
+		t.Fatalf("NewFiler failed: %v", err)
+	}
+	msgData := []byte(`{"action":"add","prefix":"192.168.0.0","prefix_len":16,"peer_ip":"10.0.0.1"}`)
+	if err := p.PublishMessage(bmp.UnicastPrefixV4Msg, []byte("testhash"), msgData); err != nil {
+		t.Fatalf("PublishMessage failed: %v", err)
+	}
+	if err := p.PublishMessage(bmp.UnicastPrefixV4Msg, []byte("testhash"), []byte(`{"prefix":16}`)); err == nil {
+		t.Error("PublishMessage expected an error for a message not matching its protobuf definition")
+	}
+	p.Stop()
+
+	content, err := os.ReadFile(tmpFile)
+	if err != nil {
+		t.Fatalf("ReadFile failed: %v", err)
+	}
+	var m MsgOut
+	if err := json.Unmarshal(content, &m); err != nil {
+		t.Fatalf("Output is not valid JSON: %v\nContent: %s", err, string(content))
+	}
+	if m.Msg != nil {
+		t.Errorf("msg_data = %s, want none", string(m.Msg))
+	}
+	u := &api.UnicastPrefix{}
+	if err := proto.Unmarshal(m.MsgProto, u); err != nil {
+		t.Fatalf("msg_proto is not a protobuf message: %v", err)
+	}
+	if u.GetPrefix() != "192.168.0.0" || u.GetPrefixLen() != 16 || u.GetPeerIp() != "10.0.0.1" {
+		t.Errorf("msg_proto = %v", u)
+	}
+}
+
+// TestPublishMessageRaw verifies that RAW messages are stored in msg_raw.
+func TestPublishMessageRaw(t *testing.T) {
+	tmpFile := filepath.Join(t.TempDir(), "test.json")
+	p, err := NewFiler(tmpFile, pub.EncodingJSON)
+	if err != nil {
+		t.Fatalf("NewFiler failed: %v", err)
+	}
+	raw := []byte{0x4f, 0x42, 0x4d, 0x50, 0x01, 0x07}
+	if err := p.PublishMessage(bmp.BMPRawMsg, []byte("routerhash"), raw); err != nil {
+		t.Fatalf("PublishMessage failed: %v", err)
+	}
+	p.Stop()
+
+	content, err := os.ReadFile(tmpFile)
+	if err != nil {
+		t.Fatalf("ReadFile failed: %v", err)
+	}
+	var m MsgOut
+	if err := json.Unmarshal(content, &m); err != nil {
+		t.Fatalf("Output is not valid JSON: %v\nContent: %s", err, string(content))
+	}
+	if m.MsgType != bmp.BMPRawMsg || m.MsgHash != "routerhash" || string(m.MsgRaw) != string(raw) || m.Msg != nil {
+		t.Errorf("MsgOut = %+v", m)
+	}
+}
+
 // TestNoDoubleEscaping verifies that json.RawMessage prevents double-escaping
 func TestNoDoubleEscaping(t *testing.T) {
 	m := MsgOut{
@@ -0,0 +1,356 @@
+// Package geoip enriches the parsed messages with the AS names and the
+// countries of local MaxMind format databases, such as GeoLite2-ASN,
+// GeoLite2-Country or the ipinfo ASN and country databases. The databases are
+// read from files on the collector host, no lookup leaves the process, and
+// reloaded when the files change.
+package geoip
+
+import (
+	"container/list"
+	"fmt"
+	"net/netip"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/sbezverk/gobmp/pkg/mmdb"
+)
+
+const (
+	// DefaultCacheSize is the number of prefix countries cached when no cache
+	// size is configured.
+	DefaultCacheSize = 100000
+	// DefaultReloadInterval is the period the database files are checked for
+	// changes when no interval is configured.
+	DefaultReloadInterval = time.Minute
+)
+
+// AS is the name and the organisation of an autonomous system.
+type AS struct {
+	Name string
+	Org  string
+}
+
+// database is a database file and the version of it last loaded.
+type database struct {
+	path    string
+	modTime time.Time
+	size    int64
+}
+
+// changed returns the file info of the database file when it changed since it
+// was last loaded.
+func (d *database) changed() (os.FileInfo, error) {
+	fi, err := os.Stat(d.path)
+	if err != nil {
+		return nil, err
+	}
+	if fi.ModTime().Equal(d.modTime) && fi.Size() == d.size {
+		return nil, nil
+	}
+	return fi, nil
+}
+
+// Enricher looks up the AS names in an ASN database and the prefix countries
+// in a country database, it is safe for concurrent use. A nil Enricher has no
+// names nor countries.
+type Enricher struct {
+	asnDB     *database
+	countryDB *database
+	// asns is the table of the names of the ASN database
+	asns      atomic.Pointer[map[uint32]AS]
+	country   atomic.Pointer[countries]
+	cacheSize int
+	// mu serializes the reloads
+	mu     sync.Mutex
+	stopCh chan struct{}
+	doneCh chan struct{}
+}
+
+// New returns the Enricher of the ASN database file asnPath and the country
+// database file countryPath, either can be empty. The countries of up to
+// cacheSize prefixes are cached, DefaultCacheSize when cacheSize is zero.
+func New(asnPath, countryPath string, cacheSize int) (*Enricher, error) {
+	if asnPath == "" && countryPath == "" {
+		return nil, fmt.Errorf("no geoip database file")
+	}
+	e := &Enricher{cacheSize: cacheSize}
+	if asnPath != "" {
+		e.asnDB = &database{path: asnPath}
+	}
+	if countryPath != "" {
+		e.countryDB = &database{path: countryPath}
+	}
+	if _, err := e.Reload(); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// Reload loads the database files again when they changed since they were last
+// loaded and returns true when one did. A database loaded so far is kept when
+// its file can not be loaded.
+func (e *Enricher) Reload() (bool, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	reloaded := false
+	if e.asnDB != nil {
+		fi, err := e.asnDB.changed()
+		if err != nil {
+			return reloaded, err
+		}
+		if fi != nil {
+			r, err := mmdb.Open(e.asnDB.path)
+			if err != nil {
+				return reloaded, err
+			}
+			asns, err := asnTable(r)
+			if err != nil {
+				return reloaded, fmt.Errorf("failed to read asn database %s with error: %w", e.asnDB.path, err)
+			}
+			e.asns.Store(&asns)
+			e.asnDB.modTime, e.asnDB.size = fi.ModTime(), fi.Size()
+			reloaded = true
+			glog.Infof("loaded the names of %d autonomous systems from %s", len(asns), e.asnDB.path)
+		}
+	}
+	if e.countryDB != nil {
+		fi, err := e.countryDB.changed()
+		if err != nil {
+			return reloaded, err
+		}
+		if fi != nil {
+			r, err := mmdb.Open(e.countryDB.path)
+			if err != nil {
+				return reloaded, err
+			}
+			e.country.Store(&countries{reader: r, cache: newCache(e.cacheSize)})
+			e.countryDB.modTime, e.countryDB.size = fi.ModTime(), fi.Size()
+			reloaded = true
+			glog.Infof("loaded country database %s of type %s", e.countryDB.path, r.Metadata.DatabaseType)
+		}
+	}
+
+	return reloaded, nil
+}
+
+// asnTable returns the names of the autonomous systems of the records of the
+// ASN database r.
+func asnTable(r *mmdb.Reader) (map[uint32]AS, error) {
+	asns := make(map[uint32]AS)
+	var err error
+	if werr := r.Offsets(func(offset uint32) bool {
+		var v interface{}
+		if v, err = r.Decode(offset); err != nil {
+			return false
+		}
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return true
+		}
+		asn, ok := asNumber(m)
+		if !ok {
+			return true
+		}
+		as := AS{Name: str(m, "as_name"), Org: str(m, "autonomous_system_organization")}
+		if as.Org == "" {
+			as.Org = str(m, "as_org")
+		}
+		if as.Name == "" {
+			as.Name = str(m, "name")
+		}
+		if as.Name == "" {
+			as.Name = as.Org
+		}
+		if as.Name != "" {
+			asns[asn] = as
+		}
+		return true
+	}); werr != nil {
+		return nil, werr
+	}
+	return asns, err
+}
+
+// asNumber returns the AS number of the record m, the MaxMind
+// autonomous_system_number or the ipinfo asn, such as "AS64500".
+func asNumber(m map[string]interface{}) (uint32, bool) {
+	for _, k := range []string{"autonomous_system_number", "asn"} {
+		switch n := m[k].(type) {
+		case uint16:
+			return uint32(n), true
+		case uint32:
+			return n, true
+		case uint64:
+			return uint32(n), n <= 0xffffffff
+		case string:
+			v, err := strconv.ParseUint(strings.TrimPrefix(strings.ToUpper(n), "AS"), 10, 32)
+			return uint32(v), err == nil
+		}
+	}
+	return 0, false
+}
+
+func str(m map[string]interface{}, k string) string {
+	s, _ := m[k].(string)
+	return s
+}
+
+// AS returns the name and the organisation of the autonomous system asn.
+func (e *Enricher) AS(asn uint32) (AS, bool) {
+	if e == nil || asn == 0 {
+		return AS{}, false
+	}
+	asns := e.asns.Load()
+	if asns == nil {
+		return AS{}, false
+	}
+	as, ok := (*asns)[asn]
+	return as, ok
+}
+
+// Country returns the ISO 3166-1 country code of the prefix p, the country of
+// its network address, or the registered country when the database has no
+// location for it. The countries are cached per prefix.
+func (e *Enricher) Country(p netip.Prefix) string {
+	if e == nil || !p.IsValid() {
+		return ""
+	}
+	t := e.country.Load()
+	if t == nil {
+		return ""
+	}
+	if p.Addr().Is4In6() && p.Bits() >= 96 {
+		p = netip.PrefixFrom(p.Addr().Unmap(), p.Bits()-96)
+	}
+	p = p.Masked()
+	if c, ok := t.cache.get(p); ok {
+		return c
+	}
+	v, _, err := t.reader.Lookup(p.Addr())
+	if err != nil {
+		glog.Errorf("failed to look up the country of %s with error: %+v", p, err)
+		return ""
+	}
+	c := ""
+	if m, ok := v.(map[string]interface{}); ok {
+		for _, k := range []string{"country_code", "country", "registered_country"} {
+			if c = isoCode(m[k]); c != "" {
+				break
+			}
+		}
+	}
+	t.cache.add(p, c)
+
+	return c
+}
+
+// isoCode returns the country code of the MaxMind country map or of the
+// ipinfo country_code string.
+func isoCode(v interface{}) string {
+	switch c := v.(type) {
+	case map[string]interface{}:
+		return str(c, "iso_code")
+	case string:
+		return c
+	}
+	return ""
+}
+
+// Start reloads the database files every interval when they changed,
+// DefaultReloadInterval when interval is zero.
+func (e *Enricher) Start(interval time.Duration) {
+	if interval == 0 {
+		interval = DefaultReloadInterval
+	}
+	e.stopCh = make(chan struct{})
+	e.doneCh = make(chan struct{})
+	go func() {
+		defer close(e.doneCh)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				if _, err := e.Reload(); err != nil {
+					glog.Errorf("failed to reload geoip database with error: %+v", err)
+				}
+			case <-e.stopCh:
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops the reloads of the database files.
+func (e *Enricher) Stop() {
+	if e == nil || e.stopCh == nil {
+		return
+	}
+	close(e.stopCh)
+	<-e.doneCh
+	e.stopCh = nil
+}
+
+// countries is a country database and the cache of its lookups.
+type countries struct {
+	reader *mmdb.Reader
+	cache  *cache
+}
+
+type entry struct {
+	prefix  netip.Prefix
+	country string
+}
+
+// cache is a LRU cache of the countries of the prefixes.
+type cache struct {
+	mu    sync.Mutex
+	size  int
+	order *list.List
+	items map[netip.Prefix]*list.Element
+}
+
+func newCache(size int) *cache {
+	if size <= 0 {
+		size = DefaultCacheSize
+	}
+	return &cache{
+		size:  size,
+		order: list.New(),
+		items: make(map[netip.Prefix]*list.Element),
+	}
+}
+
+func (c *cache) get(p netip.Prefix) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.items[p]
+	if !ok {
+		return "", false
+	}
+	c.order.MoveToFront(e)
+	return e.Value.(*entry).country, true
+}
+
+// add adds the country of p to the cache, the least recently used prefix is
+// evicted when the cache is full.
+func (c *cache) add(p netip.Prefix, country string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.items[p]; ok {
+		e.Value.(*entry).country = country
+		c.order.MoveToFront(e)
+		return
+	}
+	c.items[p] = c.order.PushFront(&entry{prefix: p, country: country})
+	if c.order.Len() > c.size {
+		e := c.order.Back()
+		c.order.Remove(e)
+		delete(c.items, e.Value.(*entry).prefix)
+	}
+}
@@ -0,0 +1,185 @@
+package geoip
+
+import (
+	"net/netip"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sbezverk/gobmp/pkg/mmdb/mmdbtest"
+)
+
+func maxmind(t *testing.T, dir string) (string, string) {
+	t.Helper()
+	asn, country := filepath.Join(dir, "asn.mmdb"), filepath.Join(dir, "country.mmdb")
+	mmdbtest.Write(t, asn, "GeoLite2-ASN", []mmdbtest.Network{
+		{Prefix: "198.51.100.0/24", Record: map[string]interface{}{"autonomous_system_number": uint32(64500), "autonomous_system_organization": "Example Networks"}},
+		{Prefix: "198.51.101.0/24", Record: map[string]interface{}{"autonomous_system_number": uint32(64500), "autonomous_system_organization": "Example Networks"}},
+		{Prefix: "2001:db8::/32", Record: map[string]interface{}{"autonomous_system_number": uint32(64501), "autonomous_system_organization": "Documentation"}},
+	})
+	mmdbtest.Write(t, country, "GeoLite2-Country", []mmdbtest.Network{
+		{Prefix: "198.51.100.0/24", Record: map[string]interface{}{"country": map[string]interface{}{"iso_code": "FR", "names": map[string]interface{}{"en": "France"}}}},
+		{Prefix: "203.0.113.0/24", Record: map[string]interface{}{"registered_country": map[string]interface{}{"iso_code": "DE"}}},
+		{Prefix: "2001:db8::/32", Record: map[string]interface{}{"country": map[string]interface{}{"iso_code": "NL"}}},
+	})
+	return asn, country
+}
+
+func TestEnricher(t *testing.T) {
+	dir := t.TempDir()
+	asn, country := maxmind(t, dir)
+	ipinfo := filepath.Join(dir, "ipinfo.mmdb")
+	mmdbtest.Write(t, ipinfo, "ipinfo_lite.mmdb", []mmdbtest.Network{
+		{Prefix: "192.0.2.0/24", Record: map[string]interface{}{"asn": "AS64510", "as_name": "Lite Networks", "country_code": "JP", "country": "Japan"}},
+	})
+	tests := []struct {
+		name        string
+		asn         string
+		country     string
+		as          uint32
+		wantAS      AS
+		prefix      string
+		wantCountry string
+	}{
+		{
+			name:        "maxmind",
+			asn:         asn,
+			country:     country,
+			as:          64500,
+			wantAS:      AS{Name: "Example Networks", Org: "Example Networks"},
+			prefix:      "198.51.100.128/25",
+			wantCountry: "FR",
+		},
+		{
+			name:        "registered country",
+			asn:         asn,
+			country:     country,
+			as:          64501,
+			wantAS:      AS{Name: "Documentation", Org: "Documentation"},
+			prefix:      "203.0.113.0/24",
+			wantCountry: "DE",
+		},
+		{
+			name:        "ipv6",
+			country:     country,
+			prefix:      "2001:db8:100::/40",
+			wantCountry: "NL",
+		},
+		{
+			name:        "mapped prefix",
+			country:     country,
+			prefix:      "::ffff:198.51.100.0/120",
+			wantCountry: "FR",
+		},
+		{
+			name:    "unknown",
+			asn:     asn,
+			country: country,
+			as:      64502,
+			prefix:  "192.0.2.0/24",
+		},
+		{
+			name:        "ipinfo",
+			asn:         ipinfo,
+			country:     ipinfo,
+			as:          64510,
+			wantAS:      AS{Name: "Lite Networks"},
+			prefix:      "192.0.2.0/25",
+			wantCountry: "JP",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, err := New(tt.asn, tt.country, 0)
+			if err != nil {
+				t.Fatalf("New() error: %v", err)
+			}
+			if got, _ := e.AS(tt.as); got != tt.wantAS {
+				t.Errorf("AS(%d) = %+v, want %+v", tt.as, got, tt.wantAS)
+			}
+			// The second lookup is served by the cache
+			for i := 0; i < 2; i++ {
+				if got := e.Country(netip.MustParsePrefix(tt.prefix)); got != tt.wantCountry {
+					t.Errorf("Country(%s) = %q, want %q", tt.prefix, got, tt.wantCountry)
+				}
+			}
+		})
+	}
+	var e *Enricher
+	if _, ok := e.AS(64500); ok {
+		t.Error("nil enricher AS() found a name")
+	}
+	if got := e.Country(netip.MustParsePrefix("198.51.100.0/24")); got != "" {
+		t.Errorf("nil enricher Country() = %q", got)
+	}
+}
+
+func TestReload(t *testing.T) {
+	dir := t.TempDir()
+	asn, country := maxmind(t, dir)
+	e, err := New(asn, country, 0)
+	if err != nil {
+		t.Fatalf("New() error: %v", err)
+	}
+	if changed, err := e.Reload(); err != nil || changed {
+		t.Errorf("Reload() of unchanged files = %t, %v", changed, err)
+	}
+	prefix := netip.MustParsePrefix("198.51.100.0/24")
+	if got := e.Country(prefix); got != "FR" {
+		t.Fatalf("Country() = %q, want FR", got)
+	}
+	mtime := time.Now().Add(time.Minute)
+	mmdbtest.Write(t, country, "GeoLite2-Country", []mmdbtest.Network{
+		{Prefix: "198.51.100.0/24", Record: map[string]interface{}{"country": map[string]interface{}{"iso_code": "BE"}}},
+	})
+	if err := os.Chtimes(country, mtime, mtime); err != nil {
+		t.Fatal(err)
+	}
+	if changed, err := e.Reload(); err != nil || !changed {
+		t.Fatalf("Reload() of a changed file = %t, %v", changed, err)
+	}
+	if got := e.Country(prefix); got != "BE" {
+		t.Errorf("Country() after reload = %q, want BE", got)
+	}
+	// The database is kept when the file is invalid
+	if err := os.WriteFile(country, []byte("not a database"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.Reload(); err == nil {
+		t.Error("Reload() expected error for an invalid file")
+	}
+	if got := e.Country(prefix); got != "BE" {
+		t.Errorf("Country() after failed reload = %q, want BE", got)
+	}
+	if _, err := New(filepath.Join(dir, "missing.mmdb"), "", 0); err == nil {
+		t.Error("New() expected error for a missing file")
+	}
+	if _, err := New("", "", 0); err == nil {
+		t.Error("New() expected error without database")
+	}
+}
+
+func TestCache(t *testing.T) {
+	c := newCache(2)
+	p := []netip.Prefix{
+		netip.MustParsePrefix("10.0.0.0/8"),
+		netip.MustParsePrefix("10.0.0.0/16"),
+		netip.MustParsePrefix("10.0.0.0/24"),
+	}
+	c.add(p[0], "FR")
+	c.add(p[1], "DE")
+	if _, ok := c.get(p[0]); !ok {
+		t.Fatal("get() missed a cached prefix")
+	}
+	// p[1] is the least recently used prefix
+	c.add(p[2], "NL")
+	if _, ok := c.get(p[1]); ok {
+		t.Error("get() found an evicted prefix")
+	}
+	for i, want := range map[int]string{0: "FR", 2: "NL"} {
+		if got, ok := c.get(p[i]); !ok || got != want {
+			t.Errorf("get(%s) = %q, %t, want %q", p[i], got, ok, want)
+		}
+	}
+}
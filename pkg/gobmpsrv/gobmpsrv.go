@@ -14,6 +14,7 @@ import (
 	"github.com/sbezverk/gobmp/pkg/bmp"
 	"github.com/sbezverk/gobmp/pkg/config"
 	"github.com/sbezverk/gobmp/pkg/message"
+	"github.com/sbezverk/gobmp/pkg/mrt"
 	"github.com/sbezverk/gobmp/pkg/parser"
 	"github.com/sbezverk/gobmp/pkg/pub"
 )
@@ -51,8 +52,16 @@ type bmpServer struct {
 	stopOnce  sync.Once
 	clients   map[net.Conn]struct{} // active bmpWorker connections
 	closing   bool                  // set to true in Stop() before iterating clients
-	bmpRaw    bool
-	adminID   string
+	// bmpRaw enables the RAW messages, rawParsed the parsed messages alongside
+	// them, routerGroup is the router group of the RAW messages
+	bmpRaw      bool
+	rawParsed   bool
+	routerGroup string
+	adminID     string
+	// producerConfig configures the parsed messages of every producer
+	producerConfig *message.Config
+	// mrt when not nil receives every parsed BMP message for MRT export
+	mrt *mrt.Writer
 	// Active-mode fields — all nil/zero in passive mode.
 	connectorStopCh chan struct{}      // closed by stopConnector() to signal connector() to exit
 	bgpSpeakers     []string           // list of "host:port" addresses to dial
@@ -104,7 +113,10 @@ func (srv *bmpServer) Stop() {
 	srv.mu.Unlock()
 	// 3. Wait for server() and all bmpWorker goroutines to finish.
 	srv.wg.Wait()
-	// 4. Now it is safe to stop the publisher.
+	// 4. Now it is safe to stop the MRT writer and the publisher.
+	if srv.mrt != nil {
+		srv.mrt.Stop()
+	}
 	if srv.publisher != nil {
 		srv.publisher.Stop()
 	}
@@ -180,9 +192,12 @@ func (srv *bmpServer) bmpWorker(client net.Conn) {
 	prod := message.NewProducer(srv.publisher, srv.splitAF)
 
 	// Configure producer with admin ID for RAW message support
-	if err := prod.SetConfig(&message.Config{
-		AdminID: srv.adminID,
-	}); err != nil {
+	var pc message.Config
+	if srv.producerConfig != nil {
+		pc = *srv.producerConfig
+	}
+	pc.AdminID, pc.RouterGroup = srv.adminID, srv.routerGroup
+	if err := prod.SetConfig(&pc); err != nil {
 		glog.Errorf("failed to configure producer with error: %+v", err)
 		return
 	}
@@ -227,9 +242,17 @@ func (srv *bmpServer) bmpWorker(client net.Conn) {
 	// Starting parser per client with dedicated work queue
 	parserConfig := &parser.Config{
 		EnableRawMode: srv.bmpRaw,
+		RawAndParsed:  srv.rawParsed,
 		SpeakerIP:     speakerIP,
 	}
-	p := parser.NewParser(parserQueue, producerQueue, parsStop, parserConfig)
+	// When MRT export is enabled the parser feeds the MRT tap which forwards
+	// every message to the producer.
+	parserOut := producerQueue
+	if srv.mrt != nil {
+		parserOut = make(chan bmp.Message)
+		go srv.mrtTap(speakerIP, parserOut, producerQueue, parsStop)
+	}
+	p := parser.NewParser(parserQueue, parserOut, parsStop, parserConfig)
 	go p.Start()
 	defer func() {
 		glog.V(5).Infof("all done with client %+v", client.RemoteAddr())
@@ -295,6 +318,27 @@ func (srv *bmpServer) bmpWorker(client net.Conn) {
 	}
 }
 
+// mrtTap hands each parsed BMP message to the MRT writer before passing it on
+// to the producer. When the session ends the router state kept by the writer
+// is released.
+func (srv *bmpServer) mrtTap(speakerIP string, in, out chan bmp.Message, stop chan struct{}) {
+	session := srv.mrt.RouterUp(speakerIP)
+	defer session.RouterDown()
+	for {
+		select {
+		case msg := <-in:
+			session.WriteMessage(msg)
+			select {
+			case out <- msg:
+			case <-stop:
+				return
+			}
+		case <-stop:
+			return
+		}
+	}
+}
+
 // bgpSpeaker tracks the connection state and reconnection backoff for a single
 // BGP speaker in active mode. All fields except Address are protected by mu.
 type bgpSpeaker struct {
@@ -457,6 +501,7 @@ func NewBMPServer(cfg *config.Config) (BMPServer, error) {
 		splitAF:     cfg.SplitAF == nil || *cfg.SplitAF, // nil means unset → default true
 		bgpSpeakers: cfg.SpeakersList,
 	}
+	bmpSrv.producerConfig = cfg.ProducerConfig()
 	if !bmpSrv.isActive {
 		incoming, err := net.Listen("tcp", ":"+strconv.Itoa(cfg.BmpListenPort))
 		if err != nil {
@@ -477,8 +522,30 @@ func NewBMPServer(cfg *config.Config) (BMPServer, error) {
 		bmpSrv.connectorCtx = ctx
 		bmpSrv.connectorCancel = cancel
 	}
-	if cfg.PublisherType == config.PublisherTypeKafka && cfg.KafkaConfig != nil {
-		bmpSrv.bmpRaw = cfg.KafkaConfig.BmpRaw
+	if cfg.MRTConfig != nil && cfg.MRTConfig.Dir != "" {
+		w, err := mrt.NewWriter(&mrt.Config{
+			Dir:              cfg.MRTConfig.Dir,
+			RotationInterval: cfg.MRTConfig.RotationInterval,
+			RIBInterval:      cfg.MRTConfig.RIBInterval,
+		})
+		if err != nil {
+			if bmpSrv.incoming != nil {
+				_ = bmpSrv.incoming.Close()
+			}
+			if bmpSrv.connectorCancel != nil {
+				bmpSrv.connectorCancel()
+			}
+			return nil, err
+		}
+		bmpSrv.mrt = w
+	}
+	if c := cfg.RawConfig; c != nil && c.Enabled {
+		bmpSrv.bmpRaw = true
+		bmpSrv.rawParsed = c.Parsed
+		bmpSrv.routerGroup = c.RouterGroup
+	}
+	if cfg.KafkaConfig != nil {
+		bmpSrv.bmpRaw = bmpSrv.bmpRaw || cfg.KafkaConfig.BmpRaw
 		bmpSrv.adminID = cfg.KafkaConfig.AdminID
 	}
 
@@ -6,6 +6,8 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"os"
+	"path/filepath"
 	"strconv"
 	"sync"
 	"testing"
@@ -551,6 +553,61 @@ func TestNewBMPServer_KafkaConfig_SetsRawAndAdminID(t *testing.T) {
 	srv.Stop()
 }
 
+func TestNewBMPServer_RawConfig(t *testing.T) {
+	cfg := &config.Config{
+		Publisher:     newMockPublisher(),
+		PublisherType: config.PublisherTypeNATS,
+		RawConfig:     &config.RawConfig{Enabled: true, Parsed: true, RouterGroup: "edge"},
+		KafkaConfig:   &config.KafkaConfig{AdminID: "test-collector"},
+	}
+	srv, err := NewBMPServer(cfg)
+	if err != nil {
+		t.Fatalf("NewBMPServer: %v", err)
+	}
+	bs := srv.(*bmpServer)
+	if !bs.bmpRaw || !bs.rawParsed || bs.routerGroup != "edge" || bs.adminID != "test-collector" {
+		t.Errorf("bmpRaw = %t, rawParsed = %t, routerGroup = %q, adminID = %q", bs.bmpRaw, bs.rawParsed, bs.routerGroup, bs.adminID)
+	}
+	srv.Stop()
+}
+
+// TestBMPWorker_MRTWriter verifies that parsed messages are handed to the MRT
+// writer and still reach the publisher.
+func TestBMPWorker_MRTWriter(t *testing.T) {
+	dir := t.TempDir()
+	pub := newMockPublisher()
+	srv, err := NewBMPServer(&config.Config{
+		Publisher: pub,
+		MRTConfig: &config.MRTConfig{Dir: dir, RIBInterval: -1},
+	})
+	if err != nil {
+		t.Fatalf("NewBMPServer: %v", err)
+	}
+	bs := srv.(*bmpServer)
+	if bs.mrt == nil {
+		t.Fatal("mrt writer was not created")
+	}
+	serverConn, clientConn := net.Pipe()
+	done := workerDone(bs, serverConn)
+	if _, err := clientConn.Write(makePeerDownMessage()); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	if !pub.waitForMessages(1, 3*time.Second) {
+		t.Fatal("timed out waiting for message to reach publisher")
+	}
+	_ = clientConn.Close()
+	assertWorkerExits(t, done)
+	srv.Stop()
+
+	files, err := filepath.Glob(filepath.Join(dir, "*", "updates.*.mrt"))
+	if err != nil || len(files) != 1 {
+		t.Fatalf("expected a single mrt updates file, got %v (err %v)", files, err)
+	}
+	if fi, err := os.Stat(files[0]); err != nil || fi.Size() == 0 {
+		t.Fatalf("mrt updates file is empty or missing: %v", err)
+	}
+}
+
 // ---- startWorker: closing-state race path -----------------------------------
 
 // TestStartWorker_WhenClosing_ClosesConnection verifies that startWorker
@@ -0,0 +1,164 @@
+package grpcpub
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/netip"
+
+	"github.com/sbezverk/gobmp/pkg/api"
+	"github.com/sbezverk/gobmp/pkg/bmp"
+)
+
+// filter is the compiled SubscribeRequest of a subscriber.
+type filter struct {
+	types    map[string]bool
+	routers  map[netip.Addr]bool
+	peers    map[netip.Addr]bool
+	afis     map[api.AFI]bool
+	prefixes []netip.Prefix
+}
+
+func newFilter(req *api.SubscribeRequest) (*filter, error) {
+	f := &filter{}
+	if len(req.GetTypes()) != 0 {
+		known := make(map[string]bool, len(bmp.MessageTypeNames))
+		for _, n := range bmp.MessageTypeNames {
+			known[n] = true
+		}
+		f.types = make(map[string]bool)
+		for _, t := range req.GetTypes() {
+			if !known[t] {
+				return nil, fmt.Errorf("unknown message type %q", t)
+			}
+			f.types[t] = true
+		}
+	}
+	var err error
+	if f.routers, err = addrSet("router", req.GetRouters()); err != nil {
+		return nil, err
+	}
+	if f.peers, err = addrSet("peer", req.GetPeers()); err != nil {
+		return nil, err
+	}
+	if len(req.GetAfis()) != 0 {
+		f.afis = make(map[api.AFI]bool)
+		for _, a := range req.GetAfis() {
+			if a != api.AFI_AFI_IPV4 && a != api.AFI_AFI_IPV6 {
+				return nil, fmt.Errorf("invalid afi %v", a)
+			}
+			f.afis[a] = true
+		}
+	}
+	for _, s := range req.GetPrefixes() {
+		p, err := netip.ParsePrefix(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid prefix %q: %w", s, err)
+		}
+		f.prefixes = append(f.prefixes, p.Masked())
+	}
+
+	return f, nil
+}
+
+func addrSet(kind string, addrs []string) (map[netip.Addr]bool, error) {
+	if len(addrs) == 0 {
+		return nil, nil
+	}
+	m := make(map[netip.Addr]bool)
+	for _, s := range addrs {
+		a, err := netip.ParseAddr(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s address %q: %w", kind, s, err)
+		}
+		m[a.Unmap()] = true
+	}
+
+	return m, nil
+}
+
+func (f *filter) matchType(name string) bool {
+	return f.types == nil || f.types[name]
+}
+
+// needsFields returns true when the filter matches on the message fields.
+func (f *filter) needsFields() bool {
+	return f.routers != nil || f.peers != nil || f.afis != nil || f.prefixes != nil
+}
+
+// match returns true when the message fields match the filter, fields is
+// only decoded when needsFields returns true.
+func (f *filter) match(m *fields) bool {
+	if !f.needsFields() {
+		return true
+	}
+	if f.routers != nil && !f.routers[m.router] {
+		return false
+	}
+	if f.peers != nil && !f.peers[m.peer] {
+		return false
+	}
+	if f.afis != nil && !f.afis[m.afi] {
+		return false
+	}
+	if f.prefixes != nil {
+		if !m.prefix.IsValid() {
+			return false
+		}
+		for _, p := range f.prefixes {
+			if p.Bits() <= m.prefix.Bits() && p.Contains(m.prefix.Addr()) {
+				return true
+			}
+		}
+		return false
+	}
+
+	return true
+}
+
+// fields are the message fields matched by the filters, the zero values are
+// never matched.
+type fields struct {
+	router netip.Addr
+	peer   netip.Addr
+	afi    api.AFI
+	prefix netip.Prefix
+}
+
+func newFields(msg []byte) (*fields, error) {
+	var m struct {
+		RouterIP  string `json:"router_ip"`
+		PeerIP    string `json:"peer_ip"`
+		RemoteIP  string `json:"remote_ip"`
+		IsIPv4    *bool  `json:"is_ipv4"`
+		Prefix    string `json:"prefix"`
+		PrefixLen int    `json:"prefix_len"`
+	}
+	if err := json.Unmarshal(msg, &m); err != nil {
+		return nil, err
+	}
+	f := &fields{}
+	if a, err := netip.ParseAddr(m.RouterIP); err == nil {
+		f.router = a.Unmap()
+	}
+	// Peer State Change messages carry the peer address as remote_ip
+	peer := m.PeerIP
+	if peer == "" {
+		peer = m.RemoteIP
+	}
+	if a, err := netip.ParseAddr(peer); err == nil {
+		f.peer = a.Unmap()
+	}
+	if m.IsIPv4 != nil {
+		f.afi = api.AFI_AFI_IPV6
+		if *m.IsIPv4 {
+			f.afi = api.AFI_AFI_IPV4
+		}
+	}
+	if a, err := netip.ParseAddr(m.Prefix); err == nil {
+		if p, err := a.Unmap().Prefix(m.PrefixLen); err == nil {
+			f.prefix = p
+		}
+	}
+
+	return f, nil
+}
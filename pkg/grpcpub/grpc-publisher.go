@@ -0,0 +1,269 @@
+package grpcpub
+
+import (
+	"fmt"
+	"net"
+	"sync"
+
+	"github.com/golang/glog"
+	"github.com/sbezverk/gobmp/pkg/api"
+	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/pub"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// DefaultBufferSize is the number of messages buffered per subscriber when
+// the buffer size is not configured.
+const DefaultBufferSize = 4096
+
+var unmarshalOptions = protojson.UnmarshalOptions{DiscardUnknown: true}
+
+type publisher struct {
+	api.UnimplementedGoBMPServer
+	srv        *grpc.Server
+	bufferSize int
+	mu         sync.RWMutex
+	subs       map[*subscriber]struct{}
+	stopped    bool
+}
+
+// subscriber is a Subscribe stream, messages are queued to ch by the
+// publisher and sent by the stream's handler.
+type subscriber struct {
+	filter *filter
+	ch     chan *api.Message
+	done   chan struct{}
+	once   sync.Once
+	err    error
+}
+
+func (s *subscriber) close(err error) {
+	s.once.Do(func() {
+		s.err = err
+		close(s.done)
+	})
+}
+
+func (s *subscriber) closed() bool {
+	select {
+	case <-s.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// send queues m, a subscriber which does not drain its buffer is disconnected
+// rather than slowing down the collector.
+func (s *subscriber) send(m *api.Message) {
+	select {
+	case s.ch <- m:
+	default:
+		s.close(status.Errorf(codes.ResourceExhausted, "subscriber is too slow, %d messages are pending", cap(s.ch)))
+	}
+}
+
+// PublishMessage streams the message to the subscribers it matches, it is
+// only decoded when at least one subscriber matches its type. The RAW and
+// OpenBMP messages are not streamed.
+func (p *publisher) PublishMessage(t int, key []byte, msg []byte) error {
+	if t == bmp.BMPRawMsg || bmp.IsOpenBMPMsg(t) {
+		return nil
+	}
+	name, ok := bmp.MessageTypeNames[t]
+	if !ok {
+		return fmt.Errorf("grpc publisher: message type %d has no name", t)
+	}
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	var m *api.Message
+	var f *fields
+	for s := range p.subs {
+		if s.closed() || !s.filter.matchType(name) {
+			continue
+		}
+		if f == nil && s.filter.needsFields() {
+			var err error
+			if f, err = newFields(msg); err != nil {
+				return fmt.Errorf("grpc publisher: failed to decode message of type %d: %w", t, err)
+			}
+		}
+		if !s.filter.match(f) {
+			continue
+		}
+		if m == nil {
+			var err error
+			if m, err = newMessage(t, name, key, msg); err != nil {
+				return fmt.Errorf("grpc publisher: failed to decode message of type %d: %w", t, err)
+			}
+		}
+		s.send(m)
+	}
+
+	return nil
+}
+
+// newMessage returns the protobuf message of the JSON message msg of a type,
+// the messages of a type without a protobuf definition are carried as JSON.
+func newMessage(t int, name string, key []byte, msg []byte) (*api.Message, error) {
+	m := &api.Message{Type: name, Key: key}
+	if _, ok := api.NewMessage(t); !ok {
+		b := &structpb.Struct{}
+		if err := unmarshalOptions.Unmarshal(msg, b); err != nil {
+			return nil, err
+		}
+		m.Body = &api.Message_Json{Json: b}
+		return m, nil
+	}
+	body, err := api.FromJSON(t, msg)
+	if err != nil {
+		return nil, err
+	}
+	switch b := body.(type) {
+	case *api.PeerStateChange:
+		m.Body = &api.Message_Peer{Peer: b}
+	case *api.UnicastPrefix:
+		m.Body = &api.Message_UnicastPrefix{UnicastPrefix: b}
+	case *api.L3VPNPrefix:
+		m.Body = &api.Message_L3VpnPrefix{L3VpnPrefix: b}
+	case *api.LSNode:
+		m.Body = &api.Message_LsNode{LsNode: b}
+	case *api.LSLink:
+		m.Body = &api.Message_LsLink{LsLink: b}
+	case *api.LSPrefix:
+		m.Body = &api.Message_LsPrefix{LsPrefix: b}
+	case *api.LSSRv6SID:
+		m.Body = &api.Message_LsSrv6Sid{LsSrv6Sid: b}
+	case *api.EVPNPrefix:
+		m.Body = &api.Message_EvpnPrefix{EvpnPrefix: b}
+	case *api.SRPolicy:
+		m.Body = &api.Message_SrPolicy{SrPolicy: b}
+	case *api.Flowspec:
+		m.Body = &api.Message_Flowspec{Flowspec: b}
+	case *api.VPLSPrefix:
+		m.Body = &api.Message_VplsPrefix{VplsPrefix: b}
+	case *api.Stats:
+		m.Body = &api.Message_Stats{Stats: b}
+	case *api.TopologyEvent:
+		m.Body = &api.Message_TopologyEvent{TopologyEvent: b}
+	case *api.ResolvedSRPolicy:
+		m.Body = &api.Message_ResolvedSrPolicy{ResolvedSrPolicy: b}
+	case *api.ChurnStats:
+		m.Body = &api.Message_ChurnStats{ChurnStats: b}
+	case *api.FlapEvent:
+		m.Body = &api.Message_FlapEvent{FlapEvent: b}
+	case *api.HijackEvent:
+		m.Body = &api.Message_HijackEvent{HijackEvent: b}
+	case *api.RouteLeak:
+		m.Body = &api.Message_RouteLeak{RouteLeak: b}
+	case *api.PeerSync:
+		m.Body = &api.Message_PeerSync{PeerSync: b}
+	case *api.BaseAttribute:
+		m.Body = &api.Message_BaseAttribute{BaseAttribute: b}
+	case *api.MulticastPrefix:
+		m.Body = &api.Message_MulticastPrefix{MulticastPrefix: b}
+	case *api.MCASTVPNPrefix:
+		m.Body = &api.Message_McastVpnPrefix{McastVpnPrefix: b}
+	case *api.RTCPrefix:
+		m.Body = &api.Message_RtcPrefix{RtcPrefix: b}
+	default:
+		return nil, fmt.Errorf("message type %d has no body", t)
+	}
+
+	return m, nil
+}
+
+// Subscribe implements the Subscribe RPC.
+func (p *publisher) Subscribe(req *api.SubscribeRequest, stream api.GoBMP_SubscribeServer) error {
+	f, err := newFilter(req)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	s := &subscriber{
+		filter: f,
+		ch:     make(chan *api.Message, p.bufferSize),
+		done:   make(chan struct{}),
+	}
+	if !p.add(s) {
+		return status.Error(codes.Unavailable, "collector is shutting down")
+	}
+	defer p.remove(s)
+	glog.V(5).Infof("gRPC subscriber %+v connected", req)
+	for {
+		select {
+		case m := <-s.ch:
+			if err := stream.Send(m); err != nil {
+				return err
+			}
+		case <-s.done:
+			glog.Warningf("gRPC subscriber %+v disconnected: %v", req, s.err)
+			return s.err
+		case <-stream.Context().Done():
+			return status.FromContextError(stream.Context().Err()).Err()
+		}
+	}
+}
+
+func (p *publisher) add(s *subscriber) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.stopped {
+		return false
+	}
+	p.subs[s] = struct{}{}
+
+	return true
+}
+
+func (p *publisher) remove(s *subscriber) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.subs, s)
+}
+
+// Stop ends the streams of the subscribers and stops the gRPC server.
+func (p *publisher) Stop() {
+	p.mu.Lock()
+	p.stopped = true
+	for s := range p.subs {
+		s.close(status.Error(codes.Unavailable, "collector is shutting down"))
+	}
+	p.mu.Unlock()
+	p.srv.GracefulStop()
+}
+
+// NewPublisher returns a Publisher streaming the published messages to the
+// subscribers of the gRPC server listening on addr, bufferSize is the number
+// of messages buffered per subscriber.
+func NewPublisher(addr string, bufferSize int) (pub.Publisher, error) {
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen for gRPC subscribers on %s with error: %w", addr, err)
+	}
+	glog.Infof("gRPC server listening for subscribers on %s", lis.Addr())
+
+	return newPublisher(lis, bufferSize), nil
+}
+
+func newPublisher(lis net.Listener, bufferSize int) *publisher {
+	if bufferSize <= 0 {
+		bufferSize = DefaultBufferSize
+	}
+	p := &publisher{
+		srv:        grpc.NewServer(),
+		bufferSize: bufferSize,
+		subs:       make(map[*subscriber]struct{}),
+	}
+	api.RegisterGoBMPServer(p.srv, p)
+	go func() {
+		if err := p.srv.Serve(lis); err != nil {
+			glog.Errorf("gRPC server failed with error: %+v", err)
+		}
+	}()
+
+	return p
+}
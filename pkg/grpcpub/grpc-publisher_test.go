@@ -0,0 +1,281 @@
+package grpcpub
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/sbezverk/gobmp/pkg/api"
+	"github.com/sbezverk/gobmp/pkg/bgp"
+	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/message"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func TestNewFilter(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *api.SubscribeRequest
+		wantErr bool
+	}{
+		{name: "empty", req: &api.SubscribeRequest{}},
+		{
+			name: "valid",
+			req: &api.SubscribeRequest{
+				Types:    []string{"peer", "unicast_prefix_v4"},
+				Routers:  []string{"10.0.0.1"},
+				Peers:    []string{"2001:db8::1"},
+				Afis:     []api.AFI{api.AFI_AFI_IPV4},
+				Prefixes: []string{"192.0.2.0/24"},
+			},
+		},
+		{name: "unknown type", req: &api.SubscribeRequest{Types: []string{"unicast"}}, wantErr: true},
+		{name: "invalid router", req: &api.SubscribeRequest{Routers: []string{"router1"}}, wantErr: true},
+		{name: "invalid peer", req: &api.SubscribeRequest{Peers: []string{"10.0.0.0/8"}}, wantErr: true},
+		{name: "unspecified afi", req: &api.SubscribeRequest{Afis: []api.AFI{api.AFI_AFI_UNSPECIFIED}}, wantErr: true},
+		{name: "invalid prefix", req: &api.SubscribeRequest{Prefixes: []string{"192.0.2.0"}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := newFilter(tt.req); (err != nil) != tt.wantErr {
+				t.Fatalf("newFilter() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestFilterMatch(t *testing.T) {
+	route := `{"router_ip":"10.0.0.1","peer_ip":"192.168.0.1","prefix":"192.0.2.128","prefix_len":25,"is_ipv4":true}`
+	peer := `{"router_ip":"10.0.0.1","remote_ip":"2001:db8::1","is_ipv4":false}`
+	node := `{"router_ip":"10.0.0.1","peer_ip":"192.168.0.1"}`
+	tests := []struct {
+		name string
+		req  *api.SubscribeRequest
+		msg  string
+		want bool
+	}{
+		{name: "no filter", req: &api.SubscribeRequest{}, msg: route, want: true},
+		{name: "router", req: &api.SubscribeRequest{Routers: []string{"10.0.0.1"}}, msg: route, want: true},
+		{name: "other router", req: &api.SubscribeRequest{Routers: []string{"10.0.0.2"}}, msg: route},
+		{name: "peer", req: &api.SubscribeRequest{Peers: []string{"192.168.0.1"}}, msg: route, want: true},
+		{name: "peer of peer message", req: &api.SubscribeRequest{Peers: []string{"2001:db8::1"}}, msg: peer, want: true},
+		{name: "afi", req: &api.SubscribeRequest{Afis: []api.AFI{api.AFI_AFI_IPV4}}, msg: route, want: true},
+		{name: "other afi", req: &api.SubscribeRequest{Afis: []api.AFI{api.AFI_AFI_IPV4}}, msg: peer},
+		{name: "afi without is_ipv4", req: &api.SubscribeRequest{Afis: []api.AFI{api.AFI_AFI_IPV4}}, msg: node},
+		{name: "covering prefix", req: &api.SubscribeRequest{Prefixes: []string{"192.0.2.0/24"}}, msg: route, want: true},
+		{name: "same prefix", req: &api.SubscribeRequest{Prefixes: []string{"192.0.2.128/25"}}, msg: route, want: true},
+		{name: "more specific prefix", req: &api.SubscribeRequest{Prefixes: []string{"192.0.2.128/26"}}, msg: route},
+		{name: "prefix without prefix", req: &api.SubscribeRequest{Prefixes: []string{"0.0.0.0/0"}}, msg: node},
+		{
+			name: "all",
+			req:  &api.SubscribeRequest{Routers: []string{"10.0.0.1"}, Peers: []string{"192.168.0.1"}, Prefixes: []string{"198.51.100.0/24", "192.0.2.0/24"}},
+			msg:  route,
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := newFilter(tt.req)
+			if err != nil {
+				t.Fatalf("newFilter() error: %v", err)
+			}
+			m, err := newFields([]byte(tt.msg))
+			if err != nil {
+				t.Fatalf("newFields() error: %v", err)
+			}
+			if got := f.match(m); got != tt.want {
+				t.Errorf("match() = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
+
+func newTestPublisher(t *testing.T, bufferSize int) (*publisher, api.GoBMPClient) {
+	t.Helper()
+	lis := bufconn.Listen(1024 * 1024)
+	p := newPublisher(lis, bufferSize)
+	t.Cleanup(p.Stop)
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return lis.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("failed to connect to the gRPC server: %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return p, api.NewGoBMPClient(conn)
+}
+
+// subscribe returns the stream of a subscription once the publisher has
+// registered it.
+func subscribe(t *testing.T, p *publisher, c api.GoBMPClient, req *api.SubscribeRequest) api.GoBMP_SubscribeClient {
+	t.Helper()
+	p.mu.RLock()
+	n := len(p.subs)
+	p.mu.RUnlock()
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	stream, err := c.Subscribe(ctx, req)
+	if err != nil {
+		t.Fatalf("Subscribe() error: %v", err)
+	}
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(time.Millisecond) {
+		p.mu.RLock()
+		registered := len(p.subs) > n
+		p.mu.RUnlock()
+		if registered {
+			return stream
+		}
+	}
+	t.Fatal("subscriber was not registered")
+
+	return nil
+}
+
+func publish(t *testing.T, p *publisher, msgType int, msg interface{}) {
+	t.Helper()
+	b, err := json.Marshal(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := p.PublishMessage(msgType, []byte("key"), b); err != nil {
+		t.Fatalf("PublishMessage() error: %v", err)
+	}
+}
+
+func TestSubscribe(t *testing.T) {
+	p, c := newTestPublisher(t, 0)
+	stream := subscribe(t, p, c, &api.SubscribeRequest{
+		Types:    []string{"unicast_prefix_v4", "statistics"},
+		Prefixes: []string{"192.0.2.0/24"},
+	})
+	all := subscribe(t, p, c, &api.SubscribeRequest{Types: []string{"peer", "statistics"}})
+
+	color := uint32(100)
+	publish(t, p, bmp.PeerStateChangeMsg, &message.PeerStateChange{Action: "add", RouterIP: "10.0.0.1", RemoteIP: "192.168.0.1", RemoteASN: 65000, IsIPv4: true})
+	publish(t, p, bmp.UnicastPrefixV4Msg, &message.UnicastPrefix{Action: "add", Prefix: "198.51.100.0", PrefixLen: 24, IsIPv4: true})
+	publish(t, p, bmp.UnicastPrefixV4Msg, &message.UnicastPrefix{
+		Key:            "ignored",
+		Action:         "add",
+		RouterIP:       "10.0.0.1",
+		PeerIP:         "192.168.0.1",
+		Prefix:         "192.0.2.0",
+		PrefixLen:      24,
+		IsIPv4:         true,
+		Color:          &color,
+		BaseAttributes: &bgp.BaseAttributes{ASPath: []uint32{65000, 65001}, CommunityList: []string{"65000:1"}},
+	})
+	publish(t, p, bmp.StatsReportMsg, &message.Stats{RouterIP: "10.0.0.1"})
+
+	m, err := stream.Recv()
+	if err != nil {
+		t.Fatalf("Recv() error: %v", err)
+	}
+	u := m.GetUnicastPrefix()
+	if m.GetType() != "unicast_prefix_v4" || string(m.GetKey()) != "key" || u == nil {
+		t.Fatalf("message = %v, want a unicast_prefix_v4 message", m)
+	}
+	if u.GetPrefix() != "192.0.2.0" || u.GetPrefixLen() != 24 || u.GetColor() != 100 || len(u.GetBaseAttrs().GetAsPath()) != 2 || u.GetBaseAttrs().GetCommunityList()[0] != "65000:1" {
+		t.Errorf("unicast prefix = %v", u)
+	}
+	if err := p.PublishMessage(bmp.UnicastPrefixV4Msg, nil, []byte(`{"prefix":"192.0.2.1","prefix_len":32}`)); err != nil {
+		t.Fatal(err)
+	}
+	if m, err = stream.Recv(); err != nil || m.GetUnicastPrefix().GetPrefixLen() != 32 {
+		t.Fatalf("Recv() = %v, %v, want the /32 route", m, err)
+	}
+
+	if m, err = all.Recv(); err != nil || m.GetPeer().GetRemoteAsn() != 65000 {
+		t.Fatalf("Recv() = %v, %v, want the peer message", m, err)
+	}
+	if m, err = all.Recv(); err != nil || m.GetStats().GetRouterIp() != "10.0.0.1" {
+		t.Fatalf("Recv() = %v, %v, want the statistics message", m, err)
+	}
+}
+
+func TestSubscribeAllTypes(t *testing.T) {
+	p, c := newTestPublisher(t, 0)
+	stream := subscribe(t, p, c, &api.SubscribeRequest{})
+	// Every parsed message type published by the producer reaches the
+	// subscriber with its name and a body.
+	want := make(map[string]bool, len(bmp.MessageTypeNames))
+	for msgType, name := range bmp.MessageTypeNames {
+		if err := p.PublishMessage(msgType, []byte(name), []byte(`{}`)); err != nil {
+			t.Fatalf("PublishMessage(%d) error: %v", msgType, err)
+		}
+		want[name] = true
+	}
+	for range bmp.MessageTypeNames {
+		m, err := stream.Recv()
+		if err != nil {
+			t.Fatalf("Recv() error: %v", err)
+		}
+		if !want[m.GetType()] || string(m.GetKey()) != m.GetType() || m.GetBody() == nil {
+			t.Errorf("message = %v, want a message of a remaining type with a body", m)
+		}
+		delete(want, m.GetType())
+	}
+	if len(want) != 0 {
+		t.Errorf("types %v did not reach the subscriber", want)
+	}
+	// The RAW messages are not streamed, unknown types are reported
+	if err := p.PublishMessage(bmp.BMPRawMsg, nil, []byte{0x03}); err != nil {
+		t.Errorf("PublishMessage() of a RAW message error: %v", err)
+	}
+	if err := p.PublishMessage(1000, nil, []byte(`{}`)); err == nil {
+		t.Error("PublishMessage() of an unknown type succeeded")
+	}
+}
+
+func TestSubscribeInvalidRequest(t *testing.T) {
+	_, c := newTestPublisher(t, 0)
+	stream, err := c.Subscribe(context.Background(), &api.SubscribeRequest{Prefixes: []string{"192.0.2.0"}})
+	if err != nil {
+		t.Fatalf("Subscribe() error: %v", err)
+	}
+	if _, err := stream.Recv(); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("Recv() error = %v, want InvalidArgument", err)
+	}
+}
+
+func TestSlowSubscriber(t *testing.T) {
+	p, c := newTestPublisher(t, 16)
+	stream := subscribe(t, p, c, &api.SubscribeRequest{})
+	// The subscriber does not read its stream, the publisher buffer fills up
+	// once the gRPC flow control window is exhausted.
+	m := &message.UnicastPrefix{Action: "add", Prefix: "192.0.2.0", PrefixLen: 24, IsIPv4: true, BaseAttributes: &bgp.BaseAttributes{ASPath: make([]uint32, 64)}}
+	for i := 0; i < 10000; i++ {
+		publish(t, p, bmp.UnicastPrefixV4Msg, m)
+	}
+	for {
+		if _, err := stream.Recv(); err != nil {
+			if status.Code(err) != codes.ResourceExhausted {
+				t.Fatalf("Recv() error = %v, want ResourceExhausted", err)
+			}
+			break
+		}
+	}
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if len(p.subs) != 0 {
+		t.Errorf("%d subscribers left after the slow subscriber was disconnected", len(p.subs))
+	}
+}
+
+func TestStop(t *testing.T) {
+	p, c := newTestPublisher(t, 0)
+	stream := subscribe(t, p, c, &api.SubscribeRequest{})
+	p.Stop()
+	if _, err := stream.Recv(); status.Code(err) != codes.Unavailable {
+		t.Fatalf("Recv() error = %v, want Unavailable", err)
+	}
+	if err := p.PublishMessage(bmp.PeerStateChangeMsg, nil, []byte(`{}`)); err != nil {
+		t.Fatalf("PublishMessage() after Stop() error: %v", err)
+	}
+}
@@ -0,0 +1,506 @@
+// Package hijack detects the announcements of owned prefixes by unauthorised
+// origins, more specific announcements, Multiple Origin AS conflicts and
+// unexpected first hop ASes, and publishes open and close hijack events.
+package hijack
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/message"
+	"github.com/sbezverk/gobmp/pkg/pub"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	maxPrefixFileSize = 4 * 1024 * 1024 // 4 MB
+)
+
+// Event types
+const (
+	// TypeOrigin is an owned prefix announced by an unauthorised origin AS
+	TypeOrigin = "origin"
+	// TypeMoreSpecific is a prefix more specific than the owned prefix max length
+	TypeMoreSpecific = "more_specific"
+	// TypeMOAS is a prefix announced by several origin ASes, not all authorised
+	TypeMOAS = "moas"
+	// TypeFirstHop is an owned prefix received through an unexpected upstream of
+	// an authorised origin AS
+	TypeFirstHop = "first_hop"
+)
+
+// OwnedPrefix is a prefix of the owned-prefix list.
+type OwnedPrefix struct {
+	Prefix string `yaml:"prefix"`
+	// Origins are the authorised origin ASNs
+	Origins []uint32 `yaml:"origins"`
+	// Upstreams are the authorised first hop ASNs, the ASes adjacent to the
+	// origin in the AS_PATH, an empty list disables the first hop check
+	Upstreams []uint32 `yaml:"upstreams,omitempty"`
+	// MaxLength is the longest authorised prefix length, defaults to the
+	// prefix length
+	MaxLength int `yaml:"max_length,omitempty"`
+	network   *net.IPNet
+	length    int
+}
+
+// File defines the format of the owned-prefix list file:
+//
+//	prefixes:
+//	  - prefix: 192.0.2.0/24
+//	    origins: [65000]
+//	    upstreams: [64500, 64501]
+//	    max_length: 24
+type File struct {
+	Prefixes []*OwnedPrefix `yaml:"prefixes"`
+}
+
+// Event is the hijack_event message. An event is opened by the first route
+// showing the anomaly and closed once no route shows it anymore, the route
+// fields describe the route which opened or closed the event.
+type Event struct {
+	// Action is "open" or "close"
+	Action      string   `json:"action"`
+	Type        string   `json:"type"`
+	Prefix      string   `json:"prefix"`
+	OwnedPrefix string   `json:"owned_prefix"`
+	OriginAS    uint32   `json:"origin_as,omitempty"`
+	FirstHopAS  uint32   `json:"first_hop_as,omitempty"`
+	Origins     []uint32 `json:"origins,omitempty"`
+	RouterHash  string   `json:"router_hash,omitempty"`
+	RouterIP    string   `json:"router_ip,omitempty"`
+	PeerIP      string   `json:"peer_ip,omitempty"`
+	PeerASN     uint32   `json:"peer_asn,omitempty"`
+	ASPath      []uint32 `json:"as_path,omitempty"`
+	Timestamp   string   `json:"timestamp,omitempty"`
+}
+
+// Key returns the key identifying an event between its open and close.
+func (e *Event) Key() string {
+	return e.Type + "|" + e.Prefix + "|" + strconv.FormatUint(uint64(e.OriginAS), 10) + "|" + strconv.FormatUint(uint64(e.FirstHopAS), 10)
+}
+
+type routeKey struct {
+	routerIP string
+	peerIP   string
+	rib      string
+	prefix   string
+	pathID   int32
+}
+
+// routeState is a route announcing an owned address space.
+type routeState struct {
+	origin uint32
+	events []string
+}
+
+// openEvent is an open event with the number of routes showing it.
+type openEvent struct {
+	event  *Event
+	routes int
+}
+
+// prefixOrigins counts the routes per origin AS of an announced prefix of the
+// owned address space, for the MOAS detection.
+type prefixOrigins struct {
+	owned *OwnedPrefix
+	asns  map[uint32]int
+}
+
+// Detector watches the Unicast Prefix messages for hijacks of the owned
+// prefixes, it implements message.Observer.
+type Detector struct {
+	mu        sync.Mutex
+	owned     []*OwnedPrefix
+	publisher pub.Publisher
+	routes    map[routeKey]*routeState
+	origins   map[string]*prefixOrigins
+	events    map[string]*openEvent
+}
+
+var _ message.Observer = &Detector{}
+
+// LoadPrefixes reads and validates an owned-prefix list file.
+func LoadPrefixes(path string) ([]*OwnedPrefix, error) {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if fi.Size() > maxPrefixFileSize {
+		return nil, fmt.Errorf("owned prefix file size exceeds the maximum allowed size of %d bytes", maxPrefixFileSize)
+	}
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	f := &File{}
+	if err := yaml.Unmarshal(b, f); err != nil {
+		return nil, fmt.Errorf("failed to parse owned prefix file %s with error: %w", path, err)
+	}
+	for _, p := range f.Prefixes {
+		if err := p.validate(); err != nil {
+			return nil, fmt.Errorf("owned prefix file %s: %w", path, err)
+		}
+	}
+	glog.Infof("loaded %d owned prefixes from %s", len(f.Prefixes), path)
+
+	return f.Prefixes, nil
+}
+
+func (p *OwnedPrefix) validate() error {
+	_, n, err := net.ParseCIDR(p.Prefix)
+	if err != nil {
+		return fmt.Errorf("invalid prefix %q: %w", p.Prefix, err)
+	}
+	p.network = n
+	p.length, _ = n.Mask.Size()
+	if p.MaxLength == 0 {
+		p.MaxLength = p.length
+	}
+	if p.MaxLength < p.length || p.MaxLength > len(n.IP)*8 {
+		return fmt.Errorf("invalid max_length %d of prefix %s", p.MaxLength, p.Prefix)
+	}
+	if len(p.Origins) == 0 {
+		return fmt.Errorf("prefix %s has no authorised origins", p.Prefix)
+	}
+
+	return nil
+}
+
+// New returns a Detector of the hijacks of the owned prefixes, publishing its
+// events to publisher when it is not nil.
+func New(publisher pub.Publisher, owned []*OwnedPrefix) (*Detector, error) {
+	for _, p := range owned {
+		if p.network == nil {
+			if err := p.validate(); err != nil {
+				return nil, err
+			}
+		}
+	}
+	// Longest owned prefix first, a route is checked against the most
+	// specific owned prefix covering it.
+	sort.SliceStable(owned, func(i, j int) bool { return owned[i].length > owned[j].length })
+
+	return &Detector{
+		owned:     owned,
+		publisher: publisher,
+		routes:    make(map[routeKey]*routeState),
+		origins:   make(map[string]*prefixOrigins),
+		events:    make(map[string]*openEvent),
+	}, nil
+}
+
+// Observe checks a produced message.
+func (d *Detector) Observe(msgType int, msg interface{}) {
+	var events []*Event
+	switch m := msg.(type) {
+	case *message.UnicastPrefix:
+		if m.IsEOR {
+			return
+		}
+		events = d.unicast(m)
+	case *message.PeerStateChange:
+		if m.Action == "down" {
+			events = d.peerDown(m)
+		}
+	default:
+		return
+	}
+	d.publish(events)
+}
+
+// covering returns the most specific owned prefix covering prefix/length.
+func (d *Detector) covering(ip net.IP, length int) *OwnedPrefix {
+	for _, p := range d.owned {
+		if p.length <= length && len(p.network.IP) == len(ip) && p.network.Contains(ip) {
+			return p
+		}
+	}
+	return nil
+}
+
+func (d *Detector) unicast(m *message.UnicastPrefix) []*Event {
+	ip := net.ParseIP(m.Prefix)
+	if ip == nil {
+		return nil
+	}
+	if v4 := ip.To4(); v4 != nil && m.IsIPv4 {
+		ip = v4
+	}
+	owned := d.covering(ip, int(m.PrefixLen))
+	if owned == nil {
+		return nil
+	}
+	prefix := m.Prefix + "/" + strconv.Itoa(int(m.PrefixLen))
+	rk := routeKey{
+		routerIP: m.RouterIP,
+		peerIP:   m.PeerIP,
+		rib:      rib(m),
+		prefix:   prefix,
+		pathID:   m.PathID,
+	}
+	ts := m.Timestamp
+	if ts == "" {
+		ts = time.Now().UTC().Format(time.RFC3339Nano)
+	}
+	route := &Event{
+		Prefix:      prefix,
+		OwnedPrefix: owned.Prefix,
+		RouterHash:  m.RouterHash,
+		RouterIP:    m.RouterIP,
+		PeerIP:      m.PeerIP,
+		PeerASN:     m.PeerASN,
+		Timestamp:   ts,
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	old := d.routes[rk]
+	if m.Action == "del" {
+		if old == nil {
+			return nil
+		}
+		delete(d.routes, rk)
+		return d.release(old, route)
+	}
+	if m.BaseAttributes != nil {
+		route.ASPath = m.BaseAttributes.ASPath
+	}
+	rs := &routeState{origin: m.OriginAS}
+	d.routes[rk] = rs
+	// The events of the new route are taken before the events of the route
+	// it replaces are released, an unchanged anomaly stays open.
+	var events []*Event
+	for _, e := range check(owned, int(m.PrefixLen), m.OriginAS, route) {
+		k := e.Key()
+		rs.events = append(rs.events, k)
+		if oe, ok := d.events[k]; ok {
+			oe.routes++
+			continue
+		}
+		d.events[k] = &openEvent{event: e, routes: 1}
+		events = append(events, e)
+	}
+	// The origin of the replaced route is swapped before the MOAS check, a
+	// route changing origin is not a conflict with itself.
+	po := d.prefixOrigins(prefix, owned)
+	if rs.origin != 0 {
+		po.asns[rs.origin]++
+	}
+	if old != nil {
+		events = append(events, d.releaseEvents(old, route)...)
+		po.remove(old.origin)
+	}
+
+	return append(events, d.moas(po, route)...)
+}
+
+func (d *Detector) prefixOrigins(prefix string, owned *OwnedPrefix) *prefixOrigins {
+	po, ok := d.origins[prefix]
+	if !ok {
+		po = &prefixOrigins{owned: owned, asns: make(map[uint32]int)}
+		d.origins[prefix] = po
+	}
+	return po
+}
+
+func (po *prefixOrigins) remove(origin uint32) {
+	if po.asns[origin] == 0 {
+		return
+	}
+	if po.asns[origin]--; po.asns[origin] == 0 {
+		delete(po.asns, origin)
+	}
+}
+
+// check returns the open events of the anomalies of a route of an owned
+// address space.
+func check(owned *OwnedPrefix, length int, origin uint32, route *Event) []*Event {
+	var events []*Event
+	open := func(t string, firstHop uint32) {
+		e := *route
+		e.Action = "open"
+		e.Type = t
+		e.OriginAS = origin
+		e.FirstHopAS = firstHop
+		events = append(events, &e)
+	}
+	if length > owned.MaxLength {
+		open(TypeMoreSpecific, 0)
+	}
+	if origin == 0 {
+		// Locally originated route, the AS_PATH is empty
+		return events
+	}
+	if !contains(owned.Origins, origin) {
+		open(TypeOrigin, 0)
+		return events
+	}
+	if len(owned.Upstreams) == 0 {
+		return events
+	}
+	// The first hop is the AS preceding the origin, skipping its prepends
+	for i := len(route.ASPath) - 1; i >= 0; i-- {
+		if route.ASPath[i] == origin {
+			continue
+		}
+		if !contains(owned.Upstreams, route.ASPath[i]) {
+			open(TypeFirstHop, route.ASPath[i])
+		}
+		break
+	}
+
+	return events
+}
+
+func contains(asns []uint32, asn uint32) bool {
+	for _, a := range asns {
+		if a == asn {
+			return true
+		}
+	}
+	return false
+}
+
+// release releases the events and the origin of a route removed by route,
+// and returns the events it closes.
+func (d *Detector) release(rs *routeState, route *Event) []*Event {
+	events := d.releaseEvents(rs, route)
+	po, ok := d.origins[route.Prefix]
+	if !ok {
+		return events
+	}
+	po.remove(rs.origin)
+
+	return append(events, d.moas(po, route)...)
+}
+
+// releaseEvents releases the events of a route removed or replaced by route,
+// and returns the events it closes.
+func (d *Detector) releaseEvents(rs *routeState, route *Event) []*Event {
+	var events []*Event
+	for _, k := range rs.events {
+		oe, ok := d.events[k]
+		if !ok {
+			continue
+		}
+		if oe.routes--; oe.routes > 0 {
+			continue
+		}
+		delete(d.events, k)
+		events = append(events, closeEvent(oe.event, route))
+	}
+
+	return events
+}
+
+func closeEvent(open *Event, route *Event) *Event {
+	e := *open
+	e.Action = "close"
+	e.RouterHash = route.RouterHash
+	e.RouterIP = route.RouterIP
+	e.PeerIP = route.PeerIP
+	e.PeerASN = route.PeerASN
+	e.ASPath = route.ASPath
+	e.Timestamp = route.Timestamp
+	return &e
+}
+
+// moas opens or closes the MOAS event of the route's prefix, a prefix is in
+// conflict when it is announced by several origins not all authorised.
+func (d *Detector) moas(po *prefixOrigins, route *Event) []*Event {
+	origins := make([]uint32, 0, len(po.asns))
+	conflict := false
+	for asn := range po.asns {
+		origins = append(origins, asn)
+		if !contains(po.owned.Origins, asn) {
+			conflict = true
+		}
+	}
+	conflict = conflict && len(origins) > 1
+	sort.Slice(origins, func(i, j int) bool { return origins[i] < origins[j] })
+	k := (&Event{Type: TypeMOAS, Prefix: route.Prefix}).Key()
+	oe, open := d.events[k]
+	if len(po.asns) == 0 {
+		delete(d.origins, route.Prefix)
+	}
+	switch {
+	case conflict && !open:
+		e := *route
+		e.Action = "open"
+		e.Type = TypeMOAS
+		e.Origins = origins
+		d.events[k] = &openEvent{event: &e, routes: 1}
+		return []*Event{&e}
+	case !conflict && open:
+		delete(d.events, k)
+		e := closeEvent(oe.event, route)
+		e.Origins = origins
+		return []*Event{e}
+	}
+
+	return nil
+}
+
+// peerDown removes the routes of a peer going down and returns the events
+// they close.
+func (d *Detector) peerDown(m *message.PeerStateChange) []*Event {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	var events []*Event
+	for rk := range d.routes {
+		if rk.routerIP != m.RouterIP || rk.peerIP != m.RemoteIP {
+			continue
+		}
+		rs := d.routes[rk]
+		delete(d.routes, rk)
+		events = append(events, d.release(rs, &Event{
+			Prefix:     rk.prefix,
+			RouterHash: m.RouterHash,
+			RouterIP:   m.RouterIP,
+			PeerIP:     m.RemoteIP,
+			PeerASN:    m.RemoteASN,
+			Timestamp:  m.Timestamp,
+		})...)
+	}
+
+	return events
+}
+
+func rib(m *message.UnicastPrefix) string {
+	switch {
+	case m.IsLocRIB:
+		return "loc_rib"
+	case m.IsAdjRIBOut && m.IsAdjRIBOutPost:
+		return "adj_rib_out_post"
+	case m.IsAdjRIBOut:
+		return "adj_rib_out_pre"
+	case m.IsAdjRIBInPost:
+		return "adj_rib_in_post"
+	}
+	return "adj_rib_in_pre"
+}
+
+func (d *Detector) publish(events []*Event) {
+	if d.publisher == nil {
+		return
+	}
+	for _, e := range events {
+		b, err := json.Marshal(e)
+		if err != nil {
+			glog.Errorf("failed to marshal hijack event with error: %+v", err)
+			continue
+		}
+		if err := d.publisher.PublishMessage(bmp.HijackEventMsg, []byte(e.Key()), b); err != nil {
+			glog.Errorf("failed to publish hijack event with error: %+v", err)
+		}
+	}
+}
@@ -0,0 +1,241 @@
+package hijack
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/sbezverk/gobmp/pkg/bgp"
+	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/message"
+)
+
+type capture struct {
+	events []*Event
+}
+
+func (c *capture) PublishMessage(msgType int, msgHash []byte, msg []byte) error {
+	if msgType != bmp.HijackEventMsg {
+		return nil
+	}
+	e := &Event{}
+	if err := json.Unmarshal(msg, e); err != nil {
+		return err
+	}
+	c.events = append(c.events, e)
+	return nil
+}
+
+func (c *capture) Stop() {}
+
+func (c *capture) summary() []string {
+	var s []string
+	for _, e := range c.events {
+		s = append(s, e.Action+" "+e.Type+" "+e.Prefix)
+	}
+	c.events = nil
+	return s
+}
+
+func newDetector(t *testing.T) (*Detector, *capture) {
+	t.Helper()
+	c := &capture{}
+	d, err := New(c, []*OwnedPrefix{
+		{Prefix: "192.0.2.0/24", Origins: []uint32{65000}, Upstreams: []uint32{64500, 64501}},
+		{Prefix: "2001:db8::/32", Origins: []uint32{65000, 65010}, MaxLength: 48},
+	})
+	if err != nil {
+		t.Fatalf("New() error: %v", err)
+	}
+	return d, c
+}
+
+func route(action, peerIP, prefix string, length int32, path ...uint32) *message.UnicastPrefix {
+	u := &message.UnicastPrefix{
+		Action:         action,
+		RouterHash:     "r1",
+		RouterIP:       "10.0.0.1",
+		PeerIP:         peerIP,
+		PeerASN:        64500,
+		Prefix:         prefix,
+		PrefixLen:      length,
+		IsIPv4:         prefix != "" && prefix[0] != '2',
+		BaseAttributes: &bgp.BaseAttributes{ASPath: path},
+	}
+	if len(path) > 0 {
+		u.OriginAS = path[len(path)-1]
+	}
+	return u
+}
+
+func TestLoadPrefixes(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr bool
+	}{
+		{name: "valid", content: "prefixes:\n  - prefix: 192.0.2.0/24\n    origins: [65000]\n    upstreams: [64500]\n    max_length: 25\n"},
+		{name: "invalid prefix", content: "prefixes:\n  - prefix: 192.0.2.0\n    origins: [65000]\n", wantErr: true},
+		{name: "no origins", content: "prefixes:\n  - prefix: 192.0.2.0/24\n", wantErr: true},
+		{name: "max length shorter than prefix", content: "prefixes:\n  - prefix: 192.0.2.0/24\n    origins: [65000]\n    max_length: 16\n", wantErr: true},
+		{name: "max length too long", content: "prefixes:\n  - prefix: 192.0.2.0/24\n    origins: [65000]\n    max_length: 33\n", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "prefixes.yaml")
+			if err := os.WriteFile(path, []byte(tt.content), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			p, err := LoadPrefixes(path)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("LoadPrefixes() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && (len(p) != 1 || p[0].MaxLength != 25) {
+				t.Fatalf("LoadPrefixes() = %+v", p)
+			}
+		})
+	}
+}
+
+func TestDetect(t *testing.T) {
+	tests := []struct {
+		name string
+		msgs []*message.UnicastPrefix
+		want []string
+	}{
+		{
+			name: "authorised route",
+			msgs: []*message.UnicastPrefix{route("add", "192.168.0.1", "192.0.2.0", 24, 64500, 65000, 65000)},
+		},
+		{
+			name: "not owned",
+			msgs: []*message.UnicastPrefix{route("add", "192.168.0.1", "198.51.100.0", 24, 64500, 65999)},
+		},
+		{
+			name: "origin hijack",
+			msgs: []*message.UnicastPrefix{route("add", "192.168.0.1", "192.0.2.0", 24, 64500, 65999)},
+			want: []string{"open origin 192.0.2.0/24"},
+		},
+		{
+			name: "more specific hijack",
+			msgs: []*message.UnicastPrefix{route("add", "192.168.0.1", "192.0.2.128", 25, 64500, 65999)},
+			want: []string{"open more_specific 192.0.2.128/25", "open origin 192.0.2.128/25"},
+		},
+		{
+			name: "more specific within max length",
+			msgs: []*message.UnicastPrefix{route("add", "192.168.0.1", "2001:db8:1::", 48, 64500, 65010)},
+		},
+		{
+			name: "unexpected first hop",
+			msgs: []*message.UnicastPrefix{route("add", "192.168.0.1", "192.0.2.0", 24, 64500, 64666, 65000, 65000)},
+			want: []string{"open first_hop 192.0.2.0/24"},
+		},
+		{
+			name: "moas",
+			msgs: []*message.UnicastPrefix{
+				route("add", "192.168.0.1", "192.0.2.0", 24, 64500, 65000),
+				route("add", "192.168.0.2", "192.0.2.0", 24, 64501, 65999),
+			},
+			want: []string{"open origin 192.0.2.0/24", "open moas 192.0.2.0/24"},
+		},
+		{
+			name: "authorised moas",
+			msgs: []*message.UnicastPrefix{
+				route("add", "192.168.0.1", "2001:db8::", 32, 64500, 65000),
+				route("add", "192.168.0.2", "2001:db8::", 32, 64501, 65010),
+			},
+		},
+		{
+			name: "withdraw closes",
+			msgs: []*message.UnicastPrefix{
+				route("add", "192.168.0.1", "192.0.2.0", 24, 64500, 65000),
+				route("add", "192.168.0.2", "192.0.2.0", 24, 64501, 65999),
+				route("del", "192.168.0.2", "192.0.2.0", 24),
+			},
+			want: []string{"open origin 192.0.2.0/24", "open moas 192.0.2.0/24", "close origin 192.0.2.0/24", "close moas 192.0.2.0/24"},
+		},
+		{
+			name: "duplicate routes are deduplicated",
+			msgs: []*message.UnicastPrefix{
+				route("add", "192.168.0.1", "192.0.2.0", 24, 64500, 65999),
+				route("add", "192.168.0.1", "192.0.2.0", 24, 64500, 65999),
+				route("add", "192.168.0.2", "192.0.2.0", 24, 64501, 65999),
+				route("del", "192.168.0.1", "192.0.2.0", 24),
+				route("del", "192.168.0.1", "192.0.2.0", 24),
+				route("del", "192.168.0.2", "192.0.2.0", 24),
+			},
+			want: []string{"open origin 192.0.2.0/24", "close origin 192.0.2.0/24"},
+		},
+		{
+			name: "replaced route closes",
+			msgs: []*message.UnicastPrefix{
+				route("add", "192.168.0.1", "192.0.2.0", 24, 64500, 65999),
+				route("add", "192.168.0.1", "192.0.2.0", 24, 64500, 65000),
+			},
+			want: []string{"open origin 192.0.2.0/24", "close origin 192.0.2.0/24"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, c := newDetector(t)
+			for _, m := range tt.msgs {
+				d.Observe(bmp.UnicastPrefixV4Msg, m)
+			}
+			if got := c.summary(); !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("events = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEventDetails(t *testing.T) {
+	d, c := newDetector(t)
+	d.Observe(bmp.UnicastPrefixV4Msg, route("add", "192.168.0.1", "192.0.2.0", 24, 64500, 64666, 65000))
+	if len(c.events) != 1 {
+		t.Fatalf("got %d events, want 1", len(c.events))
+	}
+	want := &Event{
+		Action:      "open",
+		Type:        TypeFirstHop,
+		Prefix:      "192.0.2.0/24",
+		OwnedPrefix: "192.0.2.0/24",
+		OriginAS:    65000,
+		FirstHopAS:  64666,
+		RouterHash:  "r1",
+		RouterIP:    "10.0.0.1",
+		PeerIP:      "192.168.0.1",
+		PeerASN:     64500,
+		ASPath:      []uint32{64500, 64666, 65000},
+	}
+	got := c.events[0]
+	got.Timestamp = ""
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("event = %+v, want %+v", got, want)
+	}
+}
+
+func TestPeerDownCloses(t *testing.T) {
+	d, c := newDetector(t)
+	d.Observe(bmp.UnicastPrefixV4Msg, route("add", "192.168.0.1", "192.0.2.0", 24, 64500, 65000))
+	d.Observe(bmp.UnicastPrefixV4Msg, route("add", "192.168.0.2", "192.0.2.0", 24, 64501, 65999))
+	d.Observe(bmp.UnicastPrefixV4Msg, route("add", "192.168.0.2", "192.0.2.128", 25, 64501, 65000))
+	c.summary()
+	d.Observe(bmp.PeerStateChangeMsg, &message.PeerStateChange{Action: "down", RouterIP: "10.0.0.1", RemoteIP: "192.168.0.2"})
+	got := make(map[string]bool)
+	for _, s := range c.summary() {
+		got[s] = true
+	}
+	want := map[string]bool{
+		"close origin 192.0.2.0/24":          true,
+		"close moas 192.0.2.0/24":            true,
+		"close more_specific 192.0.2.128/25": true,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("events = %v, want %v", got, want)
+	}
+	if len(d.routes) != 1 || len(d.events) != 0 || len(d.origins) != 1 {
+		t.Fatalf("state after peer down: %d routes, %d events, %d origins", len(d.routes), len(d.events), len(d.origins))
+	}
+}
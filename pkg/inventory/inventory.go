@@ -0,0 +1,292 @@
+// Package inventory attaches user defined labels, such as the site or the role
+// of a router or the description of a peer, to the parsed messages. The labels
+// are loaded from a YAML or CSV inventory file keyed by router address, peer
+// address, peer ASN or Route Distinguisher, and reloaded when the file changes.
+package inventory
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/golang/glog"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	maxFileSize = 16 * 1024 * 1024 // 16 MB
+	// DefaultReloadInterval is the period the inventory file is checked for
+	// changes when no interval is configured.
+	DefaultReloadInterval = 30 * time.Second
+)
+
+// File defines the format of the YAML inventory file, the labels per router
+// address, peer address, peer ASN and Route Distinguisher:
+//
+//	routers:
+//	  10.0.0.1: {site: paris, region: eu-west, role: pe}
+//	peers:
+//	  192.0.2.1: {description: transit-a}
+//	peer_asns:
+//	  65001: {customer: acme}
+//	rds:
+//	  "65000:100": {customer: acme}
+//
+// A CSV inventory file, selected by its .csv extension, has a header row whose
+// first two columns are the key type (router, peer, peer_asn or rd) and the key,
+// the other columns are the label names. Empty cells are not labels.
+//
+//	type,key,site,description
+//	router,10.0.0.1,paris,
+//	peer,192.0.2.1,,transit-a
+type File struct {
+	Routers  map[string]map[string]string `yaml:"routers"`
+	Peers    map[string]map[string]string `yaml:"peers"`
+	PeerASNs map[uint32]map[string]string `yaml:"peer_asns"`
+	RDs      map[string]map[string]string `yaml:"rds"`
+}
+
+// Keys are the keys of a message looked up in the inventory, the zero values
+// are not looked up.
+type Keys struct {
+	Router  netip.Addr
+	Peer    netip.Addr
+	PeerASN uint32
+	RD      string
+}
+
+type tables struct {
+	routers map[netip.Addr]map[string]string
+	peers   map[netip.Addr]map[string]string
+	asns    map[uint32]map[string]string
+	rds     map[string]map[string]string
+}
+
+// Inventory holds the labels of an inventory file, it is safe for concurrent
+// use. A nil Inventory has no labels.
+type Inventory struct {
+	path   string
+	tables atomic.Pointer[tables]
+	// modTime and size identify the loaded version of the file
+	modTime time.Time
+	size    int64
+	stopCh  chan struct{}
+	doneCh  chan struct{}
+}
+
+// Load returns the inventory of the file path.
+func Load(path string) (*Inventory, error) {
+	i := &Inventory{path: path}
+	if _, err := i.Reload(); err != nil {
+		return nil, err
+	}
+	return i, nil
+}
+
+// Reload loads the inventory file again when it changed since it was last
+// loaded and returns true when it did. The labels loaded so far are kept when
+// the file can not be loaded.
+func (i *Inventory) Reload() (bool, error) {
+	fi, err := os.Stat(i.path)
+	if err != nil {
+		return false, err
+	}
+	if i.tables.Load() != nil && fi.ModTime().Equal(i.modTime) && fi.Size() == i.size {
+		return false, nil
+	}
+	if fi.Size() > maxFileSize {
+		return false, fmt.Errorf("inventory file size exceeds the maximum allowed size of %d bytes", maxFileSize)
+	}
+	b, err := os.ReadFile(i.path)
+	if err != nil {
+		return false, err
+	}
+	f := &File{}
+	if strings.EqualFold(filepath.Ext(i.path), ".csv") {
+		err = parseCSV(b, f)
+	} else {
+		err = yaml.Unmarshal(b, f)
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to parse inventory file %s with error: %w", i.path, err)
+	}
+	t, err := newTables(f)
+	if err != nil {
+		return false, fmt.Errorf("inventory file %s: %w", i.path, err)
+	}
+	i.tables.Store(t)
+	i.modTime, i.size = fi.ModTime(), fi.Size()
+	glog.Infof("loaded the labels of %d routers, %d peers, %d peer ASNs and %d RDs from %s",
+		len(t.routers), len(t.peers), len(t.asns), len(t.rds), i.path)
+
+	return true, nil
+}
+
+func newTables(f *File) (*tables, error) {
+	t := &tables{
+		routers: make(map[netip.Addr]map[string]string, len(f.Routers)),
+		peers:   make(map[netip.Addr]map[string]string, len(f.Peers)),
+		asns:    make(map[uint32]map[string]string, len(f.PeerASNs)),
+		rds:     make(map[string]map[string]string, len(f.RDs)),
+	}
+	for k, l := range f.Routers {
+		a, err := netip.ParseAddr(strings.TrimSpace(k))
+		if err != nil {
+			return nil, fmt.Errorf("invalid router address %q", k)
+		}
+		t.routers[a.Unmap()] = l
+	}
+	for k, l := range f.Peers {
+		a, err := netip.ParseAddr(strings.TrimSpace(k))
+		if err != nil {
+			return nil, fmt.Errorf("invalid peer address %q", k)
+		}
+		t.peers[a.Unmap()] = l
+	}
+	for k, l := range f.PeerASNs {
+		t.asns[k] = l
+	}
+	for k, l := range f.RDs {
+		t.rds[strings.TrimSpace(k)] = l
+	}
+	return t, nil
+}
+
+// parseCSV adds the labels of the CSV inventory b to f.
+func parseCSV(b []byte, f *File) error {
+	r := csv.NewReader(bytes.NewReader(b))
+	r.TrimLeadingSpace = true
+	r.Comment = '#'
+	header, err := r.Read()
+	if err != nil {
+		return err
+	}
+	if len(header) < 3 {
+		return fmt.Errorf("the header has no label column")
+	}
+	for {
+		rec, err := r.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		labels := make(map[string]string)
+		for c := 2; c < len(rec); c++ {
+			if v := strings.TrimSpace(rec[c]); v != "" {
+				labels[strings.TrimSpace(header[c])] = v
+			}
+		}
+		key := strings.TrimSpace(rec[1])
+		switch strings.TrimSpace(rec[0]) {
+		case "router":
+			f.Routers = add(f.Routers, key, labels)
+		case "peer":
+			f.Peers = add(f.Peers, key, labels)
+		case "peer_asn":
+			asn, err := strconv.ParseUint(key, 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid peer ASN %q", key)
+			}
+			f.PeerASNs = add(f.PeerASNs, uint32(asn), labels)
+		case "rd":
+			f.RDs = add(f.RDs, key, labels)
+		default:
+			return fmt.Errorf("unknown key type %q", rec[0])
+		}
+	}
+}
+
+// add merges labels into the labels of key in m.
+func add[K comparable](m map[K]map[string]string, key K, labels map[string]string) map[K]map[string]string {
+	if m == nil {
+		m = make(map[K]map[string]string)
+	}
+	if m[key] == nil {
+		m[key] = labels
+		return m
+	}
+	for n, v := range labels {
+		m[key][n] = v
+	}
+	return m
+}
+
+// Labels returns the labels of a message with the keys k, nil when it has
+// none. The labels of the peer ASN override the labels of the router, the
+// labels of the peer override both and the labels of the RD override all.
+func (i *Inventory) Labels(k *Keys) map[string]string {
+	if i == nil {
+		return nil
+	}
+	t := i.tables.Load()
+	var labels map[string]string
+	merge := func(l map[string]string) {
+		if len(l) == 0 {
+			return
+		}
+		if labels == nil {
+			labels = make(map[string]string, len(l))
+		}
+		for n, v := range l {
+			labels[n] = v
+		}
+	}
+	if k.Router.IsValid() {
+		merge(t.routers[k.Router.Unmap()])
+	}
+	if k.PeerASN != 0 {
+		merge(t.asns[k.PeerASN])
+	}
+	if k.Peer.IsValid() {
+		merge(t.peers[k.Peer.Unmap()])
+	}
+	if k.RD != "" {
+		merge(t.rds[k.RD])
+	}
+	return labels
+}
+
+// Start reloads the inventory file every interval when it changed,
+// DefaultReloadInterval when interval is zero.
+func (i *Inventory) Start(interval time.Duration) {
+	if interval == 0 {
+		interval = DefaultReloadInterval
+	}
+	i.stopCh = make(chan struct{})
+	i.doneCh = make(chan struct{})
+	go func() {
+		defer close(i.doneCh)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				if _, err := i.Reload(); err != nil {
+					glog.Errorf("failed to reload inventory file with error: %+v", err)
+				}
+			case <-i.stopCh:
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops the reloads of the inventory file.
+func (i *Inventory) Stop() {
+	if i == nil || i.stopCh == nil {
+		return
+	}
+	close(i.stopCh)
+	<-i.doneCh
+	i.stopCh = nil
+}
@@ -0,0 +1,152 @@
+package inventory
+
+import (
+	"net/netip"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+const yamlInventory = `
+routers:
+  10.0.0.1: {site: paris, region: eu-west, role: pe}
+peers:
+  192.0.2.1: {description: transit-a, role: transit}
+peer_asns:
+  65001: {customer: acme, region: eu}
+rds:
+  "65000:100": {customer: globex}
+`
+
+const csvInventory = `type,key,site,region,role,description,customer
+# routers
+router,10.0.0.1,paris,eu-west,pe,,
+peer,192.0.2.1,,,transit,transit-a,
+peer_asn,65001,,eu,,,acme
+rd,65000:100,,,,,globex
+`
+
+func write(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLabels(t *testing.T) {
+	tests := []struct {
+		name string
+		keys Keys
+		want map[string]string
+	}{
+		{
+			name: "router",
+			keys: Keys{Router: netip.MustParseAddr("10.0.0.1")},
+			want: map[string]string{"site": "paris", "region": "eu-west", "role": "pe"},
+		},
+		{
+			name: "peer asn overrides router",
+			keys: Keys{Router: netip.MustParseAddr("10.0.0.1"), PeerASN: 65001},
+			want: map[string]string{"site": "paris", "region": "eu", "role": "pe", "customer": "acme"},
+		},
+		{
+			name: "peer and rd override all",
+			keys: Keys{Router: netip.MustParseAddr("10.0.0.1"), Peer: netip.MustParseAddr("192.0.2.1"), PeerASN: 65001, RD: "65000:100"},
+			want: map[string]string{"site": "paris", "region": "eu", "role": "transit", "description": "transit-a", "customer": "globex"},
+		},
+		{
+			name: "mapped address",
+			keys: Keys{Peer: netip.MustParseAddr("::ffff:192.0.2.1")},
+			want: map[string]string{"description": "transit-a", "role": "transit"},
+		},
+		{
+			name: "unknown",
+			keys: Keys{Router: netip.MustParseAddr("10.0.0.2"), PeerASN: 65002},
+		},
+	}
+	for _, file := range []struct{ name, content string }{
+		{"inventory.yaml", yamlInventory},
+		{"inventory.csv", csvInventory},
+	} {
+		i, err := Load(write(t, file.name, file.content))
+		if err != nil {
+			t.Fatalf("Load(%s) error: %v", file.name, err)
+		}
+		for _, tt := range tests {
+			t.Run(file.name+"/"+tt.name, func(t *testing.T) {
+				if got := i.Labels(&tt.keys); !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("Labels() = %v, want %v", got, tt.want)
+				}
+			})
+		}
+	}
+	var i *Inventory
+	if got := i.Labels(&Keys{PeerASN: 65001}); got != nil {
+		t.Errorf("nil inventory Labels() = %v, want nil", got)
+	}
+}
+
+func TestReload(t *testing.T) {
+	path := write(t, "inventory.yaml", yamlInventory)
+	i, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load() error: %v", err)
+	}
+	if changed, err := i.Reload(); err != nil || changed {
+		t.Errorf("Reload() of an unchanged file = %t, %v", changed, err)
+	}
+	keys := &Keys{Router: netip.MustParseAddr("10.0.0.1")}
+	update := func(content string, mtime time.Time) {
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Chtimes(path, mtime, mtime); err != nil {
+			t.Fatal(err)
+		}
+	}
+	update("routers:\n  10.0.0.1: {site: lyon}\n", time.Now().Add(time.Minute))
+	if changed, err := i.Reload(); err != nil || !changed {
+		t.Fatalf("Reload() of a changed file = %t, %v", changed, err)
+	}
+	if got := i.Labels(keys); !reflect.DeepEqual(got, map[string]string{"site": "lyon"}) {
+		t.Errorf("Labels() after reload = %v", got)
+	}
+	// The labels are kept when the file is invalid
+	update("routers:\n  router1: {site: nice}\n", time.Now().Add(2*time.Minute))
+	if _, err := i.Reload(); err == nil {
+		t.Error("Reload() expected error for an invalid file")
+	}
+	if got := i.Labels(keys); !reflect.DeepEqual(got, map[string]string{"site": "lyon"}) {
+		t.Errorf("Labels() after failed reload = %v", got)
+	}
+}
+
+func TestLoadErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		file    string
+		content string
+	}{
+		{name: "router address", file: "inventory.yaml", content: "routers:\n  router1: {site: paris}\n"},
+		{name: "peer address", file: "inventory.yaml", content: "peers:\n  10.0.0.0/8: {site: paris}\n"},
+		{name: "yaml", file: "inventory.yaml", content: "routers: [10.0.0.1]\n"},
+		{name: "csv key type", file: "inventory.csv", content: "type,key,site\nhost,10.0.0.1,paris\n"},
+		{name: "csv peer asn", file: "inventory.csv", content: "type,key,site\npeer_asn,AS65001,paris\n"},
+		{name: "csv header", file: "inventory.csv", content: "type,key\nrouter,10.0.0.1\n"},
+		{name: "csv columns", file: "inventory.csv", content: "type,key,site\nrouter,10.0.0.1\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Load(write(t, tt.file, tt.content)); err == nil {
+				t.Error("Load() expected error")
+			}
+		})
+	}
+	if _, err := Load(filepath.Join(t.TempDir(), "missing.yaml")); err == nil {
+		t.Error("Load() expected error for a missing file")
+	}
+}
@@ -1,9 +1,23 @@
 package kafka
 
+import (
+	"github.com/sbezverk/gobmp/pkg/avro"
+	"github.com/sbezverk/gobmp/pkg/pub"
+)
+
 type Config struct {
 	ServerAddress        string
 	TopicRetentionTimeMs string
 	// TopicPrefix, when set, is prepended to all Kafka topic names.
 	// Example: TopicPrefix="prod" -> "prod.gobmp.parsed.peer"
 	TopicPrefix string
+	// Encoding is the encoding of the parsed messages, JSON when not set. The
+	// records carry it in their content-type header.
+	Encoding pub.Encoding
+	// SchemaRegistry is the URL of the Confluent compatible schema registry of
+	// the avro encoding, SubjectStrategy names the subjects of the topics and
+	// AutoRegisterSchemas registers the schemas rather than looking them up.
+	SchemaRegistry      string
+	SubjectStrategy     avro.SubjectStrategy
+	AutoRegisterSchemas bool
 }
@@ -1,6 +1,7 @@
 package kafka
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
@@ -10,10 +11,13 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/IBM/sarama"
 	"github.com/golang/glog"
+	"github.com/sbezverk/gobmp/pkg/api"
+	"github.com/sbezverk/gobmp/pkg/avro"
 	"github.com/sbezverk/gobmp/pkg/bmp"
 	"github.com/sbezverk/gobmp/pkg/pub"
 )
@@ -40,7 +44,26 @@ const (
 	FlowspecMessageV6Topic = "gobmp.parsed.flowspec_v6"
 	VPLSMessageTopic       = "gobmp.parsed.vpls"
 	StatsMessageTopic      = "gobmp.parsed.statistics"
+	LSTopologyChangeTopic  = "gobmp.parsed.ls_topology_change"
+	SRPolicyResolvedTopic  = "gobmp.parsed.sr_policy_resolved"
+	ChurnStatsTopic        = "gobmp.parsed.churn_stats"
+	FlapEventTopic         = "gobmp.parsed.flap_event"
+	HijackEventTopic       = "gobmp.parsed.hijack_event"
+	RouteLeakTopic         = "gobmp.parsed.route_leak"
+	PeerSyncTopic          = "gobmp.parsed.peer_sync"
+	BaseAttributeTopic     = "gobmp.parsed.base_attribute"
 	RawMessageTopic        = "gobmp.raw"
+	// The topics of the OpenBMP parsed messages
+	OpenBMPCollectorTopic     = "openbmp.parsed.collector"
+	OpenBMPRouterTopic        = "openbmp.parsed.router"
+	OpenBMPPeerTopic          = "openbmp.parsed.peer"
+	OpenBMPBaseAttributeTopic = "openbmp.parsed.base_attribute"
+	OpenBMPUnicastPrefixTopic = "openbmp.parsed.unicast_prefix"
+	OpenBMPL3VPNTopic         = "openbmp.parsed.l3vpn"
+	OpenBMPLSNodeTopic        = "openbmp.parsed.ls_node"
+	OpenBMPLSLinkTopic        = "openbmp.parsed.ls_link"
+	OpenBMPLSPrefixTopic      = "openbmp.parsed.ls_prefix"
+	OpenBMPStatTopic          = "openbmp.parsed.bmp_stat"
 )
 
 var (
@@ -74,7 +97,25 @@ var (
 		FlowspecMessageV6Topic,
 		VPLSMessageTopic,
 		StatsMessageTopic,
+		LSTopologyChangeTopic,
+		SRPolicyResolvedTopic,
+		ChurnStatsTopic,
+		FlapEventTopic,
+		HijackEventTopic,
+		RouteLeakTopic,
+		PeerSyncTopic,
+		BaseAttributeTopic,
 		RawMessageTopic,
+		OpenBMPCollectorTopic,
+		OpenBMPRouterTopic,
+		OpenBMPPeerTopic,
+		OpenBMPBaseAttributeTopic,
+		OpenBMPUnicastPrefixTopic,
+		OpenBMPL3VPNTopic,
+		OpenBMPLSNodeTopic,
+		OpenBMPLSLinkTopic,
+		OpenBMPLSPrefixTopic,
+		OpenBMPStatTopic,
 	}
 )
 
@@ -84,71 +125,195 @@ type publisher struct {
 	producer     sarama.AsyncProducer
 	stopCh       chan struct{}
 	topicPrefix  string
+	encoding     pub.Encoding
+	// registry encodes the parsed messages with the avro encoding
+	registry *avro.Registry
+	// subtopics holds the sub-topics already ensured
+	subtopics sync.Map
 }
 
-func (p *publisher) PublishMessage(t int, key []byte, msg []byte) error {
+// topicForMessage maps a BMP message type to its Kafka topic, without the
+// topic prefix. Returns ("", false) for unknown types.
+func topicForMessage(t int) (string, bool) {
 	switch t {
 	case bmp.PeerStateChangeMsg:
-		return p.produceMessage(WithTopicPrefix(p.topicPrefix, PeerTopic), key, msg)
+		return PeerTopic, true
 	case bmp.UnicastPrefixMsg:
-		return p.produceMessage(WithTopicPrefix(p.topicPrefix, UnicastMessageTopic), key, msg)
+		return UnicastMessageTopic, true
 	case bmp.UnicastPrefixV4Msg:
-		return p.produceMessage(WithTopicPrefix(p.topicPrefix, UnicastMessageV4Topic), key, msg)
+		return UnicastMessageV4Topic, true
 	case bmp.UnicastPrefixV6Msg:
-		return p.produceMessage(WithTopicPrefix(p.topicPrefix, UnicastMessageV6Topic), key, msg)
+		return UnicastMessageV6Topic, true
 	case bmp.LSNodeMsg:
-		return p.produceMessage(WithTopicPrefix(p.topicPrefix, LSNodeMessageTopic), key, msg)
+		return LSNodeMessageTopic, true
 	case bmp.LSLinkMsg:
-		return p.produceMessage(WithTopicPrefix(p.topicPrefix, LSLinkMessageTopic), key, msg)
+		return LSLinkMessageTopic, true
 	case bmp.L3VPNMsg:
-		return p.produceMessage(WithTopicPrefix(p.topicPrefix, L3vpnMessageTopic), key, msg)
+		return L3vpnMessageTopic, true
 	case bmp.L3VPNV4Msg:
-		return p.produceMessage(WithTopicPrefix(p.topicPrefix, L3vpnMessageV4Topic), key, msg)
+		return L3vpnMessageV4Topic, true
 	case bmp.L3VPNV6Msg:
-		return p.produceMessage(WithTopicPrefix(p.topicPrefix, L3vpnMessageV6Topic), key, msg)
+		return L3vpnMessageV6Topic, true
 	case bmp.LSPrefixMsg:
-		return p.produceMessage(WithTopicPrefix(p.topicPrefix, LSPrefixMessageTopic), key, msg)
+		return LSPrefixMessageTopic, true
 	case bmp.LSSRv6SIDMsg:
-		return p.produceMessage(WithTopicPrefix(p.topicPrefix, LSSRv6SIDMessageTopic), key, msg)
+		return LSSRv6SIDMessageTopic, true
 	case bmp.EVPNMsg:
-		return p.produceMessage(WithTopicPrefix(p.topicPrefix, EVPNMessageTopic), key, msg)
+		return EVPNMessageTopic, true
 	case bmp.SRPolicyMsg:
-		return p.produceMessage(WithTopicPrefix(p.topicPrefix, SRPolicyMessageTopic), key, msg)
+		return SRPolicyMessageTopic, true
 	case bmp.SRPolicyV4Msg:
-		return p.produceMessage(WithTopicPrefix(p.topicPrefix, SRPolicyMessageV4Topic), key, msg)
+		return SRPolicyMessageV4Topic, true
 	case bmp.SRPolicyV6Msg:
-		return p.produceMessage(WithTopicPrefix(p.topicPrefix, SRPolicyMessageV6Topic), key, msg)
+		return SRPolicyMessageV6Topic, true
 	case bmp.FlowspecMsg:
-		return p.produceMessage(WithTopicPrefix(p.topicPrefix, FlowspecMessageTopic), key, msg)
+		return FlowspecMessageTopic, true
 	case bmp.FlowspecV4Msg:
-		return p.produceMessage(WithTopicPrefix(p.topicPrefix, FlowspecMessageV4Topic), key, msg)
+		return FlowspecMessageV4Topic, true
 	case bmp.FlowspecV6Msg:
-		return p.produceMessage(WithTopicPrefix(p.topicPrefix, FlowspecMessageV6Topic), key, msg)
+		return FlowspecMessageV6Topic, true
 	case bmp.VPLSMsg:
-		return p.produceMessage(WithTopicPrefix(p.topicPrefix, VPLSMessageTopic), key, msg)
+		return VPLSMessageTopic, true
 	case bmp.StatsReportMsg:
-		return p.produceMessage(WithTopicPrefix(p.topicPrefix, StatsMessageTopic), key, msg)
+		return StatsMessageTopic, true
+	case bmp.LSTopologyChangeMsg:
+		return LSTopologyChangeTopic, true
+	case bmp.SRPolicyResolvedMsg:
+		return SRPolicyResolvedTopic, true
+	case bmp.ChurnStatsMsg:
+		return ChurnStatsTopic, true
+	case bmp.FlapEventMsg:
+		return FlapEventTopic, true
+	case bmp.HijackEventMsg:
+		return HijackEventTopic, true
+	case bmp.RouteLeakMsg:
+		return RouteLeakTopic, true
+	case bmp.PeerSyncMsg:
+		return PeerSyncTopic, true
+	case bmp.BaseAttributeMsg:
+		return BaseAttributeTopic, true
 	case bmp.BMPRawMsg:
-		return p.produceMessage(WithTopicPrefix(p.topicPrefix, RawMessageTopic), key, msg)
+		return RawMessageTopic, true
+	case bmp.OpenBMPCollectorMsg:
+		return OpenBMPCollectorTopic, true
+	case bmp.OpenBMPRouterMsg:
+		return OpenBMPRouterTopic, true
+	case bmp.OpenBMPPeerMsg:
+		return OpenBMPPeerTopic, true
+	case bmp.OpenBMPBaseAttributeMsg:
+		return OpenBMPBaseAttributeTopic, true
+	case bmp.OpenBMPUnicastPrefixMsg:
+		return OpenBMPUnicastPrefixTopic, true
+	case bmp.OpenBMPL3VPNMsg:
+		return OpenBMPL3VPNTopic, true
+	case bmp.OpenBMPLSNodeMsg:
+		return OpenBMPLSNodeTopic, true
+	case bmp.OpenBMPLSLinkMsg:
+		return OpenBMPLSLinkTopic, true
+	case bmp.OpenBMPLSPrefixMsg:
+		return OpenBMPLSPrefixTopic, true
+	case bmp.OpenBMPStatMsg:
+		return OpenBMPStatTopic, true
 	}
+	return "", false
+}
 
-	return fmt.Errorf("not implemented")
+func (p *publisher) PublishMessage(t int, key []byte, msg []byte) error {
+	topic, err := p.topic(t, "")
+	if err != nil {
+		return err
+	}
+	return p.produceMessage(topic, t, key, msg)
 }
 
-func (p *publisher) produceMessage(topic string, key []byte, msg []byte) error {
-	var k sarama.ByteEncoder
-	var m sarama.ByteEncoder
-	k = key
-	m = msg
-	p.producer.Input() <- &sarama.ProducerMessage{
-		Topic: topic,
-		Key:   k,
-		Value: m,
+// PublishMessageToSubtopic publishes a message to a sub-topic of the message
+// type's topic, such as a per-VRF L3VPN topic. Sub-topics are created on their
+// first use.
+func (p *publisher) PublishMessageToSubtopic(t int, subtopic string, key []byte, msg []byte) error {
+	topic, err := p.topic(t, subtopic)
+	if err != nil {
+		return err
+	}
+	return p.produceMessage(topic, t, key, msg)
+}
+
+// PublishStruct publishes a parsed message to its topic or to a sub-topic of
+// it, the protobuf encoding is built from the message struct, the other
+// encodings from its JSON encoding.
+func (p *publisher) PublishStruct(t int, subtopic string, key []byte, msg interface{}) error {
+	topic, err := p.topic(t, subtopic)
+	if err != nil {
+		return err
+	}
+	if p.encoding != pub.EncodingProtobuf {
+		b, err := json.Marshal(msg)
+		if err != nil {
+			return err
+		}
+		return p.produceMessage(topic, t, key, b)
+	}
+	b, err := api.Marshal(t, msg)
+	if err != nil {
+		return err
+	}
+	p.producer.Input() <- newProducerMessage(topic, t, p.encoding, key, b)
+
+	return nil
+}
+
+// topic returns the topic of the message type t, or its sub-topic subtopic
+// when set, which is created on its first use.
+func (p *publisher) topic(t int, subtopic string) (string, error) {
+	topic, ok := topicForMessage(t)
+	if !ok {
+		return "", fmt.Errorf("not implemented")
+	}
+	topic = WithTopicPrefix(p.topicPrefix, topic)
+	if subtopic == "" {
+		return topic, nil
+	}
+	topic = pub.SubtopicName(topic, subtopic)
+	if _, ok := p.subtopics.Load(topic); !ok {
+		if err := ensureTopic(p.clusterAdmin, topicCreateTimeout, topic); err != nil {
+			return "", fmt.Errorf("failed to ensure topic %s with error: %w", topic, err)
+		}
+		p.subtopics.Store(topic, struct{}{})
+	}
+	return topic, nil
+}
+
+func (p *publisher) produceMessage(topic string, t int, key []byte, msg []byte) error {
+	var m []byte
+	var err error
+	if p.encoding == pub.EncodingAvro && t != bmp.BMPRawMsg && !bmp.IsOpenBMPMsg(t) {
+		m, err = p.registry.Encode(topic, t, msg)
+	} else {
+		m, err = api.Encode(p.encoding, t, msg)
+	}
+	if err != nil {
+		return err
 	}
+	p.producer.Input() <- newProducerMessage(topic, t, p.encoding, key, m)
 
 	return nil
 }
 
+// newProducerMessage returns the record of a message, the records of the parsed
+// messages carry the content type of their encoding, the raw and OpenBMP
+// messages are published without it.
+func newProducerMessage(topic string, t int, enc pub.Encoding, key []byte, msg []byte) *sarama.ProducerMessage {
+	m := &sarama.ProducerMessage{
+		Topic: topic,
+		Key:   sarama.ByteEncoder(key),
+		Value: sarama.ByteEncoder(msg),
+	}
+	if t != bmp.BMPRawMsg && !bmp.IsOpenBMPMsg(t) {
+		m.Headers = []sarama.RecordHeader{{Key: []byte("content-type"), Value: []byte(enc.ContentType())}}
+	}
+
+	return m
+}
+
 func (p *publisher) Stop() {
 	close(p.stopCh)
 	_ = p.clusterAdmin.Close()
@@ -161,6 +326,14 @@ func NewKafkaPublisher(kConfig *Config) (pub.Publisher, error) {
 		glog.Errorf("Failed to validate Kafka config: %v with error: %+v", kConfig, err)
 		return nil, err
 	}
+	var registry *avro.Registry
+	if kConfig.Encoding == pub.EncodingAvro {
+		var err error
+		if registry, err = avro.NewRegistry(kConfig.SchemaRegistry, kConfig.SubjectStrategy, kConfig.AutoRegisterSchemas); err != nil {
+			glog.Errorf("Failed to initialize the schema registry client with error: %+v", err)
+			return nil, err
+		}
+	}
 	if glog.V(6) {
 		sarama.Logger = log.New(os.Stdout, "[sarama]      ", log.LstdFlags)
 	}
@@ -221,6 +394,8 @@ func NewKafkaPublisher(kConfig *Config) (pub.Publisher, error) {
 		config:       config,
 		producer:     producer,
 		topicPrefix:  kConfig.TopicPrefix,
+		encoding:     kConfig.Encoding,
+		registry:     registry,
 	}, nil
 }
 
@@ -0,0 +1,172 @@
+package message
+
+import (
+	"container/list"
+	"fmt"
+	"sync"
+
+	"github.com/sbezverk/gobmp/pkg/bgp"
+	"github.com/sbezverk/gobmp/pkg/bmp"
+)
+
+// DefaultBaseAttributeCacheSize is the number of recently published base
+// attribute hashes remembered per router when no cache size is configured.
+const DefaultBaseAttributeCacheSize = 100000
+
+// BaseAttribute defines the base_attribute message published once per router
+// for each unique set of path attributes, the Unicast and L3VPN prefix
+// messages reference it by its base_attr_hash. The peer is the peer of the
+// first prefix carrying the attributes.
+type BaseAttribute struct {
+	RouterHash     string              `json:"router_hash,omitempty"`
+	RouterIP       string              `json:"router_ip,omitempty"`
+	PeerHash       string              `json:"peer_hash,omitempty"`
+	PeerIP         string              `json:"peer_ip,omitempty"`
+	PeerASN        uint32              `json:"peer_asn,omitempty"`
+	BaseAttrHash   string              `json:"base_attr_hash"`
+	BaseAttributes *bgp.BaseAttributes `json:"base_attrs,omitempty"`
+	Timestamp      string              `json:"timestamp,omitempty"`
+	// UserLabels are the labels added by the publishing rules and the inventory
+	UserLabels map[string]string `json:"user_labels,omitempty"`
+}
+
+// hashCache is a LRU set of the base attribute hashes already published.
+type hashCache struct {
+	mu    sync.Mutex
+	size  int
+	order *list.List
+	items map[string]*list.Element
+}
+
+func newHashCache(size int) *hashCache {
+	if size <= 0 {
+		size = DefaultBaseAttributeCacheSize
+	}
+	return &hashCache{
+		size:  size,
+		order: list.New(),
+		items: make(map[string]*list.Element),
+	}
+}
+
+// add returns true when key was not in the cache, the least recently used key
+// is evicted when the cache is full.
+func (c *hashCache) add(key string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.items[key]; ok {
+		c.order.MoveToFront(e)
+		return false
+	}
+	c.items[key] = c.order.PushFront(key)
+	if c.order.Len() > c.size {
+		e := c.order.Back()
+		c.order.Remove(e)
+		delete(c.items, e.Value.(string))
+	}
+	return true
+}
+
+// remove removes key from the cache, for the hashes which failed to publish.
+func (c *hashCache) remove(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.items[key]; ok {
+		c.order.Remove(e)
+		delete(c.items, key)
+	}
+}
+
+// prefixBaseAttributes returns the Unicast or L3VPN prefix message msg when it
+// carries base attributes with a hash, msg is passed as a pointer or a pointer
+// to a pointer.
+func prefixBaseAttributes(msg interface{}) (*UnicastPrefix, *L3VPNPrefix) {
+	switch m := msg.(type) {
+	case **UnicastPrefix:
+		if m != nil {
+			return prefixBaseAttributes(*m)
+		}
+	case *UnicastPrefix:
+		if m != nil && m.BaseAttributes != nil && m.BaseAttributes.BaseAttrHash != "" {
+			return m, nil
+		}
+	case **L3VPNPrefix:
+		if m != nil {
+			return prefixBaseAttributes(*m)
+		}
+	case *L3VPNPrefix:
+		if m != nil && m.BaseAttributes != nil && m.BaseAttributes.BaseAttrHash != "" {
+			return nil, m
+		}
+	}
+	return nil, nil
+}
+
+// publishBaseAttribute publishes the base_attribute message of the base
+// attributes of the prefix message msg, unless the router's hash of the
+// attributes was recently published to the topic or sub-topic the message is
+// routed to.
+func (p *producer) publishBaseAttribute(msg interface{}) error {
+	var ba *BaseAttribute
+	if u, l := prefixBaseAttributes(msg); u != nil {
+		ba = &BaseAttribute{
+			RouterHash:     u.RouterHash,
+			RouterIP:       u.RouterIP,
+			PeerHash:       u.PeerHash,
+			PeerIP:         u.PeerIP,
+			PeerASN:        u.PeerASN,
+			BaseAttributes: u.BaseAttributes,
+			Timestamp:      u.Timestamp,
+		}
+	} else if l != nil {
+		ba = &BaseAttribute{
+			RouterHash:     l.RouterHash,
+			RouterIP:       l.RouterIP,
+			PeerHash:       l.PeerHash,
+			PeerIP:         l.PeerIP,
+			PeerASN:        l.PeerASN,
+			BaseAttributes: l.BaseAttributes,
+			Timestamp:      l.Timestamp,
+		}
+	} else {
+		return nil
+	}
+	ba.BaseAttrHash = ba.BaseAttributes.BaseAttrHash
+	// The publishing rules may route the base attributes of the peers of a
+	// router to different sub-topics, the attributes are published once per
+	// sub-topic.
+	var subtopic string
+	if p.rules != nil {
+		d := p.applyRules(ba, bmp.BaseAttributeMsg)
+		if d.Drop {
+			return nil
+		}
+		subtopic = d.Subtopic
+	}
+	key := ba.RouterHash + "/" + subtopic + "/" + ba.BaseAttrHash
+	if !p.baseAttrs.add(key) {
+		return nil
+	}
+	if err := p.enrichAndPublish(ba, bmp.BaseAttributeMsg, subtopic, []byte(ba.BaseAttrHash)); err != nil {
+		p.baseAttrs.remove(key)
+		return fmt.Errorf("failed to publish base attributes %s with error: %w", ba.BaseAttrHash, err)
+	}
+	return nil
+}
+
+// normalized returns the message published instead of msg in the normalized
+// mode, a copy of the Unicast or L3VPN prefix message referencing its base
+// attributes by base_attr_hash. The other messages are returned as they are.
+func normalized(msg interface{}) interface{} {
+	switch u, l := prefixBaseAttributes(msg); {
+	case u != nil:
+		n := *u
+		n.BaseAttrHash, n.BaseAttributes = u.BaseAttributes.BaseAttrHash, nil
+		return &n
+	case l != nil:
+		n := *l
+		n.BaseAttrHash, n.BaseAttributes = l.BaseAttributes.BaseAttrHash, nil
+		return &n
+	}
+	return msg
+}
@@ -0,0 +1,116 @@
+package message
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/sbezverk/gobmp/pkg/bgp"
+	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/rules"
+)
+
+func TestPublishBaseAttributes(t *testing.T) {
+	tests := []struct {
+		name       string
+		normalized bool
+	}{
+		{name: "base attributes"},
+		{name: "normalized", normalized: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rp := &recordingPublisher{}
+			p := NewProducer(rp, true).(*producer)
+			if err := p.SetConfig(&Config{BaseAttributes: true, BaseAttributeCacheSize: 2, NormalizedBaseAttributes: tt.normalized}); err != nil {
+				t.Fatalf("SetConfig() error: %v", err)
+			}
+			attrs := func(h string) *bgp.BaseAttributes {
+				return &bgp.BaseAttributes{BaseAttrHash: h, ASPath: []uint32{65001}}
+			}
+			msgs := []struct {
+				msg     interface{}
+				msgType int
+			}{
+				{&UnicastPrefix{RouterHash: "r1", Prefix: "198.51.100.0", BaseAttributes: attrs("a")}, bmp.UnicastPrefixV4Msg},
+				{&UnicastPrefix{RouterHash: "r1", Prefix: "198.51.101.0", BaseAttributes: attrs("a")}, bmp.UnicastPrefixV4Msg},
+				{&L3VPNPrefix{RouterHash: "r1", Prefix: "10.0.0.0", BaseAttributes: attrs("b")}, bmp.L3VPNV4Msg},
+				{&UnicastPrefix{RouterHash: "r2", Prefix: "198.51.100.0", BaseAttributes: attrs("a")}, bmp.UnicastPrefixV4Msg},
+				// a is evicted by b and the a of r2
+				{&UnicastPrefix{RouterHash: "r1", Prefix: "198.51.102.0", BaseAttributes: attrs("a")}, bmp.UnicastPrefixV4Msg},
+				{&UnicastPrefix{RouterHash: "r1", Prefix: "198.51.103.0"}, bmp.UnicastPrefixV4Msg},
+			}
+			for _, m := range msgs {
+				if err := p.marshalAndPublish(m.msg, m.msgType, nil); err != nil {
+					t.Fatalf("marshalAndPublish() error: %v", err)
+				}
+			}
+			var got []string
+			for _, m := range rp.msgs {
+				if m.msgType != bmp.BaseAttributeMsg {
+					var pm UnicastPrefix
+					if err := json.Unmarshal(m.payload, &pm); err != nil {
+						t.Fatal(err)
+					}
+					// The prefix without base attributes is published as it is
+					if pm.Prefix != "198.51.103.0" && (tt.normalized != (pm.BaseAttributes == nil) || tt.normalized != (pm.BaseAttrHash != "")) {
+						t.Errorf("%s: base_attrs %+v, base_attr_hash %q", pm.Prefix, pm.BaseAttributes, pm.BaseAttrHash)
+					}
+					continue
+				}
+				var ba BaseAttribute
+				if err := json.Unmarshal(m.payload, &ba); err != nil {
+					t.Fatal(err)
+				}
+				if ba.BaseAttributes == nil || ba.BaseAttributes.BaseAttrHash != ba.BaseAttrHash {
+					t.Errorf("base_attribute %s: base_attrs %+v", ba.BaseAttrHash, ba.BaseAttributes)
+				}
+				got = append(got, ba.RouterHash+"/"+ba.BaseAttrHash)
+			}
+			want := []string{"r1/a", "r1/b", "r2/a", "r1/a"}
+			if len(got) != len(want) {
+				t.Fatalf("published base attributes %v, want %v", got, want)
+			}
+			for i := range want {
+				if got[i] != want[i] {
+					t.Errorf("published base attributes %v, want %v", got, want)
+					break
+				}
+			}
+			// The observed messages keep their base attributes
+			if u := msgs[0].msg.(*UnicastPrefix); u.BaseAttributes == nil || u.BaseAttrHash != "" {
+				t.Errorf("the normalized message was modified: %+v", u)
+			}
+			if n := len(rp.msgs) - len(got); n != len(msgs) {
+				t.Errorf("published %d prefixes, want %d", n, len(msgs))
+			}
+		})
+	}
+}
+
+func TestPublishBaseAttributesSubtopics(t *testing.T) {
+	e, err := rules.New([]rules.Rule{
+		{Match: rules.Match{PeerASNs: []uint32{65099}}, Action: rules.ActionRoute, Subtopic: "lab"},
+	})
+	if err != nil {
+		t.Fatalf("rules.New() error: %v", err)
+	}
+	rp := &rulesPublisher{}
+	p := NewProducer(rp, true).(*producer)
+	if err := p.SetConfig(&Config{BaseAttributes: true, Rules: e}); err != nil {
+		t.Fatalf("SetConfig() error: %v", err)
+	}
+	for _, peerASN := range []uint32{65001, 65099, 65099} {
+		m := &UnicastPrefix{RouterHash: "r1", PeerASN: peerASN, Prefix: "198.51.100.0", BaseAttributes: &bgp.BaseAttributes{BaseAttrHash: "a"}}
+		if err := p.marshalAndPublish(m, bmp.UnicastPrefixV4Msg, nil); err != nil {
+			t.Fatalf("marshalAndPublish() error: %v", err)
+		}
+	}
+	// The attributes published to the topic are published again to the
+	// sub-topic of the routed peer, once.
+	if len(rp.msgs) != 2 || rp.msgs[0].msgType != bmp.BaseAttributeMsg {
+		t.Errorf("published %d messages to the topics, want the base attributes and the prefix", len(rp.msgs))
+	}
+	if len(rp.subtopics) != 3 {
+		t.Errorf("published %d messages to the sub-topics, want the base attributes and 2 prefixes", len(rp.subtopics))
+	}
+}
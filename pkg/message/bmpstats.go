@@ -50,6 +50,7 @@ func (p *producer) produceStatsMessage(msg bmp.Message) {
 	}
 	m.RemoteIP = msg.PeerHeader.GetPeerAddrString()
 	m.RemoteBGPID = msg.PeerHeader.GetPeerBGPIDString()
+	m.PeerHash = msg.PeerHeader.GetPeerHash()
 	for _, tlv := range StatsMsg.StatsTLV {
 		switch tlv.InformationType {
 		case 0, 1, 2, 3, 4, 5, 6, 11, 12, 13:
@@ -36,6 +36,7 @@ func (p *producer) evpn(nlri bgp.MPNLRI, op int, ph *bmp.PerPeerHeader, update *
 		return nil, fmt.Errorf("unknown operation %d", op)
 	}
 
+	ec := evpnExtCommunities(update.BaseAttributes)
 	for _, e := range evpn.Route {
 		prfx := EVPNPrefix{
 			Action:         operation,
@@ -57,6 +58,7 @@ func (p *producer) evpn(nlri bgp.MPNLRI, op int, ph *bmp.PerPeerHeader, update *
 		prfx.RemoteBGPID = ph.GetPeerBGPIDString()
 		prfx.IsIPv4 = !nlri.IsIPv6NLRI()
 		prfx.IsNexthopIPv4 = !nlri.IsNextHopIPv6()
+		ec.apply(&prfx)
 
 		// Do not want to panic on nil pointer
 		if e != nil {
@@ -140,3 +142,59 @@ func (p *producer) evpn(nlri bgp.MPNLRI, op int, ph *bmp.PerPeerHeader, update *
 
 	return prfxs, nil
 }
+
+// evpnExtComm carries EVPN relevant Extended Communities of an update, shared by
+// all EVPN routes of the update.
+type evpnExtComm struct {
+	macMobility    *bgp.ECMACMobility
+	esiLabel       *bgp.ECESILabel
+	routerMAC      string
+	defaultGateway bool
+	l2Attributes   *bgp.ECLayer2Attributes
+	dfElection     *bgp.ECDFElection
+	routeTargets   []string
+}
+
+// evpnExtCommunities decodes EVPN relevant Extended Communities from the update's
+// Base Attributes.
+func evpnExtCommunities(ba *bgp.BaseAttributes) *evpnExtComm {
+	ec := &evpnExtComm{}
+	if ba == nil {
+		return ec
+	}
+	for _, d := range ba.GetExtCommunities() {
+		switch v := d.Decoded.(type) {
+		case *bgp.ECMACMobility:
+			ec.macMobility = v
+		case *bgp.ECESILabel:
+			ec.esiLabel = v
+		case *bgp.ECMAC:
+			// Router's MAC, ES-Import Route Target shares the same value format
+			if d.Type == 0x06 && d.SubType != nil && *d.SubType == 0x03 {
+				ec.routerMAC = v.MAC
+			}
+		case *bgp.ECDefaultGateway:
+			ec.defaultGateway = true
+		case *bgp.ECLayer2Attributes:
+			ec.l2Attributes = v
+		case *bgp.ECDFElection:
+			ec.dfElection = v
+		case *bgp.ECAdministrator:
+			if d.Name == "rt" {
+				ec.routeTargets = append(ec.routeTargets, d.Value)
+			}
+		}
+	}
+
+	return ec
+}
+
+func (ec *evpnExtComm) apply(prfx *EVPNPrefix) {
+	prfx.MACMobility = ec.macMobility
+	prfx.ESILabel = ec.esiLabel
+	prfx.RouterMAC = ec.routerMAC
+	prfx.DefaultGateway = ec.defaultGateway
+	prfx.Layer2Attributes = ec.l2Attributes
+	prfx.DFElection = ec.dfElection
+	prfx.RouteTargets = ec.routeTargets
+}
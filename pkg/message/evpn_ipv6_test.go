@@ -269,3 +269,64 @@ func TestEvpnType2ESIAndMAC(t *testing.T) {
 		t.Errorf("MAC = %q, want 'aa:bb:cc:dd:ee:ff'", prfxs[0].MAC)
 	}
 }
+
+func TestEvpnExtCommunities(t *testing.T) {
+	prod := &producer{
+		speakerHash: "test-hash",
+		speakerIP:   "10.0.0.1",
+		publisher:   &mockPublisher{},
+	}
+	route, err := evpn.UnmarshalEVPNNLRI(buildEVPNType2Wire([10]byte{}, [6]byte{0xAA, 0xBB, 0xCC, 0xDD, 0xEE, 0xFF}))
+	if err != nil {
+		t.Fatalf("UnmarshalEVPNNLRI() error: %v", err)
+	}
+	ecs, err := bgp.UnmarshalBGPExtCommunityDetail([]byte{
+		0x00, 0x02, 0xfd, 0xe8, 0x00, 0x00, 0x00, 0x64, // rt=65000:100
+		0x06, 0x00, 0x01, 0x00, 0x00, 0x00, 0x00, 0x07, // MAC Mobility, sticky, sequence 7
+		0x06, 0x01, 0x01, 0x00, 0x00, 0x00, 0x06, 0x40, // ESI Label, single-active, label 100
+		0x06, 0x02, 0x00, 0x11, 0x22, 0x33, 0x44, 0x55, // ES-Import Route Target
+		0x06, 0x03, 0x00, 0x01, 0x02, 0x03, 0x04, 0x05, // Router's MAC
+		0x03, 0x0d, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, // Default Gateway
+		0x06, 0x04, 0x00, 0x02, 0x05, 0xdc, 0x00, 0x00, // Layer 2 Attributes, MTU 1500
+		0x06, 0x06, 0x01, 0x00, 0x02, 0x00, 0x00, 0x00, // DF Election, HRW, AC-DF
+	})
+	if err != nil {
+		t.Fatalf("UnmarshalBGPExtCommunityDetail() error: %v", err)
+	}
+	ph := &bmp.PerPeerHeader{
+		PeerBGPID:         make([]byte, 4),
+		PeerAddress:       make([]byte, 16),
+		PeerDistinguisher: make([]byte, 8),
+		PeerTimestamp:     make([]byte, 8),
+	}
+	update := &bgp.Update{BaseAttributes: &bgp.BaseAttributes{ExtCommunities: ecs}}
+	prfxs, err := prod.evpn(&evpnMockNLRI{route: route, nextHop: "10.0.0.1"}, 0, ph, update)
+	if err != nil {
+		t.Fatalf("evpn() error: %v", err)
+	}
+	if len(prfxs) != 1 {
+		t.Fatalf("got %d prefixes, want 1", len(prfxs))
+	}
+	p := prfxs[0]
+	if p.MACMobility == nil || !p.MACMobility.Sticky || p.MACMobility.Sequence != 7 {
+		t.Errorf("MACMobility = %+v, want sticky sequence 7", p.MACMobility)
+	}
+	if p.ESILabel == nil || !p.ESILabel.SingleActive || p.ESILabel.Label != 100 {
+		t.Errorf("ESILabel = %+v, want single-active label 100", p.ESILabel)
+	}
+	if p.RouterMAC != "00:01:02:03:04:05" {
+		t.Errorf("RouterMAC = %q, want '00:01:02:03:04:05'", p.RouterMAC)
+	}
+	if !p.DefaultGateway {
+		t.Errorf("DefaultGateway = false, want true")
+	}
+	if p.Layer2Attributes == nil || p.Layer2Attributes.MTU != 1500 || p.Layer2Attributes.ControlFlags != 2 {
+		t.Errorf("Layer2Attributes = %+v, want MTU 1500 control flags 2", p.Layer2Attributes)
+	}
+	if p.DFElection == nil || p.DFElection.Algorithm != 1 || p.DFElection.Capabilities != 2 {
+		t.Errorf("DFElection = %+v, want algorithm 1 capabilities 2", p.DFElection)
+	}
+	if len(p.RouteTargets) != 1 || p.RouteTargets[0] != "65000:100" {
+		t.Errorf("RouteTargets = %v, want [65000:100]", p.RouteTargets)
+	}
+}
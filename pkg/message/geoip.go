@@ -0,0 +1,43 @@
+package message
+
+import (
+	"net/netip"
+)
+
+// applyGeoIP sets the AS names and the prefix country of the Unicast prefix
+// messages and the remote AS name of the peer messages, msg is passed as a
+// pointer or a pointer to a pointer.
+func (p *producer) applyGeoIP(msg interface{}) {
+	switch m := msg.(type) {
+	case **UnicastPrefix:
+		if m != nil {
+			p.applyGeoIP(*m)
+		}
+	case *UnicastPrefix:
+		if m == nil {
+			return
+		}
+		if as, ok := p.geoIP.AS(m.OriginAS); ok {
+			m.OriginASName, m.OriginASOrg = as.Name, as.Org
+		}
+		if as, ok := p.geoIP.AS(m.PeerASN); ok {
+			m.PeerASName = as.Name
+		}
+		if a, err := netip.ParseAddr(m.Prefix); err == nil {
+			if pfx, err := a.Prefix(int(m.PrefixLen)); err == nil {
+				m.Country = p.geoIP.Country(pfx)
+			}
+		}
+	case **PeerStateChange:
+		if m != nil {
+			p.applyGeoIP(*m)
+		}
+	case *PeerStateChange:
+		if m == nil {
+			return
+		}
+		if as, ok := p.geoIP.AS(m.RemoteASN); ok {
+			m.RemoteASName = as.Name
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package message
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/geoip"
+	"github.com/sbezverk/gobmp/pkg/mmdb/mmdbtest"
+)
+
+func TestMarshalAndPublishGeoIP(t *testing.T) {
+	dir := t.TempDir()
+	asn, country := filepath.Join(dir, "asn.mmdb"), filepath.Join(dir, "country.mmdb")
+	mmdbtest.Write(t, asn, "GeoLite2-ASN", []mmdbtest.Network{
+		{Prefix: "198.51.100.0/24", Record: map[string]interface{}{"autonomous_system_number": uint32(64500), "autonomous_system_organization": "Example Networks"}},
+		{Prefix: "192.0.2.0/24", Record: map[string]interface{}{"autonomous_system_number": uint32(65001), "autonomous_system_organization": "Transit Corp"}},
+	})
+	mmdbtest.Write(t, country, "GeoLite2-Country", []mmdbtest.Network{
+		{Prefix: "198.51.100.0/24", Record: map[string]interface{}{"country": map[string]interface{}{"iso_code": "FR"}}},
+	})
+	e, err := geoip.New(asn, country, 0)
+	if err != nil {
+		t.Fatalf("geoip.New() error: %v", err)
+	}
+	rp := &recordingPublisher{}
+	p := NewProducer(rp, true).(*producer)
+	if err := p.SetConfig(&Config{GeoIP: e}); err != nil {
+		t.Fatalf("SetConfig() error: %v", err)
+	}
+	u := &UnicastPrefix{RouterIP: "10.0.0.1", PeerIP: "192.0.2.1", PeerASN: 65001, Prefix: "198.51.100.0", PrefixLen: 24, IsIPv4: true, OriginAS: 64500}
+	tests := []struct {
+		name    string
+		msg     interface{}
+		msgType int
+		want    map[string]interface{}
+	}{
+		{
+			name:    "unicast prefix",
+			msg:     &u,
+			msgType: bmp.UnicastPrefixV4Msg,
+			want: map[string]interface{}{
+				"origin_as_name": "Example Networks",
+				"origin_as_org":  "Example Networks",
+				"peer_as_name":   "Transit Corp",
+				"country":        "FR",
+			},
+		},
+		{
+			name:    "unknown origin",
+			msg:     &UnicastPrefix{PeerASN: 65002, Prefix: "203.0.113.0", PrefixLen: 24, IsIPv4: true, OriginAS: 64501},
+			msgType: bmp.UnicastPrefixV4Msg,
+			want:    map[string]interface{}{},
+		},
+		{
+			name:    "peer",
+			msg:     &PeerStateChange{RouterIP: "10.0.0.1", RemoteIP: "192.0.2.1", RemoteASN: 65001},
+			msgType: bmp.PeerStateChangeMsg,
+			want:    map[string]interface{}{"remote_as_name": "Transit Corp"},
+		},
+	}
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := p.marshalAndPublish(tt.msg, tt.msgType, nil); err != nil {
+				t.Fatalf("marshalAndPublish() error: %v", err)
+			}
+			var m map[string]interface{}
+			if err := json.Unmarshal(rp.msgs[i].payload, &m); err != nil {
+				t.Fatal(err)
+			}
+			got := map[string]interface{}{}
+			for _, k := range []string{"origin_as_name", "origin_as_org", "peer_as_name", "country", "remote_as_name"} {
+				if v, ok := m[k]; ok {
+					got[k] = v
+				}
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("enrichment = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
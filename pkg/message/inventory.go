@@ -0,0 +1,55 @@
+package message
+
+import (
+	"net/netip"
+	"reflect"
+
+	"github.com/sbezverk/gobmp/pkg/inventory"
+)
+
+// applyInventory adds the inventory labels of the router, peer, peer ASN and
+// RD of msg to its user labels, the labels of the publishing rules take
+// precedence.
+func (p *producer) applyInventory(msg interface{}) {
+	v := messageStruct(msg)
+	if !v.IsValid() {
+		return
+	}
+	f := v.FieldByName("UserLabels")
+	if !f.IsValid() || !f.CanSet() {
+		return
+	}
+	labels := p.inventory.Labels(inventoryKeys(v))
+	if len(labels) == 0 {
+		return
+	}
+	for n, l := range f.Interface().(map[string]string) {
+		labels[n] = l
+	}
+	f.Set(reflect.ValueOf(labels))
+}
+
+// inventoryKeys returns the inventory keys of the message struct v, the RD is
+// the Route Distinguisher of the L3VPN routes or the Peer Distinguisher.
+func inventoryKeys(v reflect.Value) *inventory.Keys {
+	k := &inventory.Keys{}
+	if s, ok := stringField(v, "RouterIP"); ok {
+		k.Router, _ = netip.ParseAddr(s)
+	}
+	if s, ok := stringField(v, "PeerIP", "RemoteIP"); ok {
+		k.Peer, _ = netip.ParseAddr(s)
+	}
+	for _, n := range []string{"PeerASN", "RemoteASN"} {
+		if fv := v.FieldByName(n); fv.IsValid() && fv.Kind() == reflect.Uint32 {
+			k.PeerASN = uint32(fv.Uint())
+			break
+		}
+	}
+	for _, n := range []string{"VPNRD", "PeerRD"} {
+		if s, ok := stringField(v, n); ok && s != "" {
+			k.RD = s
+			break
+		}
+	}
+	return k
+}
@@ -0,0 +1,89 @@
+package message
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/inventory"
+	"github.com/sbezverk/gobmp/pkg/rules"
+)
+
+func TestMarshalAndPublishInventory(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "inventory.yaml")
+	yml := `
+routers:
+  10.0.0.1: {site: paris}
+peer_asns:
+  65001: {customer: acme}
+rds:
+  "65000:100": {vrf_owner: globex}
+`
+	if err := os.WriteFile(path, []byte(yml), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	inv, err := inventory.Load(path)
+	if err != nil {
+		t.Fatalf("inventory.Load() error: %v", err)
+	}
+	e, err := rules.New([]rules.Rule{
+		{Match: rules.Match{PeerASNs: []uint32{65001}}, Action: rules.ActionLabel, Labels: map[string]string{"site": "lab"}},
+	})
+	if err != nil {
+		t.Fatalf("rules.New() error: %v", err)
+	}
+	rp := &recordingPublisher{}
+	p := NewProducer(rp, true).(*producer)
+	if err := p.SetConfig(&Config{Rules: e, Inventory: inv}); err != nil {
+		t.Fatalf("SetConfig() error: %v", err)
+	}
+	tests := []struct {
+		name    string
+		msg     interface{}
+		msgType int
+		want    map[string]string
+	}{
+		{
+			name:    "router",
+			msg:     &PeerStateChange{RouterIP: "10.0.0.1", RemoteIP: "192.0.2.1", RemoteASN: 65002},
+			msgType: bmp.PeerStateChangeMsg,
+			want:    map[string]string{"site": "paris"},
+		},
+		{
+			name:    "rule labels take precedence",
+			msg:     &UnicastPrefix{RouterIP: "10.0.0.1", PeerIP: "192.0.2.1", PeerASN: 65001, Prefix: "198.51.100.0", PrefixLen: 24, IsIPv4: true},
+			msgType: bmp.UnicastPrefixV4Msg,
+			want:    map[string]string{"site": "lab", "customer": "acme"},
+		},
+		{
+			name:    "route distinguisher",
+			msg:     &L3VPNPrefix{RouterIP: "10.0.0.2", VPNRD: "65000:100"},
+			msgType: bmp.L3VPNV4Msg,
+			want:    map[string]string{"vrf_owner": "globex"},
+		},
+		{
+			name:    "no labels",
+			msg:     &Stats{RouterIP: "10.0.0.2"},
+			msgType: bmp.StatsReportMsg,
+		},
+	}
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := p.marshalAndPublish(tt.msg, tt.msgType, nil); err != nil {
+				t.Fatalf("marshalAndPublish() error: %v", err)
+			}
+			var got struct {
+				UserLabels map[string]string `json:"user_labels"`
+			}
+			if err := json.Unmarshal(rp.msgs[i].payload, &got); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got.UserLabels, tt.want) {
+				t.Errorf("user_labels = %v, want %v", got.UserLabels, tt.want)
+			}
+		})
+	}
+}
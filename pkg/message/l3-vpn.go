@@ -58,11 +58,13 @@ func (p *producer) l3vpn(nlri bgp.MPNLRI, op int, ph *bmp.PerPeerHeader, update
 		if prfx.IsLocRIB {
 			prfx.TableName = p.GetTableName(ph.GetPeerBGPIDString(), ph.GetPeerDistinguisherString())
 		}
+		prfx.VRF = p.vrfName(&prfx, ph)
 		return []L3VPNPrefix{prfx}, nil
 	}
 	if err != nil {
 		return nil, err
 	}
+	rts := routeTargets(update.BaseAttributes)
 	prfxs := make([]L3VPNPrefix, 0)
 	for _, e := range nlril3vpn.NLRI {
 		prfx := L3VPNPrefix{
@@ -134,6 +136,8 @@ func (p *producer) l3vpn(nlri bgp.MPNLRI, op int, ph *bmp.PerPeerHeader, update
 		}
 		prfx.VPNRD = e.RD.String()
 		prfx.VPNRDType = e.RD.Type
+		prfx.RouteTargets = rts
+		prfx.VRF = p.vrfName(&prfx, ph)
 		if psid, err := update.GetAttrPrefixSID(); err == nil {
 			prfx.PrefixSID = psid
 		}
@@ -142,3 +146,87 @@ func (p *producer) l3vpn(nlri bgp.MPNLRI, op int, ph *bmp.PerPeerHeader, update
 
 	return prfxs, nil
 }
+
+// routeTargets returns the Route Targets carried by the update's Extended
+// Communities and IPv6 Address Specific Extended Communities.
+func routeTargets(ba *bgp.BaseAttributes) []string {
+	if ba == nil {
+		return nil
+	}
+	var rts []string
+	for _, d := range append(ba.GetExtCommunities(), ba.GetIPv6ExtCommunities()...) {
+		if d.Name == "rt" {
+			rts = append(rts, d.Value)
+		}
+	}
+
+	return rts
+}
+
+// vrfName returns the VRF of an L3VPN route when VRF mapping is enabled, the
+// routes of a VRF's Loc-RIB instance belong to the VRF named by its Table Name,
+// other routes are looked up by their Route Targets and Route Distinguisher.
+// A withdrawal carries no Route Targets, it belongs to the VRF its route was
+// announced in.
+func (p *producer) vrfName(prfx *L3VPNPrefix, ph *bmp.PerPeerHeader) string {
+	if p.vrfMap == nil {
+		return ""
+	}
+	if prfx.IsLocRIB && prfx.TableName != "" && ph.GetPeerDistinguisherString() != "0:0" {
+		return prfx.TableName
+	}
+	key := vpnRoute{rd: prfx.VPNRD, prefix: prfx.Prefix, prefixLen: prfx.PrefixLen, pathID: prfx.PathID}
+	if prfx.Action == "del" {
+		if name, ok := p.forgetVPNRoute(prfx.PeerHash, key); ok {
+			return name
+		}
+		return p.vrfMap.Lookup(prfx.VPNRD, prfx.RouteTargets)
+	}
+	name := p.vrfMap.Lookup(prfx.VPNRD, prfx.RouteTargets)
+	p.rememberVPNRoute(prfx.PeerHash, key, name)
+
+	return name
+}
+
+// vpnRoute identifies an L3VPN route of a peer.
+type vpnRoute struct {
+	rd        string
+	prefix    string
+	prefixLen int32
+	pathID    int32
+}
+
+// rememberVPNRoute records the VRF of the route key announced by the peer
+// peerHash, a route announced again without a VRF is forgotten.
+func (p *producer) rememberVPNRoute(peerHash string, key vpnRoute, name string) {
+	p.vpnLock.Lock()
+	defer p.vpnLock.Unlock()
+	routes, ok := p.vpnRoutes[peerHash]
+	if name == "" {
+		if ok {
+			delete(routes, key)
+		}
+		return
+	}
+	if !ok {
+		if p.vpnRoutes == nil {
+			p.vpnRoutes = make(map[string]map[vpnRoute]string)
+		}
+		routes = make(map[vpnRoute]string)
+		p.vpnRoutes[peerHash] = routes
+	}
+	routes[key] = name
+}
+
+// forgetVPNRoute returns the VRF the route key of the peer peerHash was
+// announced in and forgets it.
+func (p *producer) forgetVPNRoute(peerHash string, key vpnRoute) (string, bool) {
+	p.vpnLock.Lock()
+	defer p.vpnLock.Unlock()
+	name, ok := p.vpnRoutes[peerHash][key]
+	if ok {
+		delete(p.vpnRoutes[peerHash], key)
+	}
+
+	return name, ok
+}
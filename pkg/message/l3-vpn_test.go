@@ -0,0 +1,181 @@
+package message
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/sbezverk/gobmp/pkg/bgp"
+	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/rules"
+	"github.com/sbezverk/gobmp/pkg/vrf"
+)
+
+type mockSubtopicPublisher struct {
+	mockPublisher
+	subtopics []string
+}
+
+func (m *mockSubtopicPublisher) PublishMessageToSubtopic(msgType int, subtopic string, msgHash []byte, msg []byte) error {
+	m.subtopics = append(m.subtopics, subtopic)
+	return nil
+}
+
+func l3vpnTestNLRI(t *testing.T) bgp.MPNLRI {
+	t.Helper()
+	nlri, err := bgp.UnmarshalMPReachNLRI([]byte{
+		0x00, 0x01, // AFI: 1
+		0x80,                                           // SAFI: 128
+		0x0C,                                           // NH Length: 12 (RD 8 + IPv4 4)
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, // RD
+		0x0a, 0x00, 0x00, 0x01, // NextHop
+		0x00,             // Reserved
+		0x58,             // PrefixLen: 88 = 24(label) + 64(RD)
+		0x00, 0x01, 0x01, // Label: 16 (bottom-of-stack)
+		0x00, 0x00, 0xFD, 0xE8, 0x00, 0x00, 0x00, 0x01, // RD: 65000:1
+	}, false, map[int]bool{})
+	if err != nil {
+		t.Fatalf("UnmarshalMPReachNLRI: %v", err)
+	}
+	return nlri
+}
+
+func TestL3VPN_RouteTargetsAndVRF(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "vrfs.yaml")
+	if err := os.WriteFile(path, []byte("vrfs:\n  - name: CUST-A\n    route_targets: [\"65000:100\"]\n"), 0o644); err != nil {
+		t.Fatalf("failed to write vrf map file: %v", err)
+	}
+	vrfMap, err := vrf.LoadMap(path)
+	if err != nil {
+		t.Fatalf("LoadMap() error: %v", err)
+	}
+	vrfMap.Learn("65000:1", "CUST-B")
+
+	ph := makePeerHeader(t, bmp.PeerType0, 0x00)
+	locRIB := makePeerHeader(t, bmp.PeerType3, 0x00)
+	copy(locRIB.PeerDistinguisher, []byte{0x00, 0x00, 0xFD, 0xE8, 0x00, 0x00, 0x00, 0x07})
+	tests := []struct {
+		name    string
+		ph      *bmp.PerPeerHeader
+		vrfMap  *vrf.Map
+		ec      []bgp.ExtCommunityDetail
+		wantRTs []string
+		wantVRF string
+	}{
+		{
+			name:    "route targets without vrf mapping",
+			ph:      ph,
+			ec:      []bgp.ExtCommunityDetail{{Name: "rt", Value: "65000:100"}, {Name: "ro", Value: "65000:5"}},
+			wantRTs: []string{"65000:100"},
+		},
+		{
+			name:    "static route target",
+			ph:      ph,
+			vrfMap:  vrfMap,
+			ec:      []bgp.ExtCommunityDetail{{Name: "rt", Value: "65000:200"}, {Name: "rt", Value: "65000:100"}},
+			wantRTs: []string{"65000:200", "65000:100"},
+			wantVRF: "CUST-A",
+		},
+		{
+			name:    "learned route distinguisher",
+			ph:      ph,
+			vrfMap:  vrfMap,
+			ec:      []bgp.ExtCommunityDetail{{Name: "rt", Value: "65000:200"}},
+			wantRTs: []string{"65000:200"},
+			wantVRF: "CUST-B",
+		},
+		{
+			name:    "vrf loc-rib table name",
+			ph:      locRIB,
+			vrfMap:  vrfMap,
+			ec:      []bgp.ExtCommunityDetail{{Name: "rt", Value: "65000:100"}},
+			wantRTs: []string{"65000:100"},
+			wantVRF: "CUST-C",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewProducer(&mockPublisher{}, false).(*producer)
+			p.vrfMap = tt.vrfMap
+			p.tableProperties[locRIB.GetTableKey()] = PerTableProperties{
+				tableInfoTLVs: []bmp.InformationalTLV{{InformationType: 3, Information: []byte("CUST-C")}},
+			}
+			update := &bgp.Update{BaseAttributes: &bgp.BaseAttributes{ExtCommunities: tt.ec}}
+			msgs, err := p.l3vpn(l3vpnTestNLRI(t), 0, tt.ph, update)
+			if err != nil {
+				t.Fatalf("l3vpn() error: %v", err)
+			}
+			if len(msgs) != 1 {
+				t.Fatalf("l3vpn() returned %d messages, want 1", len(msgs))
+			}
+			if !reflect.DeepEqual(msgs[0].RouteTargets, tt.wantRTs) {
+				t.Errorf("RouteTargets = %v, want %v", msgs[0].RouteTargets, tt.wantRTs)
+			}
+			if msgs[0].VRF != tt.wantVRF {
+				t.Errorf("VRF = %q, want %q", msgs[0].VRF, tt.wantVRF)
+			}
+		})
+	}
+}
+
+func TestL3VPN_WithdrawalVRF(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "vrfs.yaml")
+	if err := os.WriteFile(path, []byte("vrfs:\n  - name: CUST-A\n    route_targets: [\"65000:100\"]\n"), 0o644); err != nil {
+		t.Fatalf("failed to write vrf map file: %v", err)
+	}
+	vrfMap, err := vrf.LoadMap(path)
+	if err != nil {
+		t.Fatalf("LoadMap() error: %v", err)
+	}
+	p := NewProducer(&mockPublisher{}, false).(*producer)
+	p.vrfMap = vrfMap
+	ph := makePeerHeader(t, bmp.PeerType0, 0x00)
+	announce := &bgp.Update{BaseAttributes: &bgp.BaseAttributes{ExtCommunities: []bgp.ExtCommunityDetail{{Name: "rt", Value: "65000:100"}}}}
+	// MP_UNREACH_NLRI carries no Route Targets
+	withdraw := &bgp.Update{BaseAttributes: &bgp.BaseAttributes{}}
+	tests := []struct {
+		name    string
+		op      int
+		update  *bgp.Update
+		wantVRF string
+	}{
+		{name: "announcement", op: 0, update: announce, wantVRF: "CUST-A"},
+		{name: "withdrawal", op: 1, update: withdraw, wantVRF: "CUST-A"},
+		{name: "withdrawal of a withdrawn route", op: 1, update: withdraw},
+	}
+	for _, tt := range tests {
+		msgs, err := p.l3vpn(l3vpnTestNLRI(t), tt.op, ph, tt.update)
+		if err != nil {
+			t.Fatalf("%s: l3vpn() error: %v", tt.name, err)
+		}
+		if len(msgs) != 1 || msgs[0].VRF != tt.wantVRF {
+			t.Errorf("%s: VRF of %+v, want %q", tt.name, msgs, tt.wantVRF)
+		}
+	}
+	// The routes of a peer are forgotten when it goes down
+	if _, err := p.l3vpn(l3vpnTestNLRI(t), 0, ph, announce); err != nil {
+		t.Fatalf("l3vpn() error: %v", err)
+	}
+	p.producePeerMessage(peerDown, bmp.Message{PeerHeader: ph, Payload: &bmp.PeerDownMessage{}})
+	if len(p.vpnRoutes) != 0 {
+		t.Errorf("vpnRoutes = %v after peer down, want none", p.vpnRoutes)
+	}
+}
+
+func TestPublishToSubtopic(t *testing.T) {
+	sp := &mockSubtopicPublisher{}
+	p := NewProducer(sp, true).(*producer)
+	m := &L3VPNPrefix{VRF: "CUST-A"}
+	if err := p.publishToSubtopic(m, bmp.L3VPNV4Msg, m.VRF, nil, rules.Decision{}); err != nil {
+		t.Fatalf("publishToSubtopic() error: %v", err)
+	}
+	if !reflect.DeepEqual(sp.subtopics, []string{"CUST-A"}) {
+		t.Errorf("subtopics = %v, want [CUST-A]", sp.subtopics)
+	}
+	// Publishers without sub-topic support are skipped
+	p = NewProducer(&mockPublisher{}, true).(*producer)
+	if err := p.publishToSubtopic(m, bmp.L3VPNV4Msg, m.VRF, nil, rules.Decision{}); err != nil {
+		t.Fatalf("publishToSubtopic() error: %v", err)
+	}
+}
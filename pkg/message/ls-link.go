@@ -155,6 +155,12 @@ func (p *producer) lsLink(link *base.LinkNLRI, nextHop string, op int, ph *bmp.P
 		if adj, err := lslink.GetSRAdjacencySID(msg.ProtocolID); err == nil {
 			msg.LSAdjacencySID = adj
 		}
+		if eag, err := lslink.GetExtAdminGroup(); err == nil {
+			msg.ExtAdminGroup = eag
+		}
+		if members, err := lslink.GetL2BundleMember(msg.ProtocolID); err == nil && len(members) != 0 {
+			msg.L2BundleMember = members
+		}
 		if msg.ProtocolID == base.BGP {
 			if sid, err := lslink.GetPeerNodeSID(); err == nil {
 				msg.PeerNodeSID = sid
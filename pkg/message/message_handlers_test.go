@@ -2,6 +2,8 @@ package message
 
 import (
 	"encoding/json"
+	"fmt"
+	"reflect"
 	"sync"
 	"testing"
 
@@ -754,3 +756,32 @@ func TestProcessMPUpdate_UnknownAFISAFI(t *testing.T) {
 	// Should not panic — exercises the default case
 	p.processMPUpdate(nlri, 1, ph, update)
 }
+
+type typeObserver struct {
+	types []string
+}
+
+func (o *typeObserver) Observe(msgType int, msg interface{}) {
+	o.types = append(o.types, fmt.Sprintf("%T", msg))
+}
+
+// TestObserversReceiveMessagePointers verifies observers receive a pointer to
+// the message when the producer publishes a pointer to a pointer.
+func TestObserversReceiveMessagePointers(t *testing.T) {
+	o := &typeObserver{}
+	p := NewProducer(&mockPublisher{}, false).(*producer)
+	if err := p.SetConfig(&Config{Observers: []Observer{o}}); err != nil {
+		t.Fatalf("SetConfig() error: %v", err)
+	}
+	u := &UnicastPrefix{Action: "add", Prefix: "10.0.0.0", PrefixLen: 8}
+	l := &L3VPNPrefix{Action: "add", Prefix: "10.0.0.0", PrefixLen: 8}
+	for _, m := range []interface{}{&u, &l, &PeerStateChange{Action: "down"}} {
+		if err := p.marshalAndPublish(m, bmp.UnicastPrefixMsg, nil); err != nil {
+			t.Fatalf("marshalAndPublish() error: %v", err)
+		}
+	}
+	want := []string{"*message.UnicastPrefix", "*message.L3VPNPrefix", "*message.PeerStateChange"}
+	if !reflect.DeepEqual(o.types, want) {
+		t.Fatalf("observed types = %v, want %v", o.types, want)
+	}
+}
@@ -0,0 +1,210 @@
+package message
+
+import (
+	"encoding/binary"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/sbezverk/gobmp/pkg/bgp"
+	"github.com/sbezverk/gobmp/pkg/bmp"
+)
+
+// PeerSync defines the peer_sync message published when the End-of-RIB marker
+// (RFC 4724) of an address family is first received from a peer after its
+// Peer Up, the peer's table of the address family is then complete.
+type PeerSync struct {
+	RouterHash string `json:"router_hash,omitempty"`
+	RouterIP   string `json:"router_ip,omitempty"`
+	PeerHash   string `json:"peer_hash,omitempty"`
+	PeerIP     string `json:"peer_ip,omitempty"`
+	PeerASN    uint32 `json:"peer_asn,omitempty"`
+	PeerType   uint8  `json:"peer_type"`
+	PeerRD     string `json:"peer_rd,omitempty"`
+	AFI        uint16 `json:"afi"`
+	SAFI       uint8  `json:"safi"`
+	RIB        string `json:"rib"`
+	// TimeToSync is the number of seconds from the Peer Up to the End-of-RIB
+	TimeToSync float64 `json:"time_to_sync"`
+	// Prefixes is the number of routes received before the End-of-RIB
+	Prefixes uint64 `json:"prefixes"`
+	// GracefulRestart and LLGR are set when both the collected router and the
+	// peer advertised the (Long-Lived) Graceful Restart capability for the
+	// address family.
+	GracefulRestart bool   `json:"graceful_restart"`
+	LLGR            bool   `json:"llgr"`
+	Timestamp       string `json:"timestamp,omitempty"`
+	// UserLabels are the labels added by the publishing rules and the inventory
+	UserLabels map[string]string `json:"user_labels,omitempty"`
+}
+
+type syncKey struct {
+	family bgp.AFISAFI
+	rib    string
+}
+
+// peerSyncState tracks the initial table transfer of a peer, per address
+// family and RIB, from its Peer Up.
+type peerSyncState struct {
+	mu sync.Mutex
+	// peerUp and peerUpTime are the BMP and collector times of the Peer Up
+	peerUp     time.Time
+	peerUpTime time.Time
+	gr         map[bgp.AFISAFI]bool
+	llgr       map[bgp.AFISAFI]bool
+	prefixes   map[syncKey]uint64
+	synced     map[syncKey]bool
+}
+
+// newPeerSyncState returns the sync state of a peer whose session was
+// established with the sent and received Open messages.
+func newPeerSyncState(ph *bmp.PerPeerHeader, sent, received *bgp.OpenMessage) *peerSyncState {
+	s := &peerSyncState{
+		peerUp:     peerTime(ph),
+		peerUpTime: time.Now(),
+		prefixes:   make(map[syncKey]uint64),
+		synced:     make(map[syncKey]bool),
+	}
+	s.gr = negotiated(sent.GracefulRestartCapability, received.GracefulRestartCapability)
+	s.llgr = negotiated(sent.LLGRCapability, received.LLGRCapability)
+
+	return s
+}
+
+// negotiated returns the address families listed by both Open messages.
+func negotiated(sent, received func() (map[bgp.AFISAFI]bool, bool)) map[bgp.AFISAFI]bool {
+	l, lok := sent()
+	r, rok := received()
+	if !lok || !rok {
+		return nil
+	}
+	m := make(map[bgp.AFISAFI]bool)
+	for k := range l {
+		if r[k] {
+			m[k] = true
+		}
+	}
+
+	return m
+}
+
+// peerTime returns the Per-Peer Header timestamp, the zero time when the
+// router does not set it.
+func peerTime(ph *bmp.PerPeerHeader) time.Time {
+	if len(ph.PeerTimestamp) < 8 {
+		return time.Time{}
+	}
+	sec := binary.BigEndian.Uint32(ph.PeerTimestamp[0:4])
+	usec := binary.BigEndian.Uint32(ph.PeerTimestamp[4:8])
+	if sec == 0 && usec == 0 {
+		return time.Time{}
+	}
+
+	return time.Unix(int64(sec), int64(usec)*int64(time.Microsecond)).UTC()
+}
+
+// ribName returns the name of the RIB of the Per-Peer Header flags.
+func ribName(ph *bmp.PerPeerHeader) string {
+	if f, _ := ph.IsLocRIB(); f {
+		return "loc_rib"
+	}
+	out, _ := ph.IsAdjRIBOut()
+	post, _ := ph.IsPostPolicy()
+	switch {
+	case out && post:
+		return "adj_rib_out_post"
+	case out:
+		return "adj_rib_out_pre"
+	case post:
+		return "adj_rib_in_post"
+	}
+	return "adj_rib_in_pre"
+}
+
+func (p *producer) peerSyncState(ph *bmp.PerPeerHeader) *peerSyncState {
+	p.tableLock.RLock()
+	defer p.tableLock.RUnlock()
+
+	return p.tableProperties[ph.GetTableKey()].sync
+}
+
+// countSyncPrefixes adds the routes of an update to the count of the routes
+// received by the peer before the End-of-RIB of the address family.
+func (p *producer) countSyncPrefixes(ph *bmp.PerPeerHeader, family bgp.AFISAFI, routes int) {
+	s := p.peerSyncState(ph)
+	if s == nil || routes <= 0 {
+		return
+	}
+	k := syncKey{family: family, rib: ribName(ph)}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.synced[k] {
+		s.prefixes[k] += uint64(routes)
+	}
+}
+
+// endOfRIB publishes the peer_sync message of the first End-of-RIB of an
+// address family received from the peer.
+func (p *producer) endOfRIB(ph *bmp.PerPeerHeader, family bgp.AFISAFI) {
+	s := p.peerSyncState(ph)
+	if s == nil {
+		return
+	}
+	k := syncKey{family: family, rib: ribName(ph)}
+	s.mu.Lock()
+	if s.synced[k] {
+		s.mu.Unlock()
+		return
+	}
+	s.synced[k] = true
+	m := &PeerSync{
+		RouterHash:      p.speakerHash,
+		RouterIP:        p.speakerIP,
+		PeerHash:        ph.GetPeerHash(),
+		PeerIP:          ph.GetPeerAddrString(),
+		PeerASN:         ph.PeerAS,
+		PeerType:        uint8(ph.PeerType),
+		PeerRD:          ph.GetPeerDistinguisherString(),
+		AFI:             family.AFI,
+		SAFI:            family.SAFI,
+		RIB:             k.rib,
+		Prefixes:        s.prefixes[k],
+		GracefulRestart: s.gr[family],
+		LLGR:            s.llgr[family],
+		Timestamp:       ph.GetPeerTimestamp(),
+	}
+	// The BMP timestamps are used when the router sets them, MRT replays and
+	// buffered BMP sessions are not measured on the collector clock.
+	if eor := peerTime(ph); !eor.IsZero() && !s.peerUp.IsZero() {
+		m.TimeToSync = eor.Sub(s.peerUp).Seconds()
+	} else {
+		m.TimeToSync = time.Since(s.peerUpTime).Seconds()
+	}
+	s.mu.Unlock()
+	if glog.V(5) {
+		glog.Infof("peer %s afi/safi %d/%d %s synced in %.3fs with %d prefixes", m.PeerIP, m.AFI, m.SAFI, m.RIB, m.TimeToSync, m.Prefixes)
+	}
+	if err := p.marshalAndPublish(m, bmp.PeerSyncMsg, []byte(m.RouterHash)); err != nil {
+		glog.Errorf("failed to process Peer Sync message with error: %+v", err)
+	}
+}
+
+// endOfRIBFamily returns the address family of an End-of-RIB update: an empty
+// UPDATE for IPv4 unicast, or an UPDATE carrying only an empty MP_UNREACH_NLRI
+// for the other address families (RFC 4724 Section 2).
+func endOfRIBFamily(update *bgp.Update) (bgp.AFISAFI, bool) {
+	if update.WithdrawnRoutesLength != 0 || len(update.WithdrawnRoutes) != 0 || len(update.NLRI) != 0 {
+		return bgp.AFISAFI{}, false
+	}
+	switch len(update.PathAttributes) {
+	case 0:
+		return bgp.AFISAFI{AFI: 1, SAFI: 1}, true
+	case 1:
+		a := update.PathAttributes[0]
+		if a.AttributeType == 15 && len(a.Attribute) == 3 {
+			return bgp.AFISAFI{AFI: binary.BigEndian.Uint16(a.Attribute[0:2]), SAFI: a.Attribute[2]}, true
+		}
+	}
+
+	return bgp.AFISAFI{}, false
+}
@@ -0,0 +1,162 @@
+package message
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/sbezverk/gobmp/pkg/bgp"
+	"github.com/sbezverk/gobmp/pkg/bmp"
+)
+
+func TestEndOfRIBFamily(t *testing.T) {
+	tests := []struct {
+		name   string
+		update *bgp.Update
+		want   bgp.AFISAFI
+		eor    bool
+	}{
+		{name: "ipv4 unicast", update: &bgp.Update{}, want: bgp.AFISAFI{AFI: 1, SAFI: 1}, eor: true},
+		{
+			name:   "mp unreach",
+			update: &bgp.Update{PathAttributes: []bgp.PathAttribute{{AttributeType: 15, AttributeLength: 3, Attribute: []byte{0x00, 0x19, 0x46}}}},
+			want:   bgp.AFISAFI{AFI: 25, SAFI: 70},
+			eor:    true,
+		},
+		{
+			name:   "mp unreach with withdrawn routes",
+			update: &bgp.Update{PathAttributes: []bgp.PathAttribute{{AttributeType: 15, AttributeLength: 5, Attribute: []byte{0x00, 0x02, 0x01, 0x08, 0x0a}}}},
+		},
+		{name: "ipv4 withdraw", update: &bgp.Update{WithdrawnRoutesLength: 2, WithdrawnRoutes: []byte{0x08, 0x0a}}},
+		{name: "ipv4 announcement", update: &bgp.Update{NLRI: []byte{0x08, 0x0a}}},
+		{
+			name:   "attributes only",
+			update: &bgp.Update{PathAttributes: []bgp.PathAttribute{{AttributeType: 1, AttributeLength: 1, Attribute: []byte{0}}}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, eor := endOfRIBFamily(tt.update)
+			if eor != tt.eor || got != tt.want {
+				t.Errorf("endOfRIBFamily() = %+v, %t, want %+v, %t", got, eor, tt.want, tt.eor)
+			}
+		})
+	}
+}
+
+func TestPeerSync(t *testing.T) {
+	pub := &recordingPublisher{}
+	p := NewProducer(pub, false).(*producer)
+	ph := makePeerHeader(t, bmp.PeerType0, 0x00)
+	binary.BigEndian.PutUint32(ph.PeerTimestamp[0:4], 1000)
+	peerUp := buildPeerUpMessage(t, "192.168.1.1")
+	gr := bgp.Capability{64: {{Value: []byte{0x00, 0x78, 0x00, 0x01, 0x01, 0x00}}}}
+	peerUp.SentOpen.Capabilities = gr
+	peerUp.ReceivedOpen.Capabilities = gr
+	p.producePeerMessage(peerUP, bmp.Message{PeerHeader: ph, Payload: peerUp})
+
+	routeMonitor := func(update *bgp.Update, sec uint32) {
+		binary.BigEndian.PutUint32(ph.PeerTimestamp[0:4], sec)
+		p.produceRouteMonitorMessage(bmp.Message{PeerHeader: ph, Payload: &bmp.RouteMonitor{Update: update}})
+	}
+	routeMonitor(&bgp.Update{NLRI: []byte{0x08, 0x0a, 0x10, 0x0a, 0x01}, BaseAttributes: &bgp.BaseAttributes{}}, 1001)
+	routeMonitor(&bgp.Update{WithdrawnRoutesLength: 2, WithdrawnRoutes: []byte{0x08, 0x0b}, BaseAttributes: &bgp.BaseAttributes{}}, 1002)
+	routeMonitor(&bgp.Update{BaseAttributes: &bgp.BaseAttributes{}}, 1003)
+	// A second End-of-RIB does not publish again
+	routeMonitor(&bgp.Update{BaseAttributes: &bgp.BaseAttributes{}}, 1004)
+
+	var syncs []*PeerSync
+	for _, m := range pub.msgs {
+		if m.msgType != bmp.PeerSyncMsg {
+			continue
+		}
+		s := &PeerSync{}
+		if err := json.Unmarshal(m.payload, s); err != nil {
+			t.Fatalf("failed to unmarshal peer_sync: %v", err)
+		}
+		syncs = append(syncs, s)
+	}
+	if len(syncs) != 1 {
+		t.Fatalf("got %d peer_sync messages, want 1", len(syncs))
+	}
+	s := syncs[0]
+	if s.AFI != 1 || s.SAFI != 1 || s.RIB != "adj_rib_in_pre" || s.Prefixes != 2 || s.TimeToSync != 3 || !s.GracefulRestart || s.LLGR || s.PeerASN != 65000 {
+		t.Errorf("peer_sync = %+v", s)
+	}
+}
+
+func TestPeerSyncProducer(t *testing.T) {
+	pub := &recordingPublisher{}
+	p := NewProducer(pub, false).(*producer)
+	queue, stop := make(chan bmp.Message), make(chan struct{})
+	defer close(stop)
+	go p.Producer(queue, stop)
+
+	ph := makePeerHeader(t, bmp.PeerType0, 0x00)
+	queue <- bmp.Message{PeerHeader: ph, Payload: buildPeerUpMessage(t, "192.168.1.1")}
+	const updates = 2000
+	for i := 0; i < updates; i++ {
+		update := &bgp.Update{NLRI: []byte{0x18, 0x0a, byte(i >> 8), byte(i)}, BaseAttributes: &bgp.BaseAttributes{}}
+		queue <- bmp.Message{PeerHeader: ph, Payload: &bmp.RouteMonitor{Update: update}}
+	}
+	queue <- bmp.Message{PeerHeader: ph, Payload: &bmp.RouteMonitor{Update: &bgp.Update{BaseAttributes: &bgp.BaseAttributes{}}}}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		pub.mu.Lock()
+		for _, m := range pub.msgs {
+			if m.msgType != bmp.PeerSyncMsg {
+				continue
+			}
+			s := &PeerSync{}
+			if err := json.Unmarshal(m.payload, s); err != nil {
+				t.Fatalf("failed to unmarshal peer_sync: %v", err)
+			}
+			pub.mu.Unlock()
+			if s.Prefixes != updates {
+				t.Fatalf("peer_sync prefixes = %d, want %d", s.Prefixes, updates)
+			}
+			return
+		}
+		pub.mu.Unlock()
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatal("no peer_sync message published")
+}
+
+func TestPeerWorkerPeerDown(t *testing.T) {
+	p := NewProducer(&recordingPublisher{}, false).(*producer)
+	p.stopCh = make(chan struct{})
+	defer close(p.stopCh)
+	peers := make(map[string]*peerQueue)
+	gone := make(chan *peerQueue)
+
+	ph := makePeerHeader(t, bmp.PeerType0, 0x00)
+	key := ph.GetPeerHash()
+	p.dispatch(peers, gone, bmp.Message{PeerHeader: ph, Payload: buildPeerUpMessage(t, "192.168.1.1")})
+	p.dispatch(peers, gone, bmp.Message{PeerHeader: ph, Payload: &bmp.PeerDownMessage{}})
+	var q *peerQueue
+	select {
+	case q = <-gone:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the worker of the peer did not stop after the peer down")
+	}
+	if peers[key] != q {
+		t.Fatal("the stopped worker is not the worker of the peer")
+	}
+	releasePeer(peers, q)
+	if len(peers) != 0 {
+		t.Fatalf("peers = %d after the peer down, want 0", len(peers))
+	}
+
+	// The peer coming back up gets a new worker
+	p.dispatch(peers, gone, bmp.Message{PeerHeader: ph, Payload: buildPeerUpMessage(t, "192.168.1.1")})
+	if pq, ok := peers[key]; !ok || pq == q {
+		t.Fatal("the peer up after the peer down did not start a new worker")
+	}
+	// A queue closed after the peer down is not reused
+	if q.push(bmp.Message{PeerHeader: ph, Payload: &bmp.PeerDownMessage{}}) {
+		t.Error("push() to the closed queue = true, want false")
+	}
+}
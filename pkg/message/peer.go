@@ -54,6 +54,7 @@ func (p *producer) producePeerMessage(op int, msg bmp.Message) {
 		}
 		m.RemoteIP = msg.PeerHeader.GetPeerAddrString()
 		m.RemoteBGPID = msg.PeerHeader.GetPeerBGPIDString()
+		m.PeerHash = msg.PeerHeader.GetPeerHash()
 		m.LocalBGPID = net.IP(peerUpMsg.SentOpen.BGPID).To4().String()
 		m.IsIPv4 = !msg.PeerHeader.IsRemotePeerIPv6()
 		m.LocalIP = peerUpMsg.GetLocalAddressString()
@@ -96,6 +97,16 @@ func (p *producer) producePeerMessage(op int, msg bmp.Message) {
 			}
 		}
 
+		// RFC 9234: the received OPEN carries the role of the peer, the sent
+		// OPEN the local role from which the role of the peer is derived.
+		if role, ok := peerUpMsg.ReceivedOpen.BGPRoleCapability(); ok {
+			ptp.peerRole, ptp.hasPeerRole = role, true
+		} else if role, ok := peerUpMsg.SentOpen.BGPRoleCapability(); ok {
+			ptp.peerRole, ptp.hasPeerRole = role.Remote()
+		}
+		ptp.localASN = m.LocalASN
+		ptp.sync = newPeerSyncState(msg.PeerHeader, peerUpMsg.SentOpen, peerUpMsg.ReceivedOpen)
+
 		// Copy table informational TLVs (includes Table Name per RFC 9069 Section 5)
 		ptp.tableInfoTLVs = make([]bmp.InformationalTLV, len(peerUpMsg.Information))
 		copy(ptp.tableInfoTLVs, peerUpMsg.Information)
@@ -104,6 +115,12 @@ func (p *producer) producePeerMessage(op int, msg bmp.Message) {
 		p.tableProperties[msg.PeerHeader.GetTableKey()] = ptp
 		p.tableLock.Unlock()
 
+		// RFC 9069 Section 4.1: the Peer Distinguisher of a VRF's Loc-RIB instance
+		// is the VRF's Route Distinguisher and its Table Name is the VRF name.
+		if m.IsLocRIB && m.PeerRD != "0:0" {
+			p.vrfMap.Learn(m.PeerRD, p.GetTableName(msg.PeerHeader.GetPeerBGPIDString(), m.PeerRD))
+		}
+
 		m.AdvCapabilities = peerUpMsg.SentOpen.GetCapabilities()
 		m.RcvCapabilities = peerUpMsg.ReceivedOpen.GetCapabilities()
 		if glog.V(6) {
@@ -130,6 +147,7 @@ func (p *producer) producePeerMessage(op int, msg bmp.Message) {
 		}
 		m.RemoteIP = msg.PeerHeader.GetPeerAddrString()
 		m.RemoteBGPID = msg.PeerHeader.GetPeerBGPIDString()
+		m.PeerHash = msg.PeerHeader.GetPeerHash()
 		m.IsIPv4 = !msg.PeerHeader.IsRemotePeerIPv6()
 		m.InfoData = make([]byte, len(peerDownMsg.Data))
 		copy(m.InfoData, peerDownMsg.Data)
@@ -139,6 +157,9 @@ func (p *producer) producePeerMessage(op int, msg bmp.Message) {
 		p.tableLock.Lock()
 		delete(p.tableProperties, msg.PeerHeader.GetTableKey())
 		p.tableLock.Unlock()
+		p.vpnLock.Lock()
+		delete(p.vpnRoutes, m.PeerHash)
+		p.vpnLock.Unlock()
 	}
 	if err := p.marshalAndPublish(&m, bmp.PeerStateChangeMsg, []byte(m.RouterHash)); err != nil {
 		glog.Errorf("failed to process peer message with error: %+v", err)
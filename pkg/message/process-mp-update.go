@@ -52,7 +52,9 @@ func extractOriginValidation(attrs *bgp.BaseAttributes) *string {
 	return nil
 }
 
-func (p *producer) processMPUpdate(nlri bgp.MPNLRI, operation int, ph *bmp.PerPeerHeader, update *bgp.Update) {
+// processMPUpdate publishes the messages of the routes of an MP_REACH_NLRI or
+// MP_UNREACH_NLRI attribute and returns the number of routes.
+func (p *producer) processMPUpdate(nlri bgp.MPNLRI, operation int, ph *bmp.PerPeerHeader, update *bgp.Update) (routes int) {
 	switch nlri.GetAFISAFIType() {
 	case 1, 2, 16, 17:
 		// AFI 1/2 SAFI 1 = Unicast, AFI 1/2 SAFI 4 = Labeled Unicast
@@ -61,12 +63,14 @@ func (p *producer) processMPUpdate(nlri bgp.MPNLRI, operation int, ph *bmp.PerPe
 		if err != nil {
 			glog.Errorf("failed to produce unicast messages with error: %+v", err)
 		}
+		routes = len(msgs)
 		// Publish whatever messages were successfully parsed (may be partial on error)
 		for _, m := range msgs {
 			// Extract Color EC for RFC 9723 CPR
 			m.Color = extractColorEC(update.BaseAttributes)
 			// Extract RPKI Origin Validation State for RFC 8097
 			m.OriginValidation = extractOriginValidation(update.BaseAttributes)
+			leak := p.checkRouteLeak(m, ph)
 
 			topicType := bmp.UnicastPrefixMsg
 			if p.splitAF {
@@ -80,6 +84,12 @@ func (p *producer) processMPUpdate(nlri bgp.MPNLRI, operation int, ph *bmp.PerPe
 				glog.Errorf("failed to process Unicast Prefix message with error: %+v", err)
 				return
 			}
+			if leak != nil {
+				if err := p.marshalAndPublish(leak, bmp.RouteLeakMsg, []byte(m.RouterHash)); err != nil {
+					glog.Errorf("failed to process Route Leak message with error: %+v", err)
+					return
+				}
+			}
 		}
 	case 18, 19:
 		msgs, err := p.l3vpn(nlri, operation, ph, update)
@@ -87,6 +97,7 @@ func (p *producer) processMPUpdate(nlri bgp.MPNLRI, operation int, ph *bmp.PerPe
 			glog.Errorf("failed to produce l3vpn messages with error: %+v", err)
 			return
 		}
+		routes = len(msgs)
 		for _, m := range msgs {
 			// Extract RPKI Origin Validation State for RFC 8097
 			m.OriginValidation = extractOriginValidation(update.BaseAttributes)
@@ -99,10 +110,17 @@ func (p *producer) processMPUpdate(nlri bgp.MPNLRI, operation int, ph *bmp.PerPe
 					topicType = bmp.L3VPNV6Msg
 				}
 			}
-			if err := p.marshalAndPublish(&m, topicType, []byte(m.RouterHash)); err != nil {
+			d, err := p.decideAndPublish(&m, topicType, []byte(m.RouterHash))
+			if err != nil {
 				glog.Errorf("failed to process L3VPN message with error: %+v", err)
 				return
 			}
+			if p.vrfTopics && m.VRF != "" {
+				if err := p.publishToSubtopic(&m, topicType, m.VRF, []byte(m.RouterHash), d); err != nil {
+					glog.Errorf("failed to publish L3VPN message to vrf %s topic with error: %+v", m.VRF, err)
+					return
+				}
+			}
 		}
 	case 23:
 		msgs, err := p.vpls(nlri, operation, ph, update)
@@ -110,6 +128,7 @@ func (p *producer) processMPUpdate(nlri bgp.MPNLRI, operation int, ph *bmp.PerPe
 			glog.Errorf("failed to produce vpls messages with error: %+v", err)
 			return
 		}
+		routes = len(msgs)
 		for _, msg := range msgs {
 			if err := p.marshalAndPublish(&msg, bmp.VPLSMsg, []byte(msg.RouterHash)); err != nil {
 				glog.Errorf("failed to process VPLS message with error: %+v", err)
@@ -122,6 +141,7 @@ func (p *producer) processMPUpdate(nlri bgp.MPNLRI, operation int, ph *bmp.PerPe
 			glog.Errorf("failed to produce evpn messages with error: %+v", err)
 			return
 		}
+		routes = len(msgs)
 		for _, msg := range msgs {
 			if err := p.marshalAndPublish(&msg, bmp.EVPNMsg, []byte(msg.RouterHash)); err != nil {
 				glog.Errorf("failed to process EVPNP message with error: %+v", err)
@@ -134,6 +154,7 @@ func (p *producer) processMPUpdate(nlri bgp.MPNLRI, operation int, ph *bmp.PerPe
 			glog.Errorf("failed to produce srpolicy messages with error: %+v", err)
 			return
 		}
+		routes = len(msgs)
 		for _, m := range msgs {
 			topicType := bmp.SRPolicyMsg
 			if p.splitAF {
@@ -154,6 +175,7 @@ func (p *producer) processMPUpdate(nlri bgp.MPNLRI, operation int, ph *bmp.PerPe
 			glog.Errorf("failed to produce flowspec messages with error: %+v", err)
 			return
 		}
+		routes = len(msgs)
 		for _, m := range msgs {
 			topicType := bmp.FlowspecMsg
 			if p.splitAF {
@@ -174,6 +196,7 @@ func (p *producer) processMPUpdate(nlri bgp.MPNLRI, operation int, ph *bmp.PerPe
 			glog.Errorf("failed to produce multicast messages with error: %+v", err)
 			return
 		}
+		routes = len(msgs)
 		for _, m := range msgs {
 			topicType := bmp.MulticastV6Msg
 			if m.IsIPv4 {
@@ -190,6 +213,7 @@ func (p *producer) processMPUpdate(nlri bgp.MPNLRI, operation int, ph *bmp.PerPe
 			glog.Errorf("failed to produce mcastvpn messages with error: %+v", err)
 			return
 		}
+		routes = len(msgs)
 		for _, m := range msgs {
 			topicType := bmp.MCASTVPNV6Msg
 			if m.IsIPv4 {
@@ -206,6 +230,7 @@ func (p *producer) processMPUpdate(nlri bgp.MPNLRI, operation int, ph *bmp.PerPe
 			glog.Errorf("failed to produce mvpn messages with error: %+v", err)
 			return
 		}
+		routes = len(msgs)
 		for _, m := range msgs {
 			topicType := bmp.MVPNV6Msg
 			if m.IsIPv4 {
@@ -222,6 +247,7 @@ func (p *producer) processMPUpdate(nlri bgp.MPNLRI, operation int, ph *bmp.PerPe
 			glog.Errorf("failed to produce rtc messages with error: %+v", err)
 			return
 		}
+		routes = len(msgs)
 		for _, m := range msgs {
 			topicType := bmp.RTCV6Msg
 			if m.IsIPv4 {
@@ -233,9 +259,9 @@ func (p *producer) processMPUpdate(nlri bgp.MPNLRI, operation int, ph *bmp.PerPe
 			}
 		}
 	case 71:
-		p.processNLRI71SubTypes(nlri, operation, ph, update)
+		routes = p.processNLRI71SubTypes(nlri, operation, ph, update)
 	case 72:
-		p.processNLRI72SubTypes(nlri, operation, ph, update)
+		routes = p.processNLRI72SubTypes(nlri, operation, ph, update)
 	default:
 		switch n := nlri.(type) {
 		case *bgp.MPReachNLRI:
@@ -246,14 +272,16 @@ func (p *producer) processMPUpdate(nlri bgp.MPNLRI, operation int, ph *bmp.PerPe
 			glog.Warningf("unsupported AFI/SAFI type %d in MP update", nlri.GetAFISAFIType())
 		}
 	}
+
+	return routes
 }
 
-func (p *producer) processNLRI71SubTypes(nlri bgp.MPNLRI, operation int, ph *bmp.PerPeerHeader, update *bgp.Update) {
+func (p *producer) processNLRI71SubTypes(nlri bgp.MPNLRI, operation int, ph *bmp.PerPeerHeader, update *bgp.Update) int {
 	// NLRI 71 carries 6 known sub type
 	ls, err := nlri.GetNLRI71()
 	if err != nil {
 		glog.Errorf("failed to NLRI 71 with error: %+v", err)
-		return
+		return 0
 	}
 	for _, e := range ls.NLRI {
 		// ipv4Flag used to differentiate between IPv4 and IPv6 Prefix NLRI messages
@@ -325,6 +353,8 @@ func (p *producer) processNLRI71SubTypes(nlri bgp.MPNLRI, operation int, ph *bmp
 		}
 
 	}
+
+	return len(ls.NLRI)
 }
 
 // processNLRI72SubTypes dispatches the per-Element decode for BGP-LS-VPN
@@ -333,11 +363,11 @@ func (p *producer) processNLRI71SubTypes(nlri bgp.MPNLRI, operation int, ph *bmp
 // Distinguisher that scopes the link/node/prefix to a VPN. The RD is stamped
 // onto the produced LSNode/LSLink/LSPrefix/LSSRv6SID message so downstream consumers
 // can distinguish per-tenant topology.
-func (p *producer) processNLRI72SubTypes(nlri bgp.MPNLRI, operation int, ph *bmp.PerPeerHeader, update *bgp.Update) {
+func (p *producer) processNLRI72SubTypes(nlri bgp.MPNLRI, operation int, ph *bmp.PerPeerHeader, update *bgp.Update) int {
 	ls, err := nlri.GetNLRI72()
 	if err != nil {
 		glog.Errorf("failed to decode NLRI 72 with error: %+v", err)
-		return
+		return 0
 	}
 	for _, e := range ls.NLRI {
 		rd := ""
@@ -415,4 +445,6 @@ func (p *producer) processNLRI72SubTypes(nlri bgp.MPNLRI, operation int, ph *bmp
 			glog.Warningf("Unknown NLRI 72 Sub type %d", e.Type)
 		}
 	}
+
+	return len(ls.NLRI)
 }
@@ -6,8 +6,14 @@ import (
 	"sync"
 
 	"github.com/golang/glog"
+	"github.com/sbezverk/gobmp/pkg/bgp"
 	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/geoip"
+	"github.com/sbezverk/gobmp/pkg/inventory"
+	"github.com/sbezverk/gobmp/pkg/profile"
 	"github.com/sbezverk/gobmp/pkg/pub"
+	"github.com/sbezverk/gobmp/pkg/rules"
+	"github.com/sbezverk/gobmp/pkg/vrf"
 )
 
 const (
@@ -19,9 +25,15 @@ const (
 // Each VRF (identified by BGP-ID + Peer Distinguisher) has its own:
 // - AddPath capability map (per AFI/SAFI)
 // - Table Informational TLVs (including Table Name per RFC 9069)
+// - BGP Role of the peer and local ASN of the session (RFC 9234)
+// - Initial table sync state of the peer (RFC 4724 End-of-RIB)
 type PerTableProperties struct {
 	addPathCapable map[int]bool
 	tableInfoTLVs  []bmp.InformationalTLV
+	peerRole       bgp.BGPRole
+	hasPeerRole    bool
+	localASN       uint32
+	sync           *peerSyncState
 }
 
 // Config holds producer configuration options
@@ -29,11 +41,64 @@ type Config struct {
 	// AdminID is the collector identifier for RAW messages
 	// Used to generate collector hash for OpenBMP compatibility
 	AdminID string
+	// RouterGroup is the router group of the OpenBMP header of RAW messages
+	RouterGroup string
+	// StructuredExtCommunities enables the typed representation of Extended
+	// Communities in published BaseAttributes
+	StructuredExtCommunities bool
+	// Observers are notified of every message published by the producer
+	Observers []Observer
+	// VRFMap when set attaches the VRF name to L3VPN messages, the map learns
+	// Route Distinguisher to VRF associations from RFC 9069 Loc-RIB instances.
+	VRFMap *vrf.Map
+	// VRFTopics publishes L3VPN messages with a known VRF to the per-VRF
+	// sub-topics of the L3VPN topics, when the publisher supports sub-topics.
+	VRFTopics bool
+	// RouteLeak enables the RFC 9234 route leak checks of Unicast Prefix
+	// messages and the publishing of route_leak events.
+	RouteLeak bool
+	// BGPRoles overrides per peer address the BGP Role of the peer learned from
+	// the Peer Up OPEN messages, for peers without the BGP Role capability.
+	BGPRoles map[string]bgp.BGPRole
+	// Rules when set are evaluated for every parsed message before it is
+	// published, dropping, sampling, labelling or routing it to a sub-topic.
+	Rules *rules.Engine
+	// Profile when set projects the published messages on the fields of an
+	// output profile.
+	Profile *profile.Profile
+	// BaseAttributes enables the base_attribute messages, each unique set of
+	// base attributes of the Unicast and L3VPN prefixes is published once per
+	// router. BaseAttributeCacheSize is the number of recently published
+	// hashes remembered, DefaultBaseAttributeCacheSize when zero.
+	BaseAttributes         bool
+	BaseAttributeCacheSize int
+	// NormalizedBaseAttributes replaces the base attributes of the published
+	// prefix messages by their base_attr_hash, with BaseAttributes.
+	NormalizedBaseAttributes bool
+	// Inventory when set adds the labels of the router, peer, peer ASN and RD
+	// of every published message to its user labels.
+	Inventory *inventory.Inventory
+	// GeoIP when set adds the AS names and the country of the local MaxMind
+	// format databases to the Unicast prefix and the peer messages.
+	GeoIP *geoip.Enricher
+}
+
+// Observer receives the typed messages the producer publishes, before they are
+// marshalled, allowing in-collector consumers such as the BGP-LS topology to
+// use them without decoding the published JSON. The messages of a peer are
+// observed in the order they are received, Observe is called concurrently for
+// the messages of different peers.
+type Observer interface {
+	Observe(msgType int, msg interface{})
 }
 
 // Producer defines methods to act as a message producer
 type Producer interface {
 	Producer(queue chan bmp.Message, stop chan struct{})
+	// Produce processes a single message in the calling goroutine, for inputs
+	// such as MRT import which do not go through the queue. RouteMonitor and
+	// StatsReport messages wait for the first PeerUp to have been produced.
+	Produce(msg bmp.Message)
 	SetConfig(config *Config) error
 }
 
@@ -64,6 +129,27 @@ type producer struct {
 	collectorAdminID string
 	// adminHash is the MD5 hash of the admin ID for RAW messages
 	adminHash string
+	// routerGroup is the router group of the OpenBMP binary header
+	routerGroup string
+	// structuredExtComm when set populates BaseAttributes.ExtCommunities
+	structuredExtComm bool
+	observers         []Observer
+	vrfMap            *vrf.Map
+	vrfTopics         bool
+	routeLeak         bool
+	bgpRoles          map[string]bgp.BGPRole
+	rules             *rules.Engine
+	profile           *profile.Profile
+	// baseAttrs holds the base attribute hashes recently published per
+	// router, nil when the base_attribute messages are disabled
+	baseAttrs       *hashCache
+	normalizedAttrs bool
+	inventory       *inventory.Inventory
+	geoIP           *geoip.Enricher
+	// vpnRoutes holds per peer the VRF of the announced L3VPN routes, which
+	// is the VRF of their withdrawals
+	vpnLock   sync.Mutex
+	vpnRoutes map[string]map[vpnRoute]string
 }
 
 // Producer dispatches kafka workers upon request received from the channel
@@ -71,10 +157,29 @@ func (p *producer) Producer(queue chan bmp.Message, stop chan struct{}) {
 	// Store stop before spawning any goroutine.  The Go memory model guarantees
 	// that all goroutines created inside the loop below observe this write.
 	p.stopCh = stop
+	// The messages of a peer are produced in the order they are received by
+	// the worker of the peer, so that the End-of-RIB follows the routes it
+	// completes and a withdraw the announcement it removes. The peers are
+	// produced concurrently.
+	peers := make(map[string]*peerQueue)
+	// The worker of a peer stops once the peer down message of the peer is
+	// produced, its queue is then released.
+	gone := make(chan *peerQueue)
 	for {
 		select {
 		case msg := <-queue:
-			go p.producingWorker(msg)
+			// RAW messages are published in the order they are received
+			if _, ok := msg.Payload.(*bmp.RawMessage); ok {
+				p.produceRawMessage(msg)
+				continue
+			}
+			if msg.PeerHeader == nil {
+				go p.producingWorker(msg)
+				continue
+			}
+			p.dispatch(peers, gone, msg)
+		case q := <-gone:
+			releasePeer(peers, q)
 		case <-stop:
 			glog.Infof("received interrupt, stopping.")
 			return
@@ -82,6 +187,106 @@ func (p *producer) Producer(queue chan bmp.Message, stop chan struct{}) {
 	}
 }
 
+// dispatch pushes msg to the queue of its peer, starting the worker of the
+// peer when the peer has none or its worker has stopped after a peer down.
+func (p *producer) dispatch(peers map[string]*peerQueue, gone chan *peerQueue, msg bmp.Message) {
+	key := msg.PeerHeader.GetPeerHash()
+	if pq, ok := peers[key]; ok && pq.push(msg) {
+		return
+	}
+	pq := &peerQueue{key: key, ready: make(chan struct{}, 1)}
+	peers[key] = pq
+	go p.peerWorker(pq, gone)
+	pq.push(msg)
+}
+
+// releasePeer removes the queue of a stopped worker, unless a new worker has
+// already replaced it.
+func releasePeer(peers map[string]*peerQueue, q *peerQueue) {
+	if peers[q.key] == q {
+		delete(peers, q.key)
+	}
+}
+
+// peerQueue is the unbounded queue of the messages of a peer waiting for the
+// worker of the peer, the dispatch loop never blocks on a peer whose worker
+// waits for the first PeerUp.
+type peerQueue struct {
+	key    string
+	mu     sync.Mutex
+	msgs   []bmp.Message
+	closed bool
+	ready  chan struct{}
+}
+
+// push queues msg, it returns false when the worker of the queue has stopped.
+func (q *peerQueue) push(msg bmp.Message) bool {
+	q.mu.Lock()
+	if q.closed {
+		q.mu.Unlock()
+		return false
+	}
+	q.msgs = append(q.msgs, msg)
+	q.mu.Unlock()
+	select {
+	case q.ready <- struct{}{}:
+	default:
+	}
+	return true
+}
+
+func (q *peerQueue) pop() []bmp.Message {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	msgs := q.msgs
+	q.msgs = nil
+	return msgs
+}
+
+// close closes the queue when it is empty, the messages pushed after the
+// peer down are left to the worker otherwise.
+func (q *peerQueue) close() bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if len(q.msgs) != 0 {
+		return false
+	}
+	q.closed = true
+	return true
+}
+
+// peerWorker produces the messages of a peer in order until the peer goes
+// down or the producer stops.
+func (p *producer) peerWorker(q *peerQueue, gone chan *peerQueue) {
+	for {
+		select {
+		case <-q.ready:
+			down := false
+			for msgs := q.pop(); len(msgs) != 0; msgs = q.pop() {
+				for _, msg := range msgs {
+					p.producingWorker(msg)
+					_, down = msg.Payload.(*bmp.PeerDownMessage)
+				}
+			}
+			if !down || !q.close() {
+				continue
+			}
+			select {
+			case gone <- q:
+			case <-p.stopCh:
+			}
+			return
+		case <-p.stopCh:
+			return
+		}
+	}
+}
+
+// Produce processes msg synchronously.
+func (p *producer) Produce(msg bmp.Message) {
+	p.producingWorker(msg)
+}
+
 func (p *producer) producingWorker(msg bmp.Message) {
 	switch obj := msg.Payload.(type) {
 	case *bmp.PeerUpMessage:
@@ -131,6 +336,21 @@ func (p *producer) SetConfig(config *Config) error {
 		hash := md5.Sum([]byte(config.AdminID))
 		p.adminHash = hex.EncodeToString(hash[:])
 	}
+	p.routerGroup = config.RouterGroup
+	p.structuredExtComm = config.StructuredExtCommunities
+	p.observers = config.Observers
+	p.vrfMap = config.VRFMap
+	p.vrfTopics = config.VRFTopics
+	p.routeLeak = config.RouteLeak
+	p.bgpRoles = config.BGPRoles
+	p.rules = config.Rules
+	p.profile = config.Profile
+	p.inventory = config.Inventory
+	p.geoIP = config.GeoIP
+	if config.BaseAttributes {
+		p.baseAttrs = newHashCache(config.BaseAttributeCacheSize)
+		p.normalizedAttrs = config.NormalizedBaseAttributes
+	}
 
 	return nil
 }
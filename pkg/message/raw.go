@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"crypto/md5"
 	"encoding/binary"
+	"encoding/hex"
 	"fmt"
 	"net"
 	"time"
@@ -73,9 +74,6 @@ func (p *producer) produceRawMessage(msg bmp.Message) {
 	// Determine if router uses IPv6
 	routerIsIPv6 := isIPv6(routerIPStr)
 
-	// Router group - using empty string as gobmp doesn't have router group concept
-	routerGroup := ""
-
 	// Calculate collector hash (MD5 of collector admin ID)
 	collectorHash := generateMD5Hash([]byte(p.collectorAdminID))
 
@@ -83,7 +81,7 @@ func (p *producer) produceRawMessage(msg bmp.Message) {
 	routerHash := generateMD5Hash([]byte(routerIPStr))
 
 	// Calculate header length
-	headerLen := calculateHeaderLength(p.collectorAdminID, routerGroup)
+	headerLen := calculateHeaderLength(p.collectorAdminID, p.routerGroup)
 
 	// Get current timestamp
 	timestampSec, timestampUsec := getCurrentTimestamp()
@@ -107,8 +105,8 @@ func (p *producer) produceRawMessage(msg bmp.Message) {
 	w.writeString(p.collectorAdminID)        // Offset 40: Collector Admin ID
 	w.writeBytes(routerHash[:])              // Offset 40+N: Router Hash (16 bytes)
 	w.writeBytes(routerIPBytes[:])           // Offset 56+N: Router IP (16 bytes)
-	w.write(uint16(len(routerGroup)))        // Offset 72+N: Router Group Length
-	w.writeString(routerGroup)               // Offset 74+N: Router Group
+	w.write(uint16(len(p.routerGroup)))      // Offset 72+N: Router Group Length
+	w.writeString(p.routerGroup)             // Offset 74+N: Router Group
 	w.write(uint32(1))                       // Offset 74+N+M: Row Count (always 1)
 	w.writeBytes(rm.Msg)                     // Append raw BMP message
 
@@ -118,8 +116,9 @@ func (p *producer) produceRawMessage(msg bmp.Message) {
 		return
 	}
 
-	// Publish to raw topic
-	if err := p.publisher.PublishMessage(bmp.BMPRawMsg, nil, w.buf.Bytes()); err != nil {
+	// Publish to raw topic, keyed by router hash so that the messages of a
+	// router stay in order on a single partition
+	if err := p.publisher.PublishMessage(bmp.BMPRawMsg, []byte(hex.EncodeToString(routerHash[:])), w.buf.Bytes()); err != nil {
 		glog.Errorf("failed to publish RAW message: %v", err)
 	}
 }
@@ -15,12 +15,14 @@ import (
 type mockPublisherRAW struct {
 	lastMessage     []byte
 	lastMessageType int
+	lastHash        string
 	publishCalled   bool
 }
 
 func (m *mockPublisherRAW) PublishMessage(msgType int, msgHash []byte, msg []byte) error {
 	m.publishCalled = true
 	m.lastMessageType = msgType
+	m.lastHash = string(msgHash)
 	m.lastMessage = make([]byte, len(msg))
 	copy(m.lastMessage, msg)
 	return nil
@@ -176,6 +178,35 @@ func TestProduceRawMessage(t *testing.T) {
 	}
 }
 
+// TestProduceRawMessage_RouterGroup tests the router group of the header and
+// the router hash key of RAW messages
+func TestProduceRawMessage_RouterGroup(t *testing.T) {
+	adminID := "c1"
+	mockPub := &mockPublisherRAW{}
+	p := &producer{publisher: mockPub}
+	if err := p.SetConfig(&Config{AdminID: adminID, RouterGroup: "group1"}); err != nil {
+		t.Fatalf("SetConfig() error: %v", err)
+	}
+	rawData := []byte{0x03, 0x00, 0x00, 0x00, 0x06, 0x04}
+	p.produceRawMessage(bmp.Message{Payload: &bmp.RawMessage{Msg: rawData}, SpeakerIP: "192.0.2.1"})
+
+	routerHash := md5.Sum([]byte("192.0.2.1"))
+	if mockPub.lastHash != hex.EncodeToString(routerHash[:]) {
+		t.Errorf("key = %q, want the router hash %x", mockPub.lastHash, routerHash)
+	}
+	output := mockPub.lastMessage
+	if headerLen := binary.BigEndian.Uint16(output[6:8]); headerLen != uint16(78+len(adminID)+len("group1")) {
+		t.Errorf("Header length = %d, want %d", headerLen, 78+len(adminID)+len("group1"))
+	}
+	offset := 40 + len(adminID) + 32
+	if l := binary.BigEndian.Uint16(output[offset : offset+2]); l != uint16(len("group1")) || string(output[offset+2:offset+2+int(l)]) != "group1" {
+		t.Errorf("Router group = %q", output[offset+2:offset+2+int(l)])
+	}
+	if !bytes.Equal(output[len(output)-len(rawData):], rawData) {
+		t.Error("Raw BMP message data does not match expected")
+	}
+}
+
 // TestProduceRawMessage_NilPeerHeader tests error handling for nil peer header
 func TestProduceRawMessage_NilPeerHeader(t *testing.T) {
 	mockPub := &mockPublisherRAW{}
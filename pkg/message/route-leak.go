@@ -0,0 +1,134 @@
+package message
+
+import (
+	"github.com/sbezverk/gobmp/pkg/bgp"
+	"github.com/sbezverk/gobmp/pkg/bmp"
+)
+
+// Route leak reasons, RFC 9234 Section 5
+const (
+	// LeakOTCFromCustomer is a route with the OTC attribute received from a Customer
+	LeakOTCFromCustomer = "otc_from_customer"
+	// LeakOTCFromRSClient is a route with the OTC attribute received from an RS-Client
+	LeakOTCFromRSClient = "otc_from_rs_client"
+	// LeakOTCFromPeer is a route received from a Peer with an OTC attribute
+	// other than the Peer's ASN
+	LeakOTCFromPeer = "otc_from_peer"
+	// LeakOTCToProvider is a route with the OTC attribute sent to a Provider
+	LeakOTCToProvider = "otc_to_provider"
+	// LeakOTCToRS is a route with the OTC attribute sent to an RS
+	LeakOTCToRS = "otc_to_rs"
+	// LeakOTCToPeer is a route sent to a Peer with an OTC attribute other than
+	// the local ASN
+	LeakOTCToPeer = "otc_to_peer"
+)
+
+// RouteLeak defines the route_leak event published for a Unicast Prefix
+// message with a suspected route leak.
+type RouteLeak struct {
+	RouterHash      string   `json:"router_hash,omitempty"`
+	RouterIP        string   `json:"router_ip,omitempty"`
+	PeerHash        string   `json:"peer_hash,omitempty"`
+	PeerIP          string   `json:"peer_ip,omitempty"`
+	PeerASN         uint32   `json:"peer_asn,omitempty"`
+	PeerRole        string   `json:"peer_role,omitempty"`
+	Prefix          string   `json:"prefix,omitempty"`
+	PrefixLen       int32    `json:"prefix_len,omitempty"`
+	IsIPv4          bool     `json:"is_ipv4"`
+	PathID          int32    `json:"path_id,omitempty"`
+	OTC             uint32   `json:"otc,omitempty"`
+	ASPath          []uint32 `json:"as_path,omitempty"`
+	Reason          string   `json:"reason,omitempty"`
+	IsAdjRIBInPost  bool     `json:"is_adj_rib_in_post_policy"`
+	IsAdjRIBOut     bool     `json:"is_adj_rib_out"`
+	IsAdjRIBOutPost bool     `json:"is_adj_rib_out_post_policy"`
+	Timestamp       string   `json:"timestamp,omitempty"`
+	// UserLabels are the labels added by the publishing rules and the inventory
+	UserLabels map[string]string `json:"user_labels,omitempty"`
+}
+
+// peerRole returns the BGP Role of the peer of ph, a configured role takes
+// precedence over the role learned from the Peer Up message.
+func (p *producer) peerRole(ph *bmp.PerPeerHeader) (bgp.BGPRole, uint32, bool) {
+	p.tableLock.RLock()
+	props, ok := p.tableProperties[ph.GetTableKey()]
+	p.tableLock.RUnlock()
+	if role, found := p.bgpRoles[ph.GetPeerAddrString()]; found {
+		return role, props.localASN, true
+	}
+	if !ok {
+		return 0, 0, false
+	}
+
+	return props.peerRole, props.localASN, props.hasPeerRole
+}
+
+// checkRouteLeak applies the RFC 9234 ingress procedure to the routes received
+// by the router and the egress procedure to the routes it sends, it flags a
+// route failing them as a suspected leak and returns its route_leak event.
+func (p *producer) checkRouteLeak(m *UnicastPrefix, ph *bmp.PerPeerHeader) *RouteLeak {
+	if !p.routeLeak || m.Action != "add" || m.IsEOR || m.IsLocRIB || m.BaseAttributes == nil || m.BaseAttributes.OTC == 0 {
+		return nil
+	}
+	role, localASN, ok := p.peerRole(ph)
+	if !ok {
+		return nil
+	}
+	m.LeakReason = leakReason(role, m.IsAdjRIBOut, m.BaseAttributes.OTC, m.PeerASN, localASN)
+	if m.LeakReason == "" {
+		return nil
+	}
+	m.LeakSuspected = true
+
+	return &RouteLeak{
+		RouterHash:      m.RouterHash,
+		RouterIP:        m.RouterIP,
+		PeerHash:        m.PeerHash,
+		PeerIP:          m.PeerIP,
+		PeerASN:         m.PeerASN,
+		PeerRole:        role.String(),
+		Prefix:          m.Prefix,
+		PrefixLen:       m.PrefixLen,
+		IsIPv4:          m.IsIPv4,
+		PathID:          m.PathID,
+		OTC:             m.BaseAttributes.OTC,
+		ASPath:          m.BaseAttributes.ASPath,
+		Reason:          m.LeakReason,
+		IsAdjRIBInPost:  m.IsAdjRIBInPost,
+		IsAdjRIBOut:     m.IsAdjRIBOut,
+		IsAdjRIBOutPost: m.IsAdjRIBOutPost,
+		Timestamp:       m.Timestamp,
+	}
+}
+
+// leakReason returns the reason a route carrying the OTC attribute otc,
+// received from or sent to a peer with the role, is a leak, or an empty string.
+func leakReason(role bgp.BGPRole, egress bool, otc, peerASN, localASN uint32) string {
+	if egress {
+		// A route with the OTC attribute must not be sent to Providers, Peers
+		// or RSes, except with the local ASN added when sending to a Peer.
+		switch role {
+		case bgp.BGPRoleProvider:
+			return LeakOTCToProvider
+		case bgp.BGPRoleRS:
+			return LeakOTCToRS
+		case bgp.BGPRolePeer:
+			if localASN != 0 && otc != localASN {
+				return LeakOTCToPeer
+			}
+		}
+		return ""
+	}
+	switch role {
+	case bgp.BGPRoleCustomer:
+		return LeakOTCFromCustomer
+	case bgp.BGPRoleRSClient:
+		return LeakOTCFromRSClient
+	case bgp.BGPRolePeer:
+		if otc != peerASN {
+			return LeakOTCFromPeer
+		}
+	}
+
+	return ""
+}
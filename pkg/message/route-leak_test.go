@@ -0,0 +1,102 @@
+package message
+
+import (
+	"testing"
+
+	"github.com/sbezverk/gobmp/pkg/bgp"
+	"github.com/sbezverk/gobmp/pkg/bmp"
+)
+
+func TestLeakReason(t *testing.T) {
+	tests := []struct {
+		name   string
+		role   bgp.BGPRole
+		egress bool
+		otc    uint32
+		want   string
+	}{
+		{name: "from customer", role: bgp.BGPRoleCustomer, otc: 65001, want: LeakOTCFromCustomer},
+		{name: "from rs client", role: bgp.BGPRoleRSClient, otc: 65001, want: LeakOTCFromRSClient},
+		{name: "from peer with peer otc", role: bgp.BGPRolePeer, otc: 65001},
+		{name: "from peer with other otc", role: bgp.BGPRolePeer, otc: 65002, want: LeakOTCFromPeer},
+		{name: "from provider", role: bgp.BGPRoleProvider, otc: 65002},
+		{name: "from rs", role: bgp.BGPRoleRS, otc: 65002},
+		{name: "to provider", role: bgp.BGPRoleProvider, egress: true, otc: 65000, want: LeakOTCToProvider},
+		{name: "to rs", role: bgp.BGPRoleRS, egress: true, otc: 65000, want: LeakOTCToRS},
+		{name: "to peer with local otc", role: bgp.BGPRolePeer, egress: true, otc: 65000},
+		{name: "to peer with other otc", role: bgp.BGPRolePeer, egress: true, otc: 65002, want: LeakOTCToPeer},
+		{name: "to customer", role: bgp.BGPRoleCustomer, egress: true, otc: 65002},
+		{name: "to rs client", role: bgp.BGPRoleRSClient, egress: true, otc: 65002},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := leakReason(tt.role, tt.egress, tt.otc, 65001, 65000); got != tt.want {
+				t.Errorf("leakReason() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckRouteLeak(t *testing.T) {
+	route := func(otc uint32) *UnicastPrefix {
+		return &UnicastPrefix{
+			Action:         "add",
+			PeerASN:        65000,
+			Prefix:         "192.0.2.0",
+			PrefixLen:      24,
+			IsIPv4:         true,
+			BaseAttributes: &bgp.BaseAttributes{OTC: otc, ASPath: []uint32{65000, 65010}},
+		}
+	}
+	tests := []struct {
+		name     string
+		enabled  bool
+		sentRole []byte
+		recvRole []byte
+		override bool
+		otc      uint32
+		want     string
+	}{
+		{name: "disabled", recvRole: []byte{byte(bgp.BGPRoleCustomer)}, otc: 65010},
+		{name: "no otc", enabled: true, recvRole: []byte{byte(bgp.BGPRoleCustomer)}},
+		{name: "no role", enabled: true, otc: 65010},
+		{name: "received role", enabled: true, recvRole: []byte{byte(bgp.BGPRoleCustomer)}, otc: 65010, want: LeakOTCFromCustomer},
+		{name: "sent role", enabled: true, sentRole: []byte{byte(bgp.BGPRoleProvider)}, otc: 65010, want: LeakOTCFromCustomer},
+		{name: "peer", enabled: true, recvRole: []byte{byte(bgp.BGPRolePeer)}, otc: 65000},
+		{name: "override", enabled: true, recvRole: []byte{byte(bgp.BGPRolePeer)}, override: true, otc: 65000, want: LeakOTCFromRSClient},
+		{name: "override without capability", enabled: true, override: true, otc: 65000, want: LeakOTCFromRSClient},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewProducer(&mockPublisher{}, false).(*producer)
+			ph := makePeerHeader(t, bmp.PeerType0, 0x00)
+			cfg := &Config{RouteLeak: tt.enabled}
+			if tt.override {
+				cfg.BGPRoles = map[string]bgp.BGPRole{ph.GetPeerAddrString(): bgp.BGPRoleRSClient}
+			}
+			if err := p.SetConfig(cfg); err != nil {
+				t.Fatalf("SetConfig() error: %v", err)
+			}
+			peerUp := buildPeerUpMessage(t, "192.168.1.1")
+			if tt.sentRole != nil {
+				peerUp.SentOpen.Capabilities = bgp.Capability{9: {{Value: tt.sentRole}}}
+			}
+			if tt.recvRole != nil {
+				peerUp.ReceivedOpen.Capabilities = bgp.Capability{9: {{Value: tt.recvRole}}}
+			}
+			p.producePeerMessage(peerUP, bmp.Message{PeerHeader: ph, Payload: peerUp})
+
+			m := route(tt.otc)
+			leak := p.checkRouteLeak(m, ph)
+			if m.LeakReason != tt.want || m.LeakSuspected != (tt.want != "") {
+				t.Fatalf("leak_suspected = %t, leak_reason = %q, want %q", m.LeakSuspected, m.LeakReason, tt.want)
+			}
+			if (leak != nil) != (tt.want != "") {
+				t.Fatalf("route_leak event = %+v, want reason %q", leak, tt.want)
+			}
+			if leak != nil && (leak.Reason != tt.want || leak.OTC != tt.otc || leak.Prefix != "192.0.2.0" || leak.PeerRole == "") {
+				t.Errorf("route_leak event = %+v", leak)
+			}
+		})
+	}
+}
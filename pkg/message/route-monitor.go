@@ -3,10 +3,13 @@ package message
 import (
 	"encoding/json"
 	"fmt"
+	"reflect"
 
 	"github.com/golang/glog"
 	"github.com/sbezverk/gobmp/pkg/bgp"
 	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/pub"
+	"github.com/sbezverk/gobmp/pkg/rules"
 )
 
 const (
@@ -33,6 +36,13 @@ func (p *producer) produceRouteMonitorMessage(msg bmp.Message) {
 	if routeMonitorMsg.Update == nil {
 		return
 	}
+	if p.structuredExtComm && routeMonitorMsg.Update.BaseAttributes != nil {
+		routeMonitorMsg.Update.BaseAttributes.PopulateExtCommunities()
+	}
+	eor, isEOR := endOfRIBFamily(routeMonitorMsg.Update)
+	if isEOR {
+		defer p.endOfRIB(msg.PeerHeader, eor)
+	}
 	attrType := uint8(0)
 	index := 0
 	if len(routeMonitorMsg.Update.PathAttributes) != 0 {
@@ -51,7 +61,10 @@ func (p *producer) produceRouteMonitorMessage(msg bmp.Message) {
 			glog.Errorf("failed to process MP_REACH_NLRI with error: %+v", err)
 			return
 		}
-		p.processMPUpdate(nlri, AddPrefix, msg.PeerHeader, routeMonitorMsg.Update)
+		routes := p.processMPUpdate(nlri, AddPrefix, msg.PeerHeader, routeMonitorMsg.Update)
+		if n, ok := nlri.(*bgp.MPReachNLRI); ok {
+			p.countSyncPrefixes(msg.PeerHeader, bgp.AFISAFI{AFI: n.AddressFamilyID, SAFI: n.SubAddressFamilyID}, routes)
+		}
 	case 15:
 		// MP_UNREACH_NLRI - Use per-table AddPath capability
 		nlri, err := bgp.UnmarshalMPUnReachNLRI(
@@ -68,6 +81,7 @@ func (p *producer) produceRouteMonitorMessage(msg bmp.Message) {
 		if p.splitAF {
 			t = bmp.UnicastPrefixV4Msg
 		}
+		ph := msg.PeerHeader
 		// Original BGP's NLRI messages processing
 		msgs := make([]*UnicastPrefix, 0)
 		if routeMonitorMsg.Update.WithdrawnRoutesLength != 0 {
@@ -84,24 +98,155 @@ func (p *producer) produceRouteMonitorMessage(msg bmp.Message) {
 			return
 		}
 		msgs = append(msgs, msg...)
+		// Updates without NLRI produce an EoR flagged message, they are not routes
+		routes := 0
+		for _, m := range msg {
+			if !m.IsEOR {
+				routes++
+			}
+		}
+		p.countSyncPrefixes(ph, bgp.AFISAFI{AFI: 1, SAFI: 1}, routes)
 		// Loop through and publish all collected messages
 		for _, m := range msgs {
+			leak := p.checkRouteLeak(m, ph)
 			if err := p.marshalAndPublish(&m, t, []byte(m.RouterHash)); err != nil {
 				glog.Errorf("failed to process Unicast Prefix message with error: %+v", err)
 				return
 			}
+			if leak != nil {
+				if err := p.marshalAndPublish(leak, bmp.RouteLeakMsg, []byte(m.RouterHash)); err != nil {
+					glog.Errorf("failed to process Route Leak message with error: %+v", err)
+					return
+				}
+			}
 		}
 	}
 }
 
 func (p *producer) marshalAndPublish(msg interface{}, msgType int, hash []byte) error {
+	_, err := p.decideAndPublish(msg, msgType, hash)
+	return err
+}
+
+// decideAndPublish publishes the message msg as marshalAndPublish does and
+// returns the decision of the publishing rules for the message, the zero
+// Decision without rules.
+func (p *producer) decideAndPublish(msg interface{}, msgType int, hash []byte) (rules.Decision, error) {
 	ensureMessageHash(msg)
-	j, err := json.Marshal(msg)
+	// The publishing rules are evaluated first, the observers do not see the
+	// dropped messages.
+	var d rules.Decision
+	if p.rules != nil {
+		d = p.applyRules(msg, msgType)
+		if d.Drop {
+			return d, nil
+		}
+	}
+	return d, p.enrichAndPublish(msg, msgType, d.Subtopic, hash)
+}
+
+// enrichAndPublish publishes the message msg kept by the publishing rules to
+// the subtopic of the msgType topic, the topic when subtopic is empty.
+func (p *producer) enrichAndPublish(msg interface{}, msgType int, subtopic string, hash []byte) error {
+	if p.inventory != nil {
+		p.applyInventory(msg)
+	}
+	if p.geoIP != nil {
+		p.applyGeoIP(msg)
+	}
+	if len(p.observers) != 0 {
+		om := observed(msg)
+		for _, o := range p.observers {
+			o.Observe(msgType, om)
+		}
+	}
+	// The base attributes are published before the first prefix referencing
+	// them.
+	if p.baseAttrs != nil {
+		if err := p.publishBaseAttribute(msg); err != nil {
+			return err
+		}
+	}
+	if sp, ok := p.structPublisher(); ok {
+		if err := sp.PublishStruct(msgType, subtopic, hash, observed(msg)); err != nil {
+			return fmt.Errorf("failed to push a message of type %d with error: %w", msgType, err)
+		}
+		return nil
+	}
+	j, err := p.marshal(msg, msgType)
 	if err != nil {
 		return fmt.Errorf("failed to marshal a message of type %d with error: %w", msgType, err)
 	}
+	if sp, ok := p.publisher.(pub.SubtopicPublisher); ok && subtopic != "" {
+		if err := sp.PublishMessageToSubtopic(msgType, subtopic, hash, j); err != nil {
+			return fmt.Errorf("failed to push a message of type %d to sub-topic %s with error: %w", msgType, subtopic, err)
+		}
+		return nil
+	}
 	if err := p.publisher.PublishMessage(msgType, hash, j); err != nil {
 		return fmt.Errorf("failed to push a message of type %d to kafka with error: %w", msgType, err)
 	}
 	return nil
 }
+
+// marshal returns the JSON encoding of msg, projected on the fields of the
+// output profile when one is configured.
+func (p *producer) marshal(msg interface{}, msgType int) ([]byte, error) {
+	if p.normalizedAttrs {
+		msg = normalized(msg)
+	}
+	if p.profile == nil {
+		return json.Marshal(msg)
+	}
+	return p.profile.Marshal(msgType, msg)
+}
+
+// structPublisher returns the publisher when it encodes the messages from their
+// structs, the messages projected on an output profile or normalized are
+// published in their JSON encoding.
+func (p *producer) structPublisher() (pub.StructPublisher, bool) {
+	if p.profile != nil || p.normalizedAttrs {
+		return nil, false
+	}
+	sp, ok := p.publisher.(pub.StructPublisher)
+	return sp, ok
+}
+
+// observed returns the message passed to the observers. Messages produced as
+// slices of pointers are published as pointers to pointers, observers always
+// receive a pointer to the message.
+func observed(msg interface{}) interface{} {
+	if v := reflect.ValueOf(msg); v.Kind() == reflect.Ptr && v.Elem().Kind() == reflect.Ptr {
+		return v.Elem().Interface()
+	}
+	return msg
+}
+
+// publishToSubtopic publishes an already published message to a sub-topic of
+// the msgType topic, d is the decision of the publishing rules returned by
+// decideAndPublish for the message. The rules are not evaluated again, a
+// withdrawal has already forgotten the decision of its route, and the message
+// is already enriched. It is a no-op for publishers without sub-topic support.
+func (p *producer) publishToSubtopic(msg interface{}, msgType int, subtopic string, hash []byte, d rules.Decision) error {
+	sp, ok := p.publisher.(pub.SubtopicPublisher)
+	if !ok {
+		return nil
+	}
+	if d.Drop {
+		return nil
+	}
+	if stp, ok := p.structPublisher(); ok {
+		if err := stp.PublishStruct(msgType, subtopic, hash, observed(msg)); err != nil {
+			return fmt.Errorf("failed to push a message of type %d to sub-topic %s with error: %w", msgType, subtopic, err)
+		}
+		return nil
+	}
+	j, err := p.marshal(msg, msgType)
+	if err != nil {
+		return fmt.Errorf("failed to marshal a message of type %d with error: %w", msgType, err)
+	}
+	if err := sp.PublishMessageToSubtopic(msgType, subtopic, hash, j); err != nil {
+		return fmt.Errorf("failed to push a message of type %d to sub-topic %s with error: %w", msgType, subtopic, err)
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+package message
+
+import (
+	"net/netip"
+	"reflect"
+	"strconv"
+
+	"github.com/sbezverk/gobmp/pkg/bgp"
+	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/rules"
+)
+
+// applyRules evaluates the publishing rules for msg, it adds the labels of the
+// matching rules to the message and returns the decision.
+func (p *producer) applyRules(msg interface{}, msgType int) rules.Decision {
+	v := messageStruct(msg)
+	if !v.IsValid() {
+		return p.rules.Evaluate(&rules.Fields{Type: msgType})
+	}
+	d := p.rules.Evaluate(ruleFields(msgType, v))
+	if len(d.Labels) != 0 {
+		if f := v.FieldByName("UserLabels"); f.IsValid() && f.CanSet() {
+			labels := make(map[string]string, f.Len()+len(d.Labels))
+			for n, l := range f.Interface().(map[string]string) {
+				labels[n] = l
+			}
+			for n, l := range d.Labels {
+				labels[n] = l
+			}
+			f.Set(reflect.ValueOf(labels))
+		}
+	}
+
+	return d
+}
+
+// messageStruct returns the struct of a message passed as a pointer or a
+// pointer to a pointer, the zero Value when msg is not a struct.
+func messageStruct(msg interface{}) reflect.Value {
+	v := reflect.ValueOf(msg)
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return reflect.Value{}
+	}
+	return v
+}
+
+// ruleFields returns the fields of the message struct v matched by the
+// publishing rules, the fields are looked up by name as they are common to the
+// messages but not part of an interface.
+func ruleFields(msgType int, v reflect.Value) *rules.Fields {
+	f := &rules.Fields{Type: msgType}
+	if s, ok := stringField(v, "RouterIP"); ok {
+		f.Router, _ = netip.ParseAddr(s)
+	}
+	if s, ok := stringField(v, "PeerIP", "RemoteIP"); ok {
+		f.Peer, _ = netip.ParseAddr(s)
+	}
+	for _, n := range []string{"PeerASN", "RemoteASN"} {
+		if fv := v.FieldByName(n); fv.IsValid() && fv.Kind() == reflect.Uint32 {
+			f.PeerASN = uint32(fv.Uint())
+			break
+		}
+	}
+	f.RIB = messageRIB(v)
+	if fv := v.FieldByName("IsIPv4"); fv.IsValid() && fv.Kind() == reflect.Bool {
+		f.AFI = "ipv6"
+		if fv.Bool() {
+			f.AFI = "ipv4"
+		}
+	} else if fv := v.FieldByName("AFI"); fv.IsValid() && fv.Kind() == reflect.Uint16 {
+		switch fv.Uint() {
+		case 1:
+			f.AFI = "ipv4"
+		case 2:
+			f.AFI = "ipv6"
+		}
+	}
+	if s, ok := stringField(v, "Prefix"); ok {
+		if fv := v.FieldByName("PrefixLen"); fv.IsValid() && fv.Kind() == reflect.Int32 {
+			if a, err := netip.ParseAddr(s); err == nil {
+				f.Prefix, _ = a.Prefix(int(fv.Int()))
+			}
+		}
+	}
+	if s, ok := stringField(v, "Action"); ok {
+		f.Withdraw = s == "del"
+		f.PeerDown = msgType == bmp.PeerStateChangeMsg && s == "down"
+	}
+	rd, _ := stringField(v, "VPNRD", "RD")
+	if fv := v.FieldByName("PathID"); fv.IsValid() && fv.Kind() == reflect.Int32 && fv.Int() != 0 {
+		rd += "#" + strconv.FormatInt(fv.Int(), 10)
+	}
+	f.Route = rd
+	if fv := v.FieldByName("BaseAttributes"); fv.IsValid() {
+		if attrs, ok := fv.Interface().(*bgp.BaseAttributes); ok && attrs != nil {
+			f.ASPath = attrs.ASPath
+			f.Communities = append(f.Communities, attrs.CommunityList...)
+			f.Communities = append(f.Communities, attrs.ExtCommunityList...)
+			f.Communities = append(f.Communities, attrs.LgCommunityList...)
+		}
+	} else if fv := v.FieldByName("ASPath"); fv.IsValid() {
+		f.ASPath, _ = fv.Interface().([]uint32)
+	}
+
+	return f
+}
+
+// stringField returns the value of the first string field of v found of the
+// names.
+func stringField(v reflect.Value, names ...string) (string, bool) {
+	for _, n := range names {
+		if fv := v.FieldByName(n); fv.IsValid() && fv.Kind() == reflect.String {
+			return fv.String(), true
+		}
+	}
+	return "", false
+}
+
+// messageRIB returns the RIB name of a message from its RIB flags, or its RIB
+// field, empty for the messages without RIB.
+func messageRIB(v reflect.Value) string {
+	flag := func(n string) (bool, bool) {
+		fv := v.FieldByName(n)
+		if !fv.IsValid() || fv.Kind() != reflect.Bool {
+			return false, false
+		}
+		return fv.Bool(), true
+	}
+	locRIB, hasLocRIB := flag("IsLocRIB")
+	out, hasOut := flag("IsAdjRIBOut")
+	inPost, hasInPost := flag("IsAdjRIBInPost")
+	outPost, _ := flag("IsAdjRIBOutPost")
+	switch {
+	case !hasLocRIB && !hasOut && !hasInPost:
+		s, _ := stringField(v, "RIB")
+		return s
+	case locRIB:
+		return "loc_rib"
+	case out && outPost:
+		return "adj_rib_out_post"
+	case out:
+		return "adj_rib_out_pre"
+	case inPost:
+		return "adj_rib_in_post"
+	}
+	return "adj_rib_in_pre"
+}
@@ -0,0 +1,290 @@
+package message
+
+import (
+	"encoding/json"
+	"net/netip"
+	"reflect"
+	"testing"
+
+	"github.com/sbezverk/gobmp/pkg/bgp"
+	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/profile"
+	"github.com/sbezverk/gobmp/pkg/rules"
+)
+
+type rulesPublisher struct {
+	recordingPublisher
+	subtopics []string
+}
+
+func (r *rulesPublisher) PublishMessageToSubtopic(msgType int, subtopic string, msgHash []byte, msg []byte) error {
+	r.subtopics = append(r.subtopics, subtopic)
+	return nil
+}
+
+// structPublisher records the messages published from their structs.
+type structPublisher struct {
+	rulesPublisher
+	structs []interface{}
+}
+
+func (s *structPublisher) PublishStruct(msgType int, subtopic string, msgHash []byte, msg interface{}) error {
+	s.structs = append(s.structs, msg)
+	s.subtopics = append(s.subtopics, subtopic)
+	return nil
+}
+
+func TestMarshalAndPublishStruct(t *testing.T) {
+	sp := &structPublisher{}
+	p := NewProducer(sp, true).(*producer)
+	m := &UnicastPrefix{Prefix: "198.51.100.0", PrefixLen: 24, IsIPv4: true}
+	// Messages passed as a pointer to a pointer are published as a pointer
+	if err := p.marshalAndPublish(&m, bmp.UnicastPrefixV4Msg, nil); err != nil {
+		t.Fatalf("marshalAndPublish() error: %v", err)
+	}
+	if err := p.publishToSubtopic(m, bmp.UnicastPrefixV4Msg, "vrf", nil, rules.Decision{}); err != nil {
+		t.Fatalf("publishToSubtopic() error: %v", err)
+	}
+	if !reflect.DeepEqual(sp.structs, []interface{}{m, m}) || !reflect.DeepEqual(sp.subtopics, []string{"", "vrf"}) || len(sp.msgs) != 0 {
+		t.Errorf("published structs %v to sub-topics %q and %d JSON messages", sp.structs, sp.subtopics, len(sp.msgs))
+	}
+	// The messages of an output profile are published in JSON
+	pr, err := profile.New(profile.Compact, nil)
+	if err != nil {
+		t.Fatalf("profile.New() error: %v", err)
+	}
+	if err := p.SetConfig(&Config{Profile: pr}); err != nil {
+		t.Fatalf("SetConfig() error: %v", err)
+	}
+	if err := p.marshalAndPublish(&m, bmp.UnicastPrefixV4Msg, nil); err != nil {
+		t.Fatalf("marshalAndPublish() error: %v", err)
+	}
+	if len(sp.structs) != 2 || len(sp.msgs) != 1 {
+		t.Errorf("published %d structs and %d JSON messages with a profile, want 2 and 1", len(sp.structs), len(sp.msgs))
+	}
+}
+
+type countingObserver struct{ n int }
+
+func (o *countingObserver) Observe(msgType int, msg interface{}) { o.n++ }
+
+func TestMarshalAndPublishRules(t *testing.T) {
+	e, err := rules.New([]rules.Rule{
+		{Match: rules.Match{PeerASNs: []uint32{65099}}, Action: rules.ActionRoute, Subtopic: "lab"},
+		{Match: rules.Match{Routers: []string{"10.0.0.0/24"}}, Action: rules.ActionLabel, Labels: map[string]string{"site": "paris"}},
+		{Match: rules.Match{Prefixes: []string{"192.168.0.0/16 le 32"}, RIBs: []string{"adj_rib_in"}}, Action: rules.ActionDrop},
+	})
+	if err != nil {
+		t.Fatalf("rules.New() error: %v", err)
+	}
+	rp := &rulesPublisher{}
+	o := &countingObserver{}
+	p := NewProducer(rp, true).(*producer)
+	if err := p.SetConfig(&Config{Rules: e, Observers: []Observer{o}}); err != nil {
+		t.Fatalf("SetConfig() error: %v", err)
+	}
+	route := func(peerASN uint32, prefix string) *UnicastPrefix {
+		return &UnicastPrefix{RouterIP: "10.0.0.1", PeerIP: "192.0.2.1", PeerASN: peerASN, Prefix: prefix, PrefixLen: 24, IsIPv4: true}
+	}
+
+	// Messages are labelled and published to their topic
+	m := route(65001, "198.51.100.0")
+	if err := p.marshalAndPublish(&m, bmp.UnicastPrefixV4Msg, nil); err != nil {
+		t.Fatalf("marshalAndPublish() error: %v", err)
+	}
+	if len(rp.msgs) != 1 {
+		t.Fatalf("published %d messages, want 1", len(rp.msgs))
+	}
+	var got UnicastPrefix
+	if err := json.Unmarshal(rp.msgs[0].payload, &got); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got.UserLabels, map[string]string{"site": "paris"}) {
+		t.Errorf("user_labels = %v, want site=paris", got.UserLabels)
+	}
+
+	// Dropped messages are neither published nor observed
+	dropped := route(65001, "192.168.1.0")
+	d, err := p.decideAndPublish(dropped, bmp.UnicastPrefixV4Msg, nil)
+	if err != nil {
+		t.Fatalf("decideAndPublish() error: %v", err)
+	}
+	if err := p.publishToSubtopic(dropped, bmp.UnicastPrefixV4Msg, "vrf", nil, d); err != nil {
+		t.Fatalf("publishToSubtopic() error: %v", err)
+	}
+	if len(rp.msgs) != 1 || len(rp.subtopics) != 0 || o.n != 1 {
+		t.Errorf("dropped message published: %d messages, sub-topics %v, %d observed", len(rp.msgs), rp.subtopics, o.n)
+	}
+
+	// Routed messages are published to the sub-topic only
+	if err := p.marshalAndPublish(route(65099, "198.51.100.0"), bmp.UnicastPrefixV4Msg, nil); err != nil {
+		t.Fatalf("marshalAndPublish() error: %v", err)
+	}
+	if len(rp.msgs) != 1 || !reflect.DeepEqual(rp.subtopics, []string{"lab"}) {
+		t.Errorf("routed message: %d messages, sub-topics %v", len(rp.msgs), rp.subtopics)
+	}
+}
+
+func TestRuleFields(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  interface{}
+		want rules.Fields
+	}{
+		{
+			name: "unicast prefix",
+			msg: &UnicastPrefix{
+				RouterIP:        "10.0.0.1",
+				PeerIP:          "2001:db8::1",
+				PeerASN:         65001,
+				Prefix:          "2001:db8:1::",
+				PrefixLen:       48,
+				IsAdjRIBOut:     true,
+				IsAdjRIBOutPost: true,
+				BaseAttributes: &bgp.BaseAttributes{
+					ASPath:           []uint32{65001, 65002},
+					CommunityList:    []string{"65001:1"},
+					ExtCommunityList: []string{"rt=65001:1"},
+					LgCommunityList:  []string{"65001:1:1"},
+				},
+			},
+			want: rules.Fields{
+				Type:        bmp.UnicastPrefixV6Msg,
+				Router:      netip.MustParseAddr("10.0.0.1"),
+				Peer:        netip.MustParseAddr("2001:db8::1"),
+				PeerASN:     65001,
+				RIB:         "adj_rib_out_post",
+				AFI:         "ipv6",
+				Prefix:      netip.MustParsePrefix("2001:db8:1::/48"),
+				Communities: []string{"65001:1", "rt=65001:1", "65001:1:1"},
+				ASPath:      []uint32{65001, 65002},
+			},
+		},
+		{
+			name: "l3vpn withdrawal",
+			msg:  &L3VPNPrefix{Action: "del", RouterIP: "10.0.0.1", PeerIP: "192.0.2.1", Prefix: "198.51.100.0", PrefixLen: 24, IsIPv4: true, VPNRD: "65000:1", PathID: 2},
+			want: rules.Fields{
+				Type:     bmp.UnicastPrefixV6Msg,
+				Router:   netip.MustParseAddr("10.0.0.1"),
+				Peer:     netip.MustParseAddr("192.0.2.1"),
+				RIB:      "adj_rib_in_pre",
+				AFI:      "ipv4",
+				Prefix:   netip.MustParsePrefix("198.51.100.0/24"),
+				Withdraw: true,
+				Route:    "65000:1#2",
+			},
+		},
+		{
+			name: "peer",
+			msg:  &PeerStateChange{RouterIP: "10.0.0.1", RemoteIP: "192.0.2.1", RemoteASN: 65001, IsLocRIB: true, IsIPv4: true},
+			want: rules.Fields{
+				Type:    bmp.UnicastPrefixV6Msg,
+				Router:  netip.MustParseAddr("10.0.0.1"),
+				Peer:    netip.MustParseAddr("192.0.2.1"),
+				PeerASN: 65001,
+				RIB:     "loc_rib",
+				AFI:     "ipv4",
+			},
+		},
+		{
+			name: "peer sync",
+			msg:  &PeerSync{RouterIP: "10.0.0.1", PeerIP: "192.0.2.1", AFI: 2, RIB: "adj_rib_in_pre"},
+			want: rules.Fields{
+				Type:   bmp.UnicastPrefixV6Msg,
+				Router: netip.MustParseAddr("10.0.0.1"),
+				Peer:   netip.MustParseAddr("192.0.2.1"),
+				RIB:    "adj_rib_in_pre",
+				AFI:    "ipv6",
+			},
+		},
+		{
+			name: "route leak",
+			msg:  &RouteLeak{RouterIP: "10.0.0.1", ASPath: []uint32{65001}, IsAdjRIBInPost: true, IsIPv4: true},
+			want: rules.Fields{
+				Type:   bmp.UnicastPrefixV6Msg,
+				Router: netip.MustParseAddr("10.0.0.1"),
+				RIB:    "adj_rib_in_post",
+				AFI:    "ipv4",
+				ASPath: []uint32{65001},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ruleFields(bmp.UnicastPrefixV6Msg, messageStruct(tt.msg)); !reflect.DeepEqual(*got, tt.want) {
+				t.Errorf("ruleFields() =\n%+v\nwant\n%+v", *got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplyRulesPeerDown(t *testing.T) {
+	e, err := rules.New([]rules.Rule{
+		{Match: rules.Match{Communities: []string{"65000:100"}}, Action: rules.ActionRoute, Subtopic: "lab"},
+	})
+	if err != nil {
+		t.Fatalf("rules.New() error: %v", err)
+	}
+	p := NewProducer(&rulesPublisher{}, true).(*producer)
+	if err := p.SetConfig(&Config{Rules: e}); err != nil {
+		t.Fatalf("SetConfig() error: %v", err)
+	}
+	route := func(action string) *UnicastPrefix {
+		u := &UnicastPrefix{Action: action, RouterIP: "10.0.0.1", PeerIP: "192.0.2.1", PeerASN: 65001, Prefix: "198.51.100.0", PrefixLen: 24, IsIPv4: true}
+		if action == "add" {
+			u.BaseAttributes = &bgp.BaseAttributes{CommunityList: []string{"65000:100"}}
+		}
+		return u
+	}
+	down := &PeerStateChange{Action: "down", RouterIP: "10.0.0.1", RemoteIP: "192.0.2.1", RemoteASN: 65001}
+	if f := ruleFields(bmp.PeerStateChangeMsg, messageStruct(down)); !f.PeerDown || f.Withdraw {
+		t.Errorf("ruleFields() of a peer down = %+v, want PeerDown", f)
+	}
+	if d := p.applyRules(route("add"), bmp.UnicastPrefixV4Msg); d.Subtopic != "lab" {
+		t.Fatalf("applyRules() of the announcement = %+v, want the lab sub-topic", d)
+	}
+	// The decision of the announcement is forgotten with the peer down, the
+	// withdrawal of the route announced again without the community is not
+	// routed.
+	p.applyRules(down, bmp.PeerStateChangeMsg)
+	if d := p.applyRules(route("del"), bmp.UnicastPrefixV4Msg); d.Subtopic != "" {
+		t.Errorf("applyRules() of the withdrawal after the peer down = %+v, want no sub-topic", d)
+	}
+}
+
+func TestPublishToSubtopicWithdraw(t *testing.T) {
+	e, err := rules.New([]rules.Rule{
+		{Match: rules.Match{Communities: []string{"65000:666"}}, Action: rules.ActionDrop},
+	})
+	if err != nil {
+		t.Fatalf("rules.New() error: %v", err)
+	}
+	rp := &rulesPublisher{}
+	p := NewProducer(rp, true).(*producer)
+	if err := p.SetConfig(&Config{Rules: e}); err != nil {
+		t.Fatalf("SetConfig() error: %v", err)
+	}
+	route := func(action string) *L3VPNPrefix {
+		l := &L3VPNPrefix{Action: action, RouterIP: "10.0.0.1", PeerIP: "192.0.2.1", PeerASN: 65001, Prefix: "198.51.100.0", PrefixLen: 24, IsIPv4: true, VRF: "CUST-A"}
+		if action == "add" {
+			l.BaseAttributes = &bgp.BaseAttributes{CommunityList: []string{"65000:666"}}
+		}
+		return l
+	}
+	// The withdrawal of a dropped announcement is dropped from the topic and
+	// from the vrf sub-topic, its decision is forgotten by the first
+	// evaluation.
+	for _, action := range []string{"add", "del"} {
+		m := route(action)
+		d, err := p.decideAndPublish(m, bmp.L3VPNV4Msg, nil)
+		if err != nil {
+			t.Fatalf("decideAndPublish() error: %v", err)
+		}
+		if err := p.publishToSubtopic(m, bmp.L3VPNV4Msg, m.VRF, nil, d); err != nil {
+			t.Fatalf("publishToSubtopic() error: %v", err)
+		}
+	}
+	if len(rp.msgs) != 0 || len(rp.subtopics) != 0 {
+		t.Errorf("dropped route published: %d messages, sub-topics %v", len(rp.msgs), rp.subtopics)
+	}
+}
@@ -0,0 +1,47 @@
+package message
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/go-test/deep"
+	"github.com/sbezverk/gobmp/pkg/srpolicy"
+)
+
+func TestSRPolicyJSONRoundTrip(t *testing.T) {
+	tlv, err := srpolicy.UnmarshalSRPolicyTLV([]byte{
+		0x00, 0x0F, 0x00, 0x30,
+		// Policy Name "gold"
+		0x82, 0x00, 0x05, 0x00, 0x67, 0x6F, 0x6C, 0x64,
+		// SRv6 Binding SID with Endpoint Behavior and SID Structure
+		0x14, 0x1A, 0x20, 0x00,
+		0x20, 0x01, 0x0D, 0xB8, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x00,
+		0x00, 0x39, 0x00, 0x00, 0x20, 0x10, 0x10, 0x00,
+		// Segment List with Weight 5 and no segments
+		0x80, 0x00, 0x09, 0x00, 0x09, 0x06, 0x00, 0x00, 0x00, 0x00, 0x00, 0x05,
+	})
+	if err != nil {
+		t.Fatalf("failed to unmarshal sr policy tlv: %+v", err)
+	}
+	p := &SRPolicy{
+		Action:      "add",
+		Color:       100,
+		PolicyName:  tlv.Name,
+		SRv6BSID:    tlv.SRv6BindingSID,
+		SegmentList: tlv.SegmentList,
+	}
+	b, err := json.Marshal(p)
+	if err != nil {
+		t.Fatalf("failed to marshal sr policy: %+v", err)
+	}
+	var result SRPolicy
+	if err := json.Unmarshal(b, &result); err != nil {
+		t.Fatalf("failed to unmarshal sr policy: %+v", err)
+	}
+	if diffs := deep.Equal(&result, p); len(diffs) != 0 {
+		t.Errorf("round trip differences: %+v", diffs)
+	}
+	if result.PolicyName != "gold" || result.SegmentList[0].Weight.Weight != 5 {
+		t.Errorf("unexpected sr policy %+v", result)
+	}
+}
@@ -25,6 +25,7 @@ type PeerStateChange struct {
 	Sequence        int            `json:"sequence,omitempty"`
 	Hash            string         `json:"hash,omitempty"`
 	RouterHash      string         `json:"router_hash,omitempty"`
+	PeerHash        string         `json:"peer_hash,omitempty"`
 	Name            string         `json:"name,omitempty"`
 	RemoteBGPID     string         `json:"remote_bgp_id,omitempty"`
 	RouterIP        string         `json:"router_ip,omitempty"`
@@ -57,6 +58,10 @@ type PeerStateChange struct {
 	IsAdjRIBOut      bool `json:"is_adj_rib_out"`
 	IsLocRIB         bool `json:"is_loc_rib"`
 	IsLocRIBFiltered bool `json:"is_loc_rib_filtered"`
+	// RemoteASName is set by the GeoIP enrichment
+	RemoteASName string `json:"remote_as_name,omitempty"`
+	// UserLabels are the labels added by the publishing rules and the inventory
+	UserLabels map[string]string `json:"user_labels,omitempty"`
 }
 
 // UnicastPrefix defines a message format sent as a result of BMP Route Monitor message
@@ -71,6 +76,7 @@ type UnicastPrefix struct {
 	RouterHash       string              `json:"router_hash,omitempty"`
 	RouterIP         string              `json:"router_ip,omitempty"`
 	BaseAttributes   *bgp.BaseAttributes `json:"base_attrs,omitempty"`
+	BaseAttrHash     string              `json:"base_attr_hash,omitempty"` // Set instead of base_attrs in the normalized mode
 	PeerHash         string              `json:"peer_hash,omitempty"`
 	PeerIP           string              `json:"peer_ip,omitempty"`
 	PeerType         uint8               `json:"peer_type"`
@@ -88,6 +94,8 @@ type UnicastPrefix struct {
 	OriginValidation *string             `json:"origin_validation,omitempty"` // RFC 8097 RPKI Origin Validation State
 	PrefixSID        *prefixsid.PSid     `json:"prefix_sid,omitempty"`
 	IsEOR            bool                `json:"is_eor,omitempty"`
+	LeakSuspected    bool                `json:"leak_suspected,omitempty"` // RFC 9234 route leak check
+	LeakReason       string              `json:"leak_reason,omitempty"`
 	// Values are assigned based on PerPeerHeader flags
 	IsAdjRIBInPost   bool   `json:"is_adj_rib_in_post_policy"`
 	IsAdjRIBOutPost  bool   `json:"is_adj_rib_out_post_policy"`
@@ -95,6 +103,13 @@ type UnicastPrefix struct {
 	IsLocRIB         bool   `json:"is_loc_rib"`
 	IsLocRIBFiltered bool   `json:"is_loc_rib_filtered"`
 	TableName        string `json:"table_name,omitempty"` // RFC 9069 Table Name for LocRIB
+	// Values are set by the GeoIP enrichment
+	OriginASName string `json:"origin_as_name,omitempty"`
+	OriginASOrg  string `json:"origin_as_org,omitempty"`
+	PeerASName   string `json:"peer_as_name,omitempty"`
+	Country      string `json:"country,omitempty"` // ISO 3166-1 country code of the prefix
+	// UserLabels are the labels added by the publishing rules and the inventory
+	UserLabels map[string]string `json:"user_labels,omitempty"`
 }
 
 func (u *UnicastPrefix) Equal(ou *UnicastPrefix) (bool, []string) {
@@ -183,6 +198,10 @@ func (u *UnicastPrefix) Equal(ou *UnicastPrefix) (bool, []string) {
 		equal = false
 		diffs = append(diffs, "origin_validation mismatch")
 	}
+	if u.LeakSuspected != ou.LeakSuspected || u.LeakReason != ou.LeakReason {
+		equal = false
+		diffs = append(diffs, "leak_suspected mismatch: "+u.LeakReason+" and "+ou.LeakReason)
+	}
 	if u.PrefixSID != nil || ou.PrefixSID != nil {
 		if eq, df := u.PrefixSID.Equal(ou.PrefixSID); !eq {
 			equal = false
@@ -260,6 +279,8 @@ type LSNode struct {
 	// RD is the BGP-LS-VPN Route Distinguisher (RFC 9552 §5.2); set only
 	// for NLRI carried under AFI 16388 / SAFI 72, otherwise omitted.
 	RD string `json:"route_distinguisher,omitempty"`
+	// UserLabels are the labels added by the publishing rules and the inventory
+	UserLabels map[string]string `json:"user_labels,omitempty"`
 }
 
 // LSLink defines a structure of LS link message
@@ -328,6 +349,8 @@ type LSLink struct {
 	UnidirAvailableBW     uint32                        `json:"unidir_available_bw,omitempty"`
 	UnidirBWUtilization   uint32                        `json:"unidir_bw_utilization,omitempty"`
 	OpaqueLinkAttribute   []string                      `json:"opaque_link_attribute,omitempty"` // RFC 9552 §5.3.2.6 TLV 1097, hex-encoded raw values
+	ExtAdminGroup         []uint32                      `json:"ext_admin_group,omitempty"`       // RFC 9104 TLV 1173, set administrative groups
+	L2BundleMember        []*bgpls.L2BundleMember       `json:"l2_bundle_member,omitempty"`      // RFC 9085 §2.2.3 TLV 1172
 	// Values are assigned based on PerPeerHeader flags
 	IsAdjRIBInPost   bool   `json:"is_adj_rib_in_post_policy"`
 	IsAdjRIBOutPost  bool   `json:"is_adj_rib_out_post_policy"`
@@ -338,6 +361,8 @@ type LSLink struct {
 	// RD is the BGP-LS-VPN Route Distinguisher (RFC 9552 §5.2); set only
 	// for NLRI carried under AFI 16388 / SAFI 72, otherwise omitted.
 	RD string `json:"route_distinguisher,omitempty"`
+	// UserLabels are the labels added by the publishing rules and the inventory
+	UserLabels map[string]string `json:"user_labels,omitempty"`
 }
 
 // MulticastPrefix defines a message format sent as a result of BMP Route Monitor message
@@ -372,6 +397,8 @@ type MulticastPrefix struct {
 	IsLocRIB         bool   `json:"is_loc_rib"`
 	IsLocRIBFiltered bool   `json:"is_loc_rib_filtered"`
 	TableName        string `json:"table_name,omitempty"` // RFC 9069 Table Name for LocRIB
+	// UserLabels are the labels added by the publishing rules and the inventory
+	UserLabels map[string]string `json:"user_labels,omitempty"`
 }
 
 // MCASTVPNPrefix defines the structure of MCAST-VPN message (AFI 1/2, SAFI 5)
@@ -409,6 +436,8 @@ type MCASTVPNPrefix struct {
 	IsLocRIB         bool   `json:"is_loc_rib"`
 	IsLocRIBFiltered bool   `json:"is_loc_rib_filtered"`
 	TableName        string `json:"table_name,omitempty"` // RFC 9069 Table Name for LocRIB
+	// UserLabels are the labels added by the publishing rules and the inventory
+	UserLabels map[string]string `json:"user_labels,omitempty"`
 }
 
 // MVPNPrefix defines structure for Multicast VPN (SAFI 129)
@@ -443,6 +472,8 @@ type RTCPrefix struct {
 	IsLocRIB         bool   `json:"is_loc_rib"`
 	IsLocRIBFiltered bool   `json:"is_loc_rib_filtered"`
 	TableName        string `json:"table_name,omitempty"` // RFC 9069 Table Name for LocRIB
+	// UserLabels are the labels added by the publishing rules and the inventory
+	UserLabels map[string]string `json:"user_labels,omitempty"`
 }
 
 // L3VPNPrefix defines the structure of Layer 3 VPN message
@@ -456,6 +487,7 @@ type L3VPNPrefix struct {
 	RouterHash       string              `json:"router_hash,omitempty"`
 	RouterIP         string              `json:"router_ip,omitempty"`
 	BaseAttributes   *bgp.BaseAttributes `json:"base_attrs,omitempty"`
+	BaseAttrHash     string              `json:"base_attr_hash,omitempty"` // Set instead of base_attrs in the normalized mode
 	PeerHash         string              `json:"peer_hash,omitempty"`
 	PeerIP           string              `json:"peer_ip,omitempty"`
 	PeerType         uint8               `json:"peer_type"`
@@ -473,6 +505,8 @@ type L3VPNPrefix struct {
 	OriginValidation *string             `json:"origin_validation,omitempty"` // RFC 8097 RPKI Origin Validation State
 	VPNRD            string              `json:"vpn_rd,omitempty"`
 	VPNRDType        uint16              `json:"vpn_rd_type"`
+	RouteTargets     []string            `json:"route_targets,omitempty"`
+	VRF              string              `json:"vrf,omitempty"` // VRF of the route when VRF mapping is enabled
 	PrefixSID        *prefixsid.PSid     `json:"prefix_sid,omitempty"`
 	IsEOR            bool                `json:"is_eor,omitempty"`
 	// Values are assigned based on PerPeerHeader flags
@@ -482,6 +516,8 @@ type L3VPNPrefix struct {
 	IsLocRIB         bool   `json:"is_loc_rib"`
 	IsLocRIBFiltered bool   `json:"is_loc_rib_filtered"`
 	TableName        string `json:"table_name,omitempty"` // RFC 9069 Table Name for LocRIB
+	// UserLabels are the labels added by the publishing rules and the inventory
+	UserLabels map[string]string `json:"user_labels,omitempty"`
 }
 
 // LSPrefix defines a structure of LS Prefix message
@@ -531,6 +567,8 @@ type LSPrefix struct {
 	// RD is the BGP-LS-VPN Route Distinguisher (RFC 9552 §5.2); set only
 	// for NLRI carried under AFI 16388 / SAFI 72, otherwise omitted.
 	RD string `json:"route_distinguisher,omitempty"`
+	// UserLabels are the labels added by the publishing rules and the inventory
+	UserLabels map[string]string `json:"user_labels,omitempty"`
 }
 
 // LSSRv6SID defines a structure of LS SRv6 SID message
@@ -580,6 +618,8 @@ type LSSRv6SID struct {
 	// RD is the BGP-LS-VPN Route Distinguisher (RFC 9552 §5.2); set only
 	// for NLRI carried under AFI 16388 / SAFI 72, otherwise omitted.
 	RD string `json:"route_distinguisher,omitempty"`
+	// UserLabels are the labels added by the publishing rules and the inventory
+	UserLabels map[string]string `json:"user_labels,omitempty"`
 }
 
 // EVPNPrefix defines the structure of EVPN message
@@ -618,6 +658,14 @@ type EVPNPrefix struct {
 	MACLength      uint8               `json:"mac_len,omitempty"`
 	RouteType      uint8               `json:"route_type,omitempty"`
 	PMSITunnel     *pmsi.PMSITunnel    `json:"pmsi_tunnel,omitempty"` // RFC 6514 PMSI Tunnel for Type 3 routes
+	// EVPN Extended Communities decoded from the update (RFC 7432 §7, RFC 8214, RFC 8584, RFC 9135)
+	MACMobility      *bgp.ECMACMobility      `json:"mac_mobility,omitempty"`
+	ESILabel         *bgp.ECESILabel         `json:"esi_label,omitempty"`
+	RouterMAC        string                  `json:"router_mac,omitempty"`
+	DefaultGateway   bool                    `json:"default_gateway,omitempty"`
+	Layer2Attributes *bgp.ECLayer2Attributes `json:"layer2_attributes,omitempty"`
+	DFElection       *bgp.ECDFElection       `json:"df_election,omitempty"`
+	RouteTargets     []string                `json:"route_targets,omitempty"`
 	// Values are assigned based on PerPeerHeader flags
 	IsAdjRIBInPost   bool   `json:"is_adj_rib_in_post_policy"`
 	IsAdjRIBOutPost  bool   `json:"is_adj_rib_out_post_policy"`
@@ -625,6 +673,8 @@ type EVPNPrefix struct {
 	IsLocRIB         bool   `json:"is_loc_rib"`
 	IsLocRIBFiltered bool   `json:"is_loc_rib_filtered"`
 	TableName        string `json:"table_name,omitempty"` // RFC 9069 Table Name for LocRIB
+	// UserLabels are the labels added by the publishing rules and the inventory
+	UserLabels map[string]string `json:"user_labels,omitempty"`
 }
 
 // VPLSPrefix defines the structure of VPLS message (AFI 25, SAFI 65)
@@ -680,41 +730,44 @@ type VPLSPrefix struct {
 	IsLocRIB         bool   `json:"is_loc_rib"`
 	IsLocRIBFiltered bool   `json:"is_loc_rib_filtered"`
 	TableName        string `json:"table_name,omitempty"` // RFC 9069 Table Name for LocRIB
+	// UserLabels are the labels added by the publishing rules and the inventory
+	UserLabels map[string]string `json:"user_labels,omitempty"`
 }
 
 // SRPolicy defines the structure of SR Policy message
 type SRPolicy struct {
-	Key            string                  `json:"_key,omitempty"`
-	ID             string                  `json:"_id,omitempty"`
-	Rev            string                  `json:"_rev,omitempty"`
-	Action         string                  `json:"action,omitempty"` // Action can be "add" or "del"
-	Sequence       int                     `json:"sequence,omitempty"`
-	Hash           string                  `json:"hash,omitempty"`
-	RouterHash     string                  `json:"router_hash,omitempty"`
-	RouterIP       string                  `json:"router_ip,omitempty"`
-	BaseAttributes *bgp.BaseAttributes     `json:"base_attrs,omitempty"`
-	PeerHash       string                  `json:"peer_hash,omitempty"`
-	PeerIP         string                  `json:"peer_ip,omitempty"`
-	PeerType       uint8                   `json:"peer_type"`
-	PeerASN        uint32                  `json:"peer_asn,omitempty"`
-	Timestamp      string                  `json:"timestamp,omitempty"`
-	IsIPv4         bool                    `json:"is_ipv4"`
-	OriginAS       uint32                  `json:"origin_as,omitempty"`
-	Nexthop        string                  `json:"nexthop,omitempty"`
-	ClusterList    string                  `json:"cluster_list,omitempty"`
-	IsNexthopIPv4  bool                    `json:"is_nexthop_ipv4"`
-	PathID         int32                   `json:"path_id,omitempty"`
-	Labels         []uint32                `json:"labels,omitempty"`
-	Distinguisher  uint32                  `json:"distinguisher,omitempty"`
-	Color          uint32                  `json:"color,omitempty"`
-	Endpoint       []byte                  `json:"endpoint,omitempty"`
-	PolicyName     string                  `json:"policy_name,omitempty"`
-	BSID           *srpolicy.BindingSID    `json:"binding_sid,omitempty"`
-	Preference     *srpolicy.Preference    `json:"preference_subtlv,omitempty"`
-	Priority       byte                    `json:"priority_subtlv,omitempty"`
-	PolicyPathName string                  `json:"policy_path_name,omitempty"`
-	ENLP           *srpolicy.ENLP          `json:"enlp_subtlv,omitempty"`
-	SegmentList    []*srpolicy.SegmentList `json:"segment_list_subtlv,omitempty"`
+	Key            string                     `json:"_key,omitempty"`
+	ID             string                     `json:"_id,omitempty"`
+	Rev            string                     `json:"_rev,omitempty"`
+	Action         string                     `json:"action,omitempty"` // Action can be "add" or "del"
+	Sequence       int                        `json:"sequence,omitempty"`
+	Hash           string                     `json:"hash,omitempty"`
+	RouterHash     string                     `json:"router_hash,omitempty"`
+	RouterIP       string                     `json:"router_ip,omitempty"`
+	BaseAttributes *bgp.BaseAttributes        `json:"base_attrs,omitempty"`
+	PeerHash       string                     `json:"peer_hash,omitempty"`
+	PeerIP         string                     `json:"peer_ip,omitempty"`
+	PeerType       uint8                      `json:"peer_type"`
+	PeerASN        uint32                     `json:"peer_asn,omitempty"`
+	Timestamp      string                     `json:"timestamp,omitempty"`
+	IsIPv4         bool                       `json:"is_ipv4"`
+	OriginAS       uint32                     `json:"origin_as,omitempty"`
+	Nexthop        string                     `json:"nexthop,omitempty"`
+	ClusterList    string                     `json:"cluster_list,omitempty"`
+	IsNexthopIPv4  bool                       `json:"is_nexthop_ipv4"`
+	PathID         int32                      `json:"path_id,omitempty"`
+	Labels         []uint32                   `json:"labels,omitempty"`
+	Distinguisher  uint32                     `json:"distinguisher,omitempty"`
+	Color          uint32                     `json:"color,omitempty"`
+	Endpoint       []byte                     `json:"endpoint,omitempty"`
+	PolicyName     string                     `json:"policy_name,omitempty"`
+	BSID           *srpolicy.BindingSID       `json:"binding_sid,omitempty"`
+	SRv6BSID       []*srpolicy.SRv6BindingSID `json:"srv6_binding_sid,omitempty"`
+	Preference     *srpolicy.Preference       `json:"preference_subtlv,omitempty"`
+	Priority       byte                       `json:"priority_subtlv,omitempty"`
+	PolicyPathName string                     `json:"policy_path_name,omitempty"`
+	ENLP           *srpolicy.ENLP             `json:"enlp_subtlv,omitempty"`
+	SegmentList    []*srpolicy.SegmentList    `json:"segment_list_subtlv,omitempty"`
 	// Values are assigned based on PerPeerHeader flags
 	IsAdjRIBInPost   bool   `json:"is_adj_rib_in_post_policy"`
 	IsAdjRIBOutPost  bool   `json:"is_adj_rib_out_post_policy"`
@@ -722,6 +775,8 @@ type SRPolicy struct {
 	IsLocRIB         bool   `json:"is_loc_rib"`
 	IsLocRIBFiltered bool   `json:"is_loc_rib_filtered"`
 	TableName        string `json:"table_name,omitempty"` // RFC 9069 Table Name for LocRIB
+	// UserLabels are the labels added by the publishing rules and the inventory
+	UserLabels map[string]string `json:"user_labels,omitempty"`
 }
 
 // Flowspec defines the structure of SR Policy message
@@ -753,6 +808,8 @@ type Flowspec struct {
 	IsLocRIB         bool   `json:"is_loc_rib"`
 	IsLocRIBFiltered bool   `json:"is_loc_rib_filtered"`
 	TableName        string `json:"table_name,omitempty"` // RFC 9069 Table Name for LocRIB
+	// UserLabels are the labels added by the publishing rules and the inventory
+	UserLabels map[string]string `json:"user_labels,omitempty"`
 }
 
 // AFISAFIStat represents statistics per Address Family (RFC 7854, RFC 8671)
@@ -770,6 +827,7 @@ type Stats struct {
 	Sequence                   int    `json:"sequence,omitempty"`
 	RouterHash                 string `json:"router_hash,omitempty"`
 	RouterIP                   string `json:"router_ip,omitempty"`
+	PeerHash                   string `json:"peer_hash,omitempty"`
 	PeerType                   uint8  `json:"peer_type"`
 	RemoteBGPID                string `json:"remote_bgp_id,omitempty"`
 	RemoteASN                  uint32 `json:"remote_asn,omitempty"`
@@ -799,4 +857,6 @@ type Stats struct {
 	PerAFIPrePolicyAdjRIBOut []AFISAFIStat `json:"per_afi_pre_policy_adj_rib_out,omitempty"`
 	// Type 17: Per-AFI/SAFI Post-policy Adj-RIB-Out (RFC 8671)
 	PerAFIPostPolicyAdjRIBOut []AFISAFIStat `json:"per_afi_post_policy_adj_rib_out,omitempty"`
+	// UserLabels are the labels added by the publishing rules and the inventory
+	UserLabels map[string]string `json:"user_labels,omitempty"`
 }
@@ -0,0 +1,212 @@
+// Package mmdbtest builds small MaxMind DB files for the tests of the
+// packages reading them.
+package mmdbtest
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"net/netip"
+	"os"
+	"sort"
+	"testing"
+)
+
+// Network is a network of the database and its record, the record values are
+// map[string]interface{}, []interface{}, string, float64, bool, uint16,
+// uint32, uint64 and int.
+type Network struct {
+	Prefix string
+	Record interface{}
+}
+
+type node struct {
+	child [2]*node
+	// data is the offset plus one of the record of the leaf nodes
+	data int
+}
+
+// Build returns an IPv6 database of the networks with 28 bits records, the
+// IPv4 networks are stored in ::/96 and the networks must not overlap.
+func Build(databaseType string, networks []Network) ([]byte, error) {
+	root := &node{}
+	data := &bytes.Buffer{}
+	for _, n := range networks {
+		p, err := netip.ParsePrefix(n.Prefix)
+		if err != nil {
+			return nil, err
+		}
+		p = p.Masked()
+		ip, bits := p.Addr().As16(), p.Bits()
+		if p.Addr().Is4() {
+			copy(ip[:12], make([]byte, 12))
+			bits += 96
+		}
+		cur := root
+		for i := 0; i < bits; i++ {
+			if cur.data != 0 {
+				return nil, fmt.Errorf("network %s overlaps", n.Prefix)
+			}
+			b := ip[i>>3] >> (7 - uint(i&7)) & 1
+			if cur.child[b] == nil {
+				cur.child[b] = &node{}
+			}
+			cur = cur.child[b]
+		}
+		if cur.data != 0 || cur.child[0] != nil || cur.child[1] != nil {
+			return nil, fmt.Errorf("network %s overlaps", n.Prefix)
+		}
+		cur.data = data.Len() + 1
+		if err := encode(data, n.Record); err != nil {
+			return nil, err
+		}
+	}
+	// Numbers the internal nodes in breadth first order
+	var nodes []*node
+	index := map[*node]int{}
+	for queue := []*node{root}; len(queue) > 0; queue = queue[1:] {
+		n := queue[0]
+		index[n] = len(nodes)
+		nodes = append(nodes, n)
+		for _, c := range n.child {
+			if c != nil && c.data == 0 {
+				queue = append(queue, c)
+			}
+		}
+	}
+	count := len(nodes)
+	out := &bytes.Buffer{}
+	for _, n := range nodes {
+		var rec [2]uint32
+		for i, c := range n.child {
+			switch {
+			case c == nil:
+				rec[i] = uint32(count)
+			case c.data != 0:
+				rec[i] = uint32(count + 16 + c.data - 1)
+			default:
+				rec[i] = uint32(index[c])
+			}
+		}
+		out.Write([]byte{
+			byte(rec[0] >> 16), byte(rec[0] >> 8), byte(rec[0]),
+			byte(rec[0]>>20&0xf0 | rec[1]>>24&0x0f),
+			byte(rec[1] >> 16), byte(rec[1] >> 8), byte(rec[1]),
+		})
+	}
+	out.Write(make([]byte, 16))
+	out.Write(data.Bytes())
+	out.WriteString("\xab\xcd\xefMaxMind.com")
+	err := encode(out, map[string]interface{}{
+		"binary_format_major_version": uint16(2),
+		"binary_format_minor_version": uint16(0),
+		"build_epoch":                 uint64(1700000000),
+		"database_type":               databaseType,
+		"description":                 map[string]interface{}{"en": "gobmp test database"},
+		"ip_version":                  uint16(6),
+		"languages":                   []interface{}{"en"},
+		"node_count":                  uint32(count),
+		"record_size":                 uint16(28),
+	})
+	return out.Bytes(), err
+}
+
+// Write writes the database of the networks to the file path.
+func Write(t testing.TB, path, databaseType string, networks []Network) {
+	t.Helper()
+	b, err := Build(databaseType, networks)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, b, 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func control(buf *bytes.Buffer, t int, size int) {
+	var ctrl byte
+	if t <= 7 {
+		ctrl = byte(t) << 5
+	}
+	var ext []byte
+	switch {
+	case size < 29:
+		ctrl |= byte(size)
+	case size < 285:
+		ctrl |= 29
+		ext = []byte{byte(size - 29)}
+	case size < 65821:
+		ctrl |= 30
+		s := size - 285
+		ext = []byte{byte(s >> 8), byte(s)}
+	default:
+		ctrl |= 31
+		s := size - 65821
+		ext = []byte{byte(s >> 16), byte(s >> 8), byte(s)}
+	}
+	buf.WriteByte(ctrl)
+	if t > 7 {
+		buf.WriteByte(byte(t - 7))
+	}
+	buf.Write(ext)
+}
+
+func encodeUint(buf *bytes.Buffer, t int, v uint64, max int) {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, v)
+	b = bytes.TrimLeft(b, "\x00")
+	if len(b) > max {
+		b = b[len(b)-max:]
+	}
+	control(buf, t, len(b))
+	buf.Write(b)
+}
+
+func encode(buf *bytes.Buffer, v interface{}) error {
+	switch v := v.(type) {
+	case string:
+		control(buf, 2, len(v))
+		buf.WriteString(v)
+	case float64:
+		control(buf, 3, 8)
+		binary.Write(buf, binary.BigEndian, math.Float64bits(v))
+	case uint16:
+		encodeUint(buf, 5, uint64(v), 2)
+	case uint32:
+		encodeUint(buf, 6, uint64(v), 4)
+	case int:
+		encodeUint(buf, 6, uint64(v), 4)
+	case uint64:
+		encodeUint(buf, 9, v, 8)
+	case bool:
+		s := 0
+		if v {
+			s = 1
+		}
+		control(buf, 14, s)
+	case map[string]interface{}:
+		control(buf, 7, len(v))
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			encode(buf, k)
+			if err := encode(buf, v[k]); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		control(buf, 11, len(v))
+		for _, e := range v {
+			if err := encode(buf, e); err != nil {
+				return err
+			}
+		}
+	default:
+		return fmt.Errorf("unsupported record value of type %T", v)
+	}
+	return nil
+}
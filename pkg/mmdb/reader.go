@@ -0,0 +1,438 @@
+// Package mmdb reads the MaxMind DB files, the IP address databases such as
+// the GeoLite2 / GeoIP2 and ipinfo ASN and country databases, per the MaxMind
+// DB File Format Specification version 2.0. The database is read in memory,
+// records are decoded to Go values: map[string]interface{} for maps,
+// []interface{} for arrays, string, []byte, float64, float32, bool, int32,
+// uint16, uint32, uint64 and *big.Int for the 128 bits unsigned integers.
+package mmdb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"net/netip"
+	"os"
+)
+
+const (
+	maxFileSize = 1024 * 1024 * 1024 // 1 GB
+	// dataSeparator is the size of the zero bytes between the search tree and
+	// the data section
+	dataSeparator = 16
+	// maxDepth bounds the nesting of the decoded values
+	maxDepth = 64
+)
+
+// metadataMarker starts the metadata section at the end of the file.
+var metadataMarker = []byte("\xab\xcd\xefMaxMind.com")
+
+// Data types of the data section
+const (
+	typeExtended = iota
+	typePointer
+	typeString
+	typeDouble
+	typeBytes
+	typeUint16
+	typeUint32
+	typeMap
+	typeInt32
+	typeUint64
+	typeUint128
+	typeArray
+	typeContainer
+	typeEndMarker
+	typeBool
+	typeFloat
+)
+
+// Metadata holds the metadata of a database.
+type Metadata struct {
+	NodeCount    uint32
+	RecordSize   uint16
+	IPVersion    uint16
+	DatabaseType string
+	BuildEpoch   uint64
+	Languages    []string
+	Description  map[string]string
+}
+
+// Reader looks up the records of the addresses in a database, it is safe for
+// concurrent use.
+type Reader struct {
+	Metadata Metadata
+	buf      []byte
+	// data is the data section
+	data     []byte
+	nodeSize int
+	// ipv4Start is the node of the IPv4 addresses of an IPv6 database
+	ipv4Start uint32
+}
+
+// Open returns the Reader of the database file path.
+func Open(path string) (*Reader, error) {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if fi.Size() > maxFileSize {
+		return nil, fmt.Errorf("mmdb file size exceeds the maximum allowed size of %d bytes", maxFileSize)
+	}
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	r, err := New(b)
+	if err != nil {
+		return nil, fmt.Errorf("invalid mmdb file %s: %w", path, err)
+	}
+	return r, nil
+}
+
+// New returns the Reader of the database b.
+func New(b []byte) (*Reader, error) {
+	i := bytes.LastIndex(b, metadataMarker)
+	if i < 0 {
+		return nil, errors.New("metadata section not found")
+	}
+	md := &decoder{buf: b[i+len(metadataMarker):]}
+	v, _, err := md.decode(0, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode metadata with error: %w", err)
+	}
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return nil, errors.New("metadata is not a map")
+	}
+	r := &Reader{buf: b}
+	if err := r.Metadata.parse(m); err != nil {
+		return nil, err
+	}
+	switch r.Metadata.RecordSize {
+	case 24, 28, 32:
+		r.nodeSize = int(r.Metadata.RecordSize) / 4
+	default:
+		return nil, fmt.Errorf("unsupported record size %d", r.Metadata.RecordSize)
+	}
+	treeSize := uint64(r.Metadata.NodeCount) * uint64(r.nodeSize)
+	if treeSize+dataSeparator > uint64(i) {
+		return nil, errors.New("search tree exceeds the file size")
+	}
+	r.data = b[treeSize+dataSeparator : i]
+	if r.Metadata.IPVersion == 6 {
+		node := uint32(0)
+		for n := 0; n < 96 && node < r.Metadata.NodeCount; n++ {
+			node = r.record(node, 0)
+		}
+		r.ipv4Start = node
+	}
+
+	return r, nil
+}
+
+func (m *Metadata) parse(v map[string]interface{}) error {
+	num := func(k string) (uint64, error) {
+		switch n := v[k].(type) {
+		case uint16:
+			return uint64(n), nil
+		case uint32:
+			return uint64(n), nil
+		case uint64:
+			return n, nil
+		}
+		return 0, fmt.Errorf("metadata %s is missing", k)
+	}
+	if major, err := num("binary_format_major_version"); err != nil || major != 2 {
+		return fmt.Errorf("unsupported binary format major version %v", v["binary_format_major_version"])
+	}
+	n, err := num("node_count")
+	if err != nil {
+		return err
+	}
+	m.NodeCount = uint32(n)
+	if n, err = num("record_size"); err != nil {
+		return err
+	}
+	m.RecordSize = uint16(n)
+	if n, err = num("ip_version"); err != nil {
+		return err
+	}
+	if n != 4 && n != 6 {
+		return fmt.Errorf("unsupported ip version %d", n)
+	}
+	m.IPVersion = uint16(n)
+	m.BuildEpoch, _ = num("build_epoch")
+	m.DatabaseType, _ = v["database_type"].(string)
+	if l, ok := v["languages"].([]interface{}); ok {
+		for _, s := range l {
+			if s, ok := s.(string); ok {
+				m.Languages = append(m.Languages, s)
+			}
+		}
+	}
+	if d, ok := v["description"].(map[string]interface{}); ok {
+		m.Description = make(map[string]string, len(d))
+		for k, s := range d {
+			m.Description[k], _ = s.(string)
+		}
+	}
+	return nil
+}
+
+// record returns the record of the bit of node.
+func (r *Reader) record(node uint32, bit uint) uint32 {
+	b := r.buf[int(node)*r.nodeSize:]
+	switch r.Metadata.RecordSize {
+	case 24:
+		b = b[bit*3:]
+		return uint32(b[0])<<16 | uint32(b[1])<<8 | uint32(b[2])
+	case 28:
+		if bit == 0 {
+			return uint32(b[3]&0xf0)<<20 | uint32(b[0])<<16 | uint32(b[1])<<8 | uint32(b[2])
+		}
+		return uint32(b[3]&0x0f)<<24 | uint32(b[4])<<16 | uint32(b[5])<<8 | uint32(b[6])
+	}
+	return binary.BigEndian.Uint32(b[bit*4:])
+}
+
+// Lookup returns the record of the address addr and the prefix length of its
+// network, a nil record when addr is not in the database.
+func (r *Reader) Lookup(addr netip.Addr) (interface{}, int, error) {
+	addr = addr.Unmap()
+	node := uint32(0)
+	if addr.Is4() && r.Metadata.IPVersion == 6 {
+		node = r.ipv4Start
+	} else if addr.Is6() && r.Metadata.IPVersion == 4 {
+		return nil, 0, fmt.Errorf("ipv6 address %s looked up in an ipv4 database", addr)
+	}
+	ip := addr.AsSlice()
+	i := 0
+	for ; i < len(ip)*8 && node < r.Metadata.NodeCount; i++ {
+		node = r.record(node, uint(ip[i>>3]>>(7-uint(i&7))&1))
+	}
+	switch {
+	case node == r.Metadata.NodeCount:
+		return nil, i, nil
+	case node < r.Metadata.NodeCount:
+		return nil, 0, errors.New("invalid search tree")
+	}
+	v, err := r.Decode(node - r.Metadata.NodeCount - dataSeparator)
+	if err != nil {
+		return nil, 0, err
+	}
+	return v, i, nil
+}
+
+// Decode returns the value at offset of the data section.
+func (r *Reader) Decode(offset uint32) (interface{}, error) {
+	if uint64(offset) >= uint64(len(r.data)) {
+		return nil, fmt.Errorf("data offset %d exceeds the data section", offset)
+	}
+	d := &decoder{buf: r.data}
+	v, _, err := d.decode(offset, 0)
+	return v, err
+}
+
+// Offsets calls fn with the data section offset of every record of the
+// database once, until fn returns false.
+func (r *Reader) Offsets(fn func(offset uint32) bool) error {
+	seen := make(map[uint32]bool)
+	var walk func(node uint32, depth int) (bool, error)
+	walk = func(node uint32, depth int) (bool, error) {
+		for bit := uint(0); bit < 2; bit++ {
+			rec := r.record(node, bit)
+			switch {
+			case rec < r.Metadata.NodeCount:
+				if depth >= 128 {
+					return false, errors.New("invalid search tree depth")
+				}
+				if ok, err := walk(rec, depth+1); !ok || err != nil {
+					return ok, err
+				}
+			case rec > r.Metadata.NodeCount:
+				o := rec - r.Metadata.NodeCount - dataSeparator
+				if seen[o] {
+					continue
+				}
+				seen[o] = true
+				if !fn(o) {
+					return false, nil
+				}
+			}
+		}
+		return true, nil
+	}
+	if r.Metadata.NodeCount == 0 {
+		return nil
+	}
+	_, err := walk(0, 0)
+	return err
+}
+
+// decoder decodes the values of a data section.
+type decoder struct {
+	buf []byte
+}
+
+func (d *decoder) bytes(offset uint32, n uint32) ([]byte, error) {
+	end := uint64(offset) + uint64(n)
+	if end > uint64(len(d.buf)) {
+		return nil, errors.New("unexpected end of data")
+	}
+	return d.buf[offset:end], nil
+}
+
+// decode returns the value at offset and the offset following it.
+func (d *decoder) decode(offset uint32, depth int) (interface{}, uint32, error) {
+	if depth > maxDepth {
+		return nil, 0, errors.New("maximum data structure depth exceeded")
+	}
+	b, err := d.bytes(offset, 1)
+	if err != nil {
+		return nil, 0, err
+	}
+	ctrl := b[0]
+	offset++
+	t := int(ctrl >> 5)
+	if t == typePointer {
+		ptr, next, err := d.pointer(ctrl, offset)
+		if err != nil {
+			return nil, 0, err
+		}
+		v, _, err := d.decode(ptr, depth+1)
+		return v, next, err
+	}
+	if t == typeExtended {
+		if b, err = d.bytes(offset, 1); err != nil {
+			return nil, 0, err
+		}
+		t = int(b[0]) + 7
+		offset++
+	}
+	size := uint32(ctrl & 0x1f)
+	if size >= 29 {
+		n := size - 28
+		if b, err = d.bytes(offset, n); err != nil {
+			return nil, 0, err
+		}
+		offset += n
+		switch n {
+		case 1:
+			size = 29 + uint32(b[0])
+		case 2:
+			size = 285 + (uint32(b[0])<<8 | uint32(b[1]))
+		case 3:
+			size = 65821 + (uint32(b[0])<<16 | uint32(b[1])<<8 | uint32(b[2]))
+		}
+	}
+	switch t {
+	case typeMap:
+		m := make(map[string]interface{}, size)
+		for i := uint32(0); i < size; i++ {
+			k, next, err := d.decode(offset, depth+1)
+			if err != nil {
+				return nil, 0, err
+			}
+			key, ok := k.(string)
+			if !ok {
+				return nil, 0, fmt.Errorf("map key of type %T", k)
+			}
+			v, next, err := d.decode(next, depth+1)
+			if err != nil {
+				return nil, 0, err
+			}
+			m[key] = v
+			offset = next
+		}
+		return m, offset, nil
+	case typeArray:
+		a := make([]interface{}, 0, size)
+		for i := uint32(0); i < size; i++ {
+			v, next, err := d.decode(offset, depth+1)
+			if err != nil {
+				return nil, 0, err
+			}
+			a = append(a, v)
+			offset = next
+		}
+		return a, offset, nil
+	case typeBool:
+		if size > 1 {
+			return nil, 0, fmt.Errorf("invalid boolean size %d", size)
+		}
+		return size == 1, offset, nil
+	case typeContainer, typeEndMarker:
+		return nil, 0, fmt.Errorf("unexpected data type %d", t)
+	}
+	b, err = d.bytes(offset, size)
+	if err != nil {
+		return nil, 0, err
+	}
+	offset += size
+	switch t {
+	case typeString:
+		return string(b), offset, nil
+	case typeBytes:
+		return append([]byte(nil), b...), offset, nil
+	case typeDouble:
+		if size != 8 {
+			return nil, 0, fmt.Errorf("invalid double size %d", size)
+		}
+		return math.Float64frombits(binary.BigEndian.Uint64(b)), offset, nil
+	case typeFloat:
+		if size != 4 {
+			return nil, 0, fmt.Errorf("invalid float size %d", size)
+		}
+		return math.Float32frombits(binary.BigEndian.Uint32(b)), offset, nil
+	case typeUint16, typeUint32, typeUint64, typeInt32:
+		max := map[int]uint32{typeUint16: 2, typeUint32: 4, typeUint64: 8, typeInt32: 4}[t]
+		if size > max {
+			return nil, 0, fmt.Errorf("invalid size %d of data type %d", size, t)
+		}
+		var n uint64
+		for _, c := range b {
+			n = n<<8 | uint64(c)
+		}
+		switch t {
+		case typeUint16:
+			return uint16(n), offset, nil
+		case typeUint32:
+			return uint32(n), offset, nil
+		case typeInt32:
+			return int32(uint32(n)), offset, nil
+		}
+		return n, offset, nil
+	case typeUint128:
+		if size > 16 {
+			return nil, 0, fmt.Errorf("invalid uint128 size %d", size)
+		}
+		return new(big.Int).SetBytes(b), offset, nil
+	}
+	return nil, 0, fmt.Errorf("unknown data type %d", t)
+}
+
+// pointer returns the data section offset of the pointer of control byte ctrl
+// and the offset following the pointer.
+func (d *decoder) pointer(ctrl byte, offset uint32) (uint32, uint32, error) {
+	n := uint32(ctrl>>3&0x3) + 1
+	b, err := d.bytes(offset, n)
+	if err != nil {
+		return 0, 0, err
+	}
+	v := uint32(ctrl & 0x7)
+	var ptr uint32
+	switch n {
+	case 1:
+		ptr = v<<8 | uint32(b[0])
+	case 2:
+		ptr = (v<<16 | uint32(b[0])<<8 | uint32(b[1])) + 2048
+	case 3:
+		ptr = (v<<24 | uint32(b[0])<<16 | uint32(b[1])<<8 | uint32(b[2])) + 526336
+	case 4:
+		ptr = binary.BigEndian.Uint32(b)
+	}
+	return ptr, offset + n, nil
+}
@@ -0,0 +1,175 @@
+package mmdb
+
+import (
+	"math/big"
+	"net/netip"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/sbezverk/gobmp/pkg/mmdb/mmdbtest"
+)
+
+func TestLookup(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "asn.mmdb")
+	mmdbtest.Write(t, path, "GeoLite2-ASN", []mmdbtest.Network{
+		{Prefix: "198.51.100.0/24", Record: map[string]interface{}{"autonomous_system_number": uint32(64500), "autonomous_system_organization": "Example Networks"}},
+		{Prefix: "203.0.113.128/25", Record: map[string]interface{}{"autonomous_system_number": uint32(64501), "tags": []interface{}{"a", true, 1.5}}},
+		{Prefix: "2001:db8::/32", Record: map[string]interface{}{"autonomous_system_number": uint32(64502)}},
+		{Prefix: "2001:db9::/32", Record: map[string]interface{}{"autonomous_system_organization": strings.Repeat("o", 286), "autonomous_system_number": uint32(64503)}},
+	})
+	r, err := Open(path)
+	if err != nil {
+		t.Fatalf("Open() error: %v", err)
+	}
+	if r.Metadata.DatabaseType != "GeoLite2-ASN" || r.Metadata.IPVersion != 6 || r.Metadata.RecordSize != 28 {
+		t.Errorf("Metadata = %+v", r.Metadata)
+	}
+	tests := []struct {
+		addr      string
+		want      interface{}
+		prefixLen int
+	}{
+		{
+			addr:      "198.51.100.7",
+			want:      map[string]interface{}{"autonomous_system_number": uint32(64500), "autonomous_system_organization": "Example Networks"},
+			prefixLen: 24,
+		},
+		{
+			addr:      "::ffff:203.0.113.200",
+			want:      map[string]interface{}{"autonomous_system_number": uint32(64501), "tags": []interface{}{"a", true, 1.5}},
+			prefixLen: 25,
+		},
+		{
+			addr:      "2001:db8:1::1",
+			want:      map[string]interface{}{"autonomous_system_number": uint32(64502)},
+			prefixLen: 32,
+		},
+		{
+			addr:      "2001:db9::1",
+			want:      map[string]interface{}{"autonomous_system_organization": strings.Repeat("o", 286), "autonomous_system_number": uint32(64503)},
+			prefixLen: 32,
+		},
+		{addr: "203.0.113.1"},
+		{addr: "2001:dba::1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.addr, func(t *testing.T) {
+			got, prefixLen, err := r.Lookup(netip.MustParseAddr(tt.addr))
+			if err != nil {
+				t.Fatalf("Lookup() error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Lookup() = %v, want %v", got, tt.want)
+			}
+			if tt.want != nil && prefixLen != tt.prefixLen {
+				t.Errorf("Lookup() prefix length = %d, want %d", prefixLen, tt.prefixLen)
+			}
+		})
+	}
+	n := 0
+	if err := r.Offsets(func(o uint32) bool {
+		if _, err := r.Decode(o); err != nil {
+			t.Errorf("Decode(%d) error: %v", o, err)
+		}
+		n++
+		return true
+	}); err != nil {
+		t.Fatalf("Offsets() error: %v", err)
+	}
+	if n != 4 {
+		t.Errorf("Offsets() visited %d records, want 4", n)
+	}
+}
+
+func TestDecode(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+		want interface{}
+	}{
+		{
+			name: "pointer",
+			// "ab" at offset 0, a pointer to it at offset 3
+			data: []byte{0x42, 'a', 'b', 0x20, 0x00},
+			want: "ab",
+		},
+		{
+			name: "long string",
+			data: append([]byte{0x5d, 0x01}, []byte(strings.Repeat("x", 30))...),
+			want: strings.Repeat("x", 30),
+		},
+		{
+			name: "two bytes size string",
+			data: append([]byte{0x5e, 0x00, 0x01}, []byte(strings.Repeat("y", 286))...),
+			want: strings.Repeat("y", 286),
+		},
+		{
+			name: "three bytes size string",
+			data: append([]byte{0x5f, 0x00, 0x00, 0x01}, []byte(strings.Repeat("z", 65822))...),
+			want: strings.Repeat("z", 65822),
+		},
+		{
+			name: "uint128",
+			data: []byte{0x02, 0x03, 0x01, 0x00},
+			want: big.NewInt(256),
+		},
+		{
+			name: "int32",
+			data: []byte{0x04, 0x01, 0xff, 0xff, 0xff, 0xff},
+			want: int32(-1),
+		},
+		{
+			name: "uint16",
+			data: []byte{0xa2, 0x01, 0x02},
+			want: uint16(258),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Reader{data: tt.data}
+			offset := uint32(0)
+			if tt.name == "pointer" {
+				offset = 3
+			}
+			got, err := r.Decode(offset)
+			if err != nil {
+				t.Fatalf("Decode() error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Decode() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+	for _, data := range [][]byte{
+		{0x45, 'a'},        // truncated string
+		{0xe1, 0x42},       // map key is not a string
+		{0x68, 0x00},       // truncated double
+		{0x00, 0x05, 0x00}, // end marker
+		{0x20, 0x00},       // pointer loop
+	} {
+		r := &Reader{data: data}
+		if v, err := r.Decode(0); err == nil {
+			t.Errorf("Decode(% x) = %v, want error", data, v)
+		}
+	}
+}
+
+func TestNewErrors(t *testing.T) {
+	valid, err := mmdbtest.Build("test", []mmdbtest.Network{{Prefix: "10.0.0.0/8", Record: "ten"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, b := range map[string][]byte{
+		"no metadata": []byte("not a database"),
+		"truncated":   valid[len(valid)-60:],
+	} {
+		if _, err := New(b); err == nil {
+			t.Errorf("New(%s) expected error", name)
+		}
+	}
+	if _, err := New(valid); err != nil {
+		t.Errorf("New() error: %v", err)
+	}
+}
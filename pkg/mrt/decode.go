@@ -0,0 +1,253 @@
+package mrt
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+)
+
+// PeerIndexTable is the decoded PEER_INDEX_TABLE record (RFC 6396 §4.3.1).
+type PeerIndexTable struct {
+	CollectorID net.IP
+	ViewName    string
+	Peers       []PeerIndexEntry
+}
+
+// UnmarshalPeerIndexTable decodes the body of a PEER_INDEX_TABLE record.
+func UnmarshalPeerIndexTable(b []byte) (*PeerIndexTable, error) {
+	if len(b) < 8 {
+		return nil, fmt.Errorf("invalid PEER_INDEX_TABLE length %d", len(b))
+	}
+	pit := &PeerIndexTable{
+		CollectorID: net.IP(b[0:4]),
+	}
+	p := 4
+	vl := int(binary.BigEndian.Uint16(b[p : p+2]))
+	p += 2
+	if p+vl+2 > len(b) {
+		return nil, fmt.Errorf("PEER_INDEX_TABLE view name length %d exceeds record", vl)
+	}
+	pit.ViewName = string(b[p : p+vl])
+	p += vl
+	n := int(binary.BigEndian.Uint16(b[p : p+2]))
+	p += 2
+	pit.Peers = make([]PeerIndexEntry, 0, n)
+	for i := 0; i < n; i++ {
+		if p+1 > len(b) {
+			return nil, fmt.Errorf("PEER_INDEX_TABLE truncated at peer %d", i)
+		}
+		pt := b[p]
+		p++
+		il, al := net.IPv4len, 2
+		if pt&0x1 != 0 {
+			il = net.IPv6len
+		}
+		if pt&0x2 != 0 {
+			al = 4
+		}
+		if p+4+il+al > len(b) {
+			return nil, fmt.Errorf("PEER_INDEX_TABLE truncated at peer %d", i)
+		}
+		e := PeerIndexEntry{
+			BGPID: net.IP(b[p : p+4]),
+			IP:    net.IP(b[p+4 : p+4+il]),
+		}
+		p += 4 + il
+		if al == 4 {
+			e.AS = binary.BigEndian.Uint32(b[p : p+4])
+		} else {
+			e.AS = uint32(binary.BigEndian.Uint16(b[p : p+2]))
+		}
+		p += al
+		pit.Peers = append(pit.Peers, e)
+	}
+
+	return pit, nil
+}
+
+// RIB is the decoded body of an AFI/SAFI specific RIB record (RFC 6396
+// §4.3.2, RFC 8050 §4).
+type RIB struct {
+	Sequence uint32
+	AFI      uint16
+	SAFI     uint8
+	AddPath  bool
+	// Prefix is the NLRI encoded prefix, length in bits followed by the
+	// significant octets.
+	Prefix  []byte
+	Entries []RIBEntry
+}
+
+// ribSubtypes maps the supported RIB record subtypes to AFI, SAFI and ADD-PATH.
+var ribSubtypes = map[uint16]struct {
+	afi     uint16
+	safi    uint8
+	addPath bool
+}{
+	2:  {1, 1, false},
+	3:  {1, 2, false},
+	4:  {2, 1, false},
+	5:  {2, 2, false},
+	8:  {1, 1, true},
+	9:  {1, 2, true},
+	10: {2, 1, true},
+	11: {2, 2, true},
+}
+
+// UnmarshalRIB decodes the body of a RIB_IPV4/IPV6_UNICAST/MULTICAST record
+// or of their _ADDPATH variants.
+func UnmarshalRIB(subtype uint16, b []byte) (*RIB, error) {
+	st, ok := ribSubtypes[subtype]
+	if !ok {
+		return nil, fmt.Errorf("unsupported TABLE_DUMP_V2 subtype %d", subtype)
+	}
+	rib := &RIB{
+		AFI:     st.afi,
+		SAFI:    st.safi,
+		AddPath: st.addPath,
+	}
+	if len(b) < 5 {
+		return nil, fmt.Errorf("invalid RIB record length %d", len(b))
+	}
+	rib.Sequence = binary.BigEndian.Uint32(b[0:4])
+	p := 4
+	bits := int(b[p])
+	maxBits := 32
+	if rib.AFI == 2 {
+		maxBits = 128
+	}
+	if bits > maxBits {
+		return nil, fmt.Errorf("invalid RIB record prefix length %d", bits)
+	}
+	pl := 1 + (bits+7)/8
+	if p+pl+2 > len(b) {
+		return nil, fmt.Errorf("RIB record truncated at prefix")
+	}
+	rib.Prefix = b[p : p+pl]
+	p += pl
+	n := int(binary.BigEndian.Uint16(b[p : p+2]))
+	p += 2
+	rib.Entries = make([]RIBEntry, 0, n)
+	for i := 0; i < n; i++ {
+		hl := 8
+		if rib.AddPath {
+			hl += 4
+		}
+		if p+hl > len(b) {
+			return nil, fmt.Errorf("RIB record truncated at entry %d", i)
+		}
+		e := RIBEntry{
+			PeerIndex:      binary.BigEndian.Uint16(b[p : p+2]),
+			OriginatedTime: binary.BigEndian.Uint32(b[p+2 : p+6]),
+		}
+		p += 6
+		if rib.AddPath {
+			e.PathID = binary.BigEndian.Uint32(b[p : p+4])
+			p += 4
+		}
+		al := int(binary.BigEndian.Uint16(b[p : p+2]))
+		p += 2
+		if p+al > len(b) {
+			return nil, fmt.Errorf("RIB record entry %d attributes length %d exceeds record", i, al)
+		}
+		e.Attributes = b[p : p+al]
+		p += al
+		rib.Entries = append(rib.Entries, e)
+	}
+
+	return rib, nil
+}
+
+// BGP4MP is a decoded BGP4MP or BGP4MP_ET record.
+type BGP4MP struct {
+	PeerAS  uint32
+	LocalAS uint32
+	PeerIP  net.IP
+	LocalIP net.IP
+	// AS4 reports if ASNs, including those in AS_PATH, are 4 octets
+	AS4     bool
+	AddPath bool
+	// OldState and NewState are set for STATE_CHANGE subtypes
+	OldState uint16
+	NewState uint16
+	// Message is the complete BGP message for MESSAGE subtypes
+	Message []byte
+}
+
+// IsStateChange returns true if the record is a state change.
+func (m *BGP4MP) IsStateChange() bool {
+	return m.Message == nil
+}
+
+// UnmarshalBGP4MP decodes the body of a BGP4MP or BGP4MP_ET record of
+// subtypes STATE_CHANGE, MESSAGE, MESSAGE_AS4, STATE_CHANGE_AS4 and their
+// ADD-PATH variants.
+func UnmarshalBGP4MP(subtype uint16, b []byte) (*BGP4MP, error) {
+	m := &BGP4MP{}
+	stateChange := false
+	switch subtype {
+	case SubtypeBGP4MPStateChange:
+		stateChange = true
+	case SubtypeBGP4MPMessage:
+	case SubtypeBGP4MPMessageAS4:
+		m.AS4 = true
+	case SubtypeBGP4MPStateChangeAS4:
+		m.AS4 = true
+		stateChange = true
+	case SubtypeBGP4MPMessageAddPath:
+		m.AddPath = true
+	case SubtypeBGP4MPMessageAS4AddPath:
+		m.AS4 = true
+		m.AddPath = true
+	default:
+		return nil, fmt.Errorf("unsupported BGP4MP subtype %d", subtype)
+	}
+	al := 2
+	if m.AS4 {
+		al = 4
+	}
+	if len(b) < 2*al+4 {
+		return nil, fmt.Errorf("invalid BGP4MP record length %d", len(b))
+	}
+	p := 0
+	if m.AS4 {
+		m.PeerAS = binary.BigEndian.Uint32(b[p : p+4])
+		m.LocalAS = binary.BigEndian.Uint32(b[p+4 : p+8])
+	} else {
+		m.PeerAS = uint32(binary.BigEndian.Uint16(b[p : p+2]))
+		m.LocalAS = uint32(binary.BigEndian.Uint16(b[p+2 : p+4]))
+	}
+	p += 2 * al
+	// Skip Interface Index
+	p += 2
+	il := 0
+	switch afi := binary.BigEndian.Uint16(b[p : p+2]); afi {
+	case 1:
+		il = net.IPv4len
+	case 2:
+		il = net.IPv6len
+	default:
+		return nil, fmt.Errorf("invalid BGP4MP address family %d", afi)
+	}
+	p += 2
+	if p+2*il > len(b) {
+		return nil, fmt.Errorf("BGP4MP record truncated at addresses")
+	}
+	m.PeerIP = net.IP(b[p : p+il])
+	m.LocalIP = net.IP(b[p+il : p+2*il])
+	p += 2 * il
+	if stateChange {
+		if p+4 > len(b) {
+			return nil, fmt.Errorf("BGP4MP state change truncated")
+		}
+		m.OldState = binary.BigEndian.Uint16(b[p : p+2])
+		m.NewState = binary.BigEndian.Uint16(b[p+2 : p+4])
+		return m, nil
+	}
+	if len(b)-p < 19 {
+		return nil, fmt.Errorf("BGP4MP message too short: %d bytes", len(b)-p)
+	}
+	m.Message = b[p:]
+
+	return m, nil
+}
@@ -0,0 +1,437 @@
+package mrt
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/sbezverk/gobmp/pkg/bgp"
+	"github.com/sbezverk/gobmp/pkg/bmp"
+)
+
+// ImportConfig defines the MRT importer configuration
+type ImportConfig struct {
+	// RouterIP is reported as the monitored router of every imported message.
+	// When empty, the collector BGP ID of the first PEER_INDEX_TABLE or the
+	// local address of the first BGP4MP record is used.
+	RouterIP string
+}
+
+// Importer converts MRT records into the Peer Up, Peer Down and Route Monitor
+// BMP messages a router would have sent for the same information, so archived
+// dumps can be fed to the regular message producer.
+type Importer struct {
+	routerIP  net.IP
+	peerIndex []PeerIndexEntry
+	peers     map[string]*importPeer
+	emit      func(bmp.Message)
+}
+
+// importPeer tracks the Peer Up state of an imported peer.
+type importPeer struct {
+	up      bool
+	bgpID   net.IP
+	ip      net.IP
+	as      uint32
+	localAS uint32
+	// addPath holds the ADD-PATH AFI/SAFI pairs announced in the synthesised
+	// Open messages, keyed by bgp.NLRIMessageType
+	addPath map[int][2]uint16
+}
+
+// NewImporter returns an Importer passing every synthesised message to emit.
+func NewImporter(cfg *ImportConfig, emit func(bmp.Message)) (*Importer, error) {
+	if emit == nil {
+		return nil, fmt.Errorf("mrt importer requires a message consumer")
+	}
+	im := &Importer{
+		peers: make(map[string]*importPeer),
+		emit:  emit,
+	}
+	if cfg != nil && cfg.RouterIP != "" {
+		if im.routerIP = net.ParseIP(cfg.RouterIP); im.routerIP == nil {
+			return nil, fmt.Errorf("invalid router ip %q", cfg.RouterIP)
+		}
+	}
+
+	return im, nil
+}
+
+// Import reads all records of rd and returns the number of records read.
+// Records which cannot be decoded are logged and skipped, a read error
+// terminates the import.
+func (im *Importer) Import(rd *Reader) (int, error) {
+	n := 0
+	for {
+		rec, err := rd.Next()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return n, nil
+			}
+			return n, err
+		}
+		n++
+		if err := im.ImportRecord(rec); err != nil {
+			glog.Errorf("failed to import mrt record type %d subtype %d with error: %+v", rec.Type, rec.Subtype, err)
+		}
+	}
+}
+
+// ImportRecord converts a single MRT record.
+func (im *Importer) ImportRecord(rec *Record) error {
+	switch rec.Type {
+	case TypeTableDumpV2:
+		return im.tableDumpV2(rec)
+	case TypeBGP4MP, TypeBGP4MPET:
+		return im.bgp4mp(rec)
+	default:
+		glog.V(5).Infof("skipping unsupported mrt record type %d", rec.Type)
+	}
+
+	return nil
+}
+
+func (im *Importer) tableDumpV2(rec *Record) error {
+	if rec.Subtype == SubtypePeerIndexTable {
+		pit, err := UnmarshalPeerIndexTable(rec.Message)
+		if err != nil {
+			return err
+		}
+		if im.routerIP == nil {
+			im.routerIP = pit.CollectorID
+		}
+		im.peerIndex = pit.Peers
+		return nil
+	}
+	if _, ok := ribSubtypes[rec.Subtype]; !ok {
+		glog.V(5).Infof("skipping unsupported TABLE_DUMP_V2 subtype %d", rec.Subtype)
+		return nil
+	}
+	if im.peerIndex == nil {
+		return fmt.Errorf("RIB record before PEER_INDEX_TABLE")
+	}
+	rib, err := UnmarshalRIB(rec.Subtype, rec.Message)
+	if err != nil {
+		return err
+	}
+	for _, e := range rib.Entries {
+		if int(e.PeerIndex) >= len(im.peerIndex) {
+			return fmt.Errorf("RIB entry references unknown peer index %d", e.PeerIndex)
+		}
+		pe := im.peerIndex[e.PeerIndex]
+		p := im.getPeer(pe.IP, pe.AS, pe.BGPID)
+		if rib.AddPath {
+			im.ensureAddPath(p, rib.AFI, rib.SAFI, rec)
+		}
+		im.ensureUp(p, rec)
+		nlri := rib.Prefix
+		if rib.AddPath {
+			nlri = binary.BigEndian.AppendUint32(make([]byte, 0, 4+len(rib.Prefix)), e.PathID)
+			nlri = append(nlri, rib.Prefix...)
+		}
+		msg, err := ribUpdate(e.Attributes, rib.AFI, rib.SAFI, nlri)
+		if err != nil {
+			return err
+		}
+		rm, err := bmp.UnmarshalBMPRouteMonitorMessageWithAS4Hint(msg, true)
+		if err != nil {
+			return err
+		}
+		ts := rec.Timestamp
+		if e.OriginatedTime != 0 {
+			ts = time.Unix(int64(e.OriginatedTime), 0)
+		}
+		im.emit(bmp.Message{
+			PeerHeader: im.peerHeader(p, true, ts),
+			Payload:    rm,
+			SpeakerIP:  im.routerIP.String(),
+		})
+	}
+
+	return nil
+}
+
+func (im *Importer) bgp4mp(rec *Record) error {
+	m, err := UnmarshalBGP4MP(rec.Subtype, rec.Message)
+	if err != nil {
+		if rec.Subtype == 6 || rec.Subtype == 7 {
+			// BGP4MP_MESSAGE_LOCAL records carry messages sent by the collector
+			glog.V(5).Infof("skipping BGP4MP subtype %d", rec.Subtype)
+			return nil
+		}
+		return err
+	}
+	if im.routerIP == nil {
+		im.routerIP = m.LocalIP
+	}
+	// BGP4MP carries no BGP Identifier, the peer address, its low 4 octets
+	// for IPv6, stands in so every peer maps to its own table
+	id := m.PeerIP.To4()
+	if id == nil {
+		id = m.PeerIP[12:16]
+	}
+	p := im.getPeer(m.PeerIP, m.PeerAS, id)
+	p.localAS = m.LocalAS
+	if m.IsStateChange() {
+		switch {
+		case m.NewState == StateEstablished && !p.up:
+			im.ensureUp(p, rec)
+		case m.OldState == StateEstablished && m.NewState != StateEstablished && p.up:
+			p.up = false
+			im.emit(bmp.Message{
+				PeerHeader: im.peerHeader(p, m.AS4, rec.Timestamp),
+				// Remote system closed the session without a notification
+				Payload:   &bmp.PeerDownMessage{Reason: 4},
+				SpeakerIP: im.routerIP.String(),
+			})
+		}
+		return nil
+	}
+	if m.Message[18] != 2 {
+		// Only UPDATE messages are reported by BMP Route Monitoring
+		return nil
+	}
+	rm, err := bmp.UnmarshalBMPRouteMonitorMessageWithAS4Hint(m.Message, m.AS4)
+	if err != nil {
+		return err
+	}
+	if m.AddPath {
+		afi, safi := uint16(1), uint8(1)
+		for _, attr := range rm.Update.PathAttributes {
+			if (attr.AttributeType == bgp.MP_REACH_NLRI || attr.AttributeType == bgp.MP_UNREACH_NLRI) && len(attr.Attribute) >= 3 {
+				afi, safi = binary.BigEndian.Uint16(attr.Attribute[0:2]), attr.Attribute[2]
+				break
+			}
+		}
+		im.ensureAddPath(p, afi, safi, rec)
+	}
+	im.ensureUp(p, rec)
+	im.emit(bmp.Message{
+		PeerHeader: im.peerHeader(p, m.AS4, rec.Timestamp),
+		Payload:    rm,
+		SpeakerIP:  im.routerIP.String(),
+	})
+
+	return nil
+}
+
+func (im *Importer) getPeer(ip net.IP, as uint32, bgpID net.IP) *importPeer {
+	key := ip.String() + "/" + strconv.FormatUint(uint64(as), 10)
+	if p, ok := im.peers[key]; ok {
+		return p
+	}
+	p := &importPeer{
+		bgpID:   append(net.IP{}, bgpID.To4()...),
+		ip:      append(net.IP{}, ip...),
+		as:      as,
+		addPath: make(map[int][2]uint16),
+	}
+	im.peers[key] = p
+
+	return p
+}
+
+// ensureAddPath records ADD-PATH use for afi/safi, a peer already up is
+// announced again so the producer learns the new capability.
+func (im *Importer) ensureAddPath(p *importPeer, afi uint16, safi uint8, rec *Record) {
+	t := bgp.NLRIMessageType(afi, safi)
+	if _, ok := p.addPath[t]; ok {
+		return
+	}
+	p.addPath[t] = [2]uint16{afi, uint16(safi)}
+	p.up = false
+	im.ensureUp(p, rec)
+}
+
+// ensureUp emits a Peer Up for p unless one has already been emitted.
+func (im *Importer) ensureUp(p *importPeer, rec *Record) {
+	if p.up {
+		return
+	}
+	p.up = true
+	ph := im.peerHeader(p, true, rec.Timestamp)
+	pu, err := bmp.UnmarshalPeerUpMessage(im.peerUp(p), ph.IsRemotePeerIPv6())
+	if err != nil {
+		glog.Errorf("failed to build Peer Up for peer %s with error: %+v", p.ip, err)
+		return
+	}
+	im.emit(bmp.Message{
+		PeerHeader: ph,
+		Payload:    pu,
+		SpeakerIP:  im.routerIP.String(),
+	})
+}
+
+// peerHeader builds the Per-Peer Header (RFC 7854 §4.2) of a Global Instance
+// Peer, with the A flag set when the AS_PATH uses 2 octet ASNs.
+func (im *Importer) peerHeader(p *importPeer, as4 bool, ts time.Time) *bmp.PerPeerHeader {
+	b := make([]byte, bmp.PerPeerHeaderLength)
+	if p.ip.To4() == nil {
+		b[1] |= 0x80
+	}
+	if !as4 {
+		b[1] |= 0x20
+	}
+	if v4 := p.ip.To4(); v4 != nil {
+		copy(b[22:26], v4)
+	} else {
+		copy(b[10:26], p.ip.To16())
+	}
+	binary.BigEndian.PutUint32(b[26:30], p.as)
+	copy(b[30:34], p.bgpID)
+	binary.BigEndian.PutUint32(b[34:38], uint32(ts.Unix()))
+	binary.BigEndian.PutUint32(b[38:42], uint32(ts.Nanosecond()/1000))
+	ph, err := bmp.UnmarshalPerPeerHeader(b)
+	if err != nil {
+		// Cannot happen, the header is built with a valid peer type
+		glog.Errorf("failed to build Per-Peer Header with error: %+v", err)
+	}
+
+	return ph
+}
+
+// peerUp builds the body of a Peer Up Notification (RFC 7854 §4.10) with the
+// Open messages a session matching p would have exchanged.
+func (im *Importer) peerUp(p *importPeer) []byte {
+	b := make([]byte, 20, 128)
+	// An IPv4 router address is stored IPv4-mapped, readable for both IPv4
+	// and IPv6 peers
+	copy(b[0:16], im.routerIP.To16())
+	b = append(b, openMessage(p.localAS, im.routerIP, p.addPath)...)
+
+	return append(b, openMessage(p.as, p.bgpID, p.addPath)...)
+}
+
+// openMessage builds a BGP Open message with the 4 octet ASN capability and
+// the ADD-PATH capability for the AFI/SAFI pairs of addPath.
+func openMessage(as uint32, id net.IP, addPath map[int][2]uint16) []byte {
+	caps := []byte{65, 4}
+	caps = binary.BigEndian.AppendUint32(caps, as)
+	if len(addPath) != 0 {
+		caps = append(caps, 69, byte(4*len(addPath)))
+		keys := make([]int, 0, len(addPath))
+		for k := range addPath {
+			keys = append(keys, k)
+		}
+		sort.Ints(keys)
+		for _, t := range keys {
+			caps = binary.BigEndian.AppendUint16(caps, addPath[t][0])
+			caps = append(caps, byte(addPath[t][1]), 3)
+		}
+	}
+	b := make([]byte, 16, 29+2+len(caps))
+	for i := range b {
+		b[i] = 0xff
+	}
+	b = binary.BigEndian.AppendUint16(b, uint16(29+2+len(caps)))
+	b = append(b, 1, 4)
+	b = binary.BigEndian.AppendUint16(b, as2(as))
+	b = binary.BigEndian.AppendUint16(b, 0)
+	b = append(b, ipBytes(id, 1)...)
+	b = append(b, byte(2+len(caps)), 2, byte(len(caps)))
+
+	return append(b, caps...)
+}
+
+// ribUpdate builds a BGP UPDATE message announcing nlri with the attributes
+// of a TABLE_DUMP_V2 RIB entry. IPv4 unicast routes are carried in the NLRI
+// field unless the entry has an MP_REACH_NLRI attribute, all other address
+// families get a complete MP_REACH_NLRI rebuilt from the abbreviated form of
+// RFC 6396 §4.3.4.
+func ribUpdate(attrs []byte, afi uint16, safi uint8, nlri []byte) ([]byte, error) {
+	pattrs, err := splitAttributes(attrs)
+	if err != nil {
+		return nil, err
+	}
+	hasMPReach := false
+	for _, a := range pattrs {
+		if a.AttributeType == bgp.MP_REACH_NLRI {
+			hasMPReach = true
+		}
+	}
+	mpReach := hasMPReach || afi != 1 || safi != 1
+	b := make([]byte, 0, 19+4+len(attrs)+len(nlri)+32)
+	for i := 0; i < 16; i++ {
+		b = append(b, 0xff)
+	}
+	b = append(b, 0, 0, 2, 0, 0, 0, 0)
+	for _, a := range pattrs {
+		if a.AttributeType != bgp.MP_REACH_NLRI {
+			b = appendAttribute(b, a.AttributeTypeFlags, a.AttributeType, a.Attribute)
+			continue
+		}
+		b = appendAttribute(b, 0x80, bgp.MP_REACH_NLRI, mpReachNLRI(a.Attribute, afi, safi, nlri))
+	}
+	if mpReach && !hasMPReach {
+		b = appendAttribute(b, 0x80, bgp.MP_REACH_NLRI, mpReachNLRI(nil, afi, safi, nlri))
+	}
+	binary.BigEndian.PutUint16(b[21:23], uint16(len(b)-23))
+	if !mpReach {
+		b = append(b, nlri...)
+	}
+	if len(b) > 65535 {
+		return nil, fmt.Errorf("rebuilt UPDATE exceeds maximum length: %d", len(b))
+	}
+	binary.BigEndian.PutUint16(b[16:18], uint16(len(b)))
+
+	return b, nil
+}
+
+// mpReachNLRI returns a complete MP_REACH_NLRI value carrying nlri. v is the
+// attribute stored in the RIB entry, either the abbreviated next hop length
+// and next hop or, as written by some implementations, a complete attribute.
+func mpReachNLRI(v []byte, afi uint16, safi uint8, nlri []byte) []byte {
+	var nh []byte
+	switch {
+	case len(v) > 0 && int(v[0]) == len(v)-1:
+		nh = v[1:]
+	case len(v) >= 4 && 4+int(v[3]) <= len(v):
+		nh = v[4 : 4+int(v[3])]
+	}
+	b := make([]byte, 0, 5+len(nh)+len(nlri))
+	b = binary.BigEndian.AppendUint16(b, afi)
+	b = append(b, safi, byte(len(nh)))
+	b = append(b, nh...)
+	b = append(b, 0)
+
+	return append(b, nlri...)
+}
+
+// splitAttributes splits an encoded path attribute list.
+func splitAttributes(b []byte) ([]bgp.PathAttribute, error) {
+	attrs := make([]bgp.PathAttribute, 0, 8)
+	for p := 0; p < len(b); {
+		if p+3 > len(b) {
+			return nil, fmt.Errorf("truncated path attribute header at offset %d", p)
+		}
+		a := bgp.PathAttribute{
+			AttributeTypeFlags: b[p],
+			AttributeType:      b[p+1],
+		}
+		p += 2
+		if a.AttributeTypeFlags&0x10 != 0 {
+			if p+2 > len(b) {
+				return nil, fmt.Errorf("truncated path attribute length at offset %d", p)
+			}
+			a.AttributeLength = binary.BigEndian.Uint16(b[p : p+2])
+			p += 2
+		} else {
+			a.AttributeLength = uint16(b[p])
+			p++
+		}
+		if p+int(a.AttributeLength) > len(b) {
+			return nil, fmt.Errorf("path attribute type %d length %d exceeds remaining %d bytes", a.AttributeType, a.AttributeLength, len(b)-p)
+		}
+		a.Attribute = b[p : p+int(a.AttributeLength)]
+		p += int(a.AttributeLength)
+		attrs = append(attrs, a)
+	}
+
+	return attrs, nil
+}
@@ -0,0 +1,199 @@
+package mrt
+
+import (
+	"bytes"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sbezverk/gobmp/pkg/bgp"
+	"github.com/sbezverk/gobmp/pkg/bmp"
+)
+
+// collect returns an Importer storing every emitted message into msgs.
+func collect(t *testing.T, cfg *ImportConfig, msgs *[]bmp.Message) *Importer {
+	t.Helper()
+	im, err := NewImporter(cfg, func(msg bmp.Message) {
+		*msgs = append(*msgs, msg)
+	})
+	if err != nil {
+		t.Fatalf("failed to create importer: %v", err)
+	}
+	return im
+}
+
+func TestImporter_WriterRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	w, err := NewWriter(&Config{Dir: dir, RotationInterval: time.Hour, RIBInterval: -1})
+	if err != nil {
+		t.Fatalf("failed to create writer: %v", err)
+	}
+	now := time.Date(2026, 10, 18, 12, 10, 0, 0, time.UTC)
+	w.now = func() time.Time { return now }
+	local := make([]byte, 16)
+	copy(local[12:], []byte{192, 0, 2, 2})
+	w.WriteMessage(bmp.Message{
+		SpeakerIP:  "10.0.0.1",
+		PeerHeader: testPeerHeader(t, 1000),
+		Payload: &bmp.PeerUpMessage{
+			LocalAddress: local,
+			SentOpen:     &bgp.OpenMessage{MyAS: 65000, BGPID: []byte{10, 0, 0, 1}},
+			ReceivedOpen: &bgp.OpenMessage{MyAS: 65001, BGPID: []byte{1, 1, 1, 1}},
+		},
+	})
+	w.WriteMessage(bmp.Message{SpeakerIP: "10.0.0.1", PeerHeader: testPeerHeader(t, 1001), Payload: testRouteMonitor(t)})
+	w.Snapshot()
+	w.Stop()
+
+	for _, name := range []string{"rib.20261018.1210.mrt", "updates.20261018.1200.mrt"} {
+		t.Run(name, func(t *testing.T) {
+			rd, err := Open(filepath.Join(dir, "10.0.0.1", name))
+			if err != nil {
+				t.Fatalf("failed to open: %v", err)
+			}
+			defer func() { _ = rd.Close() }()
+			var msgs []bmp.Message
+			n, err := collect(t, nil, &msgs).Import(rd)
+			if err != nil || n != 2 {
+				t.Fatalf("expected 2 records got %d, error %v", n, err)
+			}
+			if len(msgs) != 2 {
+				t.Fatalf("expected Peer Up and Route Monitor got %d messages", len(msgs))
+			}
+			pu, ok := msgs[0].Payload.(*bmp.PeerUpMessage)
+			if !ok {
+				t.Fatalf("expected Peer Up got %T", msgs[0].Payload)
+			}
+			if msgs[0].SpeakerIP != "10.0.0.1" && msgs[0].SpeakerIP != "192.0.2.2" {
+				t.Fatalf("unexpected speaker ip %s", msgs[0].SpeakerIP)
+			}
+			if as, ok := pu.ReceivedOpen.Is4BytesASCapable(); !ok || as != 65001 {
+				t.Fatalf("unexpected received open %+v", pu.ReceivedOpen)
+			}
+			ph := msgs[1].PeerHeader
+			if ph.GetPeerAddrString() != "192.0.2.1" || ph.PeerAS != 65001 {
+				t.Fatalf("unexpected peer header %+v", ph)
+			}
+			rm, ok := msgs[1].Payload.(*bmp.RouteMonitor)
+			if !ok {
+				t.Fatalf("expected Route Monitor got %T", msgs[1].Payload)
+			}
+			if !bytes.Equal(rm.Update.NLRI, []byte{8, 10}) {
+				t.Fatalf("unexpected nlri %x", rm.Update.NLRI)
+			}
+			if rm.Update.BaseAttributes == nil || rm.Update.BaseAttributes.Nexthop != "192.0.2.1" {
+				t.Fatalf("unexpected base attributes %+v", rm.Update.BaseAttributes)
+			}
+		})
+	}
+}
+
+func TestImporter_RIBIPv6AddPath(t *testing.T) {
+	nh := net.ParseIP("2001:db8::1")
+	attrs := []byte{0x40, 1, 1, 0, 0x40, 2, 6, 2, 1, 0, 0, 0xfd, 0xe9, 0x80, 14, 17, 16}
+	attrs = append(attrs, nh...)
+	var msgs []bmp.Message
+	im := collect(t, &ImportConfig{RouterIP: "192.0.2.254"}, &msgs)
+	recs := []*Record{
+		{
+			Timestamp: time.Unix(5, 0),
+			Type:      TypeTableDumpV2,
+			Subtype:   SubtypePeerIndexTable,
+			Message: encodePeerIndexTable(net.ParseIP("10.0.0.1"), "", []PeerIndexEntry{
+				{BGPID: net.ParseIP("1.1.1.1"), IP: net.ParseIP("2001:db8::2"), AS: 65001},
+			}),
+		},
+		{
+			Timestamp: time.Unix(5, 0),
+			Type:      TypeTableDumpV2,
+			Subtype:   SubtypeRIBIPv6UnicastAddPath,
+			Message:   encodeRIB(0, []byte{32, 0x20, 0x01, 0x0d, 0xb8}, []RIBEntry{{PathID: 9, OriginatedTime: 3, Attributes: attrs}}, true),
+		},
+	}
+	for _, rec := range recs {
+		if err := im.ImportRecord(rec); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if len(msgs) != 2 {
+		t.Fatalf("expected 2 messages got %d", len(msgs))
+	}
+	pu := msgs[0].Payload.(*bmp.PeerUpMessage)
+	ap := pu.ReceivedOpen.AddPathCapability()
+	if !ap[bgp.NLRIMessageType(2, 1)] {
+		t.Fatalf("expected ADD-PATH for IPv6 unicast got %+v", ap)
+	}
+	if msgs[0].SpeakerIP != "192.0.2.254" || pu.GetLocalAddressString() != "192.0.2.254" {
+		t.Fatalf("unexpected router identity %s / %s", msgs[0].SpeakerIP, pu.GetLocalAddressString())
+	}
+	rm := msgs[1].Payload.(*bmp.RouteMonitor)
+	if !msgs[1].PeerHeader.IsRemotePeerIPv6() {
+		t.Fatalf("expected IPv6 peer header")
+	}
+	if ts := msgs[1].PeerHeader.GetPeerTimestamp(); ts != time.Unix(3, 0).UTC().Format(time.RFC3339Nano) {
+		t.Fatalf("unexpected timestamp %s", ts)
+	}
+	var mp []byte
+	for _, a := range rm.Update.PathAttributes {
+		if a.AttributeType == bgp.MP_REACH_NLRI {
+			mp = a.Attribute
+		}
+	}
+	expect := []byte{0, 2, 1, 16}
+	expect = append(expect, nh...)
+	expect = append(expect, 0, 0, 0, 0, 9, 32, 0x20, 0x01, 0x0d, 0xb8)
+	if !bytes.Equal(mp, expect) {
+		t.Fatalf("expected MP_REACH_NLRI %x got %x", expect, mp)
+	}
+}
+
+func TestImporter_BGP4MPStateChanges(t *testing.T) {
+	p := &bgp4mpPeer{PeerAS: 65001, LocalAS: 65000, PeerIP: net.ParseIP("192.0.2.1"), LocalIP: net.ParseIP("192.0.2.2")}
+	var msgs []bmp.Message
+	im := collect(t, nil, &msgs)
+	for _, states := range [][2]uint16{{StateOpenConfirm, StateEstablished}, {StateEstablished, StateIdle}, {StateIdle, StateConnect}} {
+		rec := &Record{
+			Timestamp: time.Unix(1, 0),
+			Type:      TypeBGP4MPET,
+			Subtype:   SubtypeBGP4MPStateChangeAS4,
+			Message:   encodeBGP4MPStateChange(p, states[0], states[1]),
+		}
+		if err := im.ImportRecord(rec); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if len(msgs) != 2 {
+		t.Fatalf("expected Peer Up and Peer Down got %d messages", len(msgs))
+	}
+	if _, ok := msgs[0].Payload.(*bmp.PeerUpMessage); !ok {
+		t.Fatalf("expected Peer Up got %T", msgs[0].Payload)
+	}
+	if _, ok := msgs[1].Payload.(*bmp.PeerDownMessage); !ok {
+		t.Fatalf("expected Peer Down got %T", msgs[1].Payload)
+	}
+	if msgs[0].SpeakerIP != "192.0.2.2" {
+		t.Fatalf("unexpected speaker ip %s", msgs[0].SpeakerIP)
+	}
+	if id := msgs[0].PeerHeader.GetPeerBGPIDString(); id != "192.0.2.1" {
+		t.Fatalf("unexpected peer bgp id %s", id)
+	}
+}
+
+func TestImporter_Errors(t *testing.T) {
+	if _, err := NewImporter(nil, nil); err == nil {
+		t.Fatalf("expected error for nil consumer")
+	}
+	if _, err := NewImporter(&ImportConfig{RouterIP: "router"}, func(bmp.Message) {}); err == nil {
+		t.Fatalf("expected error for invalid router ip")
+	}
+	var msgs []bmp.Message
+	im := collect(t, nil, &msgs)
+	rec := &Record{Type: TypeTableDumpV2, Subtype: SubtypeRIBIPv4Unicast, Message: encodeRIB(0, []byte{0}, nil, false)}
+	if err := im.ImportRecord(rec); err == nil {
+		t.Fatalf("expected error for RIB before PEER_INDEX_TABLE")
+	}
+	if err := im.ImportRecord(&Record{Type: 12}); err != nil || len(msgs) != 0 {
+		t.Fatalf("expected unsupported record to be skipped, error %v", err)
+	}
+}
@@ -0,0 +1,230 @@
+// Package mrt implements the Multi-Threaded Routing Toolkit (MRT) routing
+// information export format defined in RFC 6396, with the ADD-PATH
+// extensions of RFC 8050.
+package mrt
+
+import (
+	"encoding/binary"
+	"net"
+	"time"
+)
+
+// MRT record types (RFC 6396 §4)
+const (
+	TypeTableDumpV2 = 13
+	TypeBGP4MP      = 16
+	TypeBGP4MPET    = 17
+)
+
+// TABLE_DUMP_V2 subtypes (RFC 6396 §4.3, RFC 8050 §3)
+const (
+	SubtypePeerIndexTable        = 1
+	SubtypeRIBIPv4Unicast        = 2
+	SubtypeRIBIPv6Unicast        = 4
+	SubtypeRIBIPv4UnicastAddPath = 8
+	SubtypeRIBIPv6UnicastAddPath = 10
+)
+
+// BGP4MP and BGP4MP_ET subtypes (RFC 6396 §4.4, RFC 8050 §3)
+const (
+	SubtypeBGP4MPStateChange       = 0
+	SubtypeBGP4MPMessage           = 1
+	SubtypeBGP4MPMessageAS4        = 4
+	SubtypeBGP4MPStateChangeAS4    = 5
+	SubtypeBGP4MPMessageAddPath    = 8
+	SubtypeBGP4MPMessageAS4AddPath = 9
+)
+
+// BGP FSM states carried in BGP4MP_STATE_CHANGE records (RFC 6396 §4.4.1)
+const (
+	StateIdle        = 1
+	StateConnect     = 2
+	StateActive      = 3
+	StateOpenSent    = 4
+	StateOpenConfirm = 5
+	StateEstablished = 6
+)
+
+const (
+	// HeaderLength is the length of the MRT Common Header
+	HeaderLength = 12
+	// asTrans is the AS_TRANS value used where a 4 octet ASN must be carried
+	// in a 2 octet field (RFC 6793 §9)
+	asTrans = 23456
+)
+
+// isExtendedTimestamp returns true for record types carrying the additional
+// microsecond timestamp field (RFC 6396 §3).
+func isExtendedTimestamp(t uint16) bool {
+	return t == TypeBGP4MPET
+}
+
+// encodeRecord builds a complete MRT record: the Common Header followed by
+// the microsecond timestamp for _ET types and the message body.
+//
+//	+-----------------------------------------+
+//	| Timestamp (4 octets)                    |
+//	+-----------------------------------------+
+//	| Type (2 octets) | Subtype (2 octets)    |
+//	+-----------------------------------------+
+//	| Length (4 octets)                       |
+//	+-----------------------------------------+
+//	| Microsecond Timestamp (_ET only)        |
+//	+-----------------------------------------+
+//	| Message (variable)                      |
+//	+-----------------------------------------+
+func encodeRecord(ts time.Time, t, st uint16, body []byte) []byte {
+	l := len(body)
+	if isExtendedTimestamp(t) {
+		l += 4
+	}
+	b := make([]byte, HeaderLength, HeaderLength+l)
+	binary.BigEndian.PutUint32(b[0:4], uint32(ts.Unix()))
+	binary.BigEndian.PutUint16(b[4:6], t)
+	binary.BigEndian.PutUint16(b[6:8], st)
+	binary.BigEndian.PutUint32(b[8:12], uint32(l))
+	if isExtendedTimestamp(t) {
+		b = binary.BigEndian.AppendUint32(b, uint32(ts.Nanosecond()/1000))
+	}
+
+	return append(b, body...)
+}
+
+// bgp4mpPeer carries the fields common to the BGP4MP_MESSAGE and
+// BGP4MP_STATE_CHANGE records.
+type bgp4mpPeer struct {
+	PeerAS  uint32
+	LocalAS uint32
+	PeerIP  net.IP
+	LocalIP net.IP
+}
+
+// afi returns the Address Family of the peering, IPv6 when the peer address
+// is not an IPv4 address.
+func (p *bgp4mpPeer) afi() uint16 {
+	if p.PeerIP.To4() == nil && p.PeerIP != nil {
+		return 2
+	}
+	return 1
+}
+
+// encode appends Peer AS, Local AS, Interface Index, Address Family, Peer IP
+// and Local IP to b, ASNs being 4 octets when as4 is true.
+func (p *bgp4mpPeer) encode(b []byte, as4 bool) []byte {
+	if as4 {
+		b = binary.BigEndian.AppendUint32(b, p.PeerAS)
+		b = binary.BigEndian.AppendUint32(b, p.LocalAS)
+	} else {
+		b = binary.BigEndian.AppendUint16(b, as2(p.PeerAS))
+		b = binary.BigEndian.AppendUint16(b, as2(p.LocalAS))
+	}
+	// Interface Index is not known to the BMP collector
+	b = binary.BigEndian.AppendUint16(b, 0)
+	afi := p.afi()
+	b = binary.BigEndian.AppendUint16(b, afi)
+	b = append(b, ipBytes(p.PeerIP, afi)...)
+	b = append(b, ipBytes(p.LocalIP, afi)...)
+
+	return b
+}
+
+// encodeBGP4MPMessage returns the body of a BGP4MP_MESSAGE family record
+// carrying the complete BGP message msg.
+func encodeBGP4MPMessage(p *bgp4mpPeer, as4 bool, msg []byte) []byte {
+	b := make([]byte, 0, 44+len(msg))
+	b = p.encode(b, as4)
+
+	return append(b, msg...)
+}
+
+// encodeBGP4MPStateChange returns the body of a BGP4MP_STATE_CHANGE_AS4 record.
+func encodeBGP4MPStateChange(p *bgp4mpPeer, oldState, newState uint16) []byte {
+	b := make([]byte, 0, 48)
+	b = p.encode(b, true)
+	b = binary.BigEndian.AppendUint16(b, oldState)
+
+	return binary.BigEndian.AppendUint16(b, newState)
+}
+
+// PeerIndexEntry is a single peer of the PEER_INDEX_TABLE (RFC 6396 §4.3.1).
+type PeerIndexEntry struct {
+	BGPID net.IP
+	IP    net.IP
+	AS    uint32
+}
+
+// encodePeerIndexTable returns the body of a PEER_INDEX_TABLE record. All
+// peers are encoded with 4 octet ASNs.
+func encodePeerIndexTable(collectorID net.IP, viewName string, peers []PeerIndexEntry) []byte {
+	b := make([]byte, 0, 8+len(viewName)+len(peers)*25)
+	b = append(b, ipBytes(collectorID, 1)...)
+	b = binary.BigEndian.AppendUint16(b, uint16(len(viewName)))
+	b = append(b, viewName...)
+	b = binary.BigEndian.AppendUint16(b, uint16(len(peers)))
+	for _, p := range peers {
+		// Bit 1 (0x2) set: AS is 4 octets, bit 0 (0x1) set: IPv6 peer address
+		pt := uint8(0x2)
+		afi := uint16(1)
+		if p.IP != nil && p.IP.To4() == nil {
+			pt |= 0x1
+			afi = 2
+		}
+		b = append(b, pt)
+		b = append(b, ipBytes(p.BGPID, 1)...)
+		b = append(b, ipBytes(p.IP, afi)...)
+		b = binary.BigEndian.AppendUint32(b, p.AS)
+	}
+
+	return b
+}
+
+// RIBEntry is a single route of a RIB_AFI_SAFI record (RFC 6396 §4.3.4).
+type RIBEntry struct {
+	PeerIndex      uint16
+	OriginatedTime uint32
+	PathID         uint32
+	Attributes     []byte
+}
+
+// encodeRIB returns the body of a RIB_IPV4_UNICAST / RIB_IPV6_UNICAST record
+// or, when addPath is true, of their _ADDPATH variants. prefix is the NLRI
+// encoded prefix: the length in bits followed by the significant octets.
+func encodeRIB(seq uint32, prefix []byte, entries []RIBEntry, addPath bool) []byte {
+	b := make([]byte, 0, 6+len(prefix)+len(entries)*32)
+	b = binary.BigEndian.AppendUint32(b, seq)
+	b = append(b, prefix...)
+	b = binary.BigEndian.AppendUint16(b, uint16(len(entries)))
+	for _, e := range entries {
+		b = binary.BigEndian.AppendUint16(b, e.PeerIndex)
+		b = binary.BigEndian.AppendUint32(b, e.OriginatedTime)
+		if addPath {
+			b = binary.BigEndian.AppendUint32(b, e.PathID)
+		}
+		b = binary.BigEndian.AppendUint16(b, uint16(len(e.Attributes)))
+		b = append(b, e.Attributes...)
+	}
+
+	return b
+}
+
+// ipBytes returns ip as 4 octets for afi 1 and 16 octets otherwise, a nil or
+// mismatched address being encoded as all zeros.
+func ipBytes(ip net.IP, afi uint16) []byte {
+	if afi == 1 {
+		if v4 := ip.To4(); v4 != nil {
+			return v4
+		}
+		return make([]byte, net.IPv4len)
+	}
+	if v6 := ip.To16(); v6 != nil {
+		return v6
+	}
+	return make([]byte, net.IPv6len)
+}
+
+func as2(as uint32) uint16 {
+	if as > 0xffff {
+		return asTrans
+	}
+	return uint16(as)
+}
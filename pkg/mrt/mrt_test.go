@@ -0,0 +1,189 @@
+package mrt
+
+import (
+	"bytes"
+	"net"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/sbezverk/gobmp/pkg/bgp"
+)
+
+func TestEncodeRecord(t *testing.T) {
+	ts := time.Unix(0x5f000000, 123456000)
+	tests := []struct {
+		name   string
+		t, st  uint16
+		body   []byte
+		expect []byte
+	}{
+		{
+			name: "table dump v2",
+			t:    TypeTableDumpV2,
+			st:   SubtypePeerIndexTable,
+			body: []byte{0xaa, 0xbb},
+			expect: []byte{
+				0x5f, 0x00, 0x00, 0x00, 0x00, 0x0d, 0x00, 0x01, 0x00, 0x00, 0x00, 0x02,
+				0xaa, 0xbb,
+			},
+		},
+		{
+			name: "bgp4mp_et carries microseconds",
+			t:    TypeBGP4MPET,
+			st:   SubtypeBGP4MPMessageAS4,
+			body: []byte{0xaa},
+			expect: []byte{
+				0x5f, 0x00, 0x00, 0x00, 0x00, 0x11, 0x00, 0x04, 0x00, 0x00, 0x00, 0x05,
+				0x00, 0x01, 0xe2, 0x40, 0xaa,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := encodeRecord(ts, tt.t, tt.st, tt.body); !bytes.Equal(got, tt.expect) {
+				t.Fatalf("expected %x got %x", tt.expect, got)
+			}
+		})
+	}
+}
+
+func TestEncodeBGP4MP(t *testing.T) {
+	p := &bgp4mpPeer{
+		PeerAS:  70000,
+		LocalAS: 65000,
+		PeerIP:  net.ParseIP("192.0.2.1"),
+		LocalIP: net.ParseIP("192.0.2.2"),
+	}
+	got := encodeBGP4MPStateChange(p, StateEstablished, StateIdle)
+	expect := []byte{
+		0x00, 0x01, 0x11, 0x70, 0x00, 0x00, 0xfd, 0xe8, 0x00, 0x00, 0x00, 0x01,
+		192, 0, 2, 1, 192, 0, 2, 2, 0x00, 0x06, 0x00, 0x01,
+	}
+	if !bytes.Equal(got, expect) {
+		t.Fatalf("state change: expected %x got %x", expect, got)
+	}
+	got = encodeBGP4MPMessage(p, false, []byte{0xff})
+	expect = []byte{
+		0x5b, 0xa0, 0xfd, 0xe8, 0x00, 0x00, 0x00, 0x01,
+		192, 0, 2, 1, 192, 0, 2, 2, 0xff,
+	}
+	if !bytes.Equal(got, expect) {
+		t.Fatalf("2 octet message: expected %x got %x", expect, got)
+	}
+	p6 := &bgp4mpPeer{PeerAS: 1, PeerIP: net.ParseIP("2001:db8::1")}
+	if got := encodeBGP4MPMessage(p6, true, nil); len(got) != 44 || got[11] != 2 {
+		t.Fatalf("ipv6 message: unexpected encoding %x", got)
+	}
+}
+
+func TestEncodePeerIndexTable(t *testing.T) {
+	got := encodePeerIndexTable(net.ParseIP("10.0.0.1"), "r1", []PeerIndexEntry{
+		{BGPID: net.ParseIP("1.1.1.1"), IP: net.ParseIP("192.0.2.1"), AS: 65001},
+		{BGPID: net.ParseIP("2.2.2.2"), IP: net.ParseIP("2001:db8::2"), AS: 65002},
+	})
+	expect := []byte{
+		10, 0, 0, 1, 0x00, 0x02, 'r', '1', 0x00, 0x02,
+		0x02, 1, 1, 1, 1, 192, 0, 2, 1, 0x00, 0x00, 0xfd, 0xe9,
+		0x03, 2, 2, 2, 2, 0x20, 0x01, 0x0d, 0xb8, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 0x00, 0x00, 0xfd, 0xea,
+	}
+	if !bytes.Equal(got, expect) {
+		t.Fatalf("expected %x got %x", expect, got)
+	}
+}
+
+func TestEncodeRIB(t *testing.T) {
+	entries := []RIBEntry{{PeerIndex: 1, OriginatedTime: 2, PathID: 3, Attributes: []byte{0x40, 0x01, 0x01, 0x00}}}
+	got := encodeRIB(7, []byte{8, 10}, entries, false)
+	expect := []byte{
+		0, 0, 0, 7, 8, 10, 0, 1,
+		0, 1, 0, 0, 0, 2, 0, 4, 0x40, 0x01, 0x01, 0x00,
+	}
+	if !bytes.Equal(got, expect) {
+		t.Fatalf("expected %x got %x", expect, got)
+	}
+	got = encodeRIB(7, []byte{8, 10}, entries, true)
+	expect = []byte{
+		0, 0, 0, 7, 8, 10, 0, 1,
+		0, 1, 0, 0, 0, 2, 0, 0, 0, 3, 0, 4, 0x40, 0x01, 0x01, 0x00,
+	}
+	if !bytes.Equal(got, expect) {
+		t.Fatalf("add-path: expected %x got %x", expect, got)
+	}
+}
+
+func TestUnmarshalPrefixes(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   []byte
+		afi     uint16
+		addPath bool
+		expect  []nlriPrefix
+		fail    bool
+	}{
+		{
+			name:   "two ipv4 prefixes",
+			input:  []byte{8, 10, 24, 192, 0, 2},
+			afi:    1,
+			expect: []nlriPrefix{{prefix: []byte{8, 10}}, {prefix: []byte{24, 192, 0, 2}}},
+		},
+		{
+			name:    "add-path",
+			input:   []byte{0, 0, 0, 5, 0},
+			afi:     1,
+			addPath: true,
+			expect:  []nlriPrefix{{pathID: 5, prefix: []byte{0}}},
+		},
+		{
+			name:  "ipv4 prefix too long",
+			input: []byte{33, 1, 2, 3, 4, 5},
+			afi:   1,
+			fail:  true,
+		},
+		{
+			name:  "truncated",
+			input: []byte{24, 192, 0},
+			afi:   1,
+			fail:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := unmarshalPrefixes(tt.input, tt.afi, tt.addPath)
+			if (err != nil) != tt.fail {
+				t.Fatalf("expected failure %t got error %v", tt.fail, err)
+			}
+			if !tt.fail && !reflect.DeepEqual(got, tt.expect) {
+				t.Fatalf("expected %+v got %+v", tt.expect, got)
+			}
+		})
+	}
+}
+
+func TestRIBAttributes(t *testing.T) {
+	attrs := []bgp.PathAttribute{
+		{AttributeTypeFlags: 0x40, AttributeType: 1, Attribute: []byte{0}},
+		{AttributeTypeFlags: 0x40, AttributeType: 2, Attribute: []byte{2, 2, 0xfd, 0xe9, 0xfd, 0xea}},
+		{AttributeTypeFlags: 0xc0, AttributeType: 7, Attribute: []byte{0xfd, 0xe9, 1, 1, 1, 1}},
+		{AttributeTypeFlags: 0x90, AttributeType: 14, Attribute: []byte{0, 2, 1, 16}},
+		{AttributeTypeFlags: 0x90, AttributeType: 15, Attribute: []byte{0, 2, 1}},
+	}
+	nh := net.ParseIP("2001:db8::1")
+	got, err := ribAttributes(attrs, nh, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expect := []byte{
+		0x40, 1, 1, 0,
+		0x40, 2, 10, 2, 2, 0, 0, 0xfd, 0xe9, 0, 0, 0xfd, 0xea,
+		0xc0, 7, 8, 0, 0, 0xfd, 0xe9, 1, 1, 1, 1,
+		0x80, 14, 17, 16,
+	}
+	expect = append(expect, nh...)
+	if !bytes.Equal(got, expect) {
+		t.Fatalf("expected %x got %x", expect, got)
+	}
+	if _, err := ribAttributes([]bgp.PathAttribute{{AttributeType: 2, Attribute: []byte{2, 2, 0}}}, nil, false); err == nil {
+		t.Fatalf("expected error for truncated AS_PATH")
+	}
+}
@@ -0,0 +1,124 @@
+package mrt
+
+import (
+	"bufio"
+	"bytes"
+	"compress/bzip2"
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+)
+
+// maxRecordLength bounds the length of a single MRT record, it is well above
+// the largest TABLE_DUMP_V2 RIB record seen in public archives.
+const maxRecordLength = 16 << 20
+
+// Record is a single MRT record.
+type Record struct {
+	Timestamp time.Time
+	Type      uint16
+	Subtype   uint16
+	// Message is the record body, without the microsecond timestamp of the
+	// _ET types which is folded into Timestamp.
+	Message []byte
+}
+
+// Reader reads MRT records from a stream.
+type Reader struct {
+	r      *bufio.Reader
+	closer []io.Closer
+}
+
+// NewReader returns a Reader of the uncompressed MRT stream r.
+func NewReader(r io.Reader) *Reader {
+	return &Reader{
+		r: bufio.NewReader(r),
+	}
+}
+
+// Open opens the MRT file name, transparently decompressing gzip and bzip2
+// files recognised by their magic number.
+func Open(name string) (*Reader, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	br := bufio.NewReader(f)
+	magic, err := br.Peek(3)
+	if err != nil && !errors.Is(err, io.EOF) {
+		_ = f.Close()
+		return nil, fmt.Errorf("failed to read %s with error: %w", name, err)
+	}
+	rd := &Reader{
+		closer: []io.Closer{f},
+	}
+	switch {
+	case bytes.HasPrefix(magic, []byte{0x1f, 0x8b}):
+		gz, err := gzip.NewReader(br)
+		if err != nil {
+			_ = f.Close()
+			return nil, fmt.Errorf("failed to open gzip file %s with error: %w", name, err)
+		}
+		rd.closer = append(rd.closer, gz)
+		rd.r = bufio.NewReader(gz)
+	case bytes.HasPrefix(magic, []byte("BZh")):
+		rd.r = bufio.NewReader(bzip2.NewReader(br))
+	default:
+		rd.r = br
+	}
+
+	return rd, nil
+}
+
+// Close releases the file and decompressor of a Reader returned by Open.
+func (rd *Reader) Close() error {
+	var err error
+	for i := len(rd.closer) - 1; i >= 0; i-- {
+		if e := rd.closer[i].Close(); e != nil && err == nil {
+			err = e
+		}
+	}
+	rd.closer = nil
+
+	return err
+}
+
+// Next returns the next record, or io.EOF when the stream ends on a record
+// boundary.
+func (rd *Reader) Next() (*Record, error) {
+	var h [HeaderLength]byte
+	if _, err := io.ReadFull(rd.r, h[:]); err != nil {
+		if errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil, fmt.Errorf("truncated mrt record header: %w", err)
+		}
+		return nil, err
+	}
+	rec := &Record{
+		Type:    binary.BigEndian.Uint16(h[4:6]),
+		Subtype: binary.BigEndian.Uint16(h[6:8]),
+	}
+	l := binary.BigEndian.Uint32(h[8:12])
+	if l > maxRecordLength {
+		return nil, fmt.Errorf("mrt record type %d length %d exceeds maximum %d", rec.Type, l, maxRecordLength)
+	}
+	b := make([]byte, l)
+	if _, err := io.ReadFull(rd.r, b); err != nil {
+		return nil, fmt.Errorf("truncated mrt record type %d length %d: %w", rec.Type, l, err)
+	}
+	var us uint32
+	if isExtendedTimestamp(rec.Type) {
+		if len(b) < 4 {
+			return nil, fmt.Errorf("mrt record type %d too short for microsecond timestamp", rec.Type)
+		}
+		us = binary.BigEndian.Uint32(b[0:4])
+		b = b[4:]
+	}
+	rec.Timestamp = time.Unix(int64(binary.BigEndian.Uint32(h[0:4])), int64(us)*int64(time.Microsecond))
+	rec.Message = b
+
+	return rec, nil
+}
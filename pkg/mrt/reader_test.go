@@ -0,0 +1,165 @@
+package mrt
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// testPeerIndexRecord is a PEER_INDEX_TABLE record with collector ID 10.0.0.1,
+// an empty view name and no peers.
+var testPeerIndexRecord = []byte{
+	0, 0, 0, 1, 0, 13, 0, 1, 0, 0, 0, 8,
+	10, 0, 0, 1, 0, 0, 0, 0,
+}
+
+func TestReaderOpen(t *testing.T) {
+	dir := t.TempDir()
+	var gz bytes.Buffer
+	zw := gzip.NewWriter(&gz)
+	_, _ = zw.Write(testPeerIndexRecord)
+	_ = zw.Close()
+	// testPeerIndexRecord compressed with bzip2
+	bz, _ := hex.DecodeString("425a6839314159265359bca4c8fb000002c0006052200030cd00935322c1bd325433e2ee48a70a121794991f60")
+	files := map[string][]byte{
+		"plain.mrt":    testPeerIndexRecord,
+		"gzip.mrt.gz":  gz.Bytes(),
+		"bzip2.mrt.bz": bz,
+	}
+	for name, content := range files {
+		t.Run(name, func(t *testing.T) {
+			fn := filepath.Join(dir, name)
+			if err := os.WriteFile(fn, content, 0o644); err != nil {
+				t.Fatalf("failed to write %s: %v", fn, err)
+			}
+			rd, err := Open(fn)
+			if err != nil {
+				t.Fatalf("failed to open %s: %v", fn, err)
+			}
+			defer func() { _ = rd.Close() }()
+			rec, err := rd.Next()
+			if err != nil {
+				t.Fatalf("failed to read record: %v", err)
+			}
+			if rec.Type != TypeTableDumpV2 || rec.Subtype != SubtypePeerIndexTable || rec.Timestamp.Unix() != 1 {
+				t.Fatalf("unexpected record %+v", rec)
+			}
+			pit, err := UnmarshalPeerIndexTable(rec.Message)
+			if err != nil {
+				t.Fatalf("failed to decode peer index table: %v", err)
+			}
+			if !pit.CollectorID.Equal(net.ParseIP("10.0.0.1")) {
+				t.Fatalf("unexpected collector id %s", pit.CollectorID)
+			}
+			if _, err := rd.Next(); !errors.Is(err, io.EOF) {
+				t.Fatalf("expected io.EOF got %v", err)
+			}
+		})
+	}
+}
+
+func TestReaderNext_Errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+	}{
+		{
+			name:  "truncated header",
+			input: []byte{0, 0, 0, 1, 0, 13},
+		},
+		{
+			name:  "truncated body",
+			input: []byte{0, 0, 0, 1, 0, 13, 0, 1, 0, 0, 0, 8, 10},
+		},
+		{
+			name:  "et without microseconds",
+			input: []byte{0, 0, 0, 1, 0, 17, 0, 4, 0, 0, 0, 2, 0, 0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewReader(bytes.NewReader(tt.input)).Next()
+			if err == nil || errors.Is(err, io.EOF) {
+				t.Fatalf("expected decoding error got %v", err)
+			}
+		})
+	}
+}
+
+func TestReaderNext_ExtendedTimestamp(t *testing.T) {
+	ts := time.Unix(100, 250000000)
+	rec, err := NewReader(bytes.NewReader(encodeRecord(ts, TypeBGP4MPET, SubtypeBGP4MPMessageAS4, []byte{1, 2}))).Next()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !rec.Timestamp.Equal(ts) || !bytes.Equal(rec.Message, []byte{1, 2}) {
+		t.Fatalf("unexpected record %+v", rec)
+	}
+}
+
+func TestUnmarshalPeerIndexTable_RoundTrip(t *testing.T) {
+	peers := []PeerIndexEntry{
+		{BGPID: net.ParseIP("1.1.1.1").To4(), IP: net.ParseIP("192.0.2.1").To4(), AS: 65001},
+		{BGPID: net.ParseIP("2.2.2.2").To4(), IP: net.ParseIP("2001:db8::2"), AS: 4200000000},
+	}
+	pit, err := UnmarshalPeerIndexTable(encodePeerIndexTable(net.ParseIP("10.0.0.1"), "view", peers))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if pit.ViewName != "view" || !reflect.DeepEqual(pit.Peers, peers) {
+		t.Fatalf("unexpected peer index table %+v", pit)
+	}
+	if _, err := UnmarshalPeerIndexTable([]byte{10, 0, 0, 1, 0, 0, 0, 1, 0x02}); err == nil {
+		t.Fatalf("expected error for truncated peer entry")
+	}
+}
+
+func TestUnmarshalRIB_RoundTrip(t *testing.T) {
+	entries := []RIBEntry{
+		{PeerIndex: 0, OriginatedTime: 10, PathID: 7, Attributes: []byte{0x40, 1, 1, 0}},
+		{PeerIndex: 1, OriginatedTime: 11, PathID: 8, Attributes: []byte{}},
+	}
+	rib, err := UnmarshalRIB(SubtypeRIBIPv4UnicastAddPath, encodeRIB(3, []byte{8, 10}, entries, true))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rib.Sequence != 3 || rib.AFI != 1 || rib.SAFI != 1 || !rib.AddPath || !reflect.DeepEqual(rib.Entries, entries) {
+		t.Fatalf("unexpected rib %+v", rib)
+	}
+	if _, err := UnmarshalRIB(SubtypeRIBIPv4Unicast, []byte{0, 0, 0, 1, 33, 1, 2, 3, 4, 5, 0, 0}); err == nil {
+		t.Fatalf("expected error for invalid prefix length")
+	}
+	if _, err := UnmarshalRIB(6, nil); err == nil {
+		t.Fatalf("expected error for RIB_GENERIC")
+	}
+}
+
+func TestUnmarshalBGP4MP_RoundTrip(t *testing.T) {
+	p := &bgp4mpPeer{PeerAS: 65001, LocalAS: 65000, PeerIP: net.ParseIP("2001:db8::1"), LocalIP: net.ParseIP("2001:db8::2")}
+	m, err := UnmarshalBGP4MP(SubtypeBGP4MPStateChangeAS4, encodeBGP4MPStateChange(p, StateOpenConfirm, StateEstablished))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !m.IsStateChange() || m.NewState != StateEstablished || m.PeerAS != 65001 || !m.LocalIP.Equal(p.LocalIP) {
+		t.Fatalf("unexpected state change %+v", m)
+	}
+	msg := make([]byte, 19)
+	m, err = UnmarshalBGP4MP(SubtypeBGP4MPMessage, encodeBGP4MPMessage(p, false, msg))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if m.IsStateChange() || m.AS4 || m.LocalAS != 65000 || len(m.Message) != 19 {
+		t.Fatalf("unexpected message %+v", m)
+	}
+	if _, err := UnmarshalBGP4MP(SubtypeBGP4MPMessageAS4, encodeBGP4MPMessage(p, true, msg[:10])); err == nil {
+		t.Fatalf("expected error for short BGP message")
+	}
+}
@@ -0,0 +1,204 @@
+package mrt
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/sbezverk/gobmp/pkg/bgp"
+)
+
+// ribKey identifies a route in a peer's RIB.
+type ribKey struct {
+	afi    uint16
+	pathID uint32
+	// prefix is the NLRI encoded prefix converted to string to be comparable
+	prefix string
+}
+
+// ribRoute is the state kept for a route between snapshots.
+type ribRoute struct {
+	originated uint32
+	attrs      []byte
+}
+
+// nlriPrefix is a single unicast prefix extracted from an UPDATE.
+type nlriPrefix struct {
+	pathID uint32
+	prefix []byte
+}
+
+// unmarshalPrefixes splits an IPv4 or IPv6 unicast NLRI field into prefixes,
+// each carrying a 4 octet Path Identifier when addPath is true (RFC 7911 §3).
+func unmarshalPrefixes(b []byte, afi uint16, addPath bool) ([]nlriPrefix, error) {
+	maxBits := 32
+	if afi == 2 {
+		maxBits = 128
+	}
+	prefixes := make([]nlriPrefix, 0)
+	for p := 0; p < len(b); {
+		np := nlriPrefix{}
+		if addPath {
+			if p+4 > len(b) {
+				return nil, fmt.Errorf("not enough bytes for path id at offset %d", p)
+			}
+			np.pathID = binary.BigEndian.Uint32(b[p : p+4])
+			p += 4
+		}
+		if p >= len(b) {
+			return nil, fmt.Errorf("not enough bytes for prefix length at offset %d", p)
+		}
+		bits := int(b[p])
+		if bits > maxBits {
+			return nil, fmt.Errorf("invalid prefix length %d for afi %d", bits, afi)
+		}
+		l := 1 + (bits+7)/8
+		if p+l > len(b) {
+			return nil, fmt.Errorf("prefix of length %d exceeds remaining %d bytes", bits, len(b)-p)
+		}
+		np.prefix = b[p : p+l]
+		prefixes = append(prefixes, np)
+		p += l
+	}
+
+	return prefixes, nil
+}
+
+// ribChange is the set of unicast routes an UPDATE adds and removes.
+type ribChange struct {
+	afi       uint16
+	nextHop   []byte
+	announced []nlriPrefix
+	withdrawn []nlriPrefix
+}
+
+// unmarshalRIBChanges extracts IPv4 and IPv6 unicast announcements and
+// withdrawals from u. addPath reports if ADD-PATH is in use for an AFI/SAFI
+// keyed by bgp.NLRIMessageType.
+func unmarshalRIBChanges(u *bgp.Update, addPath map[int]bool) ([]ribChange, error) {
+	changes := make([]ribChange, 0, 2)
+	v4 := ribChange{afi: 1}
+	var err error
+	if len(u.WithdrawnRoutes) != 0 {
+		if v4.withdrawn, err = unmarshalPrefixes(u.WithdrawnRoutes, 1, addPath[bgp.NLRIMessageType(1, 1)]); err != nil {
+			return nil, fmt.Errorf("invalid withdrawn routes: %w", err)
+		}
+	}
+	if len(u.NLRI) != 0 {
+		if v4.announced, err = unmarshalPrefixes(u.NLRI, 1, addPath[bgp.NLRIMessageType(1, 1)]); err != nil {
+			return nil, fmt.Errorf("invalid nlri: %w", err)
+		}
+	}
+	if len(v4.announced) != 0 || len(v4.withdrawn) != 0 {
+		changes = append(changes, v4)
+	}
+	for _, attr := range u.PathAttributes {
+		switch attr.AttributeType {
+		case bgp.MP_REACH_NLRI:
+			b := attr.Attribute
+			if len(b) < 5 {
+				return nil, fmt.Errorf("invalid MP_REACH_NLRI length %d", len(b))
+			}
+			afi, safi := binary.BigEndian.Uint16(b[0:2]), b[2]
+			if (afi != 1 && afi != 2) || safi != 1 {
+				continue
+			}
+			nhl := int(b[3])
+			if 4+nhl+1 > len(b) {
+				return nil, fmt.Errorf("invalid MP_REACH_NLRI next hop length %d", nhl)
+			}
+			c := ribChange{afi: afi, nextHop: b[4 : 4+nhl]}
+			if c.announced, err = unmarshalPrefixes(b[4+nhl+1:], afi, addPath[bgp.NLRIMessageType(afi, safi)]); err != nil {
+				return nil, fmt.Errorf("invalid MP_REACH_NLRI nlri: %w", err)
+			}
+			changes = append(changes, c)
+		case bgp.MP_UNREACH_NLRI:
+			b := attr.Attribute
+			if len(b) < 3 {
+				return nil, fmt.Errorf("invalid MP_UNREACH_NLRI length %d", len(b))
+			}
+			afi, safi := binary.BigEndian.Uint16(b[0:2]), b[2]
+			if (afi != 1 && afi != 2) || safi != 1 {
+				continue
+			}
+			c := ribChange{afi: afi}
+			if c.withdrawn, err = unmarshalPrefixes(b[3:], afi, addPath[bgp.NLRIMessageType(afi, safi)]); err != nil {
+				return nil, fmt.Errorf("invalid MP_UNREACH_NLRI nlri: %w", err)
+			}
+			changes = append(changes, c)
+		}
+	}
+
+	return changes, nil
+}
+
+// ribAttributes encodes the path attributes stored with a RIB entry per
+// RFC 6396 §4.3.4: MP_REACH_NLRI is reduced to the next hop length and next
+// hop, MP_UNREACH_NLRI is dropped, and AS_PATH and AGGREGATOR are widened to
+// 4 octet ASNs when the session uses 2 octet ASNs.
+func ribAttributes(attrs []bgp.PathAttribute, nextHop []byte, as4 bool) ([]byte, error) {
+	b := make([]byte, 0, 64)
+	for _, attr := range attrs {
+		v := attr.Attribute
+		switch attr.AttributeType {
+		case bgp.MP_UNREACH_NLRI:
+			continue
+		case bgp.MP_REACH_NLRI:
+			if nextHop == nil {
+				continue
+			}
+			v = append([]byte{byte(len(nextHop))}, nextHop...)
+		case 2:
+			if !as4 {
+				var err error
+				if v, err = widenASPath(v); err != nil {
+					return nil, err
+				}
+			}
+		case 7:
+			if !as4 && len(v) == 6 {
+				w := make([]byte, 8)
+				binary.BigEndian.PutUint32(w[0:4], uint32(binary.BigEndian.Uint16(v[0:2])))
+				copy(w[4:], v[2:6])
+				v = w
+			}
+		}
+		b = appendAttribute(b, attr.AttributeTypeFlags, attr.AttributeType, v)
+	}
+
+	return b, nil
+}
+
+// appendAttribute appends a path attribute to b, setting or clearing the
+// Extended Length flag according to the length of v.
+func appendAttribute(b []byte, flags, t uint8, v []byte) []byte {
+	if len(v) > 255 {
+		b = append(b, flags|0x10, t)
+		b = binary.BigEndian.AppendUint16(b, uint16(len(v)))
+	} else {
+		b = append(b, flags&^0x10, t, byte(len(v)))
+	}
+
+	return append(b, v...)
+}
+
+// widenASPath converts an AS_PATH of 2 octet ASNs into 4 octet ASNs.
+func widenASPath(b []byte) ([]byte, error) {
+	w := make([]byte, 0, len(b)*2)
+	for p := 0; p < len(b); {
+		if p+2 > len(b) {
+			return nil, fmt.Errorf("AS_PATH truncated at segment header: offset %d, len %d", p, len(b))
+		}
+		n := int(b[p+1])
+		w = append(w, b[p], b[p+1])
+		p += 2
+		if p+n*2 > len(b) {
+			return nil, fmt.Errorf("AS_PATH truncated: segment of %d ASNs exceeds remaining %d bytes", n, len(b)-p)
+		}
+		for i := 0; i < n; i++ {
+			w = binary.BigEndian.AppendUint32(w, uint32(binary.BigEndian.Uint16(b[p:p+2])))
+			p += 2
+		}
+	}
+
+	return w, nil
+}
@@ -0,0 +1,570 @@
+package mrt
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/sbezverk/gobmp/pkg/bgp"
+	"github.com/sbezverk/gobmp/pkg/bmp"
+)
+
+const (
+	// DefaultRotationInterval is the default period after which a new updates
+	// file is started
+	DefaultRotationInterval = 15 * time.Minute
+	// DefaultRIBInterval is the default period between RIB snapshots
+	DefaultRIBInterval = 2 * time.Hour
+	// fileTimeFormat follows the naming used by RouteViews and RIPE RIS archives
+	fileTimeFormat = "20060102.1504"
+)
+
+// Config defines the MRT writer configuration
+type Config struct {
+	// Dir is the directory under which a sub directory per router is created
+	Dir string
+	// RotationInterval is the period covered by each updates file, zero selects
+	// DefaultRotationInterval
+	RotationInterval time.Duration
+	// RIBInterval is the period between TABLE_DUMP_V2 snapshots, zero selects
+	// DefaultRIBInterval and a negative value disables snapshots
+	RIBInterval time.Duration
+}
+
+// Writer consumes the stream of parsed BMP messages and writes, per router,
+// BGP4MP_ET records for every Route Monitor and peer state change into time
+// rotated updates files and periodic TABLE_DUMP_V2 RIB snapshots. The mutex of
+// the Writer protects the routers map, the state of each router is protected
+// by the mutex of the router.
+type Writer struct {
+	mu               sync.Mutex
+	dir              string
+	rotationInterval time.Duration
+	routers          map[string]*router
+	stop             chan struct{}
+	wg               sync.WaitGroup
+	stopped          bool
+	// now returns current time, replaced in tests
+	now func() time.Time
+}
+
+// router holds the updates file and the RIB state of a single BMP speaker.
+type router struct {
+	mu          sync.Mutex
+	name        string
+	dir         string
+	updates     *os.File
+	updatesFrom time.Time
+	peers       map[string]*peer
+	// down is set when the BMP session of the router terminates or the writer
+	// stops, no record is written for the router afterwards
+	down bool
+}
+
+// Session is the BMP session of a router. The messages written through a
+// Session update the router state of this session only, and ending the
+// session does not release the state of a later session of the same router.
+type Session struct {
+	w *Writer
+	r *router
+}
+
+// peer holds the identity and Adj-RIB of a single monitored BGP peer.
+type peer struct {
+	bgp4mpPeer
+	bgpID   net.IP
+	localID net.IP
+	as4     bool
+	addPath map[int]bool
+	rib     map[ribKey]*ribRoute
+}
+
+// NewWriter creates the MRT output directory and returns a running Writer.
+func NewWriter(cfg *Config) (*Writer, error) {
+	if cfg == nil || cfg.Dir == "" {
+		return nil, fmt.Errorf("mrt output directory is not specified")
+	}
+	if err := os.MkdirAll(cfg.Dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create mrt output directory %s with error: %w", cfg.Dir, err)
+	}
+	w := &Writer{
+		dir:              cfg.Dir,
+		rotationInterval: cfg.RotationInterval,
+		routers:          make(map[string]*router),
+		stop:             make(chan struct{}),
+		now:              time.Now,
+	}
+	if w.rotationInterval <= 0 {
+		w.rotationInterval = DefaultRotationInterval
+	}
+	ribInterval := cfg.RIBInterval
+	if ribInterval == 0 {
+		ribInterval = DefaultRIBInterval
+	}
+	if ribInterval > 0 {
+		w.wg.Add(1)
+		go w.snapshotter(ribInterval)
+	}
+
+	return w, nil
+}
+
+// WriteMessage records a single BMP message. Peer Up, Peer Down and Route
+// Monitor messages are processed, any other message is ignored. The message
+// is recorded for the router of msg.SpeakerIP, the messages of a BMP session
+// are written through its Session.
+func (w *Writer) WriteMessage(msg bmp.Message) {
+	if msg.PeerHeader == nil {
+		return
+	}
+	w.mu.Lock()
+	if w.stopped {
+		w.mu.Unlock()
+		return
+	}
+	r := w.getRouter(msg.SpeakerIP)
+	w.mu.Unlock()
+	w.writeMessage(r, msg)
+}
+
+// RouterUp starts the BMP session of the router speakerIP, the state kept for
+// a previous session of the router is discarded.
+func (w *Writer) RouterUp(speakerIP string) *Session {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	name := routerName(speakerIP)
+	if r, ok := w.routers[name]; ok {
+		r.close()
+	}
+	r := w.newRouter(name)
+	r.down = w.stopped
+	w.routers[name] = r
+
+	return &Session{w: w, r: r}
+}
+
+// WriteMessage records a single BMP message of the session, as
+// Writer.WriteMessage does.
+func (s *Session) WriteMessage(msg bmp.Message) {
+	if msg.PeerHeader == nil {
+		return
+	}
+	s.w.writeMessage(s.r, msg)
+}
+
+// RouterDown closes the updates file of the session and discards its RIB, it
+// is called when the BMP session with the router terminates.
+func (s *Session) RouterDown() {
+	s.w.mu.Lock()
+	if s.w.routers[s.r.name] == s.r {
+		delete(s.w.routers, s.r.name)
+	}
+	s.w.mu.Unlock()
+	s.r.close()
+}
+
+func (w *Writer) writeMessage(r *router, msg bmp.Message) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.down {
+		return
+	}
+	var err error
+	switch obj := msg.Payload.(type) {
+	case *bmp.PeerUpMessage:
+		err = w.peerUp(r, msg, obj)
+	case *bmp.PeerDownMessage:
+		err = w.peerDown(r, msg)
+	case *bmp.RouteMonitor:
+		err = w.routeMonitor(r, msg, obj)
+	}
+	if err != nil {
+		glog.Errorf("failed to write mrt record for router %s with error: %+v", msg.SpeakerIP, err)
+	}
+}
+
+// Snapshot writes a TABLE_DUMP_V2 RIB file for every known router. The RIB of
+// a router is copied under the lock of the router and written without it, the
+// messages of the router are not held up by the file write.
+func (w *Writer) Snapshot() {
+	w.mu.Lock()
+	if w.stopped {
+		w.mu.Unlock()
+		return
+	}
+	routers := make([]*router, 0, len(w.routers))
+	for _, r := range w.routers {
+		routers = append(routers, r)
+	}
+	w.mu.Unlock()
+	now := w.now()
+	for _, r := range routers {
+		r.mu.Lock()
+		if r.down {
+			r.mu.Unlock()
+			continue
+		}
+		rib := r.rib()
+		r.mu.Unlock()
+		if err := rib.write(now); err != nil {
+			glog.Errorf("failed to write mrt rib snapshot for router %s with error: %+v", r.name, err)
+		}
+	}
+}
+
+// Stop terminates snapshots and closes all open files.
+func (w *Writer) Stop() {
+	w.mu.Lock()
+	if w.stopped {
+		w.mu.Unlock()
+		return
+	}
+	w.stopped = true
+	close(w.stop)
+	for _, r := range w.routers {
+		r.close()
+	}
+	w.mu.Unlock()
+	w.wg.Wait()
+}
+
+func (w *Writer) snapshotter(interval time.Duration) {
+	defer w.wg.Done()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			w.Snapshot()
+		case <-w.stop:
+			return
+		}
+	}
+}
+
+// routerName converts the speaker IP into a name usable as a directory.
+func routerName(speakerIP string) string {
+	if speakerIP == "" {
+		return "unknown"
+	}
+	return strings.ReplaceAll(speakerIP, ":", "_")
+}
+
+// getRouter returns the router of speakerIP, creating it when the router is
+// not known. It is called with the Writer's mutex held.
+func (w *Writer) getRouter(speakerIP string) *router {
+	name := routerName(speakerIP)
+	if r, ok := w.routers[name]; ok {
+		return r
+	}
+	r := w.newRouter(name)
+	w.routers[name] = r
+
+	return r
+}
+
+// newRouter returns the router name, its directory is created with the first
+// file written for the router.
+func (w *Writer) newRouter(name string) *router {
+	return &router{
+		name:  name,
+		dir:   filepath.Join(w.dir, name),
+		peers: make(map[string]*peer),
+	}
+}
+
+// adjRIBInPre returns true when the per peer header is of the Adj-RIB-In
+// pre-policy view of the peer, the routes as the peer sent them, or of a
+// Loc-RIB peer which has no other view. The records of the other views of the
+// same peer would duplicate its updates and merge into its RIB snapshot.
+func adjRIBInPre(ph *bmp.PerPeerHeader) bool {
+	if ok, err := ph.IsAdjRIBInPre(); err == nil {
+		return ok
+	}
+	return true
+}
+
+// getPeer returns the peer identified by the per peer header, creating it
+// when the first message for the peer is not a Peer Up.
+func (r *router) getPeer(ph *bmp.PerPeerHeader) *peer {
+	key := ph.GetPeerHash()
+	if p, ok := r.peers[key]; ok {
+		return p
+	}
+	p := &peer{
+		bgp4mpPeer: bgp4mpPeer{
+			PeerAS: ph.PeerAS,
+			PeerIP: net.ParseIP(ph.GetPeerAddrString()),
+		},
+		bgpID:   net.IP(append([]byte{}, ph.PeerBGPID...)),
+		as4:     true,
+		addPath: make(map[int]bool),
+		rib:     make(map[ribKey]*ribRoute),
+	}
+	// Loc-RIB peers carry no A flag and always use 4 octet ASNs (RFC 9069)
+	if as4, err := ph.Is4ByteASN(); err == nil {
+		p.as4 = as4
+	}
+	r.peers[key] = p
+
+	return p
+}
+
+// write appends a record to the router's updates file, rotating the file
+// when the current rotation period has elapsed.
+func (w *Writer) write(r *router, rec []byte) error {
+	now := w.now()
+	from := now.Truncate(w.rotationInterval)
+	if r.updates != nil && !from.Equal(r.updatesFrom) {
+		r.closeUpdates()
+	}
+	if r.updates == nil {
+		if err := os.MkdirAll(r.dir, 0o755); err != nil {
+			return err
+		}
+		fn := filepath.Join(r.dir, "updates."+from.UTC().Format(fileTimeFormat)+".mrt")
+		f, err := os.OpenFile(fn, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+		if err != nil {
+			return err
+		}
+		r.updates = f
+		r.updatesFrom = from
+	}
+	_, err := r.updates.Write(rec)
+
+	return err
+}
+
+// close marks the router down and closes its updates file, it is called
+// without the router's mutex held.
+func (r *router) close() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.down = true
+	r.closeUpdates()
+}
+
+func (r *router) closeUpdates() {
+	if r.updates == nil {
+		return
+	}
+	if err := r.updates.Close(); err != nil {
+		glog.Errorf("failed to close mrt updates file for router %s with error: %+v", r.name, err)
+	}
+	r.updates = nil
+}
+
+// timestamp returns the time carried in the per peer header, or the current
+// time when the router did not set it.
+func (w *Writer) timestamp(ph *bmp.PerPeerHeader) time.Time {
+	if len(ph.PeerTimestamp) == 8 {
+		s := binary.BigEndian.Uint32(ph.PeerTimestamp[0:4])
+		us := binary.BigEndian.Uint32(ph.PeerTimestamp[4:8])
+		if s != 0 {
+			return time.Unix(int64(s), int64(us)*int64(time.Microsecond))
+		}
+	}
+	return w.now()
+}
+
+func (w *Writer) peerUp(r *router, msg bmp.Message, pu *bmp.PeerUpMessage) error {
+	p := r.getPeer(msg.PeerHeader)
+	// A new session replaces whatever was known about the previous one
+	p.rib = make(map[ribKey]*ribRoute)
+	p.addPath = make(map[int]bool)
+	if len(pu.LocalAddress) == net.IPv6len {
+		if msg.PeerHeader.IsRemotePeerIPv6() {
+			p.LocalIP = net.IP(append([]byte{}, pu.LocalAddress...))
+		} else {
+			p.LocalIP = net.IP(append([]byte{}, pu.LocalAddress[12:]...))
+		}
+	}
+	if pu.SentOpen != nil {
+		p.LocalAS = uint32(pu.SentOpen.MyAS)
+		if as, ok := pu.SentOpen.Is4BytesASCapable(); ok {
+			p.LocalAS = as
+		}
+		p.localID = pu.SentOpen.BGPID
+	}
+	if pu.SentOpen != nil && pu.ReceivedOpen != nil {
+		remote := pu.ReceivedOpen.AddPathCapability()
+		for k, v := range pu.SentOpen.AddPathCapability() {
+			if v && remote[k] {
+				p.addPath[k] = true
+			}
+		}
+	}
+	rec := encodeRecord(w.timestamp(msg.PeerHeader), TypeBGP4MPET, SubtypeBGP4MPStateChangeAS4,
+		encodeBGP4MPStateChange(&p.bgp4mpPeer, StateOpenConfirm, StateEstablished))
+
+	return w.write(r, rec)
+}
+
+func (w *Writer) peerDown(r *router, msg bmp.Message) error {
+	p := r.getPeer(msg.PeerHeader)
+	rec := encodeRecord(w.timestamp(msg.PeerHeader), TypeBGP4MPET, SubtypeBGP4MPStateChangeAS4,
+		encodeBGP4MPStateChange(&p.bgp4mpPeer, StateEstablished, StateIdle))
+	delete(r.peers, msg.PeerHeader.GetPeerHash())
+
+	return w.write(r, rec)
+}
+
+func (w *Writer) routeMonitor(r *router, msg bmp.Message, rm *bmp.RouteMonitor) error {
+	if rm.Update == nil || len(rm.RawMessage) == 0 || !adjRIBInPre(msg.PeerHeader) {
+		return nil
+	}
+	p := r.getPeer(msg.PeerHeader)
+	ts := w.timestamp(msg.PeerHeader)
+	st := uint16(SubtypeBGP4MPMessage)
+	if p.as4 {
+		st = SubtypeBGP4MPMessageAS4
+	}
+	if len(p.addPath) != 0 {
+		st = SubtypeBGP4MPMessageAddPath
+		if p.as4 {
+			st = SubtypeBGP4MPMessageAS4AddPath
+		}
+	}
+	if err := w.write(r, encodeRecord(ts, TypeBGP4MPET, st, encodeBGP4MPMessage(&p.bgp4mpPeer, p.as4, rm.RawMessage))); err != nil {
+		return err
+	}
+
+	return p.update(rm.Update, uint32(ts.Unix()))
+}
+
+// update applies announcements and withdrawals of u to the peer's RIB.
+func (p *peer) update(u *bgp.Update, originated uint32) error {
+	changes, err := unmarshalRIBChanges(u, p.addPath)
+	if err != nil {
+		return err
+	}
+	for _, c := range changes {
+		for _, np := range c.withdrawn {
+			delete(p.rib, ribKey{afi: c.afi, pathID: np.pathID, prefix: string(np.prefix)})
+		}
+		if len(c.announced) == 0 {
+			continue
+		}
+		attrs, err := ribAttributes(u.PathAttributes, c.nextHop, p.as4)
+		if err != nil {
+			return err
+		}
+		for _, np := range c.announced {
+			p.rib[ribKey{afi: c.afi, pathID: np.pathID, prefix: string(np.prefix)}] = &ribRoute{
+				originated: originated,
+				attrs:      attrs,
+			}
+		}
+	}
+
+	return nil
+}
+
+// ribRecordKey groups RIB entries of all peers into a single RIB record.
+type ribRecordKey struct {
+	subtype uint16
+	prefix  string
+}
+
+// ribSnapshot is a copy of the RIB of a router taken for a rib file.
+type ribSnapshot struct {
+	name        string
+	dir         string
+	collectorID net.IP
+	index       []PeerIndexEntry
+	records     map[ribRecordKey][]RIBEntry
+}
+
+// rib returns a copy of the router's RIB, it is called with the router's
+// mutex held. The attributes of the routes are shared, they are replaced and
+// never modified by the updates.
+func (r *router) rib() *ribSnapshot {
+	keys := make([]string, 0, len(r.peers))
+	for k := range r.peers {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var collectorID net.IP
+	index := make([]PeerIndexEntry, 0, len(keys))
+	records := make(map[ribRecordKey][]RIBEntry)
+	for i, k := range keys {
+		p := r.peers[k]
+		if collectorID == nil && p.localID != nil {
+			collectorID = p.localID
+		}
+		index = append(index, PeerIndexEntry{BGPID: p.bgpID, IP: p.PeerIP, AS: p.PeerAS})
+		for rk, route := range p.rib {
+			addPath := p.addPath[bgp.NLRIMessageType(rk.afi, 1)]
+			var st uint16
+			switch {
+			case rk.afi == 2 && addPath:
+				st = SubtypeRIBIPv6UnicastAddPath
+			case rk.afi == 2:
+				st = SubtypeRIBIPv6Unicast
+			case addPath:
+				st = SubtypeRIBIPv4UnicastAddPath
+			default:
+				st = SubtypeRIBIPv4Unicast
+			}
+			key := ribRecordKey{subtype: st, prefix: rk.prefix}
+			records[key] = append(records[key], RIBEntry{
+				PeerIndex:      uint16(i),
+				OriginatedTime: route.originated,
+				PathID:         rk.pathID,
+				Attributes:     route.attrs,
+			})
+		}
+	}
+
+	return &ribSnapshot{name: r.name, dir: r.dir, collectorID: collectorID, index: index, records: records}
+}
+
+// write writes the RIB into a new rib file. The file is written under a
+// temporary name and renamed once complete so readers never observe a partial
+// snapshot.
+func (s *ribSnapshot) write(now time.Time) error {
+	records := s.records
+	order := make([]ribRecordKey, 0, len(records))
+	for k := range records {
+		order = append(order, k)
+	}
+	sort.Slice(order, func(i, j int) bool {
+		if order[i].subtype != order[j].subtype {
+			return order[i].subtype < order[j].subtype
+		}
+		return order[i].prefix < order[j].prefix
+	})
+
+	var buf bytes.Buffer
+	buf.Write(encodeRecord(now, TypeTableDumpV2, SubtypePeerIndexTable, encodePeerIndexTable(s.collectorID, s.name, s.index)))
+	for seq, k := range order {
+		entries := records[k]
+		sort.Slice(entries, func(i, j int) bool {
+			if entries[i].PeerIndex != entries[j].PeerIndex {
+				return entries[i].PeerIndex < entries[j].PeerIndex
+			}
+			return entries[i].PathID < entries[j].PathID
+		})
+		addPath := k.subtype == SubtypeRIBIPv4UnicastAddPath || k.subtype == SubtypeRIBIPv6UnicastAddPath
+		buf.Write(encodeRecord(now, TypeTableDumpV2, k.subtype, encodeRIB(uint32(seq), []byte(k.prefix), entries, addPath)))
+	}
+
+	if err := os.MkdirAll(s.dir, 0o755); err != nil {
+		return err
+	}
+	fn := filepath.Join(s.dir, "rib."+now.UTC().Format(fileTimeFormat)+".mrt")
+	tmp := fn + ".tmp"
+	if err := os.WriteFile(tmp, buf.Bytes(), 0o644); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, fn)
+}
@@ -0,0 +1,320 @@
+package mrt
+
+import (
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sbezverk/gobmp/pkg/bgp"
+	"github.com/sbezverk/gobmp/pkg/bmp"
+)
+
+// testPeerHeader returns a Global Instance IPv4 peer header with 4 octet ASNs.
+func testPeerHeader(t *testing.T, ts uint32) *bmp.PerPeerHeader {
+	t.Helper()
+	b := make([]byte, bmp.PerPeerHeaderLength)
+	copy(b[22:26], []byte{192, 0, 2, 1})
+	binary.BigEndian.PutUint32(b[26:30], 65001)
+	copy(b[30:34], []byte{1, 1, 1, 1})
+	binary.BigEndian.PutUint32(b[34:38], ts)
+	ph, err := bmp.UnmarshalPerPeerHeader(b)
+	if err != nil {
+		t.Fatalf("failed to build per peer header: %v", err)
+	}
+	return ph
+}
+
+// testRouteMonitor returns a Route Monitor announcing 10.0.0.0/8 and
+// withdrawing 192.0.2.0/24.
+func testRouteMonitor(t *testing.T) *bmp.RouteMonitor {
+	t.Helper()
+	body := []byte{
+		0x00, 0x04, 24, 192, 0, 2,
+		0x00, 0x14,
+		0x40, 0x01, 0x01, 0x00,
+		0x40, 0x02, 0x06, 0x02, 0x01, 0x00, 0x00, 0xfd, 0xe9,
+		0x40, 0x03, 0x04, 192, 0, 2, 1,
+		8, 10,
+	}
+	msg := make([]byte, 19, 19+len(body))
+	for i := 0; i < 16; i++ {
+		msg[i] = 0xff
+	}
+	binary.BigEndian.PutUint16(msg[16:18], uint16(19+len(body)))
+	msg[18] = 2
+	msg = append(msg, body...)
+	rm, err := bmp.UnmarshalBMPRouteMonitorMessageWithAS4Hint(msg, true)
+	if err != nil {
+		t.Fatalf("failed to build route monitor: %v", err)
+	}
+	return rm
+}
+
+type testRecord struct {
+	ts      uint32
+	t, st   uint16
+	message []byte
+}
+
+func readRecords(t *testing.T, fn string) []testRecord {
+	t.Helper()
+	b, err := os.ReadFile(fn)
+	if err != nil {
+		t.Fatalf("failed to read %s: %v", fn, err)
+	}
+	recs := make([]testRecord, 0)
+	for p := 0; p < len(b); {
+		if p+HeaderLength > len(b) {
+			t.Fatalf("truncated record header at %d", p)
+		}
+		r := testRecord{
+			ts: binary.BigEndian.Uint32(b[p : p+4]),
+			t:  binary.BigEndian.Uint16(b[p+4 : p+6]),
+			st: binary.BigEndian.Uint16(b[p+6 : p+8]),
+		}
+		l := int(binary.BigEndian.Uint32(b[p+8 : p+12]))
+		p += HeaderLength
+		if p+l > len(b) {
+			t.Fatalf("truncated record body at %d", p)
+		}
+		r.message = b[p : p+l]
+		if r.t == TypeBGP4MPET {
+			r.message = r.message[4:]
+		}
+		recs = append(recs, r)
+		p += l
+	}
+	return recs
+}
+
+func TestWriter(t *testing.T) {
+	dir := t.TempDir()
+	w, err := NewWriter(&Config{Dir: dir, RotationInterval: time.Hour, RIBInterval: -1})
+	if err != nil {
+		t.Fatalf("failed to create writer: %v", err)
+	}
+	now := time.Date(2026, 10, 18, 12, 10, 0, 0, time.UTC)
+	w.now = func() time.Time { return now }
+
+	local := make([]byte, 16)
+	copy(local[12:], []byte{192, 0, 2, 2})
+	w.WriteMessage(bmp.Message{
+		SpeakerIP:  "10.0.0.1",
+		PeerHeader: testPeerHeader(t, 1000),
+		Payload: &bmp.PeerUpMessage{
+			LocalAddress: local,
+			SentOpen:     &bgp.OpenMessage{MyAS: 65000, BGPID: []byte{10, 0, 0, 1}},
+			ReceivedOpen: &bgp.OpenMessage{MyAS: 65001, BGPID: []byte{1, 1, 1, 1}},
+		},
+	})
+	rm := testRouteMonitor(t)
+	w.WriteMessage(bmp.Message{SpeakerIP: "10.0.0.1", PeerHeader: testPeerHeader(t, 1001), Payload: rm})
+	w.Snapshot()
+
+	// Crossing the rotation boundary starts a new updates file
+	now = now.Add(time.Hour)
+	w.WriteMessage(bmp.Message{SpeakerIP: "10.0.0.1", PeerHeader: testPeerHeader(t, 1002), Payload: &bmp.PeerDownMessage{}})
+	w.Stop()
+
+	recs := readRecords(t, filepath.Join(dir, "10.0.0.1", "updates.20261018.1200.mrt"))
+	if len(recs) != 2 {
+		t.Fatalf("expected 2 update records got %d", len(recs))
+	}
+	if recs[0].t != TypeBGP4MPET || recs[0].st != SubtypeBGP4MPStateChangeAS4 || recs[0].ts != 1000 {
+		t.Fatalf("unexpected peer up record %+v", recs[0])
+	}
+	if s := recs[0].message[len(recs[0].message)-4:]; binary.BigEndian.Uint16(s[2:]) != StateEstablished {
+		t.Fatalf("unexpected peer up state %x", s)
+	}
+	if binary.BigEndian.Uint32(recs[0].message[4:8]) != 65000 {
+		t.Fatalf("unexpected local as %x", recs[0].message[4:8])
+	}
+	if recs[1].st != SubtypeBGP4MPMessageAS4 || string(recs[1].message[20:]) != string(rm.RawMessage) {
+		t.Fatalf("unexpected route monitor record %+v", recs[1])
+	}
+	recs = readRecords(t, filepath.Join(dir, "10.0.0.1", "updates.20261018.1300.mrt"))
+	if len(recs) != 1 || binary.BigEndian.Uint16(recs[0].message[22:24]) != StateIdle {
+		t.Fatalf("unexpected peer down records %+v", recs)
+	}
+
+	recs = readRecords(t, filepath.Join(dir, "10.0.0.1", "rib.20261018.1210.mrt"))
+	if len(recs) != 2 {
+		t.Fatalf("expected 2 rib records got %d", len(recs))
+	}
+	if recs[0].st != SubtypePeerIndexTable {
+		t.Fatalf("expected peer index table got subtype %d", recs[0].st)
+	}
+	if got := recs[0].message[0:4]; string(got) != string([]byte{10, 0, 0, 1}) {
+		t.Fatalf("unexpected collector id %v", got)
+	}
+	if recs[1].st != SubtypeRIBIPv4Unicast {
+		t.Fatalf("expected RIB_IPV4_UNICAST got subtype %d", recs[1].st)
+	}
+	m := recs[1].message
+	if m[4] != 8 || m[5] != 10 || binary.BigEndian.Uint16(m[6:8]) != 1 {
+		t.Fatalf("unexpected rib record %x", m)
+	}
+	if binary.BigEndian.Uint32(m[10:14]) != 1001 {
+		t.Fatalf("unexpected originated time %x", m[10:14])
+	}
+}
+
+func TestWriter_RIBViews(t *testing.T) {
+	dir := t.TempDir()
+	w, err := NewWriter(&Config{Dir: dir, RotationInterval: time.Hour, RIBInterval: -1})
+	if err != nil {
+		t.Fatalf("failed to create writer: %v", err)
+	}
+	now := time.Date(2026, 10, 18, 12, 10, 0, 0, time.UTC)
+	w.now = func() time.Time { return now }
+	rm := testRouteMonitor(t)
+	// Adj-RIB-In pre-policy, post-policy (L), Adj-RIB-Out pre-policy (O) and
+	// post-policy (O and L) views of the same peer
+	for _, flags := range []byte{0x00, 0x40, 0x10, 0x50} {
+		b := make([]byte, bmp.PerPeerHeaderLength)
+		b[1] = flags
+		copy(b[22:26], []byte{192, 0, 2, 1})
+		binary.BigEndian.PutUint32(b[26:30], 65001)
+		copy(b[30:34], []byte{1, 1, 1, 1})
+		binary.BigEndian.PutUint32(b[34:38], 1000)
+		ph, err := bmp.UnmarshalPerPeerHeader(b)
+		if err != nil {
+			t.Fatalf("failed to build per peer header: %v", err)
+		}
+		w.WriteMessage(bmp.Message{SpeakerIP: "10.0.0.1", PeerHeader: ph, Payload: rm})
+	}
+	r := w.routers["10.0.0.1"]
+	if r == nil || len(r.peers) != 1 {
+		t.Fatalf("expected a single peer got %+v", r)
+	}
+	w.Stop()
+	recs := readRecords(t, filepath.Join(dir, "10.0.0.1", "updates.20261018.1200.mrt"))
+	if len(recs) != 1 {
+		t.Fatalf("expected the route monitor of the Adj-RIB-In pre-policy view only, got %d records", len(recs))
+	}
+}
+
+func TestWriter_2OctetASNAndWithdraw(t *testing.T) {
+	dir := t.TempDir()
+	w, err := NewWriter(&Config{Dir: dir, RIBInterval: -1})
+	if err != nil {
+		t.Fatalf("failed to create writer: %v", err)
+	}
+	defer w.Stop()
+	b := make([]byte, bmp.PerPeerHeaderLength)
+	// A flag set: legacy 2 octet AS_PATH
+	b[1] = 0x20
+	copy(b[22:26], []byte{192, 0, 2, 1})
+	ph, err := bmp.UnmarshalPerPeerHeader(b)
+	if err != nil {
+		t.Fatalf("failed to build per peer header: %v", err)
+	}
+	msg := []byte{
+		0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+		0x00, 0x26, 0x02,
+		0x00, 0x00,
+		0x00, 0x0b,
+		0x40, 0x01, 0x01, 0x00,
+		0x40, 0x02, 0x04, 0x02, 0x01, 0xfd, 0xe9,
+		8, 10,
+	}
+	msg[17] = byte(len(msg))
+	rm, err := bmp.UnmarshalBMPRouteMonitorMessageWithAS4Hint(msg, false)
+	if err != nil {
+		t.Fatalf("failed to build route monitor: %v", err)
+	}
+	session := w.RouterUp("2001:db8::1")
+	session.WriteMessage(bmp.Message{SpeakerIP: "2001:db8::1", PeerHeader: ph, Payload: rm})
+	r := w.routers["2001_db8__1"]
+	if r == nil {
+		t.Fatalf("router was not created")
+	}
+	var p *peer
+	for _, v := range r.peers {
+		p = v
+	}
+	if p == nil || p.as4 || len(p.rib) != 1 {
+		t.Fatalf("unexpected peer state %+v", p)
+	}
+	for _, route := range p.rib {
+		expect := []byte{0x40, 0x01, 0x01, 0x00, 0x40, 0x02, 0x06, 0x02, 0x01, 0x00, 0x00, 0xfd, 0xe9}
+		if string(route.attrs) != string(expect) {
+			t.Fatalf("expected attributes %x got %x", expect, route.attrs)
+		}
+	}
+	withdraw := []byte{
+		0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+		0x00, 0x19, 0x02,
+		0x00, 0x02, 8, 10,
+		0x00, 0x00,
+	}
+	rm, err = bmp.UnmarshalBMPRouteMonitorMessageWithAS4Hint(withdraw, false)
+	if err != nil {
+		t.Fatalf("failed to build withdraw: %v", err)
+	}
+	session.WriteMessage(bmp.Message{SpeakerIP: "2001:db8::1", PeerHeader: ph, Payload: rm})
+	if len(p.rib) != 0 {
+		t.Fatalf("expected empty rib after withdraw got %d routes", len(p.rib))
+	}
+	session.RouterDown()
+	if len(w.routers) != 0 {
+		t.Fatalf("expected router to be removed")
+	}
+}
+
+func TestWriterSessionReconnect(t *testing.T) {
+	dir := t.TempDir()
+	w, err := NewWriter(&Config{Dir: dir, RotationInterval: time.Hour, RIBInterval: -1})
+	if err != nil {
+		t.Fatalf("failed to create writer: %v", err)
+	}
+	defer w.Stop()
+	now := time.Date(2026, 10, 18, 12, 10, 0, 0, time.UTC)
+	w.now = func() time.Time { return now }
+	old := w.RouterUp("10.0.0.1")
+	old.WriteMessage(bmp.Message{SpeakerIP: "10.0.0.1", PeerHeader: testPeerHeader(t, 1001), Payload: testRouteMonitor(t)})
+	// The router reconnects before the end of its previous session is noticed
+	session := w.RouterUp("10.0.0.1")
+	old.RouterDown()
+	r := w.routers["10.0.0.1"]
+	if r == nil || r != session.r {
+		t.Fatalf("the end of the previous session released the router state of the new session")
+	}
+	if len(r.peers) != 0 {
+		t.Fatalf("the new session inherited %d peers of the previous session", len(r.peers))
+	}
+	// The messages of the previous session are no longer written
+	old.WriteMessage(bmp.Message{SpeakerIP: "10.0.0.1", PeerHeader: testPeerHeader(t, 1002), Payload: testRouteMonitor(t)})
+	session.WriteMessage(bmp.Message{SpeakerIP: "10.0.0.1", PeerHeader: testPeerHeader(t, 1003), Payload: testRouteMonitor(t)})
+	recs := readRecords(t, filepath.Join(dir, "10.0.0.1", "updates.20261018.1200.mrt"))
+	if len(recs) != 2 || recs[0].ts != 1001 || recs[1].ts != 1003 {
+		t.Fatalf("unexpected records %+v", recs)
+	}
+	w.Snapshot()
+	rib := readRecords(t, filepath.Join(dir, "10.0.0.1", "rib.20261018.1210.mrt"))
+	if len(rib) != 2 || rib[1].st != SubtypeRIBIPv4Unicast {
+		t.Fatalf("unexpected rib records %+v", rib)
+	}
+}
+
+func TestWriterConcurrentSnapshot(t *testing.T) {
+	w, err := NewWriter(&Config{Dir: t.TempDir(), RIBInterval: -1})
+	if err != nil {
+		t.Fatalf("failed to create writer: %v", err)
+	}
+	defer w.Stop()
+	session := w.RouterUp("10.0.0.1")
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 50; i++ {
+			w.Snapshot()
+		}
+	}()
+	for i := 0; i < 50; i++ {
+		session.WriteMessage(bmp.Message{SpeakerIP: "10.0.0.1", PeerHeader: testPeerHeader(t, uint32(1000+i)), Payload: testRouteMonitor(t)})
+	}
+	<-done
+}
@@ -1,12 +1,14 @@
 package nats
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"time"
 
 	"github.com/golang/glog"
 	"github.com/nats-io/nats.go"
+	"github.com/sbezverk/gobmp/pkg/api"
 	"github.com/sbezverk/gobmp/pkg/bmp"
 	"github.com/sbezverk/gobmp/pkg/pub"
 )
@@ -33,8 +35,19 @@ const (
 	flowspecMessageV6Topic = "gobmp.parsed.flowspec_v6"
 	vplsMessageTopic       = "gobmp.parsed.vpls"
 	statsMessageTopic      = "gobmp.parsed.statistics"
+	lsTopologyChangeTopic  = "gobmp.parsed.ls_topology_change"
+	srPolicyResolvedTopic  = "gobmp.parsed.sr_policy_resolved"
+	churnStatsTopic        = "gobmp.parsed.churn_stats"
+	flapEventTopic         = "gobmp.parsed.flap_event"
+	hijackEventTopic       = "gobmp.parsed.hijack_event"
+	routeLeakTopic         = "gobmp.parsed.route_leak"
+	peerSyncTopic          = "gobmp.parsed.peer_sync"
+	baseAttributeTopic     = "gobmp.parsed.base_attribute"
 	rawMessageTopic        = "gobmp.raw"
 	parsedWildcardSubject  = "gobmp.parsed.*"
+	// subtopicWildcardSubject matches the sub-topics of the parsed topics, such
+	// as the per-VRF L3VPN subjects gobmp.parsed.l3vpn.<vrf>.
+	subtopicWildcardSubject = "gobmp.parsed.*.*"
 )
 
 var (
@@ -53,8 +66,9 @@ type jetStreamClient interface {
 }
 
 type publisher struct {
-	nc *nats.Conn
-	js jetStreamClient
+	nc       *nats.Conn
+	js       jetStreamClient
+	encoding pub.Encoding
 }
 
 // topicForMessage maps a BMP message type to its NATS subject.
@@ -101,6 +115,22 @@ func topicForMessage(t int) (string, bool) {
 		return vplsMessageTopic, true
 	case bmp.StatsReportMsg:
 		return statsMessageTopic, true
+	case bmp.LSTopologyChangeMsg:
+		return lsTopologyChangeTopic, true
+	case bmp.SRPolicyResolvedMsg:
+		return srPolicyResolvedTopic, true
+	case bmp.ChurnStatsMsg:
+		return churnStatsTopic, true
+	case bmp.FlapEventMsg:
+		return flapEventTopic, true
+	case bmp.HijackEventMsg:
+		return hijackEventTopic, true
+	case bmp.RouteLeakMsg:
+		return routeLeakTopic, true
+	case bmp.PeerSyncMsg:
+		return peerSyncTopic, true
+	case bmp.BaseAttributeMsg:
+		return baseAttributeTopic, true
 	case bmp.BMPRawMsg:
 		return rawMessageTopic, true
 	}
@@ -112,13 +142,58 @@ func (p *publisher) PublishMessage(t int, key []byte, msg []byte) error {
 	if !ok {
 		return fmt.Errorf("nats publisher: unsupported BMP message type %d", t)
 	}
-	return p.produceMessage(topic, key, msg)
+	return p.produceMessage(topic, t, key, msg)
 }
 
-func (p *publisher) produceMessage(subject string, key []byte, data []byte) error {
+// PublishMessageToSubtopic publishes a message to a sub-topic of the message
+// type's subject, such as a per-VRF L3VPN subject.
+func (p *publisher) PublishMessageToSubtopic(t int, subtopic string, key []byte, msg []byte) error {
+	topic, ok := topicForMessage(t)
+	if !ok {
+		return fmt.Errorf("nats publisher: unsupported BMP message type %d", t)
+	}
+	return p.produceMessage(pub.SubtopicName(topic, subtopic), t, key, msg)
+}
+
+// PublishStruct publishes a parsed message to its subject or to a sub-topic of
+// it, the protobuf encoding is built from the message struct.
+func (p *publisher) PublishStruct(t int, subtopic string, key []byte, msg interface{}) error {
+	subject, ok := topicForMessage(t)
+	if !ok {
+		return fmt.Errorf("nats publisher: unsupported BMP message type %d", t)
+	}
+	if subtopic != "" {
+		subject = pub.SubtopicName(subject, subtopic)
+	}
+	var data []byte
+	var err error
+	if p.encoding == pub.EncodingProtobuf {
+		data, err = api.Marshal(t, msg)
+	} else {
+		data, err = json.Marshal(msg)
+	}
+	if err != nil {
+		return err
+	}
+	return p.publish(subject, t, key, data)
+}
+
+func (p *publisher) produceMessage(subject string, t int, key []byte, data []byte) error {
+	data, err := api.Encode(p.encoding, t, data)
+	if err != nil {
+		return err
+	}
+	return p.publish(subject, t, key, data)
+}
+
+// publish publishes the message data already in the encoding of the publisher.
+func (p *publisher) publish(subject string, t int, key []byte, data []byte) error {
 	// use the header to pass the hash key
 	header := nats.Header{}
 	header.Set("Hash", string(key))
+	if t != bmp.BMPRawMsg {
+		header.Set("Content-Type", p.encoding.ContentType())
+	}
 
 	msg := &nats.Msg{
 		Subject: subject,
@@ -126,8 +201,7 @@ func (p *publisher) produceMessage(subject string, key []byte, data []byte) erro
 		Data:    data,
 	}
 
-	_, err := p.js.PublishMsg(msg)
-	if err != nil {
+	if _, err := p.js.PublishMsg(msg); err != nil {
 		return err
 	}
 
@@ -162,7 +236,7 @@ func (p *publisher) createStreams() error {
 	// Define the stream configuration
 	streamConfig := &nats.StreamConfig{
 		Name:      "goBMP",
-		Subjects:  []string{parsedWildcardSubject, rawMessageTopic},
+		Subjects:  []string{parsedWildcardSubject, subtopicWildcardSubject, rawMessageTopic},
 		Storage:   nats.FileStorage,
 		Retention: nats.InterestPolicy,
 		MaxMsgs:   -1, // No limit
@@ -192,8 +266,9 @@ func (p *publisher) createStreams() error {
 	return nil
 }
 
-// NewPublisher instantiates a new instance of a NATS publisher
-func NewPublisher(natsSrv string) (pub.Publisher, error) {
+// NewPublisher instantiates a new instance of a NATS publisher, the parsed
+// messages are published in the encoding enc.
+func NewPublisher(natsSrv string, enc pub.Encoding) (pub.Publisher, error) {
 	glog.Infof("Initializing NATS producer client")
 
 	opts := []nats.Option{
@@ -215,8 +290,9 @@ func NewPublisher(natsSrv string) (pub.Publisher, error) {
 	}
 
 	p := &publisher{
-		nc: nc,
-		js: js,
+		nc:       nc,
+		js:       js,
+		encoding: enc,
 	}
 
 	// Create the streams
@@ -6,7 +6,10 @@ import (
 	"testing"
 
 	natsgo "github.com/nats-io/nats.go"
+	"github.com/sbezverk/gobmp/pkg/api"
 	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/pub"
+	"google.golang.org/protobuf/proto"
 )
 
 // fakeJetStream is a minimal jetStreamClient used in unit tests.
@@ -14,9 +17,13 @@ type fakeJetStream struct {
 	addStreamFn    func(*natsgo.StreamConfig, ...natsgo.JSOpt) (*natsgo.StreamInfo, error)
 	streamInfoFn   func(string, ...natsgo.JSOpt) (*natsgo.StreamInfo, error)
 	updateStreamFn func(*natsgo.StreamConfig, ...natsgo.JSOpt) (*natsgo.StreamInfo, error)
+	subjects       []string
+	msgs           []*natsgo.Msg
 }
 
 func (f *fakeJetStream) PublishMsg(m *natsgo.Msg, _ ...natsgo.PubOpt) (*natsgo.PubAck, error) {
+	f.subjects = append(f.subjects, m.Subject)
+	f.msgs = append(f.msgs, m)
 	return nil, nil
 }
 func (f *fakeJetStream) AddStream(cfg *natsgo.StreamConfig, opts ...natsgo.JSOpt) (*natsgo.StreamInfo, error) {
@@ -55,6 +62,14 @@ func TestTopicForMessage(t *testing.T) {
 		{bmp.FlowspecV6Msg, flowspecMessageV6Topic, true},
 		{bmp.VPLSMsg, vplsMessageTopic, true},
 		{bmp.StatsReportMsg, statsMessageTopic, true},
+		{bmp.LSTopologyChangeMsg, lsTopologyChangeTopic, true},
+		{bmp.SRPolicyResolvedMsg, srPolicyResolvedTopic, true},
+		{bmp.ChurnStatsMsg, churnStatsTopic, true},
+		{bmp.FlapEventMsg, flapEventTopic, true},
+		{bmp.HijackEventMsg, hijackEventTopic, true},
+		{bmp.RouteLeakMsg, routeLeakTopic, true},
+		{bmp.PeerSyncMsg, peerSyncTopic, true},
+		{bmp.BaseAttributeMsg, baseAttributeTopic, true},
 		{bmp.BMPRawMsg, rawMessageTopic, true},
 		{9999, "", false},
 	}
@@ -179,7 +194,7 @@ func TestCreateStreams(t *testing.T) {
 		{
 			name:          "already exists — all subjects present — no update",
 			addStreamErr:  natsgo.ErrStreamNameAlreadyInUse,
-			existingSubjs: []string{parsedWildcardSubject, rawMessageTopic},
+			existingSubjs: []string{parsedWildcardSubject, subtopicWildcardSubject, rawMessageTopic},
 			wantErr:       false,
 		},
 		{
@@ -252,3 +267,58 @@ func TestRawTopicNotUnderParsedWildcard(t *testing.T) {
 		t.Errorf("BMPRawMsg must map to %q, got %q ok=%v", wantRawTopic, topic, ok)
 	}
 }
+
+func TestPublishMessageToSubtopic(t *testing.T) {
+	fake := &fakeJetStream{}
+	p := &publisher{js: fake}
+	if err := p.PublishMessageToSubtopic(bmp.L3VPNV4Msg, "CUST A.1", []byte("hash"), []byte("{}")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := p.PublishMessageToSubtopic(9999, "CUST-A", nil, nil); err == nil {
+		t.Error("expected error for unknown message type")
+	}
+	want := "gobmp.parsed.l3vpn_v4.CUST_A_1"
+	if len(fake.subjects) != 1 || fake.subjects[0] != want {
+		t.Errorf("published subjects = %v, want [%s]", fake.subjects, want)
+	}
+}
+
+func TestPublishMessageEncoding(t *testing.T) {
+	msg := []byte(`{"action":"add","prefix":"192.0.2.0","prefix_len":24,"is_ipv4":true}`)
+	tests := []struct {
+		name            string
+		encoding        pub.Encoding
+		msgType         int
+		wantContentType string
+	}{
+		{name: "json", encoding: pub.EncodingJSON, msgType: bmp.UnicastPrefixV4Msg, wantContentType: "application/json"},
+		{name: "protobuf", encoding: pub.EncodingProtobuf, msgType: bmp.UnicastPrefixV4Msg, wantContentType: "application/x-protobuf"},
+		{name: "raw", encoding: pub.EncodingProtobuf, msgType: bmp.BMPRawMsg},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeJetStream{}
+			p := &publisher{js: fake, encoding: tt.encoding}
+			if err := p.PublishMessage(tt.msgType, []byte("hash"), msg); err != nil {
+				t.Fatalf("PublishMessage() error: %v", err)
+			}
+			m := fake.msgs[0]
+			if got := m.Header.Get("Content-Type"); got != tt.wantContentType {
+				t.Errorf("Content-Type = %q, want %q", got, tt.wantContentType)
+			}
+			if tt.encoding != pub.EncodingProtobuf || tt.msgType == bmp.BMPRawMsg {
+				if string(m.Data) != string(msg) {
+					t.Errorf("data = %s, want %s", m.Data, msg)
+				}
+				return
+			}
+			u := &api.UnicastPrefix{}
+			if err := proto.Unmarshal(m.Data, u); err != nil {
+				t.Fatalf("failed to decode the protobuf message: %v", err)
+			}
+			if u.GetPrefix() != "192.0.2.0" || u.GetPrefixLen() != 24 {
+				t.Errorf("unicast prefix = %v", u)
+			}
+		})
+	}
+}
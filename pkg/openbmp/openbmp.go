@@ -0,0 +1,133 @@
+// Package openbmp renders the parsed messages in the OpenBMP v1.7 message bus
+// format, a text header followed by tab separated records, published on the
+// openbmp.parsed topics read by the consumers of the OpenBMP collector such as
+// psql-app.
+package openbmp
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// version is the version of the OpenBMP message bus API of the messages
+const version = "1.7"
+
+// timestampLayout is the layout of the OpenBMP timestamps, in UTC
+const timestampLayout = "2006-01-02 15:04:05.000000"
+
+// newMessage returns the OpenBMP message of type msgType carrying records, the
+// header gives the length of the records data and their number.
+func newMessage(collectorHash, msgType string, records ...[]string) []byte {
+	var data bytes.Buffer
+	for _, r := range records {
+		for i, f := range r {
+			if i != 0 {
+				data.WriteByte('\t')
+			}
+			data.WriteString(sanitize(f))
+		}
+		data.WriteByte('\n')
+	}
+	b := fmt.Appendf(nil, "V: %s\nC_HASH_ID: %s\nT: %s\nL: %d\nR: %d\n\n", version, collectorHash, msgType, data.Len(), len(records))
+
+	return append(b, data.Bytes()...)
+}
+
+// sanitize replaces the record and field separators found in a value by spaces.
+func sanitize(s string) string {
+	if !strings.ContainsAny(s, "\t\n\r") {
+		return s
+	}
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '\t', '\n', '\r':
+			return ' '
+		}
+		return r
+	}, s)
+}
+
+// timestamp returns the OpenBMP timestamp of an RFC 3339 timestamp, the value
+// is returned as it is when it is not an RFC 3339 timestamp.
+func timestamp(ts string) string {
+	t, err := time.Parse(time.RFC3339Nano, ts)
+	if err != nil {
+		return ts
+	}
+	return t.UTC().Format(timestampLayout)
+}
+
+func boolField(b bool) string {
+	if b {
+		return "1"
+	}
+	return "0"
+}
+
+func uintField[T uint8 | uint16 | uint32 | uint64](v T) string {
+	return strconv.FormatUint(uint64(v), 10)
+}
+
+func intField[T int | int32 | int64](v T) string {
+	return strconv.FormatInt(int64(v), 10)
+}
+
+// listField returns the values of a list separated by sep.
+func listField[T uint32 | uint64](l []T, sep string) string {
+	s := make([]string, len(l))
+	for i, v := range l {
+		s[i] = uintField(v)
+	}
+	return strings.Join(s, sep)
+}
+
+// jsonField returns the JSON text of the values without an OpenBMP text
+// representation, empty when the value is absent.
+func jsonField(v json.RawMessage) string {
+	if len(v) == 0 || string(v) == "null" {
+		return ""
+	}
+	return string(v)
+}
+
+// aggregator returns the AS number and the address of the aggregator attribute
+// separated by a space, the AS number is 2 or 4 bytes long.
+func aggregator(b []byte) string {
+	switch len(b) {
+	case 6:
+		return uintField(binary.BigEndian.Uint16(b)) + " " + net.IP(b[2:]).String()
+	case 8:
+		return uintField(binary.BigEndian.Uint32(b)) + " " + net.IP(b[4:]).String()
+	}
+	return ""
+}
+
+// flags returns the letters of the flags set in the JSON flags object f, the
+// flags are encoded as "x_flag" keys.
+func flags(f map[string]bool) string {
+	var s []string
+	for k, v := range f {
+		if v {
+			s = append(s, strings.ToUpper(strings.TrimSuffix(k, "_flag")))
+		}
+	}
+	sort.Strings(s)
+	return strings.Join(s, "")
+}
+
+// ospfRouteTypes are the names of the OSPF route types of the LS prefixes
+var ospfRouteTypes = map[uint8]string{
+	1: "Intra",
+	2: "Inter",
+	3: "Ext-1",
+	4: "Ext-2",
+	5: "NSSA-1",
+	6: "NSSA-2",
+}
@@ -0,0 +1,202 @@
+package openbmp
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNewMessage(t *testing.T) {
+	got := string(newMessage("c1", "peer", []string{"up", "1", "a\tb"}, []string{"down", "2", "c\nd"}))
+	want := "V: 1.7\nC_HASH_ID: c1\nT: peer\nL: 20\nR: 2\n\n" +
+		"up\t1\ta b\n" +
+		"down\t2\tc d\n"
+	if got != want {
+		t.Errorf("newMessage() =\n%q\nwant\n%q", got, want)
+	}
+}
+
+func TestTimestamp(t *testing.T) {
+	tests := []struct {
+		ts   string
+		want string
+	}{
+		{ts: "2026-10-18T10:20:30.123456Z", want: "2026-10-18 10:20:30.123456"},
+		{ts: "2026-10-18T12:20:30+02:00", want: "2026-10-18 10:20:30.000000"},
+		{ts: "", want: ""},
+		{ts: "now", want: "now"},
+	}
+	for _, tt := range tests {
+		if got := timestamp(tt.ts); got != tt.want {
+			t.Errorf("timestamp(%q) = %q, want %q", tt.ts, got, tt.want)
+		}
+	}
+}
+
+func TestAggregator(t *testing.T) {
+	tests := []struct {
+		b    []byte
+		want string
+	}{
+		{b: []byte{0xfd, 0xe8, 192, 0, 2, 1}, want: "65000 192.0.2.1"},
+		{b: []byte{0x00, 0x01, 0x00, 0x00, 192, 0, 2, 1}, want: "65536 192.0.2.1"},
+		{b: []byte{1, 2, 3}, want: ""},
+	}
+	for _, tt := range tests {
+		if got := aggregator(tt.b); got != tt.want {
+			t.Errorf("aggregator(% x) = %q, want %q", tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestRecords(t *testing.T) {
+	p := &prefix{
+		route: route{
+			rib:        rib{IsAdjRIBInPost: true},
+			Action:     "add",
+			Hash:       "h",
+			RouterHash: "rh",
+			RouterIP:   "10.0.0.1",
+			PeerHash:   "ph",
+			PeerIP:     "192.0.2.1",
+			PeerASN:    65001,
+			Timestamp:  "2026-10-18T10:20:30Z",
+		},
+		BaseAttributes: &baseAttributes{
+			BaseAttrHash:    "bh",
+			Origin:          "igp",
+			ASPath:          []uint32{65001, 65002},
+			ASPathCount:     2,
+			Nexthop:         "192.0.2.1",
+			LocalPref:       100,
+			CommunityList:   []string{"65001:1", "65001:2"},
+			LgCommunityList: []string{"65001:1:1"},
+		},
+		Prefix:        "198.51.100.0",
+		PrefixLen:     24,
+		IsIPv4:        true,
+		OriginAS:      65002,
+		IsNexthopIPv4: true,
+		Labels:        []uint32{16, 17},
+		VPNRD:         "65001:10",
+	}
+	tests := []struct {
+		name   string
+		record []string
+		want   string
+	}{
+		{
+			name:   "base_attribute",
+			record: p.baseAttributeRecord("1"),
+			want: "add|1|bh|rh|10.0.0.1|ph|192.0.2.1|65001|2026-10-18 10:20:30.000000|igp|65001 65002|2|65002|" +
+				"192.0.2.1|0|100||65001:1 65001:2|||0|1||65001:1:1",
+		},
+		{
+			name:   "unicast_prefix",
+			record: p.unicastPrefixRecord("2"),
+			want: "add|2|h|rh|10.0.0.1|bh|ph|192.0.2.1|65001|2026-10-18 10:20:30.000000|198.51.100.0|24|1|igp|" +
+				"65001 65002|2|65002|192.0.2.1|0|100||65001:1 65001:2|||0|1||0|16,17|0|1|65001:1:1",
+		},
+		{
+			name:   "l3vpn",
+			record: p.l3vpnRecord("3"),
+			want: "add|3|h|rh|10.0.0.1|bh|ph|192.0.2.1|65001|2026-10-18 10:20:30.000000|198.51.100.0|24|1|igp|" +
+				"65001 65002|2|65002|192.0.2.1|0|100||65001:1 65001:2|||0|1||0|16,17|0|1|65001:1:1|65001:10|0",
+		},
+		{
+			name: "peer",
+			record: (&peer{
+				rib:        rib{IsLocRIB: true},
+				Action:     "add",
+				RouterHash: "rh",
+				PeerHash:   "ph",
+				RemoteASN:  65001,
+				RemoteIP:   "192.0.2.1",
+				InfoData:   []byte{0x01, 0x02},
+				AdvCapabilities: map[uint8][]*capability{
+					65: {{Description: "4-octet AS"}},
+					1:  {{Description: "MP IPv4"}, {Description: "MP IPv6"}},
+				},
+				IsIPv4:    true,
+				TableName: "vrf1",
+			}).record("4"),
+			want: "up|4|ph|rh|||||65001|192.0.2.1||0|0||0||0102|MP IPv4, MP IPv6, 4-octet AS||0|0|0|0|0||0|0|1|1|0|vrf1",
+		},
+		{
+			name: "ls_node",
+			record: (&lsNode{
+				ls: ls{
+					route:       route{Action: "add", RouterHash: "rh", PeerHash: "ph"},
+					IGPRouterID: "0000.0000.0001",
+					Protocol:    "IS-IS Level 2",
+					AreaID:      "49.0001",
+				},
+				MTID:      []*multiTopologyID{{MTID: 0}, {MTID: 2}},
+				NodeFlags: map[string]bool{"o_flag": false, "t_flag": true, "e_flag": true},
+				Name:      "r1",
+			}).record("5"),
+			want: "add|5|||rh||ph||0||0000.0000.0001||0|0|0,2||49.0001|IS-IS Level 2|ET||0|0||r1|1|1|",
+		},
+		{
+			name: "ls_link",
+			record: (&lsLink{
+				ls:            ls{route: route{Action: "del"}, Protocol: "OSPFv2", AreaID: "0.0.0.0"},
+				MTID:          &multiTopologyID{MTID: 2},
+				LocalLinkIP:   "10.1.1.1",
+				IGPMetric:     10,
+				MaxLinkBWKbps: 1000000,
+				UnResvBWKbps:  []uint64{1, 2},
+			}).record("6"),
+			want: "del|6|||||||0||||0|0|0.0.0.0||OSPFv2||0|0||2|0|0|10.1.1.1||10|0|1000000|0|1,2|0|0|0|||||||0|0||1|1|",
+		},
+		{
+			name: "ls_prefix",
+			record: (&lsPrefix{
+				ls:             ls{route: route{Action: "add"}, Protocol: "OSPFv2"},
+				OSPFRouteType:  1,
+				IGPFlags:       map[string]bool{"n_flag": true},
+				PrefixMetric:   20,
+				Prefix:         "10.0.0.0",
+				PrefixLen:      8,
+				PrefixAttrTLVs: []byte(`{"ls_prefix_sid":[{"sid":100}]}`),
+			}).record("7"),
+			want: "add|7|||||||0||||0|0|||OSPFv2||0|0||||Intra|N||||20|10.0.0.0|8|1|1|{\"ls_prefix_sid\":[{\"sid\":100}]}",
+		},
+		{
+			name: "bmp_stat",
+			record: (&stats{
+				RouterHash:       "rh",
+				PeerHash:         "ph",
+				RemoteIP:         "192.0.2.1",
+				DuplicatePrefixs: 3,
+				AdjRIBsIn:        100,
+				LocalRib:         90,
+			}).record("8"),
+			want: "add|8|rh||ph|192.0.2.1|0||0|3|0|0|0|0|0|100|90",
+		},
+		{
+			name:   "router",
+			record: routerRecord("9", "rh", "10.0.0.1", "2026-10-18 10:20:30.000000"),
+			want:   "init|9|10.0.0.1|rh|10.0.0.1||0||||2026-10-18 10:20:30.000000|",
+		},
+		{
+			name:   "collector",
+			record: collectorRecord("change", "10", "admin", "ch", []string{"10.0.0.1", "10.0.0.2"}, "ts"),
+			want:   "change|10|admin|ch|10.0.0.1,10.0.0.2|2|ts",
+		},
+	}
+	// The number of columns of the OpenBMP v1.7 records
+	columns := map[string]int{
+		"base_attribute": 24, "unicast_prefix": 32, "l3vpn": 34, "peer": 31, "ls_node": 27,
+		"ls_link": 46, "ls_prefix": 34, "bmp_stat": 17, "router": 12, "collector": 7,
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if len(tt.record) != columns[tt.name] {
+				t.Errorf("record has %d columns, want %d", len(tt.record), columns[tt.name])
+			}
+			if got := strings.Join(tt.record, "|"); got != tt.want {
+				t.Errorf("record =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,273 @@
+package openbmp
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/pub"
+)
+
+// heartbeatInterval is the period of the collector heartbeat messages
+var heartbeatInterval = 4 * time.Hour
+
+// typeNames are the OpenBMP message types, the T header of the messages
+var typeNames = map[int]string{
+	bmp.OpenBMPCollectorMsg:     "collector",
+	bmp.OpenBMPRouterMsg:        "router",
+	bmp.OpenBMPPeerMsg:          "peer",
+	bmp.OpenBMPBaseAttributeMsg: "base_attribute",
+	bmp.OpenBMPUnicastPrefixMsg: "unicast_prefix",
+	bmp.OpenBMPL3VPNMsg:         "l3vpn",
+	bmp.OpenBMPLSNodeMsg:        "ls_node",
+	bmp.OpenBMPLSLinkMsg:        "ls_link",
+	bmp.OpenBMPLSPrefixMsg:      "ls_prefix",
+	bmp.OpenBMPStatMsg:          "bmp_stat",
+}
+
+// message is an OpenBMP message and the key it is published with
+type message struct {
+	msgType int
+	key     string
+	data    []byte
+}
+
+type publisher struct {
+	p             pub.Publisher
+	adminID       string
+	collectorHash string
+	mu            sync.Mutex
+	// sequence holds the sequence number of the last record of each type
+	sequence map[int]uint64
+	// routers holds the routers seen, routerIPs their addresses in the order
+	// they were seen
+	routers   map[string]bool
+	routerIPs []string
+	// baseAttrs holds the hash of the last base attributes published per peer
+	baseAttrs map[string]string
+	stop      chan struct{}
+	done      chan struct{}
+}
+
+// NewPublisher returns a Publisher publishing the peer, unicast and L3VPN
+// prefix, BGP-LS and statistics messages to p as OpenBMP parsed messages of
+// the collector adminID, the other messages are published to p as they are.
+//
+// The base attributes are published when they change for a peer, a router
+// message is published on the first message of a router and the collector
+// messages when the publisher starts and stops, when a new router is seen and
+// every 4 hours.
+func NewPublisher(p pub.Publisher, adminID string) pub.Publisher {
+	h := md5.Sum([]byte(adminID))
+	o := &publisher{
+		p:             p,
+		adminID:       adminID,
+		collectorHash: hex.EncodeToString(h[:]),
+		sequence:      make(map[int]uint64),
+		routers:       make(map[string]bool),
+		baseAttrs:     make(map[string]string),
+		stop:          make(chan struct{}),
+		done:          make(chan struct{}),
+	}
+	o.publishCollector("started")
+	go o.heartbeat(heartbeatInterval)
+
+	return o
+}
+
+func (o *publisher) PublishMessage(t int, key []byte, msg []byte) error {
+	msgs, ok, err := o.render(t, msg)
+	if !ok {
+		return o.p.PublishMessage(t, key, msg)
+	}
+	if err != nil {
+		return err
+	}
+	return o.publish(msgs)
+}
+
+// PublishMessageToSubtopic publishes the sub-topic messages of the types not
+// rendered as OpenBMP messages, the rendered messages are only published to
+// the OpenBMP topics.
+func (o *publisher) PublishMessageToSubtopic(t int, subtopic string, key []byte, msg []byte) error {
+	if rendered(t) {
+		return nil
+	}
+	sp, ok := o.p.(pub.SubtopicPublisher)
+	if !ok {
+		return nil
+	}
+	return sp.PublishMessageToSubtopic(t, subtopic, key, msg)
+}
+
+// Stop publishes the collector stopped message and stops p.
+func (o *publisher) Stop() {
+	close(o.stop)
+	<-o.done
+	o.publishCollector("stopped")
+	o.p.Stop()
+}
+
+func (o *publisher) heartbeat(interval time.Duration) {
+	defer close(o.done)
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-t.C:
+			o.publishCollector("heartbeat")
+		case <-o.stop:
+			return
+		}
+	}
+}
+
+func (o *publisher) publishCollector(action string) {
+	o.mu.Lock()
+	m := o.collector(action)
+	o.mu.Unlock()
+	if err := o.publish([]message{m}); err != nil {
+		glog.Errorf("failed to publish the OpenBMP collector %s message with error: %+v", action, err)
+	}
+}
+
+func (o *publisher) publish(msgs []message) error {
+	for _, m := range msgs {
+		if err := o.p.PublishMessage(m.msgType, []byte(m.key), m.data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// rendered returns true for the message types published as OpenBMP messages.
+func rendered(t int) bool {
+	switch t {
+	case bmp.PeerStateChangeMsg, bmp.StatsReportMsg,
+		bmp.UnicastPrefixMsg, bmp.UnicastPrefixV4Msg, bmp.UnicastPrefixV6Msg,
+		bmp.L3VPNMsg, bmp.L3VPNV4Msg, bmp.L3VPNV6Msg,
+		bmp.LSNodeMsg, bmp.LSLinkMsg, bmp.LSPrefixMsg:
+		return true
+	}
+	return false
+}
+
+// render returns the OpenBMP messages of the message msg of type t, false
+// when the type is not rendered as OpenBMP messages.
+func (o *publisher) render(t int, msg []byte) ([]message, bool, error) {
+	if !rendered(t) {
+		return nil, false, nil
+	}
+	var routerHash, routerIP, ts string
+	var add func(msgs []message) []message
+	switch t {
+	case bmp.PeerStateChangeMsg:
+		m := &peer{}
+		if err := json.Unmarshal(msg, m); err != nil {
+			return nil, true, fmt.Errorf("failed to decode a peer message with error: %w", err)
+		}
+		routerHash, routerIP, ts = m.RouterHash, m.RouterIP, m.Timestamp
+		add = func(msgs []message) []message {
+			if !m.up() {
+				delete(o.baseAttrs, m.PeerHash)
+			}
+			return append(msgs, o.message(bmp.OpenBMPPeerMsg, m.PeerHash, m.record))
+		}
+	case bmp.StatsReportMsg:
+		m := &stats{}
+		if err := json.Unmarshal(msg, m); err != nil {
+			return nil, true, fmt.Errorf("failed to decode a statistics message with error: %w", err)
+		}
+		routerHash, routerIP, ts = m.RouterHash, m.RouterIP, m.Timestamp
+		add = func(msgs []message) []message {
+			return append(msgs, o.message(bmp.OpenBMPStatMsg, m.PeerHash, m.record))
+		}
+	case bmp.LSNodeMsg:
+		m := &lsNode{}
+		if err := json.Unmarshal(msg, m); err != nil {
+			return nil, true, fmt.Errorf("failed to decode an LS node message with error: %w", err)
+		}
+		routerHash, routerIP, ts = m.RouterHash, m.RouterIP, m.Timestamp
+		add = func(msgs []message) []message {
+			return append(msgs, o.message(bmp.OpenBMPLSNodeMsg, m.PeerHash, m.record))
+		}
+	case bmp.LSLinkMsg:
+		m := &lsLink{}
+		if err := json.Unmarshal(msg, m); err != nil {
+			return nil, true, fmt.Errorf("failed to decode an LS link message with error: %w", err)
+		}
+		routerHash, routerIP, ts = m.RouterHash, m.RouterIP, m.Timestamp
+		add = func(msgs []message) []message {
+			return append(msgs, o.message(bmp.OpenBMPLSLinkMsg, m.PeerHash, m.record))
+		}
+	case bmp.LSPrefixMsg:
+		m := &lsPrefix{}
+		if err := json.Unmarshal(msg, m); err != nil {
+			return nil, true, fmt.Errorf("failed to decode an LS prefix message with error: %w", err)
+		}
+		routerHash, routerIP, ts = m.RouterHash, m.RouterIP, m.Timestamp
+		add = func(msgs []message) []message {
+			return append(msgs, o.message(bmp.OpenBMPLSPrefixMsg, m.PeerHash, m.record))
+		}
+	default:
+		m := &prefix{}
+		if err := json.Unmarshal(msg, m); err != nil {
+			return nil, true, fmt.Errorf("failed to decode a prefix message of type %d with error: %w", t, err)
+		}
+		// OpenBMP has no End-of-RIB record
+		if m.IsEOR {
+			return nil, true, nil
+		}
+		routerHash, routerIP, ts = m.RouterHash, m.RouterIP, m.Timestamp
+		msgType, record := bmp.OpenBMPUnicastPrefixMsg, m.unicastPrefixRecord
+		if t == bmp.L3VPNMsg || t == bmp.L3VPNV4Msg || t == bmp.L3VPNV6Msg {
+			msgType, record = bmp.OpenBMPL3VPNMsg, m.l3vpnRecord
+		}
+		add = func(msgs []message) []message {
+			// The base attributes of a peer are published when they change,
+			// as OpenBMP publishes them for every update.
+			if h := m.attrs().BaseAttrHash; h != "" && o.baseAttrs[m.PeerHash] != h {
+				o.baseAttrs[m.PeerHash] = h
+				msgs = append(msgs, o.message(bmp.OpenBMPBaseAttributeMsg, m.PeerHash, m.baseAttributeRecord))
+			}
+			return append(msgs, o.message(msgType, m.PeerHash, record))
+		}
+	}
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	var msgs []message
+	if routerHash != "" && !o.routers[routerHash] {
+		o.routers[routerHash] = true
+		o.routerIPs = append(o.routerIPs, routerIP)
+		msgs = append(msgs, o.message(bmp.OpenBMPRouterMsg, routerHash, func(seq string) []string {
+			return routerRecord(seq, routerHash, routerIP, timestamp(ts))
+		}))
+		msgs = append(msgs, o.collector("change"))
+	}
+
+	return add(msgs), true, nil
+}
+
+// message returns the OpenBMP message of type t with the record returned by
+// record for the next sequence number of the type, o.mu must be held.
+func (o *publisher) message(t int, key string, record func(seq string) []string) message {
+	o.sequence[t]++
+	return message{
+		msgType: t,
+		key:     key,
+		data:    newMessage(o.collectorHash, typeNames[t], record(strconv.FormatUint(o.sequence[t], 10))),
+	}
+}
+
+// collector returns the collector message of the action, o.mu must be held.
+func (o *publisher) collector(action string) message {
+	return o.message(bmp.OpenBMPCollectorMsg, o.collectorHash, func(seq string) []string {
+		return collectorRecord(action, seq, o.adminID, o.collectorHash, o.routerIPs, time.Now().UTC().Format(timestampLayout))
+	})
+}
@@ -0,0 +1,179 @@
+package openbmp
+
+import (
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sbezverk/gobmp/pkg/bmp"
+)
+
+type recordedMsg struct {
+	msgType int
+	key     string
+	msg     string
+}
+
+type recordingPublisher struct {
+	mu        sync.Mutex
+	msgs      []recordedMsg
+	subtopics []int
+	stopped   bool
+}
+
+func (r *recordingPublisher) PublishMessage(msgType int, msgHash []byte, msg []byte) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.msgs = append(r.msgs, recordedMsg{msgType: msgType, key: string(msgHash), msg: string(msg)})
+	return nil
+}
+
+func (r *recordingPublisher) PublishMessageToSubtopic(msgType int, subtopic string, msgHash []byte, msg []byte) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.subtopics = append(r.subtopics, msgType)
+	return nil
+}
+
+func (r *recordingPublisher) Stop() {
+	r.stopped = true
+}
+
+// take returns the messages published since the last call.
+func (r *recordingPublisher) take() []recordedMsg {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	msgs := r.msgs
+	r.msgs = nil
+	return msgs
+}
+
+// records returns the records of an OpenBMP message, after its header.
+func records(t *testing.T, m recordedMsg) []string {
+	t.Helper()
+	_, data, ok := strings.Cut(m.msg, "\n\n")
+	if !ok {
+		t.Fatalf("message has no header: %q", m.msg)
+	}
+	return strings.Split(strings.TrimSuffix(data, "\n"), "\n")
+}
+
+func TestPublisher(t *testing.T) {
+	r := &recordingPublisher{}
+	p := NewPublisher(r, "collector1")
+	// md5("collector1")
+	collectorHash := "9ae8148974c9ca01ec9271753426d214"
+
+	msgs := r.take()
+	if len(msgs) != 1 || msgs[0].msgType != bmp.OpenBMPCollectorMsg || msgs[0].key != collectorHash {
+		t.Fatalf("started messages = %+v", msgs)
+	}
+	if !strings.HasPrefix(msgs[0].msg, "V: 1.7\nC_HASH_ID: "+collectorHash+"\nT: collector\nL: ") ||
+		!strings.HasPrefix(records(t, msgs[0])[0], "started\t1\tcollector1\t"+collectorHash+"\t\t0\t") {
+		t.Errorf("started message = %q", msgs[0].msg)
+	}
+
+	// The first message of a router publishes the router and the collector change
+	peer := `{"action":"add","router_hash":"rh1","router_ip":"10.0.0.1","peer_hash":"ph1","remote_ip":"192.0.2.1",` +
+		`"remote_asn":65001,"timestamp":"2026-10-18T10:20:30Z","peer_type":0,"is_ipv4":true}`
+	if err := p.PublishMessage(bmp.PeerStateChangeMsg, []byte("rh1"), []byte(peer)); err != nil {
+		t.Fatalf("PublishMessage() error: %v", err)
+	}
+	msgs = r.take()
+	wantTypes := []int{bmp.OpenBMPRouterMsg, bmp.OpenBMPCollectorMsg, bmp.OpenBMPPeerMsg}
+	if len(msgs) != len(wantTypes) {
+		t.Fatalf("peer messages = %+v", msgs)
+	}
+	for i, m := range msgs {
+		if m.msgType != wantTypes[i] {
+			t.Errorf("message %d type = %d, want %d", i, m.msgType, wantTypes[i])
+		}
+	}
+	if got := records(t, msgs[0])[0]; got != "init\t1\t10.0.0.1\trh1\t10.0.0.1\t\t0\t\t\t\t2026-10-18 10:20:30.000000\t" {
+		t.Errorf("router record = %q", got)
+	}
+	if got := records(t, msgs[1])[0]; !strings.HasPrefix(got, "change\t2\tcollector1\t"+collectorHash+"\t10.0.0.1\t1\t") {
+		t.Errorf("collector record = %q", got)
+	}
+	if got := records(t, msgs[2])[0]; msgs[2].key != "ph1" || !strings.HasPrefix(got, "up\t1\tph1\trh1\t") {
+		t.Errorf("peer record = %q, key %s", got, msgs[2].key)
+	}
+
+	// The base attributes are published when they change for the peer
+	prefix := func(attrHash string) []byte {
+		return []byte(`{"action":"add","router_hash":"rh1","router_ip":"10.0.0.1","peer_hash":"ph1","peer_ip":"192.0.2.1",` +
+			`"base_attrs":{"base_attr_hash":"` + attrHash + `","origin":"igp","as_path":[65001]},"prefix":"198.51.100.0","prefix_len":24,"is_ipv4":true}`)
+	}
+	for i, tt := range []struct {
+		msgType   int
+		msg       []byte
+		wantTypes []int
+	}{
+		{msgType: bmp.UnicastPrefixV4Msg, msg: prefix("a1"), wantTypes: []int{bmp.OpenBMPBaseAttributeMsg, bmp.OpenBMPUnicastPrefixMsg}},
+		{msgType: bmp.UnicastPrefixV4Msg, msg: prefix("a1"), wantTypes: []int{bmp.OpenBMPUnicastPrefixMsg}},
+		{msgType: bmp.L3VPNV4Msg, msg: prefix("a2"), wantTypes: []int{bmp.OpenBMPBaseAttributeMsg, bmp.OpenBMPL3VPNMsg}},
+		{msgType: bmp.UnicastPrefixV4Msg, msg: []byte(`{"router_hash":"rh1","is_eor":true}`)},
+		{msgType: bmp.LSNodeMsg, msg: []byte(`{"action":"add","router_hash":"rh1"}`), wantTypes: []int{bmp.OpenBMPLSNodeMsg}},
+		{msgType: bmp.LSLinkMsg, msg: []byte(`{"action":"add","router_hash":"rh1"}`), wantTypes: []int{bmp.OpenBMPLSLinkMsg}},
+		{msgType: bmp.LSPrefixMsg, msg: []byte(`{"action":"add","router_hash":"rh1"}`), wantTypes: []int{bmp.OpenBMPLSPrefixMsg}},
+		{msgType: bmp.StatsReportMsg, msg: []byte(`{"router_hash":"rh1","peer_hash":"ph1"}`), wantTypes: []int{bmp.OpenBMPStatMsg}},
+		// A new peer up forgets the base attributes of the peer after its down
+		{msgType: bmp.PeerStateChangeMsg, msg: []byte(`{"action":"del","router_hash":"rh1","peer_hash":"ph1"}`), wantTypes: []int{bmp.OpenBMPPeerMsg}},
+		{msgType: bmp.UnicastPrefixV4Msg, msg: prefix("a2"), wantTypes: []int{bmp.OpenBMPBaseAttributeMsg, bmp.OpenBMPUnicastPrefixMsg}},
+		// The other messages are published as they are
+		{msgType: bmp.EVPNMsg, msg: []byte(`{"action":"add"}`), wantTypes: []int{bmp.EVPNMsg}},
+		{msgType: bmp.BMPRawMsg, msg: []byte{0x03}, wantTypes: []int{bmp.BMPRawMsg}},
+	} {
+		if err := p.PublishMessage(tt.msgType, []byte("key"), tt.msg); err != nil {
+			t.Fatalf("%d: PublishMessage() error: %v", i, err)
+		}
+		msgs := r.take()
+		if len(msgs) != len(tt.wantTypes) {
+			t.Fatalf("%d: messages = %+v, want types %v", i, msgs, tt.wantTypes)
+		}
+		for j, m := range msgs {
+			if m.msgType != tt.wantTypes[j] {
+				t.Errorf("%d: message %d type = %d, want %d", i, j, m.msgType, tt.wantTypes[j])
+			}
+		}
+	}
+	if err := p.PublishMessage(bmp.UnicastPrefixV4Msg, nil, []byte(`{"prefix_len":"24"}`)); err == nil {
+		t.Error("PublishMessage() expected error for an invalid message")
+	}
+
+	// The rendered messages are not published to the sub-topics
+	sp := p.(interface {
+		PublishMessageToSubtopic(int, string, []byte, []byte) error
+	})
+	_ = sp.PublishMessageToSubtopic(bmp.L3VPNV4Msg, "vrf1", nil, prefix("a2"))
+	_ = sp.PublishMessageToSubtopic(bmp.EVPNMsg, "vrf1", nil, []byte(`{}`))
+	if len(r.subtopics) != 1 || r.subtopics[0] != bmp.EVPNMsg {
+		t.Errorf("sub-topic messages = %v, want [%d]", r.subtopics, bmp.EVPNMsg)
+	}
+
+	p.Stop()
+	msgs = r.take()
+	if len(msgs) != 1 || !strings.HasPrefix(records(t, msgs[0])[0], "stopped\t3\t") || !r.stopped {
+		t.Errorf("stopped messages = %+v, stopped %t", msgs, r.stopped)
+	}
+}
+
+func TestPublisherHeartbeat(t *testing.T) {
+	defer func(d time.Duration) { heartbeatInterval = d }(heartbeatInterval)
+	heartbeatInterval = 10 * time.Millisecond
+	r := &recordingPublisher{}
+	p := NewPublisher(r, "collector1")
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		msgs := r.take()
+		if len(msgs) > 0 && strings.HasPrefix(records(t, msgs[len(msgs)-1])[0], "heartbeat\t") {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("no heartbeat message published")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	p.Stop()
+}
@@ -0,0 +1,380 @@
+package openbmp
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// The records hold the fields of the parsed JSON messages rendered in the
+// OpenBMP records, the columns of the records are the ones of the OpenBMP
+// v1.7 message bus API. The values goBMP does not collect are left empty.
+
+// rib holds the RIB flags of the per peer header of the messages.
+type rib struct {
+	IsAdjRIBInPost   bool `json:"is_adj_rib_in_post_policy"`
+	IsAdjRIBOutPost  bool `json:"is_adj_rib_out_post_policy"`
+	IsAdjRIBOut      bool `json:"is_adj_rib_out"`
+	IsLocRIB         bool `json:"is_loc_rib"`
+	IsLocRIBFiltered bool `json:"is_loc_rib_filtered"`
+}
+
+func (r *rib) isPrePolicy() string {
+	switch {
+	case r.IsLocRIB:
+		return boolField(false)
+	case r.IsAdjRIBOut:
+		return boolField(!r.IsAdjRIBOutPost)
+	}
+	return boolField(!r.IsAdjRIBInPost)
+}
+
+func (r *rib) isAdjRIBIn() string {
+	return boolField(!r.IsAdjRIBOut && !r.IsLocRIB)
+}
+
+// route holds the fields common to the messages of the routes of a peer.
+type route struct {
+	rib
+	Action     string `json:"action"`
+	Hash       string `json:"hash"`
+	RouterHash string `json:"router_hash"`
+	RouterIP   string `json:"router_ip"`
+	PeerHash   string `json:"peer_hash"`
+	PeerIP     string `json:"peer_ip"`
+	PeerASN    uint32 `json:"peer_asn"`
+	Timestamp  string `json:"timestamp"`
+}
+
+type baseAttributes struct {
+	BaseAttrHash     string   `json:"base_attr_hash"`
+	Origin           string   `json:"origin"`
+	ASPath           []uint32 `json:"as_path"`
+	ASPathCount      int32    `json:"as_path_count"`
+	Nexthop          string   `json:"nexthop"`
+	MED              uint32   `json:"med"`
+	LocalPref        uint32   `json:"local_pref"`
+	IsAtomicAgg      bool     `json:"is_atomic_agg"`
+	Aggregator       []byte   `json:"aggregator"`
+	CommunityList    []string `json:"community_list"`
+	OriginatorID     string   `json:"originator_id"`
+	ClusterList      string   `json:"cluster_list"`
+	ExtCommunityList []string `json:"ext_community_list"`
+	LgCommunityList  []string `json:"large_community_list"`
+}
+
+// prefix holds the fields of the unicast and L3VPN prefix messages.
+type prefix struct {
+	route
+	BaseAttributes *baseAttributes `json:"base_attrs"`
+	Prefix         string          `json:"prefix"`
+	PrefixLen      int32           `json:"prefix_len"`
+	IsIPv4         bool            `json:"is_ipv4"`
+	OriginAS       uint32          `json:"origin_as"`
+	Nexthop        string          `json:"nexthop"`
+	IsNexthopIPv4  bool            `json:"is_nexthop_ipv4"`
+	PathID         int32           `json:"path_id"`
+	Labels         []uint32        `json:"labels"`
+	VPNRD          string          `json:"vpn_rd"`
+	VPNRDType      uint16          `json:"vpn_rd_type"`
+	IsEOR          bool            `json:"is_eor"`
+}
+
+func (p *prefix) attrs() *baseAttributes {
+	if p.BaseAttributes == nil {
+		return &baseAttributes{}
+	}
+	return p.BaseAttributes
+}
+
+func (p *prefix) nexthop() string {
+	if p.Nexthop != "" {
+		return p.Nexthop
+	}
+	return p.attrs().Nexthop
+}
+
+// baseAttributeRecord returns the base_attribute record of the attributes of
+// the prefix.
+func (p *prefix) baseAttributeRecord(seq string) []string {
+	a := p.attrs()
+	return []string{
+		"add", seq, a.BaseAttrHash, p.RouterHash, p.RouterIP, p.PeerHash, p.PeerIP, uintField(p.PeerASN),
+		timestamp(p.Timestamp), a.Origin, listField(a.ASPath, " "), intField(a.ASPathCount),
+		uintField(p.OriginAS), p.nexthop(), uintField(a.MED), uintField(a.LocalPref), aggregator(a.Aggregator),
+		strings.Join(a.CommunityList, " "), strings.Join(a.ExtCommunityList, " "), a.ClusterList,
+		boolField(a.IsAtomicAgg), boolField(p.IsNexthopIPv4), a.OriginatorID, strings.Join(a.LgCommunityList, " "),
+	}
+}
+
+// unicastPrefixRecord returns the unicast_prefix record of the prefix.
+func (p *prefix) unicastPrefixRecord(seq string) []string {
+	a := p.attrs()
+	return []string{
+		p.Action, seq, p.Hash, p.RouterHash, p.RouterIP, a.BaseAttrHash, p.PeerHash, p.PeerIP,
+		uintField(p.PeerASN), timestamp(p.Timestamp), p.Prefix, intField(p.PrefixLen), boolField(p.IsIPv4),
+		a.Origin, listField(a.ASPath, " "), intField(a.ASPathCount), uintField(p.OriginAS), p.nexthop(),
+		uintField(a.MED), uintField(a.LocalPref), aggregator(a.Aggregator), strings.Join(a.CommunityList, " "),
+		strings.Join(a.ExtCommunityList, " "), a.ClusterList, boolField(a.IsAtomicAgg), boolField(p.IsNexthopIPv4),
+		a.OriginatorID, intField(p.PathID), listField(p.Labels, ","), p.isPrePolicy(), p.isAdjRIBIn(),
+		strings.Join(a.LgCommunityList, " "),
+	}
+}
+
+// l3vpnRecord returns the l3vpn record of the prefix, the unicast_prefix
+// columns followed by the route distinguisher and its type.
+func (p *prefix) l3vpnRecord(seq string) []string {
+	return append(p.unicastPrefixRecord(seq), p.VPNRD, uintField(p.VPNRDType))
+}
+
+type capability struct {
+	Description string `json:"capability_descr"`
+}
+
+type peer struct {
+	rib
+	Action          string                  `json:"action"`
+	RouterHash      string                  `json:"router_hash"`
+	PeerHash        string                  `json:"peer_hash"`
+	Name            string                  `json:"name"`
+	RemoteBGPID     string                  `json:"remote_bgp_id"`
+	RouterIP        string                  `json:"router_ip"`
+	Timestamp       string                  `json:"timestamp"`
+	RemoteASN       uint32                  `json:"remote_asn"`
+	RemoteIP        string                  `json:"remote_ip"`
+	PeerRD          string                  `json:"peer_rd"`
+	RemotePort      int                     `json:"remote_port"`
+	LocalASN        uint32                  `json:"local_asn"`
+	LocalIP         string                  `json:"local_ip"`
+	LocalPort       int                     `json:"local_port"`
+	LocalBGPID      string                  `json:"local_bgp_id"`
+	InfoData        []byte                  `json:"info_data"`
+	AdvCapabilities map[uint8][]*capability `json:"adv_cap"`
+	RcvCapabilities map[uint8][]*capability `json:"recv_cap"`
+	RemoteHolddown  int                     `json:"remote_holddown"`
+	AdvHolddown     int                     `json:"adv_holddown"`
+	BMPReason       int                     `json:"bmp_reason"`
+	BMPErrorCode    int                     `json:"bmp_error_code"`
+	BMPErrorSubCode int                     `json:"bmp_error_sub_code"`
+	ErrorText       string                  `json:"error_text"`
+	IsL3VPN         bool                    `json:"is_l"`
+	IsPrepolicy     bool                    `json:"is_prepolicy"`
+	IsIPv4          bool                    `json:"is_ipv4"`
+	TableName       string                  `json:"table_name"`
+}
+
+func (p *peer) up() bool {
+	return p.Action == "add" || p.Action == "up"
+}
+
+// capabilities returns the descriptions of the capabilities in the order of
+// their codes, separated by commas.
+func capabilities(c map[uint8][]*capability) string {
+	codes := make([]int, 0, len(c))
+	for code := range c {
+		codes = append(codes, int(code))
+	}
+	sort.Ints(codes)
+	var s []string
+	for _, code := range codes {
+		for _, d := range c[uint8(code)] {
+			if d != nil && d.Description != "" {
+				s = append(s, d.Description)
+			}
+		}
+	}
+	return strings.Join(s, ", ")
+}
+
+// record returns the peer record of the peer up or down message.
+func (p *peer) record(seq string) []string {
+	action := "down"
+	if p.up() {
+		action = "up"
+	}
+	return []string{
+		action, seq, p.PeerHash, p.RouterHash, p.Name, p.RemoteBGPID, p.RouterIP, timestamp(p.Timestamp),
+		uintField(p.RemoteASN), p.RemoteIP, p.PeerRD, intField(p.RemotePort), uintField(p.LocalASN), p.LocalIP,
+		intField(p.LocalPort), p.LocalBGPID, hex.EncodeToString(p.InfoData), capabilities(p.AdvCapabilities),
+		capabilities(p.RcvCapabilities), intField(p.RemoteHolddown), intField(p.AdvHolddown), intField(p.BMPReason),
+		intField(p.BMPErrorCode), intField(p.BMPErrorSubCode), p.ErrorText, boolField(p.IsL3VPN),
+		boolField(p.IsPrepolicy), boolField(p.IsIPv4), boolField(p.IsLocRIB), boolField(p.IsLocRIBFiltered),
+		p.TableName,
+	}
+}
+
+type multiTopologyID struct {
+	MTID uint16 `json:"mt_id"`
+}
+
+// ls holds the fields common to the BGP-LS messages.
+type ls struct {
+	route
+	DomainID    int64  `json:"domain_id"`
+	IGPRouterID string `json:"igp_router_id"`
+	RouterID    string `json:"router_id"`
+	LSID        uint32 `json:"ls_id"`
+	Protocol    string `json:"protocol"`
+	AreaID      string `json:"area_id"`
+	Nexthop     string `json:"nexthop"`
+}
+
+// areas returns the OSPF and the IS-IS area ID columns of the area ID.
+func (l *ls) areas() (string, string) {
+	if strings.HasPrefix(l.Protocol, "OSPF") {
+		return l.AreaID, ""
+	}
+	return "", l.AreaID
+}
+
+// head returns the leading columns of the LS records, the base attribute hash
+// is empty as the BGP-LS messages do not carry the attributes.
+func (l *ls) head(seq string) []string {
+	return []string{
+		l.Action, seq, l.Hash, "", l.RouterHash, l.RouterIP, l.PeerHash, l.PeerIP, uintField(l.PeerASN),
+		timestamp(l.Timestamp), l.IGPRouterID, l.RouterID, intField(l.DomainID), uintField(l.LSID),
+	}
+}
+
+type lsNode struct {
+	ls
+	MTID           []*multiTopologyID `json:"mt_id_tlv"`
+	NodeFlags      map[string]bool    `json:"node_flags"`
+	Name           string             `json:"name"`
+	SRCapabilities json.RawMessage    `json:"ls_sr_capabilities"`
+}
+
+// record returns the ls_node record of the node.
+func (n *lsNode) record(seq string) []string {
+	mtid := make([]string, 0, len(n.MTID))
+	for _, m := range n.MTID {
+		if m != nil {
+			mtid = append(mtid, uintField(m.MTID))
+		}
+	}
+	ospfArea, isisArea := n.areas()
+	return append(n.head(seq),
+		strings.Join(mtid, ","), ospfArea, isisArea, n.Protocol, flags(n.NodeFlags), "", "0", "0", n.Nexthop,
+		n.Name, n.isPrePolicy(), n.isAdjRIBIn(), jsonField(n.SRCapabilities),
+	)
+}
+
+type lsLink struct {
+	ls
+	MTID              *multiTopologyID `json:"mt_id_tlv"`
+	LocalLinkID       uint32           `json:"local_link_id"`
+	RemoteLinkID      uint32           `json:"remote_link_id"`
+	LocalLinkIP       string           `json:"local_link_ip"`
+	RemoteLinkIP      string           `json:"remote_link_ip"`
+	IGPMetric         uint32           `json:"igp_metric"`
+	AdminGroup        uint32           `json:"admin_group"`
+	MaxLinkBWKbps     uint64           `json:"max_link_bw_kbps"`
+	MaxResvBWKbps     uint64           `json:"max_resv_bw_kbps"`
+	UnResvBWKbps      []uint64         `json:"unresv_bw_kbps"`
+	TEDefaultMetric   uint32           `json:"te_default_metric"`
+	LinkProtection    uint16           `json:"link_protection"`
+	MPLSProtoMask     uint8            `json:"mpls_proto_mask"`
+	SRLG              []uint32         `json:"srlg"`
+	LinkName          string           `json:"link_name"`
+	RemoteNodeHash    string           `json:"remote_node_hash"`
+	LocalNodeHash     string           `json:"local_node_hash"`
+	RemoteIGPRouterID string           `json:"remote_igp_router_id"`
+	RemoteRouterID    string           `json:"remote_router_id"`
+	LocalNodeASN      uint32           `json:"local_node_asn"`
+	RemoteNodeASN     uint32           `json:"remote_node_asn"`
+	PeerNodeSID       json.RawMessage  `json:"peer_node_sid"`
+	LSAdjacencySID    json.RawMessage  `json:"ls_adjacency_sid"`
+}
+
+// record returns the ls_link record of the link.
+func (l *lsLink) record(seq string) []string {
+	mtid := ""
+	if l.MTID != nil {
+		mtid = uintField(l.MTID.MTID)
+	}
+	ospfArea, isisArea := l.areas()
+	return append(l.head(seq),
+		ospfArea, isisArea, l.Protocol, "", "0", "0", l.Nexthop, mtid, uintField(l.LocalLinkID),
+		uintField(l.RemoteLinkID), l.LocalLinkIP, l.RemoteLinkIP, uintField(l.IGPMetric), uintField(l.AdminGroup),
+		uintField(l.MaxLinkBWKbps), uintField(l.MaxResvBWKbps), listField(l.UnResvBWKbps, ","),
+		uintField(l.TEDefaultMetric), uintField(l.LinkProtection), uintField(l.MPLSProtoMask), listField(l.SRLG, " "),
+		l.LinkName, l.RemoteNodeHash, l.LocalNodeHash, l.RemoteIGPRouterID, l.RemoteRouterID,
+		uintField(l.LocalNodeASN), uintField(l.RemoteNodeASN), jsonField(l.PeerNodeSID), l.isPrePolicy(),
+		l.isAdjRIBIn(), jsonField(l.LSAdjacencySID),
+	)
+}
+
+type lsPrefix struct {
+	ls
+	LocalNodeHash  string           `json:"local_node_hash"`
+	MTID           *multiTopologyID `json:"mt_id_tlv"`
+	OSPFRouteType  uint8            `json:"ospf_route_type"`
+	IGPFlags       map[string]bool  `json:"igp_flags"`
+	IGPRouteTag    []uint32         `json:"route_tag"`
+	IGPExtRouteTag []uint64         `json:"ext_route_tag"`
+	OSPFFwdAddr    string           `json:"ospf_fwd_addr"`
+	PrefixMetric   uint32           `json:"prefix_metric"`
+	Prefix         string           `json:"prefix"`
+	PrefixLen      int32            `json:"prefix_len"`
+	PrefixAttrTLVs json.RawMessage  `json:"prefix_attr_tlvs"`
+}
+
+// record returns the ls_prefix record of the prefix, the prefix SID is carried
+// by the JSON text of the prefix attribute TLVs.
+func (p *lsPrefix) record(seq string) []string {
+	mtid := ""
+	if p.MTID != nil {
+		mtid = uintField(p.MTID.MTID)
+	}
+	ospfArea, isisArea := p.areas()
+	return append(p.head(seq),
+		ospfArea, isisArea, p.Protocol, "", "0", "0", p.Nexthop, p.LocalNodeHash, mtid,
+		ospfRouteTypes[p.OSPFRouteType], flags(p.IGPFlags), listField(p.IGPRouteTag, ","),
+		listField(p.IGPExtRouteTag, ","), p.OSPFFwdAddr, uintField(p.PrefixMetric), p.Prefix,
+		intField(p.PrefixLen), p.isPrePolicy(), p.isAdjRIBIn(), jsonField(p.PrefixAttrTLVs),
+	)
+}
+
+type stats struct {
+	RouterHash                 string `json:"router_hash"`
+	RouterIP                   string `json:"router_ip"`
+	PeerHash                   string `json:"peer_hash"`
+	RemoteASN                  uint32 `json:"remote_asn"`
+	RemoteIP                   string `json:"remote_ip"`
+	Timestamp                  string `json:"timestamp"`
+	DuplicatePrefixs           uint32 `json:"duplicate_prefix"`
+	DuplicateWithDraws         uint32 `json:"duplicate_withdraws"`
+	InvalidatedDueCluster      uint32 `json:"invalidated_due_cluster"`
+	InvalidatedDueAspath       uint32 `json:"invalidated_due_aspath"`
+	InvalidatedDueOriginatorID uint32 `json:"invalidated_due_originator_id"`
+	InvalidatedAsConfed        uint32 `json:"invalidated_due_asconfed"`
+	AdjRIBsIn                  uint64 `json:"ads_rib_in"`
+	LocalRib                   uint64 `json:"local_rib"`
+	PrefixesRejectedInbound    uint32 `json:"prefixes_rejected_inbound"`
+}
+
+// record returns the bmp_stat record of the statistics report.
+func (s *stats) record(seq string) []string {
+	return []string{
+		"add", seq, s.RouterHash, s.RouterIP, s.PeerHash, s.RemoteIP, uintField(s.RemoteASN),
+		timestamp(s.Timestamp), uintField(s.PrefixesRejectedInbound), uintField(s.DuplicatePrefixs),
+		uintField(s.DuplicateWithDraws), uintField(s.InvalidatedDueCluster), uintField(s.InvalidatedDueAspath),
+		uintField(s.InvalidatedDueOriginatorID), uintField(s.InvalidatedAsConfed), uintField(s.AdjRIBsIn),
+		uintField(s.LocalRib),
+	}
+}
+
+// routerRecord returns the router record of the init of a router, goBMP does
+// not publish the BMP initiation and termination messages and the router is
+// named after its address.
+func routerRecord(seq, hash, ip, ts string) []string {
+	return []string{"init", seq, ip, hash, ip, "", "0", "", "", "", ts, ""}
+}
+
+// collectorRecord returns the collector record of the action, started,
+// change, heartbeat or stopped, with the addresses of the routers.
+func collectorRecord(action, seq, adminID, hash string, routers []string, ts string) []string {
+	return []string{action, seq, adminID, hash, strings.Join(routers, ","), strconv.Itoa(len(routers)), ts}
+}
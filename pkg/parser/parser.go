@@ -12,6 +12,8 @@ import (
 type Config struct {
 	// EnableRawMode when true produces RAW BMP messages without parsing
 	EnableRawMode bool
+	// RawAndParsed when true with EnableRawMode also parses the messages
+	RawAndParsed bool
 	// SpeakerIP is the BMP speaker's IP from the TCP connection.
 	// Set on all bmp.Message values for consistent router identity across message types.
 	SpeakerIP string
@@ -44,7 +46,16 @@ func (p *parser) Start() {
 	for {
 		select {
 		case msg := <-p.queue:
-			go p.parsingWorker(msg)
+			if !p.config.EnableRawMode {
+				go p.parsingWorker(msg)
+				continue
+			}
+			// RAW messages are sent from this goroutine to keep the order of
+			// the router stream, the parsing is done by a worker.
+			p.sendRawMessage(msg)
+			if p.config.RawAndParsed {
+				go p.parse(msg)
+			}
 		case <-p.stop:
 			glog.Infof("received interrupt, stopping.")
 			return
@@ -63,10 +74,16 @@ func (p *parser) parsingWorker(b []byte) {
 	// If raw mode is enabled, send the entire message as-is
 	if p.config.EnableRawMode {
 		p.sendRawMessage(b)
-		return
+		if !p.config.RawAndParsed {
+			return
+		}
 	}
-
 	// Otherwise, parse the message normally
+	p.parse(b)
+}
+
+// parse parses the BMP messages of b and sends them to the producer
+func (p *parser) parse(b []byte) {
 	perPerHeaderLen := 0
 	var bmpMsg bmp.Message
 	// Loop through all found Common Headers in the slice and process them
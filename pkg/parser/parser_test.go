@@ -294,6 +294,52 @@ func TestParserRawMode(t *testing.T) {
 	}
 }
 
+// TestParserRawAndParsedMode tests that the messages are sent RAW and parsed
+func TestParserRawAndParsedMode(t *testing.T) {
+	producerQueue := make(chan bmp.Message, 2)
+	p := &parser{
+		producerQueue: producerQueue,
+		config:        &Config{EnableRawMode: true, RawAndParsed: true},
+	}
+	p.parsingWorker(buildPeerDownMsg(make([]byte, bmp.PerPeerHeaderLength), 1, nil))
+	msgs := collectMessages(producerQueue)
+	if len(msgs) != 2 {
+		t.Fatalf("expected 2 messages, got %d", len(msgs))
+	}
+	if _, ok := msgs[0].Payload.(*bmp.RawMessage); !ok {
+		t.Errorf("msgs[0] payload type = %T, want *bmp.RawMessage", msgs[0].Payload)
+	}
+	if _, ok := msgs[1].Payload.(*bmp.PeerDownMessage); !ok {
+		t.Errorf("msgs[1] payload type = %T, want *bmp.PeerDownMessage", msgs[1].Payload)
+	}
+}
+
+// TestParserRawModeOrder tests that the RAW messages are sent in the order
+// they are received.
+func TestParserRawModeOrder(t *testing.T) {
+	queue := make(chan []byte)
+	producerQueue := make(chan bmp.Message)
+	stop := make(chan struct{})
+	defer close(stop)
+	p := NewParser(queue, producerQueue, stop, &Config{EnableRawMode: true})
+	go p.Start()
+	go func() {
+		for reason := byte(1); reason <= 5; reason++ {
+			queue <- buildPeerDownMsg(make([]byte, bmp.PerPeerHeaderLength), reason, nil)
+		}
+	}()
+	for reason := byte(1); reason <= 5; reason++ {
+		msg := <-producerQueue
+		rm, ok := msg.Payload.(*bmp.RawMessage)
+		if !ok {
+			t.Fatalf("payload type = %T, want *bmp.RawMessage", msg.Payload)
+		}
+		if got := rm.Msg[bmp.CommonHeaderLength+bmp.PerPeerHeaderLength]; got != reason {
+			t.Errorf("reason = %d, want %d", got, reason)
+		}
+	}
+}
+
 // TestParserNormalMode tests parser in normal (parsed) mode
 func TestParserNormalMode(t *testing.T) {
 	input := []byte{3, 0, 0, 0, 32, 4, 0, 1, 0, 10, 32, 55, 46, 50, 46, 49, 46, 50, 51, 73, 0, 2, 0, 8, 120, 114, 118, 57, 107, 45, 114, 49}
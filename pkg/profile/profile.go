@@ -0,0 +1,257 @@
+// Package profile projects the parsed messages on the fields of an output
+// profile when they are marshalled, the published messages keep the schema of
+// the message types with fewer fields.
+package profile
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/sbezverk/gobmp/pkg/bgp"
+	"github.com/sbezverk/gobmp/pkg/bmp"
+)
+
+// Output profiles
+const (
+	// Full publishes every field of the messages
+	Full = "full"
+	// Compact drops the empty and legacy fields, but for the is_ flags and
+	// the route identifying fields, and replaces the base attributes of the prefixes by their base_attr_hash,
+	// the base attributes are published by the base_attribute messages
+	Compact = "compact"
+	// Custom publishes the fields selected per message type
+	Custom = "custom"
+)
+
+// legacyFields are the fields dropped by the compact profile, the ArangoDB
+// document fields and the BGP-LS bandwidths superseded by their _kbps form.
+var legacyFields = map[string]bool{
+	"_key":        true,
+	"_id":         true,
+	"_rev":        true,
+	"max_link_bw": true,
+	"max_resv_bw": true,
+	"unresv_bw":   true,
+}
+
+// keyFields are the route identifying fields published by the compact profile
+// even when zero, the prefix_len of a default route, the peer_type of a Global
+// Instance peer and the afi and safi of the route.
+var keyFields = map[string]bool{
+	"prefix_len": true,
+	"peer_type":  true,
+	"afi":        true,
+	"safi":       true,
+}
+
+// Fields selects the fields of a message type in the custom profile, by their
+// JSON name. When Include is set only the listed fields are published, the
+// Exclude fields are never published.
+type Fields struct {
+	Include []string `yaml:"include,omitempty"`
+	Exclude []string `yaml:"exclude,omitempty"`
+}
+
+type fieldSet struct {
+	include map[string]bool
+	exclude map[string]bool
+}
+
+// Profile marshals the messages of an output profile, it is safe for
+// concurrent use.
+type Profile struct {
+	name   string
+	fields map[int]*fieldSet
+}
+
+// field is a JSON field of a message struct, keep is set for the is_ flags
+// and the key fields whose zero value is published by the compact profile,
+// the consumers tell a false flag or a zero key from a missing one.
+type field struct {
+	index     []int
+	name      string
+	omitEmpty bool
+	keep      bool
+}
+
+// structFields caches the fields of the message types, nil for the types
+// marshalled as they are.
+var structFields sync.Map
+
+// New returns the profile name, fields are the custom profile fields per
+// message type name, a name without the _v4 or _v6 suffix selects the fields
+// of the split address family types.
+func New(name string, fields map[string]Fields) (*Profile, error) {
+	p := &Profile{name: name}
+	switch name {
+	case Full, Compact:
+		if len(fields) != 0 {
+			return nil, fmt.Errorf("fields are only supported by the %s profile", Custom)
+		}
+	case Custom:
+		p.fields = make(map[int]*fieldSet)
+		for n, f := range fields {
+			types := bmp.MessageTypes(n)
+			if len(types) == 0 {
+				return nil, fmt.Errorf("unknown message type %q", n)
+			}
+			fs := &fieldSet{include: set(f.Include), exclude: set(f.Exclude)}
+			for _, t := range types {
+				// The fields of a split type take precedence over the
+				// fields of its family
+				if _, ok := p.fields[t]; ok && bmp.MessageTypeNames[t] != n {
+					continue
+				}
+				p.fields[t] = fs
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unknown output profile %q", name)
+	}
+
+	return p, nil
+}
+
+func set(l []string) map[string]bool {
+	if len(l) == 0 {
+		return nil
+	}
+	s := make(map[string]bool, len(l))
+	for _, v := range l {
+		s[v] = true
+	}
+	return s
+}
+
+// Name returns the name of the profile.
+func (p *Profile) Name() string {
+	return p.name
+}
+
+// Marshal returns the JSON encoding of the message msg of type msgType
+// projected on the fields of the profile, the fields are in the order of the
+// message struct.
+func (p *Profile) Marshal(msgType int, msg interface{}) ([]byte, error) {
+	var fs *fieldSet
+	switch p.name {
+	case Full:
+		return json.Marshal(msg)
+	case Custom:
+		if fs = p.fields[msgType]; fs == nil {
+			return json.Marshal(msg)
+		}
+	}
+	v := reflect.ValueOf(msg)
+	for v.Kind() == reflect.Ptr && !v.IsNil() {
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return json.Marshal(msg)
+	}
+	fields := fieldsOf(v.Type())
+	if fields == nil {
+		return json.Marshal(msg)
+	}
+	var b bytes.Buffer
+	b.WriteByte('{')
+	for _, f := range fields {
+		fv := v.FieldByIndex(f.index)
+		var value interface{}
+		if fs != nil {
+			if fs.exclude[f.name] || (fs.include != nil && !fs.include[f.name]) || (f.omitEmpty && isEmpty(fv)) {
+				continue
+			}
+		} else {
+			if legacyFields[f.name] || (!f.keep && isEmpty(fv)) {
+				continue
+			}
+			if attrs, ok := fv.Interface().(*bgp.BaseAttributes); ok && attrs.BaseAttrHash != "" {
+				value = struct {
+					BaseAttrHash string `json:"base_attr_hash"`
+				}{attrs.BaseAttrHash}
+			}
+		}
+		if value == nil {
+			// Values are marshalled by address as encoding/json does for the
+			// fields of an addressable struct, for the MarshalJSON methods
+			// of pointer receivers.
+			value = fv.Interface()
+			if fv.CanAddr() && fv.Kind() != reflect.Ptr && fv.Kind() != reflect.Interface {
+				value = fv.Addr().Interface()
+			}
+		}
+		j, err := json.Marshal(value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal field %s with error: %w", f.name, err)
+		}
+		if b.Len() > 1 {
+			b.WriteByte(',')
+		}
+		b.WriteByte('"')
+		b.WriteString(f.name)
+		b.WriteString(`":`)
+		b.Write(j)
+	}
+	b.WriteByte('}')
+
+	return b.Bytes(), nil
+}
+
+// fieldsOf returns the JSON fields of the struct type t, nil when t has
+// embedded fields, such structs are marshalled as they are.
+func fieldsOf(t reflect.Type) []field {
+	if fields, ok := structFields.Load(t); ok {
+		return fields.([]field)
+	}
+	var fields []field
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if sf.Anonymous {
+			fields = nil
+			break
+		}
+		if !sf.IsExported() {
+			continue
+		}
+		tag := sf.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+		if name == "" {
+			name = sf.Name
+		}
+		fields = append(fields, field{
+			index:     sf.Index,
+			name:      name,
+			omitEmpty: strings.Contains(opts, "omitempty"),
+			keep:      (sf.Type.Kind() == reflect.Bool && strings.HasPrefix(name, "is_")) || keyFields[name],
+		})
+	}
+	structFields.Store(t, fields)
+
+	return fields
+}
+
+// isEmpty returns true for the values omitted by the omitempty option.
+func isEmpty(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool:
+		return !v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return v.Float() == 0
+	case reflect.Interface, reflect.Ptr:
+		return v.IsNil()
+	}
+	return false
+}
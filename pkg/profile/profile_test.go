@@ -0,0 +1,165 @@
+package profile
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/sbezverk/gobmp/pkg/bgp"
+	"github.com/sbezverk/gobmp/pkg/bmp"
+)
+
+// prefix and link have the fields of the unicast prefix and LS link messages,
+// the message package can not be imported by the tests of its dependencies.
+type prefix struct {
+	Key            string              `json:"_key,omitempty"`
+	ID             string              `json:"_id,omitempty"`
+	Action         string              `json:"action,omitempty"`
+	RouterIP       string              `json:"router_ip,omitempty"`
+	BaseAttributes *bgp.BaseAttributes `json:"base_attrs,omitempty"`
+	PeerIP         string              `json:"peer_ip,omitempty"`
+	PeerType       uint8               `json:"peer_type"`
+	PeerASN        uint32              `json:"peer_asn,omitempty"`
+	Prefix         string              `json:"prefix,omitempty"`
+	PrefixLen      int32               `json:"prefix_len,omitempty"`
+	IsIPv4         bool                `json:"is_ipv4"`
+	IsLocRIB       bool                `json:"is_loc_rib"`
+	Spec           spec                `json:"spec"`
+}
+
+type link struct {
+	Action        string `json:"action,omitempty"`
+	AreaID        string `json:"area_id"`
+	MaxLinkBW     uint32 `json:"max_link_bw,omitempty"`
+	MaxLinkBWKbps uint64 `json:"max_link_bw_kbps,omitempty"`
+}
+
+// spec has a MarshalJSON method of pointer receiver
+type spec struct{ v int }
+
+func (s *spec) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.v)
+}
+
+func TestMarshal(t *testing.T) {
+	u := &prefix{
+		Key:      "k",
+		ID:       "unicast_prefix_v4/k",
+		Action:   "add",
+		RouterIP: "10.0.0.1",
+		BaseAttributes: &bgp.BaseAttributes{
+			BaseAttrHash: "bh",
+			Origin:       "igp",
+			ASPath:       []uint32{65001},
+		},
+		PeerIP:    "192.0.2.1",
+		PeerASN:   65001,
+		Prefix:    "198.51.100.0",
+		PrefixLen: 24,
+		IsIPv4:    true,
+		Spec:      spec{v: 7},
+	}
+	l := &link{Action: "add", MaxLinkBW: 125000, MaxLinkBWKbps: 1000000}
+	tests := []struct {
+		name    string
+		profile string
+		fields  map[string]Fields
+		msgType int
+		msg     interface{}
+		want    string
+	}{
+		{
+			name:    "compact unicast prefix",
+			profile: Compact,
+			msgType: bmp.UnicastPrefixV4Msg,
+			msg:     &u,
+			want: `{"action":"add","router_ip":"10.0.0.1","base_attrs":{"base_attr_hash":"bh"},"peer_ip":"192.0.2.1","peer_type":0,` +
+				`"peer_asn":65001,"prefix":"198.51.100.0","prefix_len":24,"is_ipv4":true,"is_loc_rib":false,"spec":7}`,
+		},
+		{
+			name:    "compact default route",
+			profile: Compact,
+			msgType: bmp.UnicastPrefixV4Msg,
+			msg:     &prefix{Action: "add", Prefix: "0.0.0.0", IsIPv4: true},
+			want:    `{"action":"add","peer_type":0,"prefix":"0.0.0.0","prefix_len":0,"is_ipv4":true,"is_loc_rib":false,"spec":0}`,
+		},
+		{
+			name:    "compact ls link",
+			profile: Compact,
+			msgType: bmp.LSLinkMsg,
+			msg:     l,
+			want:    `{"action":"add","max_link_bw_kbps":1000000}`,
+		},
+		{
+			name:    "custom include",
+			profile: Custom,
+			fields:  map[string]Fields{"unicast_prefix": {Include: []string{"prefix", "prefix_len", "base_attrs", "is_loc_rib"}}},
+			msgType: bmp.UnicastPrefixV4Msg,
+			msg:     u,
+			want:    `{"base_attrs":{"base_attr_hash":"bh","origin":"igp","as_path":[65001],"is_atomic_agg":false},"prefix":"198.51.100.0","prefix_len":24,"is_loc_rib":false}`,
+		},
+		{
+			name:    "custom exclude",
+			profile: Custom,
+			fields: map[string]Fields{
+				"unicast_prefix":    {Include: []string{"prefix"}},
+				"unicast_prefix_v4": {Exclude: []string{"_key", "_id", "base_attrs", "is_loc_rib", "spec"}},
+			},
+			msgType: bmp.UnicastPrefixV4Msg,
+			msg:     u,
+			want:    `{"action":"add","router_ip":"10.0.0.1","peer_ip":"192.0.2.1","peer_type":0,"peer_asn":65001,"prefix":"198.51.100.0","prefix_len":24,"is_ipv4":true}`,
+		},
+		{
+			name:    "custom other type",
+			profile: Custom,
+			fields:  map[string]Fields{"unicast_prefix": {Include: []string{"prefix"}}},
+			msgType: bmp.LSLinkMsg,
+			msg:     l,
+		},
+		{
+			name:    "full",
+			profile: Full,
+			msgType: bmp.UnicastPrefixV4Msg,
+			msg:     u,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := New(tt.profile, tt.fields)
+			if err != nil {
+				t.Fatalf("New() error: %v", err)
+			}
+			got, err := p.Marshal(tt.msgType, tt.msg)
+			if err != nil {
+				t.Fatalf("Marshal() error: %v", err)
+			}
+			want := tt.want
+			if want == "" {
+				// The message is marshalled as it is
+				b, _ := json.Marshal(tt.msg)
+				want = string(b)
+			}
+			if string(got) != want {
+				t.Errorf("Marshal() =\n%s\nwant\n%s", got, want)
+			}
+		})
+	}
+}
+
+func TestNewErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		profile string
+		fields  map[string]Fields
+	}{
+		{name: "unknown profile", profile: "minimal"},
+		{name: "fields of compact", profile: Compact, fields: map[string]Fields{"peer": {}}},
+		{name: "unknown type", profile: Custom, fields: map[string]Fields{"prefix": {Include: []string{"prefix"}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := New(tt.profile, tt.fields); err == nil {
+				t.Error("New() expected error")
+			}
+		})
+	}
+}
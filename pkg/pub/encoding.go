@@ -0,0 +1,43 @@
+package pub
+
+import "fmt"
+
+// Encoding defines the encoding of the published parsed messages, the
+// messages are encoded by the publishers from their JSON encoding, or from
+// their structs by the publishers implementing StructPublisher.
+type Encoding string
+
+const (
+	// EncodingJSON publishes the messages in JSON, the default encoding.
+	EncodingJSON Encoding = "json"
+	// EncodingProtobuf publishes the messages in the binary protobuf encoding
+	// of the gobmp.api messages defined in pkg/api.
+	EncodingProtobuf Encoding = "protobuf"
+	// EncodingAvro publishes the messages in the Avro binary encoding of the
+	// schemas of pkg/avro, framed in the schema registry wire format. Only the
+	// Kafka publisher supports it.
+	EncodingAvro Encoding = "avro"
+)
+
+// ParseEncoding returns the Encoding of its name, an empty name is EncodingJSON.
+func ParseEncoding(s string) (Encoding, error) {
+	switch Encoding(s) {
+	case "", EncodingJSON:
+		return EncodingJSON, nil
+	case EncodingProtobuf, EncodingAvro:
+		return Encoding(s), nil
+	}
+	return "", fmt.Errorf("unknown encoding %q, supported encodings are json, protobuf and avro", s)
+}
+
+// ContentType returns the media type of the messages published in the
+// encoding, publishers set it as the content-type header of the messages.
+func (e Encoding) ContentType() string {
+	switch e {
+	case EncodingProtobuf:
+		return "application/x-protobuf"
+	case EncodingAvro:
+		return "avro/binary"
+	}
+	return "application/json"
+}
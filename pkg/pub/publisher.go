@@ -1,5 +1,10 @@
 package pub
 
+import (
+	"encoding/json"
+	"errors"
+)
+
 // Publisher defines an interface and method to publish message
 // msgType is the type of message, defined in pkg/bmp/consts.go
 // MsgHash optionally defines the key to use by the backend when storing message
@@ -8,3 +13,131 @@ type Publisher interface {
 	PublishMessage(msgType int, msgHash []byte, msg []byte) error
 	Stop()
 }
+
+// SubtopicPublisher is implemented by publishers able to publish a message to
+// a sub-topic of its msgType topic, such as the per-VRF L3VPN topics.
+type SubtopicPublisher interface {
+	PublishMessageToSubtopic(msgType int, subtopic string, msgHash []byte, msg []byte) error
+}
+
+// StructPublisher is implemented by publishers encoding the parsed messages
+// from their structs rather than from their JSON encoding, which spares the
+// encodings other than JSON a JSON round trip. msg is a pointer to the message
+// struct of msgType, it is published to the sub-topic subtopic of its topic
+// when subtopic is set and the publisher supports sub-topics, to its topic
+// otherwise.
+type StructPublisher interface {
+	PublishStruct(msgType int, subtopic string, msgHash []byte, msg interface{}) error
+}
+
+// SubtopicName returns the name of the sub-topic of topic, characters which are
+// not valid in Kafka topic names or NATS subject tokens are replaced by '_'.
+func SubtopicName(topic, subtopic string) string {
+	b := []byte(subtopic)
+	for i, c := range b {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '_' || c == '-') {
+			b[i] = '_'
+		}
+	}
+	return topic + "." + string(b)
+}
+
+// Tee returns a Publisher publishing messages to each of the publishers, the
+// sub-topic messages are published by the publishers implementing
+// SubtopicPublisher. The returned Publisher implements SubtopicPublisher only
+// when one of the publishers does, it implements StructPublisher and passes
+// the message structs to the publishers implementing it, the others get the
+// JSON encoding of the messages.
+func Tee(publishers ...Publisher) Publisher {
+	for _, p := range publishers {
+		if _, ok := p.(SubtopicPublisher); ok {
+			return subtopicTee{tee(publishers)}
+		}
+	}
+	return tee(publishers)
+}
+
+type tee []Publisher
+
+type subtopicTee struct {
+	tee
+}
+
+func (t tee) PublishMessage(msgType int, msgHash []byte, msg []byte) error {
+	var errs []error
+	for _, p := range t {
+		if err := p.PublishMessage(msgType, msgHash, msg); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (t subtopicTee) PublishMessageToSubtopic(msgType int, subtopic string, msgHash []byte, msg []byte) error {
+	var errs []error
+	for _, p := range t.tee {
+		sp, ok := p.(SubtopicPublisher)
+		if !ok {
+			continue
+		}
+		if err := sp.PublishMessageToSubtopic(msgType, subtopic, msgHash, msg); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (t tee) PublishStruct(msgType int, subtopic string, msgHash []byte, msg interface{}) error {
+	// Like PublishMessageToSubtopic, a sub-topic message goes to the publishers
+	// supporting sub-topics, to every publisher when none does.
+	if subtopic != "" && !t.subtopics() {
+		subtopic = ""
+	}
+	var j []byte
+	var errs []error
+	for _, p := range t {
+		sp, ok := p.(SubtopicPublisher)
+		if subtopic != "" && !ok {
+			continue
+		}
+		if s, ok := p.(StructPublisher); ok {
+			if err := s.PublishStruct(msgType, subtopic, msgHash, msg); err != nil {
+				errs = append(errs, err)
+			}
+			continue
+		}
+		// The JSON encoding is shared by the publishers of JSON messages
+		if j == nil {
+			b, err := json.Marshal(msg)
+			if err != nil {
+				return err
+			}
+			j = b
+		}
+		if subtopic != "" {
+			if err := sp.PublishMessageToSubtopic(msgType, subtopic, msgHash, j); err != nil {
+				errs = append(errs, err)
+			}
+			continue
+		}
+		if err := p.PublishMessage(msgType, msgHash, j); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (t tee) subtopics() bool {
+	for _, p := range t {
+		if _, ok := p.(SubtopicPublisher); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (t tee) Stop() {
+	for _, p := range t {
+		p.Stop()
+	}
+}
@@ -0,0 +1,557 @@
+// Package rules evaluates the publishing rules of the collector: the messages
+// matching a rule are dropped, sampled, labelled or published to a sub-topic
+// of their topic before they are marshalled and published.
+package rules
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"net/netip"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/sbezverk/gobmp/pkg/bmp"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	maxRulesFileSize = 4 * 1024 * 1024 // 4 MB
+)
+
+// Rule actions
+const (
+	// ActionKeep publishes the message, the following rules are not evaluated
+	ActionKeep = "keep"
+	// ActionDrop drops the message
+	ActionDrop = "drop"
+	// ActionSample publishes Sample percent of the messages and drops the others
+	ActionSample = "sample"
+	// ActionLabel adds Labels to the message, the following rules are evaluated
+	ActionLabel = "label"
+	// ActionRoute publishes the message to the Subtopic sub-topic of its topic
+	ActionRoute = "route"
+)
+
+// Rule is a publishing rule, the messages matching all the criteria of Match
+// are subject to Action.
+type Rule struct {
+	Name   string `yaml:"name"`
+	Match  Match  `yaml:"match"`
+	Action string `yaml:"action"`
+	// Sample is the percentage of the messages published by the sample action
+	Sample float64 `yaml:"sample,omitempty"`
+	// Labels are added to the user_labels of the messages by the label, keep,
+	// sample and route actions
+	Labels map[string]string `yaml:"labels,omitempty"`
+	// Subtopic is the sub-topic of the route action, the messages are
+	// published to "<topic>.<subtopic>" instead of their topic
+	Subtopic string `yaml:"subtopic,omitempty"`
+}
+
+// Match holds the criteria of a rule, a message matches when it matches every
+// criterion set, a criterion of several values matches any of them.
+type Match struct {
+	// Types are message type names, a name without the _v4 or _v6 suffix
+	// also matches the split address family types
+	Types []string `yaml:"types,omitempty"`
+	// Routers and Peers are addresses or prefixes
+	Routers  []string `yaml:"routers,omitempty"`
+	Peers    []string `yaml:"peers,omitempty"`
+	PeerASNs []uint32 `yaml:"peer_asns,omitempty"`
+	// RIBs are "loc_rib", "adj_rib_in_pre", "adj_rib_in_post",
+	// "adj_rib_out_pre" and "adj_rib_out_post", "adj_rib_in" and "adj_rib_out"
+	// match both the pre and post policy RIBs
+	RIBs []string `yaml:"ribs,omitempty"`
+	// AFIs are "ipv4" or "ipv6"
+	AFIs []string `yaml:"afis,omitempty"`
+	// Prefixes are prefix list entries: "10.0.0.0/8" matches the prefix only,
+	// "10.0.0.0/8 le 24", "10.0.0.0/8 ge 16" and "10.0.0.0/8 ge 16 le 24" the
+	// prefixes of the range of lengths covered by the prefix
+	Prefixes []string `yaml:"prefixes,omitempty"`
+	// Communities are standard, extended or large communities as published,
+	// e.g. "65000:100", "rt=65000:1" or "65000:1:2"
+	Communities []string `yaml:"communities,omitempty"`
+	// ASPath is a regular expression matched against the AS path written as
+	// space separated AS numbers, "_" matches a separator, the start or the
+	// end of the path, e.g. "_65001$" for the routes originated by AS 65001
+	ASPath string `yaml:"as_path,omitempty"`
+}
+
+// File defines the format of the rules file:
+//
+//	rules:
+//	  - name: lab-peers
+//	    match:
+//	      peer_asns: [65099]
+//	    action: route
+//	    subtopic: lab
+type File struct {
+	Rules []Rule `yaml:"rules"`
+}
+
+// Fields are the fields of a message the rules match.
+type Fields struct {
+	Type    int
+	Router  netip.Addr
+	Peer    netip.Addr
+	PeerASN uint32
+	// RIB is the RIB name of the message, empty when it has none
+	RIB string
+	// AFI is "ipv4" or "ipv6", empty when the message has no address family
+	AFI         string
+	Prefix      netip.Prefix
+	Communities []string
+	ASPath      []uint32
+	// Withdraw is set for the withdrawal of a route, which carries no
+	// attributes
+	Withdraw bool
+	// Route distinguishes the routes of a prefix, such as their Route
+	// Distinguisher and path identifier
+	Route string
+	// PeerDown is set for the peer down message of a peer
+	PeerDown bool
+}
+
+// Decision is the outcome of the rules for a message.
+type Decision struct {
+	Drop bool
+	// Labels are the labels added to the message
+	Labels map[string]string
+	// Subtopic is the sub-topic the message is published to, empty for its
+	// topic
+	Subtopic string
+}
+
+// prefixRange is a prefix list entry
+type prefixRange struct {
+	prefix netip.Prefix
+	ge, le int
+}
+
+type rule struct {
+	Rule
+	types       map[int]bool
+	routers     []netip.Prefix
+	peers       []netip.Prefix
+	peerASNs    map[uint32]bool
+	ribs        []string
+	afis        map[string]bool
+	prefixes    []prefixRange
+	communities map[string]bool
+	asPath      *regexp.Regexp
+	// threshold is the sample percentage in hundredths of a percent
+	threshold uint32
+}
+
+// routeKey identifies a route of a peer.
+type routeKey struct {
+	msgType int
+	rib     string
+	prefix  netip.Prefix
+	route   string
+}
+
+// peerKey identifies a peer of a router.
+type peerKey struct {
+	router netip.Addr
+	peer   netip.Addr
+}
+
+// Engine evaluates an ordered list of rules, it is safe for concurrent use.
+type Engine struct {
+	rules []*rule
+	// attrs is set when a rule matches the attributes of the routes
+	attrs bool
+	// routes holds per peer the decisions of the announced routes which
+	// depend on their attributes, the decisions of their withdrawals
+	mu     sync.Mutex
+	routes map[peerKey]map[routeKey]Decision
+}
+
+// LoadFile reads the rules of a rules file.
+func LoadFile(path string) ([]Rule, error) {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if fi.Size() > maxRulesFileSize {
+		return nil, fmt.Errorf("rules file size exceeds the maximum allowed size of %d bytes", maxRulesFileSize)
+	}
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	f := &File{}
+	if err := yaml.Unmarshal(b, f); err != nil {
+		return nil, fmt.Errorf("failed to parse rules file %s with error: %w", path, err)
+	}
+
+	return f.Rules, nil
+}
+
+// New validates the rules and returns their engine.
+func New(rules []Rule) (*Engine, error) {
+	e := &Engine{}
+	for i, r := range rules {
+		cr, err := compile(r)
+		if err != nil {
+			name := r.Name
+			if name == "" {
+				name = "#" + strconv.Itoa(i+1)
+			}
+			return nil, fmt.Errorf("invalid rule %s: %w", name, err)
+		}
+		e.rules = append(e.rules, cr)
+		if cr.communities != nil || cr.asPath != nil {
+			e.attrs = true
+		}
+	}
+
+	return e, nil
+}
+
+func compile(r Rule) (*rule, error) {
+	cr := &rule{Rule: r}
+	switch r.Action {
+	case ActionKeep, ActionDrop:
+	case ActionSample:
+		if r.Sample < 0 || r.Sample > 100 {
+			return nil, fmt.Errorf("sample %v is not a percentage", r.Sample)
+		}
+		cr.threshold = uint32(r.Sample * 100)
+	case ActionLabel:
+		if len(r.Labels) == 0 {
+			return nil, fmt.Errorf("label action without labels")
+		}
+	case ActionRoute:
+		if r.Subtopic == "" {
+			return nil, fmt.Errorf("route action without subtopic")
+		}
+	default:
+		return nil, fmt.Errorf("unknown action %q", r.Action)
+	}
+	m := r.Match
+	if len(m.Types) != 0 {
+		cr.types = make(map[int]bool)
+		for _, n := range m.Types {
+			ts := bmp.MessageTypes(n)
+			if len(ts) == 0 {
+				return nil, fmt.Errorf("unknown message type %q", n)
+			}
+			for _, t := range ts {
+				cr.types[t] = true
+			}
+		}
+	}
+	var err error
+	if cr.routers, err = prefixes(m.Routers); err != nil {
+		return nil, fmt.Errorf("invalid router: %w", err)
+	}
+	if cr.peers, err = prefixes(m.Peers); err != nil {
+		return nil, fmt.Errorf("invalid peer: %w", err)
+	}
+	if len(m.PeerASNs) != 0 {
+		cr.peerASNs = make(map[uint32]bool)
+		for _, asn := range m.PeerASNs {
+			cr.peerASNs[asn] = true
+		}
+	}
+	for _, rib := range m.RIBs {
+		switch rib {
+		case "loc_rib", "adj_rib_in", "adj_rib_in_pre", "adj_rib_in_post", "adj_rib_out", "adj_rib_out_pre", "adj_rib_out_post":
+		default:
+			return nil, fmt.Errorf("unknown rib %q", rib)
+		}
+		cr.ribs = append(cr.ribs, rib)
+	}
+	if len(m.AFIs) != 0 {
+		cr.afis = make(map[string]bool)
+		for _, afi := range m.AFIs {
+			if afi != "ipv4" && afi != "ipv6" {
+				return nil, fmt.Errorf("unknown afi %q", afi)
+			}
+			cr.afis[afi] = true
+		}
+	}
+	for _, s := range m.Prefixes {
+		pr, err := parsePrefixRange(s)
+		if err != nil {
+			return nil, err
+		}
+		cr.prefixes = append(cr.prefixes, pr)
+	}
+	if len(m.Communities) != 0 {
+		cr.communities = make(map[string]bool)
+		for _, c := range m.Communities {
+			cr.communities[c] = true
+		}
+	}
+	if m.ASPath != "" {
+		if cr.asPath, err = regexp.Compile(strings.ReplaceAll(m.ASPath, "_", "(?:^| |$)")); err != nil {
+			return nil, fmt.Errorf("invalid as_path: %w", err)
+		}
+	}
+
+	return cr, nil
+}
+
+// prefixes parses a list of addresses and prefixes, an address is a host prefix.
+func prefixes(l []string) ([]netip.Prefix, error) {
+	var ps []netip.Prefix
+	for _, s := range l {
+		if a, err := netip.ParseAddr(s); err == nil {
+			ps = append(ps, netip.PrefixFrom(a.Unmap(), a.Unmap().BitLen()))
+			continue
+		}
+		p, err := netip.ParsePrefix(s)
+		if err != nil {
+			return nil, fmt.Errorf("%q is neither an address nor a prefix", s)
+		}
+		ps = append(ps, p.Masked())
+	}
+
+	return ps, nil
+}
+
+// parsePrefixRange parses a prefix list entry, "<prefix> [ge <len>] [le <len>]".
+func parsePrefixRange(s string) (prefixRange, error) {
+	f := strings.Fields(s)
+	if len(f) == 0 {
+		return prefixRange{}, fmt.Errorf("empty prefix")
+	}
+	p, err := netip.ParsePrefix(f[0])
+	if err != nil {
+		return prefixRange{}, fmt.Errorf("invalid prefix %q: %w", s, err)
+	}
+	pr := prefixRange{prefix: p.Masked(), ge: p.Bits(), le: p.Bits()}
+	hasGE, hasLE := false, false
+	for f = f[1:]; len(f) != 0; f = f[2:] {
+		if len(f) < 2 {
+			return prefixRange{}, fmt.Errorf("invalid prefix %q: missing length after %s", s, f[0])
+		}
+		l, err := strconv.Atoi(f[1])
+		if err != nil || l < p.Bits() || l > p.Addr().BitLen() {
+			return prefixRange{}, fmt.Errorf("invalid prefix %q: invalid length %s", s, f[1])
+		}
+		switch {
+		case f[0] == "ge" && !hasGE:
+			pr.ge, hasGE = l, true
+			if !hasLE {
+				pr.le = p.Addr().BitLen()
+			}
+		case f[0] == "le" && !hasLE:
+			pr.le, hasLE = l, true
+		default:
+			return prefixRange{}, fmt.Errorf("invalid prefix %q: unexpected %s", s, f[0])
+		}
+	}
+	if pr.ge > pr.le {
+		return prefixRange{}, fmt.Errorf("invalid prefix %q: ge is greater than le", s)
+	}
+
+	return pr, nil
+}
+
+// Evaluate returns the decision of the rules for the message of fields f. The
+// rules are evaluated in order, the first matching rule of the keep, drop,
+// sample or route action decides, the label rules matching before it add their
+// labels. A message matching no deciding rule is published. The withdrawal of
+// a route gets the decision of its last announcement, so that the rules
+// matching the attributes apply to both, and the routes of a peer are
+// forgotten with the peer down message of the peer.
+func (e *Engine) Evaluate(f *Fields) Decision {
+	if !e.attrs {
+		return e.evaluate(f)
+	}
+	if f.Type == bmp.PeerStateChangeMsg && f.PeerDown {
+		e.mu.Lock()
+		delete(e.routes, peerKey{router: f.Router, peer: f.Peer})
+		e.mu.Unlock()
+		return e.evaluate(f)
+	}
+	if !f.Prefix.IsValid() {
+		return e.evaluate(f)
+	}
+	pk := peerKey{router: f.Router, peer: f.Peer}
+	rk := routeKey{msgType: f.Type, rib: f.RIB, prefix: f.Prefix, route: f.Route}
+	if f.Withdraw {
+		e.mu.Lock()
+		d, ok := e.routes[pk][rk]
+		if ok {
+			delete(e.routes[pk], rk)
+		}
+		e.mu.Unlock()
+		if ok {
+			return d
+		}
+		return e.evaluate(f)
+	}
+	d := e.evaluate(f)
+	// The decision is remembered when the withdrawal would get another one
+	w := *f
+	w.Withdraw, w.Communities, w.ASPath = true, nil, nil
+	same := d.equal(e.evaluate(&w))
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if same {
+		delete(e.routes[pk], rk)
+		return d
+	}
+	if e.routes == nil {
+		e.routes = make(map[peerKey]map[routeKey]Decision)
+	}
+	if e.routes[pk] == nil {
+		e.routes[pk] = make(map[routeKey]Decision)
+	}
+	e.routes[pk][rk] = d
+
+	return d
+}
+
+func (e *Engine) evaluate(f *Fields) Decision {
+	var d Decision
+	for _, r := range e.rules {
+		if !r.match(f) {
+			continue
+		}
+		if r.Action == ActionDrop || (r.Action == ActionSample && !r.sampled(f)) {
+			return Decision{Drop: true}
+		}
+		if len(r.Labels) != 0 {
+			if d.Labels == nil {
+				d.Labels = make(map[string]string)
+			}
+			for k, v := range r.Labels {
+				d.Labels[k] = v
+			}
+		}
+		switch r.Action {
+		case ActionLabel:
+			continue
+		case ActionRoute:
+			d.Subtopic = r.Subtopic
+		}
+		return d
+	}
+
+	return d
+}
+
+func (d Decision) equal(o Decision) bool {
+	if d.Drop != o.Drop || d.Subtopic != o.Subtopic || len(d.Labels) != len(o.Labels) {
+		return false
+	}
+	for k, v := range d.Labels {
+		if l, ok := o.Labels[k]; !ok || l != v {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *rule) match(f *Fields) bool {
+	if r.types != nil && !r.types[f.Type] {
+		return false
+	}
+	if r.routers != nil && !containsAddr(r.routers, f.Router) {
+		return false
+	}
+	if r.peers != nil && !containsAddr(r.peers, f.Peer) {
+		return false
+	}
+	if r.peerASNs != nil && !r.peerASNs[f.PeerASN] {
+		return false
+	}
+	if r.ribs != nil && !r.matchRIB(f.RIB) {
+		return false
+	}
+	if r.afis != nil && !r.afis[f.AFI] {
+		return false
+	}
+	if r.prefixes != nil && !r.matchPrefix(f.Prefix) {
+		return false
+	}
+	if r.communities != nil && !r.matchCommunities(f.Communities) {
+		return false
+	}
+	if r.asPath != nil && !r.asPath.MatchString(asPathString(f.ASPath)) {
+		return false
+	}
+
+	return true
+}
+
+func containsAddr(ps []netip.Prefix, a netip.Addr) bool {
+	if !a.IsValid() {
+		return false
+	}
+	a = a.Unmap()
+	for _, p := range ps {
+		if p.Contains(a) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *rule) matchRIB(rib string) bool {
+	if rib == "" {
+		return false
+	}
+	for _, n := range r.ribs {
+		if rib == n || strings.HasPrefix(rib, n+"_") {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *rule) matchPrefix(p netip.Prefix) bool {
+	if !p.IsValid() {
+		return false
+	}
+	for _, pr := range r.prefixes {
+		if p.Bits() >= pr.ge && p.Bits() <= pr.le && pr.prefix.Contains(p.Addr()) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *rule) matchCommunities(cs []string) bool {
+	for _, c := range cs {
+		if r.communities[c] {
+			return true
+		}
+	}
+	return false
+}
+
+// sampled returns true when the message is in the sampled percentage, the
+// choice is a hash of the message identity so the updates and the withdraw of
+// a route are sampled alike.
+func (r *rule) sampled(f *Fields) bool {
+	h := fnv.New32a()
+	var b [4]byte
+	binary.BigEndian.PutUint32(b[:], uint32(f.Type))
+	h.Write(b[:])
+	h.Write(f.Router.AsSlice())
+	h.Write(f.Peer.AsSlice())
+	if f.Prefix.IsValid() {
+		h.Write(f.Prefix.Addr().AsSlice())
+		h.Write([]byte{byte(f.Prefix.Bits())})
+	}
+	return h.Sum32()%10000 < r.threshold
+}
+
+func asPathString(path []uint32) string {
+	s := make([]string, len(path))
+	for i, asn := range path {
+		s[i] = strconv.FormatUint(uint64(asn), 10)
+	}
+	return strings.Join(s, " ")
+}
@@ -0,0 +1,269 @@
+package rules
+
+import (
+	"net/netip"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/sbezverk/gobmp/pkg/bmp"
+)
+
+func unicast(peer string, asn uint32, prefix string, path ...uint32) *Fields {
+	return &Fields{
+		Type:        bmp.UnicastPrefixV4Msg,
+		Router:      netip.MustParseAddr("10.0.0.1"),
+		Peer:        netip.MustParseAddr(peer),
+		PeerASN:     asn,
+		RIB:         "adj_rib_in_post",
+		AFI:         "ipv4",
+		Prefix:      netip.MustParsePrefix(prefix),
+		Communities: []string{"65000:100", "65000:1:2"},
+		ASPath:      path,
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	e, err := New([]Rule{
+		{Name: "lab", Match: Match{PeerASNs: []uint32{65099}}, Action: ActionRoute, Subtopic: "lab", Labels: map[string]string{"env": "lab"}},
+		{Name: "site", Match: Match{Routers: []string{"10.0.0.0/24"}}, Action: ActionLabel, Labels: map[string]string{"site": "paris"}},
+		{Name: "bogons", Match: Match{Prefixes: []string{"192.168.0.0/16 le 32"}}, Action: ActionDrop},
+		{Name: "long", Match: Match{AFIs: []string{"ipv4"}, Prefixes: []string{"0.0.0.0/0 ge 25"}}, Action: ActionDrop},
+		{Name: "transit", Match: Match{ASPath: "^64500_"}, Action: ActionKeep},
+		{Name: "blackhole", Match: Match{Communities: []string{"65535:666", "65000:1:2"}, RIBs: []string{"adj_rib_in"}}, Action: ActionDrop},
+		{Name: "peers", Match: Match{Types: []string{"peer"}}, Action: ActionDrop},
+	})
+	if err != nil {
+		t.Fatalf("New() error: %v", err)
+	}
+	tests := []struct {
+		name string
+		f    *Fields
+		want Decision
+	}{
+		{
+			name: "route",
+			f:    unicast("192.0.2.1", 65099, "198.51.100.0/24"),
+			want: Decision{Labels: map[string]string{"env": "lab"}, Subtopic: "lab"},
+		},
+		{
+			name: "drop prefix range",
+			f:    unicast("192.0.2.2", 65001, "192.168.1.0/24"),
+			want: Decision{Drop: true},
+		},
+		{
+			name: "drop prefix length",
+			f:    unicast("192.0.2.2", 65001, "198.51.100.128/25"),
+			want: Decision{Drop: true},
+		},
+		{
+			name: "keep as path",
+			f:    unicast("192.0.2.2", 64500, "198.51.100.0/24", 64500, 65001),
+			want: Decision{Labels: map[string]string{"site": "paris"}},
+		},
+		{
+			name: "drop community",
+			f:    unicast("192.0.2.2", 64501, "198.51.100.0/24", 64501, 64500),
+			want: Decision{Drop: true},
+		},
+		{
+			name: "label",
+			f:    &Fields{Type: bmp.StatsReportMsg, Router: netip.MustParseAddr("10.0.0.2")},
+			want: Decision{Labels: map[string]string{"site": "paris"}},
+		},
+		{
+			name: "type",
+			f:    &Fields{Type: bmp.PeerStateChangeMsg, Router: netip.MustParseAddr("10.0.1.1")},
+			want: Decision{Drop: true},
+		},
+		{
+			name: "no match",
+			f:    &Fields{Type: bmp.LSNodeMsg, Router: netip.MustParseAddr("10.0.1.1")},
+			want: Decision{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := e.Evaluate(tt.f); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Evaluate() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEvaluateMatch(t *testing.T) {
+	tests := []struct {
+		name  string
+		match Match
+		f     *Fields
+		want  bool
+	}{
+		{name: "type family", match: Match{Types: []string{"unicast_prefix"}}, f: unicast("192.0.2.1", 1, "10.0.0.0/8"), want: true},
+		{name: "type split", match: Match{Types: []string{"unicast_prefix_v6"}}, f: unicast("192.0.2.1", 1, "10.0.0.0/8"), want: false},
+		{name: "peer address", match: Match{Peers: []string{"192.0.2.1"}}, f: unicast("192.0.2.1", 1, "10.0.0.0/8"), want: true},
+		{name: "peer prefix", match: Match{Peers: []string{"2001:db8::/32"}}, f: unicast("192.0.2.1", 1, "10.0.0.0/8"), want: false},
+		{name: "rib", match: Match{RIBs: []string{"loc_rib", "adj_rib_in_post"}}, f: unicast("192.0.2.1", 1, "10.0.0.0/8"), want: true},
+		{name: "rib pre", match: Match{RIBs: []string{"adj_rib_in_pre"}}, f: unicast("192.0.2.1", 1, "10.0.0.0/8"), want: false},
+		{name: "rib absent", match: Match{RIBs: []string{"loc_rib"}}, f: &Fields{Type: bmp.StatsReportMsg}, want: false},
+		{name: "afi", match: Match{AFIs: []string{"ipv6"}}, f: unicast("192.0.2.1", 1, "10.0.0.0/8"), want: false},
+		{name: "exact prefix", match: Match{Prefixes: []string{"10.0.0.0/8"}}, f: unicast("192.0.2.1", 1, "10.0.0.0/8"), want: true},
+		{name: "exact prefix more specific", match: Match{Prefixes: []string{"10.0.0.0/8"}}, f: unicast("192.0.2.1", 1, "10.1.0.0/16"), want: false},
+		{name: "prefix ge le", match: Match{Prefixes: []string{"10.0.0.0/8 ge 16 le 24"}}, f: unicast("192.0.2.1", 1, "10.1.0.0/16"), want: true},
+		{name: "prefix outside", match: Match{Prefixes: []string{"10.0.0.0/8 le 32"}}, f: unicast("192.0.2.1", 1, "11.0.0.0/8"), want: false},
+		{name: "prefix absent", match: Match{Prefixes: []string{"0.0.0.0/0 le 32"}}, f: &Fields{Type: bmp.LSNodeMsg}, want: false},
+		{name: "as path origin", match: Match{ASPath: "_65001$"}, f: unicast("192.0.2.1", 1, "10.0.0.0/8", 64500, 65001), want: true},
+		{name: "as path partial asn", match: Match{ASPath: "_6500_"}, f: unicast("192.0.2.1", 1, "10.0.0.0/8", 64500, 65001), want: false},
+		{name: "all criteria", match: Match{PeerASNs: []uint32{1}, Communities: []string{"65000:100"}}, f: unicast("192.0.2.1", 1, "10.0.0.0/8"), want: true},
+		{name: "one criterion fails", match: Match{PeerASNs: []uint32{2}, Communities: []string{"65000:100"}}, f: unicast("192.0.2.1", 1, "10.0.0.0/8"), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, err := New([]Rule{{Match: tt.match, Action: ActionDrop}})
+			if err != nil {
+				t.Fatalf("New() error: %v", err)
+			}
+			if got := e.Evaluate(tt.f).Drop; got != tt.want {
+				t.Errorf("match = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEvaluateWithdraw(t *testing.T) {
+	e, err := New([]Rule{
+		{Name: "blackhole", Match: Match{Communities: []string{"65535:666"}}, Action: ActionDrop},
+		{Name: "customers", Match: Match{ASPath: "^65010_"}, Action: ActionRoute, Subtopic: "customers"},
+	})
+	if err != nil {
+		t.Fatalf("New() error: %v", err)
+	}
+	announce := func(prefix string, communities []string, path ...uint32) *Fields {
+		f := unicast("192.0.2.1", 65001, prefix, path...)
+		f.Communities = communities
+		return f
+	}
+	withdraw := func(prefix string) *Fields {
+		f := unicast("192.0.2.1", 65001, prefix)
+		f.Communities, f.Withdraw = nil, true
+		return f
+	}
+	peerDown := &Fields{Type: bmp.PeerStateChangeMsg, Router: netip.MustParseAddr("10.0.0.1"), Peer: netip.MustParseAddr("192.0.2.1"), PeerDown: true}
+	tests := []struct {
+		name string
+		f    *Fields
+		want Decision
+	}{
+		{name: "blackholed route", f: announce("198.51.100.0/24", []string{"65535:666"}), want: Decision{Drop: true}},
+		{name: "customer route", f: announce("203.0.113.0/24", nil, 65010), want: Decision{Subtopic: "customers"}},
+		{name: "withdrawal of the blackholed route", f: withdraw("198.51.100.0/24"), want: Decision{Drop: true}},
+		{name: "withdrawal of the customer route", f: withdraw("203.0.113.0/24"), want: Decision{Subtopic: "customers"}},
+		// The decisions are forgotten with the withdrawals
+		{name: "second withdrawal", f: withdraw("198.51.100.0/24")},
+		{name: "blackholed route announced again", f: announce("198.51.100.0/24", []string{"65535:666"}), want: Decision{Drop: true}},
+		{name: "route announced without the community", f: announce("198.51.100.0/24", nil)},
+		{name: "withdrawal of the route", f: withdraw("198.51.100.0/24")},
+		{name: "customer route announced again", f: announce("203.0.113.0/24", nil, 65010), want: Decision{Subtopic: "customers"}},
+		{name: "peer down", f: peerDown},
+		{name: "withdrawal after the peer down", f: withdraw("203.0.113.0/24")},
+	}
+	for _, tt := range tests {
+		if got := e.Evaluate(tt.f); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: Evaluate() = %+v, want %+v", tt.name, got, tt.want)
+		}
+	}
+	if len(e.routes) != 0 {
+		t.Errorf("routes = %v, want none", e.routes)
+	}
+}
+
+func TestEvaluateSample(t *testing.T) {
+	e, err := New([]Rule{{Action: ActionSample, Sample: 25}})
+	if err != nil {
+		t.Fatalf("New() error: %v", err)
+	}
+	kept := 0
+	for i := 0; i < 4000; i++ {
+		f := unicast("192.0.2.1", 1, netip.PrefixFrom(netip.AddrFrom4([4]byte{10, byte(i >> 8), byte(i), 0}), 24).String())
+		d := e.Evaluate(f)
+		if !d.Drop {
+			kept++
+		}
+		// The decision of a message does not change
+		if e.Evaluate(f).Drop != d.Drop {
+			t.Fatalf("sample decision of %s changed", f.Prefix)
+		}
+	}
+	if kept < 800 || kept > 1200 {
+		t.Errorf("sampled %d messages of 4000, want about 1000", kept)
+	}
+	for _, pct := range []float64{0, 100} {
+		e, _ := New([]Rule{{Action: ActionSample, Sample: pct}})
+		if got := e.Evaluate(unicast("192.0.2.1", 1, "10.0.0.0/8")).Drop; got != (pct == 0) {
+			t.Errorf("sample %v%%: drop = %t", pct, got)
+		}
+	}
+}
+
+func TestNewErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		rule Rule
+	}{
+		{name: "unknown action", rule: Rule{Action: "forward"}},
+		{name: "sample percentage", rule: Rule{Action: ActionSample, Sample: 150}},
+		{name: "label without labels", rule: Rule{Action: ActionLabel}},
+		{name: "route without subtopic", rule: Rule{Action: ActionRoute}},
+		{name: "unknown type", rule: Rule{Action: ActionDrop, Match: Match{Types: []string{"ls_foo"}}}},
+		{name: "invalid router", rule: Rule{Action: ActionDrop, Match: Match{Routers: []string{"router1"}}}},
+		{name: "unknown rib", rule: Rule{Action: ActionDrop, Match: Match{RIBs: []string{"rib_in"}}}},
+		{name: "unknown afi", rule: Rule{Action: ActionDrop, Match: Match{AFIs: []string{"l2vpn"}}}},
+		{name: "invalid prefix", rule: Rule{Action: ActionDrop, Match: Match{Prefixes: []string{"10.0.0.0"}}}},
+		{name: "prefix length", rule: Rule{Action: ActionDrop, Match: Match{Prefixes: []string{"10.0.0.0/8 le 4"}}}},
+		{name: "prefix ge le", rule: Rule{Action: ActionDrop, Match: Match{Prefixes: []string{"10.0.0.0/8 ge 24 le 16"}}}},
+		{name: "prefix keyword", rule: Rule{Action: ActionDrop, Match: Match{Prefixes: []string{"10.0.0.0/8 eq 16"}}}},
+		{name: "as path", rule: Rule{Action: ActionDrop, Match: Match{ASPath: "(65001"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := New([]Rule{tt.rule}); err == nil {
+				t.Error("New() expected error")
+			}
+		})
+	}
+}
+
+func TestLoadFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rules.yaml")
+	yml := `
+rules:
+  - name: lab-peers
+    match:
+      peer_asns: [65099]
+      ribs: [adj_rib_in]
+    action: route
+    subtopic: lab
+  - name: sample
+    match:
+      types: [unicast_prefix]
+    action: sample
+    sample: 12.5
+`
+	if err := os.WriteFile(path, []byte(yml), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	rs, err := LoadFile(path)
+	if err != nil {
+		t.Fatalf("LoadFile() error: %v", err)
+	}
+	want := []Rule{
+		{Name: "lab-peers", Match: Match{PeerASNs: []uint32{65099}, RIBs: []string{"adj_rib_in"}}, Action: ActionRoute, Subtopic: "lab"},
+		{Name: "sample", Match: Match{Types: []string{"unicast_prefix"}}, Action: ActionSample, Sample: 12.5},
+	}
+	if !reflect.DeepEqual(rs, want) {
+		t.Errorf("LoadFile() = %+v, want %+v", rs, want)
+	}
+	if _, err := LoadFile(filepath.Join(t.TempDir(), "missing.yaml")); err == nil {
+		t.Error("LoadFile() expected error for a missing file")
+	}
+}
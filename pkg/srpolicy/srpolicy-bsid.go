@@ -292,3 +292,77 @@ func UnmarshalBSIDSTLV(b []byte) (BSID, error) {
 
 	return bsid, nil
 }
+
+const (
+	// SRv6BSIDSFlag is the Specified-BSID-only flag of SRv6 Binding SID sub-TLV
+	SRv6BSIDSFlag = 0x80
+	// SRv6BSIDIFlag is the Drop Upon Invalid flag of SRv6 Binding SID sub-TLV
+	SRv6BSIDIFlag = 0x40
+	// SRv6BSIDBFlag indicates presence of SRv6 Endpoint Behavior and SID Structure
+	SRv6BSIDBFlag = 0x20
+)
+
+// SRv6EndpointBehavior defines SRv6 Endpoint Behavior and SID Structure
+// carried by SRv6 Binding SID sub-TLV and SRv6 segments (RFC 9830 Section 2.4.4.2.4)
+type SRv6EndpointBehavior struct {
+	EndpointBehavior uint16 `json:"endpoint_behavior"`
+	LBLength         uint8  `json:"locator_block_length"`
+	LNLength         uint8  `json:"locator_node_length"`
+	FunLength        uint8  `json:"function_length"`
+	ArgLength        uint8  `json:"argument_length"`
+}
+
+// SRv6BindingSID sub-TLV is used to signal the SRv6 Binding SID related
+// information of the SR Policy candidate path (RFC 9830 Section 2.4.3).
+type SRv6BindingSID struct {
+	Flags            byte                  `json:"flags"`
+	BSID             []byte                `json:"srv6_bsid,omitempty"`
+	EndpointBehavior *SRv6EndpointBehavior `json:"endpoint_behavior_sid_structure,omitempty"`
+}
+
+// IsSpecifiedBSIDOnly returns true if S flag is set
+func (s *SRv6BindingSID) IsSpecifiedBSIDOnly() bool {
+	return s.Flags&SRv6BSIDSFlag == SRv6BSIDSFlag
+}
+
+// IsDropUponInvalid returns true if I flag is set
+func (s *SRv6BindingSID) IsDropUponInvalid() bool {
+	return s.Flags&SRv6BSIDIFlag == SRv6BSIDIFlag
+}
+
+// UnmarshalSRv6BSIDSTLV builds SRv6 Binding SID object from a slice of bytes,
+// the value is 2 bytes of flags and reserved, followed by optional 16 bytes SID and
+// optional 8 bytes of Endpoint Behavior and SID Structure.
+func UnmarshalSRv6BSIDSTLV(b []byte) (*SRv6BindingSID, error) {
+	if glog.V(5) {
+		glog.Infof("SR Policy SRv6 Binding SID STLV Raw: %s", tools.MessageHex(b))
+	}
+	if len(b) != 2 && len(b) != 18 && len(b) != 26 {
+		return nil, fmt.Errorf("invalid length %d of srv6 binding sid stlv", len(b))
+	}
+	s := &SRv6BindingSID{
+		Flags: b[0],
+	}
+	p := 2
+	if len(b) == 2 {
+		return s, nil
+	}
+	s.BSID = make([]byte, 16)
+	copy(s.BSID, b[p:p+16])
+	p += 16
+	if s.Flags&SRv6BSIDBFlag == SRv6BSIDBFlag && len(b) != 26 {
+		return nil, fmt.Errorf("srv6 binding sid stlv with B flag is missing endpoint behavior and sid structure")
+	}
+	if len(b) == 26 {
+		s.EndpointBehavior = &SRv6EndpointBehavior{
+			EndpointBehavior: binary.BigEndian.Uint16(b[p : p+2]),
+			// Skip 2 reserved bytes
+			LBLength:  b[p+4],
+			LNLength:  b[p+5],
+			FunLength: b[p+6],
+			ArgLength: b[p+7],
+		}
+	}
+
+	return s, nil
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"testing"
 
+	"github.com/go-test/deep"
 	"github.com/sbezverk/gobmp/pkg/srv6"
 )
 
@@ -342,3 +343,76 @@ func TestBindingSID_MarshalJSON_InvalidType(t *testing.T) {
 		t.Error("Expected error for unknown bsid type in Marshal, got nil")
 	}
 }
+
+func TestUnmarshalSRv6BSIDSTLV(t *testing.T) {
+	sid := []byte{0x20, 0x01, 0x0d, 0xb8, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x01, 0x00}
+	tests := []struct {
+		name   string
+		input  []byte
+		expect *SRv6BindingSID
+		fail   bool
+	}{
+		{
+			name:   "flags only",
+			input:  []byte{0x40, 0x00},
+			expect: &SRv6BindingSID{Flags: 0x40},
+		},
+		{
+			name:   "sid without endpoint behavior",
+			input:  append([]byte{0x80, 0x00}, sid...),
+			expect: &SRv6BindingSID{Flags: 0x80, BSID: sid},
+		},
+		{
+			name:  "sid with endpoint behavior and sid structure",
+			input: append(append([]byte{0x20, 0x00}, sid...), 0x00, 0x39, 0x00, 0x00, 32, 16, 16, 0),
+			expect: &SRv6BindingSID{
+				Flags: 0x20,
+				BSID:  sid,
+				EndpointBehavior: &SRv6EndpointBehavior{
+					EndpointBehavior: 57,
+					LBLength:         32,
+					LNLength:         16,
+					FunLength:        16,
+				},
+			},
+		},
+		{
+			name:  "B flag without endpoint behavior",
+			input: append([]byte{0x20, 0x00}, sid...),
+			fail:  true,
+		},
+		{
+			name:  "invalid length",
+			input: []byte{0x00, 0x00, 0x01},
+			fail:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := UnmarshalSRv6BSIDSTLV(tt.input)
+			if err != nil {
+				if !tt.fail {
+					t.Fatalf("supposed to succeed but failed with error: %+v", err)
+				}
+				return
+			}
+			if tt.fail {
+				t.Fatalf("supposed to fail but succeeded")
+			}
+			if diffs := deep.Equal(got, tt.expect); len(diffs) != 0 {
+				t.Errorf("differences: %+v", diffs)
+			}
+			data, err := json.Marshal(got)
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+			var result SRv6BindingSID
+			if err := json.Unmarshal(data, &result); err != nil {
+				t.Fatalf("Unmarshal() error = %v", err)
+			}
+			if diffs := deep.Equal(&result, got); len(diffs) != 0 {
+				t.Errorf("round trip differences: %+v", diffs)
+			}
+		})
+	}
+}
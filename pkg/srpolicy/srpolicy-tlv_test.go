@@ -601,6 +601,47 @@ func TestUnmarshalSRPolicyTLV_PathName(t *testing.T) {
 	}
 }
 
+func TestUnmarshalSRPolicyTLV_PolicyNameAndSRv6BSID(t *testing.T) {
+	input := []byte{
+		0x00, 0x0F, // Tunnel Type: 15
+		0x00, 0x28, // Length: 40 bytes
+		0x82,       // Sub-TLV Type: Policy Name (130)
+		0x00, 0x05, // Length: 5 bytes
+		0x00,                   // Reserved
+		0x67, 0x6F, 0x6C, 0x64, // "gold"
+		0x14, // Sub-TLV Type: SRv6 Binding SID (20)
+		0x1A, // Length: 26 bytes
+		0x20, // Flags: B
+		0x00, // Reserved
+		0x20, 0x01, 0x0D, 0xB8, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x00,
+		0x00, 0x39, // Endpoint Behavior: End.B6.Encaps.Red
+		0x00, 0x00, // Reserved
+		0x20, 0x10, 0x10, 0x00, // LB, LN, Function and Argument lengths
+		0x14,       // Sub-TLV Type: SRv6 Binding SID (20)
+		0x02,       // Length: 2 bytes
+		0x80, 0x00, // Flags: S, no SID
+	}
+	tlv, err := UnmarshalSRPolicyTLV(input)
+	if err != nil {
+		t.Fatalf("UnmarshalSRPolicyTLV() error = %v", err)
+	}
+	if tlv.Name != "gold" {
+		t.Errorf("Name = %q, want %q", tlv.Name, "gold")
+	}
+	if len(tlv.SRv6BindingSID) != 2 {
+		t.Fatalf("expected 2 SRv6 Binding SIDs, got %d", len(tlv.SRv6BindingSID))
+	}
+	if eb := tlv.SRv6BindingSID[0].EndpointBehavior; eb == nil || eb.EndpointBehavior != 0x39 || eb.LBLength != 32 {
+		t.Errorf("unexpected endpoint behavior %+v", eb)
+	}
+	if !tlv.SRv6BindingSID[1].IsSpecifiedBSIDOnly() || tlv.SRv6BindingSID[1].BSID != nil {
+		t.Errorf("unexpected second SRv6 Binding SID %+v", tlv.SRv6BindingSID[1])
+	}
+	if _, err := UnmarshalSRPolicyTLV([]byte{0x00, 0x0F, 0x00, 0x03, 0x82, 0x00, 0x00}); err == nil {
+		t.Errorf("expected error for policy name without reserved byte")
+	}
+}
+
 // ============================================================================
 // Unknown Sub-TLV Handling Tests
 // ============================================================================
@@ -0,0 +1,499 @@
+package topology
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+
+	"github.com/golang/glog"
+	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/message"
+	"github.com/sbezverk/gobmp/pkg/sr"
+	"github.com/sbezverk/gobmp/pkg/srpolicy"
+)
+
+// Hop is a segment of a segment list resolved to the topology element owning
+// it.
+type Hop struct {
+	// Segment is the index of the segment in the segment list
+	Segment int `json:"segment"`
+	// SegmentType is the segment type letter, "A" to "K"
+	SegmentType string `json:"segment_type"`
+	// Kind is "prefix" for a Prefix SID, "node" for a node address,
+	// "adjacency", "srv6_sid" for a node SRv6 SID or "srv6_locator"
+	Kind string `json:"kind"`
+	// SID is the MPLS label or SRv6 SID of the segment when known
+	SID string `json:"sid,omitempty"`
+	// Node is the IGP Router ID of the node owning the segment, the local
+	// node for an adjacency
+	Node     string `json:"node"`
+	NodeName string `json:"node_name,omitempty"`
+	Link     *Link  `json:"link,omitempty"`
+	Prefix   string `json:"prefix,omitempty"`
+}
+
+// ResolvedSegmentList is a segment list resolved against a topology,
+// segments which could not be resolved are reported in Errors.
+type ResolvedSegmentList struct {
+	Weight   uint32   `json:"weight,omitempty"`
+	Topology *Key     `json:"topology,omitempty"`
+	Hops     []*Hop   `json:"hops"`
+	Errors   []string `json:"errors,omitempty"`
+}
+
+// ResolvedSRPolicy is an SR Policy with its segment lists resolved.
+type ResolvedSRPolicy struct {
+	Action        string                 `json:"action,omitempty"`
+	Hash          string                 `json:"hash,omitempty"`
+	RouterIP      string                 `json:"router_ip,omitempty"`
+	PeerIP        string                 `json:"peer_ip,omitempty"`
+	Timestamp     string                 `json:"timestamp,omitempty"`
+	Distinguisher uint32                 `json:"distinguisher,omitempty"`
+	Color         uint32                 `json:"color,omitempty"`
+	Endpoint      string                 `json:"endpoint,omitempty"`
+	PolicyName    string                 `json:"policy_name,omitempty"`
+	SegmentLists  []*ResolvedSegmentList `json:"segment_lists,omitempty"`
+}
+
+// ResolveSRPolicy resolves the segment lists of an SR Policy.
+func (t *Topology) ResolveSRPolicy(m *message.SRPolicy) *ResolvedSRPolicy {
+	rp := &ResolvedSRPolicy{
+		Action:        m.Action,
+		Hash:          m.Hash,
+		RouterIP:      m.RouterIP,
+		PeerIP:        m.PeerIP,
+		Timestamp:     m.Timestamp,
+		Distinguisher: m.Distinguisher,
+		Color:         m.Color,
+		PolicyName:    m.PolicyName,
+	}
+	if len(m.Endpoint) != 0 {
+		rp.Endpoint = net.IP(m.Endpoint).String()
+	}
+	if m.Action == "del" {
+		return rp
+	}
+	for _, sl := range m.SegmentList {
+		rp.SegmentLists = append(rp.SegmentLists, t.ResolveSegmentList(sl))
+	}
+
+	return rp
+}
+
+func (t *Topology) publishSRPolicy(m *message.SRPolicy) {
+	if t.publisher == nil || (m.Action != "del" && len(m.SegmentList) == 0) {
+		return
+	}
+	b, err := json.Marshal(t.ResolveSRPolicy(m))
+	if err != nil {
+		glog.Errorf("failed to marshal resolved SR Policy with error: %+v", err)
+		return
+	}
+	if err := t.publisher.PublishMessage(bmp.SRPolicyResolvedMsg, []byte(m.Hash), b); err != nil {
+		glog.Errorf("failed to publish resolved SR Policy with error: %+v", err)
+	}
+}
+
+// ResolveSegmentList maps every segment of sl to the node, adjacency or
+// prefix owning it. The segment list is resolved in each known topology and
+// the topology resolving the most segments is reported.
+func (t *Topology) ResolveSegmentList(sl *srpolicy.SegmentList) *ResolvedSegmentList {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	keys := make([]Key, 0, len(t.graphs))
+	for k := range t.graphs {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].String() < keys[j].String()
+	})
+	var best *ResolvedSegmentList
+	for _, k := range keys {
+		r := t.graphs[k].resolveSegmentList(sl)
+		if best == nil || len(r.Errors) < len(best.Errors) {
+			key := k
+			r.Topology = &key
+			best = r
+		}
+	}
+	if best == nil {
+		best = &ResolvedSegmentList{
+			Hops:   []*Hop{},
+			Errors: []string{"no BGP-LS topology available"},
+		}
+	}
+	if sl.Weight != nil {
+		best.Weight = sl.Weight.Weight
+	}
+
+	return best
+}
+
+var segmentTypeNames = map[srpolicy.SegmentType]string{
+	srpolicy.TypeA: "A",
+	srpolicy.TypeB: "B",
+	srpolicy.TypeC: "C",
+	srpolicy.TypeD: "D",
+	srpolicy.TypeE: "E",
+	srpolicy.TypeF: "F",
+	srpolicy.TypeG: "G",
+	srpolicy.TypeH: "H",
+	srpolicy.TypeI: "I",
+	srpolicy.TypeJ: "J",
+	srpolicy.TypeK: "K",
+}
+
+// resolveSegmentList resolves the segments in order, SR-MPLS labels are
+// interpreted in the label space of the node reached by the previous segment
+// or, for the first segment, of any node.
+func (g *graph) resolveSegmentList(sl *srpolicy.SegmentList) *ResolvedSegmentList {
+	r := &ResolvedSegmentList{
+		Hops: make([]*Hop, 0, len(sl.Segment)),
+	}
+	ctx := ""
+	for i, s := range sl.Segment {
+		hop, err := g.resolveSegment(s, ctx)
+		if err != nil {
+			r.Errors = append(r.Errors, fmt.Sprintf("segment %d: %v", i, err))
+			// The label space of the following segment is unknown
+			ctx = ""
+			continue
+		}
+		hop.Segment = i
+		hop.SegmentType = segmentTypeNames[s.GetType()]
+		if e, ok := g.nodes[hop.Node]; ok {
+			hop.NodeName = e.node.Name
+		}
+		r.Hops = append(r.Hops, hop)
+		ctx = hop.Node
+		if hop.Link != nil {
+			ctx = hop.Link.RemoteNode
+		}
+	}
+
+	return r
+}
+
+func (g *graph) resolveSegment(s srpolicy.Segment, ctx string) (*Hop, error) {
+	// Segment interfaces overlap, Type G segments implement TypeHSegment, the
+	// segment type selects the interface.
+	switch s.GetType() {
+	case srpolicy.TypeA:
+		if seg, ok := s.(srpolicy.TypeASegment); ok {
+			return g.resolveLabel(seg.GetLabel(), ctx)
+		}
+	case srpolicy.TypeB:
+		if seg, ok := s.(srpolicy.TypeBSegment); ok {
+			return g.resolveSRv6SID(seg.GetSRv6SID())
+		}
+	case srpolicy.TypeC:
+		if seg, ok := s.(srpolicy.TypeCSegment); ok {
+			return g.resolveNodeSegment(seg.GetIPv4Address(), seg.GetSID)
+		}
+	case srpolicy.TypeD:
+		if seg, ok := s.(srpolicy.TypeDSegment); ok {
+			return g.resolveNodeSegment(seg.GetIPv6Address(), seg.GetSID)
+		}
+	case srpolicy.TypeE:
+		if seg, ok := s.(srpolicy.TypeESegment); ok {
+			hop, err := g.resolveAdjacencyByID(seg.GetIPv4Address(), seg.GetLocalInterfaceID(), 0)
+			return withLabel(hop, err, seg.GetSID)
+		}
+	case srpolicy.TypeF:
+		if seg, ok := s.(srpolicy.TypeFSegment); ok {
+			hop, err := g.resolveAdjacencyByAddr(seg.GetLocalIPv4Address(), seg.GetRemoteIPv4Address())
+			return withLabel(hop, err, seg.GetSID)
+		}
+	case srpolicy.TypeG:
+		if seg, ok := s.(srpolicy.TypeGSegment); ok {
+			hop, err := g.resolveAdjacencyByID(seg.GetLocalIPv6Address(), seg.GetLocalInterfaceID(), seg.GetRemoteInterfaceID())
+			return withLabel(hop, err, seg.GetSID)
+		}
+	case srpolicy.TypeH:
+		if seg, ok := s.(srpolicy.TypeHSegment); ok {
+			hop, err := g.resolveAdjacencyByAddr(seg.GetLocalIPv6Address(), seg.GetRemoteIPv6Address())
+			return withLabel(hop, err, seg.GetSID)
+		}
+	case srpolicy.TypeI:
+		if seg, ok := s.(srpolicy.TypeISegment); ok {
+			if sid, ok := seg.GetSRv6SID(); ok {
+				return g.resolveSRv6SID(sid)
+			}
+			return g.resolveNodeSegment(seg.GetIPv6NodeAddress(), nil)
+		}
+	case srpolicy.TypeJ:
+		if seg, ok := s.(srpolicy.TypeJSegment); ok {
+			hop, err := g.resolveAdjacencyByID(seg.GetLocalIPv6Address(), seg.GetLocalInterfaceID(), seg.GetRemoteInterfaceID())
+			return withSRv6SID(hop, err, seg.GetSRv6SID)
+		}
+	case srpolicy.TypeK:
+		if seg, ok := s.(srpolicy.TypeKSegment); ok {
+			hop, err := g.resolveAdjacencyByAddr(seg.GetLocalIPv6Address(), seg.GetRemoteIPv6Address())
+			return withSRv6SID(hop, err, seg.GetSRv6SID)
+		}
+	}
+
+	return nil, fmt.Errorf("unsupported segment type %d", s.GetType())
+}
+
+// withLabel sets the label of the optional SR-MPLS SID of a resolved hop, the
+// SID is encoded as a label stack entry (RFC 9831 §2.4.4.2).
+func withLabel(hop *Hop, err error, sid func() (uint32, bool)) (*Hop, error) {
+	if err != nil {
+		return nil, err
+	}
+	if v, ok := sid(); ok {
+		hop.SID = strconv.FormatUint(uint64(v>>12), 10)
+	}
+
+	return hop, nil
+}
+
+func withSRv6SID(hop *Hop, err error, sid func() ([]byte, bool)) (*Hop, error) {
+	if err != nil {
+		return nil, err
+	}
+	if v, ok := sid(); ok {
+		hop.SID = net.IP(v).String()
+	}
+
+	return hop, nil
+}
+
+// labelIndex returns the index of label in the label block made of ranges.
+func labelIndex(ranges []*LabelRange, label uint32) (uint32, bool) {
+	var offset uint32
+	for _, r := range ranges {
+		if label >= r.Base && label-r.Base < r.Size {
+			return offset + label - r.Base, true
+		}
+		offset += r.Size
+	}
+
+	return 0, false
+}
+
+func prefixSIDIsLabel(psid *sr.PrefixSIDTLV) bool {
+	switch f := psid.Flags.(type) {
+	case *sr.ISISFlags:
+		return f.VFlag
+	case *sr.OSPFFlags:
+		return f.VFlag
+	case nil:
+		return false
+	default:
+		return f.GetPrefixSIDFlagByte()&0x08 == 0x08
+	}
+}
+
+func adjSIDIsLabel(asid *sr.AdjacencySIDTLV) bool {
+	switch f := asid.Flags.(type) {
+	case *sr.AdjISISFlags:
+		return f.VFlag
+	case *sr.AdjOSPFFlags:
+		return f.VFlag
+	}
+	// Without flags a 3 octets label is the common encoding
+	return true
+}
+
+// resolveLabel finds the Prefix SID or Adjacency SID of label in the label
+// space of node ctx, or of any node when ctx is empty.
+func (g *graph) resolveLabel(label uint32, ctx string) (*Hop, error) {
+	candidates := make([]*Node, 0)
+	if e, ok := g.nodes[ctx]; ok {
+		candidates = append(candidates, e.node)
+	} else {
+		for _, e := range g.nodes {
+			candidates = append(candidates, e.node)
+		}
+	}
+	sid := strconv.FormatUint(uint64(label), 10)
+	hops := make(map[string]*Hop)
+	for _, e := range g.prefixes {
+		p := e.prefix
+		for _, psid := range p.PrefixSID {
+			match := false
+			if prefixSIDIsLabel(psid) {
+				match = psid.SID == label
+			} else {
+				for _, n := range candidates {
+					if idx, ok := labelIndex(n.SRGB, label); ok && idx == psid.SID {
+						match = true
+						break
+					}
+				}
+			}
+			if match {
+				prefix := fmt.Sprintf("%s/%d", p.Prefix, p.PrefixLen)
+				hops["prefix|"+p.Node+"|"+prefix] = &Hop{Kind: "prefix", SID: sid, Node: p.Node, Prefix: prefix}
+			}
+		}
+	}
+	for _, e := range g.links {
+		l := e.link
+		local, ok := g.nodes[l.LocalNode]
+		if ctx != "" && l.LocalNode != ctx {
+			continue
+		}
+		for _, asid := range l.AdjSID {
+			match := false
+			if adjSIDIsLabel(asid) {
+				match = asid.SID == label
+			} else if ok {
+				idx, found := labelIndex(local.node.SRLB, label)
+				match = found && idx == asid.SID
+			}
+			if match {
+				hops["adjacency|"+linkID(l)] = &Hop{Kind: "adjacency", SID: sid, Node: l.LocalNode, Link: l}
+			}
+		}
+	}
+
+	return uniqueHop(hops, "label "+sid)
+}
+
+// resolveSRv6SID finds the node or adjacency instantiating sid, or the node
+// advertising the longest SRv6 Locator covering it.
+func (g *graph) resolveSRv6SID(b []byte) (*Hop, error) {
+	sid := net.IP(b)
+	if len(sid) != net.IPv6len {
+		return nil, fmt.Errorf("invalid SRv6 SID length %d", len(b))
+	}
+	hops := make(map[string]*Hop)
+	for _, e := range g.sids {
+		if sid.Equal(net.ParseIP(e.sid.SID)) {
+			hops["srv6_sid|"+e.sid.Node] = &Hop{Kind: "srv6_sid", SID: sid.String(), Node: e.sid.Node}
+		}
+	}
+	for _, e := range g.links {
+		for _, s := range e.link.SRv6EndXSID {
+			if sid.Equal(net.ParseIP(s)) {
+				hops["adjacency|"+linkID(e.link)] = &Hop{Kind: "adjacency", SID: sid.String(), Node: e.link.LocalNode, Link: e.link}
+			}
+		}
+	}
+	if len(hops) != 0 {
+		return uniqueHop(hops, "SRv6 SID "+sid.String())
+	}
+	var longest int32 = -1
+	for _, e := range g.prefixes {
+		p := e.prefix
+		if !p.SRv6Locator || p.PrefixLen < longest {
+			continue
+		}
+		_, locator, err := net.ParseCIDR(fmt.Sprintf("%s/%d", p.Prefix, p.PrefixLen))
+		if err != nil || !locator.Contains(sid) {
+			continue
+		}
+		if p.PrefixLen > longest {
+			hops = make(map[string]*Hop)
+			longest = p.PrefixLen
+		}
+		hops["srv6_locator|"+p.Node] = &Hop{Kind: "srv6_locator", SID: sid.String(), Node: p.Node, Prefix: locator.String()}
+	}
+
+	return uniqueHop(hops, "SRv6 SID "+sid.String())
+}
+
+// resolveNode returns the node owning address b: its Router ID, a host prefix
+// or a link address.
+func (g *graph) resolveNode(b []byte) (string, error) {
+	addr := net.IP(b)
+	if len(addr) != net.IPv4len && len(addr) != net.IPv6len {
+		return "", fmt.Errorf("invalid node address length %d", len(b))
+	}
+	nodes := make(map[string]struct{})
+	for id, e := range g.nodes {
+		if addr.Equal(net.ParseIP(e.node.RouterID)) {
+			nodes[id] = struct{}{}
+		}
+	}
+	if len(nodes) == 0 {
+		for _, e := range g.prefixes {
+			p := e.prefix
+			if int(p.PrefixLen) == len(addr)*8 && addr.Equal(net.ParseIP(p.Prefix)) {
+				nodes[p.Node] = struct{}{}
+			}
+		}
+	}
+	if len(nodes) == 0 {
+		for _, e := range g.links {
+			if addr.Equal(net.ParseIP(e.link.LocalLinkIP)) {
+				nodes[e.link.LocalNode] = struct{}{}
+			}
+		}
+	}
+	switch len(nodes) {
+	case 0:
+		return "", fmt.Errorf("node address %s not found", addr)
+	case 1:
+		for id := range nodes {
+			return id, nil
+		}
+	}
+
+	return "", fmt.Errorf("node address %s is ambiguous, owned by %d nodes", addr, len(nodes))
+}
+
+func (g *graph) resolveNodeSegment(b []byte, sid func() (uint32, bool)) (*Hop, error) {
+	id, err := g.resolveNode(b)
+	if err != nil {
+		return nil, err
+	}
+	hop := &Hop{Kind: "node", Node: id}
+	if sid == nil {
+		return hop, nil
+	}
+
+	return withLabel(hop, nil, sid)
+}
+
+// resolveAdjacencyByID finds the adjacency of the node owning address b with
+// local interface ID lid and, when not 0, remote interface ID rid.
+func (g *graph) resolveAdjacencyByID(b []byte, lid, rid uint32) (*Hop, error) {
+	id, err := g.resolveNode(b)
+	if err != nil {
+		return nil, err
+	}
+	hops := make(map[string]*Hop)
+	for _, e := range g.links {
+		l := e.link
+		if l.LocalNode != id || l.LocalLinkID != lid || (rid != 0 && l.RemoteLinkID != rid) {
+			continue
+		}
+		hops[linkID(l)] = &Hop{Kind: "adjacency", Node: id, Link: l}
+	}
+
+	return uniqueHop(hops, fmt.Sprintf("adjacency %s interface %d", net.IP(b), lid))
+}
+
+// resolveAdjacencyByAddr finds the adjacency from local address to remote
+// address.
+func (g *graph) resolveAdjacencyByAddr(local, remote []byte) (*Hop, error) {
+	hops := make(map[string]*Hop)
+	for _, e := range g.links {
+		l := e.link
+		if !net.IP(local).Equal(net.ParseIP(l.LocalLinkIP)) || !net.IP(remote).Equal(net.ParseIP(l.RemoteLinkIP)) {
+			continue
+		}
+		hops[linkID(l)] = &Hop{Kind: "adjacency", Node: l.LocalNode, Link: l}
+	}
+
+	return uniqueHop(hops, fmt.Sprintf("adjacency %s to %s", net.IP(local), net.IP(remote)))
+}
+
+func uniqueHop(hops map[string]*Hop, what string) (*Hop, error) {
+	switch len(hops) {
+	case 0:
+		return nil, fmt.Errorf("%s not found", what)
+	case 1:
+		for _, hop := range hops {
+			return hop, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%s is ambiguous, %d matches", what, len(hops))
+}
@@ -0,0 +1,174 @@
+package topology
+
+import (
+	"encoding/json"
+	"net"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/sbezverk/gobmp/pkg/base"
+	"github.com/sbezverk/gobmp/pkg/bgpls"
+	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/message"
+	"github.com/sbezverk/gobmp/pkg/sr"
+	"github.com/sbezverk/gobmp/pkg/srpolicy"
+	"github.com/sbezverk/gobmp/pkg/srv6"
+)
+
+type capture struct {
+	policies []*ResolvedSRPolicy
+}
+
+func (c *capture) PublishMessage(msgType int, msgHash []byte, msg []byte) error {
+	if msgType != bmp.SRPolicyResolvedMsg {
+		return nil
+	}
+	p := &ResolvedSRPolicy{}
+	if err := json.Unmarshal(msg, p); err != nil {
+		return err
+	}
+	c.policies = append(c.policies, p)
+	return nil
+}
+
+func (c *capture) Stop() {}
+
+// srTopology builds the SR enabled line 1 - 2 - 3, every node has the SRGB
+// 16000-23999 and the Prefix SID index of its ID, adjacencies carry the label
+// 240<local><remote> and node 3 advertises the SRv6 locator fc00:0:3::/48.
+func srTopology(t *Topology) {
+	for i, id := range []string{"1", "2", "3"} {
+		n := node(id)
+		n.SRCapabilities = &sr.Capability{SubTLV: []sr.CapabilitySubTLV{{Range: 8000, SID: 16000}}}
+		t.Observe(bmp.LSNodeMsg, n)
+		t.Observe(bmp.LSPrefixMsg, &message.LSPrefix{
+			Action:         "add",
+			RouterIP:       "10.0.0.1",
+			PeerIP:         "192.168.0.1",
+			ProtocolID:     base.ISISL2,
+			IGPRouterID:    id,
+			Prefix:         "10.0.0." + id,
+			PrefixLen:      32,
+			PrefixAttrTLVs: &bgpls.PrefixAttrTLVs{LSPrefixSID: []*sr.PrefixSIDTLV{{Flags: &sr.ISISFlags{NFlag: true}, SID: uint32(i + 1)}}},
+		})
+	}
+	t.Observe(bmp.LSPrefixMsg, &message.LSPrefix{
+		Action:      "add",
+		RouterIP:    "10.0.0.1",
+		PeerIP:      "192.168.0.1",
+		ProtocolID:  base.ISISL2,
+		IGPRouterID: "3",
+		Prefix:      "fc00:0:3::",
+		PrefixLen:   48,
+		SRv6Locator: &srv6.LocatorTLV{},
+	})
+	for _, l := range append(biLink("1", "2", 10, 0, 0, 0), biLink("2", "3", 10, 0, 0, 0)...) {
+		l.LocalLinkIP = "10.1." + l.IGPRouterID + l.RemoteIGPRouterID + "." + l.IGPRouterID
+		l.RemoteLinkIP = "10.1." + l.IGPRouterID + l.RemoteIGPRouterID + "." + l.RemoteIGPRouterID
+		label := uint32(24000 + 10*int(l.IGPRouterID[0]-'0') + int(l.RemoteIGPRouterID[0]-'0'))
+		l.LSAdjacencySID = []*sr.AdjacencySIDTLV{{Flags: &sr.AdjISISFlags{VFlag: true, LFlag: true}, SID: label}}
+		t.Observe(bmp.LSLinkMsg, l)
+	}
+}
+
+func segment(t *testing.T, f func([]byte) (srpolicy.Segment, error), b []byte) srpolicy.Segment {
+	t.Helper()
+	s, err := f(b)
+	if err != nil {
+		t.Fatalf("failed to build segment: %v", err)
+	}
+	return s
+}
+
+func labelSegment(t *testing.T, label uint32) srpolicy.Segment {
+	v := label << 12
+	return segment(t, srpolicy.UnmarshalTypeASegment, []byte{0, 0, byte(v >> 24), byte(v >> 16), byte(v >> 8), byte(v)})
+}
+
+func TestResolveSegmentList(t *testing.T) {
+	topo := New(nil)
+	srTopology(topo)
+	sid := net.ParseIP("fc00:0:3:e000::")
+	sl := &srpolicy.SegmentList{
+		Weight: &srpolicy.Weight{Weight: 10},
+		Segment: []srpolicy.Segment{
+			labelSegment(t, 16002),
+			labelSegment(t, 24023),
+			segment(t, srpolicy.UnmarshalTypeCSegment, []byte{0, 0, 10, 0, 0, 1}),
+			segment(t, srpolicy.UnmarshalTypeFSegment, []byte{0, 0, 10, 1, 12, 1, 10, 1, 12, 2, 0x05, 0xdc, 0xc0, 0}),
+			segment(t, srpolicy.UnmarshalTypeBSegment, append([]byte{0, 0}, sid...)),
+			labelSegment(t, 16009),
+		},
+	}
+	r := topo.ResolveSegmentList(sl)
+	if r.Weight != 10 || r.Topology == nil || *r.Topology != isis {
+		t.Fatalf("ResolveSegmentList() weight %d topology %v", r.Weight, r.Topology)
+	}
+	type hop struct {
+		kind, node, sid, prefix, link string
+	}
+	want := []hop{
+		{kind: "prefix", node: "2", sid: "16002", prefix: "10.0.0.2/32"},
+		{kind: "adjacency", node: "2", sid: "24023", link: "2>3"},
+		{kind: "node", node: "1"},
+		{kind: "adjacency", node: "1", sid: "24012", link: "1>2"},
+		{kind: "srv6_locator", node: "3", sid: "fc00:0:3:e000::", prefix: "fc00:0:3::/48"},
+	}
+	got := make([]hop, 0, len(r.Hops))
+	for _, h := range r.Hops {
+		g := hop{kind: h.Kind, node: h.Node, sid: h.SID, prefix: h.Prefix}
+		if h.Link != nil {
+			g.link = h.Link.LocalNode + ">" + h.Link.RemoteNode
+		}
+		got = append(got, g)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("hops = %+v, want %+v", got, want)
+	}
+	if len(r.Errors) != 1 || !strings.HasPrefix(r.Errors[0], "segment 5:") {
+		t.Fatalf("errors = %v, want segment 5 unresolved", r.Errors)
+	}
+}
+
+func TestResolveSegmentListAmbiguousAdjacency(t *testing.T) {
+	topo := New(nil)
+	srTopology(topo)
+	// Both directions of a link may use the same local label, without label
+	// space context the first segment cannot be resolved.
+	for _, l := range biLink("1", "3", 10, 0, 0, 0) {
+		l.LSAdjacencySID = []*sr.AdjacencySIDTLV{{Flags: &sr.AdjISISFlags{VFlag: true, LFlag: true}, SID: 24999}}
+		topo.Observe(bmp.LSLinkMsg, l)
+	}
+	r := topo.ResolveSegmentList(&srpolicy.SegmentList{Segment: []srpolicy.Segment{labelSegment(t, 24999), labelSegment(t, 16003), labelSegment(t, 24999)}})
+	if len(r.Errors) != 1 || !strings.Contains(r.Errors[0], "ambiguous") {
+		t.Fatalf("errors = %v, want ambiguous first segment", r.Errors)
+	}
+	if len(r.Hops) != 2 || r.Hops[1].Link == nil || r.Hops[1].Link.LocalNode != "3" {
+		t.Fatalf("hops = %+v, want label 24999 resolved in node 3 label space", r.Hops)
+	}
+}
+
+func TestObserveSRPolicy(t *testing.T) {
+	c := &capture{}
+	topo := New(c)
+	srTopology(topo)
+	topo.Observe(bmp.SRPolicyMsg, &message.SRPolicy{
+		Action:      "add",
+		Hash:        "abc",
+		Color:       100,
+		Endpoint:    net.ParseIP("10.0.0.3").To4(),
+		SegmentList: []*srpolicy.SegmentList{{Segment: []srpolicy.Segment{labelSegment(t, 16003)}}},
+	})
+	topo.Observe(bmp.SRPolicyMsg, &message.SRPolicy{Action: "del", Hash: "abc"})
+	if len(c.policies) != 2 {
+		t.Fatalf("published %d resolved policies, want 2", len(c.policies))
+	}
+	p := c.policies[0]
+	if p.Color != 100 || p.Endpoint != "10.0.0.3" || len(p.SegmentLists) != 1 || len(p.SegmentLists[0].Hops) != 1 || p.SegmentLists[0].Hops[0].Node != "3" {
+		t.Fatalf("resolved policy = %+v", p)
+	}
+	if c.policies[1].Action != "del" || len(c.policies[1].SegmentLists) != 0 {
+		t.Fatalf("withdrawn policy = %+v", c.policies[1])
+	}
+}
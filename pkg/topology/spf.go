@@ -0,0 +1,384 @@
+package topology
+
+import (
+	"container/heap"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/sbezverk/gobmp/pkg/bgpls"
+	"github.com/sbezverk/gobmp/pkg/message"
+)
+
+// MetricType defines the link metric used by the shortest path computation,
+// values match Flexible Algorithm Definition metric types (RFC 9350 §5.1).
+type MetricType uint8
+
+const (
+	// MetricIGP selects the IGP metric
+	MetricIGP MetricType = 0
+	// MetricDelay selects the Min Unidirectional Link Delay
+	MetricDelay MetricType = 1
+	// MetricTE selects the Traffic Engineering Default Metric
+	MetricTE MetricType = 2
+)
+
+const (
+	// flexAlgoMin is the first Flexible Algorithm number
+	flexAlgoMin = 128
+	// aslaXBit is the Flexible Algorithm bit of the Standard Application
+	// Identifier Bit Mask (RFC 9479 §4.1)
+	aslaXBit = 0x10
+	// delayMask strips the Anomalous flag off the delay values (RFC 8571)
+	delayMask = 0x00ffffff
+)
+
+// PathOptions defines the constraints of a path computation.
+type PathOptions struct {
+	// Metric is the metric to minimize, ignored for Flexible Algorithms which
+	// use the metric type of their definition
+	Metric MetricType
+	// FlexAlgo when 128 or above restricts the computation to the Flexible
+	// Algorithm topology
+	FlexAlgo uint8
+}
+
+// Path is a shortest path, Nodes lists the IGP Router IDs from source to
+// destination and Links the traversed links.
+type Path struct {
+	Nodes []string `json:"nodes"`
+	Links []*Link  `json:"links"`
+	Cost  uint64   `json:"cost"`
+}
+
+// Path computes the shortest path from src to dst in topology k, nodes are
+// identified by IGP Router ID, name or Router ID.
+func (t *Topology) Path(k Key, src, dst string, opts *PathOptions) (*Path, error) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	g, ok := t.graphs[k]
+	if !ok {
+		return nil, fmt.Errorf("topology %s not found", k)
+	}
+	from := g.resolve(src)
+	if from == "" {
+		return nil, fmt.Errorf("node %s not found in topology %s", src, k)
+	}
+	to := g.resolve(dst)
+	if to == "" {
+		return nil, fmt.Errorf("node %s not found in topology %s", dst, k)
+	}
+	tree, err := g.spf(from, opts)
+	if err != nil {
+		return nil, err
+	}
+	p, ok := tree[to]
+	if !ok {
+		return nil, fmt.Errorf("no path from %s to %s in topology %s", src, dst, k)
+	}
+
+	return p, nil
+}
+
+// SPF computes the shortest path tree rooted at src in topology k, the result
+// maps the IGP Router ID of every reachable node to its path.
+func (t *Topology) SPF(k Key, src string, opts *PathOptions) (map[string]*Path, error) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	g, ok := t.graphs[k]
+	if !ok {
+		return nil, fmt.Errorf("topology %s not found", k)
+	}
+	from := g.resolve(src)
+	if from == "" {
+		return nil, fmt.Errorf("node %s not found in topology %s", src, k)
+	}
+
+	return g.spf(from, opts)
+}
+
+// constraints are the resolved constraints of a computation.
+type constraints struct {
+	metric MetricType
+	// flexAlgo is 0 when the computation is not a Flexible Algorithm one
+	flexAlgo uint8
+	fad      *bgpls.FlexAlgoDefinition
+}
+
+func (g *graph) constraints(opts *PathOptions) (*constraints, error) {
+	c := &constraints{}
+	if opts == nil {
+		return c, nil
+	}
+	c.metric = opts.Metric
+	if opts.FlexAlgo < flexAlgoMin {
+		return c, nil
+	}
+	c.flexAlgo = opts.FlexAlgo
+	// RFC 9350 §5.3, the definition with the highest priority wins, ties are
+	// broken by the highest advertising node identifier.
+	var winner string
+	for id, e := range g.nodes {
+		if !participates(e.node, c.flexAlgo) {
+			continue
+		}
+		for _, fad := range e.node.FlexAlgoDefinition {
+			if fad.FlexAlgorithm != c.flexAlgo {
+				continue
+			}
+			if c.fad == nil || fad.Priority > c.fad.Priority || (fad.Priority == c.fad.Priority && id > winner) {
+				c.fad = fad
+				winner = id
+			}
+		}
+	}
+	if c.fad == nil {
+		return nil, fmt.Errorf("no definition of flexible algorithm %d", c.flexAlgo)
+	}
+	if c.fad.CalculationType != 0 {
+		return nil, fmt.Errorf("unsupported calculation type %d of flexible algorithm %d", c.fad.CalculationType, c.flexAlgo)
+	}
+	c.metric = MetricType(c.fad.MetricType)
+
+	return c, nil
+}
+
+func participates(n *Node, algo uint8) bool {
+	for _, a := range n.SRAlgorithm {
+		if a == int(algo) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// cost returns the cost of link l and false when l must be pruned.
+func (c *constraints) cost(l *Link) (uint64, bool) {
+	te, delay, ag, eag, srlg := l.TEMetric, l.Delay, l.AdminGroup, l.ExtAdminGroup, l.SRLG
+	if c.flexAlgo != 0 && l.FlexAlgo != nil {
+		te, delay, ag, eag, srlg = l.FlexAlgo.TEMetric, l.FlexAlgo.Delay, l.FlexAlgo.AdminGroup, l.FlexAlgo.ExtAdminGroup, l.FlexAlgo.SRLG
+	}
+	if c.fad != nil && c.fad.SubTLV != nil && !c.admit(affinity(ag, eag), srlg) {
+		return 0, false
+	}
+	switch c.metric {
+	case MetricIGP:
+		return uint64(l.IGPMetric), true
+	case MetricDelay:
+		if delay == 0 {
+			return 0, false
+		}
+		return uint64(delay), true
+	case MetricTE:
+		if te == 0 {
+			return 0, false
+		}
+		return uint64(te), true
+	}
+
+	return 0, false
+}
+
+// affinity returns the link affinity as a bit mask of 32 bits words, the
+// Extended Administrative Group when advertised, otherwise the Administrative
+// Group (RFC 9350 §6.2).
+func affinity(ag uint32, eag []uint32) []uint32 {
+	if len(eag) == 0 {
+		return []uint32{ag}
+	}
+	words := make([]uint32, 0)
+	for _, g := range eag {
+		for int(g/32) >= len(words) {
+			words = append(words, 0)
+		}
+		words[g/32] |= 1 << (g % 32)
+	}
+
+	return words
+}
+
+// admit applies the Flexible Algorithm Definition affinity and SRLG rules of
+// RFC 9350 §6.2.
+func (c *constraints) admit(aff []uint32, srlg []uint32) bool {
+	s := c.fad.SubTLV
+	if len(s.ExcludeAny) != 0 && intersects(aff, s.ExcludeAny) {
+		return false
+	}
+	if len(s.IncludeAny) != 0 && !intersects(aff, s.IncludeAny) {
+		return false
+	}
+	if len(s.IncludeAll) != 0 {
+		for i, w := range s.IncludeAll {
+			var a uint32
+			if i < len(aff) {
+				a = aff[i]
+			}
+			if a&w != w {
+				return false
+			}
+		}
+	}
+	for _, x := range s.ExcludeSRLG {
+		for _, v := range srlg {
+			if v == x {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func intersects(a, b []uint32) bool {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if a[i]&b[i] != 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+type item struct {
+	id   string
+	cost uint64
+}
+
+type queue []item
+
+func (q queue) Len() int { return len(q) }
+func (q queue) Less(i, j int) bool {
+	if q[i].cost != q[j].cost {
+		return q[i].cost < q[j].cost
+	}
+	return q[i].id < q[j].id
+}
+func (q queue) Swap(i, j int)       { q[i], q[j] = q[j], q[i] }
+func (q *queue) Push(x interface{}) { *q = append(*q, x.(item)) }
+func (q *queue) Pop() interface{} {
+	old := *q
+	it := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return it
+}
+
+// spf runs Dijkstra from node src.
+func (g *graph) spf(src string, opts *PathOptions) (map[string]*Path, error) {
+	c, err := g.constraints(opts)
+	if err != nil {
+		return nil, err
+	}
+	if c.flexAlgo != 0 {
+		if e, ok := g.nodes[src]; !ok || !participates(e.node, c.flexAlgo) {
+			return nil, fmt.Errorf("node %s does not participate in flexible algorithm %d", src, c.flexAlgo)
+		}
+	}
+	// A link is used when the remote node advertises a link back, the two-way
+	// connectivity check, the nodes pairs of the links are indexed once.
+	adj := make(map[string][]*Link)
+	links := make(map[[2]string]bool, len(g.links))
+	for _, e := range g.links {
+		l := e.link
+		links[[2]string{l.LocalNode, l.RemoteNode}] = true
+		if c.flexAlgo != 0 {
+			local, ok := g.nodes[l.LocalNode]
+			if !ok || !participates(local.node, c.flexAlgo) {
+				continue
+			}
+			remote, ok := g.nodes[l.RemoteNode]
+			if !ok || !participates(remote.node, c.flexAlgo) {
+				continue
+			}
+		}
+		adj[l.LocalNode] = append(adj[l.LocalNode], l)
+	}
+	tree := map[string]*Path{src: {Nodes: []string{src}, Links: []*Link{}}}
+	done := make(map[string]bool)
+	q := &queue{{id: src}}
+	for q.Len() > 0 {
+		it := heap.Pop(q).(item)
+		if done[it.id] {
+			continue
+		}
+		done[it.id] = true
+		p := tree[it.id]
+		for _, l := range adj[it.id] {
+			if done[l.RemoteNode] {
+				continue
+			}
+			cost, ok := c.cost(l)
+			if !ok || !links[[2]string{l.RemoteNode, l.LocalNode}] {
+				continue
+			}
+			total := p.Cost + cost
+			if cur, ok := tree[l.RemoteNode]; ok && (cur.Cost < total || (cur.Cost == total && linkID(cur.Links[len(cur.Links)-1]) <= linkID(l))) {
+				continue
+			}
+			np := &Path{
+				Nodes: make([]string, len(p.Nodes), len(p.Nodes)+1),
+				Links: make([]*Link, len(p.Links), len(p.Links)+1),
+				Cost:  total,
+			}
+			copy(np.Nodes, p.Nodes)
+			copy(np.Links, p.Links)
+			np.Nodes = append(np.Nodes, l.RemoteNode)
+			np.Links = append(np.Links, l)
+			tree[l.RemoteNode] = np
+			heap.Push(q, item{id: l.RemoteNode, cost: total})
+		}
+	}
+
+	return tree, nil
+}
+
+// flexAlgoLinkAttributes returns the Application Specific Link Attributes
+// advertised for Flexible Algorithm, nil when there are none.
+func flexAlgoLinkAttributes(aslas []*bgpls.AppSpecLinkAttr) *LinkAttributes {
+	for _, asla := range aslas {
+		if len(asla.SAIBM) == 0 || asla.SAIBM[0]&aslaXBit == 0 {
+			continue
+		}
+		attr := &LinkAttributes{}
+		for _, tlv := range asla.SubTLV {
+			switch tlv.Type {
+			case 1088:
+				if len(tlv.Value) >= 4 {
+					attr.AdminGroup = binary.BigEndian.Uint32(tlv.Value)
+				}
+			case 1173:
+				if eag, err := bgpls.UnmarshalExtAdminGroup(tlv.Value); err == nil {
+					attr.ExtAdminGroup = eag
+				}
+			case 1092:
+				if len(tlv.Value) >= 4 {
+					attr.TEMetric = binary.BigEndian.Uint32(tlv.Value)
+				}
+			case 1096:
+				for p := 0; p+4 <= len(tlv.Value); p += 4 {
+					attr.SRLG = append(attr.SRLG, binary.BigEndian.Uint32(tlv.Value[p:]))
+				}
+			case 1114:
+				if len(tlv.Value) >= 4 && attr.Delay == 0 {
+					attr.Delay = binary.BigEndian.Uint32(tlv.Value) & delayMask
+				}
+			case 1115:
+				if len(tlv.Value) >= 4 {
+					attr.Delay = binary.BigEndian.Uint32(tlv.Value) & delayMask
+				}
+			}
+		}
+		return attr
+	}
+
+	return nil
+}
+
+// linkDelay returns the delay used by the computations, the Min Unidirectional
+// Link Delay when advertised, otherwise the Unidirectional Link Delay.
+func linkDelay(m *message.LSLink) uint32 {
+	if len(m.UnidirLinkDelayMinMax) != 0 {
+		return m.UnidirLinkDelayMinMax[0] & delayMask
+	}
+
+	return m.UnidirLinkDelay & delayMask
+}
@@ -0,0 +1,661 @@
+// Package topology maintains IGP topology graphs built from the BGP-LS Node,
+// Link, Prefix and SRv6 SID messages produced by the collector, publishes
+// topology change events and computes shortest paths on the IGP, TE and delay
+// metrics, including Flexible Algorithm (RFC 9350) constrained topologies.
+package topology
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+
+	"github.com/golang/glog"
+	"github.com/sbezverk/gobmp/pkg/base"
+	"github.com/sbezverk/gobmp/pkg/bgpls"
+	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/message"
+	"github.com/sbezverk/gobmp/pkg/pub"
+	"github.com/sbezverk/gobmp/pkg/sr"
+)
+
+// Key identifies a topology: BGP-LS Identifier (domain), IGP protocol, area
+// and Multi-Topology ID. IS-IS has no per link area, its AreaID is empty.
+type Key struct {
+	DomainID   int64        `json:"domain_id"`
+	ProtocolID base.ProtoID `json:"protocol_id"`
+	AreaID     string       `json:"area_id,omitempty"`
+	MTID       uint16       `json:"mt_id"`
+}
+
+func (k Key) String() string {
+	return fmt.Sprintf("%d:%d:%s:%d", k.DomainID, k.ProtocolID, k.AreaID, k.MTID)
+}
+
+// Node is an IGP node of a topology, identified by its IGP Router ID.
+type Node struct {
+	ID                 string                      `json:"igp_router_id"`
+	Name               string                      `json:"name,omitempty"`
+	RouterID           string                      `json:"router_id,omitempty"`
+	ASN                uint32                      `json:"asn,omitempty"`
+	SRAlgorithm        []int                       `json:"sr_algorithm,omitempty"`
+	FlexAlgoDefinition []*bgpls.FlexAlgoDefinition `json:"flex_algo_definition,omitempty"`
+	SRGB               []*LabelRange               `json:"srgb,omitempty"`
+	SRLB               []*LabelRange               `json:"srlb,omitempty"`
+	Prefixes           []*Prefix                   `json:"prefixes,omitempty"`
+	SRv6SIDs           []string                    `json:"srv6_sids,omitempty"`
+}
+
+// LabelRange is a range of MPLS labels of an SR Global or Local Block.
+type LabelRange struct {
+	Base uint32 `json:"base"`
+	Size uint32 `json:"size"`
+}
+
+// Link is a unidirectional IGP adjacency from LocalNode to RemoteNode.
+type Link struct {
+	LocalNode    string `json:"local_node"`
+	RemoteNode   string `json:"remote_node"`
+	LocalLinkIP  string `json:"local_link_ip,omitempty"`
+	RemoteLinkIP string `json:"remote_link_ip,omitempty"`
+	LocalLinkID  uint32 `json:"local_link_id,omitempty"`
+	RemoteLinkID uint32 `json:"remote_link_id,omitempty"`
+	IGPMetric    uint32 `json:"igp_metric"`
+	TEMetric     uint32 `json:"te_metric,omitempty"`
+	Delay        uint32 `json:"delay,omitempty"`
+	AdminGroup   uint32 `json:"admin_group,omitempty"`
+	// ExtAdminGroup lists the Extended Administrative Groups of the link
+	ExtAdminGroup []uint32 `json:"ext_admin_group,omitempty"`
+	SRLG          []uint32 `json:"srlg,omitempty"`
+	// AdjSID and SRv6EndXSID are the SR-MPLS and SRv6 SIDs of the adjacency
+	AdjSID      []*sr.AdjacencySIDTLV `json:"adj_sid,omitempty"`
+	SRv6EndXSID []string              `json:"srv6_endx_sid,omitempty"`
+	// FlexAlgo carries the Application Specific Link Attributes advertised for
+	// Flexible Algorithm, when present they replace the legacy attributes in
+	// Flexible Algorithm computations (RFC 9350 §12).
+	FlexAlgo *LinkAttributes `json:"flex_algo_attributes,omitempty"`
+}
+
+// LinkAttributes are the link attributes used by Flexible Algorithm.
+type LinkAttributes struct {
+	TEMetric      uint32   `json:"te_metric,omitempty"`
+	Delay         uint32   `json:"delay,omitempty"`
+	AdminGroup    uint32   `json:"admin_group,omitempty"`
+	ExtAdminGroup []uint32 `json:"ext_admin_group,omitempty"`
+	SRLG          []uint32 `json:"srlg,omitempty"`
+}
+
+// Prefix is a prefix advertised by a node.
+type Prefix struct {
+	Node                 string                        `json:"node"`
+	Prefix               string                        `json:"prefix"`
+	PrefixLen            int32                         `json:"prefix_len"`
+	Metric               uint32                        `json:"prefix_metric,omitempty"`
+	FlexAlgoPrefixMetric []*bgpls.FlexAlgoPrefixMetric `json:"flex_algo_prefix_metric,omitempty"`
+	PrefixSID            []*sr.PrefixSIDTLV            `json:"prefix_sid,omitempty"`
+	// SRv6Locator is true when the prefix is an SRv6 Locator
+	SRv6Locator bool `json:"srv6_locator,omitempty"`
+}
+
+// SRv6SID is an SRv6 SID instantiated by a node.
+type SRv6SID struct {
+	Node string `json:"node"`
+	SID  string `json:"srv6_sid"`
+}
+
+// Event is a topology change event.
+type Event struct {
+	// Action is "add", "update" or "del"
+	Action string `json:"action"`
+	// Type is "node", "link", "prefix" or "srv6_sid"
+	Type      string   `json:"type"`
+	Topology  Key      `json:"topology"`
+	Timestamp string   `json:"timestamp,omitempty"`
+	Node      *Node    `json:"node,omitempty"`
+	Link      *Link    `json:"link,omitempty"`
+	Prefix    *Prefix  `json:"prefix,omitempty"`
+	SRv6SID   *SRv6SID `json:"srv6_sid,omitempty"`
+}
+
+// sources tracks the BMP speaker and peer pairs advertising an element, the
+// element is removed when the last of them withdraws it.
+type sources map[string]struct{}
+
+type nodeEntry struct {
+	node    *Node
+	sources sources
+}
+
+type linkEntry struct {
+	link    *Link
+	sources sources
+}
+
+type prefixEntry struct {
+	prefix  *Prefix
+	sources sources
+}
+
+type sidEntry struct {
+	sid     *SRv6SID
+	sources sources
+}
+
+type graph struct {
+	nodes    map[string]*nodeEntry
+	links    map[string]*linkEntry
+	prefixes map[string]*prefixEntry
+	sids     map[string]*sidEntry
+}
+
+func newGraph() *graph {
+	return &graph{
+		nodes:    make(map[string]*nodeEntry),
+		links:    make(map[string]*linkEntry),
+		prefixes: make(map[string]*prefixEntry),
+		sids:     make(map[string]*sidEntry),
+	}
+}
+
+func (g *graph) empty() bool {
+	return len(g.nodes) == 0 && len(g.links) == 0 && len(g.prefixes) == 0 && len(g.sids) == 0
+}
+
+// Topology is the set of topologies learned from BGP-LS, it implements
+// message.Observer.
+type Topology struct {
+	mu        sync.RWMutex
+	graphs    map[Key]*graph
+	publisher pub.Publisher
+}
+
+var _ message.Observer = &Topology{}
+
+// New returns an empty Topology, topology change events are published to
+// publisher when it is not nil.
+func New(publisher pub.Publisher) *Topology {
+	return &Topology{
+		graphs:    make(map[Key]*graph),
+		publisher: publisher,
+	}
+}
+
+// Observe updates the topologies from a produced message.
+func (t *Topology) Observe(msgType int, msg interface{}) {
+	var events []*Event
+	switch m := msg.(type) {
+	case *message.LSNode:
+		events = t.lsNode(m)
+	case *message.LSLink:
+		events = t.lsLink(m)
+	case *message.LSPrefix:
+		events = t.lsPrefix(m)
+	case *message.LSSRv6SID:
+		events = t.lsSRv6SID(m)
+	case *message.SRPolicy:
+		t.publishSRPolicy(m)
+		return
+	case *message.PeerStateChange:
+		if m.Action == "down" {
+			events = t.peerDown(source(m.RouterIP, m.RemoteIP), m.Timestamp)
+		}
+	default:
+		return
+	}
+	t.publish(events)
+}
+
+func (t *Topology) publish(events []*Event) {
+	if t.publisher == nil {
+		return
+	}
+	for _, e := range events {
+		b, err := json.Marshal(e)
+		if err != nil {
+			glog.Errorf("failed to marshal topology change event with error: %+v", err)
+			continue
+		}
+		if err := t.publisher.PublishMessage(bmp.LSTopologyChangeMsg, []byte(e.Topology.String()), b); err != nil {
+			glog.Errorf("failed to publish topology change event with error: %+v", err)
+		}
+	}
+}
+
+func source(routerIP, peerIP string) string {
+	return routerIP + "/" + peerIP
+}
+
+func areaID(proto base.ProtoID, area string) string {
+	switch proto {
+	case base.OSPFv2, base.OSPFv3:
+		return area
+	}
+	return ""
+}
+
+func mtID(mt *base.MultiTopologyIdentifier) uint16 {
+	if mt == nil {
+		return 0
+	}
+	return mt.MTID
+}
+
+// graphFor returns the graph of key k, creating it when create is true.
+func (t *Topology) graphFor(k Key, create bool) *graph {
+	g, ok := t.graphs[k]
+	if !ok && create {
+		g = newGraph()
+		t.graphs[k] = g
+	}
+	return g
+}
+
+// release deletes the graph of key k once it holds no element.
+func (t *Topology) release(k Key) {
+	if g, ok := t.graphs[k]; ok && g.empty() {
+		delete(t.graphs, k)
+	}
+}
+
+func (t *Topology) lsNode(m *message.LSNode) []*Event {
+	if m.IGPRouterID == "" {
+		return nil
+	}
+	src := source(m.RouterIP, m.PeerIP)
+	keys := make([]Key, 0, 1)
+	for _, mt := range m.MTID {
+		keys = append(keys, Key{DomainID: m.DomainID, ProtocolID: m.ProtocolID, AreaID: areaID(m.ProtocolID, m.AreaID), MTID: mtID(mt)})
+	}
+	if len(keys) == 0 {
+		keys = append(keys, Key{DomainID: m.DomainID, ProtocolID: m.ProtocolID, AreaID: areaID(m.ProtocolID, m.AreaID)})
+	}
+	n := &Node{
+		ID:                 m.IGPRouterID,
+		Name:               m.Name,
+		RouterID:           m.RouterID,
+		ASN:                m.ASN,
+		SRAlgorithm:        m.SRAlgorithm,
+		FlexAlgoDefinition: m.FlexAlgoDefinition,
+	}
+	if m.SRCapabilities != nil {
+		for _, c := range m.SRCapabilities.SubTLV {
+			n.SRGB = append(n.SRGB, &LabelRange{Base: c.SID, Size: c.Range})
+		}
+	}
+	if m.SRLocalBlock != nil {
+		for _, lb := range m.SRLocalBlock.TLV {
+			if lb.Label != nil {
+				n.SRLB = append(n.SRLB, &LabelRange{Base: *lb.Label, Size: lb.SubRange})
+			}
+		}
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	events := make([]*Event, 0, len(keys))
+	for _, k := range keys {
+		if m.Action == "del" {
+			g := t.graphFor(k, false)
+			if g == nil {
+				continue
+			}
+			if e, ok := g.nodes[n.ID]; ok {
+				delete(e.sources, src)
+				if len(e.sources) == 0 {
+					delete(g.nodes, n.ID)
+					events = append(events, &Event{Action: "del", Type: "node", Topology: k, Timestamp: m.Timestamp, Node: e.node})
+				}
+			}
+			t.release(k)
+			continue
+		}
+		g := t.graphFor(k, true)
+		e, ok := g.nodes[n.ID]
+		switch {
+		case !ok:
+			g.nodes[n.ID] = &nodeEntry{node: n, sources: sources{src: {}}}
+			events = append(events, &Event{Action: "add", Type: "node", Topology: k, Timestamp: m.Timestamp, Node: n})
+		case !reflect.DeepEqual(e.node, n):
+			e.node = n
+			e.sources[src] = struct{}{}
+			events = append(events, &Event{Action: "update", Type: "node", Topology: k, Timestamp: m.Timestamp, Node: n})
+		default:
+			e.sources[src] = struct{}{}
+		}
+	}
+
+	return events
+}
+
+func linkID(l *Link) string {
+	return fmt.Sprintf("%s|%s|%s|%s|%d|%d", l.LocalNode, l.RemoteNode, l.LocalLinkIP, l.RemoteLinkIP, l.LocalLinkID, l.RemoteLinkID)
+}
+
+func (t *Topology) lsLink(m *message.LSLink) []*Event {
+	if m.IGPRouterID == "" || m.RemoteIGPRouterID == "" {
+		return nil
+	}
+	l := &Link{
+		LocalNode:     m.IGPRouterID,
+		RemoteNode:    m.RemoteIGPRouterID,
+		LocalLinkIP:   m.LocalLinkIP,
+		RemoteLinkIP:  m.RemoteLinkIP,
+		LocalLinkID:   m.LocalLinkID,
+		RemoteLinkID:  m.RemoteLinkID,
+		IGPMetric:     m.IGPMetric,
+		TEMetric:      m.TEDefaultMetric,
+		Delay:         linkDelay(m),
+		AdminGroup:    m.AdminGroup,
+		ExtAdminGroup: m.ExtAdminGroup,
+		SRLG:          m.SRLG,
+		AdjSID:        m.LSAdjacencySID,
+		FlexAlgo:      flexAlgoLinkAttributes(m.AppSpecLinkAttr),
+	}
+	for _, sid := range m.SRv6ENDXSID {
+		l.SRv6EndXSID = append(l.SRv6EndXSID, sid.SID)
+	}
+	k := Key{DomainID: m.DomainID, ProtocolID: m.ProtocolID, AreaID: areaID(m.ProtocolID, m.AreaID), MTID: mtID(m.MTID)}
+	src := source(m.RouterIP, m.PeerIP)
+	id := linkID(l)
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if m.Action == "del" {
+		g := t.graphFor(k, false)
+		if g == nil {
+			return nil
+		}
+		defer t.release(k)
+		if e, ok := g.links[id]; ok {
+			delete(e.sources, src)
+			if len(e.sources) == 0 {
+				delete(g.links, id)
+				return []*Event{{Action: "del", Type: "link", Topology: k, Timestamp: m.Timestamp, Link: e.link}}
+			}
+		}
+		return nil
+	}
+	g := t.graphFor(k, true)
+	e, ok := g.links[id]
+	switch {
+	case !ok:
+		g.links[id] = &linkEntry{link: l, sources: sources{src: {}}}
+		return []*Event{{Action: "add", Type: "link", Topology: k, Timestamp: m.Timestamp, Link: l}}
+	case !reflect.DeepEqual(e.link, l):
+		e.link = l
+		e.sources[src] = struct{}{}
+		return []*Event{{Action: "update", Type: "link", Topology: k, Timestamp: m.Timestamp, Link: l}}
+	}
+	e.sources[src] = struct{}{}
+
+	return nil
+}
+
+func (t *Topology) lsPrefix(m *message.LSPrefix) []*Event {
+	if m.IGPRouterID == "" || m.Prefix == "" {
+		return nil
+	}
+	p := &Prefix{
+		Node:                 m.IGPRouterID,
+		Prefix:               m.Prefix,
+		PrefixLen:            m.PrefixLen,
+		Metric:               m.PrefixMetric,
+		FlexAlgoPrefixMetric: m.FlexAlgoPrefixMetric,
+		SRv6Locator:          m.SRv6Locator != nil,
+	}
+	if m.PrefixAttrTLVs != nil {
+		p.PrefixSID = m.PrefixAttrTLVs.LSPrefixSID
+	}
+	k := Key{DomainID: m.DomainID, ProtocolID: m.ProtocolID, AreaID: areaID(m.ProtocolID, m.AreaID), MTID: mtID(m.MTID)}
+	src := source(m.RouterIP, m.PeerIP)
+	id := fmt.Sprintf("%s|%s/%d", p.Node, p.Prefix, p.PrefixLen)
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if m.Action == "del" {
+		g := t.graphFor(k, false)
+		if g == nil {
+			return nil
+		}
+		defer t.release(k)
+		if e, ok := g.prefixes[id]; ok {
+			delete(e.sources, src)
+			if len(e.sources) == 0 {
+				delete(g.prefixes, id)
+				return []*Event{{Action: "del", Type: "prefix", Topology: k, Timestamp: m.Timestamp, Prefix: e.prefix}}
+			}
+		}
+		return nil
+	}
+	g := t.graphFor(k, true)
+	e, ok := g.prefixes[id]
+	switch {
+	case !ok:
+		g.prefixes[id] = &prefixEntry{prefix: p, sources: sources{src: {}}}
+		return []*Event{{Action: "add", Type: "prefix", Topology: k, Timestamp: m.Timestamp, Prefix: p}}
+	case !reflect.DeepEqual(e.prefix, p):
+		e.prefix = p
+		e.sources[src] = struct{}{}
+		return []*Event{{Action: "update", Type: "prefix", Topology: k, Timestamp: m.Timestamp, Prefix: p}}
+	}
+	e.sources[src] = struct{}{}
+
+	return nil
+}
+
+func (t *Topology) lsSRv6SID(m *message.LSSRv6SID) []*Event {
+	if m.IGPRouterID == "" || m.SRv6SID == "" {
+		return nil
+	}
+	s := &SRv6SID{
+		Node: m.IGPRouterID,
+		SID:  m.SRv6SID,
+	}
+	k := Key{DomainID: m.DomainID, ProtocolID: m.ProtocolID, AreaID: areaID(m.ProtocolID, m.AreaID), MTID: mtID(m.MTID)}
+	src := source(m.RouterIP, m.PeerIP)
+	id := s.Node + "|" + s.SID
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if m.Action == "del" {
+		g := t.graphFor(k, false)
+		if g == nil {
+			return nil
+		}
+		defer t.release(k)
+		if e, ok := g.sids[id]; ok {
+			delete(e.sources, src)
+			if len(e.sources) == 0 {
+				delete(g.sids, id)
+				return []*Event{{Action: "del", Type: "srv6_sid", Topology: k, Timestamp: m.Timestamp, SRv6SID: e.sid}}
+			}
+		}
+		return nil
+	}
+	g := t.graphFor(k, true)
+	if e, ok := g.sids[id]; ok {
+		e.sources[src] = struct{}{}
+		return nil
+	}
+	g.sids[id] = &sidEntry{sid: s, sources: sources{src: {}}}
+
+	return []*Event{{Action: "add", Type: "srv6_sid", Topology: k, Timestamp: m.Timestamp, SRv6SID: s}}
+}
+
+// peerDown removes src from every element, elements left without source are
+// deleted.
+func (t *Topology) peerDown(src, ts string) []*Event {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	events := make([]*Event, 0)
+	for k, g := range t.graphs {
+		for id, e := range g.nodes {
+			if _, ok := e.sources[src]; !ok {
+				continue
+			}
+			if delete(e.sources, src); len(e.sources) == 0 {
+				delete(g.nodes, id)
+				events = append(events, &Event{Action: "del", Type: "node", Topology: k, Timestamp: ts, Node: e.node})
+			}
+		}
+		for id, e := range g.links {
+			if _, ok := e.sources[src]; !ok {
+				continue
+			}
+			if delete(e.sources, src); len(e.sources) == 0 {
+				delete(g.links, id)
+				events = append(events, &Event{Action: "del", Type: "link", Topology: k, Timestamp: ts, Link: e.link})
+			}
+		}
+		for id, e := range g.prefixes {
+			if _, ok := e.sources[src]; !ok {
+				continue
+			}
+			if delete(e.sources, src); len(e.sources) == 0 {
+				delete(g.prefixes, id)
+				events = append(events, &Event{Action: "del", Type: "prefix", Topology: k, Timestamp: ts, Prefix: e.prefix})
+			}
+		}
+		for id, e := range g.sids {
+			if _, ok := e.sources[src]; !ok {
+				continue
+			}
+			if delete(e.sources, src); len(e.sources) == 0 {
+				delete(g.sids, id)
+				events = append(events, &Event{Action: "del", Type: "srv6_sid", Topology: k, Timestamp: ts, SRv6SID: e.sid})
+			}
+		}
+		t.release(k)
+	}
+
+	return events
+}
+
+// Topologies returns the keys of all known topologies.
+func (t *Topology) Topologies() []Key {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	keys := make([]Key, 0, len(t.graphs))
+	for k := range t.graphs {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].String() < keys[j].String()
+	})
+
+	return keys
+}
+
+// Nodes returns the nodes of topology k with their prefixes and SRv6 SIDs.
+func (t *Topology) Nodes(k Key) []*Node {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	g, ok := t.graphs[k]
+	if !ok {
+		return nil
+	}
+	ix := g.index()
+	nodes := make([]*Node, 0, len(g.nodes))
+	for id := range g.nodes {
+		nodes = append(nodes, ix.node(g, id))
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].ID < nodes[j].ID
+	})
+
+	return nodes
+}
+
+// Node returns the node of topology k identified by its IGP Router ID, name or
+// Router ID, nil when not found.
+func (t *Topology) Node(k Key, id string) *Node {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	g, ok := t.graphs[k]
+	if !ok {
+		return nil
+	}
+	if id = g.resolve(id); id == "" {
+		return nil
+	}
+
+	return g.index().node(g, id)
+}
+
+// Links returns the links of topology k.
+func (t *Topology) Links(k Key) []*Link {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	g, ok := t.graphs[k]
+	if !ok {
+		return nil
+	}
+	links := make([]*Link, 0, len(g.links))
+	for _, e := range g.links {
+		links = append(links, e.link)
+	}
+	sort.Slice(links, func(i, j int) bool {
+		return linkID(links[i]) < linkID(links[j])
+	})
+
+	return links
+}
+
+// nodeIndex holds the prefixes and the SRv6 SIDs of a graph by node.
+type nodeIndex struct {
+	prefixes map[string][]*Prefix
+	sids     map[string][]string
+}
+
+// index returns the prefixes and the SRv6 SIDs of g by node.
+func (g *graph) index() *nodeIndex {
+	ix := &nodeIndex{
+		prefixes: make(map[string][]*Prefix),
+		sids:     make(map[string][]string),
+	}
+	for _, e := range g.prefixes {
+		ix.prefixes[e.prefix.Node] = append(ix.prefixes[e.prefix.Node], e.prefix)
+	}
+	for _, e := range g.sids {
+		ix.sids[e.sid.Node] = append(ix.sids[e.sid.Node], e.sid.SID)
+	}
+
+	return ix
+}
+
+// node builds a copy of node id of graph g with its prefixes and SRv6 SIDs.
+func (ix *nodeIndex) node(g *graph, id string) *Node {
+	n := &Node{ID: id}
+	if e, ok := g.nodes[id]; ok {
+		*n = *e.node
+	}
+	n.Prefixes = ix.prefixes[id]
+	n.SRv6SIDs = ix.sids[id]
+	sort.Slice(n.Prefixes, func(i, j int) bool {
+		if n.Prefixes[i].Prefix != n.Prefixes[j].Prefix {
+			return n.Prefixes[i].Prefix < n.Prefixes[j].Prefix
+		}
+		return n.Prefixes[i].PrefixLen < n.Prefixes[j].PrefixLen
+	})
+	sort.Strings(n.SRv6SIDs)
+
+	return n
+}
+
+// resolve returns the IGP Router ID of the node known by id, its name or its
+// Router ID, or an empty string.
+func (g *graph) resolve(id string) string {
+	if _, ok := g.nodes[id]; ok {
+		return id
+	}
+	for nid, e := range g.nodes {
+		if e.node.Name == id || e.node.RouterID == id {
+			return nid
+		}
+	}
+	// Nodes known only as link end points
+	for _, e := range g.links {
+		if e.link.LocalNode == id || e.link.RemoteNode == id {
+			return id
+		}
+	}
+
+	return ""
+}
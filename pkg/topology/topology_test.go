@@ -0,0 +1,337 @@
+package topology
+
+import (
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/sbezverk/gobmp/pkg/base"
+	"github.com/sbezverk/gobmp/pkg/bgpls"
+	"github.com/sbezverk/gobmp/pkg/bmp"
+	"github.com/sbezverk/gobmp/pkg/message"
+)
+
+type recorder struct {
+	mu     sync.Mutex
+	events []*Event
+}
+
+func (r *recorder) PublishMessage(msgType int, msgHash []byte, msg []byte) error {
+	if msgType != bmp.LSTopologyChangeMsg {
+		return nil
+	}
+	e := &Event{}
+	if err := json.Unmarshal(msg, e); err != nil {
+		return err
+	}
+	r.mu.Lock()
+	r.events = append(r.events, e)
+	r.mu.Unlock()
+	return nil
+}
+
+func (r *recorder) Stop() {}
+
+func (r *recorder) actions() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	a := make([]string, 0, len(r.events))
+	for _, e := range r.events {
+		a = append(a, e.Action+" "+e.Type)
+	}
+	r.events = nil
+	return a
+}
+
+var isis = Key{ProtocolID: base.ISISL2}
+
+func node(id string, algos ...int) *message.LSNode {
+	return &message.LSNode{
+		Action:      "add",
+		RouterIP:    "10.0.0.1",
+		PeerIP:      "192.168.0.1",
+		ProtocolID:  base.ISISL2,
+		IGPRouterID: id,
+		Name:        "r" + id,
+		SRAlgorithm: algos,
+	}
+}
+
+func link(local, remote string, igp, te, delay, ag uint32) *message.LSLink {
+	return &message.LSLink{
+		Action:            "add",
+		RouterIP:          "10.0.0.1",
+		PeerIP:            "192.168.0.1",
+		ProtocolID:        base.ISISL2,
+		IGPRouterID:       local,
+		RemoteIGPRouterID: remote,
+		IGPMetric:         igp,
+		TEDefaultMetric:   te,
+		UnidirLinkDelay:   delay,
+		AdminGroup:        ag,
+	}
+}
+
+// biLink returns both directions of a link.
+func biLink(a, b string, igp, te, delay, ag uint32) []*message.LSLink {
+	return []*message.LSLink{link(a, b, igp, te, delay, ag), link(b, a, igp, te, delay, ag)}
+}
+
+// square builds the topology
+//
+//	1 --(igp 10, te 100, delay 5, red)-- 2 --(igp 10, te 100, delay 5)-- 4
+//	1 --(igp 15, te 10, delay 50)------- 3 --(igp 15, te 10, delay 50)-- 4
+//
+// where every node participates in flexible algorithm 128 which excludes red.
+func square(t *Topology) {
+	fad := &bgpls.FlexAlgoDefinition{
+		FlexAlgorithm: 128,
+		MetricType:    uint8(MetricIGP),
+		Priority:      100,
+		SubTLV:        &bgpls.FADSubTLV{ExcludeAny: []uint32{0x1}},
+	}
+	for _, id := range []string{"1", "2", "3", "4"} {
+		n := node(id, 0, 128)
+		if id == "1" {
+			n.FlexAlgoDefinition = []*bgpls.FlexAlgoDefinition{fad}
+		}
+		t.Observe(bmp.LSNodeMsg, n)
+	}
+	links := make([]*message.LSLink, 0)
+	links = append(links, biLink("1", "2", 10, 100, 5, 0x1)...)
+	links = append(links, biLink("2", "4", 10, 100, 5, 0)...)
+	links = append(links, biLink("1", "3", 15, 10, 50, 0)...)
+	links = append(links, biLink("3", "4", 15, 10, 50, 0)...)
+	for _, l := range links {
+		t.Observe(bmp.LSLinkMsg, l)
+	}
+}
+
+func TestTopologyEvents(t *testing.T) {
+	r := &recorder{}
+	topo := New(r)
+
+	topo.Observe(bmp.LSNodeMsg, node("1"))
+	topo.Observe(bmp.LSNodeMsg, node("1"))
+	n := node("1")
+	n.Name = "renamed"
+	topo.Observe(bmp.LSNodeMsg, n)
+	// Same node learned from a second peer
+	n2 := node("1")
+	n2.Name = "renamed"
+	n2.PeerIP = "192.168.0.2"
+	topo.Observe(bmp.LSNodeMsg, n2)
+	topo.Observe(bmp.LSLinkMsg, link("1", "2", 10, 0, 0, 0))
+	topo.Observe(bmp.LSPrefixMsg, &message.LSPrefix{Action: "add", RouterIP: "10.0.0.1", PeerIP: "192.168.0.1", ProtocolID: base.ISISL2, IGPRouterID: "1", Prefix: "10.1.1.1", PrefixLen: 32})
+	topo.Observe(bmp.LSSRv6SIDMsg, &message.LSSRv6SID{Action: "add", RouterIP: "10.0.0.1", PeerIP: "192.168.0.1", ProtocolID: base.ISISL2, IGPRouterID: "1", SRv6SID: "fc00::1"})
+	if got, want := r.actions(), []string{"add node", "update node", "add link", "add prefix", "add srv6_sid"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("events = %v, want %v", got, want)
+	}
+	got := topo.Node(isis, "renamed")
+	if got == nil || got.ID != "1" || len(got.Prefixes) != 1 || !reflect.DeepEqual(got.SRv6SIDs, []string{"fc00::1"}) {
+		t.Fatalf("Node() = %+v", got)
+	}
+
+	del := node("1")
+	del.Action = "del"
+	topo.Observe(bmp.LSNodeMsg, del)
+	if got := r.actions(); len(got) != 0 {
+		t.Fatalf("node still advertised by a peer, got events %v", got)
+	}
+	topo.Observe(bmp.PeerStateChangeMsg, &message.PeerStateChange{Action: "down", RouterIP: "10.0.0.1", RemoteIP: "192.168.0.1"})
+	if got, want := len(r.actions()), 3; got != want {
+		t.Fatalf("peer down events = %d, want %d", got, want)
+	}
+	topo.Observe(bmp.PeerStateChangeMsg, &message.PeerStateChange{Action: "down", RouterIP: "10.0.0.1", RemoteIP: "192.168.0.2"})
+	if got, want := r.actions(), []string{"del node"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("events = %v, want %v", got, want)
+	}
+	if got := topo.Topologies(); len(got) != 0 {
+		t.Fatalf("Topologies() = %v, want none", got)
+	}
+}
+
+func TestTopologyKeys(t *testing.T) {
+	topo := New(nil)
+	n := node("1")
+	n.MTID = []*base.MultiTopologyIdentifier{{MTID: 0}, {MTID: 2}}
+	topo.Observe(bmp.LSNodeMsg, n)
+	ospf := node("2")
+	ospf.ProtocolID = base.OSPFv2
+	ospf.AreaID = "0"
+	topo.Observe(bmp.LSNodeMsg, ospf)
+	want := []Key{
+		{ProtocolID: base.ISISL2},
+		{ProtocolID: base.ISISL2, MTID: 2},
+		{ProtocolID: base.OSPFv2, AreaID: "0"},
+	}
+	if got := topo.Topologies(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("Topologies() = %v, want %v", got, want)
+	}
+}
+
+func TestTopologyPath(t *testing.T) {
+	topo := New(nil)
+	square(topo)
+	tests := []struct {
+		name  string
+		src   string
+		dst   string
+		opts  *PathOptions
+		nodes []string
+		cost  uint64
+		fail  bool
+	}{
+		{
+			name:  "igp",
+			src:   "1",
+			dst:   "4",
+			nodes: []string{"1", "2", "4"},
+			cost:  20,
+		},
+		{
+			name:  "te",
+			src:   "r1",
+			dst:   "r4",
+			opts:  &PathOptions{Metric: MetricTE},
+			nodes: []string{"1", "3", "4"},
+			cost:  20,
+		},
+		{
+			name:  "delay",
+			src:   "1",
+			dst:   "4",
+			opts:  &PathOptions{Metric: MetricDelay},
+			nodes: []string{"1", "2", "4"},
+			cost:  10,
+		},
+		{
+			name:  "flex algo 128 excludes red",
+			src:   "1",
+			dst:   "4",
+			opts:  &PathOptions{FlexAlgo: 128},
+			nodes: []string{"1", "3", "4"},
+			cost:  30,
+		},
+		{
+			name: "flex algo 129 undefined",
+			src:  "1",
+			dst:  "4",
+			opts: &PathOptions{FlexAlgo: 129},
+			fail: true,
+		},
+		{
+			name: "unknown node",
+			src:  "1",
+			dst:  "5",
+			fail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := topo.Path(isis, tt.src, tt.dst, tt.opts)
+			if err != nil {
+				if !tt.fail {
+					t.Fatalf("Path() error = %v", err)
+				}
+				return
+			}
+			if tt.fail {
+				t.Fatalf("Path() = %+v, want error", p)
+			}
+			if !reflect.DeepEqual(p.Nodes, tt.nodes) || p.Cost != tt.cost {
+				t.Fatalf("Path() = %v cost %d, want %v cost %d", p.Nodes, p.Cost, tt.nodes, tt.cost)
+			}
+		})
+	}
+}
+
+func TestTopologyTwoWay(t *testing.T) {
+	topo := New(nil)
+	topo.Observe(bmp.LSLinkMsg, link("1", "2", 10, 0, 0, 0))
+	if _, err := topo.Path(isis, "1", "2", nil); err == nil {
+		t.Fatal("Path() over a one way link succeeded")
+	}
+	topo.Observe(bmp.LSLinkMsg, link("2", "1", 10, 0, 0, 0))
+	if _, err := topo.Path(isis, "1", "2", nil); err != nil {
+		t.Fatalf("Path() error = %v", err)
+	}
+}
+
+// BenchmarkSPF computes the shortest path tree of a 30x30 grid.
+func BenchmarkSPF(b *testing.B) {
+	topo := New(nil)
+	const size = 30
+	id := func(x, y int) string { return strconv.Itoa(x*size + y) }
+	for x := 0; x < size; x++ {
+		for y := 0; y < size; y++ {
+			topo.Observe(bmp.LSNodeMsg, node(id(x, y)))
+			if x+1 < size {
+				for _, l := range biLink(id(x, y), id(x+1, y), 10, 0, 0, 0) {
+					topo.Observe(bmp.LSLinkMsg, l)
+				}
+			}
+			if y+1 < size {
+				for _, l := range biLink(id(x, y), id(x, y+1), 10, 0, 0, 0) {
+					topo.Observe(bmp.LSLinkMsg, l)
+				}
+			}
+		}
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tree, err := topo.SPF(isis, "0", nil)
+		if err != nil || len(tree) != size*size {
+			b.Fatalf("SPF() = %d paths, %v", len(tree), err)
+		}
+	}
+}
+
+func TestFlexAlgoLinkAttributes(t *testing.T) {
+	aslas := []*bgpls.AppSpecLinkAttr{
+		{SAIBMLen: 4, SAIBM: []byte{0x80, 0, 0, 0}, SubTLV: []*base.SubTLV{{Type: 1092, Length: 4, Value: []byte{0, 0, 0, 1}}}},
+		{SAIBMLen: 4, SAIBM: []byte{0x10, 0, 0, 0}, SubTLV: []*base.SubTLV{
+			{Type: 1088, Length: 4, Value: []byte{0, 0, 0, 2}},
+			{Type: 1092, Length: 4, Value: []byte{0, 0, 0, 3}},
+			{Type: 1096, Length: 8, Value: []byte{0, 0, 0, 4, 0, 0, 0, 5}},
+			{Type: 1115, Length: 8, Value: []byte{0x80, 0, 0, 6, 0, 0, 0, 7}},
+			{Type: 1173, Length: 8, Value: []byte{0, 0, 0, 0, 0, 0, 0, 1}},
+		}},
+	}
+	want := &LinkAttributes{AdminGroup: 2, ExtAdminGroup: []uint32{32}, TEMetric: 3, SRLG: []uint32{4, 5}, Delay: 6}
+	if got := flexAlgoLinkAttributes(aslas); !reflect.DeepEqual(got, want) {
+		t.Fatalf("flexAlgoLinkAttributes() = %+v, want %+v", got, want)
+	}
+	if got := flexAlgoLinkAttributes(aslas[:1]); got != nil {
+		t.Fatalf("flexAlgoLinkAttributes() = %+v, want nil", got)
+	}
+}
+
+func TestFlexAlgoExtAdminGroup(t *testing.T) {
+	topo := New(nil)
+	square(topo)
+	// Extended Administrative Group 33 on 1 - 3 - 4, algorithm 129 only
+	// includes it.
+	for _, id := range []string{"1", "2", "3", "4"} {
+		n := node(id, 0, 128, 129)
+		if id == "1" {
+			n.FlexAlgoDefinition = []*bgpls.FlexAlgoDefinition{
+				{FlexAlgorithm: 129, Priority: 100, SubTLV: &bgpls.FADSubTLV{IncludeAny: []uint32{0, 0x2}}},
+			}
+		}
+		topo.Observe(bmp.LSNodeMsg, n)
+	}
+	for _, l := range append(biLink("1", "3", 15, 10, 50, 0), biLink("3", "4", 15, 10, 50, 0)...) {
+		l.ExtAdminGroup = []uint32{33}
+		topo.Observe(bmp.LSLinkMsg, l)
+	}
+	p, err := topo.Path(isis, "1", "4", &PathOptions{FlexAlgo: 129})
+	if err != nil {
+		t.Fatalf("Path() error = %v", err)
+	}
+	if want := []string{"1", "3", "4"}; !reflect.DeepEqual(p.Nodes, want) {
+		t.Fatalf("Path() = %v, want %v", p.Nodes, want)
+	}
+}
@@ -0,0 +1,122 @@
+package vrf
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/golang/glog"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	maxMapFileSize = 1024 * 1024 // 1 MB
+)
+
+// Map associates L3VPN routes with the VRF they belong to. The association
+// is either configured statically, as a list of Route Targets per VRF, or
+// learned from RFC 9069 Loc-RIB instances, whose Peer Distinguisher is the
+// Route Distinguisher of the VRF and whose Table Name is the VRF name.
+// A nil Map never finds a VRF.
+type Map struct {
+	mu sync.RWMutex
+	rt map[string]string
+	rd map[string]string
+}
+
+// File defines the format of the static Route Target to VRF mapping file:
+//
+//	vrfs:
+//	  - name: CUSTOMER-A
+//	    route_targets: ["65000:100", "10.0.0.1:100"]
+type File struct {
+	VRFs []struct {
+		Name         string   `yaml:"name"`
+		RouteTargets []string `yaml:"route_targets"`
+	} `yaml:"vrfs"`
+}
+
+// NewMap returns a new empty VRF map.
+func NewMap() *Map {
+	return &Map{
+		rt: make(map[string]string),
+		rd: make(map[string]string),
+	}
+}
+
+// LoadMap returns a new VRF map populated from the static mapping file, an
+// empty path returns an empty map which only learns mappings.
+func LoadMap(path string) (*Map, error) {
+	m := NewMap()
+	if path == "" {
+		return m, nil
+	}
+	fi, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if fi.Size() > maxMapFileSize {
+		return nil, fmt.Errorf("vrf map file size exceeds the maximum allowed size of %d bytes", maxMapFileSize)
+	}
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	f := &File{}
+	if err := yaml.Unmarshal(b, f); err != nil {
+		return nil, fmt.Errorf("failed to parse vrf map file %s with error: %w", path, err)
+	}
+	for _, v := range f.VRFs {
+		if v.Name == "" {
+			return nil, fmt.Errorf("vrf map file %s has a vrf without a name", path)
+		}
+		for _, rt := range v.RouteTargets {
+			rt = normalizeRT(rt)
+			if n, ok := m.rt[rt]; ok && n != v.Name {
+				return nil, fmt.Errorf("route target %s is mapped to both vrf %s and vrf %s", rt, n, v.Name)
+			}
+			m.rt[rt] = v.Name
+		}
+	}
+	glog.Infof("loaded %d route targets of %d vrfs from %s", len(m.rt), len(f.VRFs), path)
+
+	return m, nil
+}
+
+// normalizeRT accepts both "65000:100" and the ext_community_list form "rt=65000:100".
+func normalizeRT(rt string) string {
+	return strings.TrimPrefix(strings.TrimSpace(rt), "rt=")
+}
+
+// Learn records that the Route Distinguisher rd belongs to the VRF name.
+func (m *Map) Learn(rd, name string) {
+	if m == nil || rd == "" || name == "" {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if n, ok := m.rd[rd]; ok && n != name {
+		glog.Warningf("route distinguisher %s moved from vrf %s to vrf %s", rd, n, name)
+	}
+	m.rd[rd] = name
+}
+
+// Lookup returns the VRF of a route with the Route Distinguisher rd carrying
+// the Route Targets rts. Statically mapped Route Targets take precedence over
+// the learned Route Distinguishers, an empty string is returned when no VRF
+// is found.
+func (m *Map) Lookup(rd string, rts []string) string {
+	if m == nil {
+		return ""
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	for _, rt := range rts {
+		if n, ok := m.rt[normalizeRT(rt)]; ok {
+			return n
+		}
+	}
+
+	return m.rd[rd]
+}
@@ -0,0 +1,87 @@
+package vrf
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadMap(t *testing.T) {
+	tests := []struct {
+		name    string
+		file    string
+		wantErr bool
+	}{
+		{
+			name: "valid map",
+			file: "vrfs:\n  - name: CUST-A\n    route_targets: [\"65000:100\", \"rt=10.0.0.1:100\"]\n  - name: CUST-B\n    route_targets: [\"65000:200\"]\n",
+		},
+		{
+			name:    "vrf without name",
+			file:    "vrfs:\n  - route_targets: [\"65000:100\"]\n",
+			wantErr: true,
+		},
+		{
+			name:    "route target in two vrfs",
+			file:    "vrfs:\n  - name: CUST-A\n    route_targets: [\"65000:100\"]\n  - name: CUST-B\n    route_targets: [\"65000:100\"]\n",
+			wantErr: true,
+		},
+		{
+			name:    "invalid yaml",
+			file:    "vrfs: [",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "vrfs.yaml")
+			if err := os.WriteFile(path, []byte(tt.file), 0o644); err != nil {
+				t.Fatalf("failed to write vrf map file: %v", err)
+			}
+			m, err := LoadMap(path)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("LoadMap() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if got := m.Lookup("", []string{"10.0.0.1:100"}); got != "CUST-A" {
+				t.Errorf("Lookup(10.0.0.1:100) = %q, want CUST-A", got)
+			}
+			if got := m.Lookup("", []string{"65000:300", "65000:200"}); got != "CUST-B" {
+				t.Errorf("Lookup(65000:300, 65000:200) = %q, want CUST-B", got)
+			}
+		})
+	}
+}
+
+func TestLookup(t *testing.T) {
+	m := NewMap()
+	m.rt["65000:100"] = "CUST-A"
+	m.Learn("65000:1", "CUST-B")
+	m.Learn("65000:2", "")
+	tests := []struct {
+		name string
+		rd   string
+		rts  []string
+		want string
+	}{
+		{name: "static route target", rd: "65000:9", rts: []string{"65000:100"}, want: "CUST-A"},
+		{name: "static route target wins over learned rd", rd: "65000:1", rts: []string{"65000:100"}, want: "CUST-A"},
+		{name: "learned rd", rd: "65000:1", rts: []string{"65000:999"}, want: "CUST-B"},
+		{name: "empty vrf name is not learned", rd: "65000:2", want: ""},
+		{name: "unknown", rd: "65000:3", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := m.Lookup(tt.rd, tt.rts); got != tt.want {
+				t.Errorf("Lookup() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+	var nilMap *Map
+	nilMap.Learn("65000:1", "CUST-A")
+	if got := nilMap.Lookup("65000:1", nil); got != "" {
+		t.Errorf("nil map Lookup() = %q, want empty", got)
+	}
+}